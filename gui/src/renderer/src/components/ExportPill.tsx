@@ -1,6 +1,6 @@
 // ExportPill is the shared v6.0 Ember "Export ▾" control that lands on
-// every Insights surface. It opens a small dropdown with Markdown / CSV
-// options; picking one triggers the matching `useExportReport` call.
+// every Insights surface. It opens a small dropdown with Markdown / CSV /
+// JSON / HTML options; picking one triggers the matching `useExportReport` call.
 //
 // Scope is set by the parent — three shapes are supported, mirroring the
 // proto contract:
@@ -108,6 +108,22 @@ export function ExportPill({ scope, window, label = 'Export', className }: Expor
           >
             CSV (.csv)
           </button>
+          <button
+            role="menuitem"
+            onClick={() => void handlePick('json')}
+            data-testid="export-pill-json"
+            className="block w-full text-left px-3 py-1.5 text-xs text-[var(--wf-text-primary)] hover:bg-[var(--wf-bg)]"
+          >
+            JSON (.json)
+          </button>
+          <button
+            role="menuitem"
+            onClick={() => void handlePick('html')}
+            data-testid="export-pill-html"
+            className="block w-full text-left px-3 py-1.5 text-xs text-[var(--wf-text-primary)] hover:bg-[var(--wf-bg)]"
+          >
+            HTML (.html)
+          </button>
         </div>
       )}
     </div>
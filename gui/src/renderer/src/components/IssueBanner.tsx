@@ -1,12 +1,19 @@
-import { AlertTriangle, RefreshCw } from 'lucide-react'
+import { useState } from 'react'
+import { AlertTriangle, MessageCircleQuestion, RefreshCw, Send } from 'lucide-react'
 import type { AgentIssue } from '../generated/watchfire_pb'
 
 interface IssueBannerProps {
   issue: AgentIssue
   onResume?: () => void
+  // Answers an awaiting_input question; option is the 1-based suggested
+  // answer (0 when the text is free-form).
+  onAnswer?: (answer: string, option: number) => Promise<void>
 }
 
-export function IssueBanner({ issue, onResume }: IssueBannerProps) {
+export function IssueBanner({ issue, onResume, onAnswer }: IssueBannerProps) {
+  if (issue.issueType === 'awaiting_input') {
+    return <QuestionBanner issue={issue} onAnswer={onAnswer} />
+  }
   // sandbox_denied (#17): the agent was never started — the project path is
   // inside a sandbox-denied root. Resume can't help; the message says what to do.
   const resumable = issue.issueType !== 'sandbox_denied'
@@ -32,3 +39,71 @@ export function IssueBanner({ issue, onResume }: IssueBannerProps) {
     </div>
   )
 }
+
+// The agent wrote .watchfire/question.yaml and is waiting. The same
+// question went to the project's chat endpoints; whichever answer lands
+// first is typed into the session and clears this banner.
+function QuestionBanner({ issue, onAnswer }: Pick<IssueBannerProps, 'issue' | 'onAnswer'>) {
+  const [text, setText] = useState('')
+  const [sending, setSending] = useState(false)
+
+  const send = async (answer: string, option: number) => {
+    if (!onAnswer || sending) return
+    setSending(true)
+    try {
+      await onAnswer(answer, option)
+      setText('')
+    } finally {
+      setSending(false)
+    }
+  }
+
+  return (
+    <div className="flex flex-col gap-2 px-4 py-2 bg-blue-900/30 border-b border-blue-700/40 text-blue-100 text-sm">
+      <div className="flex items-start gap-3">
+        <MessageCircleQuestion size={16} className="shrink-0 mt-0.5 text-blue-400" />
+        <span className="flex-1 whitespace-pre-wrap">
+          <span className="font-medium">The agent asks: </span>
+          {issue.message}
+        </span>
+      </div>
+      {onAnswer && (
+        <div className="flex flex-wrap items-center gap-2 pl-7">
+          {issue.options.map((option, i) => (
+            <button
+              key={option}
+              disabled={sending}
+              onClick={() => void send(option, i + 1)}
+              className="px-2 py-1 text-xs font-medium rounded bg-blue-700/50 hover:bg-blue-700/70 disabled:opacity-50 transition-colors"
+            >
+              {option}
+            </button>
+          ))}
+          <form
+            className="flex flex-1 min-w-[12rem] items-center gap-2"
+            onSubmit={(e) => {
+              e.preventDefault()
+              if (text.trim()) void send(text.trim(), 0)
+            }}
+          >
+            <input
+              value={text}
+              onChange={(e) => setText(e.target.value)}
+              placeholder="Type an answer…"
+              disabled={sending}
+              className="flex-1 px-2 py-1 text-xs rounded bg-blue-950/50 border border-blue-700/40 placeholder:text-blue-300/50 focus:outline-none focus:border-blue-500"
+            />
+            <button
+              type="submit"
+              disabled={sending || !text.trim()}
+              className="flex items-center gap-1 px-2 py-1 text-xs font-medium rounded bg-blue-700/50 hover:bg-blue-700/70 disabled:opacity-50 transition-colors"
+            >
+              <Send size={12} />
+              Answer
+            </button>
+          </form>
+        </div>
+      )}
+    </div>
+  )
+}
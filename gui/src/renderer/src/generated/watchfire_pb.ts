@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file watchfire.proto.
  */
 export const file_watchfire: GenFile = /*@__PURE__*/
-  fileDesc("Cg93YXRjaGZpcmUucHJvdG8SCXdhdGNoZmlyZSJBCgtSZXF1ZXN0TWV0YRIOCgZvcmlnaW4YASABKAkSEQoJY2xpZW50X2lkGAIgASgJEg8KB3ZlcnNpb24YAyABKAkinwQKB1Byb2plY3QSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHBhdGgYAyABKAkSDgoGc3RhdHVzGAQgASgJEg0KBWNvbG9yGAUgASgJEhUKDWRlZmF1bHRfYWdlbnQYByABKAkSDwoHc2FuZGJveBgIIAEoCRISCgphdXRvX21lcmdlGAkgASgIEhoKEmF1dG9fZGVsZXRlX2JyYW5jaBgKIAEoCBIYChBhdXRvX3N0YXJ0X3Rhc2tzGAsgASgIEhIKCmRlZmluaXRpb24YDCABKAkSLgoKY3JlYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGAoQbmV4dF90YXNrX251bWJlchgPIAEoBRIQCghwb3NpdGlvbhgQIAEoBRIcChRzZWNyZXRzX2luc3RydWN0aW9ucxgRIAEoCRI2Cg1ub3RpZmljYXRpb25zGBIgASgLMh8ud2F0Y2hmaXJlLlByb2plY3ROb3RpZmljYXRpb25zEjQKDGludGVncmF0aW9ucxgTIAEoCzIeLndhdGNoZmlyZS5Qcm9qZWN0SW50ZWdyYXRpb25zEiEKGWxhc3RfcmV0cm9maXRfdGFza19udW1iZXIYFCABKAVKBAgGEAciXgoTUHJvamVjdEludGVncmF0aW9ucxIVCg1zbGFja19jaGFubmVsGAEgASgJEhgKEGRpc2NvcmRfZ3VpbGRfaWQYAiABKAkSFgoOZ2l0aHViX2F1dG9fcHIYAyABKAgiggIKFFByb2plY3ROb3RpZmljYXRpb25zEg0KBW11dGVkGAEgASgIEhcKD292ZXJyaWRlX2V2ZW50cxgCIAEoCBI7CgZldmVudHMYAyADKAsyKy53YXRjaGZpcmUuUHJvamVjdE5vdGlmaWNhdGlvbnMuRXZlbnRzRW50cnkSOQoUcXVpZXRfaG91cnNfb3ZlcnJpZGUYBCABKAsyGy53YXRjaGZpcmUuUXVpZXRIb3Vyc0NvbmZpZxpKCgtFdmVudHNFbnRyeRILCgNrZXkYASABKAkSKgoFdmFsdWUYAiABKAsyGy53YXRjaGZpcmUuUHJvamVjdEV2ZW50UHJlZjoCOAEiMgoQUHJvamVjdEV2ZW50UHJlZhIPCgdlbmFibGVkGAEgASgIEg0KBXNvdW5kGAIgASgJIkUKCVByb2plY3RJZBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkiMwoLUHJvamVjdExpc3QSJAoIcHJvamVjdHMYASADKAsyEi53YXRjaGZpcmUuUHJvamVjdCK8AQoUQ3JlYXRlUHJvamVjdFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIMCgRwYXRoGAIgASgJEgwKBG5hbWUYAyABKAkSEgoKZGVmaW5pdGlvbhgEIAEoCRISCgphdXRvX21lcmdlGAYgASgIEhoKEmF1dG9fZGVsZXRlX2JyYW5jaBgHIAEoCBIYChBhdXRvX3N0YXJ0X3Rhc2tzGAggASgISgQIBRAGIuoEChRVcGRhdGVQcm9qZWN0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSEQoEbmFtZRgDIAEoCUgAiAEBEhIKBWNvbG9yGAQgASgJSAGIAQESGgoNZGVmYXVsdF9hZ2VudBgGIAEoCUgCiAEBEhcKCmF1dG9fbWVyZ2UYByABKAhIA4gBARIfChJhdXRvX2RlbGV0ZV9icmFuY2gYCCABKAhIBIgBARIdChBhdXRvX3N0YXJ0X3Rhc2tzGAkgASgISAWIAQESFwoKZGVmaW5pdGlvbhgKIAEoCUgGiAEBEiEKFHNlY3JldHNfaW5zdHJ1Y3Rpb25zGAsgASgJSAeIAQESIAoTbm90aWZpY2F0aW9uc19tdXRlZBgMIAEoCEgIiAEBEhQKB3NhbmRib3gYDSABKAlICYgBARITCgZzdGF0dXMYDiABKAlICogBARI2Cg1ub3RpZmljYXRpb25zGA8gASgLMh8ud2F0Y2hmaXJlLlByb2plY3ROb3RpZmljYXRpb25zQgcKBV9uYW1lQggKBl9jb2xvckIQCg5fZGVmYXVsdF9hZ2VudEINCgtfYXV0b19tZXJnZUIVChNfYXV0b19kZWxldGVfYnJhbmNoQhMKEV9hdXRvX3N0YXJ0X3Rhc2tzQg0KC19kZWZpbml0aW9uQhcKFV9zZWNyZXRzX2luc3RydWN0aW9uc0IWChRfbm90aWZpY2F0aW9uc19tdXRlZEIKCghfc2FuZGJveEIJCgdfc3RhdHVzSgQIBRAGIlMKFlJlb3JkZXJQcm9qZWN0c1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRITCgtwcm9qZWN0X2lkcxgCIAMoCSKBAQoHR2l0SW5mbxIWCg5jdXJyZW50X2JyYW5jaBgBIAEoCRISCgpyZW1vdGVfdXJsGAIgASgJEhAKCGlzX2RpcnR5GAMgASgIEhkKEXVuY29tbWl0dGVkX2NvdW50GAQgASgFEg0KBWFoZWFkGAUgASgFEg4KBmJlaGluZBgGIAEoBSKDBQoEVGFzaxIPCgd0YXNrX2lkGAEgASgJEhMKC3Rhc2tfbnVtYmVyGAIgASgFEhIKCnByb2plY3RfaWQYAyABKAkSDQoFdGl0bGUYBCABKAkSDgoGcHJvbXB0GAUgASgJEhsKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBiABKAkSDgoGc3RhdHVzGAcgASgJEhQKB3N1Y2Nlc3MYCCABKAhIAIgBARIbCg5mYWlsdXJlX3JlYXNvbhgJIAEoCUgBiAEBEhAKCHBvc2l0aW9uGAogASgFEhYKDmFnZW50X3Nlc3Npb25zGAsgASgFEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCnN0YXJ0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESNQoMY29tcGxldGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgDiAEBEi4KCnVwZGF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCmRlbGV0ZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSASIAQESDQoFYWdlbnQYESABKAkSIQoUbWVyZ2VfZmFpbHVyZV9yZWFzb24YEiABKAlIBYgBAUIKCghfc3VjY2Vzc0IRCg9fZmFpbHVyZV9yZWFzb25CDQoLX3N0YXJ0ZWRfYXRCDwoNX2NvbXBsZXRlZF9hdEINCgtfZGVsZXRlZF9hdEIXChVfbWVyZ2VfZmFpbHVyZV9yZWFzb24iVwoGVGFza0lkEiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRITCgt0YXNrX251bWJlchgDIAEoBSIqCghUYXNrTGlzdBIeCgV0YXNrcxgBIAMoCzIPLndhdGNoZmlyZS5UYXNrIkYKDU1hbGZvcm1lZFRhc2sSEwoLdGFza19udW1iZXIYASABKAUSEQoJZmlsZV9uYW1lGAIgASgJEg0KBWVycm9yGAMgASgJIjwKEU1hbGZvcm1lZFRhc2tMaXN0EicKBXRhc2tzGAEgAygLMhgud2F0Y2hmaXJlLk1hbGZvcm1lZFRhc2siVQoZTGlzdE1hbGZvcm1lZFRhc2tzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkihQEKEExpc3RUYXNrc1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhMKBnN0YXR1cxgDIAEoCUgAiAEBEhcKD2luY2x1ZGVfZGVsZXRlZBgEIAEoCEIJCgdfc3RhdHVzIvgBChFDcmVhdGVUYXNrUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDgoGcHJvbXB0GAQgASgJEiAKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBSABKAlIAIgBARIOCgZzdGF0dXMYBiABKAkSFQoIcG9zaXRpb24YByABKAVIAYgBARISCgVhZ2VudBgIIAEoCUgCiAEBQhYKFF9hY2NlcHRhbmNlX2NyaXRlcmlhQgsKCV9wb3NpdGlvbkIICgZfYWdlbnQijgMKEVVwZGF0ZVRhc2tSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRITCgt0YXNrX251bWJlchgDIAEoBRISCgV0aXRsZRgEIAEoCUgAiAEBEhMKBnByb21wdBgFIAEoCUgBiAEBEiAKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBiABKAlIAogBARITCgZzdGF0dXMYByABKAlIA4gBARIUCgdzdWNjZXNzGAggASgISASIAQESGwoOZmFpbHVyZV9yZWFzb24YCSABKAlIBYgBARIVCghwb3NpdGlvbhgKIAEoBUgGiAEBEhIKBWFnZW50GAsgASgJSAeIAQFCCAoGX3RpdGxlQgkKB19wcm9tcHRCFgoUX2FjY2VwdGFuY2VfY3JpdGVyaWFCCQoHX3N0YXR1c0IKCghfc3VjY2Vzc0IRCg9fZmFpbHVyZV9yZWFzb25CCwoJX3Bvc2l0aW9uQggKBl9hZ2VudCJ9ChdCdWxrVXBkYXRlU3RhdHVzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSFAoMdGFza19udW1iZXJzGAMgAygFEhIKCm5ld19zdGF0dXMYBCABKAkiYwoRQnVsa0RlbGV0ZVJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhQKDHRhc2tfbnVtYmVycxgDIAMoBSJkChJCdWxrUmVzdG9yZVJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhQKDHRhc2tfbnVtYmVycxgDIAMoBSJxChdDcmVhdGVUYXNrc0JhdGNoUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDAoEdGV4dBgDIAEoCRIOCgZzdGF0dXMYBCABKAkiYwoWQXJjaGl2ZVJldHJvZml0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDwoHZHJ5X3J1bhgDIAEoCCJlChNSZW9yZGVyVGFza3NSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIUCgx0YXNrX251bWJlcnMYAyADKAUi3QEKDERhZW1vblN0YXR1cxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAUSCwoDcGlkGAMgASgFEi4KCnN0YXJ0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWFjdGl2ZV9hZ2VudHMYBSABKAUSFwoPYWN0aXZlX3Byb2plY3RzGAYgAygJEhgKEHVwZGF0ZV9hdmFpbGFibGUYByABKAgSFgoOdXBkYXRlX3ZlcnNpb24YCCABKAkSEgoKdXBkYXRlX3VybBgJIAEoCSKTAgoLQWdlbnRTdGF0dXMSEgoKcHJvamVjdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSDAoEbW9kZRgDIAEoCRITCgt0YXNrX251bWJlchgEIAEoBRISCgp0YXNrX3RpdGxlGAUgASgJEhIKCmlzX3J1bm5pbmcYBiABKAgSFgoOd2lsZGZpcmVfcGhhc2UYByABKAkSKQoFaXNzdWUYCCABKAsyFS53YXRjaGZpcmUuQWdlbnRJc3N1ZUgAiAEBEjMKCnN0YXJ0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCCAoGX2lzc3VlQg0KC19zdGFydGVkX2F0Ip0BChFTdGFydEFnZW50UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDAoEbW9kZRgDIAEoCRITCgt0YXNrX251bWJlchgEIAEoBRIMCgRyb3dzGAUgASgFEgwKBGNvbHMYBiABKAUSDwoHc2FuZGJveBgHIAEoCSKLAQoMU2NyZWVuQnVmZmVyEhIKCnByb2plY3RfaWQYASABKAkSDQoFbGluZXMYAiADKAkSEgoKY3Vyc29yX3JvdxgDIAEoBRISCgpjdXJzb3JfY29sGAQgASgFEgwKBHJvd3MYBSABKAUSDAoEY29scxgGIAEoBRIUCgxhbnNpX2NvbnRlbnQYByABKAkiUgoWU3Vic2NyaWJlU2NyZWVuUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkibAoRU2Nyb2xsYmFja1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEg4KBm9mZnNldBgDIAEoBRINCgVsaW1pdBgEIAEoBSI1Cg9TY3JvbGxiYWNrTGluZXMSDQoFbGluZXMYASADKAkSEwoLdG90YWxfbGluZXMYAiABKAUiWgoQU2VuZElucHV0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDAoEZGF0YRgDIAEoDCJlCg1SZXNpemVSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIMCgRyb3dzGAMgASgFEgwKBGNvbHMYBCABKAUibQoZU3Vic2NyaWJlUmF3T3V0cHV0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSFgoOYnl0ZXNfcmVjZWl2ZWQYAyABKAMiMgoOUmF3T3V0cHV0Q2h1bmsSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgRkYXRhGAIgASgMIu4BCgpBZ2VudElzc3VlEhIKCmlzc3VlX3R5cGUYASABKAkSLwoLZGV0ZWN0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB21lc3NhZ2UYAyABKAkSMQoIcmVzZXRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESNwoOY29vbGRvd25fdW50aWwYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCCwoJX3Jlc2V0X2F0QhEKD19jb29sZG93bl91bnRpbCJXChtTdWJzY3JpYmVBZ2VudElzc3Vlc1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJIoABCgZCcmFuY2gSDAoEbmFtZRgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEhMKC3Rhc2tfbnVtYmVyGAMgASgFEg4KBnN0YXR1cxgEIAEoCRIVCg13b3JrdHJlZV9wYXRoGAUgASgJEhgKEGNvbW1pdF90aW1lc3RhbXAYBiABKAMiMQoKQnJhbmNoTGlzdBIjCghicmFuY2hlcxgBIAMoCzIRLndhdGNoZmlyZS5CcmFuY2giaAoIQnJhbmNoSWQSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhMKC2JyYW5jaF9uYW1lGAMgASgJEg0KBWZvcmNlGAQgASgIIn8KEk1lcmdlQnJhbmNoUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSEwoLYnJhbmNoX25hbWUYAyABKAkSGgoSZGVsZXRlX2FmdGVyX21lcmdlGAQgASgIImMKEUJ1bGtCcmFuY2hSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIUCgxicmFuY2hfbmFtZXMYAyADKAkiGwoLQWdlbnRDb25maWcSDAoEcGF0aBgBIAEoCSLfAQoORGVmYXVsdHNDb25maWcSEgoKYXV0b19tZXJnZRgBIAEoCBIaChJhdXRvX2RlbGV0ZV9icmFuY2gYAiABKAgSGAoQYXV0b19zdGFydF90YXNrcxgDIAEoCBIXCg9kZWZhdWx0X3NhbmRib3gYBSABKAkSFQoNZGVmYXVsdF9hZ2VudBgGIAEoCRI1Cg1ub3RpZmljYXRpb25zGAcgASgLMh4ud2F0Y2hmaXJlLk5vdGlmaWNhdGlvbnNDb25maWcSFgoOdGVybWluYWxfc2hlbGwYCCABKAlKBAgEEAUiVwoTTm90aWZpY2F0aW9uc0V2ZW50cxITCgt0YXNrX2ZhaWxlZBgBIAEoCBIUCgxydW5fY29tcGxldGUYAiABKAgSFQoNd2Vla2x5X2RpZ2VzdBgDIAEoCCJhChNOb3RpZmljYXRpb25zU291bmRzEg8KB2VuYWJsZWQYASABKAgSEwoLdGFza19mYWlsZWQYAiABKAgSFAoMcnVuX2NvbXBsZXRlGAMgASgIEg4KBnZvbHVtZRgEIAEoASI/ChBRdWlldEhvdXJzQ29uZmlnEg8KB2VuYWJsZWQYASABKAgSDQoFc3RhcnQYAiABKAkSCwoDZW5kGAMgASgJItEBChNOb3RpZmljYXRpb25zQ29uZmlnEg8KB2VuYWJsZWQYASABKAgSLgoGZXZlbnRzGAIgASgLMh4ud2F0Y2hmaXJlLk5vdGlmaWNhdGlvbnNFdmVudHMSLgoGc291bmRzGAMgASgLMh4ud2F0Y2hmaXJlLk5vdGlmaWNhdGlvbnNTb3VuZHMSMAoLcXVpZXRfaG91cnMYBCABKAsyGy53YXRjaGZpcmUuUXVpZXRIb3Vyc0NvbmZpZxIXCg9kaWdlc3Rfc2NoZWR1bGUYBSABKAkiWQoNVXBkYXRlc0NvbmZpZxIYChBjaGVja19vbl9zdGFydHVwGAEgASgIEhcKD2NoZWNrX2ZyZXF1ZW5jeRgCIAEoCRIVCg1hdXRvX2Rvd25sb2FkGAMgASgIIiEKEEFwcGVhcmFuY2VDb25maWcSDQoFdGhlbWUYASABKAkitQIKCFNldHRpbmdzEg8KB3ZlcnNpb24YASABKAUSLwoGYWdlbnRzGAIgAygLMh8ud2F0Y2hmaXJlLlNldHRpbmdzLkFnZW50c0VudHJ5EisKCGRlZmF1bHRzGAMgASgLMhkud2F0Y2hmaXJlLkRlZmF1bHRzQ29uZmlnEikKB3VwZGF0ZXMYBCABKAsyGC53YXRjaGZpcmUuVXBkYXRlc0NvbmZpZxIvCgphcHBlYXJhbmNlGAUgASgLMhsud2F0Y2hmaXJlLkFwcGVhcmFuY2VDb25maWcSFwoPaW5zdGFsbGF0aW9uX2lkGAYgASgJGkUKC0FnZW50c0VudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLndhdGNoZmlyZS5BZ2VudENvbmZpZzoCOAEiggMKFVVwZGF0ZVNldHRpbmdzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEjAKCGRlZmF1bHRzGAIgASgLMhkud2F0Y2hmaXJlLkRlZmF1bHRzQ29uZmlnSACIAQESLgoHdXBkYXRlcxgDIAEoCzIYLndhdGNoZmlyZS5VcGRhdGVzQ29uZmlnSAGIAQESNAoKYXBwZWFyYW5jZRgEIAEoCzIbLndhdGNoZmlyZS5BcHBlYXJhbmNlQ29uZmlnSAKIAQESPAoGYWdlbnRzGAUgAygLMiwud2F0Y2hmaXJlLlVwZGF0ZVNldHRpbmdzUmVxdWVzdC5BZ2VudHNFbnRyeRpFCgtBZ2VudHNFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi53YXRjaGZpcmUuQWdlbnRDb25maWc6AjgBQgsKCV9kZWZhdWx0c0IKCghfdXBkYXRlc0INCgtfYXBwZWFyYW5jZSJCCglBZ2VudEluZm8SDAoEbmFtZRgBIAEoCRIUCgxkaXNwbGF5X25hbWUYAiABKAkSEQoJYXZhaWxhYmxlGAMgASgIIjEKCUFnZW50TGlzdBIkCgZhZ2VudHMYASADKAsyFC53YXRjaGZpcmUuQWdlbnRJbmZvIoMBCg9NY3BDbGllbnRTdGF0dXMSDgoGY2xpZW50GAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRIQCghkZXRlY3RlZBgDIAEoCBISCgpjb25maWd1cmVkGAQgASgIEhMKC2NvbmZpZ19wYXRoGAUgASgJEg8KB21lc3NhZ2UYBiABKAkiWgoTTWNwQ2xpZW50U3RhdHVzTGlzdBIrCgdjbGllbnRzGAEgAygLMhoud2F0Y2hmaXJlLk1jcENsaWVudFN0YXR1cxIWCg5jdXN0b21fc25pcHBldBgCIAEoCSJPChdJbnN0YWxsTWNwQ2xpZW50UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEg4KBmNsaWVudBgCIAEoCSJoChtTZXRHaXRIdWJBdXRvUFJTY29wZVJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEg8KB2VuYWJsZWQYAyABKAgikQEKJFNldFByb2plY3RJbnRlZ3JhdGlvbkJpbmRpbmdzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSFQoNc2xhY2tfY2hhbm5lbBgDIAEoCRIYChBkaXNjb3JkX2d1aWxkX2lkGAQgASgJIkMKG1N1YnNjcmliZUZvY3VzRXZlbnRzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhInIKCkZvY3VzRXZlbnQSEgoKcHJvamVjdF9pZBgBIAEoCRImCgZ0YXJnZXQYAiABKA4yFi53YXRjaGZpcmUuRm9jdXNUYXJnZXQSEwoLdGFza19udW1iZXIYAyABKAUSEwoLZGlnZXN0X2RhdGUYBCABKAkiSwoPTGlzdExvZ3NSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCSKuAQoITG9nRW50cnkSDgoGbG9nX2lkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEwoLdGFza19udW1iZXIYAyABKAUSFgoOc2Vzc2lvbl9udW1iZXIYBCABKAUSDQoFYWdlbnQYBSABKAkSDAoEbW9kZRgGIAEoCRISCgpzdGFydGVkX2F0GAcgASgJEhAKCGVuZGVkX2F0GAggASgJEg4KBnN0YXR1cxgJIAEoCSIsCgdMb2dMaXN0EiEKBGxvZ3MYASADKAsyEy53YXRjaGZpcmUuTG9nRW50cnkiWQoNR2V0TG9nUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDgoGbG9nX2lkGAMgASgJIkEKCkxvZ0NvbnRlbnQSIgoFZW50cnkYASABKAsyEy53YXRjaGZpcmUuTG9nRW50cnkSDwoHY29udGVudBgCIAEoCSJcChBEZWxldGVMb2dSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIOCgZsb2dfaWQYAyABKAkiuwEKDE5vdGlmaWNhdGlvbhIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEhMKC3Rhc2tfbnVtYmVyGAMgASgFEg0KBXRpdGxlGAQgASgJEgwKBGJvZHkYBSABKAkSLgoKZW1pdHRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKQoEa2luZBgHIAEoDjIbLndhdGNoZmlyZS5Ob3RpZmljYXRpb25LaW5kIkUKHVN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGEijgIKE0V4cG9ydFJlcG9ydFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIUCgpwcm9qZWN0X2lkGAIgASgJSAASEAoGZ2xvYmFsGAMgASgISAASFQoLc2luZ2xlX3Rhc2sYBCABKAlIABInCgZmb3JtYXQYBSABKA4yFy53YXRjaGZpcmUuRXhwb3J0Rm9ybWF0EjAKDHdpbmRvd19zdGFydBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBwoFc2NvcGUiRwoURXhwb3J0UmVwb3J0UmVzcG9uc2USEAoIZmlsZW5hbWUYASABKAkSDwoHY29udGVudBgCIAEoDBIMCgRtaW1lGAMgASgJIqIBChhHZXRHbG9iYWxJbnNpZ2h0c1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIwCgx3aW5kb3dfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIncKCURheUJ1Y2tldBIMCgRkYXRlGAEgASgJEg0KBWNvdW50GAIgASgFEhEKCXN1Y2NlZWRlZBgDIAEoBRIOCgZmYWlsZWQYBCABKAUSEwoLbGluZXNfYWRkZWQYBSABKAUSFQoNbGluZXNfcmVtb3ZlZBgGIAEoBSLlAQoOQWdlbnRCcmVha2Rvd24SDQoFYWdlbnQYASABKAkSDQoFY291bnQYAiABKAUSFAoMc3VjY2Vzc19yYXRlGAMgASgBEhcKD2F2Z19kdXJhdGlvbl9tcxgEIAEoAxIXCg90b3RhbF90b2tlbnNfaW4YBSABKAMSGAoQdG90YWxfdG9rZW5zX291dBgGIAEoAxIWCg50b3RhbF9jb3N0X3VzZBgHIAEoARIPCgdjb21taXRzGAggASgFEhMKC2xpbmVzX2FkZGVkGAkgASgFEhUKDWxpbmVzX3JlbW92ZWQYCiABKAUi0gEKClRvcFByb2plY3QSEgoKcHJvamVjdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSFQoNcHJvamVjdF9jb2xvchgDIAEoCRINCgVjb3VudBgEIAEoBRIUCgxzdWNjZXNzX3JhdGUYBSABKAESDwoHY29tbWl0cxgGIAEoBRITCgtsaW5lc19hZGRlZBgHIAEoBRIVCg1saW5lc19yZW1vdmVkGAggASgFEhEKCW5ldF9saW5lcxgJIAEoBRIOCgZtZXJnZXMYCiABKAUi2wQKDkdsb2JhbEluc2lnaHRzEhMKC3Rhc2tzX3RvdGFsGAEgASgFEhcKD3Rhc2tzX3N1Y2NlZWRlZBgCIAEoBRIUCgx0YXNrc19mYWlsZWQYAyABKAUSKgoMdGFza3NfYnlfZGF5GAQgAygLMhQud2F0Y2hmaXJlLkRheUJ1Y2tldBIrCgx0b3BfcHJvamVjdHMYBSADKAsyFS53YXRjaGZpcmUuVG9wUHJvamVjdBIyCg9hZ2VudF9icmVha2Rvd24YBiADKAsyGS53YXRjaGZpcmUuQWdlbnRCcmVha2Rvd24SGQoRdG90YWxfZHVyYXRpb25fbXMYByABKAMSFgoOdG90YWxfY29zdF91c2QYCCABKAESGgoSdGFza3NfbWlzc2luZ19jb3N0GAkgASgFEjAKDHdpbmRvd19zdGFydBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNdG90YWxfY29tbWl0cxgMIAEoBRIbChN0b3RhbF9maWxlc19jaGFuZ2VkGA0gASgFEhkKEXRvdGFsX2xpbmVzX2FkZGVkGA4gASgFEhsKE3RvdGFsX2xpbmVzX3JlbW92ZWQYDyABKAUSEQoJbmV0X2xpbmVzGBAgASgFEhQKDHRhc2tzX21lcmdlZBgRIAEoBRIUCgx0YXNrc192aWFfcHIYEiABKAUSHAoUbWV0cmljc19taXNzaW5nX2NvZGUYEyABKAUitwEKGUdldFByb2plY3RJbnNpZ2h0c1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEjAKDHdpbmRvd19zdGFydBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijgUKD1Byb2plY3RJbnNpZ2h0cxISCgpwcm9qZWN0X2lkGAEgASgJEhMKC3Rhc2tzX3RvdGFsGAIgASgFEhcKD3Rhc2tzX3N1Y2NlZWRlZBgDIAEoBRIUCgx0YXNrc19mYWlsZWQYBCABKAUSKgoMdGFza3NfYnlfZGF5GAUgAygLMhQud2F0Y2hmaXJlLkRheUJ1Y2tldBIyCg9hZ2VudF9icmVha2Rvd24YBiADKAsyGS53YXRjaGZpcmUuQWdlbnRCcmVha2Rvd24SGQoRdG90YWxfZHVyYXRpb25fbXMYByABKAMSFwoPYXZnX2R1cmF0aW9uX21zGAggASgDEhcKD3A1MF9kdXJhdGlvbl9tcxgJIAEoAxIXCg9wOTVfZHVyYXRpb25fbXMYCiABKAMSFgoOdG90YWxfY29zdF91c2QYCyABKAESGgoSdGFza3NfbWlzc2luZ19jb3N0GAwgASgFEjAKDHdpbmRvd19zdGFydBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNdG90YWxfY29tbWl0cxgPIAEoBRIbChN0b3RhbF9maWxlc19jaGFuZ2VkGBAgASgFEhkKEXRvdGFsX2xpbmVzX2FkZGVkGBEgASgFEhsKE3RvdGFsX2xpbmVzX3JlbW92ZWQYEiABKAUSEQoJbmV0X2xpbmVzGBMgASgFEhQKDHRhc2tzX21lcmdlZBgUIAEoBRIUCgx0YXNrc192aWFfcHIYFSABKAUSHAoUbWV0cmljc19taXNzaW5nX2NvZGUYFiABKAUiYwoSR2V0VGFza0RpZmZSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRITCgt0YXNrX251bWJlchgDIAEoBSJ2CgtGaWxlRGlmZlNldBIiCgVmaWxlcxgBIAMoCzITLndhdGNoZmlyZS5GaWxlRGlmZhIXCg90b3RhbF9hZGRpdGlvbnMYAiABKAUSFwoPdG90YWxfZGVsZXRpb25zGAMgASgFEhEKCXRydW5jYXRlZBgEIAEoCCKzAQoIRmlsZURpZmYSDAoEcGF0aBgBIAEoCRIqCgZzdGF0dXMYAiABKA4yGi53YXRjaGZpcmUuRmlsZURpZmYuU3RhdHVzEhAKCG9sZF9wYXRoGAMgASgJEh4KBWh1bmtzGAQgAygLMg8ud2F0Y2hmaXJlLkh1bmsiOwoGU3RhdHVzEgwKCE1PRElGSUVEEAASCQoFQURERUQQARILCgdERUxFVEVEEAISCwoHUkVOQU1FRBADIoYBCgRIdW5rEhEKCW9sZF9zdGFydBgBIAEoBRIRCglvbGRfbGluZXMYAiABKAUSEQoJbmV3X3N0YXJ0GAMgASgFEhEKCW5ld19saW5lcxgEIAEoBRIOCgZoZWFkZXIYBSABKAkSIgoFbGluZXMYBiADKAsyEy53YXRjaGZpcmUuRGlmZkxpbmUiZwoIRGlmZkxpbmUSJgoEa2luZBgBIAEoDjIYLndhdGNoZmlyZS5EaWZmTGluZS5LaW5kEgwKBHRleHQYAiABKAkiJQoES2luZBILCgdDT05URVhUEAASBwoDQUREEAESBwoDREVMEAIiVQoRSW50ZWdyYXRpb25FdmVudHMSEwoLdGFza19mYWlsZWQYASABKAgSFAoMcnVuX2NvbXBsZXRlGAIgASgIEhUKDXdlZWtseV9kaWdlc3QYAyABKAgiwwEKEldlYmhvb2tJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRILCgN1cmwYAyABKAkSEQoJdXJsX2xhYmVsGAQgASgJEhIKCnNlY3JldF9zZXQYBSABKAgSDgoGc2VjcmV0GAYgASgJEjQKDmVuYWJsZWRfZXZlbnRzGAcgASgLMhwud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzEhgKEHByb2plY3RfbXV0ZV9pZHMYCCADKAkirgEKEFNsYWNrSW50ZWdyYXRpb24SCgoCaWQYASABKAkSDQoFbGFiZWwYAiABKAkSCwoDdXJsGAMgASgJEhEKCXVybF9sYWJlbBgEIAEoCRIPCgd1cmxfc2V0GAUgASgIEjQKDmVuYWJsZWRfZXZlbnRzGAYgASgLMhwud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzEhgKEHByb2plY3RfbXV0ZV9pZHMYByADKAkisAEKEkRpc2NvcmRJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRILCgN1cmwYAyABKAkSEQoJdXJsX2xhYmVsGAQgASgJEg8KB3VybF9zZXQYBSABKAgSNAoOZW5hYmxlZF9ldmVudHMYBiABKAsyHC53YXRjaGZpcmUuSW50ZWdyYXRpb25FdmVudHMSGAoQcHJvamVjdF9tdXRlX2lkcxgHIAMoCSJTChFHaXRIdWJJbnRlZ3JhdGlvbhIPCgdlbmFibGVkGAEgASgIEhUKDWRyYWZ0X2RlZmF1bHQYAiABKAgSFgoOcHJvamVjdF9zY29wZXMYAyADKAkipAEKFlRlbGVncmFtUGFpcmVkQ2hhdEluZm8SDwoHY2hhdF9pZBgBIAEoAxIQCgh1c2VybmFtZRgCIAEoCRItCglwYWlyZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEmRlZmF1bHRfcHJvamVjdF9pZBgEIAEoCRINCgVtdXRlZBgFIAEoCBINCgV3YXRjaBgGIAEoCCK7AQoTVGVsZWdyYW1JbnRlZ3JhdGlvbhIPCgdlbmFibGVkGAEgASgIEhEKCWJvdF90b2tlbhgCIAEoCRIRCgl0b2tlbl9zZXQYAyABKAgSNAoOZW5hYmxlZF9ldmVudHMYBCABKAsyHC53YXRjaGZpcmUuSW50ZWdyYXRpb25FdmVudHMSNwoMcGFpcmVkX2NoYXRzGAUgAygLMiEud2F0Y2hmaXJlLlRlbGVncmFtUGFpcmVkQ2hhdEluZm8igQIKEkludGVncmF0aW9uc0NvbmZpZxIvCgh3ZWJob29rcxgBIAMoCzIdLndhdGNoZmlyZS5XZWJob29rSW50ZWdyYXRpb24SKgoFc2xhY2sYAiADKAsyGy53YXRjaGZpcmUuU2xhY2tJbnRlZ3JhdGlvbhIuCgdkaXNjb3JkGAMgAygLMh0ud2F0Y2hmaXJlLkRpc2NvcmRJbnRlZ3JhdGlvbhIsCgZnaXRodWIYBCABKAsyHC53YXRjaGZpcmUuR2l0SHViSW50ZWdyYXRpb24SMAoIdGVsZWdyYW0YBSABKAsyHi53YXRjaGZpcmUuVGVsZWdyYW1JbnRlZ3JhdGlvbiI/ChdMaXN0SW50ZWdyYXRpb25zUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhIr8CChZTYXZlSW50ZWdyYXRpb25SZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESMAoHd2ViaG9vaxgCIAEoCzIdLndhdGNoZmlyZS5XZWJob29rSW50ZWdyYXRpb25IABIsCgVzbGFjaxgDIAEoCzIbLndhdGNoZmlyZS5TbGFja0ludGVncmF0aW9uSAASMAoHZGlzY29yZBgEIAEoCzIdLndhdGNoZmlyZS5EaXNjb3JkSW50ZWdyYXRpb25IABIuCgZnaXRodWIYBSABKAsyHC53YXRjaGZpcmUuR2l0SHViSW50ZWdyYXRpb25IABIyCgh0ZWxlZ3JhbRgGIAEoCzIeLndhdGNoZmlyZS5UZWxlZ3JhbUludGVncmF0aW9uSABCCQoHcGF5bG9hZCJ2ChhEZWxldGVJbnRlZ3JhdGlvblJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIoCgRraW5kGAIgASgOMhoud2F0Y2hmaXJlLkludGVncmF0aW9uS2luZBIKCgJpZBgDIAEoCSJ0ChZUZXN0SW50ZWdyYXRpb25SZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESKAoEa2luZBgCIAEoDjIaLndhdGNoZmlyZS5JbnRlZ3JhdGlvbktpbmQSCgoCaWQYAyABKAkiSwoXVGVzdEludGVncmF0aW9uUmVzcG9uc2USCgoCb2sYASABKAgSDwoHbWVzc2FnZRgCIAEoCRITCgtzdGF0dXNfY29kZRgDIAEoBSJDChtCZWdpblRlbGVncmFtUGFpcmluZ1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YSKFAQocQmVnaW5UZWxlZ3JhbVBhaXJpbmdSZXNwb25zZRIMCgRjb2RlGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWRlZXBfbGluaxgDIAEoCRIUCgxib3RfdXNlcm5hbWUYBCABKAkiRwofR2V0VGVsZWdyYW1QYWlyaW5nU3RhdHVzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhItYBChVUZWxlZ3JhbVBhaXJpbmdTdGF0dXMSLgoFc3RhdGUYASABKA4yHy53YXRjaGZpcmUuVGVsZWdyYW1QYWlyaW5nU3RhdGUSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoEY2hhdBgDIAEoCzIhLndhdGNoZmlyZS5UZWxlZ3JhbVBhaXJlZENoYXRJbmZvEhYKDmJyaWRnZV9ydW5uaW5nGAQgASgIEhQKDGJvdF91c2VybmFtZRgFIAEoCSJSChlSZXZva2VUZWxlZ3JhbUNoYXRSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESDwoHY2hhdF9pZBgCIAEoAyJ+ChFCZWdpbk9BdXRoUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEioKCHByb3ZpZGVyGAIgASgOMhgud2F0Y2hmaXJlLk9BdXRoUHJvdmlkZXISFwoPZGVmYXVsdF9jaGFubmVsGAMgASgJIlAKEkJlZ2luT0F1dGhSZXNwb25zZRIVCg1hdXRob3JpemVfdXJsGAEgASgJEhQKDHJlZGlyZWN0X3VyaRgCIAEoCRINCgVzdGF0ZRgDIAEoCSJpChVHZXRPQXV0aFN0YXR1c1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIqCghwcm92aWRlchgCIAEoDjIYLndhdGNoZmlyZS5PQXV0aFByb3ZpZGVyIp0BCgtPQXV0aFN0YXR1cxIqCghwcm92aWRlchgBIAEoDjIYLndhdGNoZmlyZS5PQXV0aFByb3ZpZGVyEiQKBXN0YXRlGAIgASgOMhUud2F0Y2hmaXJlLk9BdXRoU3RhdGUSDQoFZXJyb3IYAyABKAkSFAoMY29ubmVjdGVkX2FzGAQgASgJEhcKD2RlZmF1bHRfY2hhbm5lbBgFIAEoCSJmChJDYW5jZWxPQXV0aFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIqCghwcm92aWRlchgCIAEoDjIYLndhdGNoZmlyZS5PQXV0aFByb3ZpZGVyIogBChVQb3N0T0F1dGhIZWxsb1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIqCghwcm92aWRlchgCIAEoDjIYLndhdGNoZmlyZS5PQXV0aFByb3ZpZGVyEg8KB2NoYW5uZWwYAyABKAkSDAoEdGV4dBgEIAEoCSI1ChZQb3N0T0F1dGhIZWxsb1Jlc3BvbnNlEgoKAm9rGAEgASgIEg8KB21lc3NhZ2UYAiABKAkivwcKDUluYm91bmRDb25maWcSEwoLbGlzdGVuX2FkZHIYASABKAkSEgoKcHVibGljX3VybBgCIAEoCRIZChFnaXRodWJfc2VjcmV0X3NldBgDIAEoCBIVCg1naXRodWJfc2VjcmV0GAQgASgJEhgKEHNsYWNrX3NlY3JldF9zZXQYBSABKAgSFAoMc2xhY2tfc2VjcmV0GAYgASgJEh4KFmRpc2NvcmRfcHVibGljX2tleV9zZXQYByABKAgSGgoSZGlzY29yZF9wdWJsaWNfa2V5GAggASgJEhYKDmRpc2NvcmRfYXBwX2lkGAkgASgJEh0KFWRpc2NvcmRfYm90X3Rva2VuX3NldBgKIAEoCBIZChFkaXNjb3JkX2JvdF90b2tlbhgLIAEoCRIQCghkaXNhYmxlZBgMIAEoCBIaChJyYXRlX2xpbWl0X3Blcl9taW4YDSABKAUSEAoIZ2l0X2hvc3QYDiABKAkSGQoRZ2l0X2hvc3RfYmFzZV91cmwYDyABKAkSGQoRZ2l0bGFiX3NlY3JldF9zZXQYECABKAgSFQoNZ2l0bGFiX3NlY3JldBgRIAEoCRIcChRiaXRidWNrZXRfc2VjcmV0X3NldBgSIAEoCBIYChBiaXRidWNrZXRfc2VjcmV0GBMgASgJEhcKD3NsYWNrX2NsaWVudF9pZBgUIAEoCRIfChdzbGFja19jbGllbnRfc2VjcmV0X3NldBgVIAEoCBIbChNzbGFja19jbGllbnRfc2VjcmV0GBYgASgJEhsKE3NsYWNrX2JvdF90b2tlbl9zZXQYFyABKAgSFwoPc2xhY2tfYm90X3Rva2VuGBggASgJEhUKDXNsYWNrX3RlYW1faWQYGSABKAkSFwoPc2xhY2tfdGVhbV9uYW1lGBogASgJEhkKEXNsYWNrX2JvdF91c2VyX2lkGBsgASgJEhoKEnNsYWNrX2JvdF91c2VybmFtZRgcIAEoCRIdChVzbGFja19kZWZhdWx0X2NoYW5uZWwYHSABKAkSGQoRZGlzY29yZF9jbGllbnRfaWQYHiABKAkSIQoZZGlzY29yZF9jbGllbnRfc2VjcmV0X3NldBgfIAEoCBIdChVkaXNjb3JkX2NsaWVudF9zZWNyZXQYICABKAkSHAoUZGlzY29yZF9ib3RfdXNlcm5hbWUYISABKAkSIQoZZGlzY29yZF9ib3RfZGlzY3JpbWluYXRvchgiIAEoCRIfChdkaXNjb3JkX2RlZmF1bHRfY2hhbm5lbBgjIAEoCSKJAwoNSW5ib3VuZFN0YXR1cxIRCglsaXN0ZW5pbmcYASABKAgSEwoLbGlzdGVuX2FkZHIYAiABKAkSEgoKcHVibGljX3VybBgDIAEoCRISCgpiaW5kX2Vycm9yGAQgASgJEiEKGWxhc3RfZ2l0aHViX2RlbGl2ZXJ5X3VuaXgYBSABKAMSIAoYbGFzdF9zbGFja19kZWxpdmVyeV91bml4GAYgASgDEiIKGmxhc3RfZGlzY29yZF9kZWxpdmVyeV91bml4GAcgASgDEg8KB3ZlcnNpb24YCCABKAkSKAoGY29uZmlnGAkgASgLMhgud2F0Y2hmaXJlLkluYm91bmRDb25maWcSOwoOZGlzY29yZF9ndWlsZHMYCiADKAsyIy53YXRjaGZpcmUuRGlzY29yZEd1aWxkUmVnaXN0cmF0aW9uEiEKGWxhc3RfZ2l0bGFiX2RlbGl2ZXJ5X3VuaXgYCyABKAMSJAocbGFzdF9iaXRidWNrZXRfZGVsaXZlcnlfdW5peBgMIAEoAyI/ChdHZXRJbmJvdW5kU3RhdHVzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhImoKGFNhdmVJbmJvdW5kQ29uZmlnUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEigKBmNvbmZpZxgCIAEoCzIYLndhdGNoZmlyZS5JbmJvdW5kQ29uZmlnIn8KGERpc2NvcmRHdWlsZFJlZ2lzdHJhdGlvbhIQCghndWlsZF9pZBgBIAEoCRISCgpndWlsZF9uYW1lGAIgASgJEhIKCnJlZ2lzdGVyZWQYAyABKAgSDQoFZXJyb3IYBCABKAkSGgoScmVnaXN0ZXJlZF9hdF91bml4GAUgASgDKmwKC0ZvY3VzVGFyZ2V0EhUKEUZPQ1VTX1RBUkdFVF9NQUlOEAASFgoSRk9DVVNfVEFSR0VUX1RBU0tTEAESFQoRRk9DVVNfVEFSR0VUX1RBU0sQAhIXChNGT0NVU19UQVJHRVRfRElHRVNUEAMqWQoQTm90aWZpY2F0aW9uS2luZBIPCgtUQVNLX0ZBSUxFRBAAEhAKDFJVTl9DT01QTEVURRABEg8KC1NUVUNLX0FHRU5UEAISEQoNV0VFS0xZX0RJR0VTVBADKiUKDEV4cG9ydEZvcm1hdBIHCgNDU1YQABIMCghNQVJLRE9XThABKlAKD0ludGVncmF0aW9uS2luZBILCgdXRUJIT09LEAASCQoFU0xBQ0sQARILCgdESVNDT1JEEAISCgoGR0lUSFVCEAMSDAoIVEVMRUdSQU0QBCqKAQoUVGVsZWdyYW1QYWlyaW5nU3RhdGUSGQoVVEVMRUdSQU1fUEFJUklOR19OT05FEAASHAoYVEVMRUdSQU1fUEFJUklOR19QRU5ESU5HEAESGwoXVEVMRUdSQU1fUEFJUklOR19QQUlSRUQQAhIcChhURUxFR1JBTV9QQUlSSU5HX0VYUElSRUQQAypfCg1PQXV0aFByb3ZpZGVyEhgKFE9BVVRIX1BST1ZJREVSX1VOU0VUEAASGAoUT0FVVEhfUFJPVklERVJfU0xBQ0sQARIaChZPQVVUSF9QUk9WSURFUl9ESVNDT1JEEAIqcQoKT0F1dGhTdGF0ZRIUChBPQVVUSF9TVEFURV9JRExFEAASGwoXT0FVVEhfU1RBVEVfSU5fUFJPR1JFU1MQARIZChVPQVVUSF9TVEFURV9DT05ORUNURUQQAhIVChFPQVVUSF9TVEFURV9FUlJPUhADMtsGCg5Qcm9qZWN0U2VydmljZRI+CgxMaXN0UHJvamVjdHMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi53YXRjaGZpcmUuUHJvamVjdExpc3QSNgoKR2V0UHJvamVjdBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaEi53YXRjaGZpcmUuUHJvamVjdBJECg1DcmVhdGVQcm9qZWN0Eh8ud2F0Y2hmaXJlLkNyZWF0ZVByb2plY3RSZXF1ZXN0GhIud2F0Y2hmaXJlLlByb2plY3QSRAoNVXBkYXRlUHJvamVjdBIfLndhdGNoZmlyZS5VcGRhdGVQcm9qZWN0UmVxdWVzdBoSLndhdGNoZmlyZS5Qcm9qZWN0Ej0KDURlbGV0ZVByb2plY3QSFC53YXRjaGZpcmUuUHJvamVjdElkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EjYKCkdldEdpdEluZm8SFC53YXRjaGZpcmUuUHJvamVjdElkGhIud2F0Y2hmaXJlLkdpdEluZm8STAoPUmVvcmRlclByb2plY3RzEiEud2F0Y2hmaXJlLlJlb3JkZXJQcm9qZWN0c1JlcXVlc3QaFi53YXRjaGZpcmUuUHJvamVjdExpc3QSPwoTUmVnZW5lcmF0ZVByb2plY3RJZBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaEi53YXRjaGZpcmUuUHJvamVjdBI+ChJSZXNldFRhc2tOdW1iZXJpbmcSFC53YXRjaGZpcmUuUHJvamVjdElkGhIud2F0Y2hmaXJlLlByb2plY3QSQQoRVW5yZWdpc3RlclByb2plY3QSFC53YXRjaGZpcmUuUHJvamVjdElkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElYKFFNldEdpdEh1YkF1dG9QUlNjb3BlEiYud2F0Y2hmaXJlLlNldEdpdEh1YkF1dG9QUlNjb3BlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJkCh1TZXRQcm9qZWN0SW50ZWdyYXRpb25CaW5kaW5ncxIvLndhdGNoZmlyZS5TZXRQcm9qZWN0SW50ZWdyYXRpb25CaW5kaW5nc1JlcXVlc3QaEi53YXRjaGZpcmUuUHJvamVjdDLlBwoLVGFza1NlcnZpY2USPQoJTGlzdFRhc2tzEhsud2F0Y2hmaXJlLkxpc3RUYXNrc1JlcXVlc3QaEy53YXRjaGZpcmUuVGFza0xpc3QSWAoSTGlzdE1hbGZvcm1lZFRhc2tzEiQud2F0Y2hmaXJlLkxpc3RNYWxmb3JtZWRUYXNrc1JlcXVlc3QaHC53YXRjaGZpcmUuTWFsZm9ybWVkVGFza0xpc3QSLQoHR2V0VGFzaxIRLndhdGNoZmlyZS5UYXNrSWQaDy53YXRjaGZpcmUuVGFzaxI7CgpDcmVhdGVUYXNrEhwud2F0Y2hmaXJlLkNyZWF0ZVRhc2tSZXF1ZXN0Gg8ud2F0Y2hmaXJlLlRhc2sSOwoKVXBkYXRlVGFzaxIcLndhdGNoZmlyZS5VcGRhdGVUYXNrUmVxdWVzdBoPLndhdGNoZmlyZS5UYXNrEjAKCkRlbGV0ZVRhc2sSES53YXRjaGZpcmUuVGFza0lkGg8ud2F0Y2hmaXJlLlRhc2sSMQoLUmVzdG9yZVRhc2sSES53YXRjaGZpcmUuVGFza0lkGg8ud2F0Y2hmaXJlLlRhc2sSQAoTUGVybWFuZW50RGVsZXRlVGFzaxIRLndhdGNoZmlyZS5UYXNrSWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSOgoKRW1wdHlUcmFzaBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSSwoQQnVsa1VwZGF0ZVN0YXR1cxIiLndhdGNoZmlyZS5CdWxrVXBkYXRlU3RhdHVzUmVxdWVzdBoTLndhdGNoZmlyZS5UYXNrTGlzdBI/CgpCdWxrRGVsZXRlEhwud2F0Y2hmaXJlLkJ1bGtEZWxldGVSZXF1ZXN0GhMud2F0Y2hmaXJlLlRhc2tMaXN0EkEKC0J1bGtSZXN0b3JlEh0ud2F0Y2hmaXJlLkJ1bGtSZXN0b3JlUmVxdWVzdBoTLndhdGNoZmlyZS5UYXNrTGlzdBJDCgxSZW9yZGVyVGFza3MSHi53YXRjaGZpcmUuUmVvcmRlclRhc2tzUmVxdWVzdBoTLndhdGNoZmlyZS5UYXNrTGlzdBJLChBDcmVhdGVUYXNrc0JhdGNoEiIud2F0Y2hmaXJlLkNyZWF0ZVRhc2tzQmF0Y2hSZXF1ZXN0GhMud2F0Y2hmaXJlLlRhc2tMaXN0Ek4KFEFyY2hpdmVSZXRyb2ZpdFRhc2tzEiEud2F0Y2hmaXJlLkFyY2hpdmVSZXRyb2ZpdFJlcXVlc3QaEy53YXRjaGZpcmUuVGFza0xpc3QymgIKDURhZW1vblNlcnZpY2USPAoJR2V0U3RhdHVzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ghcud2F0Y2hmaXJlLkRhZW1vblN0YXR1cxI6CghTaHV0ZG93bhIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRI2CgRQaW5nEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElcKFFN1YnNjcmliZUZvY3VzRXZlbnRzEiYud2F0Y2hmaXJlLlN1YnNjcmliZUZvY3VzRXZlbnRzUmVxdWVzdBoVLndhdGNoZmlyZS5Gb2N1c0V2ZW50MAEyxQEKCkxvZ1NlcnZpY2USOgoITGlzdExvZ3MSGi53YXRjaGZpcmUuTGlzdExvZ3NSZXF1ZXN0GhIud2F0Y2hmaXJlLkxvZ0xpc3QSOQoGR2V0TG9nEhgud2F0Y2hmaXJlLkdldExvZ1JlcXVlc3QaFS53YXRjaGZpcmUuTG9nQ29udGVudBJACglEZWxldGVMb2cSGy53YXRjaGZpcmUuRGVsZXRlTG9nUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eTLWBQoMQWdlbnRTZXJ2aWNlEkIKClN0YXJ0QWdlbnQSHC53YXRjaGZpcmUuU3RhcnRBZ2VudFJlcXVlc3QaFi53YXRjaGZpcmUuQWdlbnRTdGF0dXMSOQoJU3RvcEFnZW50EhQud2F0Y2hmaXJlLlByb2plY3RJZBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRI+Cg5HZXRBZ2VudFN0YXR1cxIULndhdGNoZmlyZS5Qcm9qZWN0SWQaFi53YXRjaGZpcmUuQWdlbnRTdGF0dXMSTwoPU3Vic2NyaWJlU2NyZWVuEiEud2F0Y2hmaXJlLlN1YnNjcmliZVNjcmVlblJlcXVlc3QaFy53YXRjaGZpcmUuU2NyZWVuQnVmZmVyMAESSQoNR2V0U2Nyb2xsYmFjaxIcLndhdGNoZmlyZS5TY3JvbGxiYWNrUmVxdWVzdBoaLndhdGNoZmlyZS5TY3JvbGxiYWNrTGluZXMSQAoJU2VuZElucHV0Ehsud2F0Y2hmaXJlLlNlbmRJbnB1dFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSOgoGUmVzaXplEhgud2F0Y2hmaXJlLlJlc2l6ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVwoSU3Vic2NyaWJlUmF3T3V0cHV0EiQud2F0Y2hmaXJlLlN1YnNjcmliZVJhd091dHB1dFJlcXVlc3QaGS53YXRjaGZpcmUuUmF3T3V0cHV0Q2h1bmswARJXChRTdWJzY3JpYmVBZ2VudElzc3VlcxImLndhdGNoZmlyZS5TdWJzY3JpYmVBZ2VudElzc3Vlc1JlcXVlc3QaFS53YXRjaGZpcmUuQWdlbnRJc3N1ZTABEjsKC1Jlc3VtZUFnZW50EhQud2F0Y2hmaXJlLlByb2plY3RJZBoWLndhdGNoZmlyZS5BZ2VudFN0YXR1czLDAwoNQnJhbmNoU2VydmljZRI7CgxMaXN0QnJhbmNoZXMSFC53YXRjaGZpcmUuUHJvamVjdElkGhUud2F0Y2hmaXJlLkJyYW5jaExpc3QSMwoJR2V0QnJhbmNoEhMud2F0Y2hmaXJlLkJyYW5jaElkGhEud2F0Y2hmaXJlLkJyYW5jaBI/CgtNZXJnZUJyYW5jaBIdLndhdGNoZmlyZS5NZXJnZUJyYW5jaFJlcXVlc3QaES53YXRjaGZpcmUuQnJhbmNoEjsKDERlbGV0ZUJyYW5jaBITLndhdGNoZmlyZS5CcmFuY2hJZBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRI8Cg1QcnVuZUJyYW5jaGVzEhQud2F0Y2hmaXJlLlByb2plY3RJZBoVLndhdGNoZmlyZS5CcmFuY2hMaXN0EkAKCUJ1bGtNZXJnZRIcLndhdGNoZmlyZS5CdWxrQnJhbmNoUmVxdWVzdBoVLndhdGNoZmlyZS5CcmFuY2hMaXN0EkIKCkJ1bGtEZWxldGUSHC53YXRjaGZpcmUuQnVsa0JyYW5jaFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHky9AIKD1NldHRpbmdzU2VydmljZRI6CgtHZXRTZXR0aW5ncxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoTLndhdGNoZmlyZS5TZXR0aW5ncxJHCg5VcGRhdGVTZXR0aW5ncxIgLndhdGNoZmlyZS5VcGRhdGVTZXR0aW5nc1JlcXVlc3QaEy53YXRjaGZpcmUuU2V0dGluZ3MSOgoKTGlzdEFnZW50cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoULndhdGNoZmlyZS5BZ2VudExpc3QSTAoSR2V0TWNwQ2xpZW50U3RhdHVzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh4ud2F0Y2hmaXJlLk1jcENsaWVudFN0YXR1c0xpc3QSUgoQSW5zdGFsbE1jcENsaWVudBIiLndhdGNoZmlyZS5JbnN0YWxsTWNwQ2xpZW50UmVxdWVzdBoaLndhdGNoZmlyZS5NY3BDbGllbnRTdGF0dXMyZwoTTm90aWZpY2F0aW9uU2VydmljZRJQCglTdWJzY3JpYmUSKC53YXRjaGZpcmUuU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QaFy53YXRjaGZpcmUuTm90aWZpY2F0aW9uMAEy1QIKD0luc2lnaHRzU2VydmljZRJPCgxFeHBvcnRSZXBvcnQSHi53YXRjaGZpcmUuRXhwb3J0UmVwb3J0UmVxdWVzdBofLndhdGNoZmlyZS5FeHBvcnRSZXBvcnRSZXNwb25zZRJTChFHZXRHbG9iYWxJbnNpZ2h0cxIjLndhdGNoZmlyZS5HZXRHbG9iYWxJbnNpZ2h0c1JlcXVlc3QaGS53YXRjaGZpcmUuR2xvYmFsSW5zaWdodHMSVgoSR2V0UHJvamVjdEluc2lnaHRzEiQud2F0Y2hmaXJlLkdldFByb2plY3RJbnNpZ2h0c1JlcXVlc3QaGi53YXRjaGZpcmUuUHJvamVjdEluc2lnaHRzEkQKC0dldFRhc2tEaWZmEh0ud2F0Y2hmaXJlLkdldFRhc2tEaWZmUmVxdWVzdBoWLndhdGNoZmlyZS5GaWxlRGlmZlNldDL8CAoTSW50ZWdyYXRpb25zU2VydmljZRJVChBMaXN0SW50ZWdyYXRpb25zEiIud2F0Y2hmaXJlLkxpc3RJbnRlZ3JhdGlvbnNSZXF1ZXN0Gh0ud2F0Y2hmaXJlLkludGVncmF0aW9uc0NvbmZpZxJTCg9TYXZlSW50ZWdyYXRpb24SIS53YXRjaGZpcmUuU2F2ZUludGVncmF0aW9uUmVxdWVzdBodLndhdGNoZmlyZS5JbnRlZ3JhdGlvbnNDb25maWcSVwoRRGVsZXRlSW50ZWdyYXRpb24SIy53YXRjaGZpcmUuRGVsZXRlSW50ZWdyYXRpb25SZXF1ZXN0Gh0ud2F0Y2hmaXJlLkludGVncmF0aW9uc0NvbmZpZxJYCg9UZXN0SW50ZWdyYXRpb24SIS53YXRjaGZpcmUuVGVzdEludGVncmF0aW9uUmVxdWVzdBoiLndhdGNoZmlyZS5UZXN0SW50ZWdyYXRpb25SZXNwb25zZRJQChBHZXRJbmJvdW5kU3RhdHVzEiIud2F0Y2hmaXJlLkdldEluYm91bmRTdGF0dXNSZXF1ZXN0Ghgud2F0Y2hmaXJlLkluYm91bmRTdGF0dXMSUgoRU2F2ZUluYm91bmRDb25maWcSIy53YXRjaGZpcmUuU2F2ZUluYm91bmRDb25maWdSZXF1ZXN0Ghgud2F0Y2hmaXJlLkluYm91bmRTdGF0dXMSSQoKQmVnaW5PQXV0aBIcLndhdGNoZmlyZS5CZWdpbk9BdXRoUmVxdWVzdBodLndhdGNoZmlyZS5CZWdpbk9BdXRoUmVzcG9uc2USSgoOR2V0T0F1dGhTdGF0dXMSIC53YXRjaGZpcmUuR2V0T0F1dGhTdGF0dXNSZXF1ZXN0GhYud2F0Y2hmaXJlLk9BdXRoU3RhdHVzEkQKC0NhbmNlbE9BdXRoEh0ud2F0Y2hmaXJlLkNhbmNlbE9BdXRoUmVxdWVzdBoWLndhdGNoZmlyZS5PQXV0aFN0YXR1cxJVCg5Qb3N0T0F1dGhIZWxsbxIgLndhdGNoZmlyZS5Qb3N0T0F1dGhIZWxsb1JlcXVlc3QaIS53YXRjaGZpcmUuUG9zdE9BdXRoSGVsbG9SZXNwb25zZRJnChRCZWdpblRlbGVncmFtUGFpcmluZxImLndhdGNoZmlyZS5CZWdpblRlbGVncmFtUGFpcmluZ1JlcXVlc3QaJy53YXRjaGZpcmUuQmVnaW5UZWxlZ3JhbVBhaXJpbmdSZXNwb25zZRJoChhHZXRUZWxlZ3JhbVBhaXJpbmdTdGF0dXMSKi53YXRjaGZpcmUuR2V0VGVsZWdyYW1QYWlyaW5nU3RhdHVzUmVxdWVzdBogLndhdGNoZmlyZS5UZWxlZ3JhbVBhaXJpbmdTdGF0dXMSWQoSUmV2b2tlVGVsZWdyYW1DaGF0EiQud2F0Y2hmaXJlLlJldm9rZVRlbGVncmFtQ2hhdFJlcXVlc3QaHS53YXRjaGZpcmUuSW50ZWdyYXRpb25zQ29uZmlnQilaJ2dpdGh1Yi5jb20vd2F0Y2hmaXJlLWlvL3dhdGNoZmlyZS9wcm90b2IGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_empty]);
+  fileDesc("Cg93YXRjaGZpcmUucHJvdG8SCXdhdGNoZmlyZSJPCgtSZXF1ZXN0TWV0YRIOCgZvcmlnaW4YASABKAkSEQoJY2xpZW50X2lkGAIgASgJEg8KB3ZlcnNpb24YAyABKAkSDAoEdXNlchgEIAEoCSKfBAoHUHJvamVjdBISCgpwcm9qZWN0X2lkGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEcGF0aBgDIAEoCRIOCgZzdGF0dXMYBCABKAkSDQoFY29sb3IYBSABKAkSFQoNZGVmYXVsdF9hZ2VudBgHIAEoCRIPCgdzYW5kYm94GAggASgJEhIKCmF1dG9fbWVyZ2UYCSABKAgSGgoSYXV0b19kZWxldGVfYnJhbmNoGAogASgIEhgKEGF1dG9fc3RhcnRfdGFza3MYCyABKAgSEgoKZGVmaW5pdGlvbhgMIAEoCRIuCgpjcmVhdGVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIYChBuZXh0X3Rhc2tfbnVtYmVyGA8gASgFEhAKCHBvc2l0aW9uGBAgASgFEhwKFHNlY3JldHNfaW5zdHJ1Y3Rpb25zGBEgASgJEjYKDW5vdGlmaWNhdGlvbnMYEiABKAsyHy53YXRjaGZpcmUuUHJvamVjdE5vdGlmaWNhdGlvbnMSNAoMaW50ZWdyYXRpb25zGBMgASgLMh4ud2F0Y2hmaXJlLlByb2plY3RJbnRlZ3JhdGlvbnMSIQoZbGFzdF9yZXRyb2ZpdF90YXNrX251bWJlchgUIAEoBUoECAYQByJeChNQcm9qZWN0SW50ZWdyYXRpb25zEhUKDXNsYWNrX2NoYW5uZWwYASABKAkSGAoQZGlzY29yZF9ndWlsZF9pZBgCIAEoCRIWCg5naXRodWJfYXV0b19wchgDIAEoCCKCAgoUUHJvamVjdE5vdGlmaWNhdGlvbnMSDQoFbXV0ZWQYASABKAgSFwoPb3ZlcnJpZGVfZXZlbnRzGAIgASgIEjsKBmV2ZW50cxgDIAMoCzIrLndhdGNoZmlyZS5Qcm9qZWN0Tm90aWZpY2F0aW9ucy5FdmVudHNFbnRyeRI5ChRxdWlldF9ob3Vyc19vdmVycmlkZRgEIAEoCzIbLndhdGNoZmlyZS5RdWlldEhvdXJzQ29uZmlnGkoKC0V2ZW50c0VudHJ5EgsKA2tleRgBIAEoCRIqCgV2YWx1ZRgCIAEoCzIbLndhdGNoZmlyZS5Qcm9qZWN0RXZlbnRQcmVmOgI4ASIyChBQcm9qZWN0RXZlbnRQcmVmEg8KB2VuYWJsZWQYASABKAgSDQoFc291bmQYAiABKAkiRQoJUHJvamVjdElkEiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCSIzCgtQcm9qZWN0TGlzdBIkCghwcm9qZWN0cxgBIAMoCzISLndhdGNoZmlyZS5Qcm9qZWN0IrwBChRDcmVhdGVQcm9qZWN0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEgwKBHBhdGgYAiABKAkSDAoEbmFtZRgDIAEoCRISCgpkZWZpbml0aW9uGAQgASgJEhIKCmF1dG9fbWVyZ2UYBiABKAgSGgoSYXV0b19kZWxldGVfYnJhbmNoGAcgASgIEhgKEGF1dG9fc3RhcnRfdGFza3MYCCABKAhKBAgFEAYi6gQKFFVwZGF0ZVByb2plY3RSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIRCgRuYW1lGAMgASgJSACIAQESEgoFY29sb3IYBCABKAlIAYgBARIaCg1kZWZhdWx0X2FnZW50GAYgASgJSAKIAQESFwoKYXV0b19tZXJnZRgHIAEoCEgDiAEBEh8KEmF1dG9fZGVsZXRlX2JyYW5jaBgIIAEoCEgEiAEBEh0KEGF1dG9fc3RhcnRfdGFza3MYCSABKAhIBYgBARIXCgpkZWZpbml0aW9uGAogASgJSAaIAQESIQoUc2VjcmV0c19pbnN0cnVjdGlvbnMYCyABKAlIB4gBARIgChNub3RpZmljYXRpb25zX211dGVkGAwgASgISAiIAQESFAoHc2FuZGJveBgNIAEoCUgJiAEBEhMKBnN0YXR1cxgOIAEoCUgKiAEBEjYKDW5vdGlmaWNhdGlvbnMYDyABKAsyHy53YXRjaGZpcmUuUHJvamVjdE5vdGlmaWNhdGlvbnNCBwoFX25hbWVCCAoGX2NvbG9yQhAKDl9kZWZhdWx0X2FnZW50Qg0KC19hdXRvX21lcmdlQhUKE19hdXRvX2RlbGV0ZV9icmFuY2hCEwoRX2F1dG9fc3RhcnRfdGFza3NCDQoLX2RlZmluaXRpb25CFwoVX3NlY3JldHNfaW5zdHJ1Y3Rpb25zQhYKFF9ub3RpZmljYXRpb25zX211dGVkQgoKCF9zYW5kYm94QgkKB19zdGF0dXNKBAgFEAYiUwoWUmVvcmRlclByb2plY3RzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhMKC3Byb2plY3RfaWRzGAIgAygJIoEBCgdHaXRJbmZvEhYKDmN1cnJlbnRfYnJhbmNoGAEgASgJEhIKCnJlbW90ZV91cmwYAiABKAkSEAoIaXNfZGlydHkYAyABKAgSGQoRdW5jb21taXR0ZWRfY291bnQYBCABKAUSDQoFYWhlYWQYBSABKAUSDgoGYmVoaW5kGAYgASgFIqsFCgRUYXNrEg8KB3Rhc2tfaWQYASABKAkSEwoLdGFza19udW1iZXIYAiABKAUSEgoKcHJvamVjdF9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRIOCgZwcm9tcHQYBSABKAkSGwoTYWNjZXB0YW5jZV9jcml0ZXJpYRgGIAEoCRIOCgZzdGF0dXMYByABKAkSFAoHc3VjY2VzcxgIIAEoCEgAiAEBEhsKDmZhaWx1cmVfcmVhc29uGAkgASgJSAGIAQESEAoIcG9zaXRpb24YCiABKAUSFgoOYWdlbnRfc2Vzc2lvbnMYCyABKAUSLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoKc3RhcnRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARI1Cgxjb21wbGV0ZWRfYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAOIAQESLgoKdXBkYXRlZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoKZGVsZXRlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBIgBARINCgVhZ2VudBgRIAEoCRIhChRtZXJnZV9mYWlsdXJlX3JlYXNvbhgSIAEoCUgFiAEBEhIKCmNyZWF0ZWRfYnkYEyABKAkSEgoKc3RhcnRlZF9ieRgUIAEoCUIKCghfc3VjY2Vzc0IRCg9fZmFpbHVyZV9yZWFzb25CDQoLX3N0YXJ0ZWRfYXRCDwoNX2NvbXBsZXRlZF9hdEINCgtfZGVsZXRlZF9hdEIXChVfbWVyZ2VfZmFpbHVyZV9yZWFzb24iVwoGVGFza0lkEiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRITCgt0YXNrX251bWJlchgDIAEoBSIqCghUYXNrTGlzdBIeCgV0YXNrcxgBIAMoCzIPLndhdGNoZmlyZS5UYXNrIkYKDU1hbGZvcm1lZFRhc2sSEwoLdGFza19udW1iZXIYASABKAUSEQoJZmlsZV9uYW1lGAIgASgJEg0KBWVycm9yGAMgASgJIjwKEU1hbGZvcm1lZFRhc2tMaXN0EicKBXRhc2tzGAEgAygLMhgud2F0Y2hmaXJlLk1hbGZvcm1lZFRhc2siVQoZTGlzdE1hbGZvcm1lZFRhc2tzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkihQEKEExpc3RUYXNrc1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhMKBnN0YXR1cxgDIAEoCUgAiAEBEhcKD2luY2x1ZGVfZGVsZXRlZBgEIAEoCEIJCgdfc3RhdHVzIvgBChFDcmVhdGVUYXNrUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDgoGcHJvbXB0GAQgASgJEiAKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBSABKAlIAIgBARIOCgZzdGF0dXMYBiABKAkSFQoIcG9zaXRpb24YByABKAVIAYgBARISCgVhZ2VudBgIIAEoCUgCiAEBQhYKFF9hY2NlcHRhbmNlX2NyaXRlcmlhQgsKCV9wb3NpdGlvbkIICgZfYWdlbnQijgMKEVVwZGF0ZVRhc2tSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRITCgt0YXNrX251bWJlchgDIAEoBRISCgV0aXRsZRgEIAEoCUgAiAEBEhMKBnByb21wdBgFIAEoCUgBiAEBEiAKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBiABKAlIAogBARITCgZzdGF0dXMYByABKAlIA4gBARIUCgdzdWNjZXNzGAggASgISASIAQESGwoOZmFpbHVyZV9yZWFzb24YCSABKAlIBYgBARIVCghwb3NpdGlvbhgKIAEoBUgGiAEBEhIKBWFnZW50GAsgASgJSAeIAQFCCAoGX3RpdGxlQgkKB19wcm9tcHRCFgoUX2FjY2VwdGFuY2VfY3JpdGVyaWFCCQoHX3N0YXR1c0IKCghfc3VjY2Vzc0IRCg9fZmFpbHVyZV9yZWFzb25CCwoJX3Bvc2l0aW9uQggKBl9hZ2VudCJ9ChdCdWxrVXBkYXRlU3RhdHVzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSFAoMdGFza19udW1iZXJzGAMgAygFEhIKCm5ld19zdGF0dXMYBCABKAkiYwoRQnVsa0RlbGV0ZVJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhQKDHRhc2tfbnVtYmVycxgDIAMoBSJkChJCdWxrUmVzdG9yZVJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhQKDHRhc2tfbnVtYmVycxgDIAMoBSJxChdDcmVhdGVUYXNrc0JhdGNoUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDAoEdGV4dBgDIAEoCRIOCgZzdGF0dXMYBCABKAkiYwoWQXJjaGl2ZVJldHJvZml0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDwoHZHJ5X3J1bhgDIAEoCCJlChNSZW9yZGVyVGFza3NSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIUCgx0YXNrX251bWJlcnMYAyADKAUi3QEKDERhZW1vblN0YXR1cxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAUSCwoDcGlkGAMgASgFEi4KCnN0YXJ0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWFjdGl2ZV9hZ2VudHMYBSABKAUSFwoPYWN0aXZlX3Byb2plY3RzGAYgAygJEhgKEHVwZGF0ZV9hdmFpbGFibGUYByABKAgSFgoOdXBkYXRlX3ZlcnNpb24YCCABKAkSEgoKdXBkYXRlX3VybBgJIAEoCSKTAgoLQWdlbnRTdGF0dXMSEgoKcHJvamVjdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSDAoEbW9kZRgDIAEoCRITCgt0YXNrX251bWJlchgEIAEoBRISCgp0YXNrX3RpdGxlGAUgASgJEhIKCmlzX3J1bm5pbmcYBiABKAgSFgoOd2lsZGZpcmVfcGhhc2UYByABKAkSKQoFaXNzdWUYCCABKAsyFS53YXRjaGZpcmUuQWdlbnRJc3N1ZUgAiAEBEjMKCnN0YXJ0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCCAoGX2lzc3VlQg0KC19zdGFydGVkX2F0IrYBChFTdGFydEFnZW50UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDAoEbW9kZRgDIAEoCRITCgt0YXNrX251bWJlchgEIAEoBRIMCgRyb3dzGAUgASgFEgwKBGNvbHMYBiABKAUSDwoHc2FuZGJveBgHIAEoCRIXCg9vdmVycmlkZV9idWRnZXQYCCABKAgi2QEKDFNjcmVlbkJ1ZmZlchISCgpwcm9qZWN0X2lkGAEgASgJEg0KBWxpbmVzGAIgAygJEhIKCmN1cnNvcl9yb3cYAyABKAUSEgoKY3Vyc29yX2NvbBgEIAEoBRIMCgRyb3dzGAUgASgFEgwKBGNvbHMYBiABKAUSFAoMYW5zaV9jb250ZW50GAcgASgJEgsKA3NlcRgIIAEoBBIQCghrZXlmcmFtZRgJIAEoCBItCgpyb3dfZGVsdGFzGAogAygLMhkud2F0Y2hmaXJlLlNjcmVlblJvd0RlbHRhIjkKDlNjcmVlblJvd0RlbHRhEgsKA3JvdxgBIAEoBRIMCgRsaW5lGAIgASgJEgwKBGFuc2kYAyABKAkiYgoWU3Vic2NyaWJlU2NyZWVuUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDgoGZGVsdGFzGAMgASgIImwKEVNjcm9sbGJhY2tSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIOCgZvZmZzZXQYAyABKAUSDQoFbGltaXQYBCABKAUiNQoPU2Nyb2xsYmFja0xpbmVzEg0KBWxpbmVzGAEgAygJEhMKC3RvdGFsX2xpbmVzGAIgASgFIloKEFNlbmRJbnB1dFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEgwKBGRhdGEYAyABKAwiZQoNUmVzaXplUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDAoEcm93cxgDIAEoBRIMCgRjb2xzGAQgASgFIm0KGVN1YnNjcmliZVJhd091dHB1dFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhYKDmJ5dGVzX3JlY2VpdmVkGAMgASgDIjIKDlJhd091dHB1dENodW5rEhIKCnByb2plY3RfaWQYASABKAkSDAoEZGF0YRgCIAEoDCL/AQoKQWdlbnRJc3N1ZRISCgppc3N1ZV90eXBlGAEgASgJEi8KC2RldGVjdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdtZXNzYWdlGAMgASgJEjEKCHJlc2V0X2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjcKDmNvb2xkb3duX3VudGlsGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEg8KB29wdGlvbnMYBiADKAlCCwoJX3Jlc2V0X2F0QhEKD19jb29sZG93bl91bnRpbCJxChVBbnN3ZXJRdWVzdGlvblJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEg4KBmFuc3dlchgDIAEoCRIOCgZvcHRpb24YBCABKAUiVwobU3Vic2NyaWJlQWdlbnRJc3N1ZXNSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCSKAAQoGQnJhbmNoEgwKBG5hbWUYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRITCgt0YXNrX251bWJlchgDIAEoBRIOCgZzdGF0dXMYBCABKAkSFQoNd29ya3RyZWVfcGF0aBgFIAEoCRIYChBjb21taXRfdGltZXN0YW1wGAYgASgDIjEKCkJyYW5jaExpc3QSIwoIYnJhbmNoZXMYASADKAsyES53YXRjaGZpcmUuQnJhbmNoImgKCEJyYW5jaElkEiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRITCgticmFuY2hfbmFtZRgDIAEoCRINCgVmb3JjZRgEIAEoCCJ/ChJNZXJnZUJyYW5jaFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhMKC2JyYW5jaF9uYW1lGAMgASgJEhoKEmRlbGV0ZV9hZnRlcl9tZXJnZRgEIAEoCCJjChFCdWxrQnJhbmNoUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSFAoMYnJhbmNoX25hbWVzGAMgAygJIhsKC0FnZW50Q29uZmlnEgwKBHBhdGgYASABKAki3wEKDkRlZmF1bHRzQ29uZmlnEhIKCmF1dG9fbWVyZ2UYASABKAgSGgoSYXV0b19kZWxldGVfYnJhbmNoGAIgASgIEhgKEGF1dG9fc3RhcnRfdGFza3MYAyABKAgSFwoPZGVmYXVsdF9zYW5kYm94GAUgASgJEhUKDWRlZmF1bHRfYWdlbnQYBiABKAkSNQoNbm90aWZpY2F0aW9ucxgHIAEoCzIeLndhdGNoZmlyZS5Ob3RpZmljYXRpb25zQ29uZmlnEhYKDnRlcm1pbmFsX3NoZWxsGAggASgJSgQIBBAFIlcKE05vdGlmaWNhdGlvbnNFdmVudHMSEwoLdGFza19mYWlsZWQYASABKAgSFAoMcnVuX2NvbXBsZXRlGAIgASgIEhUKDXdlZWtseV9kaWdlc3QYAyABKAgiYQoTTm90aWZpY2F0aW9uc1NvdW5kcxIPCgdlbmFibGVkGAEgASgIEhMKC3Rhc2tfZmFpbGVkGAIgASgIEhQKDHJ1bl9jb21wbGV0ZRgDIAEoCBIOCgZ2b2x1bWUYBCABKAEiPwoQUXVpZXRIb3Vyc0NvbmZpZxIPCgdlbmFibGVkGAEgASgIEg0KBXN0YXJ0GAIgASgJEgsKA2VuZBgDIAEoCSLRAQoTTm90aWZpY2F0aW9uc0NvbmZpZxIPCgdlbmFibGVkGAEgASgIEi4KBmV2ZW50cxgCIAEoCzIeLndhdGNoZmlyZS5Ob3RpZmljYXRpb25zRXZlbnRzEi4KBnNvdW5kcxgDIAEoCzIeLndhdGNoZmlyZS5Ob3RpZmljYXRpb25zU291bmRzEjAKC3F1aWV0X2hvdXJzGAQgASgLMhsud2F0Y2hmaXJlLlF1aWV0SG91cnNDb25maWcSFwoPZGlnZXN0X3NjaGVkdWxlGAUgASgJIlkKDVVwZGF0ZXNDb25maWcSGAoQY2hlY2tfb25fc3RhcnR1cBgBIAEoCBIXCg9jaGVja19mcmVxdWVuY3kYAiABKAkSFQoNYXV0b19kb3dubG9hZBgDIAEoCCIhChBBcHBlYXJhbmNlQ29uZmlnEg0KBXRoZW1lGAEgASgJIlIKEFJlY29yZGluZ3NDb25maWcSDwoHZW5hYmxlZBgBIAEoCBIUCgxtYXhfYWdlX2RheXMYAiABKAUSFwoPbWF4X3Blcl9wcm9qZWN0GAMgASgFInwKD1JldGVudGlvbkNvbmZpZxIPCgdlbmFibGVkGAEgASgIEhQKDG1heF9hZ2VfZGF5cxgCIAEoBRIQCghtYXhfbG9ncxgDIAEoBRITCgttYXhfc2l6ZV9tYhgEIAEoBRIbChNicmFuY2hfbWF4X2FnZV9kYXlzGAUgASgFIjgKFU1ldHJpY3NFbmRwb2ludENvbmZpZxIPCgdlbmFibGVkGAEgASgIEg4KBmxpc3RlbhgCIAEoCSK6AQoNVHJhY2luZ0NvbmZpZxIPCgdlbmFibGVkGAEgASgIEhAKCGV4cG9ydGVyGAIgASgJEhAKCGVuZHBvaW50GAMgASgJEjYKB2hlYWRlcnMYBCADKAsyJS53YXRjaGZpcmUuVHJhY2luZ0NvbmZpZy5IZWFkZXJzRW50cnkSDAoEZmlsZRgFIAEoCRouCgxIZWFkZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJ2CgpUb2tlblByaWNlEg8KB2JhY2tlbmQYASABKAkSDQoFbW9kZWwYAiABKAkSFgoOaW5wdXRfcGVyX210b2sYAyABKAESFwoPb3V0cHV0X3Blcl9tdG9rGAQgASgBEhcKD2NhY2hlZF9wZXJfbXRvaxgFIAEoASI2Cg1QcmljaW5nQ29uZmlnEiUKBnByaWNlcxgBIAMoCzIVLndhdGNoZmlyZS5Ub2tlblByaWNlIkoKDEJ1ZGdldENvbmZpZxITCgttb250aGx5X3VzZBgBIAEoARISCgp0aHJlc2hvbGRzGAIgAygFEhEKCWhhcmRfc3RvcBgDIAEoCCLQBAoIU2V0dGluZ3MSDwoHdmVyc2lvbhgBIAEoBRIvCgZhZ2VudHMYAiADKAsyHy53YXRjaGZpcmUuU2V0dGluZ3MuQWdlbnRzRW50cnkSKwoIZGVmYXVsdHMYAyABKAsyGS53YXRjaGZpcmUuRGVmYXVsdHNDb25maWcSKQoHdXBkYXRlcxgEIAEoCzIYLndhdGNoZmlyZS5VcGRhdGVzQ29uZmlnEi8KCmFwcGVhcmFuY2UYBSABKAsyGy53YXRjaGZpcmUuQXBwZWFyYW5jZUNvbmZpZxIXCg9pbnN0YWxsYXRpb25faWQYBiABKAkSLwoKcmVjb3JkaW5ncxgHIAEoCzIbLndhdGNoZmlyZS5SZWNvcmRpbmdzQ29uZmlnEi0KCXJldGVudGlvbhgIIAEoCzIaLndhdGNoZmlyZS5SZXRlbnRpb25Db25maWcSOgoQbWV0cmljc19lbmRwb2ludBgJIAEoCzIgLndhdGNoZmlyZS5NZXRyaWNzRW5kcG9pbnRDb25maWcSKQoHdHJhY2luZxgKIAEoCzIYLndhdGNoZmlyZS5UcmFjaW5nQ29uZmlnEikKB3ByaWNpbmcYCyABKAsyGC53YXRjaGZpcmUuUHJpY2luZ0NvbmZpZxInCgZidWRnZXQYDCABKAsyFy53YXRjaGZpcmUuQnVkZ2V0Q29uZmlnGkUKC0FnZW50c0VudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLndhdGNoZmlyZS5BZ2VudENvbmZpZzoCOAEikAYKFVVwZGF0ZVNldHRpbmdzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEjAKCGRlZmF1bHRzGAIgASgLMhkud2F0Y2hmaXJlLkRlZmF1bHRzQ29uZmlnSACIAQESLgoHdXBkYXRlcxgDIAEoCzIYLndhdGNoZmlyZS5VcGRhdGVzQ29uZmlnSAGIAQESNAoKYXBwZWFyYW5jZRgEIAEoCzIbLndhdGNoZmlyZS5BcHBlYXJhbmNlQ29uZmlnSAKIAQESPAoGYWdlbnRzGAUgAygLMiwud2F0Y2hmaXJlLlVwZGF0ZVNldHRpbmdzUmVxdWVzdC5BZ2VudHNFbnRyeRI0CgpyZWNvcmRpbmdzGAYgASgLMhsud2F0Y2hmaXJlLlJlY29yZGluZ3NDb25maWdIA4gBARIyCglyZXRlbnRpb24YByABKAsyGi53YXRjaGZpcmUuUmV0ZW50aW9uQ29uZmlnSASIAQESPwoQbWV0cmljc19lbmRwb2ludBgIIAEoCzIgLndhdGNoZmlyZS5NZXRyaWNzRW5kcG9pbnRDb25maWdIBYgBARIuCgd0cmFjaW5nGAkgASgLMhgud2F0Y2hmaXJlLlRyYWNpbmdDb25maWdIBogBARIuCgdwcmljaW5nGAogASgLMhgud2F0Y2hmaXJlLlByaWNpbmdDb25maWdIB4gBARIsCgZidWRnZXQYCyABKAsyFy53YXRjaGZpcmUuQnVkZ2V0Q29uZmlnSAiIAQEaRQoLQWdlbnRzRW50cnkSCwoDa2V5GAEgASgJEiUKBXZhbHVlGAIgASgLMhYud2F0Y2hmaXJlLkFnZW50Q29uZmlnOgI4AUILCglfZGVmYXVsdHNCCgoIX3VwZGF0ZXNCDQoLX2FwcGVhcmFuY2VCDQoLX3JlY29yZGluZ3NCDAoKX3JldGVudGlvbkITChFfbWV0cmljc19lbmRwb2ludEIKCghfdHJhY2luZ0IKCghfcHJpY2luZ0IJCgdfYnVkZ2V0IkIKCUFnZW50SW5mbxIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRIRCglhdmFpbGFibGUYAyABKAgiMQoJQWdlbnRMaXN0EiQKBmFnZW50cxgBIAMoCzIULndhdGNoZmlyZS5BZ2VudEluZm8igwEKD01jcENsaWVudFN0YXR1cxIOCgZjbGllbnQYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhAKCGRldGVjdGVkGAMgASgIEhIKCmNvbmZpZ3VyZWQYBCABKAgSEwoLY29uZmlnX3BhdGgYBSABKAkSDwoHbWVzc2FnZRgGIAEoCSJaChNNY3BDbGllbnRTdGF0dXNMaXN0EisKB2NsaWVudHMYASADKAsyGi53YXRjaGZpcmUuTWNwQ2xpZW50U3RhdHVzEhYKDmN1c3RvbV9zbmlwcGV0GAIgASgJIk8KF0luc3RhbGxNY3BDbGllbnRSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESDgoGY2xpZW50GAIgASgJImgKG1NldEdpdEh1YkF1dG9QUlNjb3BlUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDwoHZW5hYmxlZBgDIAEoCCKRAQokU2V0UHJvamVjdEludGVncmF0aW9uQmluZGluZ3NSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIVCg1zbGFja19jaGFubmVsGAMgASgJEhgKEGRpc2NvcmRfZ3VpbGRfaWQYBCABKAkiWQoMUnVuR0NSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESDwoHZHJ5X3J1bhgCIAEoCBISCgpwcm9qZWN0X2lkGAMgASgJIlcKBkdDSXRlbRIMCgRraW5kGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSDAoEcGF0aBgDIAEoCRINCgVieXRlcxgEIAEoAxIOCgZyZWFzb24YBSABKAkiYgoIR0NSZXBvcnQSDwoHZHJ5X3J1bhgBIAEoCBIgCgVpdGVtcxgCIAMoCzIRLndhdGNoZmlyZS5HQ0l0ZW0SEwoLdG90YWxfYnl0ZXMYAyABKAMSDgoGZXJyb3JzGAQgAygJIkMKG1N1YnNjcmliZUZvY3VzRXZlbnRzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhInIKCkZvY3VzRXZlbnQSEgoKcHJvamVjdF9pZBgBIAEoCRImCgZ0YXJnZXQYAiABKA4yFi53YXRjaGZpcmUuRm9jdXNUYXJnZXQSEwoLdGFza19udW1iZXIYAyABKAUSEwoLZGlnZXN0X2RhdGUYBCABKAkiSwoPTGlzdExvZ3NSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCSLxAQoITG9nRW50cnkSDgoGbG9nX2lkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEwoLdGFza19udW1iZXIYAyABKAUSFgoOc2Vzc2lvbl9udW1iZXIYBCABKAUSDQoFYWdlbnQYBSABKAkSDAoEbW9kZRgGIAEoCRISCgpzdGFydGVkX2F0GAcgASgJEhAKCGVuZGVkX2F0GAggASgJEg4KBnN0YXR1cxgJIAEoCRIWCg5oYXNfdHJhbnNjcmlwdBgKIAEoCBIVCg1oYXNfcmVjb3JkaW5nGAsgASgIEhIKCmhhc19ldmVudHMYDCABKAgiLAoHTG9nTGlzdBIhCgRsb2dzGAEgAygLMhMud2F0Y2hmaXJlLkxvZ0VudHJ5IlkKDUdldExvZ1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEg4KBmxvZ19pZBgDIAEoCSJBCgpMb2dDb250ZW50EiIKBWVudHJ5GAEgASgLMhMud2F0Y2hmaXJlLkxvZ0VudHJ5Eg8KB2NvbnRlbnQYAiABKAkiXAoQRGVsZXRlTG9nUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhIKCnByb2plY3RfaWQYAiABKAkSDgoGbG9nX2lkGAMgASgJIl8KE0dldFJlY29yZGluZ1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEg4KBmxvZ19pZBgDIAEoCSIeCg5SZWNvcmRpbmdDaHVuaxIMCgRkYXRhGAEgASgMIswBChFTZWFyY2hMb2dzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEg0KBXF1ZXJ5GAIgASgJEhMKC3Byb2plY3RfaWRzGAMgAygJEg0KBWFnZW50GAQgASgJEhMKC3Rhc2tfbnVtYmVyGAUgASgFEgwKBG1vZGUYBiABKAkSDgoGc3RhdHVzGAcgASgJEg0KBXNpbmNlGAggASgJEg0KBXVudGlsGAkgASgJEg0KBWxpbWl0GAogASgFImkKDExvZ1NlYXJjaEhpdBIiCgVlbnRyeRgBIAEoCzITLndhdGNoZmlyZS5Mb2dFbnRyeRIUCgxwcm9qZWN0X25hbWUYAiABKAkSDQoFc2NvcmUYAyABKAESEAoIc25pcHBldHMYBCADKAkiOwoSU2VhcmNoTG9nc1Jlc3BvbnNlEiUKBGhpdHMYASADKAsyFy53YXRjaGZpcmUuTG9nU2VhcmNoSGl0InIKF0dldFNlc3Npb25FdmVudHNSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIOCgZsb2dfaWQYAyABKAkSDQoFdHlwZXMYBCADKAkirgIKDFNlc3Npb25FdmVudBILCgNzZXEYASABKAUSDAoEdHlwZRgCIAEoCRIMCgR0aW1lGAMgASgJEgwKBHRleHQYBCABKAkSDAoEdG9vbBgFIAEoCRIPCgdjYWxsX2lkGAYgASgJEgwKBGFyZ3MYByABKAkSDgoGcmVzdWx0GAggASgJEhAKCGlzX2Vycm9yGAkgASgIEgwKBHBhdGgYCiABKAkSEQoJZWRpdF9raW5kGAsgASgJEg8KB2NvbW1hbmQYDCABKAkSFgoJZXhpdF9jb2RlGA0gASgFSACIAQESEQoJdG9rZW5zX2luGA4gASgDEhIKCnRva2Vuc19vdXQYDyABKAMSGQoRY2FjaGVfcmVhZF90b2tlbnMYECABKANCDAoKX2V4aXRfY29kZSI7ChBTZXNzaW9uRXZlbnRMaXN0EicKBmV2ZW50cxgBIAMoCzIXLndhdGNoZmlyZS5TZXNzaW9uRXZlbnQijgIKDE5vdGlmaWNhdGlvbhIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEhMKC3Rhc2tfbnVtYmVyGAMgASgFEg0KBXRpdGxlGAQgASgJEgwKBGJvZHkYBSABKAkSLgoKZW1pdHRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKQoEa2luZBgHIAEoDjIbLndhdGNoZmlyZS5Ob3RpZmljYXRpb25LaW5kEg4KBmRldGFpbBgIIAEoCRILCgN1cmwYCSABKAkSDQoFcGhhc2UYCiABKAkSFgoOcHJldmlvdXNfcGhhc2UYCyABKAkSDQoFY291bnQYDCABKAUiRQodU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YSKOAgoTRXhwb3J0UmVwb3J0UmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhQKCnByb2plY3RfaWQYAiABKAlIABIQCgZnbG9iYWwYAyABKAhIABIVCgtzaW5nbGVfdGFzaxgEIAEoCUgAEicKBmZvcm1hdBgFIAEoDjIXLndhdGNoZmlyZS5FeHBvcnRGb3JtYXQSMAoMd2luZG93X3N0YXJ0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIHCgVzY29wZSJHChRFeHBvcnRSZXBvcnRSZXNwb25zZRIQCghmaWxlbmFtZRgBIAEoCRIPCgdjb250ZW50GAIgASgMEgwKBG1pbWUYAyABKAkilAIKGEdldEdsb2JhbEluc2lnaHRzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEjAKDHdpbmRvd19zdGFydBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoUY29tcGFyZV93aW5kb3dfc3RhcnQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjYKEmNvbXBhcmVfd2luZG93X2VuZBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAidwoJRGF5QnVja2V0EgwKBGRhdGUYASABKAkSDQoFY291bnQYAiABKAUSEQoJc3VjY2VlZGVkGAMgASgFEg4KBmZhaWxlZBgEIAEoBRITCgtsaW5lc19hZGRlZBgFIAEoBRIVCg1saW5lc19yZW1vdmVkGAYgASgFIuUBCg5BZ2VudEJyZWFrZG93bhINCgVhZ2VudBgBIAEoCRINCgVjb3VudBgCIAEoBRIUCgxzdWNjZXNzX3JhdGUYAyABKAESFwoPYXZnX2R1cmF0aW9uX21zGAQgASgDEhcKD3RvdGFsX3Rva2Vuc19pbhgFIAEoAxIYChB0b3RhbF90b2tlbnNfb3V0GAYgASgDEhYKDnRvdGFsX2Nvc3RfdXNkGAcgASgBEg8KB2NvbW1pdHMYCCABKAUSEwoLbGluZXNfYWRkZWQYCSABKAUSFQoNbGluZXNfcmVtb3ZlZBgKIAEoBSKFAwoPQWdlbnRDb21wYXJpc29uEg0KBWFnZW50GAEgASgJEg0KBW1vZGVsGAIgASgJEg0KBXRhc2tzGAMgASgFEhEKCXN1Y2NlZWRlZBgEIAEoBRIUCgxzdWNjZXNzX3JhdGUYBSABKAESGgoSbWVkaWFuX2R1cmF0aW9uX21zGAYgASgDEhcKD3A5MF9kdXJhdGlvbl9tcxgHIAEoAxIWCg50b3RhbF9jb3N0X3VzZBgIIAEoARIcChRjb3N0X3Blcl9zdWNjZXNzX3VzZBgJIAEoARIWCg5tZXJnZV9mYWlsdXJlcxgKIAEoBRIaChJtZXJnZV9mYWlsdXJlX3JhdGUYCyABKAESEgoKZm9sbG93X3VwcxgMIAEoBRIWCg5mb2xsb3dfdXBfcmF0ZRgNIAEoARIQCghyZXZlcnRlZBgOIAEoBRITCgtyZXZlcnRfcmF0ZRgPIAEoARITCgtsaW5lc19hZGRlZBgQIAEoBRIVCg1saW5lc19yZW1vdmVkGBEgASgFIusBCglVc2VyVXNhZ2USDAoEdXNlchgBIAEoCRINCgV0YXNrcxgCIAEoBRIRCglzdWNjZWVkZWQYAyABKAUSFAoMc3VjY2Vzc19yYXRlGAQgASgBEhMKC2R1cmF0aW9uX21zGAUgASgDEhUKDXRhc2tfY29zdF91c2QYBiABKAESEQoJbmV0X2xpbmVzGAcgASgFEhUKDXRhc2tzX2NyZWF0ZWQYCCABKAUSEAoIc2Vzc2lvbnMYCSABKAUSGAoQc2Vzc2lvbl9jb3N0X3VzZBgKIAEoARIWCg50b3RhbF9jb3N0X3VzZBgLIAEoASLPAQoQSW5zaWdodHNPdmVyaGVhZBIQCghzZXNzaW9ucxgBIAEoBRITCgtkdXJhdGlvbl9tcxgCIAEoAxIRCgl0b2tlbnNfaW4YAyABKAMSEgoKdG9rZW5zX291dBgEIAEoAxIQCghjb3N0X3VzZBgFIAEoARIdChVzZXNzaW9uc19taXNzaW5nX2Nvc3QYBiABKAUSEgoKY29zdF9zaGFyZRgHIAEoARIoCgdieV9raW5kGAggAygLMhcud2F0Y2hmaXJlLk92ZXJoZWFkS2luZCJ8CgxPdmVyaGVhZEtpbmQSDAoEa2luZBgBIAEoCRIQCghzZXNzaW9ucxgCIAEoBRITCgtkdXJhdGlvbl9tcxgDIAEoAxIRCgl0b2tlbnNfaW4YBCABKAMSEgoKdG9rZW5zX291dBgFIAEoAxIQCghjb3N0X3VzZBgGIAEoASJUCgtNZXRyaWNEZWx0YRIPCgdjdXJyZW50GAEgASgBEhAKCHByZXZpb3VzGAIgASgBEg4KBmNoYW5nZRgDIAEoARISCgpjaGFuZ2VfcGN0GAQgASgBIrUCChJJbnNpZ2h0c0NvbXBhcmlzb24SMAoMd2luZG93X3N0YXJ0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIlCgV0YXNrcxgDIAEoCzIWLndhdGNoZmlyZS5NZXRyaWNEZWx0YRIsCgxzdWNjZXNzX3JhdGUYBCABKAsyFi53YXRjaGZpcmUuTWV0cmljRGVsdGESKAoIY29zdF91c2QYBSABKAsyFi53YXRjaGZpcmUuTWV0cmljRGVsdGESKQoJbmV0X2xpbmVzGAYgASgLMhYud2F0Y2hmaXJlLk1ldHJpY0RlbHRhEhMKC3JlZ3Jlc3Npb25zGAcgAygJInwKCVRyZW5kV2VlaxISCgp3ZWVrX3N0YXJ0GAEgASgJEg0KBXRhc2tzGAIgASgFEhEKCXN1Y2NlZWRlZBgDIAEoBRIUCgxzdWNjZXNzX3JhdGUYBCABKAESEAoIY29zdF91c2QYBSABKAESEQoJbmV0X2xpbmVzGAYgASgFItIBCgpUb3BQcm9qZWN0EhIKCnByb2plY3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEhUKDXByb2plY3RfY29sb3IYAyABKAkSDQoFY291bnQYBCABKAUSFAoMc3VjY2Vzc19yYXRlGAUgASgBEg8KB2NvbW1pdHMYBiABKAUSEwoLbGluZXNfYWRkZWQYByABKAUSFQoNbGluZXNfcmVtb3ZlZBgIIAEoBRIRCgluZXRfbGluZXMYCSABKAUSDgoGbWVyZ2VzGAogASgFIqEHCg5HbG9iYWxJbnNpZ2h0cxITCgt0YXNrc190b3RhbBgBIAEoBRIXCg90YXNrc19zdWNjZWVkZWQYAiABKAUSFAoMdGFza3NfZmFpbGVkGAMgASgFEioKDHRhc2tzX2J5X2RheRgEIAMoCzIULndhdGNoZmlyZS5EYXlCdWNrZXQSKwoMdG9wX3Byb2plY3RzGAUgAygLMhUud2F0Y2hmaXJlLlRvcFByb2plY3QSMgoPYWdlbnRfYnJlYWtkb3duGAYgAygLMhkud2F0Y2hmaXJlLkFnZW50QnJlYWtkb3duEhkKEXRvdGFsX2R1cmF0aW9uX21zGAcgASgDEhYKDnRvdGFsX2Nvc3RfdXNkGAggASgBEhoKEnRhc2tzX21pc3NpbmdfY29zdBgJIAEoBRIwCgx3aW5kb3dfc3RhcnQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDXRvdGFsX2NvbW1pdHMYDCABKAUSGwoTdG90YWxfZmlsZXNfY2hhbmdlZBgNIAEoBRIZChF0b3RhbF9saW5lc19hZGRlZBgOIAEoBRIbChN0b3RhbF9saW5lc19yZW1vdmVkGA8gASgFEhEKCW5ldF9saW5lcxgQIAEoBRIUCgx0YXNrc19tZXJnZWQYESABKAUSFAoMdGFza3NfdmlhX3ByGBIgASgFEhwKFG1ldHJpY3NfbWlzc2luZ19jb2RlGBMgASgFEhoKEmVzdGltYXRlZF9jb3N0X3VzZBgUIAEoARIcChR0YXNrc19lc3RpbWF0ZWRfY29zdBgVIAEoBRIoCgdidWRnZXRzGBYgAygLMhcud2F0Y2hmaXJlLkJ1ZGdldFN0YXR1cxI0ChBhZ2VudF9jb21wYXJpc29uGBcgAygLMhoud2F0Y2hmaXJlLkFnZW50Q29tcGFyaXNvbhItCghvdmVyaGVhZBgYIAEoCzIbLndhdGNoZmlyZS5JbnNpZ2h0c092ZXJoZWFkEjEKCmNvbXBhcmlzb24YGSABKAsyHS53YXRjaGZpcmUuSW5zaWdodHNDb21wYXJpc29uEiMKBXRyZW5kGBogAygLMhQud2F0Y2hmaXJlLlRyZW5kV2VlaxIjCgV1c2VycxgbIAMoCzIULndhdGNoZmlyZS5Vc2VyVXNhZ2UixgEKDEJ1ZGdldFN0YXR1cxINCgVzY29wZRgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEhQKDHByb2plY3RfbmFtZRgDIAEoCRINCgVtb250aBgEIAEoCRIRCglsaW1pdF91c2QYBSABKAESEQoJc3BlbnRfdXNkGAYgASgBEhEKCXRocmVzaG9sZBgHIAEoBRIRCgloYXJkX3N0b3AYCCABKAgSEAoIZXhjZWVkZWQYCSABKAgSEAoIYmxvY2tpbmcYCiABKAgiqQIKGUdldFByb2plY3RJbnNpZ2h0c1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEjAKDHdpbmRvd19zdGFydBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoUY29tcGFyZV93aW5kb3dfc3RhcnQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjYKEmNvbXBhcmVfd2luZG93X2VuZBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiqgcKD1Byb2plY3RJbnNpZ2h0cxISCgpwcm9qZWN0X2lkGAEgASgJEhMKC3Rhc2tzX3RvdGFsGAIgASgFEhcKD3Rhc2tzX3N1Y2NlZWRlZBgDIAEoBRIUCgx0YXNrc19mYWlsZWQYBCABKAUSKgoMdGFza3NfYnlfZGF5GAUgAygLMhQud2F0Y2hmaXJlLkRheUJ1Y2tldBIyCg9hZ2VudF9icmVha2Rvd24YBiADKAsyGS53YXRjaGZpcmUuQWdlbnRCcmVha2Rvd24SGQoRdG90YWxfZHVyYXRpb25fbXMYByABKAMSFwoPYXZnX2R1cmF0aW9uX21zGAggASgDEhcKD3A1MF9kdXJhdGlvbl9tcxgJIAEoAxIXCg9wOTVfZHVyYXRpb25fbXMYCiABKAMSFgoOdG90YWxfY29zdF91c2QYCyABKAESGgoSdGFza3NfbWlzc2luZ19jb3N0GAwgASgFEjAKDHdpbmRvd19zdGFydBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNdG90YWxfY29tbWl0cxgPIAEoBRIbChN0b3RhbF9maWxlc19jaGFuZ2VkGBAgASgFEhkKEXRvdGFsX2xpbmVzX2FkZGVkGBEgASgFEhsKE3RvdGFsX2xpbmVzX3JlbW92ZWQYEiABKAUSEQoJbmV0X2xpbmVzGBMgASgFEhQKDHRhc2tzX21lcmdlZBgUIAEoBRIUCgx0YXNrc192aWFfcHIYFSABKAUSHAoUbWV0cmljc19taXNzaW5nX2NvZGUYFiABKAUSGgoSZXN0aW1hdGVkX2Nvc3RfdXNkGBcgASgBEhwKFHRhc2tzX2VzdGltYXRlZF9jb3N0GBggASgFEjQKEGFnZW50X2NvbXBhcmlzb24YGSADKAsyGi53YXRjaGZpcmUuQWdlbnRDb21wYXJpc29uEi0KCG92ZXJoZWFkGBogASgLMhsud2F0Y2hmaXJlLkluc2lnaHRzT3ZlcmhlYWQSMQoKY29tcGFyaXNvbhgbIAEoCzIdLndhdGNoZmlyZS5JbnNpZ2h0c0NvbXBhcmlzb24SIwoFdHJlbmQYHCADKAsyFC53YXRjaGZpcmUuVHJlbmRXZWVrEiMKBXVzZXJzGB0gAygLMhQud2F0Y2hmaXJlLlVzZXJVc2FnZSJjChJHZXRUYXNrRGlmZlJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRISCgpwcm9qZWN0X2lkGAIgASgJEhMKC3Rhc2tfbnVtYmVyGAMgASgFInYKC0ZpbGVEaWZmU2V0EiIKBWZpbGVzGAEgAygLMhMud2F0Y2hmaXJlLkZpbGVEaWZmEhcKD3RvdGFsX2FkZGl0aW9ucxgCIAEoBRIXCg90b3RhbF9kZWxldGlvbnMYAyABKAUSEQoJdHJ1bmNhdGVkGAQgASgIIrMBCghGaWxlRGlmZhIMCgRwYXRoGAEgASgJEioKBnN0YXR1cxgCIAEoDjIaLndhdGNoZmlyZS5GaWxlRGlmZi5TdGF0dXMSEAoIb2xkX3BhdGgYAyABKAkSHgoFaHVua3MYBCADKAsyDy53YXRjaGZpcmUuSHVuayI7CgZTdGF0dXMSDAoITU9ESUZJRUQQABIJCgVBRERFRBABEgsKB0RFTEVURUQQAhILCgdSRU5BTUVEEAMihgEKBEh1bmsSEQoJb2xkX3N0YXJ0GAEgASgFEhEKCW9sZF9saW5lcxgCIAEoBRIRCgluZXdfc3RhcnQYAyABKAUSEQoJbmV3X2xpbmVzGAQgASgFEg4KBmhlYWRlchgFIAEoCRIiCgVsaW5lcxgGIAMoCzITLndhdGNoZmlyZS5EaWZmTGluZSJnCghEaWZmTGluZRImCgRraW5kGAEgASgOMhgud2F0Y2hmaXJlLkRpZmZMaW5lLktpbmQSDAoEdGV4dBgCIAEoCSIlCgRLaW5kEgsKB0NPTlRFWFQQABIHCgNBREQQARIHCgNERUwQAiK/AQoSR2V0SG90c3BvdHNSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESEgoKcHJvamVjdF9pZBgCIAEoCRIwCgx3aW5kb3dfc3RhcnQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbWl0GAUgASgFIsoCCghIb3RzcG90cxISCgpwcm9qZWN0X2lkGAEgASgJEjAKDHdpbmRvd19zdGFydBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNdGFza3Nfc2Nhbm5lZBgEIAEoBRIaChJ0YXNrc193aXRob3V0X2RpZmYYBSABKAUSJQoFZmlsZXMYBiADKAsyFi53YXRjaGZpcmUuSG90c3BvdEZpbGUSLQoNZmFpbHVyZV9maWxlcxgHIAMoCzIWLndhdGNoZmlyZS5Ib3RzcG90RmlsZRIwCgtkaXJlY3RvcmllcxgIIAMoCzIbLndhdGNoZmlyZS5Ib3RzcG90RGlyZWN0b3J5Eg0KBXdlZWtzGAkgAygJIswBCgtIb3RzcG90RmlsZRIMCgRwYXRoGAEgASgJEg0KBXRhc2tzGAIgASgFEhQKDGZhaWxlZF90YXNrcxgDIAEoBRIWCg5tZXJnZV9mYWlsdXJlcxgEIAEoBRITCgtsaW5lc19hZGRlZBgFIAEoBRIVCg1saW5lc19yZW1vdmVkGAYgASgFEjAKDGxhc3RfdG91Y2hlZBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMd2Vla2x5X2NodXJuGAggAygFIpgBChBIb3RzcG90RGlyZWN0b3J5EgwKBHBhdGgYASABKAkSDQoFdGFza3MYAiABKAUSDQoFZmlsZXMYAyABKAUSFAoMZmFpbGVkX3Rhc2tzGAQgASgFEhYKDm1lcmdlX2ZhaWx1cmVzGAUgASgFEhMKC2xpbmVzX2FkZGVkGAYgASgFEhUKDWxpbmVzX3JlbW92ZWQYByABKAUi2AEKEUludGVncmF0aW9uRXZlbnRzEhMKC3Rhc2tfZmFpbGVkGAEgASgIEhQKDHJ1bl9jb21wbGV0ZRgCIAEoCBIVCg13ZWVrbHlfZGlnZXN0GAMgASgIEhgKEGJ1ZGdldF90aHJlc2hvbGQYBCABKAgSOAoGZXZlbnRzGAUgAygLMigud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzLkV2ZW50c0VudHJ5Gi0KC0V2ZW50c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCDoCOAEiwwEKEldlYmhvb2tJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRILCgN1cmwYAyABKAkSEQoJdXJsX2xhYmVsGAQgASgJEhIKCnNlY3JldF9zZXQYBSABKAgSDgoGc2VjcmV0GAYgASgJEjQKDmVuYWJsZWRfZXZlbnRzGAcgASgLMhwud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzEhgKEHByb2plY3RfbXV0ZV9pZHMYCCADKAkirgEKEFNsYWNrSW50ZWdyYXRpb24SCgoCaWQYASABKAkSDQoFbGFiZWwYAiABKAkSCwoDdXJsGAMgASgJEhEKCXVybF9sYWJlbBgEIAEoCRIPCgd1cmxfc2V0GAUgASgIEjQKDmVuYWJsZWRfZXZlbnRzGAYgASgLMhwud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzEhgKEHByb2plY3RfbXV0ZV9pZHMYByADKAkisAEKEkRpc2NvcmRJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRILCgN1cmwYAyABKAkSEQoJdXJsX2xhYmVsGAQgASgJEg8KB3VybF9zZXQYBSABKAgSNAoOZW5hYmxlZF9ldmVudHMYBiABKAsyHC53YXRjaGZpcmUuSW50ZWdyYXRpb25FdmVudHMSGAoQcHJvamVjdF9tdXRlX2lkcxgHIAMoCSKuAQoQVGVhbXNJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRILCgN1cmwYAyABKAkSEQoJdXJsX2xhYmVsGAQgASgJEg8KB3VybF9zZXQYBSABKAgSNAoOZW5hYmxlZF9ldmVudHMYBiABKAsyHC53YXRjaGZpcmUuSW50ZWdyYXRpb25FdmVudHMSGAoQcHJvamVjdF9tdXRlX2lkcxgHIAMoCSLQAQoRTWF0cml4SW50ZWdyYXRpb24SCgoCaWQYASABKAkSDQoFbGFiZWwYAiABKAkSFgoOaG9tZXNlcnZlcl91cmwYAyABKAkSDwoHcm9vbV9pZBgEIAEoCRIUCgxhY2Nlc3NfdG9rZW4YBSABKAkSEQoJdG9rZW5fc2V0GAYgASgIEjQKDmVuYWJsZWRfZXZlbnRzGAcgASgLMhwud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzEhgKEHByb2plY3RfbXV0ZV9pZHMYCCADKAkiqwEKD050ZnlJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRILCgN1cmwYAyABKAkSDQoFdG9rZW4YBCABKAkSEQoJdG9rZW5fc2V0GAUgASgIEjQKDmVuYWJsZWRfZXZlbnRzGAYgASgLMhwud2F0Y2hmaXJlLkludGVncmF0aW9uRXZlbnRzEhgKEHByb2plY3RfbXV0ZV9pZHMYByADKAkiVwoORW1haWxSZWNpcGllbnQSDwoHYWRkcmVzcxgBIAEoCRI0Cg5lbmFibGVkX2V2ZW50cxgCIAEoCzIcLndhdGNoZmlyZS5JbnRlZ3JhdGlvbkV2ZW50cyLsAQoQRW1haWxJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRINCgVsYWJlbBgCIAEoCRIMCgRob3N0GAMgASgJEgwKBHBvcnQYBCABKAUSEAoIc2VjdXJpdHkYBSABKAkSEAoIdXNlcm5hbWUYBiABKAkSEAoIcGFzc3dvcmQYByABKAkSFAoMcGFzc3dvcmRfc2V0GAggASgIEgwKBGZyb20YCSABKAkSLQoKcmVjaXBpZW50cxgKIAMoCzIZLndhdGNoZmlyZS5FbWFpbFJlY2lwaWVudBIYChBwcm9qZWN0X211dGVfaWRzGAsgAygJIlMKEUdpdEh1YkludGVncmF0aW9uEg8KB2VuYWJsZWQYASABKAgSFQoNZHJhZnRfZGVmYXVsdBgCIAEoCBIWCg5wcm9qZWN0X3Njb3BlcxgDIAMoCSKkAQoWVGVsZWdyYW1QYWlyZWRDaGF0SW5mbxIPCgdjaGF0X2lkGAEgASgDEhAKCHVzZXJuYW1lGAIgASgJEi0KCXBhaXJlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGgoSZGVmYXVsdF9wcm9qZWN0X2lkGAQgASgJEg0KBW11dGVkGAUgASgIEg0KBXdhdGNoGAYgASgIIrsBChNUZWxlZ3JhbUludGVncmF0aW9uEg8KB2VuYWJsZWQYASABKAgSEQoJYm90X3Rva2VuGAIgASgJEhEKCXRva2VuX3NldBgDIAEoCBI0Cg5lbmFibGVkX2V2ZW50cxgEIAEoCzIcLndhdGNoZmlyZS5JbnRlZ3JhdGlvbkV2ZW50cxI3CgxwYWlyZWRfY2hhdHMYBSADKAsyIS53YXRjaGZpcmUuVGVsZWdyYW1QYWlyZWRDaGF0SW5mbyKxAwoSSW50ZWdyYXRpb25zQ29uZmlnEi8KCHdlYmhvb2tzGAEgAygLMh0ud2F0Y2hmaXJlLldlYmhvb2tJbnRlZ3JhdGlvbhIqCgVzbGFjaxgCIAMoCzIbLndhdGNoZmlyZS5TbGFja0ludGVncmF0aW9uEi4KB2Rpc2NvcmQYAyADKAsyHS53YXRjaGZpcmUuRGlzY29yZEludGVncmF0aW9uEiwKBmdpdGh1YhgEIAEoCzIcLndhdGNoZmlyZS5HaXRIdWJJbnRlZ3JhdGlvbhIwCgh0ZWxlZ3JhbRgFIAEoCzIeLndhdGNoZmlyZS5UZWxlZ3JhbUludGVncmF0aW9uEioKBXRlYW1zGAYgAygLMhsud2F0Y2hmaXJlLlRlYW1zSW50ZWdyYXRpb24SLAoGbWF0cml4GAcgAygLMhwud2F0Y2hmaXJlLk1hdHJpeEludGVncmF0aW9uEigKBG50ZnkYCCADKAsyGi53YXRjaGZpcmUuTnRmeUludGVncmF0aW9uEioKBWVtYWlsGAkgAygLMhsud2F0Y2hmaXJlLkVtYWlsSW50ZWdyYXRpb24iPwoXTGlzdEludGVncmF0aW9uc1JlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YSL3AwoWU2F2ZUludGVncmF0aW9uUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEjAKB3dlYmhvb2sYAiABKAsyHS53YXRjaGZpcmUuV2ViaG9va0ludGVncmF0aW9uSAASLAoFc2xhY2sYAyABKAsyGy53YXRjaGZpcmUuU2xhY2tJbnRlZ3JhdGlvbkgAEjAKB2Rpc2NvcmQYBCABKAsyHS53YXRjaGZpcmUuRGlzY29yZEludGVncmF0aW9uSAASLgoGZ2l0aHViGAUgASgLMhwud2F0Y2hmaXJlLkdpdEh1YkludGVncmF0aW9uSAASMgoIdGVsZWdyYW0YBiABKAsyHi53YXRjaGZpcmUuVGVsZWdyYW1JbnRlZ3JhdGlvbkgAEiwKBXRlYW1zGAcgASgLMhsud2F0Y2hmaXJlLlRlYW1zSW50ZWdyYXRpb25IABIuCgZtYXRyaXgYCCABKAsyHC53YXRjaGZpcmUuTWF0cml4SW50ZWdyYXRpb25IABIqCgRudGZ5GAkgASgLMhoud2F0Y2hmaXJlLk50ZnlJbnRlZ3JhdGlvbkgAEiwKBWVtYWlsGAogASgLMhsud2F0Y2hmaXJlLkVtYWlsSW50ZWdyYXRpb25IAEIJCgdwYXlsb2FkInYKGERlbGV0ZUludGVncmF0aW9uUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEigKBGtpbmQYAiABKA4yGi53YXRjaGZpcmUuSW50ZWdyYXRpb25LaW5kEgoKAmlkGAMgASgJInQKFlRlc3RJbnRlZ3JhdGlvblJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIoCgRraW5kGAIgASgOMhoud2F0Y2hmaXJlLkludGVncmF0aW9uS2luZBIKCgJpZBgDIAEoCSJLChdUZXN0SW50ZWdyYXRpb25SZXNwb25zZRIKCgJvaxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhMKC3N0YXR1c19jb2RlGAMgASgFItkCCg1SZWxheURlbGl2ZXJ5EgoKAmlkGAEgASgJEhIKCmFkYXB0ZXJfaWQYAiABKAkSFAoMYWRhcHRlcl9raW5kGAMgASgJEg0KBWV2ZW50GAQgASgJEhIKCnByb2plY3RfaWQYBSABKAkSFAoMcHJvamVjdF9uYW1lGAYgASgJEhMKC3Rhc2tfbnVtYmVyGAcgASgFEhAKCGF0dGVtcHRzGAggASgFEhIKCmxhc3RfZXJyb3IYCSABKAkSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoPbmV4dF9hdHRlbXB0X2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIrCgdkZWFkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRkZWFkGA0gASgIIlwKG0xpc3RGYWlsZWREZWxpdmVyaWVzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEhcKD2luY2x1ZGVfcGVuZGluZxgCIAEoCCJMChxMaXN0RmFpbGVkRGVsaXZlcmllc1Jlc3BvbnNlEiwKCmRlbGl2ZXJpZXMYASADKAsyGC53YXRjaGZpcmUuUmVsYXlEZWxpdmVyeSJJChVSZXBsYXlEZWxpdmVyeVJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIKCgJpZBgCIAEoCSKRAQoWUHJldmlld1RlbXBsYXRlUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEg8KB2NoYW5uZWwYAiABKAkSDQoFZXZlbnQYAyABKAkSEwoLZW5kcG9pbnRfaWQYBCABKAkSDgoGc291cmNlGAUgASgJEgwKBHNhdmUYBiABKAgigwEKF1ByZXZpZXdUZW1wbGF0ZVJlc3BvbnNlEgoKAm9rGAEgASgIEhAKCHJlbmRlcmVkGAIgASgJEg0KBWVycm9yGAMgASgJEg4KBnNvdXJjZRgEIAEoCRIOCgZvcmlnaW4YBSABKAkSDAoEcGF0aBgGIAEoCRINCgVzYXZlZBgHIAEoCCLCAQoQVGVzdFJvdXRlUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEg0KBWV2ZW50GAIgASgJEg8KB3Byb2plY3QYAyABKAkSEwoLdGFza19udW1iZXIYBCABKAUSDQoFYWdlbnQYBSABKAkSEgoKdGFza190aXRsZRgGIAEoCRIWCg5mYWlsdXJlX3JlYXNvbhgHIAEoCRIYChBkdXJhdGlvbl9zZWNvbmRzGAggASgDIlcKC1JvdXRlVGFyZ2V0EhIKCmFkYXB0ZXJfaWQYASABKAkSFAoMYWRhcHRlcl9raW5kGAIgASgJEhAKCGNoYXRfaWRzGAMgAygDEgwKBHJ1bGUYBCABKAkigwIKEVRlc3RSb3V0ZVJlc3BvbnNlEg0KBXJ1bGVzGAEgAygJEhcKD2RlZmF1bHRfcm91dGluZxgCIAEoCBInCgd0YXJnZXRzGAMgAygLMhYud2F0Y2hmaXJlLlJvdXRlVGFyZ2V0Eg0KBW11dGVkGAQgAygJEg8KB3Vua25vd24YBSADKAkSEgoKcHJvamVjdF9pZBgGIAEoCRIUCgxwcm9qZWN0X25hbWUYByABKAkSDQoFYWdlbnQYCCABKAkSEgoKdGFza190aXRsZRgJIAEoCRIWCg5mYWlsdXJlX3JlYXNvbhgKIAEoCRIYChBkdXJhdGlvbl9zZWNvbmRzGAsgASgDIkMKG0JlZ2luVGVsZWdyYW1QYWlyaW5nUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhIoUBChxCZWdpblRlbGVncmFtUGFpcmluZ1Jlc3BvbnNlEgwKBGNvZGUYASABKAkSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJZGVlcF9saW5rGAMgASgJEhQKDGJvdF91c2VybmFtZRgEIAEoCSJHCh9HZXRUZWxlZ3JhbVBhaXJpbmdTdGF0dXNSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGEi1gEKFVRlbGVncmFtUGFpcmluZ1N0YXR1cxIuCgVzdGF0ZRgBIAEoDjIfLndhdGNoZmlyZS5UZWxlZ3JhbVBhaXJpbmdTdGF0ZRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgRjaGF0GAMgASgLMiEud2F0Y2hmaXJlLlRlbGVncmFtUGFpcmVkQ2hhdEluZm8SFgoOYnJpZGdlX3J1bm5pbmcYBCABKAgSFAoMYm90X3VzZXJuYW1lGAUgASgJIlIKGVJldm9rZVRlbGVncmFtQ2hhdFJlcXVlc3QSJAoEbWV0YRgBIAEoCzIWLndhdGNoZmlyZS5SZXF1ZXN0TWV0YRIPCgdjaGF0X2lkGAIgASgDIn4KEUJlZ2luT0F1dGhSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESKgoIcHJvdmlkZXIYAiABKA4yGC53YXRjaGZpcmUuT0F1dGhQcm92aWRlchIXCg9kZWZhdWx0X2NoYW5uZWwYAyABKAkiUAoSQmVnaW5PQXV0aFJlc3BvbnNlEhUKDWF1dGhvcml6ZV91cmwYASABKAkSFAoMcmVkaXJlY3RfdXJpGAIgASgJEg0KBXN0YXRlGAMgASgJImkKFUdldE9BdXRoU3RhdHVzUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEioKCHByb3ZpZGVyGAIgASgOMhgud2F0Y2hmaXJlLk9BdXRoUHJvdmlkZXIinQEKC09BdXRoU3RhdHVzEioKCHByb3ZpZGVyGAEgASgOMhgud2F0Y2hmaXJlLk9BdXRoUHJvdmlkZXISJAoFc3RhdGUYAiABKA4yFS53YXRjaGZpcmUuT0F1dGhTdGF0ZRINCgVlcnJvchgDIAEoCRIUCgxjb25uZWN0ZWRfYXMYBCABKAkSFwoPZGVmYXVsdF9jaGFubmVsGAUgASgJImYKEkNhbmNlbE9BdXRoUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEioKCHByb3ZpZGVyGAIgASgOMhgud2F0Y2hmaXJlLk9BdXRoUHJvdmlkZXIiiAEKFVBvc3RPQXV0aEhlbGxvUmVxdWVzdBIkCgRtZXRhGAEgASgLMhYud2F0Y2hmaXJlLlJlcXVlc3RNZXRhEioKCHByb3ZpZGVyGAIgASgOMhgud2F0Y2hmaXJlLk9BdXRoUHJvdmlkZXISDwoHY2hhbm5lbBgDIAEoCRIMCgR0ZXh0GAQgASgJIjUKFlBvc3RPQXV0aEhlbGxvUmVzcG9uc2USCgoCb2sYASABKAgSDwoHbWVzc2FnZRgCIAEoCSK/BwoNSW5ib3VuZENvbmZpZxITCgtsaXN0ZW5fYWRkchgBIAEoCRISCgpwdWJsaWNfdXJsGAIgASgJEhkKEWdpdGh1Yl9zZWNyZXRfc2V0GAMgASgIEhUKDWdpdGh1Yl9zZWNyZXQYBCABKAkSGAoQc2xhY2tfc2VjcmV0X3NldBgFIAEoCBIUCgxzbGFja19zZWNyZXQYBiABKAkSHgoWZGlzY29yZF9wdWJsaWNfa2V5X3NldBgHIAEoCBIaChJkaXNjb3JkX3B1YmxpY19rZXkYCCABKAkSFgoOZGlzY29yZF9hcHBfaWQYCSABKAkSHQoVZGlzY29yZF9ib3RfdG9rZW5fc2V0GAogASgIEhkKEWRpc2NvcmRfYm90X3Rva2VuGAsgASgJEhAKCGRpc2FibGVkGAwgASgIEhoKEnJhdGVfbGltaXRfcGVyX21pbhgNIAEoBRIQCghnaXRfaG9zdBgOIAEoCRIZChFnaXRfaG9zdF9iYXNlX3VybBgPIAEoCRIZChFnaXRsYWJfc2VjcmV0X3NldBgQIAEoCBIVCg1naXRsYWJfc2VjcmV0GBEgASgJEhwKFGJpdGJ1Y2tldF9zZWNyZXRfc2V0GBIgASgIEhgKEGJpdGJ1Y2tldF9zZWNyZXQYEyABKAkSFwoPc2xhY2tfY2xpZW50X2lkGBQgASgJEh8KF3NsYWNrX2NsaWVudF9zZWNyZXRfc2V0GBUgASgIEhsKE3NsYWNrX2NsaWVudF9zZWNyZXQYFiABKAkSGwoTc2xhY2tfYm90X3Rva2VuX3NldBgXIAEoCBIXCg9zbGFja19ib3RfdG9rZW4YGCABKAkSFQoNc2xhY2tfdGVhbV9pZBgZIAEoCRIXCg9zbGFja190ZWFtX25hbWUYGiABKAkSGQoRc2xhY2tfYm90X3VzZXJfaWQYGyABKAkSGgoSc2xhY2tfYm90X3VzZXJuYW1lGBwgASgJEh0KFXNsYWNrX2RlZmF1bHRfY2hhbm5lbBgdIAEoCRIZChFkaXNjb3JkX2NsaWVudF9pZBgeIAEoCRIhChlkaXNjb3JkX2NsaWVudF9zZWNyZXRfc2V0GB8gASgIEh0KFWRpc2NvcmRfY2xpZW50X3NlY3JldBggIAEoCRIcChRkaXNjb3JkX2JvdF91c2VybmFtZRghIAEoCRIhChlkaXNjb3JkX2JvdF9kaXNjcmltaW5hdG9yGCIgASgJEh8KF2Rpc2NvcmRfZGVmYXVsdF9jaGFubmVsGCMgASgJIokDCg1JbmJvdW5kU3RhdHVzEhEKCWxpc3RlbmluZxgBIAEoCBITCgtsaXN0ZW5fYWRkchgCIAEoCRISCgpwdWJsaWNfdXJsGAMgASgJEhIKCmJpbmRfZXJyb3IYBCABKAkSIQoZbGFzdF9naXRodWJfZGVsaXZlcnlfdW5peBgFIAEoAxIgChhsYXN0X3NsYWNrX2RlbGl2ZXJ5X3VuaXgYBiABKAMSIgoabGFzdF9kaXNjb3JkX2RlbGl2ZXJ5X3VuaXgYByABKAMSDwoHdmVyc2lvbhgIIAEoCRIoCgZjb25maWcYCSABKAsyGC53YXRjaGZpcmUuSW5ib3VuZENvbmZpZxI7Cg5kaXNjb3JkX2d1aWxkcxgKIAMoCzIjLndhdGNoZmlyZS5EaXNjb3JkR3VpbGRSZWdpc3RyYXRpb24SIQoZbGFzdF9naXRsYWJfZGVsaXZlcnlfdW5peBgLIAEoAxIkChxsYXN0X2JpdGJ1Y2tldF9kZWxpdmVyeV91bml4GAwgASgDIj8KF0dldEluYm91bmRTdGF0dXNSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGEiagoYU2F2ZUluYm91bmRDb25maWdSZXF1ZXN0EiQKBG1ldGEYASABKAsyFi53YXRjaGZpcmUuUmVxdWVzdE1ldGESKAoGY29uZmlnGAIgASgLMhgud2F0Y2hmaXJlLkluYm91bmRDb25maWcifwoYRGlzY29yZEd1aWxkUmVnaXN0cmF0aW9uEhAKCGd1aWxkX2lkGAEgASgJEhIKCmd1aWxkX25hbWUYAiABKAkSEgoKcmVnaXN0ZXJlZBgDIAEoCBINCgVlcnJvchgEIAEoCRIaChJyZWdpc3RlcmVkX2F0X3VuaXgYBSABKAMqbAoLRm9jdXNUYXJnZXQSFQoRRk9DVVNfVEFSR0VUX01BSU4QABIWChJGT0NVU19UQVJHRVRfVEFTS1MQARIVChFGT0NVU19UQVJHRVRfVEFTSxACEhcKE0ZPQ1VTX1RBUkdFVF9ESUdFU1QQAyr9AQoQTm90aWZpY2F0aW9uS2luZBIPCgtUQVNLX0ZBSUxFRBAAEhAKDFJVTl9DT01QTEVURRABEg8KC1NUVUNLX0FHRU5UEAISEQoNV0VFS0xZX0RJR0VTVBADEhQKEEJVREdFVF9USFJFU0hPTEQQBBISCg5UQVNLX1NVQ0NFRURFRBAFEhAKDE1FUkdFX0ZBSUxFRBAGEg0KCVBSX09QRU5FRBAHEhQKEEFHRU5UX05FRURTX0FVVEgQCBIQCgxSQVRFX0xJTUlURUQQCRIaChZXSUxERklSRV9QSEFTRV9DSEFOR0VEEAoSEwoPVEFTS1NfR0VORVJBVEVEEAsqOQoMRXhwb3J0Rm9ybWF0EgcKA0NTVhAAEgwKCE1BUktET1dOEAESCAoESlNPThACEggKBEhUTUwQAyp8Cg9JbnRlZ3JhdGlvbktpbmQSCwoHV0VCSE9PSxAAEgkKBVNMQUNLEAESCwoHRElTQ09SRBACEgoKBkdJVEhVQhADEgwKCFRFTEVHUkFNEAQSCQoFVEVBTVMQBRIKCgZNQVRSSVgQBhIICgROVEZZEAcSCQoFRU1BSUwQCCqKAQoUVGVsZWdyYW1QYWlyaW5nU3RhdGUSGQoVVEVMRUdSQU1fUEFJUklOR19OT05FEAASHAoYVEVMRUdSQU1fUEFJUklOR19QRU5ESU5HEAESGwoXVEVMRUdSQU1fUEFJUklOR19QQUlSRUQQAhIcChhURUxFR1JBTV9QQUlSSU5HX0VYUElSRUQQAypfCg1PQXV0aFByb3ZpZGVyEhgKFE9BVVRIX1BST1ZJREVSX1VOU0VUEAASGAoUT0FVVEhfUFJPVklERVJfU0xBQ0sQARIaChZPQVVUSF9QUk9WSURFUl9ESVNDT1JEEAIqcQoKT0F1dGhTdGF0ZRIUChBPQVVUSF9TVEFURV9JRExFEAASGwoXT0FVVEhfU1RBVEVfSU5fUFJPR1JFU1MQARIZChVPQVVUSF9TVEFURV9DT05ORUNURUQQAhIVChFPQVVUSF9TVEFURV9FUlJPUhADMtsGCg5Qcm9qZWN0U2VydmljZRI+CgxMaXN0UHJvamVjdHMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi53YXRjaGZpcmUuUHJvamVjdExpc3QSNgoKR2V0UHJvamVjdBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaEi53YXRjaGZpcmUuUHJvamVjdBJECg1DcmVhdGVQcm9qZWN0Eh8ud2F0Y2hmaXJlLkNyZWF0ZVByb2plY3RSZXF1ZXN0GhIud2F0Y2hmaXJlLlByb2plY3QSRAoNVXBkYXRlUHJvamVjdBIfLndhdGNoZmlyZS5VcGRhdGVQcm9qZWN0UmVxdWVzdBoSLndhdGNoZmlyZS5Qcm9qZWN0Ej0KDURlbGV0ZVByb2plY3QSFC53YXRjaGZpcmUuUHJvamVjdElkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EjYKCkdldEdpdEluZm8SFC53YXRjaGZpcmUuUHJvamVjdElkGhIud2F0Y2hmaXJlLkdpdEluZm8STAoPUmVvcmRlclByb2plY3RzEiEud2F0Y2hmaXJlLlJlb3JkZXJQcm9qZWN0c1JlcXVlc3QaFi53YXRjaGZpcmUuUHJvamVjdExpc3QSPwoTUmVnZW5lcmF0ZVByb2plY3RJZBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaEi53YXRjaGZpcmUuUHJvamVjdBI+ChJSZXNldFRhc2tOdW1iZXJpbmcSFC53YXRjaGZpcmUuUHJvamVjdElkGhIud2F0Y2hmaXJlLlByb2plY3QSQQoRVW5yZWdpc3RlclByb2plY3QSFC53YXRjaGZpcmUuUHJvamVjdElkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElYKFFNldEdpdEh1YkF1dG9QUlNjb3BlEiYud2F0Y2hmaXJlLlNldEdpdEh1YkF1dG9QUlNjb3BlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJkCh1TZXRQcm9qZWN0SW50ZWdyYXRpb25CaW5kaW5ncxIvLndhdGNoZmlyZS5TZXRQcm9qZWN0SW50ZWdyYXRpb25CaW5kaW5nc1JlcXVlc3QaEi53YXRjaGZpcmUuUHJvamVjdDLlBwoLVGFza1NlcnZpY2USPQoJTGlzdFRhc2tzEhsud2F0Y2hmaXJlLkxpc3RUYXNrc1JlcXVlc3QaEy53YXRjaGZpcmUuVGFza0xpc3QSWAoSTGlzdE1hbGZvcm1lZFRhc2tzEiQud2F0Y2hmaXJlLkxpc3RNYWxmb3JtZWRUYXNrc1JlcXVlc3QaHC53YXRjaGZpcmUuTWFsZm9ybWVkVGFza0xpc3QSLQoHR2V0VGFzaxIRLndhdGNoZmlyZS5UYXNrSWQaDy53YXRjaGZpcmUuVGFzaxI7CgpDcmVhdGVUYXNrEhwud2F0Y2hmaXJlLkNyZWF0ZVRhc2tSZXF1ZXN0Gg8ud2F0Y2hmaXJlLlRhc2sSOwoKVXBkYXRlVGFzaxIcLndhdGNoZmlyZS5VcGRhdGVUYXNrUmVxdWVzdBoPLndhdGNoZmlyZS5UYXNrEjAKCkRlbGV0ZVRhc2sSES53YXRjaGZpcmUuVGFza0lkGg8ud2F0Y2hmaXJlLlRhc2sSMQoLUmVzdG9yZVRhc2sSES53YXRjaGZpcmUuVGFza0lkGg8ud2F0Y2hmaXJlLlRhc2sSQAoTUGVybWFuZW50RGVsZXRlVGFzaxIRLndhdGNoZmlyZS5UYXNrSWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSOgoKRW1wdHlUcmFzaBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSSwoQQnVsa1VwZGF0ZVN0YXR1cxIiLndhdGNoZmlyZS5CdWxrVXBkYXRlU3RhdHVzUmVxdWVzdBoTLndhdGNoZmlyZS5UYXNrTGlzdBI/CgpCdWxrRGVsZXRlEhwud2F0Y2hmaXJlLkJ1bGtEZWxldGVSZXF1ZXN0GhMud2F0Y2hmaXJlLlRhc2tMaXN0EkEKC0J1bGtSZXN0b3JlEh0ud2F0Y2hmaXJlLkJ1bGtSZXN0b3JlUmVxdWVzdBoTLndhdGNoZmlyZS5UYXNrTGlzdBJDCgxSZW9yZGVyVGFza3MSHi53YXRjaGZpcmUuUmVvcmRlclRhc2tzUmVxdWVzdBoTLndhdGNoZmlyZS5UYXNrTGlzdBJLChBDcmVhdGVUYXNrc0JhdGNoEiIud2F0Y2hmaXJlLkNyZWF0ZVRhc2tzQmF0Y2hSZXF1ZXN0GhMud2F0Y2hmaXJlLlRhc2tMaXN0Ek4KFEFyY2hpdmVSZXRyb2ZpdFRhc2tzEiEud2F0Y2hmaXJlLkFyY2hpdmVSZXRyb2ZpdFJlcXVlc3QaEy53YXRjaGZpcmUuVGFza0xpc3Qy0QIKDURhZW1vblNlcnZpY2USPAoJR2V0U3RhdHVzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ghcud2F0Y2hmaXJlLkRhZW1vblN0YXR1cxI6CghTaHV0ZG93bhIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRI2CgRQaW5nEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElcKFFN1YnNjcmliZUZvY3VzRXZlbnRzEiYud2F0Y2hmaXJlLlN1YnNjcmliZUZvY3VzRXZlbnRzUmVxdWVzdBoVLndhdGNoZmlyZS5Gb2N1c0V2ZW50MAESNQoFUnVuR0MSFy53YXRjaGZpcmUuUnVuR0NSZXF1ZXN0GhMud2F0Y2hmaXJlLkdDUmVwb3J0MrIDCgpMb2dTZXJ2aWNlEjoKCExpc3RMb2dzEhoud2F0Y2hmaXJlLkxpc3RMb2dzUmVxdWVzdBoSLndhdGNoZmlyZS5Mb2dMaXN0EjkKBkdldExvZxIYLndhdGNoZmlyZS5HZXRMb2dSZXF1ZXN0GhUud2F0Y2hmaXJlLkxvZ0NvbnRlbnQSQAoJRGVsZXRlTG9nEhsud2F0Y2hmaXJlLkRlbGV0ZUxvZ1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSSwoMR2V0UmVjb3JkaW5nEh4ud2F0Y2hmaXJlLkdldFJlY29yZGluZ1JlcXVlc3QaGS53YXRjaGZpcmUuUmVjb3JkaW5nQ2h1bmswARJJCgpTZWFyY2hMb2dzEhwud2F0Y2hmaXJlLlNlYXJjaExvZ3NSZXF1ZXN0Gh0ud2F0Y2hmaXJlLlNlYXJjaExvZ3NSZXNwb25zZRJTChBHZXRTZXNzaW9uRXZlbnRzEiIud2F0Y2hmaXJlLkdldFNlc3Npb25FdmVudHNSZXF1ZXN0Ghsud2F0Y2hmaXJlLlNlc3Npb25FdmVudExpc3QyogYKDEFnZW50U2VydmljZRJCCgpTdGFydEFnZW50Ehwud2F0Y2hmaXJlLlN0YXJ0QWdlbnRSZXF1ZXN0GhYud2F0Y2hmaXJlLkFnZW50U3RhdHVzEjkKCVN0b3BBZ2VudBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSPgoOR2V0QWdlbnRTdGF0dXMSFC53YXRjaGZpcmUuUHJvamVjdElkGhYud2F0Y2hmaXJlLkFnZW50U3RhdHVzEk8KD1N1YnNjcmliZVNjcmVlbhIhLndhdGNoZmlyZS5TdWJzY3JpYmVTY3JlZW5SZXF1ZXN0Ghcud2F0Y2hmaXJlLlNjcmVlbkJ1ZmZlcjABEkkKDUdldFNjcm9sbGJhY2sSHC53YXRjaGZpcmUuU2Nyb2xsYmFja1JlcXVlc3QaGi53YXRjaGZpcmUuU2Nyb2xsYmFja0xpbmVzEkAKCVNlbmRJbnB1dBIbLndhdGNoZmlyZS5TZW5kSW5wdXRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EjoKBlJlc2l6ZRIYLndhdGNoZmlyZS5SZXNpemVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElcKElN1YnNjcmliZVJhd091dHB1dBIkLndhdGNoZmlyZS5TdWJzY3JpYmVSYXdPdXRwdXRSZXF1ZXN0Ghkud2F0Y2hmaXJlLlJhd091dHB1dENodW5rMAESVwoUU3Vic2NyaWJlQWdlbnRJc3N1ZXMSJi53YXRjaGZpcmUuU3Vic2NyaWJlQWdlbnRJc3N1ZXNSZXF1ZXN0GhUud2F0Y2hmaXJlLkFnZW50SXNzdWUwARI7CgtSZXN1bWVBZ2VudBIULndhdGNoZmlyZS5Qcm9qZWN0SWQaFi53YXRjaGZpcmUuQWdlbnRTdGF0dXMSSgoOQW5zd2VyUXVlc3Rpb24SIC53YXRjaGZpcmUuQW5zd2VyUXVlc3Rpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5MsMDCg1CcmFuY2hTZXJ2aWNlEjsKDExpc3RCcmFuY2hlcxIULndhdGNoZmlyZS5Qcm9qZWN0SWQaFS53YXRjaGZpcmUuQnJhbmNoTGlzdBIzCglHZXRCcmFuY2gSEy53YXRjaGZpcmUuQnJhbmNoSWQaES53YXRjaGZpcmUuQnJhbmNoEj8KC01lcmdlQnJhbmNoEh0ud2F0Y2hmaXJlLk1lcmdlQnJhbmNoUmVxdWVzdBoRLndhdGNoZmlyZS5CcmFuY2gSOwoMRGVsZXRlQnJhbmNoEhMud2F0Y2hmaXJlLkJyYW5jaElkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EjwKDVBydW5lQnJhbmNoZXMSFC53YXRjaGZpcmUuUHJvamVjdElkGhUud2F0Y2hmaXJlLkJyYW5jaExpc3QSQAoJQnVsa01lcmdlEhwud2F0Y2hmaXJlLkJ1bGtCcmFuY2hSZXF1ZXN0GhUud2F0Y2hmaXJlLkJyYW5jaExpc3QSQgoKQnVsa0RlbGV0ZRIcLndhdGNoZmlyZS5CdWxrQnJhbmNoUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eTL0AgoPU2V0dGluZ3NTZXJ2aWNlEjoKC0dldFNldHRpbmdzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhMud2F0Y2hmaXJlLlNldHRpbmdzEkcKDlVwZGF0ZVNldHRpbmdzEiAud2F0Y2hmaXJlLlVwZGF0ZVNldHRpbmdzUmVxdWVzdBoTLndhdGNoZmlyZS5TZXR0aW5ncxI6CgpMaXN0QWdlbnRzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhQud2F0Y2hmaXJlLkFnZW50TGlzdBJMChJHZXRNY3BDbGllbnRTdGF0dXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHi53YXRjaGZpcmUuTWNwQ2xpZW50U3RhdHVzTGlzdBJSChBJbnN0YWxsTWNwQ2xpZW50EiIud2F0Y2hmaXJlLkluc3RhbGxNY3BDbGllbnRSZXF1ZXN0Ghoud2F0Y2hmaXJlLk1jcENsaWVudFN0YXR1czJnChNOb3RpZmljYXRpb25TZXJ2aWNlElAKCVN1YnNjcmliZRIoLndhdGNoZmlyZS5TdWJzY3JpYmVOb3RpZmljYXRpb25zUmVxdWVzdBoXLndhdGNoZmlyZS5Ob3RpZmljYXRpb24wATKYAwoPSW5zaWdodHNTZXJ2aWNlEk8KDEV4cG9ydFJlcG9ydBIeLndhdGNoZmlyZS5FeHBvcnRSZXBvcnRSZXF1ZXN0Gh8ud2F0Y2hmaXJlLkV4cG9ydFJlcG9ydFJlc3BvbnNlElMKEUdldEdsb2JhbEluc2lnaHRzEiMud2F0Y2hmaXJlLkdldEdsb2JhbEluc2lnaHRzUmVxdWVzdBoZLndhdGNoZmlyZS5HbG9iYWxJbnNpZ2h0cxJWChJHZXRQcm9qZWN0SW5zaWdodHMSJC53YXRjaGZpcmUuR2V0UHJvamVjdEluc2lnaHRzUmVxdWVzdBoaLndhdGNoZmlyZS5Qcm9qZWN0SW5zaWdodHMSRAoLR2V0VGFza0RpZmYSHS53YXRjaGZpcmUuR2V0VGFza0RpZmZSZXF1ZXN0GhYud2F0Y2hmaXJlLkZpbGVEaWZmU2V0EkEKC0dldEhvdHNwb3RzEh0ud2F0Y2hmaXJlLkdldEhvdHNwb3RzUmVxdWVzdBoTLndhdGNoZmlyZS5Ib3RzcG90czLVCwoTSW50ZWdyYXRpb25zU2VydmljZRJVChBMaXN0SW50ZWdyYXRpb25zEiIud2F0Y2hmaXJlLkxpc3RJbnRlZ3JhdGlvbnNSZXF1ZXN0Gh0ud2F0Y2hmaXJlLkludGVncmF0aW9uc0NvbmZpZxJTCg9TYXZlSW50ZWdyYXRpb24SIS53YXRjaGZpcmUuU2F2ZUludGVncmF0aW9uUmVxdWVzdBodLndhdGNoZmlyZS5JbnRlZ3JhdGlvbnNDb25maWcSVwoRRGVsZXRlSW50ZWdyYXRpb24SIy53YXRjaGZpcmUuRGVsZXRlSW50ZWdyYXRpb25SZXF1ZXN0Gh0ud2F0Y2hmaXJlLkludGVncmF0aW9uc0NvbmZpZxJYCg9UZXN0SW50ZWdyYXRpb24SIS53YXRjaGZpcmUuVGVzdEludGVncmF0aW9uUmVxdWVzdBoiLndhdGNoZmlyZS5UZXN0SW50ZWdyYXRpb25SZXNwb25zZRJQChBHZXRJbmJvdW5kU3RhdHVzEiIud2F0Y2hmaXJlLkdldEluYm91bmRTdGF0dXNSZXF1ZXN0Ghgud2F0Y2hmaXJlLkluYm91bmRTdGF0dXMSUgoRU2F2ZUluYm91bmRDb25maWcSIy53YXRjaGZpcmUuU2F2ZUluYm91bmRDb25maWdSZXF1ZXN0Ghgud2F0Y2hmaXJlLkluYm91bmRTdGF0dXMSSQoKQmVnaW5PQXV0aBIcLndhdGNoZmlyZS5CZWdpbk9BdXRoUmVxdWVzdBodLndhdGNoZmlyZS5CZWdpbk9BdXRoUmVzcG9uc2USSgoOR2V0T0F1dGhTdGF0dXMSIC53YXRjaGZpcmUuR2V0T0F1dGhTdGF0dXNSZXF1ZXN0GhYud2F0Y2hmaXJlLk9BdXRoU3RhdHVzEkQKC0NhbmNlbE9BdXRoEh0ud2F0Y2hmaXJlLkNhbmNlbE9BdXRoUmVxdWVzdBoWLndhdGNoZmlyZS5PQXV0aFN0YXR1cxJVCg5Qb3N0T0F1dGhIZWxsbxIgLndhdGNoZmlyZS5Qb3N0T0F1dGhIZWxsb1JlcXVlc3QaIS53YXRjaGZpcmUuUG9zdE9BdXRoSGVsbG9SZXNwb25zZRJnChRCZWdpblRlbGVncmFtUGFpcmluZxImLndhdGNoZmlyZS5CZWdpblRlbGVncmFtUGFpcmluZ1JlcXVlc3QaJy53YXRjaGZpcmUuQmVnaW5UZWxlZ3JhbVBhaXJpbmdSZXNwb25zZRJoChhHZXRUZWxlZ3JhbVBhaXJpbmdTdGF0dXMSKi53YXRjaGZpcmUuR2V0VGVsZWdyYW1QYWlyaW5nU3RhdHVzUmVxdWVzdBogLndhdGNoZmlyZS5UZWxlZ3JhbVBhaXJpbmdTdGF0dXMSWQoSUmV2b2tlVGVsZWdyYW1DaGF0EiQud2F0Y2hmaXJlLlJldm9rZVRlbGVncmFtQ2hhdFJlcXVlc3QaHS53YXRjaGZpcmUuSW50ZWdyYXRpb25zQ29uZmlnEmcKFExpc3RGYWlsZWREZWxpdmVyaWVzEiYud2F0Y2hmaXJlLkxpc3RGYWlsZWREZWxpdmVyaWVzUmVxdWVzdBonLndhdGNoZmlyZS5MaXN0RmFpbGVkRGVsaXZlcmllc1Jlc3BvbnNlEkwKDlJlcGxheURlbGl2ZXJ5EiAud2F0Y2hmaXJlLlJlcGxheURlbGl2ZXJ5UmVxdWVzdBoYLndhdGNoZmlyZS5SZWxheURlbGl2ZXJ5ElgKD1ByZXZpZXdUZW1wbGF0ZRIhLndhdGNoZmlyZS5QcmV2aWV3VGVtcGxhdGVSZXF1ZXN0GiIud2F0Y2hmaXJlLlByZXZpZXdUZW1wbGF0ZVJlc3BvbnNlEkYKCVRlc3RSb3V0ZRIbLndhdGNoZmlyZS5UZXN0Um91dGVSZXF1ZXN0Ghwud2F0Y2hmaXJlLlRlc3RSb3V0ZVJlc3BvbnNlQilaJ2dpdGh1Yi5jb20vd2F0Y2hmaXJlLWlvL3dhdGNoZmlyZS9wcm90b2IGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_empty]);
 
 /**
  * RequestMeta is included in every request for tracking and analytics
@@ -40,6 +40,15 @@ export type RequestMeta = Message<"watchfire.RequestMeta"> & {
    * @generated from field: string version = 3;
    */
   version: string;
+
+  /**
+   * Who is acting, for task attribution: the git user for local clients,
+   * "<transport>:<id>" for remote ones ("mcp:claude-desktop"). The daemon
+   * fills in its own local user when a local client leaves it empty.
+   *
+   * @generated from field: string user = 4;
+   */
+  user: string;
 };
 
 /**
@@ -223,7 +232,7 @@ export type ProjectNotifications = Message<"watchfire.ProjectNotifications"> & {
   overrideEvents: boolean;
 
   /**
-   * v6: keys "task_failed" / "run_complete" / "weekly_digest"
+   * v6: keyed by event ("task_failed", "merge_failed", …)
    *
    * @generated from field: map<string, watchfire.ProjectEventPref> events = 3;
    */
@@ -630,6 +639,20 @@ export type Task = Message<"watchfire.Task"> & {
    * @generated from field: optional string merge_failure_reason = 18;
    */
   mergeFailureReason?: string;
+
+  /**
+   * Who added the task; empty when unknown
+   *
+   * @generated from field: string created_by = 19;
+   */
+  createdBy: string;
+
+  /**
+   * Who last started an agent on it; empty when unknown
+   *
+   * @generated from field: string started_by = 20;
+   */
+  startedBy: string;
 };
 
 /**
@@ -1288,6 +1311,13 @@ export type StartAgentRequest = Message<"watchfire.StartAgentRequest"> & {
    * @generated from field: string sandbox = 7;
    */
   sandbox: string;
+
+  /**
+   * Start even if a hard-stop monthly budget is spent (chat is never refused)
+   *
+   * @generated from field: bool override_budget = 8;
+   */
+  overrideBudget: boolean;
 };
 
 /**
@@ -1298,6 +1328,14 @@ export const StartAgentRequestSchema: GenMessage<StartAgentRequest> = /*@__PURE_
   messageDesc(file_watchfire, 28);
 
 /**
+ * ScreenBuffer is one coalesced frame of the agent's screen. Clients that
+ * set SubscribeScreenRequest.deltas get a keyframe first (keyframe=true,
+ * lines / ansi_content carry the whole screen) and row deltas afterwards;
+ * every frame bumps seq by one. A keyframe can arrive mid-stream (resize,
+ * or the client fell behind and the daemon resynced it) and always
+ * replaces local state. Clients that don't opt in keep receiving full
+ * frames and can ignore fields 8-10.
+ *
  * @generated from message watchfire.ScreenBuffer
  */
 export type ScreenBuffer = Message<"watchfire.ScreenBuffer"> & {
@@ -1337,6 +1375,27 @@ export type ScreenBuffer = Message<"watchfire.ScreenBuffer"> & {
    * @generated from field: string ansi_content = 7;
    */
   ansiContent: string;
+
+  /**
+   * Frame sequence number, monotonic per agent session
+   *
+   * @generated from field: uint64 seq = 8;
+   */
+  seq: bigint;
+
+  /**
+   * lines / ansi_content hold the full screen
+   *
+   * @generated from field: bool keyframe = 9;
+   */
+  keyframe: boolean;
+
+  /**
+   * Changed rows (delta frames only)
+   *
+   * @generated from field: repeated watchfire.ScreenRowDelta row_deltas = 10;
+   */
+  rowDeltas: ScreenRowDelta[];
 };
 
 /**
@@ -1346,6 +1405,41 @@ export type ScreenBuffer = Message<"watchfire.ScreenBuffer"> & {
 export const ScreenBufferSchema: GenMessage<ScreenBuffer> = /*@__PURE__*/
   messageDesc(file_watchfire, 29);
 
+/**
+ * ScreenRowDelta replaces one row of the client's screen.
+ *
+ * @generated from message watchfire.ScreenRowDelta
+ */
+export type ScreenRowDelta = Message<"watchfire.ScreenRowDelta"> & {
+  /**
+   * 0-based screen row
+   *
+   * @generated from field: int32 row = 1;
+   */
+  row: number;
+
+  /**
+   * Plain text
+   *
+   * @generated from field: string line = 2;
+   */
+  line: string;
+
+  /**
+   * Same row as ANSI SGR text
+   *
+   * @generated from field: string ansi = 3;
+   */
+  ansi: string;
+};
+
+/**
+ * Describes the message watchfire.ScreenRowDelta.
+ * Use `create(ScreenRowDeltaSchema)` to create a new message.
+ */
+export const ScreenRowDeltaSchema: GenMessage<ScreenRowDelta> = /*@__PURE__*/
+  messageDesc(file_watchfire, 30);
+
 /**
  * @generated from message watchfire.SubscribeScreenRequest
  */
@@ -1359,6 +1453,13 @@ export type SubscribeScreenRequest = Message<"watchfire.SubscribeScreenRequest">
    * @generated from field: string project_id = 2;
    */
   projectId: string;
+
+  /**
+   * Opt in to keyframe + row-delta frames
+   *
+   * @generated from field: bool deltas = 3;
+   */
+  deltas: boolean;
 };
 
 /**
@@ -1366,7 +1467,7 @@ export type SubscribeScreenRequest = Message<"watchfire.SubscribeScreenRequest">
  * Use `create(SubscribeScreenRequestSchema)` to create a new message.
  */
 export const SubscribeScreenRequestSchema: GenMessage<SubscribeScreenRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 30);
+  messageDesc(file_watchfire, 31);
 
 /**
  * @generated from message watchfire.ScrollbackRequest
@@ -1398,7 +1499,7 @@ export type ScrollbackRequest = Message<"watchfire.ScrollbackRequest"> & {
  * Use `create(ScrollbackRequestSchema)` to create a new message.
  */
 export const ScrollbackRequestSchema: GenMessage<ScrollbackRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 31);
+  messageDesc(file_watchfire, 32);
 
 /**
  * @generated from message watchfire.ScrollbackLines
@@ -1420,7 +1521,7 @@ export type ScrollbackLines = Message<"watchfire.ScrollbackLines"> & {
  * Use `create(ScrollbackLinesSchema)` to create a new message.
  */
 export const ScrollbackLinesSchema: GenMessage<ScrollbackLines> = /*@__PURE__*/
-  messageDesc(file_watchfire, 32);
+  messageDesc(file_watchfire, 33);
 
 /**
  * @generated from message watchfire.SendInputRequest
@@ -1447,7 +1548,7 @@ export type SendInputRequest = Message<"watchfire.SendInputRequest"> & {
  * Use `create(SendInputRequestSchema)` to create a new message.
  */
 export const SendInputRequestSchema: GenMessage<SendInputRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 33);
+  messageDesc(file_watchfire, 34);
 
 /**
  * @generated from message watchfire.ResizeRequest
@@ -1479,7 +1580,7 @@ export type ResizeRequest = Message<"watchfire.ResizeRequest"> & {
  * Use `create(ResizeRequestSchema)` to create a new message.
  */
 export const ResizeRequestSchema: GenMessage<ResizeRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 34);
+  messageDesc(file_watchfire, 35);
 
 /**
  * @generated from message watchfire.SubscribeRawOutputRequest
@@ -1513,7 +1614,7 @@ export type SubscribeRawOutputRequest = Message<"watchfire.SubscribeRawOutputReq
  * Use `create(SubscribeRawOutputRequestSchema)` to create a new message.
  */
 export const SubscribeRawOutputRequestSchema: GenMessage<SubscribeRawOutputRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 35);
+  messageDesc(file_watchfire, 36);
 
 /**
  * @generated from message watchfire.RawOutputChunk
@@ -1535,14 +1636,14 @@ export type RawOutputChunk = Message<"watchfire.RawOutputChunk"> & {
  * Use `create(RawOutputChunkSchema)` to create a new message.
  */
 export const RawOutputChunkSchema: GenMessage<RawOutputChunk> = /*@__PURE__*/
-  messageDesc(file_watchfire, 36);
+  messageDesc(file_watchfire, 37);
 
 /**
  * @generated from message watchfire.AgentIssue
  */
 export type AgentIssue = Message<"watchfire.AgentIssue"> & {
   /**
-   * "auth_required" | "rate_limited" | ""
+   * "auth_required" | "rate_limited" | "awaiting_input" | ""
    *
    * @generated from field: string issue_type = 1;
    */
@@ -1554,6 +1655,8 @@ export type AgentIssue = Message<"watchfire.AgentIssue"> & {
   detectedAt?: Timestamp;
 
   /**
+   * The question, for awaiting_input
+   *
    * @generated from field: string message = 3;
    */
   message: string;
@@ -1571,6 +1674,13 @@ export type AgentIssue = Message<"watchfire.AgentIssue"> & {
    * @generated from field: optional google.protobuf.Timestamp cooldown_until = 5;
    */
   cooldownUntil?: Timestamp;
+
+  /**
+   * Suggested answers (awaiting_input)
+   *
+   * @generated from field: repeated string options = 6;
+   */
+  options: string[];
 };
 
 /**
@@ -1578,7 +1688,43 @@ export type AgentIssue = Message<"watchfire.AgentIssue"> & {
  * Use `create(AgentIssueSchema)` to create a new message.
  */
 export const AgentIssueSchema: GenMessage<AgentIssue> = /*@__PURE__*/
-  messageDesc(file_watchfire, 37);
+  messageDesc(file_watchfire, 38);
+
+/**
+ * AnswerQuestionRequest answers the question a working agent is waiting
+ * on (issue_type "awaiting_input"). option, when > 0, picks the 1-based
+ * suggested answer and overrides answer.
+ *
+ * @generated from message watchfire.AnswerQuestionRequest
+ */
+export type AnswerQuestionRequest = Message<"watchfire.AnswerQuestionRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string answer = 3;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: int32 option = 4;
+   */
+  option: number;
+};
+
+/**
+ * Describes the message watchfire.AnswerQuestionRequest.
+ * Use `create(AnswerQuestionRequestSchema)` to create a new message.
+ */
+export const AnswerQuestionRequestSchema: GenMessage<AnswerQuestionRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 39);
 
 /**
  * @generated from message watchfire.SubscribeAgentIssuesRequest
@@ -1600,7 +1746,7 @@ export type SubscribeAgentIssuesRequest = Message<"watchfire.SubscribeAgentIssue
  * Use `create(SubscribeAgentIssuesRequestSchema)` to create a new message.
  */
 export const SubscribeAgentIssuesRequestSchema: GenMessage<SubscribeAgentIssuesRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 38);
+  messageDesc(file_watchfire, 40);
 
 /**
  * @generated from message watchfire.Branch
@@ -1652,7 +1798,7 @@ export type Branch = Message<"watchfire.Branch"> & {
  * Use `create(BranchSchema)` to create a new message.
  */
 export const BranchSchema: GenMessage<Branch> = /*@__PURE__*/
-  messageDesc(file_watchfire, 39);
+  messageDesc(file_watchfire, 41);
 
 /**
  * @generated from message watchfire.BranchList
@@ -1669,7 +1815,7 @@ export type BranchList = Message<"watchfire.BranchList"> & {
  * Use `create(BranchListSchema)` to create a new message.
  */
 export const BranchListSchema: GenMessage<BranchList> = /*@__PURE__*/
-  messageDesc(file_watchfire, 40);
+  messageDesc(file_watchfire, 42);
 
 /**
  * @generated from message watchfire.BranchId
@@ -1703,7 +1849,7 @@ export type BranchId = Message<"watchfire.BranchId"> & {
  * Use `create(BranchIdSchema)` to create a new message.
  */
 export const BranchIdSchema: GenMessage<BranchId> = /*@__PURE__*/
-  messageDesc(file_watchfire, 41);
+  messageDesc(file_watchfire, 43);
 
 /**
  * @generated from message watchfire.MergeBranchRequest
@@ -1735,7 +1881,7 @@ export type MergeBranchRequest = Message<"watchfire.MergeBranchRequest"> & {
  * Use `create(MergeBranchRequestSchema)` to create a new message.
  */
 export const MergeBranchRequestSchema: GenMessage<MergeBranchRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 42);
+  messageDesc(file_watchfire, 44);
 
 /**
  * @generated from message watchfire.BulkBranchRequest
@@ -1762,7 +1908,7 @@ export type BulkBranchRequest = Message<"watchfire.BulkBranchRequest"> & {
  * Use `create(BulkBranchRequestSchema)` to create a new message.
  */
 export const BulkBranchRequestSchema: GenMessage<BulkBranchRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 43);
+  messageDesc(file_watchfire, 45);
 
 /**
  * @generated from message watchfire.AgentConfig
@@ -1781,7 +1927,7 @@ export type AgentConfig = Message<"watchfire.AgentConfig"> & {
  * Use `create(AgentConfigSchema)` to create a new message.
  */
 export const AgentConfigSchema: GenMessage<AgentConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 44);
+  messageDesc(file_watchfire, 46);
 
 /**
  * @generated from message watchfire.DefaultsConfig
@@ -1830,7 +1976,7 @@ export type DefaultsConfig = Message<"watchfire.DefaultsConfig"> & {
  * Use `create(DefaultsConfigSchema)` to create a new message.
  */
 export const DefaultsConfigSchema: GenMessage<DefaultsConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 45);
+  messageDesc(file_watchfire, 47);
 
 /**
  * @generated from message watchfire.NotificationsEvents
@@ -1859,7 +2005,7 @@ export type NotificationsEvents = Message<"watchfire.NotificationsEvents"> & {
  * Use `create(NotificationsEventsSchema)` to create a new message.
  */
 export const NotificationsEventsSchema: GenMessage<NotificationsEvents> = /*@__PURE__*/
-  messageDesc(file_watchfire, 46);
+  messageDesc(file_watchfire, 48);
 
 /**
  * @generated from message watchfire.NotificationsSounds
@@ -1893,7 +2039,7 @@ export type NotificationsSounds = Message<"watchfire.NotificationsSounds"> & {
  * Use `create(NotificationsSoundsSchema)` to create a new message.
  */
 export const NotificationsSoundsSchema: GenMessage<NotificationsSounds> = /*@__PURE__*/
-  messageDesc(file_watchfire, 47);
+  messageDesc(file_watchfire, 49);
 
 /**
  * @generated from message watchfire.QuietHoursConfig
@@ -1924,7 +2070,7 @@ export type QuietHoursConfig = Message<"watchfire.QuietHoursConfig"> & {
  * Use `create(QuietHoursConfigSchema)` to create a new message.
  */
 export const QuietHoursConfigSchema: GenMessage<QuietHoursConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 48);
+  messageDesc(file_watchfire, 50);
 
 /**
  * @generated from message watchfire.NotificationsConfig
@@ -1965,7 +2111,7 @@ export type NotificationsConfig = Message<"watchfire.NotificationsConfig"> & {
  * Use `create(NotificationsConfigSchema)` to create a new message.
  */
 export const NotificationsConfigSchema: GenMessage<NotificationsConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 49);
+  messageDesc(file_watchfire, 51);
 
 /**
  * @generated from message watchfire.UpdatesConfig
@@ -1994,7 +2140,7 @@ export type UpdatesConfig = Message<"watchfire.UpdatesConfig"> & {
  * Use `create(UpdatesConfigSchema)` to create a new message.
  */
 export const UpdatesConfigSchema: GenMessage<UpdatesConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 50);
+  messageDesc(file_watchfire, 52);
 
 /**
  * @generated from message watchfire.AppearanceConfig
@@ -2013,1048 +2159,900 @@ export type AppearanceConfig = Message<"watchfire.AppearanceConfig"> & {
  * Use `create(AppearanceConfigSchema)` to create a new message.
  */
 export const AppearanceConfigSchema: GenMessage<AppearanceConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 51);
+  messageDesc(file_watchfire, 53);
 
 /**
- * @generated from message watchfire.Settings
+ * @generated from message watchfire.RecordingsConfig
  */
-export type Settings = Message<"watchfire.Settings"> & {
+export type RecordingsConfig = Message<"watchfire.RecordingsConfig"> & {
   /**
-   * @generated from field: int32 version = 1;
-   */
-  version: number;
-
-  /**
-   * @generated from field: map<string, watchfire.AgentConfig> agents = 2;
-   */
-  agents: { [key: string]: AgentConfig };
-
-  /**
-   * @generated from field: watchfire.DefaultsConfig defaults = 3;
-   */
-  defaults?: DefaultsConfig;
-
-  /**
-   * @generated from field: watchfire.UpdatesConfig updates = 4;
+   * Record agent sessions as asciicast
+   *
+   * @generated from field: bool enabled = 1;
    */
-  updates?: UpdatesConfig;
+  enabled: boolean;
 
   /**
-   * @generated from field: watchfire.AppearanceConfig appearance = 5;
+   * 0 = keep regardless of age
+   *
+   * @generated from field: int32 max_age_days = 2;
    */
-  appearance?: AppearanceConfig;
+  maxAgeDays: number;
 
   /**
-   * @generated from field: string installation_id = 6;
+   * 0 = no cap
+   *
+   * @generated from field: int32 max_per_project = 3;
    */
-  installationId: string;
+  maxPerProject: number;
 };
 
 /**
- * Describes the message watchfire.Settings.
- * Use `create(SettingsSchema)` to create a new message.
+ * Describes the message watchfire.RecordingsConfig.
+ * Use `create(RecordingsConfigSchema)` to create a new message.
  */
-export const SettingsSchema: GenMessage<Settings> = /*@__PURE__*/
-  messageDesc(file_watchfire, 52);
+export const RecordingsConfigSchema: GenMessage<RecordingsConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 54);
 
 /**
- * @generated from message watchfire.UpdateSettingsRequest
+ * @generated from message watchfire.RetentionConfig
  */
-export type UpdateSettingsRequest = Message<"watchfire.UpdateSettingsRequest"> & {
+export type RetentionConfig = Message<"watchfire.RetentionConfig"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * Run the periodic janitor (watchfire gc works regardless)
+   *
+   * @generated from field: bool enabled = 1;
    */
-  meta?: RequestMeta;
+  enabled: boolean;
 
   /**
-   * @generated from field: optional watchfire.DefaultsConfig defaults = 2;
+   * Session logs and cache files; 0 = unlimited
+   *
+   * @generated from field: int32 max_age_days = 2;
    */
-  defaults?: DefaultsConfig;
+  maxAgeDays: number;
 
   /**
-   * @generated from field: optional watchfire.UpdatesConfig updates = 3;
+   * Newest N session logs kept per project; 0 = unlimited
+   *
+   * @generated from field: int32 max_logs = 3;
    */
-  updates?: UpdatesConfig;
+  maxLogs: number;
 
   /**
-   * @generated from field: optional watchfire.AppearanceConfig appearance = 4;
+   * Total session-log size per project; 0 = unlimited
+   *
+   * @generated from field: int32 max_size_mb = 4;
    */
-  appearance?: AppearanceConfig;
+  maxSizeMb: number;
 
   /**
-   * Merge into existing
+   * watchfire/* branches of done/deleted tasks; 0 = never delete
    *
-   * @generated from field: map<string, watchfire.AgentConfig> agents = 5;
+   * @generated from field: int32 branch_max_age_days = 5;
    */
-  agents: { [key: string]: AgentConfig };
+  branchMaxAgeDays: number;
 };
 
 /**
- * Describes the message watchfire.UpdateSettingsRequest.
- * Use `create(UpdateSettingsRequestSchema)` to create a new message.
+ * Describes the message watchfire.RetentionConfig.
+ * Use `create(RetentionConfigSchema)` to create a new message.
  */
-export const UpdateSettingsRequestSchema: GenMessage<UpdateSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 53);
+export const RetentionConfigSchema: GenMessage<RetentionConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 55);
 
 /**
- * @generated from message watchfire.AgentInfo
+ * @generated from message watchfire.MetricsEndpointConfig
  */
-export type AgentInfo = Message<"watchfire.AgentInfo"> & {
-  /**
-   * Backend name (e.g. "claude-code")
-   *
-   * @generated from field: string name = 1;
-   */
-  name: string;
-
+export type MetricsEndpointConfig = Message<"watchfire.MetricsEndpointConfig"> & {
   /**
-   * Human-readable label (e.g. "Claude Code")
+   * Serve Prometheus /metrics (off by default)
    *
-   * @generated from field: string display_name = 2;
+   * @generated from field: bool enabled = 1;
    */
-  displayName: string;
+  enabled: boolean;
 
   /**
-   * Binary resolves on this host (display-time hint only — never used to filter the registry)
+   * host:port; default 127.0.0.1:9464
    *
-   * @generated from field: bool available = 3;
+   * @generated from field: string listen = 2;
    */
-  available: boolean;
+  listen: string;
 };
 
 /**
- * Describes the message watchfire.AgentInfo.
- * Use `create(AgentInfoSchema)` to create a new message.
+ * Describes the message watchfire.MetricsEndpointConfig.
+ * Use `create(MetricsEndpointConfigSchema)` to create a new message.
  */
-export const AgentInfoSchema: GenMessage<AgentInfo> = /*@__PURE__*/
-  messageDesc(file_watchfire, 54);
+export const MetricsEndpointConfigSchema: GenMessage<MetricsEndpointConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 56);
 
 /**
- * @generated from message watchfire.AgentList
+ * @generated from message watchfire.TracingConfig
  */
-export type AgentList = Message<"watchfire.AgentList"> & {
+export type TracingConfig = Message<"watchfire.TracingConfig"> & {
   /**
-   * @generated from field: repeated watchfire.AgentInfo agents = 1;
+   * Export task-lifecycle spans (off by default)
+   *
+   * @generated from field: bool enabled = 1;
    */
-  agents: AgentInfo[];
+  enabled: boolean;
+
+  /**
+   * "file" | "otlp"
+   *
+   * @generated from field: string exporter = 2;
+   */
+  exporter: string;
+
+  /**
+   * OTLP/HTTP base URL; spans go to <endpoint>/v1/traces
+   *
+   * @generated from field: string endpoint = 3;
+   */
+  endpoint: string;
+
+  /**
+   * Sent with every OTLP request
+   *
+   * @generated from field: map<string, string> headers = 4;
+   */
+  headers: { [key: string]: string };
+
+  /**
+   * JSONL path; empty = ~/.watchfire/traces.jsonl
+   *
+   * @generated from field: string file = 5;
+   */
+  file: string;
 };
 
 /**
- * Describes the message watchfire.AgentList.
- * Use `create(AgentListSchema)` to create a new message.
+ * Describes the message watchfire.TracingConfig.
+ * Use `create(TracingConfigSchema)` to create a new message.
  */
-export const AgentListSchema: GenMessage<AgentList> = /*@__PURE__*/
-  messageDesc(file_watchfire, 55);
+export const TracingConfigSchema: GenMessage<TracingConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 57);
 
 /**
- * McpClientStatus is one known MCP client's onboarding state on this machine
- * (v9.0 Firestorm). Produced both by GetMcpClientStatus (per-client state) and
- * by InstallMcpClient (post-install state + outcome message).
- *
- * @generated from message watchfire.McpClientStatus
+ * @generated from message watchfire.TokenPrice
  */
-export type McpClientStatus = Message<"watchfire.McpClientStatus"> & {
+export type TokenPrice = Message<"watchfire.TokenPrice"> & {
   /**
-   * Stable key: "claude-code" | "codex" | "gemini" | "opencode" | "copilot"
+   * Agent backend, e.g. "claude-code"
    *
-   * @generated from field: string client = 1;
+   * @generated from field: string backend = 1;
    */
-  client: string;
+  backend: string;
 
   /**
-   * Human-readable client name (e.g. "Gemini CLI")
+   * Model prefix; empty = the backend's fallback rate
    *
-   * @generated from field: string display_name = 2;
+   * @generated from field: string model = 2;
    */
-  displayName: string;
+  model: string;
 
   /**
-   * Harness appears installed on this machine (CLI on PATH or config dir present)
+   * USD per million input tokens
    *
-   * @generated from field: bool detected = 3;
+   * @generated from field: double input_per_mtok = 3;
    */
-  detected: boolean;
+  inputPerMtok: number;
 
   /**
-   * Client config already has a watchfire MCP entry
+   * USD per million output tokens
    *
-   * @generated from field: bool configured = 4;
+   * @generated from field: double output_per_mtok = 4;
    */
-  configured: boolean;
+  outputPerMtok: number;
 
   /**
-   * Where the client's MCP config lives (may not exist yet)
+   * USD per million cache-read tokens
    *
-   * @generated from field: string config_path = 5;
+   * @generated from field: double cached_per_mtok = 5;
    */
-  configPath: string;
-
-  /**
-   * Human-readable result; for a failed install, the manual instructions + snippet
-   *
-   * @generated from field: string message = 6;
-   */
-  message: string;
-};
+  cachedPerMtok: number;
+};
 
 /**
- * Describes the message watchfire.McpClientStatus.
- * Use `create(McpClientStatusSchema)` to create a new message.
+ * Describes the message watchfire.TokenPrice.
+ * Use `create(TokenPriceSchema)` to create a new message.
  */
-export const McpClientStatusSchema: GenMessage<McpClientStatus> = /*@__PURE__*/
-  messageDesc(file_watchfire, 56);
+export const TokenPriceSchema: GenMessage<TokenPrice> = /*@__PURE__*/
+  messageDesc(file_watchfire, 58);
 
 /**
- * @generated from message watchfire.McpClientStatusList
+ * @generated from message watchfire.PricingConfig
  */
-export type McpClientStatusList = Message<"watchfire.McpClientStatusList"> & {
-  /**
-   * @generated from field: repeated watchfire.McpClientStatus clients = 1;
-   */
-  clients: McpClientStatus[];
-
+export type PricingConfig = Message<"watchfire.PricingConfig"> & {
   /**
-   * Generic JSON block for the Custom option, so every surface renders it from one source of truth
+   * Used to estimate cost when a backend reports none
    *
-   * @generated from field: string custom_snippet = 2;
+   * @generated from field: repeated watchfire.TokenPrice prices = 1;
    */
-  customSnippet: string;
+  prices: TokenPrice[];
 };
 
 /**
- * Describes the message watchfire.McpClientStatusList.
- * Use `create(McpClientStatusListSchema)` to create a new message.
+ * Describes the message watchfire.PricingConfig.
+ * Use `create(PricingConfigSchema)` to create a new message.
  */
-export const McpClientStatusListSchema: GenMessage<McpClientStatusList> = /*@__PURE__*/
-  messageDesc(file_watchfire, 57);
+export const PricingConfigSchema: GenMessage<PricingConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 59);
 
 /**
- * @generated from message watchfire.InstallMcpClientRequest
+ * @generated from message watchfire.BudgetConfig
  */
-export type InstallMcpClientRequest = Message<"watchfire.InstallMcpClientRequest"> & {
+export type BudgetConfig = Message<"watchfire.BudgetConfig"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * 0 = no budget
+   *
+   * @generated from field: double monthly_usd = 1;
    */
-  meta?: RequestMeta;
+  monthlyUsd: number;
 
   /**
-   * Stable key from McpClientStatus.client
+   * Percentages that raise BUDGET_THRESHOLD
    *
-   * @generated from field: string client = 2;
+   * @generated from field: repeated int32 thresholds = 2;
    */
-  client: string;
+  thresholds: number[];
+
+  /**
+   * Refuse non-chat starts once spent
+   *
+   * @generated from field: bool hard_stop = 3;
+   */
+  hardStop: boolean;
 };
 
 /**
- * Describes the message watchfire.InstallMcpClientRequest.
- * Use `create(InstallMcpClientRequestSchema)` to create a new message.
+ * Describes the message watchfire.BudgetConfig.
+ * Use `create(BudgetConfigSchema)` to create a new message.
  */
-export const InstallMcpClientRequestSchema: GenMessage<InstallMcpClientRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 58);
+export const BudgetConfigSchema: GenMessage<BudgetConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 60);
 
 /**
- * @generated from message watchfire.SetGitHubAutoPRScopeRequest
+ * @generated from message watchfire.Settings
  */
-export type SetGitHubAutoPRScopeRequest = Message<"watchfire.SetGitHubAutoPRScopeRequest"> & {
+export type Settings = Message<"watchfire.Settings"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * @generated from field: int32 version = 1;
    */
-  meta?: RequestMeta;
+  version: number;
 
   /**
-   * @generated from field: string project_id = 2;
+   * @generated from field: map<string, watchfire.AgentConfig> agents = 2;
    */
-  projectId: string;
+  agents: { [key: string]: AgentConfig };
 
   /**
-   * @generated from field: bool enabled = 3;
+   * @generated from field: watchfire.DefaultsConfig defaults = 3;
    */
-  enabled: boolean;
-};
-
-/**
- * Describes the message watchfire.SetGitHubAutoPRScopeRequest.
- * Use `create(SetGitHubAutoPRScopeRequestSchema)` to create a new message.
- */
-export const SetGitHubAutoPRScopeRequestSchema: GenMessage<SetGitHubAutoPRScopeRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 59);
+  defaults?: DefaultsConfig;
 
-/**
- * @generated from message watchfire.SetProjectIntegrationBindingsRequest
- */
-export type SetProjectIntegrationBindingsRequest = Message<"watchfire.SetProjectIntegrationBindingsRequest"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * @generated from field: watchfire.UpdatesConfig updates = 4;
    */
-  meta?: RequestMeta;
+  updates?: UpdatesConfig;
 
   /**
-   * @generated from field: string project_id = 2;
+   * @generated from field: watchfire.AppearanceConfig appearance = 5;
    */
-  projectId: string;
+  appearance?: AppearanceConfig;
 
   /**
-   * @generated from field: string slack_channel = 3;
+   * @generated from field: string installation_id = 6;
    */
-  slackChannel: string;
+  installationId: string;
 
   /**
-   * @generated from field: string discord_guild_id = 4;
+   * @generated from field: watchfire.RecordingsConfig recordings = 7;
    */
-  discordGuildId: string;
-};
-
-/**
- * Describes the message watchfire.SetProjectIntegrationBindingsRequest.
- * Use `create(SetProjectIntegrationBindingsRequestSchema)` to create a new message.
- */
-export const SetProjectIntegrationBindingsRequestSchema: GenMessage<SetProjectIntegrationBindingsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 60);
+  recordings?: RecordingsConfig;
 
-/**
- * @generated from message watchfire.SubscribeFocusEventsRequest
- */
-export type SubscribeFocusEventsRequest = Message<"watchfire.SubscribeFocusEventsRequest"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * @generated from field: watchfire.RetentionConfig retention = 8;
    */
-  meta?: RequestMeta;
-};
-
-/**
- * Describes the message watchfire.SubscribeFocusEventsRequest.
- * Use `create(SubscribeFocusEventsRequestSchema)` to create a new message.
- */
-export const SubscribeFocusEventsRequestSchema: GenMessage<SubscribeFocusEventsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 61);
+  retention?: RetentionConfig;
 
-/**
- * @generated from message watchfire.FocusEvent
- */
-export type FocusEvent = Message<"watchfire.FocusEvent"> & {
   /**
-   * @generated from field: string project_id = 1;
+   * @generated from field: watchfire.MetricsEndpointConfig metrics_endpoint = 9;
    */
-  projectId: string;
+  metricsEndpoint?: MetricsEndpointConfig;
 
   /**
-   * @generated from field: watchfire.FocusTarget target = 2;
+   * @generated from field: watchfire.TracingConfig tracing = 10;
    */
-  target: FocusTarget;
+  tracing?: TracingConfig;
 
   /**
-   * Only meaningful when target == FOCUS_TARGET_TASK
-   *
-   * @generated from field: int32 task_number = 3;
+   * @generated from field: watchfire.PricingConfig pricing = 11;
    */
-  taskNumber: number;
+  pricing?: PricingConfig;
 
   /**
-   * YYYY-MM-DD identifying the digest file (FOCUS_TARGET_DIGEST)
-   *
-   * @generated from field: string digest_date = 4;
+   * @generated from field: watchfire.BudgetConfig budget = 12;
    */
-  digestDate: string;
+  budget?: BudgetConfig;
 };
 
 /**
- * Describes the message watchfire.FocusEvent.
- * Use `create(FocusEventSchema)` to create a new message.
+ * Describes the message watchfire.Settings.
+ * Use `create(SettingsSchema)` to create a new message.
  */
-export const FocusEventSchema: GenMessage<FocusEvent> = /*@__PURE__*/
-  messageDesc(file_watchfire, 62);
+export const SettingsSchema: GenMessage<Settings> = /*@__PURE__*/
+  messageDesc(file_watchfire, 61);
 
 /**
- * @generated from message watchfire.ListLogsRequest
+ * @generated from message watchfire.UpdateSettingsRequest
  */
-export type ListLogsRequest = Message<"watchfire.ListLogsRequest"> & {
+export type UpdateSettingsRequest = Message<"watchfire.UpdateSettingsRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
   meta?: RequestMeta;
 
   /**
-   * @generated from field: string project_id = 2;
-   */
-  projectId: string;
-};
-
-/**
- * Describes the message watchfire.ListLogsRequest.
- * Use `create(ListLogsRequestSchema)` to create a new message.
- */
-export const ListLogsRequestSchema: GenMessage<ListLogsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 63);
-
-/**
- * @generated from message watchfire.LogEntry
- */
-export type LogEntry = Message<"watchfire.LogEntry"> & {
-  /**
-   * @generated from field: string log_id = 1;
+   * @generated from field: optional watchfire.DefaultsConfig defaults = 2;
    */
-  logId: string;
+  defaults?: DefaultsConfig;
 
   /**
-   * @generated from field: string project_id = 2;
+   * @generated from field: optional watchfire.UpdatesConfig updates = 3;
    */
-  projectId: string;
+  updates?: UpdatesConfig;
 
   /**
-   * @generated from field: int32 task_number = 3;
+   * @generated from field: optional watchfire.AppearanceConfig appearance = 4;
    */
-  taskNumber: number;
+  appearance?: AppearanceConfig;
 
   /**
-   * @generated from field: int32 session_number = 4;
+   * Merge into existing
+   *
+   * @generated from field: map<string, watchfire.AgentConfig> agents = 5;
    */
-  sessionNumber: number;
+  agents: { [key: string]: AgentConfig };
 
   /**
-   * @generated from field: string agent = 5;
+   * @generated from field: optional watchfire.RecordingsConfig recordings = 6;
    */
-  agent: string;
+  recordings?: RecordingsConfig;
 
   /**
-   * @generated from field: string mode = 6;
+   * @generated from field: optional watchfire.RetentionConfig retention = 7;
    */
-  mode: string;
+  retention?: RetentionConfig;
 
   /**
-   * @generated from field: string started_at = 7;
+   * @generated from field: optional watchfire.MetricsEndpointConfig metrics_endpoint = 8;
    */
-  startedAt: string;
+  metricsEndpoint?: MetricsEndpointConfig;
 
   /**
-   * @generated from field: string ended_at = 8;
+   * @generated from field: optional watchfire.TracingConfig tracing = 9;
    */
-  endedAt: string;
+  tracing?: TracingConfig;
 
   /**
-   * @generated from field: string status = 9;
+   * Replaces the whole table
+   *
+   * @generated from field: optional watchfire.PricingConfig pricing = 10;
    */
-  status: string;
-};
-
-/**
- * Describes the message watchfire.LogEntry.
- * Use `create(LogEntrySchema)` to create a new message.
- */
-export const LogEntrySchema: GenMessage<LogEntry> = /*@__PURE__*/
-  messageDesc(file_watchfire, 64);
+  pricing?: PricingConfig;
 
-/**
- * @generated from message watchfire.LogList
- */
-export type LogList = Message<"watchfire.LogList"> & {
   /**
-   * @generated from field: repeated watchfire.LogEntry logs = 1;
+   * @generated from field: optional watchfire.BudgetConfig budget = 11;
    */
-  logs: LogEntry[];
+  budget?: BudgetConfig;
 };
 
 /**
- * Describes the message watchfire.LogList.
- * Use `create(LogListSchema)` to create a new message.
+ * Describes the message watchfire.UpdateSettingsRequest.
+ * Use `create(UpdateSettingsRequestSchema)` to create a new message.
  */
-export const LogListSchema: GenMessage<LogList> = /*@__PURE__*/
-  messageDesc(file_watchfire, 65);
+export const UpdateSettingsRequestSchema: GenMessage<UpdateSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 62);
 
 /**
- * @generated from message watchfire.GetLogRequest
+ * @generated from message watchfire.AgentInfo
  */
-export type GetLogRequest = Message<"watchfire.GetLogRequest"> & {
+export type AgentInfo = Message<"watchfire.AgentInfo"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * Backend name (e.g. "claude-code")
+   *
+   * @generated from field: string name = 1;
    */
-  meta?: RequestMeta;
+  name: string;
 
   /**
-   * @generated from field: string project_id = 2;
+   * Human-readable label (e.g. "Claude Code")
+   *
+   * @generated from field: string display_name = 2;
    */
-  projectId: string;
+  displayName: string;
 
   /**
-   * @generated from field: string log_id = 3;
+   * Binary resolves on this host (display-time hint only — never used to filter the registry)
+   *
+   * @generated from field: bool available = 3;
    */
-  logId: string;
+  available: boolean;
 };
 
 /**
- * Describes the message watchfire.GetLogRequest.
- * Use `create(GetLogRequestSchema)` to create a new message.
+ * Describes the message watchfire.AgentInfo.
+ * Use `create(AgentInfoSchema)` to create a new message.
  */
-export const GetLogRequestSchema: GenMessage<GetLogRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 66);
+export const AgentInfoSchema: GenMessage<AgentInfo> = /*@__PURE__*/
+  messageDesc(file_watchfire, 63);
 
 /**
- * @generated from message watchfire.LogContent
+ * @generated from message watchfire.AgentList
  */
-export type LogContent = Message<"watchfire.LogContent"> & {
-  /**
-   * @generated from field: watchfire.LogEntry entry = 1;
-   */
-  entry?: LogEntry;
-
+export type AgentList = Message<"watchfire.AgentList"> & {
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: repeated watchfire.AgentInfo agents = 1;
    */
-  content: string;
+  agents: AgentInfo[];
 };
 
 /**
- * Describes the message watchfire.LogContent.
- * Use `create(LogContentSchema)` to create a new message.
+ * Describes the message watchfire.AgentList.
+ * Use `create(AgentListSchema)` to create a new message.
  */
-export const LogContentSchema: GenMessage<LogContent> = /*@__PURE__*/
-  messageDesc(file_watchfire, 67);
+export const AgentListSchema: GenMessage<AgentList> = /*@__PURE__*/
+  messageDesc(file_watchfire, 64);
 
 /**
- * @generated from message watchfire.DeleteLogRequest
+ * McpClientStatus is one known MCP client's onboarding state on this machine
+ * (v9.0 Firestorm). Produced both by GetMcpClientStatus (per-client state) and
+ * by InstallMcpClient (post-install state + outcome message).
+ *
+ * @generated from message watchfire.McpClientStatus
  */
-export type DeleteLogRequest = Message<"watchfire.DeleteLogRequest"> & {
-  /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
-   */
-  meta?: RequestMeta;
-
+export type McpClientStatus = Message<"watchfire.McpClientStatus"> & {
   /**
-   * @generated from field: string project_id = 2;
+   * Stable key: "claude-code" | "codex" | "gemini" | "opencode" | "copilot"
+   *
+   * @generated from field: string client = 1;
    */
-  projectId: string;
+  client: string;
 
   /**
-   * @generated from field: string log_id = 3;
+   * Human-readable client name (e.g. "Gemini CLI")
+   *
+   * @generated from field: string display_name = 2;
    */
-  logId: string;
-};
-
-/**
- * Describes the message watchfire.DeleteLogRequest.
- * Use `create(DeleteLogRequestSchema)` to create a new message.
- */
-export const DeleteLogRequestSchema: GenMessage<DeleteLogRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 68);
+  displayName: string;
 
-/**
- * Notification is a single user-facing event the daemon emits when something
- * the user cares about happens (a task fails, an autonomous run finishes, …).
- * Mirrors the JSONL record written to ~/.watchfire/logs/<project_id>/notifications.log.
- *
- * @generated from message watchfire.Notification
- */
-export type Notification = Message<"watchfire.Notification"> & {
   /**
-   * Stable hash; duplicates collapse
+   * Harness appears installed on this machine (CLI on PATH or config dir present)
    *
-   * @generated from field: string id = 1;
+   * @generated from field: bool detected = 3;
    */
-  id: string;
+  detected: boolean;
 
   /**
-   * @generated from field: string project_id = 2;
+   * Client config already has a watchfire MCP entry
+   *
+   * @generated from field: bool configured = 4;
    */
-  projectId: string;
+  configured: boolean;
 
   /**
-   * 0 when not task-scoped
+   * Where the client's MCP config lives (may not exist yet)
    *
-   * @generated from field: int32 task_number = 3;
+   * @generated from field: string config_path = 5;
    */
-  taskNumber: number;
+  configPath: string;
 
   /**
-   * @generated from field: string title = 4;
+   * Human-readable result; for a failed install, the manual instructions + snippet
+   *
+   * @generated from field: string message = 6;
    */
-  title: string;
+  message: string;
+};
 
-  /**
-   * @generated from field: string body = 5;
-   */
-  body: string;
+/**
+ * Describes the message watchfire.McpClientStatus.
+ * Use `create(McpClientStatusSchema)` to create a new message.
+ */
+export const McpClientStatusSchema: GenMessage<McpClientStatus> = /*@__PURE__*/
+  messageDesc(file_watchfire, 65);
 
+/**
+ * @generated from message watchfire.McpClientStatusList
+ */
+export type McpClientStatusList = Message<"watchfire.McpClientStatusList"> & {
   /**
-   * @generated from field: google.protobuf.Timestamp emitted_at = 6;
+   * @generated from field: repeated watchfire.McpClientStatus clients = 1;
    */
-  emittedAt?: Timestamp;
+  clients: McpClientStatus[];
 
   /**
-   * @generated from field: watchfire.NotificationKind kind = 7;
+   * Generic JSON block for the Custom option, so every surface renders it from one source of truth
+   *
+   * @generated from field: string custom_snippet = 2;
    */
-  kind: NotificationKind;
+  customSnippet: string;
 };
 
 /**
- * Describes the message watchfire.Notification.
- * Use `create(NotificationSchema)` to create a new message.
+ * Describes the message watchfire.McpClientStatusList.
+ * Use `create(McpClientStatusListSchema)` to create a new message.
  */
-export const NotificationSchema: GenMessage<Notification> = /*@__PURE__*/
-  messageDesc(file_watchfire, 69);
+export const McpClientStatusListSchema: GenMessage<McpClientStatusList> = /*@__PURE__*/
+  messageDesc(file_watchfire, 66);
 
 /**
- * @generated from message watchfire.SubscribeNotificationsRequest
+ * @generated from message watchfire.InstallMcpClientRequest
  */
-export type SubscribeNotificationsRequest = Message<"watchfire.SubscribeNotificationsRequest"> & {
+export type InstallMcpClientRequest = Message<"watchfire.InstallMcpClientRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
   meta?: RequestMeta;
+
+  /**
+   * Stable key from McpClientStatus.client
+   *
+   * @generated from field: string client = 2;
+   */
+  client: string;
 };
 
 /**
- * Describes the message watchfire.SubscribeNotificationsRequest.
- * Use `create(SubscribeNotificationsRequestSchema)` to create a new message.
+ * Describes the message watchfire.InstallMcpClientRequest.
+ * Use `create(InstallMcpClientRequestSchema)` to create a new message.
  */
-export const SubscribeNotificationsRequestSchema: GenMessage<SubscribeNotificationsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 70);
+export const InstallMcpClientRequestSchema: GenMessage<InstallMcpClientRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 67);
 
 /**
- * ExportReportRequest names a scope (single task / project / fleet-wide
- * global) and a format. window_start / window_end bound the time-range the
- * report covers (applies to per-day breakdowns; ignored for single_task).
- *
- * @generated from message watchfire.ExportReportRequest
+ * @generated from message watchfire.SetGitHubAutoPRScopeRequest
  */
-export type ExportReportRequest = Message<"watchfire.ExportReportRequest"> & {
+export type SetGitHubAutoPRScopeRequest = Message<"watchfire.SetGitHubAutoPRScopeRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
   meta?: RequestMeta;
 
   /**
-   * @generated from oneof watchfire.ExportReportRequest.scope
-   */
-  scope: {
-    /**
-     * Per-project report
-     *
-     * @generated from field: string project_id = 2;
-     */
-    value: string;
-    case: "projectId";
-  } | {
-    /**
-     * Fleet rollup
-     *
-     * @generated from field: bool global = 3;
-     */
-    value: boolean;
-    case: "global";
-  } | {
-    /**
-     * "<project_id>:<task_number>" — one task
-     *
-     * @generated from field: string single_task = 4;
-     */
-    value: string;
-    case: "singleTask";
-  } | { case: undefined; value?: undefined };
-
-  /**
-   * @generated from field: watchfire.ExportFormat format = 5;
-   */
-  format: ExportFormat;
-
-  /**
-   * @generated from field: google.protobuf.Timestamp window_start = 6;
+   * @generated from field: string project_id = 2;
    */
-  windowStart?: Timestamp;
+  projectId: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_end = 7;
+   * @generated from field: bool enabled = 3;
    */
-  windowEnd?: Timestamp;
+  enabled: boolean;
 };
 
 /**
- * Describes the message watchfire.ExportReportRequest.
- * Use `create(ExportReportRequestSchema)` to create a new message.
+ * Describes the message watchfire.SetGitHubAutoPRScopeRequest.
+ * Use `create(SetGitHubAutoPRScopeRequestSchema)` to create a new message.
  */
-export const ExportReportRequestSchema: GenMessage<ExportReportRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 71);
+export const SetGitHubAutoPRScopeRequestSchema: GenMessage<SetGitHubAutoPRScopeRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 68);
 
 /**
- * ExportReportResponse carries the rendered file. content is the raw bytes
- * (UTF-8 text for CSV / Markdown); the GUI saves it via Blob URL using the
- * supplied filename. mime is `text/csv` or `text/markdown`.
- *
- * @generated from message watchfire.ExportReportResponse
+ * @generated from message watchfire.SetProjectIntegrationBindingsRequest
  */
-export type ExportReportResponse = Message<"watchfire.ExportReportResponse"> & {
+export type SetProjectIntegrationBindingsRequest = Message<"watchfire.SetProjectIntegrationBindingsRequest"> & {
   /**
-   * @generated from field: string filename = 1;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  filename: string;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: bytes content = 2;
+   * @generated from field: string project_id = 2;
    */
-  content: Uint8Array;
+  projectId: string;
 
   /**
-   * @generated from field: string mime = 3;
+   * @generated from field: string slack_channel = 3;
    */
-  mime: string;
+  slackChannel: string;
+
+  /**
+   * @generated from field: string discord_guild_id = 4;
+   */
+  discordGuildId: string;
 };
 
 /**
- * Describes the message watchfire.ExportReportResponse.
- * Use `create(ExportReportResponseSchema)` to create a new message.
+ * Describes the message watchfire.SetProjectIntegrationBindingsRequest.
+ * Use `create(SetProjectIntegrationBindingsRequestSchema)` to create a new message.
  */
-export const ExportReportResponseSchema: GenMessage<ExportReportResponse> = /*@__PURE__*/
-  messageDesc(file_watchfire, 72);
+export const SetProjectIntegrationBindingsRequestSchema: GenMessage<SetProjectIntegrationBindingsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 69);
 
 /**
- * GetGlobalInsightsRequest bounds a fleet-wide rollup query. Both bounds
- * are optional — an unset window_start collapses to "no lower bound" and
- * likewise for window_end. The dashboard typically passes a 30-day window.
- *
- * @generated from message watchfire.GetGlobalInsightsRequest
+ * @generated from message watchfire.RunGCRequest
  */
-export type GetGlobalInsightsRequest = Message<"watchfire.GetGlobalInsightsRequest"> & {
+export type RunGCRequest = Message<"watchfire.RunGCRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
   meta?: RequestMeta;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   * @generated from field: bool dry_run = 2;
    */
-  windowStart?: Timestamp;
+  dryRun: boolean;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   * Empty = every project plus fleet-wide caches
+   *
+   * @generated from field: string project_id = 3;
    */
-  windowEnd?: Timestamp;
+  projectId: string;
 };
 
 /**
- * Describes the message watchfire.GetGlobalInsightsRequest.
- * Use `create(GetGlobalInsightsRequestSchema)` to create a new message.
+ * Describes the message watchfire.RunGCRequest.
+ * Use `create(RunGCRequestSchema)` to create a new message.
  */
-export const GetGlobalInsightsRequestSchema: GenMessage<GetGlobalInsightsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 73);
+export const RunGCRequestSchema: GenMessage<RunGCRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 70);
 
 /**
- * DayBucket — one calendar day's task counts. Used by both per-project and
- * fleet rollups to render stacked bars / sparklines.
- *
- * @generated from message watchfire.DayBucket
+ * @generated from message watchfire.GCItem
  */
-export type DayBucket = Message<"watchfire.DayBucket"> & {
+export type GCItem = Message<"watchfire.GCItem"> & {
   /**
-   * YYYY-MM-DD (local zone)
+   * "log" | "recording" | "insights_cache" | "diff_cache" | "dead_delivery" | "branch"
    *
-   * @generated from field: string date = 1;
+   * @generated from field: string kind = 1;
    */
-  date: string;
+  kind: string;
 
   /**
-   * succeeded + failed
+   * Empty for fleet-wide caches
    *
-   * @generated from field: int32 count = 2;
-   */
-  count: number;
-
-  /**
-   * @generated from field: int32 succeeded = 3;
+   * @generated from field: string project_id = 2;
    */
-  succeeded: number;
+  projectId: string;
 
   /**
-   * @generated from field: int32 failed = 4;
+   * File or directory; branch name for "branch"
+   *
+   * @generated from field: string path = 3;
    */
-  failed: number;
+  path: string;
 
   /**
-   * v8.0 Inferno — code churn for the day, for a churn-by-day chart drawn
-   * next to the tasks-by-day bars. Zero on days whose tasks lack code
-   * metrics (pre-v8.0 captures).
+   * 0 for branches
    *
-   * @generated from field: int32 lines_added = 5;
+   * @generated from field: int64 bytes = 4;
    */
-  linesAdded: number;
+  bytes: bigint;
 
   /**
-   * @generated from field: int32 lines_removed = 6;
+   * @generated from field: string reason = 5;
    */
-  linesRemoved: number;
+  reason: string;
 };
 
 /**
- * Describes the message watchfire.DayBucket.
- * Use `create(DayBucketSchema)` to create a new message.
+ * Describes the message watchfire.GCItem.
+ * Use `create(GCItemSchema)` to create a new message.
  */
-export const DayBucketSchema: GenMessage<DayBucket> = /*@__PURE__*/
-  messageDesc(file_watchfire, 74);
+export const GCItemSchema: GenMessage<GCItem> = /*@__PURE__*/
+  messageDesc(file_watchfire, 71);
 
 /**
- * AgentBreakdown — one row per backend agent that touched tasks in the
- * window. Field set is shared between the per-project and global rollups
- * so the same renderer code works for both surfaces.
- *
- * @generated from message watchfire.AgentBreakdown
+ * @generated from message watchfire.GCReport
  */
-export type AgentBreakdown = Message<"watchfire.AgentBreakdown"> & {
-  /**
-   * @generated from field: string agent = 1;
-   */
-  agent: string;
-
-  /**
-   * @generated from field: int32 count = 2;
-   */
-  count: number;
-
-  /**
-   * @generated from field: double success_rate = 3;
-   */
-  successRate: number;
-
+export type GCReport = Message<"watchfire.GCReport"> & {
   /**
-   * @generated from field: int64 avg_duration_ms = 4;
-   */
-  avgDurationMs: bigint;
-
-  /**
-   * @generated from field: int64 total_tokens_in = 5;
+   * @generated from field: bool dry_run = 1;
    */
-  totalTokensIn: bigint;
+  dryRun: boolean;
 
   /**
-   * @generated from field: int64 total_tokens_out = 6;
+   * Removed (or, on a dry run, removable) items
+   *
+   * @generated from field: repeated watchfire.GCItem items = 2;
    */
-  totalTokensOut: bigint;
+  items: GCItem[];
 
   /**
-   * @generated from field: double total_cost_usd = 7;
+   * @generated from field: int64 total_bytes = 3;
    */
-  totalCostUsd: number;
+  totalBytes: bigint;
 
   /**
-   * v8.0 Inferno — output-per-agent, so agents are comparable on shipped
-   * code, not just task count. Zero for tasks lacking code metrics.
+   * Removals that failed
    *
-   * @generated from field: int32 commits = 8;
+   * @generated from field: repeated string errors = 4;
    */
-  commits: number;
+  errors: string[];
+};
 
-  /**
-   * @generated from field: int32 lines_added = 9;
-   */
-  linesAdded: number;
+/**
+ * Describes the message watchfire.GCReport.
+ * Use `create(GCReportSchema)` to create a new message.
+ */
+export const GCReportSchema: GenMessage<GCReport> = /*@__PURE__*/
+  messageDesc(file_watchfire, 72);
 
+/**
+ * @generated from message watchfire.SubscribeFocusEventsRequest
+ */
+export type SubscribeFocusEventsRequest = Message<"watchfire.SubscribeFocusEventsRequest"> & {
   /**
-   * @generated from field: int32 lines_removed = 10;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  linesRemoved: number;
+  meta?: RequestMeta;
 };
 
 /**
- * Describes the message watchfire.AgentBreakdown.
- * Use `create(AgentBreakdownSchema)` to create a new message.
+ * Describes the message watchfire.SubscribeFocusEventsRequest.
+ * Use `create(SubscribeFocusEventsRequestSchema)` to create a new message.
  */
-export const AgentBreakdownSchema: GenMessage<AgentBreakdown> = /*@__PURE__*/
-  messageDesc(file_watchfire, 75);
+export const SubscribeFocusEventsRequestSchema: GenMessage<SubscribeFocusEventsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 73);
 
 /**
- * TopProject — one row of the fleet rollup's top-projects pill list,
- * sorted by completed-task count descending. The dashboard renders these
- * as clickable pills that route to each project's InsightsTab.
- *
- * @generated from message watchfire.TopProject
+ * @generated from message watchfire.FocusEvent
  */
-export type TopProject = Message<"watchfire.TopProject"> & {
+export type FocusEvent = Message<"watchfire.FocusEvent"> & {
   /**
    * @generated from field: string project_id = 1;
    */
   projectId: string;
 
   /**
-   * @generated from field: string project_name = 2;
-   */
-  projectName: string;
-
-  /**
-   * @generated from field: string project_color = 3;
-   */
-  projectColor: string;
-
-  /**
-   * @generated from field: int32 count = 4;
-   */
-  count: number;
-
-  /**
-   * @generated from field: double success_rate = 5;
+   * @generated from field: watchfire.FocusTarget target = 2;
    */
-  successRate: number;
+  target: FocusTarget;
 
   /**
-   * v8.0 Inferno — per-project shipped-code totals over the window, so the
-   * fleet rollup can rank projects by net lines / churn rather than only by
-   * completed-task count. Zero for projects whose tasks lack code metrics.
+   * Only meaningful when target == FOCUS_TARGET_TASK
    *
-   * @generated from field: int32 commits = 6;
-   */
-  commits: number;
-
-  /**
-   * @generated from field: int32 lines_added = 7;
-   */
-  linesAdded: number;
-
-  /**
-   * @generated from field: int32 lines_removed = 8;
-   */
-  linesRemoved: number;
-
-  /**
-   * @generated from field: int32 net_lines = 9;
+   * @generated from field: int32 task_number = 3;
    */
-  netLines: number;
+  taskNumber: number;
 
   /**
-   * Completed tasks merged in the window (silent merge or auto-PR). Lets the
-   * mission-control home window show a per-card "shipped" line including merge
-   * count alongside churn. Zero for projects whose tasks lack code metrics.
+   * YYYY-MM-DD identifying the digest file (FOCUS_TARGET_DIGEST)
    *
-   * @generated from field: int32 merges = 10;
+   * @generated from field: string digest_date = 4;
    */
-  merges: number;
+  digestDate: string;
 };
 
 /**
- * Describes the message watchfire.TopProject.
- * Use `create(TopProjectSchema)` to create a new message.
+ * Describes the message watchfire.FocusEvent.
+ * Use `create(FocusEventSchema)` to create a new message.
  */
-export const TopProjectSchema: GenMessage<TopProject> = /*@__PURE__*/
-  messageDesc(file_watchfire, 76);
+export const FocusEventSchema: GenMessage<FocusEvent> = /*@__PURE__*/
+  messageDesc(file_watchfire, 74);
 
 /**
- * GlobalInsights is the cross-project rollup the daemon returns from
- * `GetGlobalInsights`. Sums every per-project metric over the window;
- * `tasks_missing_cost` surfaces a partial-data caveat when not every
- * completed task carried a cost number.
- *
- * @generated from message watchfire.GlobalInsights
+ * @generated from message watchfire.ListLogsRequest
  */
-export type GlobalInsights = Message<"watchfire.GlobalInsights"> & {
-  /**
-   * @generated from field: int32 tasks_total = 1;
-   */
-  tasksTotal: number;
-
-  /**
-   * @generated from field: int32 tasks_succeeded = 2;
-   */
-  tasksSucceeded: number;
-
+export type ListLogsRequest = Message<"watchfire.ListLogsRequest"> & {
   /**
-   * @generated from field: int32 tasks_failed = 3;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  tasksFailed: number;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: repeated watchfire.DayBucket tasks_by_day = 4;
+   * @generated from field: string project_id = 2;
    */
-  tasksByDay: DayBucket[];
+  projectId: string;
+};
 
-  /**
-   * @generated from field: repeated watchfire.TopProject top_projects = 5;
-   */
-  topProjects: TopProject[];
+/**
+ * Describes the message watchfire.ListLogsRequest.
+ * Use `create(ListLogsRequestSchema)` to create a new message.
+ */
+export const ListLogsRequestSchema: GenMessage<ListLogsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 75);
 
+/**
+ * @generated from message watchfire.LogEntry
+ */
+export type LogEntry = Message<"watchfire.LogEntry"> & {
   /**
-   * @generated from field: repeated watchfire.AgentBreakdown agent_breakdown = 6;
+   * @generated from field: string log_id = 1;
    */
-  agentBreakdown: AgentBreakdown[];
+  logId: string;
 
   /**
-   * @generated from field: int64 total_duration_ms = 7;
+   * @generated from field: string project_id = 2;
    */
-  totalDurationMs: bigint;
+  projectId: string;
 
   /**
-   * @generated from field: double total_cost_usd = 8;
+   * @generated from field: int32 task_number = 3;
    */
-  totalCostUsd: number;
+  taskNumber: number;
 
   /**
-   * @generated from field: int32 tasks_missing_cost = 9;
+   * @generated from field: int32 session_number = 4;
    */
-  tasksMissingCost: number;
+  sessionNumber: number;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_start = 10;
+   * @generated from field: string agent = 5;
    */
-  windowStart?: Timestamp;
+  agent: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_end = 11;
+   * @generated from field: string mode = 6;
    */
-  windowEnd?: Timestamp;
+  mode: string;
 
   /**
-   * v8.0 Inferno — fleet-wide shipped-code rollup. `metrics_missing_code`
-   * counts completed tasks with no code metrics so the GUI can honestly say
-   * "based on N of M tasks". Older cached payloads without these fields read
-   * them as zero.
-   *
-   * @generated from field: int32 total_commits = 12;
+   * @generated from field: string started_at = 7;
    */
-  totalCommits: number;
+  startedAt: string;
 
   /**
-   * @generated from field: int32 total_files_changed = 13;
+   * @generated from field: string ended_at = 8;
    */
-  totalFilesChanged: number;
+  endedAt: string;
 
   /**
-   * @generated from field: int32 total_lines_added = 14;
+   * @generated from field: string status = 9;
    */
-  totalLinesAdded: number;
+  status: string;
 
   /**
-   * @generated from field: int32 total_lines_removed = 15;
+   * @generated from field: bool has_transcript = 10;
    */
-  totalLinesRemoved: number;
+  hasTranscript: boolean;
 
   /**
-   * @generated from field: int32 net_lines = 16;
+   * An asciicast recording is available via GetRecording
+   *
+   * @generated from field: bool has_recording = 11;
    */
-  netLines: number;
+  hasRecording: boolean;
 
   /**
-   * @generated from field: int32 tasks_merged = 17;
+   * A normalized event log is available via GetSessionEvents
+   *
+   * @generated from field: bool has_events = 12;
    */
-  tasksMerged: number;
+  hasEvents: boolean;
+};
 
-  /**
-   * @generated from field: int32 tasks_via_pr = 18;
-   */
-  tasksViaPr: number;
+/**
+ * Describes the message watchfire.LogEntry.
+ * Use `create(LogEntrySchema)` to create a new message.
+ */
+export const LogEntrySchema: GenMessage<LogEntry> = /*@__PURE__*/
+  messageDesc(file_watchfire, 76);
 
+/**
+ * @generated from message watchfire.LogList
+ */
+export type LogList = Message<"watchfire.LogList"> & {
   /**
-   * @generated from field: int32 metrics_missing_code = 19;
+   * @generated from field: repeated watchfire.LogEntry logs = 1;
    */
-  metricsMissingCode: number;
+  logs: LogEntry[];
 };
 
 /**
- * Describes the message watchfire.GlobalInsights.
- * Use `create(GlobalInsightsSchema)` to create a new message.
+ * Describes the message watchfire.LogList.
+ * Use `create(LogListSchema)` to create a new message.
  */
-export const GlobalInsightsSchema: GenMessage<GlobalInsights> = /*@__PURE__*/
+export const LogListSchema: GenMessage<LogList> = /*@__PURE__*/
   messageDesc(file_watchfire, 77);
 
 /**
- * GetProjectInsightsRequest scopes a per-project insights query. Both
- * window bounds are optional — leaving them unset queries "all time".
- *
- * @generated from message watchfire.GetProjectInsightsRequest
+ * @generated from message watchfire.GetLogRequest
  */
-export type GetProjectInsightsRequest = Message<"watchfire.GetProjectInsightsRequest"> & {
+export type GetLogRequest = Message<"watchfire.GetLogRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
@@ -3066,163 +3064,250 @@ export type GetProjectInsightsRequest = Message<"watchfire.GetProjectInsightsReq
   projectId: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   * @generated from field: string log_id = 3;
    */
-  windowStart?: Timestamp;
+  logId: string;
+};
+
+/**
+ * Describes the message watchfire.GetLogRequest.
+ * Use `create(GetLogRequestSchema)` to create a new message.
+ */
+export const GetLogRequestSchema: GenMessage<GetLogRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 78);
 
+/**
+ * @generated from message watchfire.LogContent
+ */
+export type LogContent = Message<"watchfire.LogContent"> & {
   /**
-   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   * @generated from field: watchfire.LogEntry entry = 1;
    */
-  windowEnd?: Timestamp;
+  entry?: LogEntry;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
 };
 
 /**
- * Describes the message watchfire.GetProjectInsightsRequest.
- * Use `create(GetProjectInsightsRequestSchema)` to create a new message.
+ * Describes the message watchfire.LogContent.
+ * Use `create(LogContentSchema)` to create a new message.
  */
-export const GetProjectInsightsRequestSchema: GenMessage<GetProjectInsightsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 78);
+export const LogContentSchema: GenMessage<LogContent> = /*@__PURE__*/
+  messageDesc(file_watchfire, 79);
 
 /**
- * ProjectInsights is the per-project rollup the daemon returns from
- * `GetProjectInsights`. Same shape primitives as GlobalInsights — the
- * dashboard's per-project Insights tab and the TUI per-project overlay
- * reuse the same renderers.
- *
- * @generated from message watchfire.ProjectInsights
+ * @generated from message watchfire.DeleteLogRequest
  */
-export type ProjectInsights = Message<"watchfire.ProjectInsights"> & {
+export type DeleteLogRequest = Message<"watchfire.DeleteLogRequest"> & {
   /**
-   * @generated from field: string project_id = 1;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  projectId: string;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: int32 tasks_total = 2;
+   * @generated from field: string project_id = 2;
    */
-  tasksTotal: number;
+  projectId: string;
 
   /**
-   * @generated from field: int32 tasks_succeeded = 3;
+   * @generated from field: string log_id = 3;
    */
-  tasksSucceeded: number;
+  logId: string;
+};
+
+/**
+ * Describes the message watchfire.DeleteLogRequest.
+ * Use `create(DeleteLogRequestSchema)` to create a new message.
+ */
+export const DeleteLogRequestSchema: GenMessage<DeleteLogRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 80);
 
+/**
+ * @generated from message watchfire.GetRecordingRequest
+ */
+export type GetRecordingRequest = Message<"watchfire.GetRecordingRequest"> & {
   /**
-   * @generated from field: int32 tasks_failed = 4;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  tasksFailed: number;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: repeated watchfire.DayBucket tasks_by_day = 5;
+   * @generated from field: string project_id = 2;
    */
-  tasksByDay: DayBucket[];
+  projectId: string;
 
   /**
-   * @generated from field: repeated watchfire.AgentBreakdown agent_breakdown = 6;
+   * @generated from field: string log_id = 3;
    */
-  agentBreakdown: AgentBreakdown[];
+  logId: string;
+};
+
+/**
+ * Describes the message watchfire.GetRecordingRequest.
+ * Use `create(GetRecordingRequestSchema)` to create a new message.
+ */
+export const GetRecordingRequestSchema: GenMessage<GetRecordingRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 81);
 
+/**
+ * @generated from message watchfire.RecordingChunk
+ */
+export type RecordingChunk = Message<"watchfire.RecordingChunk"> & {
   /**
-   * @generated from field: int64 total_duration_ms = 7;
+   * Consecutive slices of the .cast file
+   *
+   * @generated from field: bytes data = 1;
    */
-  totalDurationMs: bigint;
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message watchfire.RecordingChunk.
+ * Use `create(RecordingChunkSchema)` to create a new message.
+ */
+export const RecordingChunkSchema: GenMessage<RecordingChunk> = /*@__PURE__*/
+  messageDesc(file_watchfire, 82);
 
+/**
+ * @generated from message watchfire.SearchLogsRequest
+ */
+export type SearchLogsRequest = Message<"watchfire.SearchLogsRequest"> & {
   /**
-   * @generated from field: int64 avg_duration_ms = 8;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  avgDurationMs: bigint;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: int64 p50_duration_ms = 9;
+   * Words are ANDed; "quoted phrases" must match verbatim
+   *
+   * @generated from field: string query = 2;
    */
-  p50DurationMs: bigint;
+  query: string;
 
   /**
-   * @generated from field: int64 p95_duration_ms = 10;
+   * Empty = all registered projects
+   *
+   * @generated from field: repeated string project_ids = 3;
    */
-  p95DurationMs: bigint;
+  projectIds: string[];
 
   /**
-   * @generated from field: double total_cost_usd = 11;
+   * Backend name filter, e.g. "claude-code"
+   *
+   * @generated from field: string agent = 4;
    */
-  totalCostUsd: number;
+  agent: string;
 
   /**
-   * @generated from field: int32 tasks_missing_cost = 12;
+   * 0 = any
+   *
+   * @generated from field: int32 task_number = 5;
    */
-  tasksMissingCost: number;
+  taskNumber: number;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_start = 13;
+   * "task" | "chat" | "wildfire" | ...
+   *
+   * @generated from field: string mode = 6;
    */
-  windowStart?: Timestamp;
+  mode: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp window_end = 14;
+   * "completed" | "interrupted"
+   *
+   * @generated from field: string status = 7;
    */
-  windowEnd?: Timestamp;
+  status: string;
 
   /**
-   * v8.0 Inferno — per-project shipped-code rollup. Mirrors GlobalInsights;
-   * `metrics_missing_code` drives the "based on N of M tasks" coverage note
-   * in the Insights tab. Older cached payloads read these as zero.
+   * RFC3339 or YYYY-MM-DD, inclusive
    *
-   * @generated from field: int32 total_commits = 15;
+   * @generated from field: string since = 8;
    */
-  totalCommits: number;
+  since: string;
 
   /**
-   * @generated from field: int32 total_files_changed = 16;
+   * RFC3339 (exclusive) or YYYY-MM-DD (through that day)
+   *
+   * @generated from field: string until = 9;
    */
-  totalFilesChanged: number;
+  until: string;
 
   /**
-   * @generated from field: int32 total_lines_added = 17;
+   * 0 = server default (20)
+   *
+   * @generated from field: int32 limit = 10;
    */
-  totalLinesAdded: number;
+  limit: number;
+};
+
+/**
+ * Describes the message watchfire.SearchLogsRequest.
+ * Use `create(SearchLogsRequestSchema)` to create a new message.
+ */
+export const SearchLogsRequestSchema: GenMessage<SearchLogsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 83);
 
+/**
+ * @generated from message watchfire.LogSearchHit
+ */
+export type LogSearchHit = Message<"watchfire.LogSearchHit"> & {
   /**
-   * @generated from field: int32 total_lines_removed = 18;
+   * @generated from field: watchfire.LogEntry entry = 1;
    */
-  totalLinesRemoved: number;
+  entry?: LogEntry;
 
   /**
-   * @generated from field: int32 net_lines = 19;
+   * @generated from field: string project_name = 2;
    */
-  netLines: number;
+  projectName: string;
 
   /**
-   * @generated from field: int32 tasks_merged = 20;
+   * BM25 relevance; only comparable within one response
+   *
+   * @generated from field: double score = 3;
    */
-  tasksMerged: number;
+  score: number;
 
   /**
-   * @generated from field: int32 tasks_via_pr = 21;
+   * Matching lines, trimmed around the match
+   *
+   * @generated from field: repeated string snippets = 4;
    */
-  tasksViaPr: number;
+  snippets: string[];
+};
+
+/**
+ * Describes the message watchfire.LogSearchHit.
+ * Use `create(LogSearchHitSchema)` to create a new message.
+ */
+export const LogSearchHitSchema: GenMessage<LogSearchHit> = /*@__PURE__*/
+  messageDesc(file_watchfire, 84);
 
+/**
+ * @generated from message watchfire.SearchLogsResponse
+ */
+export type SearchLogsResponse = Message<"watchfire.SearchLogsResponse"> & {
   /**
-   * @generated from field: int32 metrics_missing_code = 22;
+   * @generated from field: repeated watchfire.LogSearchHit hits = 1;
    */
-  metricsMissingCode: number;
+  hits: LogSearchHit[];
 };
 
 /**
- * Describes the message watchfire.ProjectInsights.
- * Use `create(ProjectInsightsSchema)` to create a new message.
+ * Describes the message watchfire.SearchLogsResponse.
+ * Use `create(SearchLogsResponseSchema)` to create a new message.
  */
-export const ProjectInsightsSchema: GenMessage<ProjectInsights> = /*@__PURE__*/
-  messageDesc(file_watchfire, 79);
+export const SearchLogsResponseSchema: GenMessage<SearchLogsResponse> = /*@__PURE__*/
+  messageDesc(file_watchfire, 85);
 
 /**
- * GetTaskDiffRequest names a task whose diff the daemon should compute
- * against either the still-existing `watchfire/<n>` branch or, if the
- * branch was already merged + deleted, the canonical merge commit on the
- * project's default branch.
- *
- * @generated from message watchfire.GetTaskDiffRequest
+ * @generated from message watchfire.GetSessionEventsRequest
  */
-export type GetTaskDiffRequest = Message<"watchfire.GetTaskDiffRequest"> & {
+export type GetSessionEventsRequest = Message<"watchfire.GetSessionEventsRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
@@ -3234,770 +3319,3283 @@ export type GetTaskDiffRequest = Message<"watchfire.GetTaskDiffRequest"> & {
   projectId: string;
 
   /**
-   * @generated from field: int32 task_number = 3;
+   * @generated from field: string log_id = 3;
    */
-  taskNumber: number;
+  logId: string;
+
+  /**
+   * Only return these event types; empty = all
+   *
+   * @generated from field: repeated string types = 4;
+   */
+  types: string[];
 };
 
 /**
- * Describes the message watchfire.GetTaskDiffRequest.
- * Use `create(GetTaskDiffRequestSchema)` to create a new message.
+ * Describes the message watchfire.GetSessionEventsRequest.
+ * Use `create(GetSessionEventsRequestSchema)` to create a new message.
  */
-export const GetTaskDiffRequestSchema: GenMessage<GetTaskDiffRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 80);
+export const GetSessionEventsRequestSchema: GenMessage<GetSessionEventsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 86);
 
 /**
- * FileDiffSet is the structured top-level shape returned by
- * `InsightsService.GetTaskDiff`. The GUI / TUI render natively from this
- * — no unified-diff parsing required. `truncated` flips when the daemon
- * caps a >MaxDiffLines result.
+ * SessionEvent is one entry of a session's normalized event log. Which
+ * fields are set depends on type.
  *
- * @generated from message watchfire.FileDiffSet
+ * @generated from message watchfire.SessionEvent
  */
-export type FileDiffSet = Message<"watchfire.FileDiffSet"> & {
+export type SessionEvent = Message<"watchfire.SessionEvent"> & {
   /**
-   * @generated from field: repeated watchfire.FileDiff files = 1;
+   * 1-based position in the session
+   *
+   * @generated from field: int32 seq = 1;
    */
-  files: FileDiff[];
+  seq: number;
 
   /**
-   * @generated from field: int32 total_additions = 2;
+   * "user_message" | "assistant_message" | "tool_call" | "file_edit" | "shell_command" | "token_usage"
+   *
+   * @generated from field: string type = 2;
    */
-  totalAdditions: number;
+  type: string;
 
   /**
-   * @generated from field: int32 total_deletions = 3;
+   * RFC3339; empty when the transcript has no timestamps
+   *
+   * @generated from field: string time = 3;
    */
-  totalDeletions: number;
+  time: string;
 
   /**
-   * @generated from field: bool truncated = 4;
+   * Message text
+   *
+   * @generated from field: string text = 4;
    */
-  truncated: boolean;
-};
-
-/**
- * Describes the message watchfire.FileDiffSet.
- * Use `create(FileDiffSetSchema)` to create a new message.
- */
-export const FileDiffSetSchema: GenMessage<FileDiffSet> = /*@__PURE__*/
-  messageDesc(file_watchfire, 81);
+  text: string;
 
-/**
- * FileDiff is one file-level entry inside a FileDiffSet. Binary files
- * surface as `status=MODIFIED, hunks=[]` with a synthetic single hunk
- * whose header reads "Binary file changed" — consumers can render a
- * clear marker without trying to display bytes.
- *
- * @generated from message watchfire.FileDiff
- */
-export type FileDiff = Message<"watchfire.FileDiff"> & {
   /**
-   * @generated from field: string path = 1;
+   * Backend tool name (tool_call, file_edit, shell_command)
+   *
+   * @generated from field: string tool = 5;
    */
-  path: string;
+  tool: string;
 
   /**
-   * @generated from field: watchfire.FileDiff.Status status = 2;
+   * Links derived events to their tool_call
+   *
+   * @generated from field: string call_id = 6;
    */
-  status: FileDiff_Status;
+  callId: string;
 
   /**
-   * @generated from field: string old_path = 3;
+   * Compact JSON arguments (tool_call)
+   *
+   * @generated from field: string args = 7;
    */
-  oldPath: string;
+  args: string;
 
   /**
-   * @generated from field: repeated watchfire.Hunk hunks = 4;
+   * Truncated tool output (tool_call)
+   *
+   * @generated from field: string result = 8;
    */
-  hunks: Hunk[];
-};
-
-/**
- * Describes the message watchfire.FileDiff.
- * Use `create(FileDiffSchema)` to create a new message.
- */
-export const FileDiffSchema: GenMessage<FileDiff> = /*@__PURE__*/
-  messageDesc(file_watchfire, 82);
+  result: string;
 
-/**
- * @generated from enum watchfire.FileDiff.Status
- */
-export enum FileDiff_Status {
   /**
-   * @generated from enum value: MODIFIED = 0;
+   * Tool reported failure (tool_call)
+   *
+   * @generated from field: bool is_error = 9;
    */
-  MODIFIED = 0,
+  isError: boolean;
 
   /**
-   * @generated from enum value: ADDED = 1;
+   * Edited file (file_edit)
+   *
+   * @generated from field: string path = 10;
    */
-  ADDED = 1,
+  path: string;
 
   /**
-   * @generated from enum value: DELETED = 2;
+   * "write" | "modify" | "delete" (file_edit)
+   *
+   * @generated from field: string edit_kind = 11;
    */
-  DELETED = 2,
+  editKind: string;
 
   /**
-   * @generated from enum value: RENAMED = 3;
+   * Command line (shell_command)
+   *
+   * @generated from field: string command = 12;
    */
-  RENAMED = 3,
-}
+  command: string;
+
+  /**
+   * Unset when the transcript doesn't say (shell_command)
+   *
+   * @generated from field: optional int32 exit_code = 13;
+   */
+  exitCode?: number;
+
+  /**
+   * token_usage
+   *
+   * @generated from field: int64 tokens_in = 14;
+   */
+  tokensIn: bigint;
+
+  /**
+   * @generated from field: int64 tokens_out = 15;
+   */
+  tokensOut: bigint;
+
+  /**
+   * @generated from field: int64 cache_read_tokens = 16;
+   */
+  cacheReadTokens: bigint;
+};
 
 /**
- * Describes the enum watchfire.FileDiff.Status.
+ * Describes the message watchfire.SessionEvent.
+ * Use `create(SessionEventSchema)` to create a new message.
  */
-export const FileDiff_StatusSchema: GenEnum<FileDiff_Status> = /*@__PURE__*/
-  enumDesc(file_watchfire, 82, 0);
+export const SessionEventSchema: GenMessage<SessionEvent> = /*@__PURE__*/
+  messageDesc(file_watchfire, 87);
 
 /**
- * Hunk corresponds to one `@@ -<oldStart>,<oldLines> +<newStart>,<newLines> @@`
- * region of a unified diff. `header` carries the trailing context label
- * git emits after the second `@@` (the function name, when available).
+ * @generated from message watchfire.SessionEventList
+ */
+export type SessionEventList = Message<"watchfire.SessionEventList"> & {
+  /**
+   * @generated from field: repeated watchfire.SessionEvent events = 1;
+   */
+  events: SessionEvent[];
+};
+
+/**
+ * Describes the message watchfire.SessionEventList.
+ * Use `create(SessionEventListSchema)` to create a new message.
+ */
+export const SessionEventListSchema: GenMessage<SessionEventList> = /*@__PURE__*/
+  messageDesc(file_watchfire, 88);
+
+/**
+ * Notification is a single user-facing event the daemon emits when something
+ * the user cares about happens (a task fails, an autonomous run finishes, …).
+ * Mirrors the JSONL record written to ~/.watchfire/logs/<project_id>/notifications.log.
  *
- * @generated from message watchfire.Hunk
+ * @generated from message watchfire.Notification
  */
-export type Hunk = Message<"watchfire.Hunk"> & {
+export type Notification = Message<"watchfire.Notification"> & {
   /**
-   * @generated from field: int32 old_start = 1;
+   * Stable hash; duplicates collapse
+   *
+   * @generated from field: string id = 1;
    */
-  oldStart: number;
+  id: string;
 
   /**
-   * @generated from field: int32 old_lines = 2;
+   * @generated from field: string project_id = 2;
    */
-  oldLines: number;
+  projectId: string;
 
   /**
-   * @generated from field: int32 new_start = 3;
+   * 0 when not task-scoped
+   *
+   * @generated from field: int32 task_number = 3;
    */
-  newStart: number;
+  taskNumber: number;
 
   /**
-   * @generated from field: int32 new_lines = 4;
+   * @generated from field: string title = 4;
    */
-  newLines: number;
+  title: string;
 
   /**
-   * @generated from field: string header = 5;
+   * @generated from field: string body = 5;
    */
-  header: string;
+  body: string;
 
   /**
-   * @generated from field: repeated watchfire.DiffLine lines = 6;
+   * @generated from field: google.protobuf.Timestamp emitted_at = 6;
    */
-  lines: DiffLine[];
+  emittedAt?: Timestamp;
+
+  /**
+   * @generated from field: watchfire.NotificationKind kind = 7;
+   */
+  kind: NotificationKind;
+
+  /**
+   * Merge error / agent message, when the kind has one
+   *
+   * @generated from field: string detail = 8;
+   */
+  detail: string;
+
+  /**
+   * PR_OPENED
+   *
+   * @generated from field: string url = 9;
+   */
+  url: string;
+
+  /**
+   * WILDFIRE_PHASE_CHANGED
+   *
+   * @generated from field: string phase = 10;
+   */
+  phase: string;
+
+  /**
+   * WILDFIRE_PHASE_CHANGED
+   *
+   * @generated from field: string previous_phase = 11;
+   */
+  previousPhase: string;
+
+  /**
+   * TASKS_GENERATED
+   *
+   * @generated from field: int32 count = 12;
+   */
+  count: number;
 };
 
 /**
- * Describes the message watchfire.Hunk.
- * Use `create(HunkSchema)` to create a new message.
+ * Describes the message watchfire.Notification.
+ * Use `create(NotificationSchema)` to create a new message.
  */
-export const HunkSchema: GenMessage<Hunk> = /*@__PURE__*/
-  messageDesc(file_watchfire, 83);
+export const NotificationSchema: GenMessage<Notification> = /*@__PURE__*/
+  messageDesc(file_watchfire, 89);
 
 /**
- * DiffLine is one line inside a Hunk. `text` excludes the leading +/-/space
- * prefix; the kind tag carries that semantic.
- *
- * @generated from message watchfire.DiffLine
+ * @generated from message watchfire.SubscribeNotificationsRequest
  */
-export type DiffLine = Message<"watchfire.DiffLine"> & {
-  /**
-   * @generated from field: watchfire.DiffLine.Kind kind = 1;
-   */
-  kind: DiffLine_Kind;
-
+export type SubscribeNotificationsRequest = Message<"watchfire.SubscribeNotificationsRequest"> & {
   /**
-   * @generated from field: string text = 2;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  text: string;
+  meta?: RequestMeta;
 };
 
 /**
- * Describes the message watchfire.DiffLine.
- * Use `create(DiffLineSchema)` to create a new message.
+ * Describes the message watchfire.SubscribeNotificationsRequest.
+ * Use `create(SubscribeNotificationsRequestSchema)` to create a new message.
  */
-export const DiffLineSchema: GenMessage<DiffLine> = /*@__PURE__*/
-  messageDesc(file_watchfire, 84);
+export const SubscribeNotificationsRequestSchema: GenMessage<SubscribeNotificationsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 90);
 
 /**
- * @generated from enum watchfire.DiffLine.Kind
+ * ExportReportRequest names a scope (single task / project / fleet-wide
+ * global) and a format. window_start / window_end bound the time-range the
+ * report covers (applies to per-day breakdowns; ignored for single_task).
+ *
+ * @generated from message watchfire.ExportReportRequest
  */
-export enum DiffLine_Kind {
+export type ExportReportRequest = Message<"watchfire.ExportReportRequest"> & {
   /**
-   * @generated from enum value: CONTEXT = 0;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  CONTEXT = 0,
+  meta?: RequestMeta;
 
   /**
-   * @generated from enum value: ADD = 1;
+   * @generated from oneof watchfire.ExportReportRequest.scope
    */
-  ADD = 1,
+  scope: {
+    /**
+     * Per-project report
+     *
+     * @generated from field: string project_id = 2;
+     */
+    value: string;
+    case: "projectId";
+  } | {
+    /**
+     * Fleet rollup
+     *
+     * @generated from field: bool global = 3;
+     */
+    value: boolean;
+    case: "global";
+  } | {
+    /**
+     * "<project_id>:<task_number>" — one task
+     *
+     * @generated from field: string single_task = 4;
+     */
+    value: string;
+    case: "singleTask";
+  } | { case: undefined; value?: undefined };
 
   /**
-   * @generated from enum value: DEL = 2;
+   * @generated from field: watchfire.ExportFormat format = 5;
    */
-  DEL = 2,
-}
+  format: ExportFormat;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 6;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 7;
+   */
+  windowEnd?: Timestamp;
+};
 
 /**
- * Describes the enum watchfire.DiffLine.Kind.
+ * Describes the message watchfire.ExportReportRequest.
+ * Use `create(ExportReportRequestSchema)` to create a new message.
  */
-export const DiffLine_KindSchema: GenEnum<DiffLine_Kind> = /*@__PURE__*/
-  enumDesc(file_watchfire, 84, 0);
+export const ExportReportRequestSchema: GenMessage<ExportReportRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 91);
 
 /**
- * IntegrationEvents is the per-integration event-bitmask. Mirrors the
- * daemon-side `models.EventBitmask` shape. Each integration carries its
- * own copy so the user can fan TASK_FAILED to Slack but RUN_COMPLETE to
- * Discord without duplicating endpoints.
+ * ExportReportResponse carries the rendered file. content is the raw bytes
+ * (UTF-8 text for every format); the GUI saves it via Blob URL using the
+ * supplied filename. mime is `text/csv`, `text/markdown`,
+ * `application/json` or `text/html`.
  *
- * @generated from message watchfire.IntegrationEvents
+ * @generated from message watchfire.ExportReportResponse
  */
-export type IntegrationEvents = Message<"watchfire.IntegrationEvents"> & {
+export type ExportReportResponse = Message<"watchfire.ExportReportResponse"> & {
   /**
-   * @generated from field: bool task_failed = 1;
+   * @generated from field: string filename = 1;
    */
-  taskFailed: boolean;
+  filename: string;
 
   /**
-   * @generated from field: bool run_complete = 2;
+   * @generated from field: bytes content = 2;
    */
-  runComplete: boolean;
+  content: Uint8Array;
 
   /**
-   * @generated from field: bool weekly_digest = 3;
+   * @generated from field: string mime = 3;
    */
-  weeklyDigest: boolean;
+  mime: string;
 };
 
 /**
- * Describes the message watchfire.IntegrationEvents.
- * Use `create(IntegrationEventsSchema)` to create a new message.
+ * Describes the message watchfire.ExportReportResponse.
+ * Use `create(ExportReportResponseSchema)` to create a new message.
  */
-export const IntegrationEventsSchema: GenMessage<IntegrationEvents> = /*@__PURE__*/
-  messageDesc(file_watchfire, 85);
+export const ExportReportResponseSchema: GenMessage<ExportReportResponse> = /*@__PURE__*/
+  messageDesc(file_watchfire, 92);
 
 /**
- * WebhookIntegration is a single generic outbound webhook target. The
- * `secret` field is write-only — Save accepts it (and pushes it to the
- * keyring); List + the response of Save scrub it (returning only
- * `secret_set: bool`).
+ * GetGlobalInsightsRequest bounds a fleet-wide rollup query. Both bounds
+ * are optional — an unset window_start collapses to "no lower bound" and
+ * likewise for window_end. The dashboard typically passes a 30-day window.
  *
- * @generated from message watchfire.WebhookIntegration
+ * @generated from message watchfire.GetGlobalInsightsRequest
  */
-export type WebhookIntegration = Message<"watchfire.WebhookIntegration"> & {
+export type GetGlobalInsightsRequest = Message<"watchfire.GetGlobalInsightsRequest"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  id: string;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
    */
-  label: string;
+  windowStart?: Timestamp;
 
   /**
-   * @generated from field: string url = 3;
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
    */
-  url: string;
+  windowEnd?: Timestamp;
 
   /**
-   * Masked, display-only ("***hooks.example.com/...")
+   * Optional comparison window. Unset, a window bounded at both ends is
+   * compared with the period of the same length just before it.
    *
-   * @generated from field: string url_label = 4;
+   * @generated from field: google.protobuf.Timestamp compare_window_start = 4;
    */
-  urlLabel: string;
+  compareWindowStart?: Timestamp;
 
   /**
-   * True if the keyring carries a secret
-   *
-   * @generated from field: bool secret_set = 5;
+   * @generated from field: google.protobuf.Timestamp compare_window_end = 5;
    */
-  secretSet: boolean;
+  compareWindowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message watchfire.GetGlobalInsightsRequest.
+ * Use `create(GetGlobalInsightsRequestSchema)` to create a new message.
+ */
+export const GetGlobalInsightsRequestSchema: GenMessage<GetGlobalInsightsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 93);
+
+/**
+ * DayBucket — one calendar day's task counts. Used by both per-project and
+ * fleet rollups to render stacked bars / sparklines.
+ *
+ * @generated from message watchfire.DayBucket
+ */
+export type DayBucket = Message<"watchfire.DayBucket"> & {
+  /**
+   * YYYY-MM-DD (local zone)
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * succeeded + failed
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * @generated from field: int32 succeeded = 3;
+   */
+  succeeded: number;
+
+  /**
+   * @generated from field: int32 failed = 4;
+   */
+  failed: number;
+
+  /**
+   * v8.0 Inferno — code churn for the day, for a churn-by-day chart drawn
+   * next to the tasks-by-day bars. Zero on days whose tasks lack code
+   * metrics (pre-v8.0 captures).
+   *
+   * @generated from field: int32 lines_added = 5;
+   */
+  linesAdded: number;
+
+  /**
+   * @generated from field: int32 lines_removed = 6;
+   */
+  linesRemoved: number;
+};
+
+/**
+ * Describes the message watchfire.DayBucket.
+ * Use `create(DayBucketSchema)` to create a new message.
+ */
+export const DayBucketSchema: GenMessage<DayBucket> = /*@__PURE__*/
+  messageDesc(file_watchfire, 94);
+
+/**
+ * AgentBreakdown — one row per backend agent that touched tasks in the
+ * window. Field set is shared between the per-project and global rollups
+ * so the same renderer code works for both surfaces.
+ *
+ * @generated from message watchfire.AgentBreakdown
+ */
+export type AgentBreakdown = Message<"watchfire.AgentBreakdown"> & {
+  /**
+   * @generated from field: string agent = 1;
+   */
+  agent: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * @generated from field: double success_rate = 3;
+   */
+  successRate: number;
+
+  /**
+   * @generated from field: int64 avg_duration_ms = 4;
+   */
+  avgDurationMs: bigint;
+
+  /**
+   * @generated from field: int64 total_tokens_in = 5;
+   */
+  totalTokensIn: bigint;
+
+  /**
+   * @generated from field: int64 total_tokens_out = 6;
+   */
+  totalTokensOut: bigint;
+
+  /**
+   * @generated from field: double total_cost_usd = 7;
+   */
+  totalCostUsd: number;
+
+  /**
+   * v8.0 Inferno — output-per-agent, so agents are comparable on shipped
+   * code, not just task count. Zero for tasks lacking code metrics.
+   *
+   * @generated from field: int32 commits = 8;
+   */
+  commits: number;
+
+  /**
+   * @generated from field: int32 lines_added = 9;
+   */
+  linesAdded: number;
+
+  /**
+   * @generated from field: int32 lines_removed = 10;
+   */
+  linesRemoved: number;
+};
+
+/**
+ * Describes the message watchfire.AgentBreakdown.
+ * Use `create(AgentBreakdownSchema)` to create a new message.
+ */
+export const AgentBreakdownSchema: GenMessage<AgentBreakdown> = /*@__PURE__*/
+  messageDesc(file_watchfire, 95);
+
+/**
+ * AgentComparison — one row per (backend, model) pair that completed tasks
+ * in the window, for judging which agent works best on a repo. Rates are
+ * 0..1 over `tasks`. `model` is empty when the session log named none.
+ *
+ * @generated from message watchfire.AgentComparison
+ */
+export type AgentComparison = Message<"watchfire.AgentComparison"> & {
+  /**
+   * @generated from field: string agent = 1;
+   */
+  agent: string;
+
+  /**
+   * @generated from field: string model = 2;
+   */
+  model: string;
+
+  /**
+   * @generated from field: int32 tasks = 3;
+   */
+  tasks: number;
+
+  /**
+   * @generated from field: int32 succeeded = 4;
+   */
+  succeeded: number;
+
+  /**
+   * @generated from field: double success_rate = 5;
+   */
+  successRate: number;
+
+  /**
+   * @generated from field: int64 median_duration_ms = 6;
+   */
+  medianDurationMs: bigint;
+
+  /**
+   * @generated from field: int64 p90_duration_ms = 7;
+   */
+  p90DurationMs: bigint;
+
+  /**
+   * @generated from field: double total_cost_usd = 8;
+   */
+  totalCostUsd: number;
+
+  /**
+   * total_cost_usd / succeeded; 0 with no successes
+   *
+   * @generated from field: double cost_per_success_usd = 9;
+   */
+  costPerSuccessUsd: number;
+
+  /**
+   * Succeeded but the auto-merge failed
+   *
+   * @generated from field: int32 merge_failures = 10;
+   */
+  mergeFailures: number;
+
+  /**
+   * @generated from field: double merge_failure_rate = 11;
+   */
+  mergeFailureRate: number;
+
+  /**
+   * Needed more than one agent session
+   *
+   * @generated from field: int32 follow_ups = 12;
+   */
+  followUps: number;
+
+  /**
+   * @generated from field: double follow_up_rate = 13;
+   */
+  followUpRate: number;
+
+  /**
+   * Commits later reverted on the default branch
+   *
+   * @generated from field: int32 reverted = 14;
+   */
+  reverted: number;
+
+  /**
+   * @generated from field: double revert_rate = 15;
+   */
+  revertRate: number;
+
+  /**
+   * @generated from field: int32 lines_added = 16;
+   */
+  linesAdded: number;
+
+  /**
+   * @generated from field: int32 lines_removed = 17;
+   */
+  linesRemoved: number;
+};
+
+/**
+ * Describes the message watchfire.AgentComparison.
+ * Use `create(AgentComparisonSchema)` to create a new message.
+ */
+export const AgentComparisonSchema: GenMessage<AgentComparison> = /*@__PURE__*/
+  messageDesc(file_watchfire, 96);
+
+/**
+ * UserUsage is one person's share of a window: the completed tasks they
+ * started (or, when nobody is recorded as starting them, created), the
+ * tasks they created, and the non-task sessions they started. `user` is
+ * empty for unattributed work.
+ *
+ * @generated from message watchfire.UserUsage
+ */
+export type UserUsage = Message<"watchfire.UserUsage"> & {
+  /**
+   * @generated from field: string user = 1;
+   */
+  user: string;
+
+  /**
+   * @generated from field: int32 tasks = 2;
+   */
+  tasks: number;
+
+  /**
+   * @generated from field: int32 succeeded = 3;
+   */
+  succeeded: number;
+
+  /**
+   * @generated from field: double success_rate = 4;
+   */
+  successRate: number;
+
+  /**
+   * @generated from field: int64 duration_ms = 5;
+   */
+  durationMs: bigint;
+
+  /**
+   * @generated from field: double task_cost_usd = 6;
+   */
+  taskCostUsd: number;
+
+  /**
+   * @generated from field: int32 net_lines = 7;
+   */
+  netLines: number;
+
+  /**
+   * @generated from field: int32 tasks_created = 8;
+   */
+  tasksCreated: number;
+
+  /**
+   * Non-task sessions started
+   *
+   * @generated from field: int32 sessions = 9;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: double session_cost_usd = 10;
+   */
+  sessionCostUsd: number;
+
+  /**
+   * task_cost_usd + session_cost_usd
+   *
+   * @generated from field: double total_cost_usd = 11;
+   */
+  totalCostUsd: number;
+};
+
+/**
+ * Describes the message watchfire.UserUsage.
+ * Use `create(UserUsageSchema)` to create a new message.
+ */
+export const UserUsageSchema: GenMessage<UserUsage> = /*@__PURE__*/
+  messageDesc(file_watchfire, 97);
+
+/**
+ * InsightsOverhead — agent sessions that ran outside a task (chat,
+ * wildfire refine / generate, definition and task generation), which the
+ * task totals don't include. `cost_share` is the overhead's part of task
+ * plus overhead spend, 0..1.
+ *
+ * @generated from message watchfire.InsightsOverhead
+ */
+export type InsightsOverhead = Message<"watchfire.InsightsOverhead"> & {
+  /**
+   * @generated from field: int32 sessions = 1;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: int64 duration_ms = 2;
+   */
+  durationMs: bigint;
+
+  /**
+   * @generated from field: int64 tokens_in = 3;
+   */
+  tokensIn: bigint;
+
+  /**
+   * @generated from field: int64 tokens_out = 4;
+   */
+  tokensOut: bigint;
+
+  /**
+   * @generated from field: double cost_usd = 5;
+   */
+  costUsd: number;
+
+  /**
+   * @generated from field: int32 sessions_missing_cost = 6;
+   */
+  sessionsMissingCost: number;
+
+  /**
+   * @generated from field: double cost_share = 7;
+   */
+  costShare: number;
+
+  /**
+   * @generated from field: repeated watchfire.OverheadKind by_kind = 8;
+   */
+  byKind: OverheadKind[];
+};
+
+/**
+ * Describes the message watchfire.InsightsOverhead.
+ * Use `create(InsightsOverheadSchema)` to create a new message.
+ */
+export const InsightsOverheadSchema: GenMessage<InsightsOverhead> = /*@__PURE__*/
+  messageDesc(file_watchfire, 98);
+
+/**
+ * OverheadKind — one session kind's part of InsightsOverhead. `kind` is
+ * the agent mode, qualified by the wildfire phase ("wildfire-refine").
+ *
+ * @generated from message watchfire.OverheadKind
+ */
+export type OverheadKind = Message<"watchfire.OverheadKind"> & {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: int32 sessions = 2;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: int64 duration_ms = 3;
+   */
+  durationMs: bigint;
+
+  /**
+   * @generated from field: int64 tokens_in = 4;
+   */
+  tokensIn: bigint;
+
+  /**
+   * @generated from field: int64 tokens_out = 5;
+   */
+  tokensOut: bigint;
+
+  /**
+   * @generated from field: double cost_usd = 6;
+   */
+  costUsd: number;
+};
+
+/**
+ * Describes the message watchfire.OverheadKind.
+ * Use `create(OverheadKindSchema)` to create a new message.
+ */
+export const OverheadKindSchema: GenMessage<OverheadKind> = /*@__PURE__*/
+  messageDesc(file_watchfire, 99);
+
+/**
+ * MetricDelta — one figure in the window against the comparison window.
+ * `change` is current − previous (points, 0..1, for rates); `change_pct`
+ * is change / |previous|, 0 when previous is 0.
+ *
+ * @generated from message watchfire.MetricDelta
+ */
+export type MetricDelta = Message<"watchfire.MetricDelta"> & {
+  /**
+   * @generated from field: double current = 1;
+   */
+  current: number;
+
+  /**
+   * @generated from field: double previous = 2;
+   */
+  previous: number;
+
+  /**
+   * @generated from field: double change = 3;
+   */
+  change: number;
+
+  /**
+   * @generated from field: double change_pct = 4;
+   */
+  changePct: number;
+};
+
+/**
+ * Describes the message watchfire.MetricDelta.
+ * Use `create(MetricDeltaSchema)` to create a new message.
+ */
+export const MetricDeltaSchema: GenMessage<MetricDelta> = /*@__PURE__*/
+  messageDesc(file_watchfire, 100);
+
+/**
+ * InsightsComparison — a rollup against an earlier window.
+ * `regressions` are human-readable reasons ("success rate down 15 pts
+ * (80% → 65%)") for a success-rate drop of 10 points or a 50% rise in cost
+ * per task, when both windows completed at least 3 tasks.
+ *
+ * @generated from message watchfire.InsightsComparison
+ */
+export type InsightsComparison = Message<"watchfire.InsightsComparison"> & {
+  /**
+   * Comparison window
+   *
+   * @generated from field: google.protobuf.Timestamp window_start = 1;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 2;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: watchfire.MetricDelta tasks = 3;
+   */
+  tasks?: MetricDelta;
+
+  /**
+   * @generated from field: watchfire.MetricDelta success_rate = 4;
+   */
+  successRate?: MetricDelta;
+
+  /**
+   * @generated from field: watchfire.MetricDelta cost_usd = 5;
+   */
+  costUsd?: MetricDelta;
+
+  /**
+   * @generated from field: watchfire.MetricDelta net_lines = 6;
+   */
+  netLines?: MetricDelta;
+
+  /**
+   * @generated from field: repeated string regressions = 7;
+   */
+  regressions: string[];
+};
+
+/**
+ * Describes the message watchfire.InsightsComparison.
+ * Use `create(InsightsComparisonSchema)` to create a new message.
+ */
+export const InsightsComparisonSchema: GenMessage<InsightsComparison> = /*@__PURE__*/
+  messageDesc(file_watchfire, 101);
+
+/**
+ * TrendWeek — one Monday-to-Sunday week (local time) of the rolling trend.
+ * Empty weeks are kept, zeroed.
+ *
+ * @generated from message watchfire.TrendWeek
+ */
+export type TrendWeek = Message<"watchfire.TrendWeek"> & {
+  /**
+   * YYYY-MM-DD, the Monday
+   *
+   * @generated from field: string week_start = 1;
+   */
+  weekStart: string;
+
+  /**
+   * @generated from field: int32 tasks = 2;
+   */
+  tasks: number;
+
+  /**
+   * @generated from field: int32 succeeded = 3;
+   */
+  succeeded: number;
+
+  /**
+   * @generated from field: double success_rate = 4;
+   */
+  successRate: number;
+
+  /**
+   * @generated from field: double cost_usd = 5;
+   */
+  costUsd: number;
+
+  /**
+   * @generated from field: int32 net_lines = 6;
+   */
+  netLines: number;
+};
+
+/**
+ * Describes the message watchfire.TrendWeek.
+ * Use `create(TrendWeekSchema)` to create a new message.
+ */
+export const TrendWeekSchema: GenMessage<TrendWeek> = /*@__PURE__*/
+  messageDesc(file_watchfire, 102);
+
+/**
+ * TopProject — one row of the fleet rollup's top-projects pill list,
+ * sorted by completed-task count descending. The dashboard renders these
+ * as clickable pills that route to each project's InsightsTab.
+ *
+ * @generated from message watchfire.TopProject
+ */
+export type TopProject = Message<"watchfire.TopProject"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string project_name = 2;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: string project_color = 3;
+   */
+  projectColor: string;
+
+  /**
+   * @generated from field: int32 count = 4;
+   */
+  count: number;
+
+  /**
+   * @generated from field: double success_rate = 5;
+   */
+  successRate: number;
+
+  /**
+   * v8.0 Inferno — per-project shipped-code totals over the window, so the
+   * fleet rollup can rank projects by net lines / churn rather than only by
+   * completed-task count. Zero for projects whose tasks lack code metrics.
+   *
+   * @generated from field: int32 commits = 6;
+   */
+  commits: number;
+
+  /**
+   * @generated from field: int32 lines_added = 7;
+   */
+  linesAdded: number;
+
+  /**
+   * @generated from field: int32 lines_removed = 8;
+   */
+  linesRemoved: number;
+
+  /**
+   * @generated from field: int32 net_lines = 9;
+   */
+  netLines: number;
+
+  /**
+   * Completed tasks merged in the window (silent merge or auto-PR). Lets the
+   * mission-control home window show a per-card "shipped" line including merge
+   * count alongside churn. Zero for projects whose tasks lack code metrics.
+   *
+   * @generated from field: int32 merges = 10;
+   */
+  merges: number;
+};
+
+/**
+ * Describes the message watchfire.TopProject.
+ * Use `create(TopProjectSchema)` to create a new message.
+ */
+export const TopProjectSchema: GenMessage<TopProject> = /*@__PURE__*/
+  messageDesc(file_watchfire, 103);
+
+/**
+ * GlobalInsights is the cross-project rollup the daemon returns from
+ * `GetGlobalInsights`. Sums every per-project metric over the window;
+ * `tasks_missing_cost` surfaces a partial-data caveat when not every
+ * completed task carried a cost number.
+ *
+ * @generated from message watchfire.GlobalInsights
+ */
+export type GlobalInsights = Message<"watchfire.GlobalInsights"> & {
+  /**
+   * @generated from field: int32 tasks_total = 1;
+   */
+  tasksTotal: number;
+
+  /**
+   * @generated from field: int32 tasks_succeeded = 2;
+   */
+  tasksSucceeded: number;
+
+  /**
+   * @generated from field: int32 tasks_failed = 3;
+   */
+  tasksFailed: number;
+
+  /**
+   * @generated from field: repeated watchfire.DayBucket tasks_by_day = 4;
+   */
+  tasksByDay: DayBucket[];
+
+  /**
+   * @generated from field: repeated watchfire.TopProject top_projects = 5;
+   */
+  topProjects: TopProject[];
+
+  /**
+   * @generated from field: repeated watchfire.AgentBreakdown agent_breakdown = 6;
+   */
+  agentBreakdown: AgentBreakdown[];
+
+  /**
+   * @generated from field: int64 total_duration_ms = 7;
+   */
+  totalDurationMs: bigint;
+
+  /**
+   * @generated from field: double total_cost_usd = 8;
+   */
+  totalCostUsd: number;
+
+  /**
+   * @generated from field: int32 tasks_missing_cost = 9;
+   */
+  tasksMissingCost: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 10;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 11;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * v8.0 Inferno — fleet-wide shipped-code rollup. `metrics_missing_code`
+   * counts completed tasks with no code metrics so the GUI can honestly say
+   * "based on N of M tasks". Older cached payloads without these fields read
+   * them as zero.
+   *
+   * @generated from field: int32 total_commits = 12;
+   */
+  totalCommits: number;
+
+  /**
+   * @generated from field: int32 total_files_changed = 13;
+   */
+  totalFilesChanged: number;
+
+  /**
+   * @generated from field: int32 total_lines_added = 14;
+   */
+  totalLinesAdded: number;
+
+  /**
+   * @generated from field: int32 total_lines_removed = 15;
+   */
+  totalLinesRemoved: number;
+
+  /**
+   * @generated from field: int32 net_lines = 16;
+   */
+  netLines: number;
+
+  /**
+   * @generated from field: int32 tasks_merged = 17;
+   */
+  tasksMerged: number;
+
+  /**
+   * @generated from field: int32 tasks_via_pr = 18;
+   */
+  tasksViaPr: number;
+
+  /**
+   * @generated from field: int32 metrics_missing_code = 19;
+   */
+  metricsMissingCode: number;
+
+  /**
+   * The part of total_cost_usd estimated from the settings.yaml pricing
+   * table for backends that don't report cost, and the tasks it covers.
+   *
+   * @generated from field: double estimated_cost_usd = 20;
+   */
+  estimatedCostUsd: number;
+
+  /**
+   * @generated from field: int32 tasks_estimated_cost = 21;
+   */
+  tasksEstimatedCost: number;
+
+  /**
+   * Standing of every configured monthly budget for the current month
+   * (not the query window): the global one first, then per-project ones.
+   *
+   * @generated from field: repeated watchfire.BudgetStatus budgets = 22;
+   */
+  budgets: BudgetStatus[];
+
+  /**
+   * Per backend + model comparison across every project, most tasks first.
+   *
+   * @generated from field: repeated watchfire.AgentComparison agent_comparison = 23;
+   */
+  agentComparison: AgentComparison[];
+
+  /**
+   * @generated from field: watchfire.InsightsOverhead overhead = 24;
+   */
+  overhead?: InsightsOverhead;
+
+  /**
+   * The window against the comparison window; unset when there is none
+   * (an all-time query without compare_window_*).
+   *
+   * @generated from field: watchfire.InsightsComparison comparison = 25;
+   */
+  comparison?: InsightsComparison;
+
+  /**
+   * The 12 weeks ending with the window's last week, oldest first.
+   *
+   * @generated from field: repeated watchfire.TrendWeek trend = 26;
+   */
+  trend: TrendWeek[];
+
+  /**
+   * Usage and spend per person, highest total cost first.
+   *
+   * @generated from field: repeated watchfire.UserUsage users = 27;
+   */
+  users: UserUsage[];
+};
+
+/**
+ * Describes the message watchfire.GlobalInsights.
+ * Use `create(GlobalInsightsSchema)` to create a new message.
+ */
+export const GlobalInsightsSchema: GenMessage<GlobalInsights> = /*@__PURE__*/
+  messageDesc(file_watchfire, 104);
+
+/**
+ * BudgetStatus is one monthly budget's standing.
+ *
+ * @generated from message watchfire.BudgetStatus
+ */
+export type BudgetStatus = Message<"watchfire.BudgetStatus"> & {
+  /**
+   * "global" | "project"
+   *
+   * @generated from field: string scope = 1;
+   */
+  scope: string;
+
+  /**
+   * Empty for the global budget
+   *
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string project_name = 3;
+   */
+  projectName: string;
+
+  /**
+   * YYYY-MM, local time
+   *
+   * @generated from field: string month = 4;
+   */
+  month: string;
+
+  /**
+   * @generated from field: double limit_usd = 5;
+   */
+  limitUsd: number;
+
+  /**
+   * @generated from field: double spent_usd = 6;
+   */
+  spentUsd: number;
+
+  /**
+   * Highest threshold reached; 0 = none
+   *
+   * @generated from field: int32 threshold = 7;
+   */
+  threshold: number;
+
+  /**
+   * @generated from field: bool hard_stop = 8;
+   */
+  hardStop: boolean;
+
+  /**
+   * spent_usd >= limit_usd
+   *
+   * @generated from field: bool exceeded = 9;
+   */
+  exceeded: boolean;
+
+  /**
+   * hard_stop && exceeded: non-chat starts are refused
+   *
+   * @generated from field: bool blocking = 10;
+   */
+  blocking: boolean;
+};
+
+/**
+ * Describes the message watchfire.BudgetStatus.
+ * Use `create(BudgetStatusSchema)` to create a new message.
+ */
+export const BudgetStatusSchema: GenMessage<BudgetStatus> = /*@__PURE__*/
+  messageDesc(file_watchfire, 105);
+
+/**
+ * GetProjectInsightsRequest scopes a per-project insights query. Both
+ * window bounds are optional — leaving them unset queries "all time".
+ *
+ * @generated from message watchfire.GetProjectInsightsRequest
+ */
+export type GetProjectInsightsRequest = Message<"watchfire.GetProjectInsightsRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * Optional comparison window; see GetGlobalInsightsRequest.
+   *
+   * @generated from field: google.protobuf.Timestamp compare_window_start = 5;
+   */
+  compareWindowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp compare_window_end = 6;
+   */
+  compareWindowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message watchfire.GetProjectInsightsRequest.
+ * Use `create(GetProjectInsightsRequestSchema)` to create a new message.
+ */
+export const GetProjectInsightsRequestSchema: GenMessage<GetProjectInsightsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 106);
+
+/**
+ * ProjectInsights is the per-project rollup the daemon returns from
+ * `GetProjectInsights`. Same shape primitives as GlobalInsights — the
+ * dashboard's per-project Insights tab and the TUI per-project overlay
+ * reuse the same renderers.
+ *
+ * @generated from message watchfire.ProjectInsights
+ */
+export type ProjectInsights = Message<"watchfire.ProjectInsights"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: int32 tasks_total = 2;
+   */
+  tasksTotal: number;
+
+  /**
+   * @generated from field: int32 tasks_succeeded = 3;
+   */
+  tasksSucceeded: number;
+
+  /**
+   * @generated from field: int32 tasks_failed = 4;
+   */
+  tasksFailed: number;
+
+  /**
+   * @generated from field: repeated watchfire.DayBucket tasks_by_day = 5;
+   */
+  tasksByDay: DayBucket[];
+
+  /**
+   * @generated from field: repeated watchfire.AgentBreakdown agent_breakdown = 6;
+   */
+  agentBreakdown: AgentBreakdown[];
+
+  /**
+   * @generated from field: int64 total_duration_ms = 7;
+   */
+  totalDurationMs: bigint;
+
+  /**
+   * @generated from field: int64 avg_duration_ms = 8;
+   */
+  avgDurationMs: bigint;
+
+  /**
+   * @generated from field: int64 p50_duration_ms = 9;
+   */
+  p50DurationMs: bigint;
+
+  /**
+   * @generated from field: int64 p95_duration_ms = 10;
+   */
+  p95DurationMs: bigint;
+
+  /**
+   * @generated from field: double total_cost_usd = 11;
+   */
+  totalCostUsd: number;
+
+  /**
+   * @generated from field: int32 tasks_missing_cost = 12;
+   */
+  tasksMissingCost: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 13;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 14;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * v8.0 Inferno — per-project shipped-code rollup. Mirrors GlobalInsights;
+   * `metrics_missing_code` drives the "based on N of M tasks" coverage note
+   * in the Insights tab. Older cached payloads read these as zero.
+   *
+   * @generated from field: int32 total_commits = 15;
+   */
+  totalCommits: number;
+
+  /**
+   * @generated from field: int32 total_files_changed = 16;
+   */
+  totalFilesChanged: number;
+
+  /**
+   * @generated from field: int32 total_lines_added = 17;
+   */
+  totalLinesAdded: number;
+
+  /**
+   * @generated from field: int32 total_lines_removed = 18;
+   */
+  totalLinesRemoved: number;
+
+  /**
+   * @generated from field: int32 net_lines = 19;
+   */
+  netLines: number;
+
+  /**
+   * @generated from field: int32 tasks_merged = 20;
+   */
+  tasksMerged: number;
+
+  /**
+   * @generated from field: int32 tasks_via_pr = 21;
+   */
+  tasksViaPr: number;
+
+  /**
+   * @generated from field: int32 metrics_missing_code = 22;
+   */
+  metricsMissingCode: number;
+
+  /**
+   * Mirrors GlobalInsights: the estimated share of total_cost_usd.
+   *
+   * @generated from field: double estimated_cost_usd = 23;
+   */
+  estimatedCostUsd: number;
+
+  /**
+   * @generated from field: int32 tasks_estimated_cost = 24;
+   */
+  tasksEstimatedCost: number;
+
+  /**
+   * Per backend + model comparison, most tasks first.
+   *
+   * @generated from field: repeated watchfire.AgentComparison agent_comparison = 25;
+   */
+  agentComparison: AgentComparison[];
+
+  /**
+   * @generated from field: watchfire.InsightsOverhead overhead = 26;
+   */
+  overhead?: InsightsOverhead;
+
+  /**
+   * Mirrors GlobalInsights.
+   *
+   * @generated from field: watchfire.InsightsComparison comparison = 27;
+   */
+  comparison?: InsightsComparison;
+
+  /**
+   * @generated from field: repeated watchfire.TrendWeek trend = 28;
+   */
+  trend: TrendWeek[];
+
+  /**
+   * @generated from field: repeated watchfire.UserUsage users = 29;
+   */
+  users: UserUsage[];
+};
+
+/**
+ * Describes the message watchfire.ProjectInsights.
+ * Use `create(ProjectInsightsSchema)` to create a new message.
+ */
+export const ProjectInsightsSchema: GenMessage<ProjectInsights> = /*@__PURE__*/
+  messageDesc(file_watchfire, 107);
+
+/**
+ * GetTaskDiffRequest names a task whose diff the daemon should compute
+ * against either the still-existing `watchfire/<n>` branch or, if the
+ * branch was already merged + deleted, the canonical merge commit on the
+ * project's default branch.
+ *
+ * @generated from message watchfire.GetTaskDiffRequest
+ */
+export type GetTaskDiffRequest = Message<"watchfire.GetTaskDiffRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: int32 task_number = 3;
+   */
+  taskNumber: number;
+};
+
+/**
+ * Describes the message watchfire.GetTaskDiffRequest.
+ * Use `create(GetTaskDiffRequestSchema)` to create a new message.
+ */
+export const GetTaskDiffRequestSchema: GenMessage<GetTaskDiffRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 108);
+
+/**
+ * FileDiffSet is the structured top-level shape returned by
+ * `InsightsService.GetTaskDiff`. The GUI / TUI render natively from this
+ * — no unified-diff parsing required. `truncated` flips when the daemon
+ * caps a >MaxDiffLines result.
+ *
+ * @generated from message watchfire.FileDiffSet
+ */
+export type FileDiffSet = Message<"watchfire.FileDiffSet"> & {
+  /**
+   * @generated from field: repeated watchfire.FileDiff files = 1;
+   */
+  files: FileDiff[];
+
+  /**
+   * @generated from field: int32 total_additions = 2;
+   */
+  totalAdditions: number;
+
+  /**
+   * @generated from field: int32 total_deletions = 3;
+   */
+  totalDeletions: number;
+
+  /**
+   * @generated from field: bool truncated = 4;
+   */
+  truncated: boolean;
+};
+
+/**
+ * Describes the message watchfire.FileDiffSet.
+ * Use `create(FileDiffSetSchema)` to create a new message.
+ */
+export const FileDiffSetSchema: GenMessage<FileDiffSet> = /*@__PURE__*/
+  messageDesc(file_watchfire, 109);
+
+/**
+ * FileDiff is one file-level entry inside a FileDiffSet. Binary files
+ * surface as `status=MODIFIED, hunks=[]` with a synthetic single hunk
+ * whose header reads "Binary file changed" — consumers can render a
+ * clear marker without trying to display bytes.
+ *
+ * @generated from message watchfire.FileDiff
+ */
+export type FileDiff = Message<"watchfire.FileDiff"> & {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * @generated from field: watchfire.FileDiff.Status status = 2;
+   */
+  status: FileDiff_Status;
+
+  /**
+   * @generated from field: string old_path = 3;
+   */
+  oldPath: string;
+
+  /**
+   * @generated from field: repeated watchfire.Hunk hunks = 4;
+   */
+  hunks: Hunk[];
+};
+
+/**
+ * Describes the message watchfire.FileDiff.
+ * Use `create(FileDiffSchema)` to create a new message.
+ */
+export const FileDiffSchema: GenMessage<FileDiff> = /*@__PURE__*/
+  messageDesc(file_watchfire, 110);
+
+/**
+ * @generated from enum watchfire.FileDiff.Status
+ */
+export enum FileDiff_Status {
+  /**
+   * @generated from enum value: MODIFIED = 0;
+   */
+  MODIFIED = 0,
+
+  /**
+   * @generated from enum value: ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: DELETED = 2;
+   */
+  DELETED = 2,
+
+  /**
+   * @generated from enum value: RENAMED = 3;
+   */
+  RENAMED = 3,
+}
+
+/**
+ * Describes the enum watchfire.FileDiff.Status.
+ */
+export const FileDiff_StatusSchema: GenEnum<FileDiff_Status> = /*@__PURE__*/
+  enumDesc(file_watchfire, 110, 0);
+
+/**
+ * Hunk corresponds to one `@@ -<oldStart>,<oldLines> +<newStart>,<newLines> @@`
+ * region of a unified diff. `header` carries the trailing context label
+ * git emits after the second `@@` (the function name, when available).
+ *
+ * @generated from message watchfire.Hunk
+ */
+export type Hunk = Message<"watchfire.Hunk"> & {
+  /**
+   * @generated from field: int32 old_start = 1;
+   */
+  oldStart: number;
+
+  /**
+   * @generated from field: int32 old_lines = 2;
+   */
+  oldLines: number;
+
+  /**
+   * @generated from field: int32 new_start = 3;
+   */
+  newStart: number;
+
+  /**
+   * @generated from field: int32 new_lines = 4;
+   */
+  newLines: number;
+
+  /**
+   * @generated from field: string header = 5;
+   */
+  header: string;
+
+  /**
+   * @generated from field: repeated watchfire.DiffLine lines = 6;
+   */
+  lines: DiffLine[];
+};
+
+/**
+ * Describes the message watchfire.Hunk.
+ * Use `create(HunkSchema)` to create a new message.
+ */
+export const HunkSchema: GenMessage<Hunk> = /*@__PURE__*/
+  messageDesc(file_watchfire, 111);
+
+/**
+ * DiffLine is one line inside a Hunk. `text` excludes the leading +/-/space
+ * prefix; the kind tag carries that semantic.
+ *
+ * @generated from message watchfire.DiffLine
+ */
+export type DiffLine = Message<"watchfire.DiffLine"> & {
+  /**
+   * @generated from field: watchfire.DiffLine.Kind kind = 1;
+   */
+  kind: DiffLine_Kind;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message watchfire.DiffLine.
+ * Use `create(DiffLineSchema)` to create a new message.
+ */
+export const DiffLineSchema: GenMessage<DiffLine> = /*@__PURE__*/
+  messageDesc(file_watchfire, 112);
+
+/**
+ * @generated from enum watchfire.DiffLine.Kind
+ */
+export enum DiffLine_Kind {
+  /**
+   * @generated from enum value: CONTEXT = 0;
+   */
+  CONTEXT = 0,
+
+  /**
+   * @generated from enum value: ADD = 1;
+   */
+  ADD = 1,
+
+  /**
+   * @generated from enum value: DEL = 2;
+   */
+  DEL = 2,
+}
+
+/**
+ * Describes the enum watchfire.DiffLine.Kind.
+ */
+export const DiffLine_KindSchema: GenEnum<DiffLine_Kind> = /*@__PURE__*/
+  enumDesc(file_watchfire, 112, 0);
+
+/**
+ * GetHotspotsRequest scopes a hotspot report to one project and an
+ * optional window (unset bounds mean "all time"). `limit` caps each list;
+ * 0 means 10, and it is clamped to 100.
+ *
+ * @generated from message watchfire.GetHotspotsRequest
+ */
+export type GetHotspotsRequest = Message<"watchfire.GetHotspotsRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: int32 limit = 5;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message watchfire.GetHotspotsRequest.
+ * Use `create(GetHotspotsRequestSchema)` to create a new message.
+ */
+export const GetHotspotsRequestSchema: GenMessage<GetHotspotsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 113);
+
+/**
+ * Hotspots aggregates the diffs of a project's tasks completed in the
+ * window. `files` are the most-touched files (most tasks, then most
+ * churn); `failure_files` those touched by failed tasks or failed
+ * (conflicted) merges; `directories` roll files up at every depth.
+ * `weeks` are the 12 week starts each file's `weekly_churn` lines up
+ * with. `tasks_without_diff` counts tasks whose diff couldn't be resolved.
+ *
+ * @generated from message watchfire.Hotspots
+ */
+export type Hotspots = Message<"watchfire.Hotspots"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: int32 tasks_scanned = 4;
+   */
+  tasksScanned: number;
+
+  /**
+   * @generated from field: int32 tasks_without_diff = 5;
+   */
+  tasksWithoutDiff: number;
+
+  /**
+   * @generated from field: repeated watchfire.HotspotFile files = 6;
+   */
+  files: HotspotFile[];
+
+  /**
+   * @generated from field: repeated watchfire.HotspotFile failure_files = 7;
+   */
+  failureFiles: HotspotFile[];
+
+  /**
+   * @generated from field: repeated watchfire.HotspotDirectory directories = 8;
+   */
+  directories: HotspotDirectory[];
+
+  /**
+   * @generated from field: repeated string weeks = 9;
+   */
+  weeks: string[];
+};
+
+/**
+ * Describes the message watchfire.Hotspots.
+ * Use `create(HotspotsSchema)` to create a new message.
+ */
+export const HotspotsSchema: GenMessage<Hotspots> = /*@__PURE__*/
+  messageDesc(file_watchfire, 114);
+
+/**
+ * HotspotFile — one path's tally. Renamed files count under the new path.
+ *
+ * @generated from message watchfire.HotspotFile
+ */
+export type HotspotFile = Message<"watchfire.HotspotFile"> & {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * @generated from field: int32 tasks = 2;
+   */
+  tasks: number;
+
+  /**
+   * @generated from field: int32 failed_tasks = 3;
+   */
+  failedTasks: number;
+
+  /**
+   * @generated from field: int32 merge_failures = 4;
+   */
+  mergeFailures: number;
+
+  /**
+   * @generated from field: int32 lines_added = 5;
+   */
+  linesAdded: number;
+
+  /**
+   * @generated from field: int32 lines_removed = 6;
+   */
+  linesRemoved: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_touched = 7;
+   */
+  lastTouched?: Timestamp;
+
+  /**
+   * Lines added + removed per `weeks` entry
+   *
+   * @generated from field: repeated int32 weekly_churn = 8;
+   */
+  weeklyChurn: number[];
+};
+
+/**
+ * Describes the message watchfire.HotspotFile.
+ * Use `create(HotspotFileSchema)` to create a new message.
+ */
+export const HotspotFileSchema: GenMessage<HotspotFile> = /*@__PURE__*/
+  messageDesc(file_watchfire, 115);
+
+/**
+ * HotspotDirectory — every file under a directory; `tasks` counts
+ * distinct tasks.
+ *
+ * @generated from message watchfire.HotspotDirectory
+ */
+export type HotspotDirectory = Message<"watchfire.HotspotDirectory"> & {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * @generated from field: int32 tasks = 2;
+   */
+  tasks: number;
+
+  /**
+   * @generated from field: int32 files = 3;
+   */
+  files: number;
+
+  /**
+   * @generated from field: int32 failed_tasks = 4;
+   */
+  failedTasks: number;
+
+  /**
+   * @generated from field: int32 merge_failures = 5;
+   */
+  mergeFailures: number;
+
+  /**
+   * @generated from field: int32 lines_added = 6;
+   */
+  linesAdded: number;
+
+  /**
+   * @generated from field: int32 lines_removed = 7;
+   */
+  linesRemoved: number;
+};
+
+/**
+ * Describes the message watchfire.HotspotDirectory.
+ * Use `create(HotspotDirectorySchema)` to create a new message.
+ */
+export const HotspotDirectorySchema: GenMessage<HotspotDirectory> = /*@__PURE__*/
+  messageDesc(file_watchfire, 116);
+
+/**
+ * IntegrationEvents is the per-integration event-bitmask. Mirrors the
+ * daemon-side `models.EventBitmask` shape. Each integration carries its
+ * own copy so the user can fan TASK_FAILED to Slack but RUN_COMPLETE to
+ * Discord without duplicating endpoints.
+ *
+ * @generated from message watchfire.IntegrationEvents
+ */
+export type IntegrationEvents = Message<"watchfire.IntegrationEvents"> & {
+  /**
+   * @generated from field: bool task_failed = 1;
+   */
+  taskFailed: boolean;
+
+  /**
+   * @generated from field: bool run_complete = 2;
+   */
+  runComplete: boolean;
+
+  /**
+   * @generated from field: bool weekly_digest = 3;
+   */
+  weeklyDigest: boolean;
+
+  /**
+   * @generated from field: bool budget_threshold = 4;
+   */
+  budgetThreshold: boolean;
+
+  /**
+   * Every later event by key ("task_succeeded", "pr_opened", …)
+   *
+   * @generated from field: map<string, bool> events = 5;
+   */
+  events: { [key: string]: boolean };
+};
+
+/**
+ * Describes the message watchfire.IntegrationEvents.
+ * Use `create(IntegrationEventsSchema)` to create a new message.
+ */
+export const IntegrationEventsSchema: GenMessage<IntegrationEvents> = /*@__PURE__*/
+  messageDesc(file_watchfire, 117);
+
+/**
+ * WebhookIntegration is a single generic outbound webhook target. The
+ * `secret` field is write-only — Save accepts it (and pushes it to the
+ * keyring); List + the response of Save scrub it (returning only
+ * `secret_set: bool`).
+ *
+ * @generated from message watchfire.WebhookIntegration
+ */
+export type WebhookIntegration = Message<"watchfire.WebhookIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * Masked, display-only ("***hooks.example.com/...")
+   *
+   * @generated from field: string url_label = 4;
+   */
+  urlLabel: string;
+
+  /**
+   * True if the keyring carries a secret
+   *
+   * @generated from field: bool secret_set = 5;
+   */
+  secretSet: boolean;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string secret = 6;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 7;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 8;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.WebhookIntegration.
+ * Use `create(WebhookIntegrationSchema)` to create a new message.
+ */
+export const WebhookIntegrationSchema: GenMessage<WebhookIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 118);
+
+/**
+ * SlackIntegration targets a Slack incoming webhook. The URL itself is
+ * the secret (Slack's incoming-webhook auth model), so it is write-only
+ * — Save accepts it; List returns only the masked `url_label`.
+ *
+ * @generated from message watchfire.SlackIntegration
+ */
+export type SlackIntegration = Message<"watchfire.SlackIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * Masked, display-only
+   *
+   * @generated from field: string url_label = 4;
+   */
+  urlLabel: string;
+
+  /**
+   * True if the keyring carries the URL
+   *
+   * @generated from field: bool url_set = 5;
+   */
+  urlSet: boolean;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 6;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 7;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.SlackIntegration.
+ * Use `create(SlackIntegrationSchema)` to create a new message.
+ */
+export const SlackIntegrationSchema: GenMessage<SlackIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 119);
+
+/**
+ * DiscordIntegration mirrors SlackIntegration exactly — Discord's
+ * webhook auth model is the same (URL-as-secret).
+ *
+ * @generated from message watchfire.DiscordIntegration
+ */
+export type DiscordIntegration = Message<"watchfire.DiscordIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * Masked, display-only
+   *
+   * @generated from field: string url_label = 4;
+   */
+  urlLabel: string;
+
+  /**
+   * True if the keyring carries the URL
+   *
+   * @generated from field: bool url_set = 5;
+   */
+  urlSet: boolean;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 6;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 7;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.DiscordIntegration.
+ * Use `create(DiscordIntegrationSchema)` to create a new message.
+ */
+export const DiscordIntegrationSchema: GenMessage<DiscordIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 120);
+
+/**
+ * TeamsIntegration is a Microsoft Teams incoming webhook (Workflows or
+ * legacy connector URL). Posts Adaptive Cards.
+ *
+ * @generated from message watchfire.TeamsIntegration
+ */
+export type TeamsIntegration = Message<"watchfire.TeamsIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * Masked, display-only
+   *
+   * @generated from field: string url_label = 4;
+   */
+  urlLabel: string;
+
+  /**
+   * True if the keyring carries the URL
+   *
+   * @generated from field: bool url_set = 5;
+   */
+  urlSet: boolean;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 6;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 7;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.TeamsIntegration.
+ * Use `create(TeamsIntegrationSchema)` to create a new message.
+ */
+export const TeamsIntegrationSchema: GenMessage<TeamsIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 121);
+
+/**
+ * MatrixIntegration posts m.notice events into one Matrix room as the
+ * account owning access_token.
+ *
+ * @generated from message watchfire.MatrixIntegration
+ */
+export type MatrixIntegration = Message<"watchfire.MatrixIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * e.g. https://matrix.example.org
+   *
+   * @generated from field: string homeserver_url = 3;
+   */
+  homeserverUrl: string;
+
+  /**
+   * e.g. !abcdef:example.org
+   *
+   * @generated from field: string room_id = 4;
+   */
+  roomId: string;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string access_token = 5;
+   */
+  accessToken: string;
+
+  /**
+   * True if the keyring carries the token
+   *
+   * @generated from field: bool token_set = 6;
+   */
+  tokenSet: boolean;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 7;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 8;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.MatrixIntegration.
+ * Use `create(MatrixIntegrationSchema)` to create a new message.
+ */
+export const MatrixIntegrationSchema: GenMessage<MatrixIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 122);
+
+/**
+ * NtfyIntegration publishes to one ntfy topic. The topic URL is not a
+ * secret; protected topics add an access token.
+ *
+ * @generated from message watchfire.NtfyIntegration
+ */
+export type NtfyIntegration = Message<"watchfire.NtfyIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Full topic URL, e.g. https://ntfy.sh/alerts
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * Write-only, optional — never returned by List
+   *
+   * @generated from field: string token = 4;
+   */
+  token: string;
+
+  /**
+   * True if the keyring carries a token
+   *
+   * @generated from field: bool token_set = 5;
+   */
+  tokenSet: boolean;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 6;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 7;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.NtfyIntegration.
+ * Use `create(NtfyIntegrationSchema)` to create a new message.
+ */
+export const NtfyIntegrationSchema: GenMessage<NtfyIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 123);
+
+/**
+ * EmailRecipient is one address on an EmailIntegration with its own
+ * event selection.
+ *
+ * @generated from message watchfire.EmailRecipient
+ */
+export type EmailRecipient = Message<"watchfire.EmailRecipient"> & {
+  /**
+   * @generated from field: string address = 1;
+   */
+  address: string;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 2;
+   */
+  enabledEvents?: IntegrationEvents;
+};
+
+/**
+ * Describes the message watchfire.EmailRecipient.
+ * Use `create(EmailRecipientSchema)` to create a new message.
+ */
+export const EmailRecipientSchema: GenMessage<EmailRecipient> = /*@__PURE__*/
+  messageDesc(file_watchfire, 124);
+
+/**
+ * EmailIntegration delivers notifications over SMTP. Events are selected
+ * per recipient, so the integration itself carries no event bitmask. The
+ * password follows the write-only secret convention.
+ *
+ * @generated from message watchfire.EmailIntegration
+ */
+export type EmailIntegration = Message<"watchfire.EmailIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string host = 3;
+   */
+  host: string;
+
+  /**
+   * 0 = default for the security mode (587 / 465)
+   *
+   * @generated from field: int32 port = 4;
+   */
+  port: number;
+
+  /**
+   * "starttls" (default), "tls" or "none"
+   *
+   * @generated from field: string security = 5;
+   */
+  security: string;
+
+  /**
+   * Empty = no AUTH
+   *
+   * @generated from field: string username = 6;
+   */
+  username: string;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string password = 7;
+   */
+  password: string;
+
+  /**
+   * True if the keyring carries a password
+   *
+   * @generated from field: bool password_set = 8;
+   */
+  passwordSet: boolean;
+
+  /**
+   * @generated from field: string from = 9;
+   */
+  from: string;
+
+  /**
+   * @generated from field: repeated watchfire.EmailRecipient recipients = 10;
+   */
+  recipients: EmailRecipient[];
+
+  /**
+   * @generated from field: repeated string project_mute_ids = 11;
+   */
+  projectMuteIds: string[];
+};
+
+/**
+ * Describes the message watchfire.EmailIntegration.
+ * Use `create(EmailIntegrationSchema)` to create a new message.
+ */
+export const EmailIntegrationSchema: GenMessage<EmailIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 125);
+
+/**
+ * GitHubIntegration is the single-instance GitHub auto-PR config. No
+ * URL field — relies on `gh` CLI auth.
+ *
+ * @generated from message watchfire.GitHubIntegration
+ */
+export type GitHubIntegration = Message<"watchfire.GitHubIntegration"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: bool draft_default = 2;
+   */
+  draftDefault: boolean;
+
+  /**
+   * @generated from field: repeated string project_scopes = 3;
+   */
+  projectScopes: string[];
+};
+
+/**
+ * Describes the message watchfire.GitHubIntegration.
+ * Use `create(GitHubIntegrationSchema)` to create a new message.
+ */
+export const GitHubIntegrationSchema: GenMessage<GitHubIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 126);
+
+/**
+ * TelegramPairedChatInfo is one paired Telegram chat as surfaced to the
+ * settings UIs. `username` is display-only — authorization is by chat_id
+ * membership in the paired list, never by username.
+ *
+ * @generated from message watchfire.TelegramPairedChatInfo
+ */
+export type TelegramPairedChatInfo = Message<"watchfire.TelegramPairedChatInfo"> & {
+  /**
+   * @generated from field: int64 chat_id = 1;
+   */
+  chatId: bigint;
+
+  /**
+   * Display only, never trusted for auth
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp paired_at = 3;
+   */
+  pairedAt?: Timestamp;
+
+  /**
+   * Project selected via /use, persisted per chat
+   *
+   * @generated from field: string default_project_id = 4;
+   */
+  defaultProjectId: string;
+
+  /**
+   * Outbound events paused for this chat
+   *
+   * @generated from field: bool muted = 5;
+   */
+  muted: boolean;
+
+  /**
+   * Live conversation relay enabled
+   *
+   * @generated from field: bool watch = 6;
+   */
+  watch: boolean;
+};
+
+/**
+ * Describes the message watchfire.TelegramPairedChatInfo.
+ * Use `create(TelegramPairedChatInfoSchema)` to create a new message.
+ */
+export const TelegramPairedChatInfoSchema: GenMessage<TelegramPairedChatInfo> = /*@__PURE__*/
+  messageDesc(file_watchfire, 127);
+
+/**
+ * TelegramIntegration is the single-instance Telegram bridge config
+ * (v10.0 Torch). The bot token follows the write-only secret convention:
+ * Save accepts `bot_token` (pushes it to the keyring); List + the Save
+ * response return only `token_set`. An empty `bot_token` on update means
+ * "keep the stored token".
+ *
+ * @generated from message watchfire.TelegramIntegration
+ */
+export type TelegramIntegration = Message<"watchfire.TelegramIntegration"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Write-only — never returned by List
+   *
+   * @generated from field: string bot_token = 2;
+   */
+  botToken: string;
+
+  /**
+   * True if the keyring carries a token
+   *
+   * @generated from field: bool token_set = 3;
+   */
+  tokenSet: boolean;
+
+  /**
+   * @generated from field: watchfire.IntegrationEvents enabled_events = 4;
+   */
+  enabledEvents?: IntegrationEvents;
+
+  /**
+   * @generated from field: repeated watchfire.TelegramPairedChatInfo paired_chats = 5;
+   */
+  pairedChats: TelegramPairedChatInfo[];
+};
+
+/**
+ * Describes the message watchfire.TelegramIntegration.
+ * Use `create(TelegramIntegrationSchema)` to create a new message.
+ */
+export const TelegramIntegrationSchema: GenMessage<TelegramIntegration> = /*@__PURE__*/
+  messageDesc(file_watchfire, 128);
+
+/**
+ * IntegrationsConfig is the root document the IntegrationsService
+ * returns from List + as the response to Save / Delete.
+ *
+ * @generated from message watchfire.IntegrationsConfig
+ */
+export type IntegrationsConfig = Message<"watchfire.IntegrationsConfig"> & {
+  /**
+   * @generated from field: repeated watchfire.WebhookIntegration webhooks = 1;
+   */
+  webhooks: WebhookIntegration[];
+
+  /**
+   * @generated from field: repeated watchfire.SlackIntegration slack = 2;
+   */
+  slack: SlackIntegration[];
+
+  /**
+   * @generated from field: repeated watchfire.DiscordIntegration discord = 3;
+   */
+  discord: DiscordIntegration[];
+
+  /**
+   * @generated from field: watchfire.GitHubIntegration github = 4;
+   */
+  github?: GitHubIntegration;
+
+  /**
+   * v10.0 Torch — unset when unconfigured
+   *
+   * @generated from field: watchfire.TelegramIntegration telegram = 5;
+   */
+  telegram?: TelegramIntegration;
+
+  /**
+   * @generated from field: repeated watchfire.TeamsIntegration teams = 6;
+   */
+  teams: TeamsIntegration[];
+
+  /**
+   * @generated from field: repeated watchfire.MatrixIntegration matrix = 7;
+   */
+  matrix: MatrixIntegration[];
+
+  /**
+   * @generated from field: repeated watchfire.NtfyIntegration ntfy = 8;
+   */
+  ntfy: NtfyIntegration[];
+
+  /**
+   * @generated from field: repeated watchfire.EmailIntegration email = 9;
+   */
+  email: EmailIntegration[];
+};
+
+/**
+ * Describes the message watchfire.IntegrationsConfig.
+ * Use `create(IntegrationsConfigSchema)` to create a new message.
+ */
+export const IntegrationsConfigSchema: GenMessage<IntegrationsConfig> = /*@__PURE__*/
+  messageDesc(file_watchfire, 129);
+
+/**
+ * @generated from message watchfire.ListIntegrationsRequest
+ */
+export type ListIntegrationsRequest = Message<"watchfire.ListIntegrationsRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+};
+
+/**
+ * Describes the message watchfire.ListIntegrationsRequest.
+ * Use `create(ListIntegrationsRequestSchema)` to create a new message.
+ */
+export const ListIntegrationsRequestSchema: GenMessage<ListIntegrationsRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 130);
+
+/**
+ * SaveIntegrationRequest is the unified create + update wire shape. The
+ * `payload` oneof carries the integration-specific body; the server
+ * derives create-vs-update by checking whether the ID already exists in
+ * the YAML.
+ *
+ * @generated from message watchfire.SaveIntegrationRequest
+ */
+export type SaveIntegrationRequest = Message<"watchfire.SaveIntegrationRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from oneof watchfire.SaveIntegrationRequest.payload
+   */
+  payload: {
+    /**
+     * @generated from field: watchfire.WebhookIntegration webhook = 2;
+     */
+    value: WebhookIntegration;
+    case: "webhook";
+  } | {
+    /**
+     * @generated from field: watchfire.SlackIntegration slack = 3;
+     */
+    value: SlackIntegration;
+    case: "slack";
+  } | {
+    /**
+     * @generated from field: watchfire.DiscordIntegration discord = 4;
+     */
+    value: DiscordIntegration;
+    case: "discord";
+  } | {
+    /**
+     * @generated from field: watchfire.GitHubIntegration github = 5;
+     */
+    value: GitHubIntegration;
+    case: "github";
+  } | {
+    /**
+     * v10.0 Torch
+     *
+     * @generated from field: watchfire.TelegramIntegration telegram = 6;
+     */
+    value: TelegramIntegration;
+    case: "telegram";
+  } | {
+    /**
+     * @generated from field: watchfire.TeamsIntegration teams = 7;
+     */
+    value: TeamsIntegration;
+    case: "teams";
+  } | {
+    /**
+     * @generated from field: watchfire.MatrixIntegration matrix = 8;
+     */
+    value: MatrixIntegration;
+    case: "matrix";
+  } | {
+    /**
+     * @generated from field: watchfire.NtfyIntegration ntfy = 9;
+     */
+    value: NtfyIntegration;
+    case: "ntfy";
+  } | {
+    /**
+     * @generated from field: watchfire.EmailIntegration email = 10;
+     */
+    value: EmailIntegration;
+    case: "email";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message watchfire.SaveIntegrationRequest.
+ * Use `create(SaveIntegrationRequestSchema)` to create a new message.
+ */
+export const SaveIntegrationRequestSchema: GenMessage<SaveIntegrationRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 131);
+
+/**
+ * DeleteIntegrationRequest names the integration to delete by kind + id.
+ * GitHub is single-instance; passing GITHUB resets it to the zero value.
+ *
+ * @generated from message watchfire.DeleteIntegrationRequest
+ */
+export type DeleteIntegrationRequest = Message<"watchfire.DeleteIntegrationRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from field: watchfire.IntegrationKind kind = 2;
+   */
+  kind: IntegrationKind;
+
+  /**
+   * Ignored when kind = GITHUB
+   *
+   * @generated from field: string id = 3;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message watchfire.DeleteIntegrationRequest.
+ * Use `create(DeleteIntegrationRequestSchema)` to create a new message.
+ */
+export const DeleteIntegrationRequestSchema: GenMessage<DeleteIntegrationRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 132);
+
+/**
+ * TestIntegrationRequest fires a synthetic notification through the
+ * dispatcher to the named integration. Used by the GUI / TUI's "Test"
+ * button to verify plumbing end-to-end.
+ *
+ * @generated from message watchfire.TestIntegrationRequest
+ */
+export type TestIntegrationRequest = Message<"watchfire.TestIntegrationRequest"> & {
+  /**
+   * @generated from field: watchfire.RequestMeta meta = 1;
+   */
+  meta?: RequestMeta;
+
+  /**
+   * @generated from field: watchfire.IntegrationKind kind = 2;
+   */
+  kind: IntegrationKind;
+
+  /**
+   * @generated from field: string id = 3;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message watchfire.TestIntegrationRequest.
+ * Use `create(TestIntegrationRequestSchema)` to create a new message.
+ */
+export const TestIntegrationRequestSchema: GenMessage<TestIntegrationRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 133);
 
+/**
+ * @generated from message watchfire.TestIntegrationResponse
+ */
+export type TestIntegrationResponse = Message<"watchfire.TestIntegrationResponse"> & {
   /**
-   * Write-only — never returned by List
-   *
-   * @generated from field: string secret = 6;
+   * @generated from field: bool ok = 1;
    */
-  secret: string;
+  ok: boolean;
 
   /**
-   * @generated from field: watchfire.IntegrationEvents enabled_events = 7;
+   * Human-readable success / failure summary
+   *
+   * @generated from field: string message = 2;
    */
-  enabledEvents?: IntegrationEvents;
+  message: string;
 
   /**
-   * @generated from field: repeated string project_mute_ids = 8;
+   * HTTP status of the test send (0 if not applicable)
+   *
+   * @generated from field: int32 status_code = 3;
    */
-  projectMuteIds: string[];
+  statusCode: number;
 };
 
 /**
- * Describes the message watchfire.WebhookIntegration.
- * Use `create(WebhookIntegrationSchema)` to create a new message.
+ * Describes the message watchfire.TestIntegrationResponse.
+ * Use `create(TestIntegrationResponseSchema)` to create a new message.
  */
-export const WebhookIntegrationSchema: GenMessage<WebhookIntegration> = /*@__PURE__*/
-  messageDesc(file_watchfire, 86);
+export const TestIntegrationResponseSchema: GenMessage<TestIntegrationResponse> = /*@__PURE__*/
+  messageDesc(file_watchfire, 134);
 
 /**
- * SlackIntegration targets a Slack incoming webhook. The URL itself is
- * the secret (Slack's incoming-webhook auth model), so it is write-only
- * — Save accepts it; List returns only the masked `url_label`.
+ * RelayDelivery is one record from the relay dispatcher's durable
+ * outbox: a notification bound to one adapter that has failed at least
+ * one delivery round. `dead` records exhausted their retries and wait in
+ * the dead-letter store for a ReplayDelivery; the rest are still being
+ * retried on the outbox backoff schedule.
  *
- * @generated from message watchfire.SlackIntegration
+ * @generated from message watchfire.RelayDelivery
  */
-export type SlackIntegration = Message<"watchfire.SlackIntegration"> & {
+export type RelayDelivery = Message<"watchfire.RelayDelivery"> & {
   /**
    * @generated from field: string id = 1;
    */
   id: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string adapter_id = 2;
    */
-  label: string;
+  adapterId: string;
 
   /**
-   * Write-only — never returned by List
+   * webhook | slack | discord | telegram
    *
-   * @generated from field: string url = 3;
+   * @generated from field: string adapter_kind = 3;
    */
-  url: string;
+  adapterKind: string;
 
   /**
-   * Masked, display-only
+   * canonical kind, e.g. TASK_FAILED
    *
-   * @generated from field: string url_label = 4;
+   * @generated from field: string event = 4;
    */
-  urlLabel: string;
+  event: string;
 
   /**
-   * True if the keyring carries the URL
-   *
-   * @generated from field: bool url_set = 5;
+   * @generated from field: string project_id = 5;
    */
-  urlSet: boolean;
+  projectId: string;
 
   /**
-   * @generated from field: watchfire.IntegrationEvents enabled_events = 6;
+   * @generated from field: string project_name = 6;
    */
-  enabledEvents?: IntegrationEvents;
+  projectName: string;
 
   /**
-   * @generated from field: repeated string project_mute_ids = 7;
+   * @generated from field: int32 task_number = 7;
    */
-  projectMuteIds: string[];
-};
-
-/**
- * Describes the message watchfire.SlackIntegration.
- * Use `create(SlackIntegrationSchema)` to create a new message.
- */
-export const SlackIntegrationSchema: GenMessage<SlackIntegration> = /*@__PURE__*/
-  messageDesc(file_watchfire, 87);
+  taskNumber: number;
 
-/**
- * DiscordIntegration mirrors SlackIntegration exactly — Discord's
- * webhook auth model is the same (URL-as-secret).
- *
- * @generated from message watchfire.DiscordIntegration
- */
-export type DiscordIntegration = Message<"watchfire.DiscordIntegration"> & {
   /**
-   * @generated from field: string id = 1;
+   * failed delivery rounds so far
+   *
+   * @generated from field: int32 attempts = 8;
    */
-  id: string;
+  attempts: number;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string last_error = 9;
    */
-  label: string;
+  lastError: string;
 
   /**
-   * Write-only — never returned by List
-   *
-   * @generated from field: string url = 3;
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
    */
-  url: string;
+  createdAt?: Timestamp;
 
   /**
-   * Masked, display-only
+   * unset once dead
    *
-   * @generated from field: string url_label = 4;
+   * @generated from field: google.protobuf.Timestamp next_attempt_at = 11;
    */
-  urlLabel: string;
+  nextAttemptAt?: Timestamp;
 
   /**
-   * True if the keyring carries the URL
+   * set when dead
    *
-   * @generated from field: bool url_set = 5;
-   */
-  urlSet: boolean;
-
-  /**
-   * @generated from field: watchfire.IntegrationEvents enabled_events = 6;
+   * @generated from field: google.protobuf.Timestamp dead_at = 12;
    */
-  enabledEvents?: IntegrationEvents;
+  deadAt?: Timestamp;
 
   /**
-   * @generated from field: repeated string project_mute_ids = 7;
+   * @generated from field: bool dead = 13;
    */
-  projectMuteIds: string[];
+  dead: boolean;
 };
 
 /**
- * Describes the message watchfire.DiscordIntegration.
- * Use `create(DiscordIntegrationSchema)` to create a new message.
+ * Describes the message watchfire.RelayDelivery.
+ * Use `create(RelayDeliverySchema)` to create a new message.
  */
-export const DiscordIntegrationSchema: GenMessage<DiscordIntegration> = /*@__PURE__*/
-  messageDesc(file_watchfire, 88);
+export const RelayDeliverySchema: GenMessage<RelayDelivery> = /*@__PURE__*/
+  messageDesc(file_watchfire, 135);
 
 /**
- * GitHubIntegration is the single-instance GitHub auto-PR config. No
- * URL field — relies on `gh` CLI auth.
- *
- * @generated from message watchfire.GitHubIntegration
+ * @generated from message watchfire.ListFailedDeliveriesRequest
  */
-export type GitHubIntegration = Message<"watchfire.GitHubIntegration"> & {
-  /**
-   * @generated from field: bool enabled = 1;
-   */
-  enabled: boolean;
-
+export type ListFailedDeliveriesRequest = Message<"watchfire.ListFailedDeliveriesRequest"> & {
   /**
-   * @generated from field: bool draft_default = 2;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  draftDefault: boolean;
+  meta?: RequestMeta;
 
   /**
-   * @generated from field: repeated string project_scopes = 3;
+   * also list deliveries still being retried
+   *
+   * @generated from field: bool include_pending = 2;
    */
-  projectScopes: string[];
+  includePending: boolean;
 };
 
 /**
- * Describes the message watchfire.GitHubIntegration.
- * Use `create(GitHubIntegrationSchema)` to create a new message.
+ * Describes the message watchfire.ListFailedDeliveriesRequest.
+ * Use `create(ListFailedDeliveriesRequestSchema)` to create a new message.
  */
-export const GitHubIntegrationSchema: GenMessage<GitHubIntegration> = /*@__PURE__*/
-  messageDesc(file_watchfire, 89);
+export const ListFailedDeliveriesRequestSchema: GenMessage<ListFailedDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 136);
 
 /**
- * TelegramPairedChatInfo is one paired Telegram chat as surfaced to the
- * settings UIs. `username` is display-only — authorization is by chat_id
- * membership in the paired list, never by username.
- *
- * @generated from message watchfire.TelegramPairedChatInfo
+ * @generated from message watchfire.ListFailedDeliveriesResponse
  */
-export type TelegramPairedChatInfo = Message<"watchfire.TelegramPairedChatInfo"> & {
-  /**
-   * @generated from field: int64 chat_id = 1;
-   */
-  chatId: bigint;
-
-  /**
-   * Display only, never trusted for auth
-   *
-   * @generated from field: string username = 2;
-   */
-  username: string;
-
+export type ListFailedDeliveriesResponse = Message<"watchfire.ListFailedDeliveriesResponse"> & {
   /**
-   * @generated from field: google.protobuf.Timestamp paired_at = 3;
+   * @generated from field: repeated watchfire.RelayDelivery deliveries = 1;
    */
-  pairedAt?: Timestamp;
+  deliveries: RelayDelivery[];
+};
 
-  /**
-   * Project selected via /use, persisted per chat
-   *
-   * @generated from field: string default_project_id = 4;
-   */
-  defaultProjectId: string;
+/**
+ * Describes the message watchfire.ListFailedDeliveriesResponse.
+ * Use `create(ListFailedDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListFailedDeliveriesResponseSchema: GenMessage<ListFailedDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_watchfire, 137);
 
+/**
+ * @generated from message watchfire.ReplayDeliveryRequest
+ */
+export type ReplayDeliveryRequest = Message<"watchfire.ReplayDeliveryRequest"> & {
   /**
-   * Outbound events paused for this chat
-   *
-   * @generated from field: bool muted = 5;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  muted: boolean;
+  meta?: RequestMeta;
 
   /**
-   * Live conversation relay enabled
-   *
-   * @generated from field: bool watch = 6;
+   * @generated from field: string id = 2;
    */
-  watch: boolean;
+  id: string;
 };
 
 /**
- * Describes the message watchfire.TelegramPairedChatInfo.
- * Use `create(TelegramPairedChatInfoSchema)` to create a new message.
+ * Describes the message watchfire.ReplayDeliveryRequest.
+ * Use `create(ReplayDeliveryRequestSchema)` to create a new message.
  */
-export const TelegramPairedChatInfoSchema: GenMessage<TelegramPairedChatInfo> = /*@__PURE__*/
-  messageDesc(file_watchfire, 90);
+export const ReplayDeliveryRequestSchema: GenMessage<ReplayDeliveryRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 138);
 
 /**
- * TelegramIntegration is the single-instance Telegram bridge config
- * (v10.0 Torch). The bot token follows the write-only secret convention:
- * Save accepts `bot_token` (pushes it to the keyring); List + the Save
- * response return only `token_set`. An empty `bot_token` on update means
- * "keep the stored token".
+ * PreviewTemplateRequest renders a relay message template against the
+ * sample payload for one event. With `source` empty the template the
+ * endpoint currently uses is rendered; with `save` set a `source` that
+ * validates is written as the override and the dispatcher reloads.
  *
- * @generated from message watchfire.TelegramIntegration
+ * @generated from message watchfire.PreviewTemplateRequest
  */
-export type TelegramIntegration = Message<"watchfire.TelegramIntegration"> & {
+export type PreviewTemplateRequest = Message<"watchfire.PreviewTemplateRequest"> & {
   /**
-   * @generated from field: bool enabled = 1;
+   * @generated from field: watchfire.RequestMeta meta = 1;
    */
-  enabled: boolean;
+  meta?: RequestMeta;
 
   /**
-   * Write-only — never returned by List
+   * slack | discord | teams | matrix | ntfy
    *
-   * @generated from field: string bot_token = 2;
+   * @generated from field: string channel = 2;
    */
-  botToken: string;
+  channel: string;
 
   /**
-   * True if the keyring carries a token
+   * event key, e.g. task_failed
    *
-   * @generated from field: bool token_set = 3;
+   * @generated from field: string event = 3;
    */
-  tokenSet: boolean;
+  event: string;
 
   /**
-   * @generated from field: watchfire.IntegrationEvents enabled_events = 4;
+   * empty = every endpoint of the channel
+   *
+   * @generated from field: string endpoint_id = 4;
    */
-  enabledEvents?: IntegrationEvents;
+  endpointId: string;
 
   /**
-   * @generated from field: repeated watchfire.TelegramPairedChatInfo paired_chats = 5;
+   * template to preview; empty = the one in effect
+   *
+   * @generated from field: string source = 5;
    */
-  pairedChats: TelegramPairedChatInfo[];
+  source: string;
+
+  /**
+   * write `source` as the override once it validates
+   *
+   * @generated from field: bool save = 6;
+   */
+  save: boolean;
 };
 
 /**
- * Describes the message watchfire.TelegramIntegration.
- * Use `create(TelegramIntegrationSchema)` to create a new message.
+ * Describes the message watchfire.PreviewTemplateRequest.
+ * Use `create(PreviewTemplateRequestSchema)` to create a new message.
  */
-export const TelegramIntegrationSchema: GenMessage<TelegramIntegration> = /*@__PURE__*/
-  messageDesc(file_watchfire, 91);
+export const PreviewTemplateRequestSchema: GenMessage<PreviewTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 139);
 
 /**
- * IntegrationsConfig is the root document the IntegrationsService
- * returns from List + as the response to Save / Delete.
- *
- * @generated from message watchfire.IntegrationsConfig
+ * @generated from message watchfire.PreviewTemplateResponse
  */
-export type IntegrationsConfig = Message<"watchfire.IntegrationsConfig"> & {
-  /**
-   * @generated from field: repeated watchfire.WebhookIntegration webhooks = 1;
-   */
-  webhooks: WebhookIntegration[];
-
+export type PreviewTemplateResponse = Message<"watchfire.PreviewTemplateResponse"> & {
   /**
-   * @generated from field: repeated watchfire.SlackIntegration slack = 2;
+   * false when the template failed validation
+   *
+   * @generated from field: bool ok = 1;
    */
-  slack: SlackIntegration[];
+  ok: boolean;
 
   /**
-   * @generated from field: repeated watchfire.DiscordIntegration discord = 3;
+   * rendered sample payload (JSON)
+   *
+   * @generated from field: string rendered = 2;
    */
-  discord: DiscordIntegration[];
+  rendered: string;
 
   /**
-   * @generated from field: watchfire.GitHubIntegration github = 4;
+   * parse / render / JSON error when !ok
+   *
+   * @generated from field: string error = 3;
    */
-  github?: GitHubIntegration;
+  error: string;
 
   /**
-   * v10.0 Torch — unset when unconfigured
+   * the template that was rendered
    *
-   * @generated from field: watchfire.TelegramIntegration telegram = 5;
+   * @generated from field: string source = 4;
    */
-  telegram?: TelegramIntegration;
-};
-
-/**
- * Describes the message watchfire.IntegrationsConfig.
- * Use `create(IntegrationsConfigSchema)` to create a new message.
- */
-export const IntegrationsConfigSchema: GenMessage<IntegrationsConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 92);
+  source: string;
 
-/**
- * @generated from message watchfire.ListIntegrationsRequest
- */
-export type ListIntegrationsRequest = Message<"watchfire.ListIntegrationsRequest"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * endpoint | global | builtin | request
+   *
+   * @generated from field: string origin = 5;
    */
-  meta?: RequestMeta;
-};
-
-/**
- * Describes the message watchfire.ListIntegrationsRequest.
- * Use `create(ListIntegrationsRequestSchema)` to create a new message.
- */
-export const ListIntegrationsRequestSchema: GenMessage<ListIntegrationsRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 93);
-
-/**
- * SaveIntegrationRequest is the unified create + update wire shape. The
- * `payload` oneof carries the integration-specific body; the server
- * derives create-vs-update by checking whether the ID already exists in
- * the YAML.
- *
- * @generated from message watchfire.SaveIntegrationRequest
- */
-export type SaveIntegrationRequest = Message<"watchfire.SaveIntegrationRequest"> & {
+  origin: string;
+
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * override file read, or written when saved
+   *
+   * @generated from field: string path = 6;
    */
-  meta?: RequestMeta;
+  path: string;
 
   /**
-   * @generated from oneof watchfire.SaveIntegrationRequest.payload
+   * @generated from field: bool saved = 7;
    */
-  payload: {
-    /**
-     * @generated from field: watchfire.WebhookIntegration webhook = 2;
-     */
-    value: WebhookIntegration;
-    case: "webhook";
-  } | {
-    /**
-     * @generated from field: watchfire.SlackIntegration slack = 3;
-     */
-    value: SlackIntegration;
-    case: "slack";
-  } | {
-    /**
-     * @generated from field: watchfire.DiscordIntegration discord = 4;
-     */
-    value: DiscordIntegration;
-    case: "discord";
-  } | {
-    /**
-     * @generated from field: watchfire.GitHubIntegration github = 5;
-     */
-    value: GitHubIntegration;
-    case: "github";
-  } | {
-    /**
-     * v10.0 Torch
-     *
-     * @generated from field: watchfire.TelegramIntegration telegram = 6;
-     */
-    value: TelegramIntegration;
-    case: "telegram";
-  } | { case: undefined; value?: undefined };
+  saved: boolean;
 };
 
 /**
- * Describes the message watchfire.SaveIntegrationRequest.
- * Use `create(SaveIntegrationRequestSchema)` to create a new message.
+ * Describes the message watchfire.PreviewTemplateResponse.
+ * Use `create(PreviewTemplateResponseSchema)` to create a new message.
  */
-export const SaveIntegrationRequestSchema: GenMessage<SaveIntegrationRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 94);
+export const PreviewTemplateResponseSchema: GenMessage<PreviewTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_watchfire, 140);
 
 /**
- * DeleteIntegrationRequest names the integration to delete by kind + id.
- * GitHub is single-instance; passing GITHUB resets it to the zero value.
+ * TestRouteRequest describes a notification to dry-run through the
+ * routing rules in integrations.yaml. The project (id or name) and task
+ * number are resolved like a live notification; the remaining fields
+ * override what was resolved.
  *
- * @generated from message watchfire.DeleteIntegrationRequest
+ * @generated from message watchfire.TestRouteRequest
  */
-export type DeleteIntegrationRequest = Message<"watchfire.DeleteIntegrationRequest"> & {
+export type TestRouteRequest = Message<"watchfire.TestRouteRequest"> & {
   /**
    * @generated from field: watchfire.RequestMeta meta = 1;
    */
   meta?: RequestMeta;
 
   /**
-   * @generated from field: watchfire.IntegrationKind kind = 2;
+   * event key, e.g. task_failed
+   *
+   * @generated from field: string event = 2;
    */
-  kind: IntegrationKind;
+  event: string;
 
   /**
-   * Ignored when kind = GITHUB
+   * project id or name
    *
-   * @generated from field: string id = 3;
+   * @generated from field: string project = 3;
    */
-  id: string;
+  project: string;
+
+  /**
+   * @generated from field: int32 task_number = 4;
+   */
+  taskNumber: number;
+
+  /**
+   * agent backend, e.g. codex
+   *
+   * @generated from field: string agent = 5;
+   */
+  agent: string;
+
+  /**
+   * @generated from field: string task_title = 6;
+   */
+  taskTitle: string;
+
+  /**
+   * @generated from field: string failure_reason = 7;
+   */
+  failureReason: string;
+
+  /**
+   * @generated from field: int64 duration_seconds = 8;
+   */
+  durationSeconds: bigint;
 };
 
 /**
- * Describes the message watchfire.DeleteIntegrationRequest.
- * Use `create(DeleteIntegrationRequestSchema)` to create a new message.
+ * Describes the message watchfire.TestRouteRequest.
+ * Use `create(TestRouteRequestSchema)` to create a new message.
  */
-export const DeleteIntegrationRequestSchema: GenMessage<DeleteIntegrationRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 95);
+export const TestRouteRequestSchema: GenMessage<TestRouteRequest> = /*@__PURE__*/
+  messageDesc(file_watchfire, 141);
 
 /**
- * TestIntegrationRequest fires a synthetic notification through the
- * dispatcher to the named integration. Used by the GUI / TUI's "Test"
- * button to verify plumbing end-to-end.
+ * RouteTarget is one delivery a routed notification would make.
  *
- * @generated from message watchfire.TestIntegrationRequest
+ * @generated from message watchfire.RouteTarget
  */
-export type TestIntegrationRequest = Message<"watchfire.TestIntegrationRequest"> & {
+export type RouteTarget = Message<"watchfire.RouteTarget"> & {
   /**
-   * @generated from field: watchfire.RequestMeta meta = 1;
+   * @generated from field: string adapter_id = 1;
    */
-  meta?: RequestMeta;
+  adapterId: string;
 
   /**
-   * @generated from field: watchfire.IntegrationKind kind = 2;
+   * @generated from field: string adapter_kind = 2;
    */
-  kind: IntegrationKind;
+  adapterKind: string;
 
   /**
-   * @generated from field: string id = 3;
+   * telegram only; empty = every paired chat
+   *
+   * @generated from field: repeated int64 chat_ids = 3;
    */
-  id: string;
+  chatIds: bigint[];
+
+  /**
+   * selecting rule; empty = default routing
+   *
+   * @generated from field: string rule = 4;
+   */
+  rule: string;
 };
 
 /**
- * Describes the message watchfire.TestIntegrationRequest.
- * Use `create(TestIntegrationRequestSchema)` to create a new message.
+ * Describes the message watchfire.RouteTarget.
+ * Use `create(RouteTargetSchema)` to create a new message.
  */
-export const TestIntegrationRequestSchema: GenMessage<TestIntegrationRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 96);
+export const RouteTargetSchema: GenMessage<RouteTarget> = /*@__PURE__*/
+  messageDesc(file_watchfire, 142);
 
 /**
- * @generated from message watchfire.TestIntegrationResponse
+ * @generated from message watchfire.TestRouteResponse
  */
-export type TestIntegrationResponse = Message<"watchfire.TestIntegrationResponse"> & {
+export type TestRouteResponse = Message<"watchfire.TestRouteResponse"> & {
   /**
-   * @generated from field: bool ok = 1;
+   * matched rules, in evaluation order
+   *
+   * @generated from field: repeated string rules = 1;
    */
-  ok: boolean;
+  rules: string[];
 
   /**
-   * Human-readable success / failure summary
+   * no rule matched, or one kept the default
    *
-   * @generated from field: string message = 2;
+   * @generated from field: bool default_routing = 2;
    */
-  message: string;
+  defaultRouting: boolean;
 
   /**
-   * HTTP status of the test send (0 if not applicable)
+   * @generated from field: repeated watchfire.RouteTarget targets = 3;
+   */
+  targets: RouteTarget[];
+
+  /**
+   * adapters skipped because the project is muted
    *
-   * @generated from field: int32 status_code = 3;
+   * @generated from field: repeated string muted = 4;
    */
-  statusCode: number;
+  muted: string[];
+
+  /**
+   * rule targets that are not configured
+   *
+   * @generated from field: repeated string unknown = 5;
+   */
+  unknown: string[];
+
+  /**
+   * The notification as resolved, so the user can see what the rules
+   * were matched against.
+   *
+   * @generated from field: string project_id = 6;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string project_name = 7;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: string agent = 8;
+   */
+  agent: string;
+
+  /**
+   * @generated from field: string task_title = 9;
+   */
+  taskTitle: string;
+
+  /**
+   * @generated from field: string failure_reason = 10;
+   */
+  failureReason: string;
+
+  /**
+   * @generated from field: int64 duration_seconds = 11;
+   */
+  durationSeconds: bigint;
 };
 
 /**
- * Describes the message watchfire.TestIntegrationResponse.
- * Use `create(TestIntegrationResponseSchema)` to create a new message.
+ * Describes the message watchfire.TestRouteResponse.
+ * Use `create(TestRouteResponseSchema)` to create a new message.
  */
-export const TestIntegrationResponseSchema: GenMessage<TestIntegrationResponse> = /*@__PURE__*/
-  messageDesc(file_watchfire, 97);
+export const TestRouteResponseSchema: GenMessage<TestRouteResponse> = /*@__PURE__*/
+  messageDesc(file_watchfire, 143);
 
 /**
  * @generated from message watchfire.BeginTelegramPairingRequest
@@ -4014,7 +6612,7 @@ export type BeginTelegramPairingRequest = Message<"watchfire.BeginTelegramPairin
  * Use `create(BeginTelegramPairingRequestSchema)` to create a new message.
  */
 export const BeginTelegramPairingRequestSchema: GenMessage<BeginTelegramPairingRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 98);
+  messageDesc(file_watchfire, 144);
 
 /**
  * @generated from message watchfire.BeginTelegramPairingResponse
@@ -4052,7 +6650,7 @@ export type BeginTelegramPairingResponse = Message<"watchfire.BeginTelegramPairi
  * Use `create(BeginTelegramPairingResponseSchema)` to create a new message.
  */
 export const BeginTelegramPairingResponseSchema: GenMessage<BeginTelegramPairingResponse> = /*@__PURE__*/
-  messageDesc(file_watchfire, 99);
+  messageDesc(file_watchfire, 145);
 
 /**
  * @generated from message watchfire.GetTelegramPairingStatusRequest
@@ -4069,7 +6667,7 @@ export type GetTelegramPairingStatusRequest = Message<"watchfire.GetTelegramPair
  * Use `create(GetTelegramPairingStatusRequestSchema)` to create a new message.
  */
 export const GetTelegramPairingStatusRequestSchema: GenMessage<GetTelegramPairingStatusRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 100);
+  messageDesc(file_watchfire, 146);
 
 /**
  * @generated from message watchfire.TelegramPairingStatus
@@ -4114,7 +6712,7 @@ export type TelegramPairingStatus = Message<"watchfire.TelegramPairingStatus"> &
  * Use `create(TelegramPairingStatusSchema)` to create a new message.
  */
 export const TelegramPairingStatusSchema: GenMessage<TelegramPairingStatus> = /*@__PURE__*/
-  messageDesc(file_watchfire, 101);
+  messageDesc(file_watchfire, 147);
 
 /**
  * @generated from message watchfire.RevokeTelegramChatRequest
@@ -4136,7 +6734,7 @@ export type RevokeTelegramChatRequest = Message<"watchfire.RevokeTelegramChatReq
  * Use `create(RevokeTelegramChatRequestSchema)` to create a new message.
  */
 export const RevokeTelegramChatRequestSchema: GenMessage<RevokeTelegramChatRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 102);
+  messageDesc(file_watchfire, 148);
 
 /**
  * @generated from message watchfire.BeginOAuthRequest
@@ -4166,7 +6764,7 @@ export type BeginOAuthRequest = Message<"watchfire.BeginOAuthRequest"> & {
  * Use `create(BeginOAuthRequestSchema)` to create a new message.
  */
 export const BeginOAuthRequestSchema: GenMessage<BeginOAuthRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 103);
+  messageDesc(file_watchfire, 149);
 
 /**
  * @generated from message watchfire.BeginOAuthResponse
@@ -4200,7 +6798,7 @@ export type BeginOAuthResponse = Message<"watchfire.BeginOAuthResponse"> & {
  * Use `create(BeginOAuthResponseSchema)` to create a new message.
  */
 export const BeginOAuthResponseSchema: GenMessage<BeginOAuthResponse> = /*@__PURE__*/
-  messageDesc(file_watchfire, 104);
+  messageDesc(file_watchfire, 150);
 
 /**
  * @generated from message watchfire.GetOAuthStatusRequest
@@ -4222,7 +6820,7 @@ export type GetOAuthStatusRequest = Message<"watchfire.GetOAuthStatusRequest"> &
  * Use `create(GetOAuthStatusRequestSchema)` to create a new message.
  */
 export const GetOAuthStatusRequestSchema: GenMessage<GetOAuthStatusRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 105);
+  messageDesc(file_watchfire, 151);
 
 /**
  * @generated from message watchfire.OAuthStatus
@@ -4263,7 +6861,7 @@ export type OAuthStatus = Message<"watchfire.OAuthStatus"> & {
  * Use `create(OAuthStatusSchema)` to create a new message.
  */
 export const OAuthStatusSchema: GenMessage<OAuthStatus> = /*@__PURE__*/
-  messageDesc(file_watchfire, 106);
+  messageDesc(file_watchfire, 152);
 
 /**
  * @generated from message watchfire.CancelOAuthRequest
@@ -4285,7 +6883,7 @@ export type CancelOAuthRequest = Message<"watchfire.CancelOAuthRequest"> & {
  * Use `create(CancelOAuthRequestSchema)` to create a new message.
  */
 export const CancelOAuthRequestSchema: GenMessage<CancelOAuthRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 107);
+  messageDesc(file_watchfire, 153);
 
 /**
  * @generated from message watchfire.PostOAuthHelloRequest
@@ -4321,7 +6919,7 @@ export type PostOAuthHelloRequest = Message<"watchfire.PostOAuthHelloRequest"> &
  * Use `create(PostOAuthHelloRequestSchema)` to create a new message.
  */
 export const PostOAuthHelloRequestSchema: GenMessage<PostOAuthHelloRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 108);
+  messageDesc(file_watchfire, 154);
 
 /**
  * @generated from message watchfire.PostOAuthHelloResponse
@@ -4343,7 +6941,7 @@ export type PostOAuthHelloResponse = Message<"watchfire.PostOAuthHelloResponse">
  * Use `create(PostOAuthHelloResponseSchema)` to create a new message.
  */
 export const PostOAuthHelloResponseSchema: GenMessage<PostOAuthHelloResponse> = /*@__PURE__*/
-  messageDesc(file_watchfire, 109);
+  messageDesc(file_watchfire, 155);
 
 /**
  * InboundConfig (v8.0 Echo) — wire shape of `models.InboundConfig`.
@@ -4585,7 +7183,7 @@ export type InboundConfig = Message<"watchfire.InboundConfig"> & {
  * Use `create(InboundConfigSchema)` to create a new message.
  */
 export const InboundConfigSchema: GenMessage<InboundConfig> = /*@__PURE__*/
-  messageDesc(file_watchfire, 110);
+  messageDesc(file_watchfire, 156);
 
 /**
  * InboundStatus (v8.0 Echo) is the response of GetInboundStatus and
@@ -4681,7 +7279,7 @@ export type InboundStatus = Message<"watchfire.InboundStatus"> & {
  * Use `create(InboundStatusSchema)` to create a new message.
  */
 export const InboundStatusSchema: GenMessage<InboundStatus> = /*@__PURE__*/
-  messageDesc(file_watchfire, 111);
+  messageDesc(file_watchfire, 157);
 
 /**
  * @generated from message watchfire.GetInboundStatusRequest
@@ -4698,7 +7296,7 @@ export type GetInboundStatusRequest = Message<"watchfire.GetInboundStatusRequest
  * Use `create(GetInboundStatusRequestSchema)` to create a new message.
  */
 export const GetInboundStatusRequestSchema: GenMessage<GetInboundStatusRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 112);
+  messageDesc(file_watchfire, 158);
 
 /**
  * @generated from message watchfire.SaveInboundConfigRequest
@@ -4720,7 +7318,7 @@ export type SaveInboundConfigRequest = Message<"watchfire.SaveInboundConfigReque
  * Use `create(SaveInboundConfigRequestSchema)` to create a new message.
  */
 export const SaveInboundConfigRequestSchema: GenMessage<SaveInboundConfigRequest> = /*@__PURE__*/
-  messageDesc(file_watchfire, 113);
+  messageDesc(file_watchfire, 159);
 
 /**
  * DiscordGuildRegistration (v8.x Echo) is a single guild's auto-register
@@ -4763,7 +7361,7 @@ export type DiscordGuildRegistration = Message<"watchfire.DiscordGuildRegistrati
  * Use `create(DiscordGuildRegistrationSchema)` to create a new message.
  */
 export const DiscordGuildRegistrationSchema: GenMessage<DiscordGuildRegistration> = /*@__PURE__*/
-  messageDesc(file_watchfire, 114);
+  messageDesc(file_watchfire, 160);
 
 /**
  * FocusTarget identifies which view in the GUI a focus event is targeting.
@@ -4810,6 +7408,10 @@ export const FocusTargetSchema: GenEnum<FocusTarget> = /*@__PURE__*/
  * NotificationKind enumerates the high-level reasons the daemon emits a
  * notification. STUCK_AGENT is reserved for a future task; TASK_FAILED and
  * RUN_COMPLETE ship in v5.0 Pulse, WEEKLY_DIGEST in v6.0 Ember.
+ * BUDGET_THRESHOLD fires when monthly spend crosses a configured
+ * percentage of a global or per-project budget. The kinds from
+ * TASK_SUCCEEDED on follow a task or run through its lifecycle; their
+ * config keys are the lower-cased names ("merge_failed", …).
  *
  * @generated from enum watchfire.NotificationKind
  */
@@ -4833,6 +7435,58 @@ export enum NotificationKind {
    * @generated from enum value: WEEKLY_DIGEST = 3;
    */
   WEEKLY_DIGEST = 3,
+
+  /**
+   * @generated from enum value: BUDGET_THRESHOLD = 4;
+   */
+  BUDGET_THRESHOLD = 4,
+
+  /**
+   * @generated from enum value: TASK_SUCCEEDED = 5;
+   */
+  TASK_SUCCEEDED = 5,
+
+  /**
+   * Auto-merge into the default branch failed
+   *
+   * @generated from enum value: MERGE_FAILED = 6;
+   */
+  MERGE_FAILED = 6,
+
+  /**
+   * Auto-PR opened; url set
+   *
+   * @generated from enum value: PR_OPENED = 7;
+   */
+  PR_OPENED = 7,
+
+  /**
+   * Agent halted on a login / API-key error
+   *
+   * @generated from enum value: AGENT_NEEDS_AUTH = 8;
+   */
+  AGENT_NEEDS_AUTH = 8,
+
+  /**
+   * Agent hit a provider rate limit
+   *
+   * @generated from enum value: RATE_LIMITED = 9;
+   */
+  RATE_LIMITED = 9,
+
+  /**
+   * phase / previous_phase set
+   *
+   * @generated from enum value: WILDFIRE_PHASE_CHANGED = 10;
+   */
+  WILDFIRE_PHASE_CHANGED = 10,
+
+  /**
+   * count set
+   *
+   * @generated from enum value: TASKS_GENERATED = 11;
+   */
+  TASKS_GENERATED = 11,
 }
 
 /**
@@ -4845,7 +7499,9 @@ export const NotificationKindSchema: GenEnum<NotificationKind> = /*@__PURE__*/
  * ExportFormat selects the wire format for an exported report. CSV is a
  * flat single-file representation (multi-section reports use a `# section:`
  * header line to delimit sub-tables); MARKDOWN renders human-readable
- * reports with H2 headings.
+ * reports with H2 headings; JSON is the versioned machine-readable
+ * schema (`watchfire.insights.report`, see schema_version in the body);
+ * HTML is a self-contained page with inline CSS and SVG charts.
  *
  * @generated from enum watchfire.ExportFormat
  */
@@ -4859,6 +7515,16 @@ export enum ExportFormat {
    * @generated from enum value: MARKDOWN = 1;
    */
   MARKDOWN = 1,
+
+  /**
+   * @generated from enum value: JSON = 2;
+   */
+  JSON = 2,
+
+  /**
+   * @generated from enum value: HTML = 3;
+   */
+  HTML = 3,
 }
 
 /**
@@ -4868,7 +7534,7 @@ export const ExportFormatSchema: GenEnum<ExportFormat> = /*@__PURE__*/
   enumDesc(file_watchfire, 2);
 
 /**
- * IntegrationKind disambiguates the four outbound integration types in
+ * IntegrationKind disambiguates the outbound integration types in
  * the IntegrationsService oneof payloads. The on-the-wire ordering
  * matches the order the GUI surfaces them in the picker.
  *
@@ -4901,6 +7567,26 @@ export enum IntegrationKind {
    * @generated from enum value: TELEGRAM = 4;
    */
   TELEGRAM = 4,
+
+  /**
+   * @generated from enum value: TEAMS = 5;
+   */
+  TEAMS = 5,
+
+  /**
+   * @generated from enum value: MATRIX = 6;
+   */
+  MATRIX = 6,
+
+  /**
+   * @generated from enum value: NTFY = 7;
+   */
+  NTFY = 7,
+
+  /**
+   * @generated from enum value: EMAIL = 8;
+   */
+  EMAIL = 8,
 }
 
 /**
@@ -5317,6 +8003,18 @@ export const DaemonService: GenService<{
     input: typeof SubscribeFocusEventsRequestSchema;
     output: typeof FocusEventSchema;
   },
+  /**
+   * RunGC applies the retention policies once — session logs, recordings,
+   * insights and diff caches, stale watchfire/* branches. dry_run reports
+   * what would be removed without touching anything.
+   *
+   * @generated from rpc watchfire.DaemonService.RunGC
+   */
+  runGC: {
+    methodKind: "unary";
+    input: typeof RunGCRequestSchema;
+    output: typeof GCReportSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_watchfire, 2);
 
@@ -5350,6 +8048,39 @@ export const LogService: GenService<{
     input: typeof DeleteLogRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * GetRecording streams a session's asciicast v2 recording in chunks so
+   * long sessions don't run into the gRPC message size limit.
+   *
+   * @generated from rpc watchfire.LogService.GetRecording
+   */
+  getRecording: {
+    methodKind: "server_streaming";
+    input: typeof GetRecordingRequestSchema;
+    output: typeof RecordingChunkSchema;
+  },
+  /**
+   * SearchLogs runs a full-text query over rendered transcripts (or
+   * scrollback) of session logs, across one or all projects.
+   *
+   * @generated from rpc watchfire.LogService.SearchLogs
+   */
+  searchLogs: {
+    methodKind: "unary";
+    input: typeof SearchLogsRequestSchema;
+    output: typeof SearchLogsResponseSchema;
+  },
+  /**
+   * GetSessionEvents returns a session's normalized event log: messages,
+   * tool calls, shell commands, file edits and token usage.
+   *
+   * @generated from rpc watchfire.LogService.GetSessionEvents
+   */
+  getSessionEvents: {
+    methodKind: "unary";
+    input: typeof GetSessionEventsRequestSchema;
+    output: typeof SessionEventListSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_watchfire, 3);
 
@@ -5441,6 +8172,14 @@ export const AgentService: GenService<{
     input: typeof ProjectIdSchema;
     output: typeof AgentStatusSchema;
   },
+  /**
+   * @generated from rpc watchfire.AgentService.AnswerQuestion
+   */
+  answerQuestion: {
+    methodKind: "unary";
+    input: typeof AnswerQuestionRequestSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_watchfire, 4);
 
@@ -5628,6 +8367,14 @@ export const InsightsService: GenService<{
     input: typeof GetTaskDiffRequestSchema;
     output: typeof FileDiffSetSchema;
   },
+  /**
+   * @generated from rpc watchfire.InsightsService.GetHotspots
+   */
+  getHotspots: {
+    methodKind: "unary";
+    input: typeof GetHotspotsRequestSchema;
+    output: typeof HotspotsSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_watchfire, 8);
 
@@ -5771,6 +8518,51 @@ export const IntegrationsService: GenService<{
     input: typeof RevokeTelegramChatRequestSchema;
     output: typeof IntegrationsConfigSchema;
   },
+  /**
+   * Durable relay outbox. ListFailedDeliveries returns the dead-lettered
+   * deliveries (and, on request, the ones still retrying); ReplayDelivery
+   * re-queues one for an immediate attempt with a fresh retry budget.
+   *
+   * @generated from rpc watchfire.IntegrationsService.ListFailedDeliveries
+   */
+  listFailedDeliveries: {
+    methodKind: "unary";
+    input: typeof ListFailedDeliveriesRequestSchema;
+    output: typeof ListFailedDeliveriesResponseSchema;
+  },
+  /**
+   * @generated from rpc watchfire.IntegrationsService.ReplayDelivery
+   */
+  replayDelivery: {
+    methodKind: "unary";
+    input: typeof ReplayDeliveryRequestSchema;
+    output: typeof RelayDeliverySchema;
+  },
+  /**
+   * Relay message templates. Overrides live in
+   * ~/.watchfire/templates/relay/ (per endpoint in a subdirectory named
+   * after its id); PreviewTemplate validates and previews one against a
+   * sample payload and can save it.
+   *
+   * @generated from rpc watchfire.IntegrationsService.PreviewTemplate
+   */
+  previewTemplate: {
+    methodKind: "unary";
+    input: typeof PreviewTemplateRequestSchema;
+    output: typeof PreviewTemplateResponseSchema;
+  },
+  /**
+   * Notification routing. TestRoute dry-runs one notification through
+   * the `routes:` rules and reports where it would be delivered; nothing
+   * is sent.
+   *
+   * @generated from rpc watchfire.IntegrationsService.TestRoute
+   */
+  testRoute: {
+    methodKind: "unary";
+    input: typeof TestRouteRequestSchema;
+    output: typeof TestRouteResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_watchfire, 9);
 
@@ -18,10 +18,19 @@ import {
 import { getInsightsClient } from '../lib/grpc-client'
 
 /** ExportFormatLabel — label keyed by the format the user picked. */
-export type ExportFormatLabel = 'markdown' | 'csv'
+export type ExportFormatLabel = 'markdown' | 'csv' | 'json' | 'html'
 
 function labelToProto(format: ExportFormatLabel): ExportFormat {
-  return format === 'csv' ? ExportFormat.CSV : ExportFormat.MARKDOWN
+  switch (format) {
+    case 'csv':
+      return ExportFormat.CSV
+    case 'json':
+      return ExportFormat.JSON
+    case 'html':
+      return ExportFormat.HTML
+    default:
+      return ExportFormat.MARKDOWN
+  }
 }
 
 export interface ExportWindow {
@@ -203,6 +203,14 @@ export function formatCost(cost: number, missing: number): string {
   return usd
 }
 
+/** formatEstimatedCost labels the share of a cost total that was
+ *  estimated from the settings pricing table rather than reported by the
+ *  agent, or returns undefined when every cost was reported. */
+export function formatEstimatedCost(estimated: number, tasks: number): string | undefined {
+  if (tasks <= 0) return undefined
+  return `$${estimated.toFixed(2)} estimated (${tasks} task${tasks === 1 ? '' : 's'})`
+}
+
 /** formatPercent renders a 0..1 ratio as a whole-number percentage. */
 export function formatPercent(rate: number): string {
   if (!Number.isFinite(rate) || rate <= 0) return '0%'
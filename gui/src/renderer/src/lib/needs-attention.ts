@@ -3,14 +3,21 @@ import type { FocusRequestTarget } from '../stores/app-store'
 
 // v8 Inferno — mission control. A single "needs me" entry aggregated across all
 // projects for the home window's needs-attention surface. Two sources feed it:
-//   - live agent issues (auth_required / rate_limited) from the daemon's issue
+//   - live agent issues (auth_required / rate_limited, and awaiting_input
+//     while an agent waits on a question it asked) from the daemon's issue
 //     detector, streamed via AgentService.SubscribeAgentIssues; and
 //   - TASK_FAILED state (an agent-reported failure, or a post-task auto-merge
 //     failure) derived from the task list the dashboard already loads.
 // Click-through opens/focuses the offending project's window and routes it to
 // the relevant surface (`target` / `taskNumber`).
 
-export type AttentionKind = 'auth_required' | 'rate_limited' | 'agent_issue' | 'task_failed' | 'merge_failed'
+export type AttentionKind =
+  | 'auth_required'
+  | 'rate_limited'
+  | 'awaiting_input'
+  | 'agent_issue'
+  | 'task_failed'
+  | 'merge_failed'
 
 export interface AttentionEntry {
   // Stable key so React lists don't thrash and so identical re-renders dedupe.
@@ -54,6 +61,8 @@ function issueKindLabel(issueType: string): { kind: AttentionKind; label: string
       return { kind: 'auth_required', label: 'Auth required' }
     case 'rate_limited':
       return { kind: 'rate_limited', label: 'Rate limited' }
+    case 'awaiting_input':
+      return { kind: 'awaiting_input', label: 'Question' }
     default:
       return { kind: 'agent_issue', label: 'Agent issue' }
   }
@@ -92,12 +92,21 @@ function openStream(projectId: string): SubscriptionEntry {
 
   void (async () => {
     const client = getAgentClient()
+    // Delta frames: the daemon sends a keyframe first (and again after a
+    // resize or a resync) and only the changed rows in between, so keep
+    // a local copy of the screen and patch it.
+    let lines: string[] = []
     try {
       for await (const buf of client.subscribeScreen(
-        { projectId },
+        { projectId, deltas: true },
         { signal: abort.signal }
       )) {
-        scheduleEmit(projectId, lastNonBlank(buf.lines))
+        if (buf.keyframe) {
+          lines = [...buf.lines]
+        } else {
+          for (const d of buf.rowDeltas) lines[d.row] = d.line
+        }
+        scheduleEmit(projectId, lastNonBlank(lines))
       }
     } catch (err: unknown) {
       if (err instanceof Error && err.name !== 'AbortError') {
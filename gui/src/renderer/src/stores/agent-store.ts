@@ -39,6 +39,9 @@ interface AgentState {
   }) => Promise<AgentStatus>
   stopAgent: (projectId: string) => Promise<void>
   resumeAgent: (projectId: string) => Promise<void>
+  // Answer the question the agent is waiting on (issue awaiting_input);
+  // option picks a suggested answer by its 1-based number.
+  answerQuestion: (projectId: string, answer: string, option?: number) => Promise<void>
   sendInput: (projectId: string, data: Uint8Array) => Promise<void>
   resize: (projectId: string, rows: number, cols: number) => Promise<void>
 
@@ -118,6 +121,7 @@ export const useAgentStore = create<AgentState>((set, get) => ({
     try {
       const client = getAgentClient()
       const status = await client.startAgent({
+        meta: { origin: 'gui' },
         projectId,
         mode,
         taskNumber: opts.taskNumber || 0,
@@ -157,6 +161,12 @@ export const useAgentStore = create<AgentState>((set, get) => ({
     }))
   },
 
+  answerQuestion: async (projectId, answer, option = 0) => {
+    const client = getAgentClient()
+    await client.answerQuestion({ meta: { origin: 'gui' }, projectId, answer, option })
+    // The issue stream clears the banner once the answer is typed in.
+  },
+
   sendInput: async (projectId, data) => {
     const client = getAgentClient()
     await client.sendInput({ projectId, data })
@@ -90,12 +90,37 @@ function getAudios(): { taskFailed: PlayableAudio; runComplete: PlayableAudio }
   return cachedAudios
 }
 
+// Kinds that fire often during a healthy run. They land in the recent list
+// (and the tray) but never raise a native OS toast — chat channels opt into
+// them; the desktop shouldn't pop up for every finished task.
+const NO_TOAST_KINDS: ReadonlySet<NotificationKind> = new Set<NotificationKind>([
+  'TASK_SUCCEEDED',
+  'WILDFIRE_PHASE_CHANGED',
+  'TASKS_GENERATED'
+])
+
 function pbKindToString(k: PbNotificationKind): NotificationKind {
   switch (k) {
     case PbNotificationKind.RUN_COMPLETE:
       return 'RUN_COMPLETE'
     case PbNotificationKind.WEEKLY_DIGEST:
       return 'WEEKLY_DIGEST'
+    case PbNotificationKind.BUDGET_THRESHOLD:
+      return 'BUDGET_THRESHOLD'
+    case PbNotificationKind.TASK_SUCCEEDED:
+      return 'TASK_SUCCEEDED'
+    case PbNotificationKind.MERGE_FAILED:
+      return 'MERGE_FAILED'
+    case PbNotificationKind.PR_OPENED:
+      return 'PR_OPENED'
+    case PbNotificationKind.AGENT_NEEDS_AUTH:
+      return 'AGENT_NEEDS_AUTH'
+    case PbNotificationKind.RATE_LIMITED:
+      return 'RATE_LIMITED'
+    case PbNotificationKind.WILDFIRE_PHASE_CHANGED:
+      return 'WILDFIRE_PHASE_CHANGED'
+    case PbNotificationKind.TASKS_GENERATED:
+      return 'TASKS_GENERATED'
     case PbNotificationKind.TASK_FAILED:
     default:
       return 'TASK_FAILED'
@@ -124,6 +149,7 @@ async function consume(abort: AbortController): Promise<void> {
       // Hand off to the main process so Electron can show a native OS
       // Notification (with silent: true — the renderer plays its own
       // sound via notify() above when the user has enabled it).
+      if (NO_TOAST_KINDS.has(kind)) continue
       try {
         await window.watchfire.emitNotification({
           id: ev.id,
@@ -52,6 +52,7 @@ export const useTasksStore = create<TasksState>((set, get) => ({
   createTask: async (projectId, title, prompt, opts = {}) => {
     const client = getTaskClient()
     const task = await client.createTask({
+      meta: { origin: 'gui' },
       projectId,
       title,
       prompt,
@@ -69,7 +70,7 @@ export const useTasksStore = create<TasksState>((set, get) => ({
   // as createTask. Returns the created tasks in input order.
   createTasksBatch: async (projectId, text, status) => {
     const client = getTaskClient()
-    const resp = await client.createTasksBatch({ projectId, text, status })
+    const resp = await client.createTasksBatch({ meta: { origin: 'gui' }, projectId, text, status })
     get().fetchTasks(projectId)
     return resp.tasks
   },
@@ -19,6 +19,7 @@ import {
   dayChartSummary,
   formatBucketLabel,
   formatCost,
+  formatEstimatedCost,
   formatDuration,
   formatInt,
   formatLinesPair,
@@ -294,6 +295,7 @@ function RollupBody({ insights, granularity, compareLines }: RollupBodyProps) {
           value={costLabel}
           warn={partialCost}
           warnHint={`${insights.tasksMissingCost} task${insights.tasksMissingCost === 1 ? '' : 's'} missing cost`}
+          sub={formatEstimatedCost(insights.estimatedCostUsd, insights.tasksEstimatedCost)}
         />
       </div>
 
@@ -19,6 +19,7 @@ import {
   codeCoverageNote,
   dayBarHeights,
   formatCost,
+  formatEstimatedCost,
   formatDuration,
   formatInt,
   formatLinesPair,
@@ -196,6 +197,7 @@ function InsightsBody({ insights }: BodyProps) {
           value={costLabel}
           warn={partialCost}
           warnHint={`${insights.tasksMissingCost} task${insights.tasksMissingCost === 1 ? '' : 's'} missing cost`}
+          sub={formatEstimatedCost(insights.estimatedCostUsd, insights.tasksEstimatedCost)}
         />
       </section>
 
@@ -19,6 +19,7 @@ export function ChatTab({ projectId }: Props) {
   const startAgent = useAgentStore((s) => s.startAgent)
 
   const resumeAgent = useAgentStore((s) => s.resumeAgent)
+  const answerQuestion = useAgentStore((s) => s.answerQuestion)
   const fetchStatus = useAgentStore((s) => s.fetchStatus)
   const { toast } = useToast()
 
@@ -95,6 +96,13 @@ export function ChatTab({ projectId }: Props) {
         <IssueBanner
           issue={issue}
           onResume={() => resumeAgent(projectId)}
+          onAnswer={async (answer, option) => {
+            try {
+              await answerQuestion(projectId, answer, option)
+            } catch (err) {
+              toast(String(err), 'error')
+            }
+          }}
         />
       )}
 
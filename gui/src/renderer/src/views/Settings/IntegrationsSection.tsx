@@ -14,6 +14,7 @@ import { SlackDetail } from './integrations/SlackDetail'
 import { DiscordDetail } from './integrations/DiscordDetail'
 import { GitHubDetail } from './integrations/GitHubDetail'
 import { TelegramDetail } from './integrations/TelegramDetail'
+import { enabledEventLabels } from './integrations/EventCheckboxes'
 
 type DetailTarget =
   | { kind: IntegrationKind.WEBHOOK; id: string | null }
@@ -25,10 +26,7 @@ type DetailTarget =
 
 function formatEvents(events?: IntegrationEvents): string {
   if (!events) return '(none)'
-  const parts: string[] = []
-  if (events.taskFailed) parts.push('TASK_FAILED')
-  if (events.runComplete) parts.push('RUN_COMPLETE')
-  if (events.weeklyDigest) parts.push('WEEKLY_DIGEST')
+  const parts = enabledEventLabels(events)
   return parts.length === 0 ? '(no events)' : parts.join(' · ')
 }
 
@@ -1,15 +1,23 @@
-interface Events {
+import type { IntegrationEvents } from '../../../generated/watchfire_pb'
+
+export interface Events {
   taskFailed: boolean
   runComplete: boolean
   weeklyDigest: boolean
+  budgetThreshold: boolean
+  // Lifecycle events keyed by their snake_case event key
+  // (`merge_failed`, `pr_opened`, ...). The daemon reports every key.
+  events: Record<string, boolean>
 }
 
+type LegacyKey = Exclude<keyof Events, 'events'>
+
 interface Props {
   value: Events
   onChange: (next: Events) => void
 }
 
-const ROWS: { key: keyof Events; label: string; description: string }[] = [
+const ROWS: { key: LegacyKey; label: string; description: string }[] = [
   {
     key: 'taskFailed',
     label: 'TASK_FAILED',
@@ -24,9 +32,86 @@ const ROWS: { key: keyof Events; label: string; description: string }[] = [
     key: 'weeklyDigest',
     label: 'WEEKLY_DIGEST',
     description: 'Fan out the v6.0 Ember Markdown digest'
+  },
+  {
+    key: 'budgetThreshold',
+    label: 'BUDGET_THRESHOLD',
+    description: 'Fan out when monthly spend crosses a budget threshold'
+  }
+]
+
+// Mirrors models.ExtendedEvents. `inherits` names the legacy toggle an
+// unset key follows — same rule as EventBitmask.Enabled in the daemon.
+const EXTENDED_ROWS: {
+  key: string
+  label: string
+  description: string
+  inherits?: LegacyKey
+}[] = [
+  {
+    key: 'task_succeeded',
+    label: 'TASK_SUCCEEDED',
+    description: 'Fan out every task that finishes with success: true'
+  },
+  {
+    key: 'merge_failed',
+    label: 'MERGE_FAILED',
+    description: 'Fan out when the post-task auto-merge fails',
+    inherits: 'taskFailed'
+  },
+  {
+    key: 'pr_opened',
+    label: 'PR_OPENED',
+    description: 'Fan out when auto-PR opens a pull request',
+    inherits: 'runComplete'
+  },
+  {
+    key: 'agent_needs_auth',
+    label: 'AGENT_NEEDS_AUTH',
+    description: 'Fan out when the agent stops on an authentication error'
+  },
+  {
+    key: 'rate_limited',
+    label: 'RATE_LIMITED',
+    description: "Fan out when the agent hits its provider's rate limit"
+  },
+  {
+    key: 'wildfire_phase_changed',
+    label: 'WILDFIRE_PHASE_CHANGED',
+    description: 'Fan out each wildfire phase transition'
+  },
+  {
+    key: 'tasks_generated',
+    label: 'TASKS_GENERATED',
+    description: 'Fan out how many tasks a generation session created'
   }
 ]
 
+/** Builds the editable event state from a saved endpoint (or the defaults). */
+export function eventsFromProto(ev?: IntegrationEvents): Events {
+  const base = {
+    taskFailed: ev?.taskFailed ?? true,
+    runComplete: ev?.runComplete ?? true,
+    weeklyDigest: ev?.weeklyDigest ?? false,
+    budgetThreshold: ev?.budgetThreshold ?? false
+  }
+  const events: Record<string, boolean> = {}
+  for (const row of EXTENDED_ROWS) {
+    events[row.key] = ev?.events?.[row.key] ?? (row.inherits ? base[row.inherits] : false)
+  }
+  return { ...base, events }
+}
+
+/** Short labels for every enabled event, in display order. */
+export function enabledEventLabels(ev?: IntegrationEvents): string[] {
+  if (!ev) return []
+  const out = ROWS.filter((row) => ev[row.key]).map((row) => row.label)
+  for (const row of EXTENDED_ROWS) {
+    if (ev.events?.[row.key]) out.push(row.label)
+  }
+  return out
+}
+
 export function EventCheckboxes({ value, onChange }: Props) {
   return (
     <fieldset className="space-y-2">
@@ -47,6 +132,22 @@ export function EventCheckboxes({ value, onChange }: Props) {
           </div>
         </label>
       ))}
+      {EXTENDED_ROWS.map((row) => (
+        <label key={row.key} className="flex items-start gap-2 cursor-pointer">
+          <input
+            type="checkbox"
+            checked={value.events[row.key] ?? false}
+            onChange={(e) =>
+              onChange({ ...value, events: { ...value.events, [row.key]: e.target.checked } })
+            }
+            className="mt-1 accent-fire-500"
+          />
+          <div className="flex flex-col">
+            <span className="text-sm font-medium text-[var(--wf-text-primary)]">{row.label}</span>
+            <span className="text-xs text-[var(--wf-text-muted)]">{row.description}</span>
+          </div>
+        </label>
+      ))}
     </fieldset>
   )
 }
@@ -6,7 +6,7 @@ import { useProjectsStore } from '../../../stores/projects-store'
 import { useToast } from '../../../components/ui/Toast'
 import { Button } from '../../../components/ui/Button'
 import { Input } from '../../../components/ui/Input'
-import { EventCheckboxes } from './EventCheckboxes'
+import { EventCheckboxes, eventsFromProto } from './EventCheckboxes'
 import { ProjectMuteSelect } from './ProjectMuteSelect'
 
 interface Props {
@@ -26,11 +26,7 @@ export function SlackDetail({ initial, onClose }: Props) {
 
   const [label, setLabel] = useState(initial?.label ?? '')
   const [url, setUrl] = useState('')
-  const [events, setEvents] = useState({
-    taskFailed: initial?.enabledEvents?.taskFailed ?? true,
-    runComplete: initial?.enabledEvents?.runComplete ?? true,
-    weeklyDigest: initial?.enabledEvents?.weeklyDigest ?? false
-  })
+  const [events, setEvents] = useState(() => eventsFromProto(initial?.enabledEvents))
   const [muteIds, setMuteIds] = useState<string[]>(initial?.projectMuteIds ?? [])
   const [testing, setTesting] = useState(false)
 
@@ -7,7 +7,7 @@ import { useToast } from '../../../components/ui/Toast'
 import { Button } from '../../../components/ui/Button'
 import { Input } from '../../../components/ui/Input'
 import { Toggle } from '../../../components/ui/Toggle'
-import { EventCheckboxes } from './EventCheckboxes'
+import { EventCheckboxes, eventsFromProto } from './EventCheckboxes'
 import { encodeQr } from '../../../lib/qr'
 import {
   formatCountdown,
@@ -83,11 +83,7 @@ export function TelegramDetail({ initial, onClose }: Props) {
 
   const [enabled, setEnabled] = useState(initial?.enabled ?? false)
   const [token, setToken] = useState('')
-  const [events, setEvents] = useState({
-    taskFailed: initial?.enabledEvents?.taskFailed ?? true,
-    runComplete: initial?.enabledEvents?.runComplete ?? true,
-    weeklyDigest: initial?.enabledEvents?.weeklyDigest ?? false
-  })
+  const [events, setEvents] = useState(() => eventsFromProto(initial?.enabledEvents))
   const [testing, setTesting] = useState(false)
   const [nowMs, setNowMs] = useState(() => Date.now())
 
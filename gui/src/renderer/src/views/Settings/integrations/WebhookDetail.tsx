@@ -7,7 +7,7 @@ import { useToast } from '../../../components/ui/Toast'
 import { Button } from '../../../components/ui/Button'
 import { Toggle } from '../../../components/ui/Toggle'
 import { Input } from '../../../components/ui/Input'
-import { EventCheckboxes } from './EventCheckboxes'
+import { EventCheckboxes, eventsFromProto } from './EventCheckboxes'
 import { ProjectMuteSelect } from './ProjectMuteSelect'
 
 interface Props {
@@ -28,11 +28,7 @@ export function WebhookDetail({ initial, onClose }: Props) {
   const [label, setLabel] = useState(initial?.label ?? '')
   const [url, setUrl] = useState(initial?.url ?? '')
   const [secret, setSecret] = useState('')
-  const [events, setEvents] = useState({
-    taskFailed: initial?.enabledEvents?.taskFailed ?? true,
-    runComplete: initial?.enabledEvents?.runComplete ?? true,
-    weeklyDigest: initial?.enabledEvents?.weeklyDigest ?? false
-  })
+  const [events, setEvents] = useState(() => eventsFromProto(initial?.enabledEvents))
   const [muteIds, setMuteIds] = useState<string[]>(initial?.projectMuteIds ?? [])
   const [testing, setTesting] = useState(false)
 
@@ -151,7 +147,13 @@ export function WebhookDetail({ initial, onClose }: Props) {
           "muted" master switch the daemon already supports via empty
           enabled_events. */}
       <Toggle
-        checked={events.taskFailed || events.runComplete || events.weeklyDigest}
+        checked={
+          events.taskFailed ||
+          events.runComplete ||
+          events.weeklyDigest ||
+          events.budgetThreshold ||
+          Object.values(events.events).some(Boolean)
+        }
         onChange={() => {}}
         label="Endpoint active"
         disabled
@@ -0,0 +1,262 @@
+// Package asciicast reads and writes asciicast v2 recordings
+// (https://docs.asciinema.org/manual/asciicast/v2/). The daemon records
+// every agent PTY session as one; `watchfire logs replay` plays them back.
+package asciicast
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Version is the asciicast format version this package writes.
+const Version = 2
+
+// Event types.
+const (
+	EventOutput = "o"
+	EventInput  = "i"
+	EventResize = "r"
+	EventMarker = "m"
+)
+
+// Header is the first line of an asciicast v2 file.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Event is one timestamped entry after the header. Time is seconds since
+// the start of the recording.
+type Event struct {
+	Time float64
+	Type string
+	Data string
+}
+
+// MarshalJSON encodes the event as the `[time, type, data]` triple the
+// format uses.
+func (e Event) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := json.Marshal(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(data)+len(typ)+16)
+	out = append(out, '[')
+	out = strconv.AppendFloat(out, e.Time, 'f', 6, 64)
+	out = append(out, ',', ' ')
+	out = append(out, typ...)
+	out = append(out, ',', ' ')
+	out = append(out, data...)
+	out = append(out, ']')
+	return out, nil
+}
+
+// UnmarshalJSON decodes a `[time, type, data]` triple.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("asciicast: event has %d elements, want 3", len(raw))
+	}
+	if err := json.Unmarshal(raw[0], &e.Time); err != nil {
+		return fmt.Errorf("asciicast: event time: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &e.Type); err != nil {
+		return fmt.Errorf("asciicast: event type: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &e.Data); err != nil {
+		return fmt.Errorf("asciicast: event data: %w", err)
+	}
+	return nil
+}
+
+// Writer appends events to a recording. Safe for concurrent use.
+type Writer struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+	start  time.Time
+	// partial holds the leading bytes of a UTF-8 rune split across two
+	// Output calls. Event data is a JSON string, so emitting half a rune
+	// would turn it into U+FFFD on disk.
+	partial []byte
+	err     error
+}
+
+// Create opens path for writing and writes the header. start is the
+// instant event times are measured from; it also becomes the header
+// timestamp when h.Timestamp is unset.
+func Create(path string, h Header, start time.Time) (*Writer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewWriter(f, h, start)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	w.closer = f
+	return w, nil
+}
+
+// NewWriter writes the header to w and returns a Writer for the events.
+func NewWriter(w io.Writer, h Header, start time.Time) (*Writer, error) {
+	h.Version = Version
+	if h.Timestamp == 0 {
+		h.Timestamp = start.Unix()
+	}
+	bw := bufio.NewWriterSize(w, 32*1024)
+	head, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := bw.Write(append(head, '\n')); err != nil {
+		return nil, err
+	}
+	return &Writer{w: bw, start: start}, nil
+}
+
+// Output records data written to the terminal at the given instant.
+func (w *Writer) Output(at time.Time, data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		data = append(w.partial, data...)
+		w.partial = nil
+	}
+	if n := incompleteSuffix(data); n > 0 {
+		w.partial = append([]byte(nil), data[len(data)-n:]...)
+		data = data[:len(data)-n]
+	}
+	if len(data) == 0 {
+		return w.err
+	}
+	return w.writeLocked(Event{Time: w.offset(at), Type: EventOutput, Data: string(data)})
+}
+
+// Resize records a terminal size change.
+func (w *Writer) Resize(at time.Time, cols, rows int) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.writeLocked(Event{Time: w.offset(at), Type: EventResize, Data: fmt.Sprintf("%dx%d", cols, rows)})
+}
+
+// Close flushes buffered events and closes the underlying file, if the
+// Writer owns one. Any bytes of a rune that never completed are written
+// out (as U+FFFD) rather than dropped.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		_ = w.writeLocked(Event{Time: w.offset(time.Now()), Type: EventOutput, Data: string(w.partial)})
+		w.partial = nil
+	}
+	err := w.w.Flush()
+	if w.closer != nil {
+		if cerr := w.closer.Close(); err == nil {
+			err = cerr
+		}
+		w.closer = nil
+	}
+	if err == nil {
+		err = w.err
+	}
+	return err
+}
+
+func (w *Writer) offset(at time.Time) float64 {
+	d := at.Sub(w.start).Seconds()
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// writeLocked appends one event line. The first error is sticky: once
+// the disk write fails the recording is truncated, not interleaved with
+// garbage. Must be called while holding mu.
+func (w *Writer) writeLocked(e Event) error {
+	if w.err != nil {
+		return w.err
+	}
+	line, err := e.MarshalJSON()
+	if err != nil {
+		w.err = err
+		return err
+	}
+	if _, err := w.w.Write(append(line, '\n')); err != nil {
+		w.err = err
+	}
+	return w.err
+}
+
+// incompleteSuffix reports how many trailing bytes of b are the start of
+// a UTF-8 rune that hasn't been completed yet.
+func incompleteSuffix(b []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		if utf8.RuneStart(b[len(b)-i]) {
+			if !utf8.FullRune(b[len(b)-i:]) {
+				return i
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// Read parses a whole recording.
+func Read(r io.Reader) (*Header, []Event, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("asciicast: empty recording")
+	}
+	var h Header
+	if err := json.Unmarshal(sc.Bytes(), &h); err != nil {
+		return nil, nil, fmt.Errorf("asciicast: header: %w", err)
+	}
+	if h.Version != Version {
+		return nil, nil, fmt.Errorf("asciicast: unsupported version %d", h.Version)
+	}
+	var events []Event
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			// A recording cut short by a crash ends mid-line; keep
+			// everything before it.
+			break
+		}
+		events = append(events, e)
+	}
+	if err := sc.Err(); err != nil {
+		return &h, events, err
+	}
+	return &h, events, nil
+}
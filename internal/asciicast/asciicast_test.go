@@ -0,0 +1,127 @@
+package asciicast
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestWriterRoundTrip writes a header, output and a resize, reads them
+// back, and checks times are relative to start.
+func TestWriterRoundTrip(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{Width: 80, Height: 24}, start)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	if err := w.Output(start.Add(500*time.Millisecond), []byte("hello \"world\"\r\n")); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+	if err := w.Resize(start.Add(time.Second), 120, 40); err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	h, events, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if h.Version != 2 || h.Width != 80 || h.Height != 24 || h.Timestamp != start.Unix() {
+		t.Errorf("header = %+v", h)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].Type != EventOutput || events[0].Data != "hello \"world\"\r\n" || events[0].Time != 0.5 {
+		t.Errorf("output event = %+v", events[0])
+	}
+	if events[1].Type != EventResize || events[1].Data != "120x40" || events[1].Time != 1 {
+		t.Errorf("resize event = %+v", events[1])
+	}
+}
+
+// TestWriterSplitRune — PTY reads split multi-byte runes; the writer must
+// hold the partial bytes back so the on-disk JSON string stays valid UTF-8.
+func TestWriterSplitRune(t *testing.T) {
+	start := time.Now()
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, Header{Width: 80, Height: 24}, start)
+	check := []byte("✓ done")
+	_ = w.Output(start, check[:2])
+	_ = w.Output(start, check[2:])
+	_ = w.Close()
+
+	_, events, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	var got strings.Builder
+	for _, e := range events {
+		got.WriteString(e.Data)
+	}
+	if got.String() != "✓ done" {
+		t.Errorf("reassembled = %q, want %q", got.String(), "✓ done")
+	}
+}
+
+// TestReadTruncated — a daemon crash leaves a half-written last line; the
+// events before it must still be playable.
+func TestReadTruncated(t *testing.T) {
+	in := `{"version": 2, "width": 80, "height": 24}
+[0.1, "o", "a"]
+[0.2, "o", "b`
+	_, events, err := Read(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if len(events) != 1 || events[0].Data != "a" {
+		t.Errorf("events = %+v", events)
+	}
+}
+
+func TestReadRejectsOtherVersions(t *testing.T) {
+	if _, _, err := Read(strings.NewReader(`{"version": 1, "width": 80, "height": 24}`)); err == nil {
+		t.Error("expected error for version 1")
+	}
+}
+
+// TestPlaySpeedAndIdle checks that only output is written and that speed
+// and max-idle keep a recording with a long gap fast to replay.
+func TestPlaySpeedAndIdle(t *testing.T) {
+	events := []Event{
+		{Time: 0, Type: EventOutput, Data: "a"},
+		{Time: 0.1, Type: EventResize, Data: "100x30"},
+		{Time: 0.2, Type: EventOutput, Data: "b"},
+		{Time: 60, Type: EventOutput, Data: "c"},
+	}
+	var out bytes.Buffer
+	begin := time.Now()
+	err := Play(context.Background(), &out, events, PlayOptions{Speed: 10, MaxIdle: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Play: %v", err)
+	}
+	if out.String() != "abc" {
+		t.Errorf("output = %q, want %q", out.String(), "abc")
+	}
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Errorf("playback took %v; max-idle should have capped the 60s gap", elapsed)
+	}
+}
+
+func TestPlayCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	events := []Event{{Time: 10, Type: EventOutput, Data: "late"}}
+	var out bytes.Buffer
+	if err := Play(ctx, &out, events, PlayOptions{}); err != context.Canceled {
+		t.Errorf("Play err = %v, want context.Canceled", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("wrote %q after cancel", out.String())
+	}
+}
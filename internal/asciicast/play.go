@@ -0,0 +1,57 @@
+package asciicast
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// PlayOptions tunes playback.
+type PlayOptions struct {
+	// Speed multiplies playback rate; values <= 0 mean 1.
+	Speed float64
+	// MaxIdle caps any pause between events (after scaling by Speed) so
+	// an agent thinking for two minutes doesn't stall the replay. Zero
+	// means no cap.
+	MaxIdle time.Duration
+}
+
+// Play writes the output events to out, sleeping between them to
+// reproduce the original timing. Input, resize and marker events are
+// skipped: the caller's terminal is whatever size it is. Returns
+// ctx.Err() when cancelled.
+func Play(ctx context.Context, out io.Writer, events []Event, opts PlayOptions) error {
+	speed := opts.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+
+	var last float64
+	for _, e := range events {
+		if e.Type != EventOutput {
+			continue
+		}
+		wait := time.Duration((e.Time - last) / speed * float64(time.Second))
+		last = e.Time
+		if opts.MaxIdle > 0 && wait > opts.MaxIdle {
+			wait = opts.MaxIdle
+		}
+		if wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(out, e.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -25,6 +25,9 @@ var sandboxOverride string
 // noSandbox holds the --no-sandbox flag value.
 var noSandbox bool
 
+// overrideBudget holds the --override-budget flag value.
+var overrideBudget bool
+
 func runAgentAttach(projectPath, mode string, taskNumber int32) error {
 	// Ensure daemon is running
 	if err := EnsureDaemon(); err != nil {
@@ -56,10 +59,13 @@ func runAgentAttach(projectPath, mode string, taskNumber int32) error {
 
 	// Start agent
 	status, err := client.StartAgent(ctx, &pb.StartAgentRequest{
+		Meta:       &pb.RequestMeta{Origin: "cli", User: config.LocalUser()},
 		ProjectId:  project.ProjectID,
 		Mode:       mode,
 		TaskNumber: taskNumber,
 		Sandbox:    sandbox,
+
+		OverrideBudget: overrideBudget,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to start agent: %w", err)
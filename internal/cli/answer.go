@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+var answerOption int
+
+var answerCmd = &cobra.Command{
+	Use:   "answer [text]",
+	Short: "Answer the question an agent is waiting on",
+	Long: `Answer the question the current project's agent asked (it wrote
+.watchfire/question.yaml and is waiting). The answer is typed into the
+agent's session, which carries on from there.
+
+Without arguments, prints the pending question and its suggested answers.`,
+	Example: `  watchfire answer
+  watchfire answer "Use Redis"
+  watchfire answer --option 2`,
+	RunE: runAnswer,
+}
+
+func init() {
+	answerCmd.Flags().IntVarP(&answerOption, "option", "o", 0, "Pick the question's suggested answer by number")
+	rootCmd.AddCommand(answerCmd)
+}
+
+func runAnswer(_ *cobra.Command, args []string) error {
+	projectPath, err := getProjectPath()
+	if err != nil {
+		return err
+	}
+	project, err := config.LoadProject(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project config: %w", err)
+	}
+
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+	client := pb.NewAgentServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	text := strings.TrimSpace(strings.Join(args, " "))
+	if text == "" && answerOption == 0 {
+		status, err := client.GetAgentStatus(ctx, &pb.ProjectId{ProjectId: project.ProjectID})
+		if err != nil {
+			return fmt.Errorf("failed to get agent status: %w", err)
+		}
+		issue := status.GetIssue()
+		if issue.GetIssueType() != "awaiting_input" {
+			fmt.Println("No agent is waiting for an answer.")
+			return nil
+		}
+		fmt.Println(styleWarn.Render("The agent asks:"))
+		fmt.Println(issue.GetMessage())
+		for i, o := range issue.GetOptions() {
+			fmt.Printf("  %d. %s\n", i+1, o)
+		}
+		fmt.Println()
+		fmt.Println(`Answer with: watchfire answer "<text>" (or --option <n>)`)
+		return nil
+	}
+
+	if _, err := client.AnswerQuestion(ctx, &pb.AnswerQuestionRequest{
+		Meta:      &pb.RequestMeta{Origin: "cli", User: config.LocalUser()},
+		ProjectId: project.ProjectID,
+		Answer:    text,
+		Option:    int32(answerOption),
+	}); err != nil {
+		return fmt.Errorf("failed to answer: %w", err)
+	}
+	fmt.Println(styleSuccess.Render("Answer sent to the agent."))
+	return nil
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+var (
+	gcDryRun  bool
+	gcProject bool
+)
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Apply retention policies to logs, caches and stale branches",
+	Long: `Remove what the retention policy says Watchfire no longer needs:
+  - session logs (with transcripts, event logs and recordings) past the
+    age, count or size limits
+  - recordings past the recordings limits
+  - insights and diff caches for deleted projects/tasks or past the age limit
+  - relay dead letters past the global age limit
+  - watchfire/* branches of done or deleted tasks whose last commit is
+    older than branch_max_age_days, merged or not (off unless set)
+
+Limits come from settings.yaml 'retention:' and can be overridden per
+project with a 'retention:' block in .watchfire/project.yaml. The daemon
+runs the same pass every few hours while retention.enabled is on.
+
+Run with --dry-run first to see what would go.`,
+	Example: `  watchfire gc --dry-run
+  watchfire gc --project`,
+	Args: cobra.NoArgs,
+	RunE: runGC,
+}
+
+func init() {
+	gcCmd.Flags().BoolVar(&gcDryRun, "dry-run", false, "Report what would be removed without removing anything")
+	gcCmd.Flags().BoolVarP(&gcProject, "project", "p", false, "Only the project in the current directory")
+	rootCmd.AddCommand(gcCmd)
+}
+
+func runGC(_ *cobra.Command, _ []string) error {
+	var projectID string
+	if gcProject {
+		id, err := currentProjectID()
+		if err != nil {
+			return err
+		}
+		projectID = id
+	}
+	if err := EnsureDaemon(); err != nil {
+		return err
+	}
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Deleting many branches shells out to git per branch; be generous.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	report, err := pb.NewDaemonServiceClient(conn).RunGC(ctx, &pb.RunGCRequest{DryRun: gcDryRun, ProjectId: projectID})
+	if err != nil {
+		return fmt.Errorf("gc failed: %w", err)
+	}
+
+	if len(report.Items) == 0 && len(report.Errors) == 0 {
+		fmt.Println(styleHint.Render("Nothing to clean up."))
+		return nil
+	}
+	home, _ := os.UserHomeDir()
+	for _, it := range report.Items {
+		path := it.Path
+		if home != "" && strings.HasPrefix(path, home+string(filepath.Separator)) {
+			path = "~" + path[len(home):]
+		}
+		size := ""
+		if it.Bytes > 0 {
+			size = " " + styleHint.Render(gcBytes(it.Bytes))
+		}
+		fmt.Printf("%s %s%s  %s\n", styleLabel.Render(fmt.Sprintf("%-14s", it.Kind)), styleValue.Render(path), size, styleHint.Render(it.Reason))
+	}
+	for _, e := range report.Errors {
+		fmt.Fprintf(os.Stderr, "error: %s\n", e)
+	}
+
+	verb := "Removed"
+	if report.DryRun {
+		verb = "Would remove"
+	}
+	fmt.Printf("\n%s %d item(s), %s.\n", verb, len(report.Items), gcBytes(report.TotalBytes))
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("%d removal(s) failed", len(report.Errors))
+	}
+	return nil
+}
+
+func gcBytes(n int64) string {
+	switch {
+	case n < 1024:
+		return fmt.Sprintf("%d B", n)
+	case n < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(n)/1024.0)
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1024.0*1024.0))
+	}
+}
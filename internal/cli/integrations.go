@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 
+	"github.com/watchfire-io/watchfire/internal/models"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
@@ -19,7 +20,7 @@ import (
 // covers headless workflows (CI checks, scripted setup verification).
 var integrationsCmd = &cobra.Command{
 	Use:   "integrations",
-	Short: "Manage outbound integrations (Webhook / Slack / Discord / GitHub / Telegram)",
+	Short: "Manage outbound integrations (Webhook / Slack / Discord / Teams / Matrix / ntfy / Email / GitHub / Telegram)",
 	Long: `Inspect and exercise the outbound integrations configured in ~/.watchfire/integrations.yaml.
 
 For the Telegram bridge, chat pairing and status live under their own
@@ -58,10 +59,10 @@ var telegramAddToken string
 
 var integrationsAddCmd = &cobra.Command{
 	Use:   "add <kind>",
-	Short: "Add an outbound integration (telegram)",
+	Short: "Add an outbound integration (telegram, email)",
 	Long: `Configure a new outbound integration from the terminal.
 
-Currently only the Telegram bridge can be added here:
+The Telegram bridge:
 
   watchfire integrations add telegram              # prompts for the bot token
   watchfire integrations add telegram --token ...  # non-interactive
@@ -76,13 +77,25 @@ configured events.
 Next steps: authorize your chat with 'watchfire telegram pair', then check
 bridge health and paired chats with 'watchfire telegram status'.
 
-Other kinds (webhook / slack / discord / github) are added in Settings →
-Integrations (GUI or TUI).`,
+Email over SMTP — each --to picks its own events (default: task_failed,
+run_complete, weekly_digest); a --username prompts for the password,
+which is stored in the OS keyring:
+
+  watchfire integrations add email --host smtp.example.com --username relay \
+    --from "Watchfire <watchfire@example.com>" \
+    --to oncall@example.com=task_failed,merge_failed \
+    --to manager@example.com=weekly_digest
+
+Other kinds (webhook / slack / discord / teams / matrix / ntfy / github)
+are added in Settings → Integrations (GUI or TUI).`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		kind := strings.ToLower(args[0])
+		if kind == "email" {
+			return runAddEmail()
+		}
 		if kind != "telegram" {
-			return fmt.Errorf("adding %q from the CLI is not supported — use Settings → Integrations in the GUI/TUI (only 'telegram' can be added here)", args[0])
+			return fmt.Errorf("adding %q from the CLI is not supported — use Settings → Integrations in the GUI/TUI (only 'telegram' and 'email' can be added here)", args[0])
 		}
 
 		token := strings.TrimSpace(telegramAddToken)
@@ -158,6 +171,14 @@ func buildTelegramAddPayload(existing *pb.TelegramIntegration, token string) *pb
 			TaskFailed:   ev.GetTaskFailed(),
 			RunComplete:  ev.GetRunComplete(),
 			WeeklyDigest: ev.GetWeeklyDigest(),
+
+			BudgetThreshold: ev.GetBudgetThreshold(),
+		}
+		if len(ev.GetEvents()) > 0 {
+			out.EnabledEvents.Events = make(map[string]bool, len(ev.GetEvents()))
+			for key, on := range ev.GetEvents() {
+				out.EnabledEvents.Events[key] = on
+			}
 		}
 	}
 	return out
@@ -271,10 +292,119 @@ telegram pair').`,
 	},
 }
 
+// deliveriesIncludePending holds the --all flag for `integrations
+// deliveries`.
+var deliveriesIncludePending bool
+
+var integrationsDeliveriesCmd = &cobra.Command{
+	Use:   "deliveries",
+	Short: "List failed outbound deliveries",
+	Long: `List outbound notifications the daemon could not deliver.
+
+Failed deliveries are kept in the durable outbox (~/.watchfire/relay-outbox/)
+and retried with exponential backoff, across daemon restarts, for several
+hours. Once retries run out they move to the dead-letter store, which is what
+this command lists by default. Pass --all to include deliveries that are
+still being retried.
+
+Re-send one with 'watchfire integrations deliveries replay <id>'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := EnsureDaemon(); err != nil {
+			return err
+		}
+		conn, err := ConnectDaemon()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		client := pb.NewIntegrationsServiceClient(conn)
+		resp, err := client.ListFailedDeliveries(ctx, &pb.ListFailedDeliveriesRequest{
+			IncludePending: deliveriesIncludePending,
+		})
+		if err != nil {
+			return fmt.Errorf("list failed deliveries: %w", err)
+		}
+		printDeliveries(resp.GetDeliveries())
+		return nil
+	},
+}
+
+var integrationsDeliveriesReplayCmd = &cobra.Command{
+	Use:   "replay <id>",
+	Short: "Re-send a failed delivery",
+	Long: `Re-queue a dead-lettered (or still retrying) delivery for an immediate
+attempt with a fresh retry budget. The daemon sends it in the background; run
+'watchfire integrations deliveries --all' to see whether it went through.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := EnsureDaemon(); err != nil {
+			return err
+		}
+		conn, err := ConnectDaemon()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		client := pb.NewIntegrationsServiceClient(conn)
+		dl, err := client.ReplayDelivery(ctx, &pb.ReplayDeliveryRequest{Id: args[0]})
+		if err != nil {
+			return fmt.Errorf("replay delivery: %w", err)
+		}
+		fmt.Printf("✓ Re-queued %s %s → %s %s\n", dl.GetId(), dl.GetEvent(), dl.GetAdapterKind(), dl.GetAdapterId())
+		return nil
+	},
+}
+
+func printDeliveries(deliveries []*pb.RelayDelivery) {
+	if len(deliveries) == 0 {
+		fmt.Println("(no failed deliveries)")
+		return
+	}
+	for _, dl := range deliveries {
+		state := "retrying"
+		when := "next=" + dl.GetNextAttemptAt().AsTime().Local().Format(time.DateTime)
+		if dl.GetDead() {
+			state = "dead"
+			when = "dead=" + dl.GetDeadAt().AsTime().Local().Format(time.DateTime)
+		}
+		fmt.Printf("%s  %-8s  %s %s  %s  %s  attempts=%d  %s\n",
+			dl.GetId(), state, dl.GetAdapterKind(), dl.GetAdapterId(),
+			dl.GetEvent(), deliverySubject(dl), dl.GetAttempts(), when,
+		)
+		if e := dl.GetLastError(); e != "" {
+			fmt.Printf("    last error: %s\n", e)
+		}
+	}
+}
+
+func deliverySubject(dl *pb.RelayDelivery) string {
+	subject := dl.GetProjectName()
+	if subject == "" {
+		subject = dl.GetProjectId()
+	}
+	if dl.GetTaskNumber() > 0 {
+		subject = fmt.Sprintf("%s #%04d", subject, dl.GetTaskNumber())
+	}
+	if subject == "" {
+		return "-"
+	}
+	return subject
+}
+
 // detectIntegrationKind searches every configured integration list for
 // a matching id. Returns the matching kind on the first hit (webhook
-// → slack → discord → github) or false when no entry matches. Used by
-// the single-arg form of `watchfire integrations test`.
+// → slack → discord → teams → matrix → ntfy → email → github) or false
+// when no entry matches. Used by the single-arg form of `watchfire
+// integrations test`.
 func detectIntegrationKind(cfg *pb.IntegrationsConfig, id string) (pb.IntegrationKind, bool) {
 	if cfg == nil {
 		return 0, false
@@ -294,6 +424,26 @@ func detectIntegrationKind(cfg *pb.IntegrationsConfig, id string) (pb.Integratio
 			return pb.IntegrationKind_DISCORD, true
 		}
 	}
+	for _, ep := range cfg.GetTeams() {
+		if ep.GetId() == id {
+			return pb.IntegrationKind_TEAMS, true
+		}
+	}
+	for _, ep := range cfg.GetMatrix() {
+		if ep.GetId() == id {
+			return pb.IntegrationKind_MATRIX, true
+		}
+	}
+	for _, ep := range cfg.GetNtfy() {
+		if ep.GetId() == id {
+			return pb.IntegrationKind_NTFY, true
+		}
+	}
+	for _, ep := range cfg.GetEmail() {
+		if ep.GetId() == id {
+			return pb.IntegrationKind_EMAIL, true
+		}
+	}
 	if g := cfg.GetGithub(); g != nil && g.GetEnabled() && id == "github" {
 		return pb.IntegrationKind_GITHUB, true
 	}
@@ -315,8 +465,16 @@ func parseIntegrationKind(s string) (pb.IntegrationKind, error) {
 		return pb.IntegrationKind_GITHUB, nil
 	case "telegram":
 		return pb.IntegrationKind_TELEGRAM, nil
+	case "teams":
+		return pb.IntegrationKind_TEAMS, nil
+	case "matrix":
+		return pb.IntegrationKind_MATRIX, nil
+	case "ntfy":
+		return pb.IntegrationKind_NTFY, nil
+	case "email":
+		return pb.IntegrationKind_EMAIL, nil
 	}
-	return 0, fmt.Errorf("unknown integration kind %q (want one of: webhook, slack, discord, github, telegram)", s)
+	return 0, fmt.Errorf("unknown integration kind %q (want one of: webhook, slack, discord, teams, matrix, ntfy, email, github, telegram)", s)
 }
 
 func printIntegrations(cfg *pb.IntegrationsConfig) {
@@ -346,6 +504,40 @@ func printIntegrations(cfg *pb.IntegrationsConfig) {
 			eventSummary(ep.GetEnabledEvents()),
 		)
 	}
+	for _, ep := range cfg.GetTeams() {
+		any = true
+		fmt.Printf("teams    %s  %s  %s  events=[%s]\n",
+			ep.GetId(), trimDisplay(ep.GetLabel()), ep.GetUrlLabel(),
+			eventSummary(ep.GetEnabledEvents()),
+		)
+	}
+	for _, ep := range cfg.GetMatrix() {
+		any = true
+		token := "token=unset"
+		if ep.GetTokenSet() {
+			token = "token=set"
+		}
+		fmt.Printf("matrix   %s  %s  %s  %s  events=[%s]\n",
+			ep.GetId(), trimDisplay(ep.GetLabel()), ep.GetRoomId(), token,
+			eventSummary(ep.GetEnabledEvents()),
+		)
+	}
+	for _, ep := range cfg.GetNtfy() {
+		any = true
+		fmt.Printf("ntfy     %s  %s  %s  events=[%s]\n",
+			ep.GetId(), trimDisplay(ep.GetLabel()), ep.GetUrl(),
+			eventSummary(ep.GetEnabledEvents()),
+		)
+	}
+	for _, ep := range cfg.GetEmail() {
+		any = true
+		fmt.Printf("email    %s  %s  %s  from=%s\n",
+			ep.GetId(), trimDisplay(ep.GetLabel()), ep.GetHost(), ep.GetFrom(),
+		)
+		for _, r := range ep.GetRecipients() {
+			fmt.Printf("           → %s  events=[%s]\n", r.GetAddress(), eventSummary(r.GetEnabledEvents()))
+		}
+	}
 	if g := cfg.GetGithub(); g != nil && g.GetEnabled() {
 		any = true
 		scopes := "(all)"
@@ -388,6 +580,16 @@ func eventSummary(e *pb.IntegrationEvents) string {
 	if e.GetWeeklyDigest() {
 		on = append(on, "WEEKLY_DIGEST")
 	}
+	if e.GetBudgetThreshold() {
+		on = append(on, "BUDGET_THRESHOLD")
+	}
+	// The daemon resolves every lifecycle event (inherited ones included)
+	// into the map, so the map alone says what the endpoint receives.
+	for _, key := range models.ExtendedEvents {
+		if e.GetEvents()[key] {
+			on = append(on, strings.ToUpper(key))
+		}
+	}
 	return strings.Join(on, ",")
 }
 
@@ -403,5 +605,8 @@ func init() {
 	integrationsCmd.AddCommand(integrationsAddCmd)
 	integrationsCmd.AddCommand(integrationsListCmd)
 	integrationsCmd.AddCommand(integrationsTestCmd)
+	integrationsDeliveriesCmd.Flags().BoolVar(&deliveriesIncludePending, "all", false, "include deliveries that are still being retried")
+	integrationsDeliveriesCmd.AddCommand(integrationsDeliveriesReplayCmd)
+	integrationsCmd.AddCommand(integrationsDeliveriesCmd)
 	rootCmd.AddCommand(integrationsCmd)
 }
@@ -43,8 +43,8 @@ func TestRegisterDiscordCommandsHappyPath(t *testing.T) {
 	if err != nil {
 		t.Fatalf("register: %v", err)
 	}
-	if len(results) != 3 {
-		t.Fatalf("expected 3 results, got %d", len(results))
+	if len(results) != 9 {
+		t.Fatalf("expected 9 results, got %d", len(results))
 	}
 	for _, r := range results {
 		if !r.OK {
@@ -53,22 +53,33 @@ func TestRegisterDiscordCommandsHappyPath(t *testing.T) {
 	}
 	mu.Lock()
 	defer mu.Unlock()
-	if len(captured) != 3 {
-		t.Fatalf("expected 3 captured calls, got %d", len(captured))
+	if len(captured) != 9 {
+		t.Fatalf("expected 9 captured calls, got %d", len(captured))
 	}
-	names := []string{captured[0].Name, captured[1].Name, captured[2].Name}
-	want := []string{"status", "retry", "cancel"}
-	for i, n := range names {
-		if n != want[i] {
-			t.Fatalf("expected command[%d]=%q, got %q", i, want[i], n)
+	want := []string{"status", "retry", "cancel", "answer", "run", "stop", "new", "screen", "watch"}
+	for i, c := range captured {
+		if c.Name != want[i] {
+			t.Fatalf("expected command[%d]=%q, got %q", i, want[i], c.Name)
 		}
 	}
 	// Schema sanity: retry + cancel require a string `task` arg.
-	for _, c := range captured[1:] {
+	for _, c := range captured[1:3] {
 		if len(c.Options) != 1 || c.Options[0].Name != "task" || !c.Options[0].Required {
 			t.Fatalf("expected required 'task' option on %s, got %+v", c.Name, c.Options)
 		}
 	}
+	// answer requires the free-text `text` arg.
+	if c := captured[3]; len(c.Options) != 1 || c.Options[0].Name != "text" || !c.Options[0].Required {
+		t.Fatalf("expected required 'text' option on answer, got %+v", c.Options)
+	}
+	// Run-control commands take an optional `project`, after their
+	// required argument (Discord rejects required-after-optional).
+	for _, c := range captured[4:] {
+		last := c.Options[len(c.Options)-1]
+		if last.Name != "project" || last.Required {
+			t.Fatalf("expected trailing optional 'project' option on %s, got %+v", c.Name, c.Options)
+		}
+	}
 }
 
 func TestRegisterDiscordCommandsHandles4xx(t *testing.T) {
@@ -81,8 +92,8 @@ func TestRegisterDiscordCommandsHandles4xx(t *testing.T) {
 	if err != nil {
 		t.Fatalf("register should not return Go error on 4xx, got %v", err)
 	}
-	if len(results) != 3 {
-		t.Fatalf("expected 3 results, got %d", len(results))
+	if len(results) != 9 {
+		t.Fatalf("expected 9 results, got %d", len(results))
 	}
 	for _, r := range results {
 		if r.OK {
@@ -117,7 +128,7 @@ func TestRegisterDiscordCommandsIdempotent(t *testing.T) {
 			}
 		}
 	}
-	if count != 6 {
-		t.Fatalf("expected 6 total POSTs (3 commands × 2 runs), got %d", count)
+	if count != 18 {
+		t.Fatalf("expected 18 total POSTs (9 commands × 2 runs), got %d", count)
 	}
 }
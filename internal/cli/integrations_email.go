@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// defaultEmailEvents is what a --to address receives when it names no
+// events of its own: failures, finished runs and the weekly digest.
+var defaultEmailEvents = []string{models.EventTaskFailed, models.EventRunComplete, models.EventWeeklyDigest}
+
+// Flags for `watchfire integrations add email`.
+var (
+	emailAddHost     string
+	emailAddPort     int
+	emailAddSecurity string
+	emailAddUsername string
+	emailAddFrom     string
+	emailAddTo       []string
+	emailAddLabel    string
+)
+
+// runAddEmail saves a new SMTP integration from the add-command flags,
+// prompting for the password when a username is set.
+func runAddEmail() error {
+	recipients := make([]*pb.EmailRecipient, 0, len(emailAddTo))
+	for _, spec := range emailAddTo {
+		r, err := parseEmailRecipient(spec)
+		if err != nil {
+			return err
+		}
+		recipients = append(recipients, r)
+	}
+	in := &pb.EmailIntegration{
+		Label:      emailAddLabel,
+		Host:       strings.TrimSpace(emailAddHost),
+		Port:       int32(emailAddPort),
+		Security:   strings.ToLower(strings.TrimSpace(emailAddSecurity)),
+		Username:   strings.TrimSpace(emailAddUsername),
+		From:       strings.TrimSpace(emailAddFrom),
+		Recipients: recipients,
+	}
+	if in.Host == "" || in.From == "" || len(recipients) == 0 {
+		return fmt.Errorf("email needs --host, --from and at least one --to")
+	}
+	if in.Username != "" {
+		password, err := promptSecret(fmt.Sprintf("SMTP password for %s: ", in.Username))
+		if err != nil {
+			return err
+		}
+		if password == "" {
+			return fmt.Errorf("no password provided for SMTP user %q", in.Username)
+		}
+		in.Password = password
+	}
+
+	if err := EnsureDaemon(); err != nil {
+		return err
+	}
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	cfg, err := pb.NewIntegrationsServiceClient(conn).SaveIntegration(ctx, &pb.SaveIntegrationRequest{
+		Payload: &pb.SaveIntegrationRequest_Email{Email: in},
+	})
+	if err != nil {
+		return fmt.Errorf("save integration: %w", err)
+	}
+	if saved := cfg.GetEmail(); len(saved) > 0 {
+		ep := saved[len(saved)-1]
+		fmt.Printf("✓ email integration %s saved (%d recipient(s))\n", ep.GetId(), len(ep.GetRecipients()))
+		fmt.Printf("Next: run 'watchfire integrations test email %s' to send a test message.\n", ep.GetId())
+	}
+	return nil
+}
+
+// parseEmailRecipient parses one --to value: `address` (default events)
+// or `address=event,event,…` with event keys such as task_failed or
+// weekly_digest.
+func parseEmailRecipient(spec string) (*pb.EmailRecipient, error) {
+	addr, list, hasEvents := strings.Cut(spec, "=")
+	addr = strings.TrimSpace(addr)
+	if _, err := mail.ParseAddress(addr); err != nil {
+		return nil, fmt.Errorf("invalid --to address %q: %w", addr, err)
+	}
+	keys := defaultEmailEvents
+	if hasEvents {
+		keys = nil
+		for _, key := range strings.Split(list, ",") {
+			key = strings.ToLower(strings.TrimSpace(key))
+			if key == "" {
+				continue
+			}
+			if !slices.Contains(models.NotificationEvents, key) {
+				return nil, fmt.Errorf("unknown event %q for %s (want one of: %s)", key, addr, strings.Join(models.NotificationEvents, ", "))
+			}
+			keys = append(keys, key)
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no events listed for %s", addr)
+		}
+	}
+	events := &pb.IntegrationEvents{}
+	for _, key := range keys {
+		switch key {
+		case models.EventTaskFailed:
+			events.TaskFailed = true
+		case models.EventRunComplete:
+			events.RunComplete = true
+		case models.EventWeeklyDigest:
+			events.WeeklyDigest = true
+		case models.EventBudgetThreshold:
+			events.BudgetThreshold = true
+		default:
+			if events.Events == nil {
+				events.Events = map[string]bool{}
+			}
+			events.Events[key] = true
+		}
+	}
+	return &pb.EmailRecipient{Address: addr, EnabledEvents: events}, nil
+}
+
+func init() {
+	f := integrationsAddCmd.Flags()
+	f.StringVar(&emailAddHost, "host", "", "email: SMTP server host")
+	f.IntVar(&emailAddPort, "port", 0, "email: SMTP port (default 587, or 465 with --security tls)")
+	f.StringVar(&emailAddSecurity, "security", models.EmailSecurityStartTLS, "email: starttls, tls or none")
+	f.StringVar(&emailAddUsername, "username", "", "email: SMTP username (prompts for the password)")
+	f.StringVar(&emailAddFrom, "from", "", "email: sender address")
+	f.StringArrayVar(&emailAddTo, "to", nil, "email: recipient, optionally with events (addr=task_failed,weekly_digest); repeatable")
+	f.StringVar(&emailAddLabel, "label", "", "email: display label")
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestParseEmailRecipient(t *testing.T) {
+	r, err := parseEmailRecipient("oncall@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := r.GetEnabledEvents()
+	if r.GetAddress() != "oncall@example.com" || !ev.GetTaskFailed() || !ev.GetRunComplete() ||
+		!ev.GetWeeklyDigest() || ev.GetBudgetThreshold() {
+		t.Errorf("bare address should get the default events: %+v", r)
+	}
+
+	r, err = parseEmailRecipient("Manager <manager@example.com>=weekly_digest, MERGE_FAILED")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = r.GetEnabledEvents()
+	if r.GetAddress() != "Manager <manager@example.com>" || ev.GetTaskFailed() || !ev.GetWeeklyDigest() ||
+		!ev.GetEvents()[models.EventMergeFailed] {
+		t.Errorf("explicit events not applied: %+v", r)
+	}
+
+	for _, bad := range []string{"", "not-an-address", "a@example.com=", "a@example.com=task_exploded"} {
+		if _, err := parseEmailRecipient(bad); err == nil {
+			t.Errorf("parseEmailRecipient(%q): expected error", bad)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// Flags for `watchfire integrations route-test`.
+var (
+	routeTestProject  string
+	routeTestTask     int
+	routeTestAgent    string
+	routeTestTitle    string
+	routeTestReason   string
+	routeTestDuration time.Duration
+)
+
+var integrationsRouteTestCmd = &cobra.Command{
+	Use:   "route-test <event>",
+	Short: "Show where a notification would be routed",
+	Long: `Dry-run a notification through the routing rules in
+~/.watchfire/integrations.yaml and print the endpoints it would reach.
+Nothing is sent.
+
+Rules live under 'routes:'. Each matches on event, project, agent backend,
+task title, failure reason (regular expressions) and task duration, and
+routes to endpoint ids or Telegram chats:
+
+  routes:
+    - name: x-codex-failures
+      match:
+        events: [task_failed]
+        projects: [x]
+        agents: [codex]
+      to:
+        endpoints: [slack-x-alerts]
+
+Rules run in order; the first match wins unless it sets 'continue: true'.
+A notification no rule matches (or one matching a rule with
+'keep_default: true') also goes to every endpoint with the event enabled.
+
+--project and --task resolve a real task like a live notification would;
+the other flags override what was resolved.
+
+Examples:
+  watchfire integrations route-test task_failed --project x --agent codex
+  watchfire integrations route-test task_failed --project x --task 12
+  watchfire integrations route-test run_complete --duration 45m`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := EnsureDaemon(); err != nil {
+			return err
+		}
+		conn, err := ConnectDaemon()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := pb.NewIntegrationsServiceClient(conn).TestRoute(ctx, &pb.TestRouteRequest{
+			Event:           args[0],
+			Project:         routeTestProject,
+			TaskNumber:      int32(routeTestTask),
+			Agent:           routeTestAgent,
+			TaskTitle:       routeTestTitle,
+			FailureReason:   routeTestReason,
+			DurationSeconds: int64(routeTestDuration / time.Second),
+		})
+		if err != nil {
+			return fmt.Errorf("test route: %w", err)
+		}
+		printRoutePlan(args[0], resp)
+		return nil
+	},
+}
+
+func printRoutePlan(event string, resp *pb.TestRouteResponse) {
+	fmt.Printf("Event:    %s\n", event)
+	if resp.GetProjectId() != "" {
+		fmt.Printf("Project:  %s (%s)\n", trimDisplay(resp.GetProjectName()), resp.GetProjectId())
+	}
+	if resp.GetAgent() != "" {
+		fmt.Printf("Agent:    %s\n", resp.GetAgent())
+	}
+	if resp.GetTaskTitle() != "" {
+		fmt.Printf("Title:    %s\n", resp.GetTaskTitle())
+	}
+	if resp.GetFailureReason() != "" {
+		fmt.Printf("Reason:   %s\n", resp.GetFailureReason())
+	}
+	if resp.GetDurationSeconds() > 0 {
+		fmt.Printf("Duration: %s\n", time.Duration(resp.GetDurationSeconds())*time.Second)
+	}
+	fmt.Println()
+
+	switch {
+	case len(resp.GetRules()) == 0:
+		fmt.Println("No rule matched — default routing.")
+	case resp.GetDefaultRouting():
+		fmt.Printf("Matched: %s (plus default routing)\n", strings.Join(resp.GetRules(), ", "))
+	default:
+		fmt.Printf("Matched: %s\n", strings.Join(resp.GetRules(), ", "))
+	}
+	if len(resp.GetTargets()) == 0 {
+		fmt.Println("(not delivered anywhere)")
+	}
+	for _, t := range resp.GetTargets() {
+		line := fmt.Sprintf("  → %s %s", t.GetAdapterKind(), t.GetAdapterId())
+		if chats := t.GetChatIds(); len(chats) > 0 {
+			ids := make([]string, len(chats))
+			for i, c := range chats {
+				ids[i] = fmt.Sprint(c)
+			}
+			line += " chats " + strings.Join(ids, ",")
+		}
+		if t.GetRule() != "" {
+			line += "  [" + t.GetRule() + "]"
+		} else {
+			line += "  [default]"
+		}
+		fmt.Println(line)
+	}
+	for _, id := range resp.GetMuted() {
+		fmt.Printf("  ✗ %s skipped: project muted\n", id)
+	}
+	for _, id := range resp.GetUnknown() {
+		fmt.Printf("  ! %s is not a configured endpoint\n", id)
+	}
+}
+
+func init() {
+	f := integrationsRouteTestCmd.Flags()
+	f.StringVar(&routeTestProject, "project", "", "project id or name")
+	f.IntVar(&routeTestTask, "task", 0, "task number to resolve title, reason, agent and duration from")
+	f.StringVar(&routeTestAgent, "agent", "", "agent backend (e.g. codex)")
+	f.StringVar(&routeTestTitle, "title", "", "task title")
+	f.StringVar(&routeTestReason, "reason", "", "failure reason")
+	f.DurationVar(&routeTestDuration, "duration", 0, "how long the task ran (e.g. 45m)")
+	integrationsCmd.AddCommand(integrationsRouteTestCmd)
+}
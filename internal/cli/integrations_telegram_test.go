@@ -20,6 +20,10 @@ func TestParseIntegrationKindTable(t *testing.T) {
 		{"github", pb.IntegrationKind_GITHUB, false},
 		{"telegram", pb.IntegrationKind_TELEGRAM, false},
 		{"Telegram", pb.IntegrationKind_TELEGRAM, false}, // case-insensitive
+		{"teams", pb.IntegrationKind_TEAMS, false},
+		{"matrix", pb.IntegrationKind_MATRIX, false},
+		{"ntfy", pb.IntegrationKind_NTFY, false},
+		{"email", pb.IntegrationKind_EMAIL, false},
 		{"signal", 0, true},
 		{"", 0, true},
 	}
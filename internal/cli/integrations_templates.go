@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// Flags for `watchfire integrations template`.
+var (
+	templateEndpointID string
+	templateFile       string
+	templateSave       bool
+)
+
+var integrationsTemplateCmd = &cobra.Command{
+	Use:   "template <channel> <event>",
+	Short: "Preview or install a custom message template",
+	Long: `Render a relay message template against a sample notification.
+
+Slack, Discord, Teams, Matrix and ntfy messages are rendered from Go
+text/template files. Overrides live in ~/.watchfire/templates/relay/ as
+<channel>_<event>.json.tmpl (for example slack_task_failed.json.tmpl), or in a
+subdirectory named after an endpoint id to customise just that endpoint. A
+template must render valid JSON; an override that fails at send time falls
+back to the built-in template with a warning in the daemon log.
+
+Without --file this previews the template currently in effect. With --file it
+validates and previews that file; add --save to install it as the override.
+
+Examples:
+  watchfire integrations template slack task_failed
+  watchfire integrations template discord run_complete --file my.json.tmpl --save
+  watchfire integrations template teams task_failed --endpoint ops --file ops.json.tmpl --save`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.PreviewTemplateRequest{
+			Channel:    args[0],
+			Event:      args[1],
+			EndpointId: templateEndpointID,
+			Save:       templateSave,
+		}
+		if templateFile != "" {
+			data, err := os.ReadFile(templateFile)
+			if err != nil {
+				return err
+			}
+			req.Source = string(data)
+		} else if templateSave {
+			return fmt.Errorf("--save needs a template passed with --file")
+		}
+
+		if err := EnsureDaemon(); err != nil {
+			return err
+		}
+		conn, err := ConnectDaemon()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := pb.NewIntegrationsServiceClient(conn).PreviewTemplate(ctx, req)
+		if err != nil {
+			return fmt.Errorf("preview template: %w", err)
+		}
+		if !resp.GetOk() {
+			return fmt.Errorf("invalid template: %s", resp.GetError())
+		}
+		source := resp.GetOrigin()
+		if resp.GetPath() != "" && !resp.GetSaved() {
+			source += " " + resp.GetPath()
+		}
+		fmt.Printf("Template (%s) renders:\n%s\n", source, resp.GetRendered())
+		if resp.GetSaved() {
+			fmt.Printf("✓ Saved to %s\n", resp.GetPath())
+		}
+		return nil
+	},
+}
+
+func init() {
+	f := integrationsTemplateCmd.Flags()
+	f.StringVar(&templateEndpointID, "endpoint", "", "endpoint id to scope the template to (default: every endpoint of the channel)")
+	f.StringVar(&templateFile, "file", "", "template file to validate and preview")
+	f.BoolVar(&templateSave, "save", false, "install --file as the override once it validates")
+	integrationsCmd.AddCommand(integrationsTemplateCmd)
+}
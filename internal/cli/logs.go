@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/watchfire-io/watchfire/internal/asciicast"
+	"github.com/watchfire-io/watchfire/internal/config"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+var (
+	logsReplaySpeed   float64
+	logsReplayMaxIdle time.Duration
+
+	logsSearchAll    bool
+	logsSearchAgent  string
+	logsSearchTask   int
+	logsSearchMode   string
+	logsSearchStatus string
+	logsSearchSince  string
+	logsSearchUntil  string
+	logsSearchLimit  int
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Browse and replay agent session logs",
+}
+
+var logsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List session logs for the current project",
+	Args:  cobra.NoArgs,
+	RunE:  runLogsList,
+}
+
+var logsReplayCmd = &cobra.Command{
+	Use:   "replay <log-id>",
+	Short: "Replay a recorded agent session in the terminal",
+	Long: `Play back a session's asciicast recording with its original timing.
+Sessions are recorded automatically unless recordings are disabled in
+settings.yaml. Use 'watchfire logs list' to find log IDs; the recording
+is also a standard asciicast v2 file that asciinema can play.
+
+--speed scales playback (2 = twice as fast); --max-idle caps any single
+pause so long thinking gaps don't stall the replay.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLogsReplay,
+}
+
+var logsSearchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Full-text search across session transcripts and scrollback",
+	Long: `Search rendered transcripts (or PTY scrollback when a session has no
+transcript) of past sessions. All words must match; wrap a phrase in
+double quotes to require it verbatim. Results are ranked by relevance.
+
+Searches the current project, or every registered project with --all
+(also the default outside a project directory). Dates accept RFC3339 or
+YYYY-MM-DD; --until with a bare date includes that whole day.`,
+	Example: `  watchfire logs search "merge conflict"
+  watchfire logs search timeout --agent codex --since 2026-01-01
+  watchfire logs search panic --all --status interrupted`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runLogsSearch,
+}
+
+func init() {
+	logsSearchCmd.Flags().BoolVar(&logsSearchAll, "all", false, "Search every registered project")
+	logsSearchCmd.Flags().StringVar(&logsSearchAgent, "agent", "", "Only sessions run by this agent backend")
+	logsSearchCmd.Flags().IntVar(&logsSearchTask, "task", 0, "Only sessions for this task number")
+	logsSearchCmd.Flags().StringVar(&logsSearchMode, "mode", "", "Only sessions in this mode (task, chat, wildfire, ...)")
+	logsSearchCmd.Flags().StringVar(&logsSearchStatus, "status", "", "Only sessions with this status (completed, interrupted)")
+	logsSearchCmd.Flags().StringVar(&logsSearchSince, "since", "", "Only sessions started on/after this date")
+	logsSearchCmd.Flags().StringVar(&logsSearchUntil, "until", "", "Only sessions started before/through this date")
+	logsSearchCmd.Flags().IntVarP(&logsSearchLimit, "limit", "n", 20, "Maximum number of results")
+
+	logsReplayCmd.Flags().Float64VarP(&logsReplaySpeed, "speed", "s", 1, "Playback speed multiplier")
+	logsReplayCmd.Flags().DurationVar(&logsReplayMaxIdle, "max-idle", 2*time.Second, "Cap pauses between output at this duration (0 = no cap)")
+
+	logsCmd.AddCommand(logsListCmd)
+	logsCmd.AddCommand(logsReplayCmd)
+	logsCmd.AddCommand(logsSearchCmd)
+	rootCmd.AddCommand(logsCmd)
+}
+
+func runLogsList(_ *cobra.Command, _ []string) error {
+	projectID, err := currentProjectID()
+	if err != nil {
+		return err
+	}
+	if err := EnsureDaemon(); err != nil {
+		return err
+	}
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	list, err := pb.NewLogServiceClient(conn).ListLogs(ctx, &pb.ListLogsRequest{ProjectId: projectID})
+	if err != nil {
+		return fmt.Errorf("failed to list logs: %w", err)
+	}
+	if len(list.Logs) == 0 {
+		fmt.Println(styleHint.Render("No session logs yet."))
+		return nil
+	}
+	for _, l := range list.Logs {
+		rec := ""
+		if l.HasRecording {
+			rec = styleHint.Render("  [recording]")
+		}
+		fmt.Printf("%s  %s  %s%s\n", styleValue.Render(l.LogId), styleLabel.Render(l.Agent), l.Status, rec)
+	}
+	return nil
+}
+
+func runLogsReplay(_ *cobra.Command, args []string) error {
+	if logsReplaySpeed <= 0 {
+		return fmt.Errorf("--speed must be greater than 0")
+	}
+	projectID, err := currentProjectID()
+	if err != nil {
+		return err
+	}
+	if err := EnsureDaemon(); err != nil {
+		return err
+	}
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stream, err := pb.NewLogServiceClient(conn).GetRecording(ctx, &pb.GetRecordingRequest{
+		ProjectId: projectID,
+		LogId:     args[0],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch recording: %w", err)
+	}
+	var data bytes.Buffer
+	for {
+		chunk, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			break
+		}
+		if recvErr != nil {
+			return fmt.Errorf("failed to fetch recording: %w", recvErr)
+		}
+		data.Write(chunk.Data)
+	}
+
+	_, events, err := asciicast.Read(&data)
+	if err != nil {
+		return fmt.Errorf("failed to parse recording: %w", err)
+	}
+
+	err = asciicast.Play(ctx, os.Stdout, events, asciicast.PlayOptions{
+		Speed:   logsReplaySpeed,
+		MaxIdle: logsReplayMaxIdle,
+	})
+	// Leave the terminal in a sane state whatever the agent's last frame
+	// did to colours and the cursor.
+	fmt.Print("\x1b[0m\x1b[?25h\r\n")
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func runLogsSearch(_ *cobra.Command, args []string) error {
+	req := &pb.SearchLogsRequest{
+		Query:      strings.Join(args, " "),
+		Agent:      logsSearchAgent,
+		TaskNumber: int32(logsSearchTask),
+		Mode:       logsSearchMode,
+		Status:     logsSearchStatus,
+		Since:      logsSearchSince,
+		Until:      logsSearchUntil,
+		Limit:      int32(logsSearchLimit),
+	}
+	if !logsSearchAll {
+		if cwd, err := os.Getwd(); err == nil && config.ProjectExists(cwd) {
+			projectID, err := currentProjectID()
+			if err != nil {
+				return err
+			}
+			req.ProjectIds = []string{projectID}
+		}
+	}
+
+	if err := EnsureDaemon(); err != nil {
+		return err
+	}
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	// The first search in a project backfills its index from every
+	// existing log, so allow more than the usual RPC timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	resp, err := pb.NewLogServiceClient(conn).SearchLogs(ctx, req)
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+	if len(resp.Hits) == 0 {
+		fmt.Println(styleHint.Render("No matching sessions."))
+		return nil
+	}
+	for i, h := range resp.Hits {
+		if i > 0 {
+			fmt.Println()
+		}
+		e := h.Entry
+		header := styleValue.Render(e.LogId)
+		if len(req.ProjectIds) != 1 && h.ProjectName != "" {
+			header = styleLabel.Render(h.ProjectName) + "  " + header
+		}
+		fmt.Printf("%s  %s  %s  %s\n", header, e.Agent, e.Mode, e.Status)
+		for _, sn := range h.Snippets {
+			fmt.Printf("  %s\n", styleHint.Render(sn))
+		}
+	}
+	return nil
+}
+
+// currentProjectID resolves the project in the working directory.
+func currentProjectID() (string, error) {
+	projectPath, err := getProjectPath()
+	if err != nil {
+		return "", err
+	}
+	project, err := config.LoadProject(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to load project config: %w", err)
+	}
+	return project.ProjectID, nil
+}
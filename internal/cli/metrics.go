@@ -1,17 +1,32 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/metrics"
 	"github.com/watchfire-io/watchfire/internal/models"
+	pb "github.com/watchfire-io/watchfire/proto"
 )
 
 var metricsBackfillForce bool
 
+var (
+	metricsExportFormat string
+	metricsExportTask   int
+	metricsExportGlobal bool
+	metricsExportWindow string
+	metricsExportOutput string
+)
+
 var metricsCmd = &cobra.Command{
 	Use:   "metrics",
 	Short: "Manage per-task metrics (v6.0 Ember)",
@@ -29,9 +44,31 @@ skips tasks that already have metrics unless --force is passed.`,
 	RunE: runMetricsBackfill,
 }
 
+var metricsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export an insights report as Markdown, CSV, JSON or HTML",
+	Long: `Render an insights report through the daemon and write it to disk.
+
+Scope defaults to the project in the current directory; --task narrows it
+to one task and --global widens it to every registered project. JSON
+follows the versioned 'watchfire.insights.report' schema; HTML is a
+single self-contained page with inline charts.`,
+	Example: `  watchfire metrics export --format html
+  watchfire metrics export --format json --global --window 90d
+  watchfire metrics export --task 12 --format md -o reports/`,
+	Args: cobra.NoArgs,
+	RunE: runMetricsExport,
+}
+
 func init() {
 	metricsBackfillCmd.Flags().BoolVar(&metricsBackfillForce, "force", false, "Overwrite existing metrics files")
 	metricsCmd.AddCommand(metricsBackfillCmd)
+	metricsExportCmd.Flags().StringVarP(&metricsExportFormat, "format", "f", "markdown", "Report format: markdown|md, csv, json, html")
+	metricsExportCmd.Flags().IntVarP(&metricsExportTask, "task", "t", 0, "Export a single task of the current project")
+	metricsExportCmd.Flags().BoolVarP(&metricsExportGlobal, "global", "g", false, "Export the fleet-wide rollup")
+	metricsExportCmd.Flags().StringVarP(&metricsExportWindow, "window", "w", "30d", "Time window: 7d, 30d, 90d or all (ignored for --task)")
+	metricsExportCmd.Flags().StringVarP(&metricsExportOutput, "output", "o", ".", "Directory to write the report into")
+	metricsCmd.AddCommand(metricsExportCmd)
 	rootCmd.AddCommand(metricsCmd)
 }
 
@@ -89,3 +126,93 @@ func backfillProject(projectID, projectPath string, force bool) (written, skippe
 	}
 	return written, skipped, nil
 }
+
+func runMetricsExport(_ *cobra.Command, _ []string) error {
+	format, err := parseExportFormat(metricsExportFormat)
+	if err != nil {
+		return err
+	}
+	if metricsExportGlobal && metricsExportTask > 0 {
+		return fmt.Errorf("--task and --global are mutually exclusive")
+	}
+	req := &pb.ExportReportRequest{Format: format, Meta: &pb.RequestMeta{Origin: "cli"}}
+	if metricsExportGlobal {
+		req.Scope = &pb.ExportReportRequest_Global{Global: true}
+	} else {
+		projectID, err := currentProjectID()
+		if err != nil {
+			return err
+		}
+		if metricsExportTask > 0 {
+			req.Scope = &pb.ExportReportRequest_SingleTask{SingleTask: fmt.Sprintf("%s:%d", projectID, metricsExportTask)}
+		} else {
+			req.Scope = &pb.ExportReportRequest_ProjectId{ProjectId: projectID}
+		}
+	}
+	days, err := parseExportWindow(metricsExportWindow)
+	if err != nil {
+		return err
+	}
+	if days > 0 {
+		now := time.Now()
+		req.WindowStart = timestamppb.New(now.AddDate(0, 0, -days))
+		req.WindowEnd = timestamppb.New(now)
+	}
+
+	if err := EnsureDaemon(); err != nil {
+		return err
+	}
+	conn, err := ConnectDaemon()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := pb.NewInsightsServiceClient(conn).ExportReport(ctx, req)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	if err := os.MkdirAll(metricsExportOutput, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", metricsExportOutput, err)
+	}
+	path := filepath.Join(metricsExportOutput, resp.Filename)
+	if err := os.WriteFile(path, resp.Content, 0o644); err != nil { //nolint:gosec // reports are meant to be shared
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	fmt.Printf("%s %s\n", styleLabel.Render("Exported:"), styleValue.Render(path))
+	return nil
+}
+
+func parseExportFormat(s string) (pb.ExportFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "markdown", "md":
+		return pb.ExportFormat_MARKDOWN, nil
+	case "csv":
+		return pb.ExportFormat_CSV, nil
+	case "json":
+		return pb.ExportFormat_JSON, nil
+	case "html":
+		return pb.ExportFormat_HTML, nil
+	default:
+		return 0, fmt.Errorf("unknown format %q (want markdown, csv, json or html)", s)
+	}
+}
+
+// parseExportWindow maps --window to a day count; 0 means no bound.
+func parseExportWindow(s string) (int, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "7d":
+		return 7, nil
+	case "30d", "":
+		return 30, nil
+	case "90d":
+		return 90, nil
+	case "all":
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unknown window %q (want 7d, 30d, 90d or all)", s)
+	}
+}
@@ -120,6 +120,12 @@ func init() {
 		cmd.Flags().BoolVar(&noSandbox, "no-sandbox", false, "Disable sandboxing for this session")
 	}
 
+	// Chat sessions are never budget-gated, so only the task-running verbs
+	// take --override-budget.
+	for _, cmd := range []*cobra.Command{runCmd, planCmd, generateCmd, wildfireCmd, definitionRetrofitCmd} {
+		cmd.Flags().BoolVar(&overrideBudget, "override-budget", false, "Start even if a hard-stop monthly budget is spent")
+	}
+
 	// Daemon management
 	rootCmd.AddCommand(daemonCmd)
 
@@ -219,6 +219,7 @@ func runTaskAdd(cmd *cobra.Command, args []string) error {
 		Prompt:             prompt,
 		AcceptanceCriteria: criteria,
 		Status:             statusStr,
+		CreatedBy:          config.LocalUser(),
 	})
 	if err != nil {
 		return err
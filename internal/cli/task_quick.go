@@ -107,6 +107,7 @@ func runTaskQuick(cmd *cobra.Command, args []string) error {
 	defer cancel()
 
 	list, err := client.CreateTasksBatch(ctx, &pb.CreateTasksBatchRequest{
+		Meta:      &pb.RequestMeta{Origin: "cli", User: config.LocalUser()},
 		ProjectId: project.ProjectID,
 		Text:      text,
 		Status:    status,
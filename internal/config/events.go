@@ -0,0 +1,97 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// eventsExt is the extension of the normalized session event log, stored
+// next to the matching <logID>.log.
+const eventsExt = ".events.jsonl"
+
+// SessionEventsPath returns the path of the event log for a session log.
+func SessionEventsPath(projectID, logID string) (string, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(logsDir, projectID, logID+eventsExt), nil
+}
+
+// WriteSessionEvents writes events as JSON lines, replacing any existing
+// event log for the session. The file is written to a temp name and
+// renamed so readers never see a partial log.
+func WriteSessionEvents(projectID, logID string, events []models.SessionEvent) error {
+	path, err := SessionEventsPath(projectID, logID)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for i := range events {
+		if err := enc.Encode(&events[i]); err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmp)
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// ReadSessionEvents reads the event log for a session. Lines that fail to
+// decode are skipped so a log written by a newer daemon still loads.
+func ReadSessionEvents(projectID, logID string) ([]models.SessionEvent, error) {
+	path, err := SessionEventsPath(projectID, logID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no event log for log %s", logID)
+		}
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var events []models.SessionEvent
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var e models.SessionEvent
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, scanner.Err()
+}
+
+// HasSessionEvents reports whether an event log exists for the given log.
+func HasSessionEvents(projectID, logID string) bool {
+	path, err := SessionEventsPath(projectID, logID)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// TestSessionEventsRoundTrip — events written for a log read back in
+// order, ListLogs flags the log, and DeleteLog removes the event file.
+func TestSessionEventsRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	const pid = "proj-ev"
+
+	entry, err := WriteLog(pid, 1, 0, "claude-code", "task", "completed", time.Now(), []string{"hi"})
+	if err != nil {
+		t.Fatalf("WriteLog: %v", err)
+	}
+	code := 0
+	in := []models.SessionEvent{
+		{Seq: 1, Type: models.SessionEventShellCommand, Command: "make", ExitCode: &code},
+		{Seq: 2, Type: models.SessionEventFileEdit, Path: "a.go", EditKind: models.FileEditModify},
+	}
+	if err := WriteSessionEvents(pid, entry.LogID, in); err != nil {
+		t.Fatalf("WriteSessionEvents: %v", err)
+	}
+
+	out, err := ReadSessionEvents(pid, entry.LogID)
+	if err != nil {
+		t.Fatalf("ReadSessionEvents: %v", err)
+	}
+	if len(out) != 2 || out[0].Command != "make" || out[0].ExitCode == nil || *out[0].ExitCode != 0 || out[1].Path != "a.go" {
+		t.Fatalf("round trip = %+v", out)
+	}
+
+	logs, err := ListLogs(pid)
+	if err != nil || len(logs) != 1 || !logs[0].HasEvents {
+		t.Fatalf("ListLogs = %+v, %v; want one entry with HasEvents", logs, err)
+	}
+
+	if err := DeleteLog(pid, entry.LogID); err != nil {
+		t.Fatalf("DeleteLog: %v", err)
+	}
+	if HasSessionEvents(pid, entry.LogID) {
+		t.Error("event log survived DeleteLog")
+	}
+}
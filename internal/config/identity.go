@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+	"sync"
+)
+
+// Identities attribute tasks and agent runs to people (Task.CreatedBy /
+// StartedBy). A person working on this machine — CLI, TUI, GUI, tray —
+// is named by LocalUser; anyone reaching the daemon through a remote
+// surface is "<transport>:<id>" (RemoteUser), with the transport's own
+// stable id: the Slack / Discord / Telegram user id, or the MCP client
+// name from its initialize handshake.
+
+var (
+	localUserOnce sync.Once
+	localUser     string
+)
+
+// LocalUser names the person at this machine: git's global user.email,
+// else user.name, else the OS login name. Empty when none resolves.
+// Resolved once per process.
+func LocalUser() string {
+	localUserOnce.Do(func() {
+		localUser = resolveLocalUser()
+	})
+	return localUser
+}
+
+func resolveLocalUser() string {
+	for _, key := range []string{"user.email", "user.name"} {
+		out, err := exec.Command("git", "config", "--global", "--get", key).Output() //nolint:gosec // fixed arguments
+		if err == nil {
+			if v := strings.TrimSpace(string(out)); v != "" {
+				return v
+			}
+		}
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// RemoteUser builds the identity of a remote caller: "slack:U024BE7LH",
+// "telegram:123456789", "mcp:claude-desktop". Empty when id is.
+func RemoteUser(transport, id string) string {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ""
+	}
+	return transport + ":" + id
+}
@@ -129,8 +129,9 @@ func SetSecretStoreForTest(s secretStore) {
 }
 
 // SecretKeyForIntegration returns the canonical keyring key for a given
-// integration ID + field. Slack / Discord store the URL itself; webhook
-// endpoints store the HMAC secret.
+// integration ID + field. Slack / Discord / Teams store the URL itself;
+// webhook endpoints store the HMAC secret, Matrix the access token,
+// ntfy the topic token and email the SMTP password.
 func SecretKeyForIntegration(integrationID, field string) string {
 	return fmt.Sprintf("watchfire.integration.%s.%s", integrationID, field)
 }
@@ -147,6 +148,7 @@ func LoadIntegrations() (*models.IntegrationsConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	cfg.Inbound.FoldAgentRunners()
 
 	store, storeErr := resolveSecretStore()
 	if storeErr != nil {
@@ -177,6 +179,38 @@ func LoadIntegrations() (*models.IntegrationsConfig, error) {
 			cfg.Telegram.BotToken = v
 		}
 	}
+	for i := range cfg.Teams {
+		if cfg.Teams[i].URLRef == "" {
+			continue
+		}
+		if v, ok := store.Get(cfg.Teams[i].URLRef); ok {
+			cfg.Teams[i].URL = v
+		}
+	}
+	for i := range cfg.Matrix {
+		if cfg.Matrix[i].AccessTokenRef == "" {
+			continue
+		}
+		if v, ok := store.Get(cfg.Matrix[i].AccessTokenRef); ok {
+			cfg.Matrix[i].AccessToken = v
+		}
+	}
+	for i := range cfg.Ntfy {
+		if cfg.Ntfy[i].TokenRef == "" {
+			continue
+		}
+		if v, ok := store.Get(cfg.Ntfy[i].TokenRef); ok {
+			cfg.Ntfy[i].Token = v
+		}
+	}
+	for i := range cfg.Email {
+		if cfg.Email[i].PasswordRef == "" {
+			continue
+		}
+		if v, ok := store.Get(cfg.Email[i].PasswordRef); ok {
+			cfg.Email[i].Password = v
+		}
+	}
 	return cfg, nil
 }
 
@@ -229,6 +263,54 @@ func SaveIntegrations(cfg *models.IntegrationsConfig) error {
 				return fmt.Errorf("failed to store Telegram bot token: %w", setErr)
 			}
 		}
+		for i := range cfg.Teams {
+			ep := &cfg.Teams[i]
+			if ep.URL == "" {
+				continue
+			}
+			if ep.URLRef == "" {
+				ep.URLRef = SecretKeyForIntegration(ep.ID, "url")
+			}
+			if setErr := store.Set(ep.URLRef, ep.URL); setErr != nil {
+				return fmt.Errorf("failed to store Teams URL: %w", setErr)
+			}
+		}
+		for i := range cfg.Matrix {
+			ep := &cfg.Matrix[i]
+			if ep.AccessToken == "" {
+				continue
+			}
+			if ep.AccessTokenRef == "" {
+				ep.AccessTokenRef = SecretKeyForIntegration(ep.ID, "access_token")
+			}
+			if setErr := store.Set(ep.AccessTokenRef, ep.AccessToken); setErr != nil {
+				return fmt.Errorf("failed to store Matrix access token: %w", setErr)
+			}
+		}
+		for i := range cfg.Ntfy {
+			ep := &cfg.Ntfy[i]
+			if ep.Token == "" {
+				continue
+			}
+			if ep.TokenRef == "" {
+				ep.TokenRef = SecretKeyForIntegration(ep.ID, "token")
+			}
+			if setErr := store.Set(ep.TokenRef, ep.Token); setErr != nil {
+				return fmt.Errorf("failed to store ntfy token: %w", setErr)
+			}
+		}
+		for i := range cfg.Email {
+			ep := &cfg.Email[i]
+			if ep.Password == "" {
+				continue
+			}
+			if ep.PasswordRef == "" {
+				ep.PasswordRef = SecretKeyForIntegration(ep.ID, "password")
+			}
+			if setErr := store.Set(ep.PasswordRef, ep.Password); setErr != nil {
+				return fmt.Errorf("failed to store SMTP password: %w", setErr)
+			}
+		}
 	}
 
 	// Detach the runtime URL field before serialising — the YAML must
@@ -251,6 +333,26 @@ func SaveIntegrations(cfg *models.IntegrationsConfig) error {
 		tg.BotToken = ""
 		scrubbed.Telegram = &tg
 	}
+	scrubbed.Teams = make([]models.TeamsEndpoint, len(cfg.Teams))
+	for i, ep := range cfg.Teams {
+		ep.URL = ""
+		scrubbed.Teams[i] = ep
+	}
+	scrubbed.Matrix = make([]models.MatrixEndpoint, len(cfg.Matrix))
+	for i, ep := range cfg.Matrix {
+		ep.AccessToken = ""
+		scrubbed.Matrix[i] = ep
+	}
+	scrubbed.Ntfy = make([]models.NtfyEndpoint, len(cfg.Ntfy))
+	for i, ep := range cfg.Ntfy {
+		ep.Token = ""
+		scrubbed.Ntfy[i] = ep
+	}
+	scrubbed.Email = make([]models.EmailEndpoint, len(cfg.Email))
+	for i, ep := range cfg.Email {
+		ep.Password = ""
+		scrubbed.Email[i] = ep
+	}
 	return SaveYAML(path, &scrubbed)
 }
 
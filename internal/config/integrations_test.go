@@ -363,6 +363,41 @@ func TestIntegrationsRoundTripTelegramTokenViaKeyring(t *testing.T) {
 	}
 }
 
+// An integrations.yaml still carrying the pre-roles agent_runners key
+// loads with those users as admins, and saving drops the old key.
+func TestIntegrationsLoadFoldsAgentRunners(t *testing.T) {
+	withTempHome(t)
+	withFakeKeyring(t)
+
+	path, err := GlobalIntegrationsFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("inbound:\n  agent_runners:\n    T024BE7LH: [U024BE7LH]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadIntegrations()
+	if err != nil {
+		t.Fatalf("LoadIntegrations: %v", err)
+	}
+	if got := cfg.SlackRole("T024BE7LH", "U024BE7LH"); got != models.ChatRoleAdmin {
+		t.Fatalf("legacy agent runner role = %q, want admin", got)
+	}
+	if err := SaveIntegrations(cfg); err != nil {
+		t.Fatalf("SaveIntegrations: %v", err)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contains(string(raw), "agent_runners") || !contains(string(raw), "slack_roles") {
+		t.Fatalf("saved integrations.yaml:\n%s", raw)
+	}
+}
+
 func TestIntegrationsNoTelegramKeyStaysNil(t *testing.T) {
 	withTempHome(t)
 	withFakeKeyring(t)
@@ -110,6 +110,14 @@ func ListLogs(projectID string) ([]*models.LogEntry, error) {
 		if _, statErr := os.Stat(filepath.Join(projectLogsDir, jsonlName)); statErr == nil {
 			entry.HasTranscript = true
 		}
+		castName := strings.TrimSuffix(e.Name(), ".log") + recordingExt
+		if _, statErr := os.Stat(filepath.Join(projectLogsDir, castName)); statErr == nil {
+			entry.HasRecording = true
+		}
+		eventsName := strings.TrimSuffix(e.Name(), ".log") + eventsExt
+		if _, statErr := os.Stat(filepath.Join(projectLogsDir, eventsName)); statErr == nil {
+			entry.HasEvents = true
+		}
 
 		logs = append(logs, entry)
 	}
@@ -143,6 +151,8 @@ func ReadLog(projectID, logID string) (*models.LogEntry, string, error) {
 	if entry == nil {
 		return nil, "", fmt.Errorf("invalid log format")
 	}
+	entry.HasRecording = HasRecording(projectID, logID)
+	entry.HasEvents = HasSessionEvents(projectID, logID)
 
 	// If JSONL transcript exists, format and return it. Format depends on
 	// which agent produced the transcript, so dispatch via the backend
@@ -249,8 +259,9 @@ func parseLogHeaderLine(entry *models.LogEntry, line string) {
 }
 
 // DeleteLog removes a session log's .log file and its optional .jsonl
-// transcript sibling. Returns an error if the .log file is missing or any
-// other filesystem error occurs. Missing .jsonl files are tolerated.
+// transcript, .events.jsonl event log and .cast recording siblings. Returns an error if the .log
+// file is missing or any other filesystem error occurs. Missing siblings
+// are tolerated.
 func DeleteLog(projectID, logID string) error {
 	logsDir, err := GlobalLogsDir()
 	if err != nil {
@@ -269,6 +280,16 @@ func DeleteLog(projectID, logID string) error {
 		return fmt.Errorf("failed to delete transcript file: %w", err)
 	}
 
+	castPath := filepath.Join(projectLogsDir, logID+recordingExt)
+	if err := os.Remove(castPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete recording file: %w", err)
+	}
+
+	eventsPath := filepath.Join(projectLogsDir, logID+eventsExt)
+	if err := os.Remove(eventsPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete event log: %w", err)
+	}
+
 	return nil
 }
 
@@ -29,6 +29,18 @@ const (
 	// DigestsDirName is the name of the directory where weekly digests are
 	// persisted (one Markdown file per fire date).
 	DigestsDirName = "digests"
+
+	// SearchIndexDirName is the name of the directory holding the session
+	// log full-text index (one file per project).
+	SearchIndexDirName = "search-index"
+
+	// RelayOutboxDirName is the name of the directory holding the relay
+	// dispatcher's durable outbox (pending/ + dead/ delivery records).
+	RelayOutboxDirName = "relay-outbox"
+
+	// RelayTemplatesDirName is the path, relative to the global directory,
+	// of the user's relay message-template overrides.
+	RelayTemplatesDirName = "templates/relay"
 )
 
 // File names
@@ -37,6 +49,7 @@ const (
 	AgentsFileName              = "agents.yaml"
 	ProjectsFileName            = "projects.yaml"
 	SettingsFileName            = "settings.yaml"
+	TracesFileName              = "traces.jsonl"
 	ProjectFileName             = "project.yaml"
 	SecretsInstructionsFileName = "instructions.md"
 )
@@ -158,6 +171,17 @@ func EnsureGlobalLogsDir() error {
 	return os.MkdirAll(dir, 0o755)
 }
 
+// GlobalTracesFile returns the default trace export file
+// (~/.watchfire/traces.jsonl), written when tracing uses the file
+// exporter.
+func GlobalTracesFile() (string, error) {
+	dir, err := GlobalDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, TracesFileName), nil
+}
+
 // GlobalDigestsDir returns the path to the weekly-digests directory
 // (~/.watchfire/digests/). Each digest is persisted there as
 // <YYYY-MM-DD>.md by `internal/daemon/server/digest.go`.
@@ -178,6 +202,37 @@ func EnsureGlobalDigestsDir() error {
 	return os.MkdirAll(dir, 0o755)
 }
 
+// GlobalSearchIndexDir returns the path to the log search index directory
+// (~/.watchfire/search-index/), maintained by `internal/logsearch`.
+func GlobalSearchIndexDir() (string, error) {
+	dir, err := GlobalDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, SearchIndexDirName), nil
+}
+
+// GlobalRelayOutboxDir returns the path to the relay outbox directory
+// (~/.watchfire/relay-outbox/), maintained by `internal/daemon/relay`.
+func GlobalRelayOutboxDir() (string, error) {
+	dir, err := GlobalDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, RelayOutboxDirName), nil
+}
+
+// GlobalRelayTemplatesDir returns the path to the user's relay
+// message-template overrides (~/.watchfire/templates/relay/), read by
+// `internal/daemon/relay` in place of the embedded templates.
+func GlobalRelayTemplatesDir() (string, error) {
+	dir, err := GlobalDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, filepath.FromSlash(RelayTemplatesDirName)), nil
+}
+
 // EnsureProjectDir creates the project's .watchfire/ directory structure.
 func EnsureProjectDir(projectPath string) error {
 	// Create main .watchfire directory
@@ -0,0 +1,164 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// recordingExt is the extension of asciicast session recordings, stored
+// next to the matching <logID>.log.
+const recordingExt = ".cast"
+
+// pendingRecordingPrefix marks a recording whose session is still
+// running. The log ID isn't known until the session log is written, so
+// the file is renamed into place by SaveRecording afterwards.
+const pendingRecordingPrefix = ".rec-"
+
+// RecordingPath returns the path of the recording for a session log.
+func RecordingPath(projectID, logID string) (string, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(logsDir, projectID, logID+recordingExt), nil
+}
+
+// NewPendingRecordingPath returns a fresh path for an in-progress
+// recording, creating the project logs dir if needed.
+func NewPendingRecordingPath(projectID string) (string, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(logsDir, projectID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create project logs dir: %w", err)
+	}
+	return filepath.Join(dir, pendingRecordingPrefix+uuid.NewString()+recordingExt), nil
+}
+
+// SaveRecording moves a finished pending recording into place as the
+// recording for logID.
+func SaveRecording(projectID, logID, pendingPath string) error {
+	dst, err := RecordingPath(projectID, logID)
+	if err != nil {
+		return err
+	}
+	return os.Rename(pendingPath, dst)
+}
+
+// HasRecording reports whether a recording exists for the given log.
+func HasRecording(projectID, logID string) bool {
+	path, err := RecordingPath(projectID, logID)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// OpenRecording opens the recording for a session log.
+func OpenRecording(projectID, logID string) (*os.File, error) {
+	path, err := RecordingPath(projectID, logID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recording for log %s", logID)
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// ExpiredFile is a file selected for removal by a retention policy.
+type ExpiredFile struct {
+	Path   string
+	Bytes  int64
+	Reason string
+}
+
+// PruneRecordings applies the retention policy to a project's
+// recordings: anything older than MaxAgeDays goes, then the oldest are
+// removed until at most MaxPerProject remain. Pending recordings left
+// behind by a daemon crash are swept once they pass the age limit too.
+// Returns the number of files removed. The .log and .jsonl files are left
+// alone — a log outlives its recording.
+func PruneRecordings(projectID string, cfg models.RecordingsConfig, now time.Time) (int, error) {
+	expired, err := ExpiredRecordings(projectID, cfg, now)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, f := range expired {
+		if rmErr := os.Remove(f.Path); rmErr == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// ExpiredRecordings returns the recordings PruneRecordings would remove,
+// without removing them.
+func ExpiredRecordings(projectID string, cfg models.RecordingsConfig, now time.Time) ([]ExpiredFile, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(logsDir, projectID)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	type rec struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var recs []rec
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), recordingExt) {
+			continue
+		}
+		info, infoErr := e.Info()
+		if infoErr != nil {
+			continue
+		}
+		recs = append(recs, rec{path: filepath.Join(dir, e.Name()), size: info.Size(), modTime: info.ModTime()})
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].modTime.After(recs[j].modTime) })
+
+	var out []ExpiredFile
+	kept := 0
+	for _, r := range recs {
+		pending := strings.HasPrefix(filepath.Base(r.path), pendingRecordingPrefix)
+		expired := cfg.MaxAgeDays > 0 && now.Sub(r.modTime) > time.Duration(cfg.MaxAgeDays)*24*time.Hour
+		overCap := !pending && cfg.MaxPerProject > 0 && kept >= cfg.MaxPerProject
+		switch {
+		case expired:
+			out = append(out, ExpiredFile{Path: r.path, Bytes: r.size, Reason: fmt.Sprintf("recording older than %dd", cfg.MaxAgeDays)})
+			continue
+		case overCap:
+			out = append(out, ExpiredFile{Path: r.path, Bytes: r.size, Reason: fmt.Sprintf("beyond newest %d recordings", cfg.MaxPerProject)})
+			continue
+		}
+		if !pending {
+			kept++
+		}
+	}
+	return out, nil
+}
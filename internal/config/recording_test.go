@@ -0,0 +1,88 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// TestPruneRecordings covers both retention knobs and that fresh pending
+// recordings (a session still running) are never counted or removed.
+func TestPruneRecordings(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	const pid = "proj-1"
+
+	pending, err := NewPendingRecordingPath(pid)
+	if err != nil {
+		t.Fatalf("NewPendingRecordingPath: %v", err)
+	}
+	dir := filepath.Dir(pending)
+	now := time.Now()
+	touch := func(name string, age time.Duration) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte("{}\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(p, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	touch(filepath.Base(pending), time.Minute)
+	newest := touch("0002-0-b.cast", time.Hour)
+	middle := touch("0001-0-a.cast", 2*time.Hour)
+	old := touch("chat-0-x.cast", 40*24*time.Hour)
+	log := touch("0001-0-a.log", 50*24*time.Hour)
+
+	n, err := PruneRecordings(pid, models.RecordingsConfig{Enabled: true, MaxAgeDays: 30, MaxPerProject: 1}, now)
+	if err != nil {
+		t.Fatalf("PruneRecordings: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("removed %d, want 2", n)
+	}
+	for _, p := range []string{pending, newest, log} {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("%s should survive: %v", filepath.Base(p), err)
+		}
+	}
+	for _, p := range []string{middle, old} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("%s should be pruned", filepath.Base(p))
+		}
+	}
+}
+
+// TestDeleteLogRemovesRecording — deleting a log takes its recording with
+// it, and ListLogs reports HasRecording while it exists.
+func TestDeleteLogRemovesRecording(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	const pid = "proj-2"
+
+	entry, err := WriteLog(pid, 3, 0, "claude-code", "task", "completed", time.Now(), []string{"hi"})
+	if err != nil {
+		t.Fatalf("WriteLog: %v", err)
+	}
+	pending, _ := NewPendingRecordingPath(pid)
+	if err := os.WriteFile(pending, []byte("{}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveRecording(pid, entry.LogID, pending); err != nil {
+		t.Fatalf("SaveRecording: %v", err)
+	}
+
+	logs, err := ListLogs(pid)
+	if err != nil || len(logs) != 1 || !logs[0].HasRecording {
+		t.Fatalf("ListLogs = %+v, %v; want one entry with HasRecording", logs, err)
+	}
+
+	if err := DeleteLog(pid, entry.LogID); err != nil {
+		t.Fatalf("DeleteLog: %v", err)
+	}
+	if HasRecording(pid, entry.LogID) {
+		t.Error("recording survived DeleteLog")
+	}
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// LogSession is one session log on disk together with its optional
+// transcript, event log and recording siblings.
+type LogSession struct {
+	LogID   string
+	Paths   []string // the .log first, then whichever siblings exist
+	Bytes   int64    // combined size of Paths
+	ModTime time.Time
+}
+
+// ExpiredSession is a session log selected for removal by a retention
+// policy, with a human-readable reason.
+type ExpiredSession struct {
+	LogSession
+	Reason string
+}
+
+// logSiblingExts are the files that live and die with a <logID>.log.
+// The session metrics record is not one of them: it carries spend that
+// budgets and insights keep counting after the log is gone.
+var logSiblingExts = []string{".jsonl", eventsExt, recordingExt}
+
+// LogProjectIDs returns the project IDs that have a logs directory,
+// including projects that are no longer registered.
+func LogProjectIDs() ([]string, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(logsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var ids []string
+	for _, e := range entries {
+		if e.IsDir() {
+			ids = append(ids, e.Name())
+		}
+	}
+	return ids, nil
+}
+
+// ListLogSessions returns a project's session logs, newest first.
+func ListLogSessions(projectID string) ([]LogSession, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(logsDir, projectID)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sessions []LogSession
+	for _, e := range entries {
+		if e.IsDir() || !isSessionLogFile(dir, e.Name()) {
+			continue
+		}
+		info, infoErr := e.Info()
+		if infoErr != nil {
+			continue
+		}
+		logID := strings.TrimSuffix(e.Name(), ".log")
+		s := LogSession{
+			LogID:   logID,
+			Paths:   []string{filepath.Join(dir, e.Name())},
+			Bytes:   info.Size(),
+			ModTime: info.ModTime(),
+		}
+		for _, ext := range logSiblingExts {
+			path := filepath.Join(dir, logID+ext)
+			if st, statErr := os.Stat(path); statErr == nil {
+				s.Paths = append(s.Paths, path)
+				s.Bytes += st.Size()
+			}
+		}
+		sessions = append(sessions, s)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].ModTime.After(sessions[j].ModTime) })
+	return sessions, nil
+}
+
+// isSessionLogFile reports whether name in a project logs dir is a
+// session log: a .log file opening with the WriteLog header. The
+// per-project daemon.log (and its rotated daemon.log.1) shares the
+// directory and is held open by ProjectLogf, so it must never be listed
+// — and any other stray .log is not ours to expire either.
+func isSessionLogFile(dir, name string) bool {
+	if !strings.HasSuffix(name, ".log") || name == "daemon.log" {
+		return false
+	}
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+	head := make([]byte, 4)
+	n, _ := io.ReadFull(f, head)
+	return string(head[:n]) == "---\n"
+}
+
+// ExpiredSessions applies a retention policy to sessions (newest first,
+// as ListLogSessions returns them). A session goes when it is older than
+// MaxAgeDays, falls outside the newest MaxLogs, or would push the running
+// total of the sessions kept so far past MaxSizeMB.
+func ExpiredSessions(sessions []LogSession, cfg models.RetentionConfig, now time.Time) []ExpiredSession {
+	maxBytes := int64(cfg.MaxSizeMB) << 20
+	var out []ExpiredSession
+	var kept int
+	var keptBytes int64
+	for _, s := range sessions {
+		var reason string
+		switch {
+		case cfg.MaxAgeDays > 0 && now.Sub(s.ModTime) > time.Duration(cfg.MaxAgeDays)*24*time.Hour:
+			reason = fmt.Sprintf("older than %dd", cfg.MaxAgeDays)
+		case cfg.MaxLogs > 0 && kept >= cfg.MaxLogs:
+			reason = fmt.Sprintf("beyond newest %d logs", cfg.MaxLogs)
+		case maxBytes > 0 && keptBytes+s.Bytes > maxBytes:
+			reason = fmt.Sprintf("over %d MB per project", cfg.MaxSizeMB)
+		}
+		if reason != "" {
+			out = append(out, ExpiredSession{LogSession: s, Reason: reason})
+			continue
+		}
+		kept++
+		keptBytes += s.Bytes
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// ExpiredSessions applies age first, then the count cap, then the size
+// cap against the running total of the sessions it keeps — so one large
+// session can go while smaller, older ones stay.
+func TestExpiredSessions(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	mb := int64(1 << 20)
+	sessions := []LogSession{ // newest first
+		{LogID: "a", Bytes: mb, ModTime: now.Add(-1 * day)},
+		{LogID: "b", Bytes: 3 * mb, ModTime: now.Add(-2 * day)},
+		{LogID: "c", Bytes: mb, ModTime: now.Add(-3 * day)},
+		{LogID: "d", Bytes: mb, ModTime: now.Add(-4 * day)},
+		{LogID: "e", Bytes: mb, ModTime: now.Add(-40 * day)},
+	}
+
+	cases := []struct {
+		name string
+		cfg  models.RetentionConfig
+		want []string
+	}{
+		{"unlimited", models.RetentionConfig{}, nil},
+		{"age", models.RetentionConfig{MaxAgeDays: 30}, []string{"e"}},
+		{"count", models.RetentionConfig{MaxLogs: 2}, []string{"c", "d", "e"}},
+		{"size", models.RetentionConfig{MaxSizeMB: 3}, []string{"b", "e"}},
+	}
+	for _, tc := range cases {
+		var got []string
+		for _, s := range ExpiredSessions(sessions, tc.cfg, now) {
+			got = append(got, s.LogID)
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: expired = %v, want %v", tc.name, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%s: expired = %v, want %v", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+// ListLogSessions lists only session logs: the per-project daemon.log
+// (held open by ProjectLogf), its rotated backup and stray .log files
+// without a session header are never sessions to expire.
+func TestListLogSessionsSkipsDaemonLog(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	entry, err := WriteLog("p-1", 3, 1, "claude", "task", "completed", time.Now(), []string{"hello"})
+	if err != nil {
+		t.Fatalf("WriteLog: %v", err)
+	}
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(logsDir, "p-1")
+	for name, body := range map[string]string{
+		"daemon.log":   "2026-06-01 [agent] started\n",
+		"daemon.log.1": "2026-05-01 [agent] started\n",
+		"stray.log":    "not a session\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sessions, err := ListLogSessions("p-1")
+	if err != nil {
+		t.Fatalf("ListLogSessions: %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].LogID != entry.LogID {
+		t.Fatalf("sessions = %+v, want only %s", sessions, entry.LogID)
+	}
+}
+
+// A session's metrics record carries spend, so it is never listed as a
+// sibling that expires with the log.
+func TestListLogSessionsKeepsSessionMetrics(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	entry, err := WriteLog("p-1", 0, 1, "claude", "chat", "completed", time.Now(), []string{"hello"})
+	if err != nil {
+		t.Fatalf("WriteLog: %v", err)
+	}
+	if err := WriteSessionMetrics(&models.SessionMetrics{ProjectID: "p-1", LogID: entry.LogID, Agent: "claude"}); err != nil {
+		t.Fatalf("WriteSessionMetrics: %v", err)
+	}
+
+	sessions, err := ListLogSessions("p-1")
+	if err != nil {
+		t.Fatalf("ListLogSessions: %v", err)
+	}
+	if len(sessions) != 1 || len(sessions[0].Paths) != 1 {
+		t.Fatalf("sessions = %+v, want the log alone", sessions)
+	}
+	if err := DeleteLog("p-1", entry.LogID); err != nil {
+		t.Fatalf("DeleteLog: %v", err)
+	}
+	if records, _ := ListSessionMetrics("p-1"); len(records) != 1 {
+		t.Fatalf("metrics records after DeleteLog = %d, want 1", len(records))
+	}
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// sessionMetricsExt is the extension of a non-task session's metrics
+// record, stored next to the matching <logID>.log. It outlives the log:
+// neither retention nor DeleteLog removes it, so budgets still count
+// the spend.
+const sessionMetricsExt = ".metrics.yaml"
+
+// SessionMetricsPath returns the path of the metrics record for a
+// session log.
+func SessionMetricsPath(projectID, logID string) (string, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(logsDir, projectID, logID+sessionMetricsExt), nil
+}
+
+// WriteSessionMetrics persists a session metrics record, replacing any
+// existing one for the same log ID.
+func WriteSessionMetrics(m *models.SessionMetrics) error {
+	if m == nil {
+		return fmt.Errorf("WriteSessionMetrics: nil metrics")
+	}
+	if m.ProjectID == "" || m.LogID == "" {
+		return fmt.Errorf("WriteSessionMetrics: missing project or log ID")
+	}
+	path, err := SessionMetricsPath(m.ProjectID, m.LogID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return SaveYAML(path, m)
+}
+
+// ReadSessionMetrics loads a session metrics record. Returns (nil, nil)
+// when the session has none.
+func ReadSessionMetrics(projectID, logID string) (*models.SessionMetrics, error) {
+	path, err := SessionMetricsPath(projectID, logID)
+	if err != nil {
+		return nil, err
+	}
+	if !FileExists(path) {
+		return nil, nil
+	}
+	var m models.SessionMetrics
+	if err := LoadYAML(path, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ListSessionMetrics returns every session metrics record of a project,
+// in no particular order. Unreadable records are skipped.
+func ListSessionMetrics(projectID string) ([]*models.SessionMetrics, error) {
+	logsDir, err := GlobalLogsDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(logsDir, projectID)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []*models.SessionMetrics
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), sessionMetricsExt) {
+			continue
+		}
+		var m models.SessionMetrics
+		if err := LoadYAML(filepath.Join(dir, e.Name()), &m); err != nil {
+			continue
+		}
+		out = append(out, &m)
+	}
+	return out, nil
+}
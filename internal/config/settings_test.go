@@ -80,3 +80,73 @@ func TestSettingsRoundTripEmptyFile(t *testing.T) {
 			got.Defaults.Notifications, want)
 	}
 }
+
+// TestSettingsRecordingsDefaultsAndOptOut — a settings.yaml without a
+// recordings block gets the defaults, while an explicit opt-out with no
+// retention limits (all zero values) survives a round-trip.
+func TestSettingsRecordingsDefaultsAndOptOut(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	path := filepath.Join(dir, ".watchfire", "settings.yaml")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("version: 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	if *got.Recordings != models.DefaultRecordings() {
+		t.Errorf("Recordings = %+v, want defaults", *got.Recordings)
+	}
+
+	got.Recordings = &models.RecordingsConfig{}
+	if err := SaveSettings(got); err != nil {
+		t.Fatalf("SaveSettings: %v", err)
+	}
+	got, err = LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	if *got.Recordings != (models.RecordingsConfig{}) {
+		t.Errorf("opt-out did not round-trip: %+v", *got.Recordings)
+	}
+}
+
+// TestSettingsRetentionDefaultsAndOptOut — same contract as recordings:
+// a file without a retention block gets the defaults, an explicit
+// all-zero block (janitor off, no limits) survives a round trip.
+func TestSettingsRetentionDefaultsAndOptOut(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	path := filepath.Join(dir, ".watchfire", "settings.yaml")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("version: 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	if *got.Retention != models.DefaultRetention() {
+		t.Errorf("Retention = %+v, want defaults", *got.Retention)
+	}
+
+	got.Retention = &models.RetentionConfig{MaxLogs: -5}
+	if err := SaveSettings(got); err != nil {
+		t.Fatalf("SaveSettings: %v", err)
+	}
+	got, err = LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	if *got.Retention != (models.RetentionConfig{}) {
+		t.Errorf("opt-out did not round-trip (negative limits clamp to 0): %+v", *got.Retention)
+	}
+}
@@ -76,6 +76,18 @@ type SessionHomeProvider interface {
 	SessionHome(sessionName string) (string, error)
 }
 
+// EventExtractor is implemented by backends that can map their transcript
+// into the normalized session event schema (models.SessionEvent): user
+// and assistant messages, tool calls with arguments and results, file
+// edits, shell commands with exit codes, and token usage. FormatTranscript
+// flattens all of that into prose; the event log keeps the structure.
+// The daemon persists the result as <logID>.events.jsonl next to the
+// session log. Like FormatTranscript, extraction is best-effort: lines
+// that don't fit the expected shape are skipped.
+type EventExtractor interface {
+	ExtractEvents(jsonlPath string) ([]models.SessionEvent, error)
+}
+
 // sessionHomeRoot returns ~/.watchfire/<dirName> (e.g. "codex-home").
 func sessionHomeRoot(dirName string) (string, error) {
 	homeDir, err := os.UserHomeDir()
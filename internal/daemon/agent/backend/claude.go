@@ -225,6 +225,10 @@ type claudeContentBlock struct {
 	Name  string `json:"name"`
 	Input any    `json:"input"`
 	ID    string `json:"id"`
+	// tool_result blocks
+	ToolUseID string          `json:"tool_use_id"`
+	Content   json.RawMessage `json:"content"`
+	IsError   bool            `json:"is_error"`
 }
 
 // FormatTranscript reads a Claude Code JSONL transcript and renders it as
@@ -314,6 +318,99 @@ func formatClaudeMessage(sb *strings.Builder, entry claudeTranscriptEntry) {
 	sb.WriteString("\n\n")
 }
 
+// claudeEventEntry is the subset of a transcript line ExtractEvents reads
+// beyond what FormatTranscript needs: timestamps and per-message usage.
+type claudeEventEntry struct {
+	Type      string `json:"type"`
+	Timestamp string `json:"timestamp"`
+	Message   struct {
+		ID      string          `json:"id"`
+		Role    string          `json:"role"`
+		Model   string          `json:"model"`
+		Content json.RawMessage `json:"content"`
+		Usage   *struct {
+			InputTokens              int64 `json:"input_tokens"`
+			OutputTokens             int64 `json:"output_tokens"`
+			CacheReadInputTokens     int64 `json:"cache_read_input_tokens"`
+			CacheCreationInputTokens int64 `json:"cache_creation_input_tokens"`
+		} `json:"usage"`
+	} `json:"message"`
+}
+
+// ExtractEvents maps a Claude Code transcript into normalized session
+// events. Claude splits one API response across several lines that all
+// repeat the same usage block, so usage is counted once per message ID.
+func (c *Claude) ExtractEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	f, err := os.Open(jsonlPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	b := newEventBuilder()
+	seenUsage := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var entry claudeEventEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if entry.Type != "user" && entry.Type != "assistant" {
+			continue
+		}
+		at := parseEventTime(entry.Timestamp)
+		msg := entry.Message
+
+		var text string
+		if err := json.Unmarshal(msg.Content, &text); err == nil {
+			b.message(msg.Role, text, at)
+		} else {
+			var blocks []claudeContentBlock
+			_ = json.Unmarshal(msg.Content, &blocks)
+			var texts []string
+			for _, blk := range blocks {
+				switch blk.Type {
+				case "text":
+					texts = append(texts, blk.Text)
+				case "tool_use":
+					args, _ := json.Marshal(blk.Input)
+					b.toolCall(blk.ID, blk.Name, args, at)
+				case "tool_result":
+					b.toolResult(blk.ToolUseID, claudeToolResultText(blk.Content), blk.IsError, nil)
+				}
+			}
+			b.message(msg.Role, strings.Join(texts, "\n\n"), at)
+		}
+
+		if u := msg.Usage; u != nil && msg.ID != "" && !seenUsage[msg.ID] {
+			seenUsage[msg.ID] = true
+			b.usage(msg.Model, u.InputTokens+u.CacheCreationInputTokens, u.OutputTokens, u.CacheReadInputTokens, at)
+		}
+	}
+	return b.finish(), scanner.Err()
+}
+
+// claudeToolResultText flattens a tool_result content field, which is
+// either a string or a list of text blocks.
+func claudeToolResultText(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var blocks []claudeContentBlock
+	if json.Unmarshal(raw, &blocks) != nil {
+		return ""
+	}
+	var parts []string
+	for _, blk := range blocks {
+		if blk.Type == "text" {
+			parts = append(parts, blk.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
 func claudeRoleLabel(role string) string {
 	switch role {
 	case "user":
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -308,3 +309,52 @@ func TestLocateTranscriptDottedWorkDir(t *testing.T) {
 		t.Fatalf("LocateTranscript = %q, want %q", got, p)
 	}
 }
+
+// ExtractEvents must pair tool_result blocks with their tool_use by ID,
+// derive shell/file events from the built-in tool names, and count usage
+// once per API message even though Claude repeats it on every line of a
+// split response.
+func TestClaudeExtractEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	lines := []string{
+		`{"type":"user","timestamp":"2026-01-02T10:00:00Z","message":{"role":"user","content":"fix the build"}}`,
+		`{"type":"assistant","timestamp":"2026-01-02T10:00:01Z","message":{"id":"m1","role":"assistant","model":"claude-sonnet-4","content":[{"type":"text","text":"Running tests."},{"type":"tool_use","id":"t1","name":"Bash","input":{"command":"go test ./..."}}],"usage":{"input_tokens":100,"output_tokens":20,"cache_read_input_tokens":50}}}`,
+		`{"type":"assistant","timestamp":"2026-01-02T10:00:01Z","message":{"id":"m1","role":"assistant","content":[{"type":"tool_use","id":"t2","name":"Edit","input":{"file_path":"main.go","old_string":"a","new_string":"b"}}],"usage":{"input_tokens":100,"output_tokens":20,"cache_read_input_tokens":50}}}`,
+		`{"type":"user","timestamp":"2026-01-02T10:00:02Z","message":{"role":"user","content":[{"type":"tool_result","tool_use_id":"t1","content":"FAIL\nExit code 1","is_error":true}]}}`,
+		`{"type":"user","timestamp":"2026-01-02T10:00:03Z","message":{"role":"user","content":[{"type":"tool_result","tool_use_id":"t2","content":[{"type":"text","text":"ok"}]}]}}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := (&Claude{}).ExtractEvents(path)
+	if err != nil {
+		t.Fatalf("ExtractEvents: %v", err)
+	}
+	byType := map[models.SessionEventType][]models.SessionEvent{}
+	for i, e := range events {
+		if e.Seq != i+1 {
+			t.Errorf("event %d has Seq %d", i, e.Seq)
+		}
+		byType[e.Type] = append(byType[e.Type], e)
+	}
+
+	if got := byType[models.SessionEventUserMessage]; len(got) != 1 || got[0].Text != "fix the build" {
+		t.Errorf("user messages = %+v", got)
+	}
+	if got := byType[models.SessionEventToolCall]; len(got) != 2 || !got[0].IsError || got[1].Result != "ok" {
+		t.Errorf("tool calls = %+v", got)
+	}
+	sh := byType[models.SessionEventShellCommand]
+	if len(sh) != 1 || sh[0].Command != "go test ./..." || sh[0].ExitCode == nil || *sh[0].ExitCode != 1 {
+		t.Errorf("shell commands = %+v", sh)
+	}
+	fe := byType[models.SessionEventFileEdit]
+	if len(fe) != 1 || fe[0].Path != "main.go" || fe[0].EditKind != models.FileEditModify {
+		t.Errorf("file edits = %+v", fe)
+	}
+	tu := byType[models.SessionEventTokenUsage]
+	if len(tu) != 1 || tu[0].TokensIn != 100 || tu[0].TokensOut != 20 || tu[0].CacheReadTokens != 50 || tu[0].Model != "claude-sonnet-4" {
+		t.Errorf("token usage = %+v", tu)
+	}
+}
@@ -429,6 +429,11 @@ func formatCodexToolUse(sb *strings.Builder, line []byte, evt codexEvent) {
 	sb.WriteString("]\n\n")
 }
 
+// ExtractEvents maps a Codex rollout into normalized session events.
+func (c *Codex) ExtractEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	return extractTypedEvents(jsonlPath)
+}
+
 func codexRoleLabel(role string) string {
 	switch role {
 	case "user":
@@ -321,3 +321,49 @@ func TestCodexDisplayName(t *testing.T) {
 		t.Errorf("DisplayName empty")
 	}
 }
+
+// Codex exec --json reports finished work as item.completed records:
+// command_execution carries the exit code directly and file_change lists
+// every touched path.
+func TestCodexExtractEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rollout.jsonl")
+	lines := []string{
+		`{"type":"thread.started"}`,
+		`{"type":"item.started","item":{"id":"i1","type":"command_execution","command":"bash -lc ls","status":"in_progress"}}`,
+		`{"type":"item.completed","item":{"id":"i1","type":"command_execution","command":"bash -lc ls","aggregated_output":"a\nb","exit_code":2,"status":"failed"}}`,
+		`{"type":"item.completed","item":{"id":"i2","type":"file_change","changes":[{"path":"a.go","kind":"add"},{"path":"b.go","kind":"update"}]}}`,
+		`{"type":"item.completed","item":{"id":"i3","type":"agent_message","text":"done"}}`,
+		`{"type":"turn.completed","usage":{"input_tokens":10,"cached_input_tokens":4,"output_tokens":3}}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := (&Codex{}).ExtractEvents(path)
+	if err != nil {
+		t.Fatalf("ExtractEvents: %v", err)
+	}
+	var shells, edits []models.SessionEvent
+	var sawMessage, sawUsage bool
+	for _, e := range events {
+		switch e.Type {
+		case models.SessionEventShellCommand:
+			shells = append(shells, e)
+		case models.SessionEventFileEdit:
+			edits = append(edits, e)
+		case models.SessionEventAssistantMessage:
+			sawMessage = e.Text == "done"
+		case models.SessionEventTokenUsage:
+			sawUsage = e.TokensIn == 10 && e.TokensOut == 3 && e.CacheReadTokens == 4
+		}
+	}
+	if len(shells) != 1 || shells[0].Command != "bash -lc ls" || shells[0].ExitCode == nil || *shells[0].ExitCode != 2 {
+		t.Errorf("shell commands = %+v", shells)
+	}
+	if len(edits) != 2 || edits[0].EditKind != models.FileEditWrite || edits[1].Path != "b.go" {
+		t.Errorf("file edits = %+v", edits)
+	}
+	if !sawMessage || !sawUsage {
+		t.Errorf("message=%v usage=%v in %+v", sawMessage, sawUsage, events)
+	}
+}
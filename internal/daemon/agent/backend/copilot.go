@@ -444,6 +444,11 @@ func formatCopilotToolUse(sb *strings.Builder, line []byte, evt copilotEvent) {
 	sb.WriteString("]\n\n")
 }
 
+// ExtractEvents maps a Copilot events.jsonl log into normalized session events.
+func (c *Copilot) ExtractEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	return extractTypedEvents(jsonlPath)
+}
+
 func copilotRoleLabel(role string) string {
 	switch role {
 	case "user":
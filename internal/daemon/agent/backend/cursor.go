@@ -463,6 +463,11 @@ func formatCursorToolUse(sb *strings.Builder, line []byte, evt cursorEvent) {
 	sb.WriteString("]\n\n")
 }
 
+// ExtractEvents maps a Cursor events.jsonl log into normalized session events.
+func (c *Cursor) ExtractEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	return extractTypedEvents(jsonlPath)
+}
+
 func cursorRoleLabel(role string) string {
 	switch role {
 	case "user":
@@ -0,0 +1,488 @@
+package backend
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// maxEventResultLen caps tool output copied into an event. The full
+// output stays in the transcript; the event log is for scanning.
+const maxEventResultLen = 2000
+
+// eventBuilder accumulates normalized events while a backend walks its
+// transcript. Tool results usually arrive on a later line than the call,
+// so calls are remembered by ID and patched in place.
+type eventBuilder struct {
+	events []models.SessionEvent
+	calls  map[string]int // call ID → index of the tool_call event
+	shells map[string]int // call ID → index of the shell_command event
+}
+
+func newEventBuilder() *eventBuilder {
+	return &eventBuilder{calls: map[string]int{}, shells: map[string]int{}}
+}
+
+func (b *eventBuilder) add(e models.SessionEvent) int {
+	b.events = append(b.events, e)
+	return len(b.events) - 1
+}
+
+// message records a user or assistant message. Empty text is dropped.
+func (b *eventBuilder) message(role, text string, at *time.Time) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	typ := models.SessionEventAssistantMessage
+	if role == "user" {
+		typ = models.SessionEventUserMessage
+	}
+	b.add(models.SessionEvent{Type: typ, Time: at, Text: text})
+}
+
+// toolCall records a tool invocation and, when the tool is recognisably
+// a shell or file-editing tool, the derived shell_command / file_edit
+// events. args may be a JSON object or a JSON string holding one.
+func (b *eventBuilder) toolCall(id, name string, args json.RawMessage, at *time.Time) {
+	if name == "" {
+		return
+	}
+	parsed := decodeToolArgs(args)
+	i := b.add(models.SessionEvent{Type: models.SessionEventToolCall, Time: at, Tool: name, CallID: id, Args: compactArgs(args, parsed)})
+	if id != "" {
+		b.calls[id] = i
+	}
+	if isShellTool(name) {
+		if cmd := commandFromArgs(parsed); cmd != "" {
+			b.shell(id, name, cmd, nil, at)
+		}
+	}
+	if kind, ok := fileEditKind(name); ok {
+		for _, path := range pathsFromArgs(parsed) {
+			b.fileEdit(id, name, path, kind, at)
+		}
+	}
+}
+
+// shell records a shell command. exitCode may be nil and patched later
+// by toolResult.
+func (b *eventBuilder) shell(id, tool, command string, exitCode *int, at *time.Time) {
+	i := b.add(models.SessionEvent{Type: models.SessionEventShellCommand, Time: at, Tool: tool, CallID: id, Command: command, ExitCode: exitCode})
+	if id != "" {
+		b.shells[id] = i
+	}
+}
+
+func (b *eventBuilder) fileEdit(id, tool, path, kind string, at *time.Time) {
+	if path == "" {
+		return
+	}
+	b.add(models.SessionEvent{Type: models.SessionEventFileEdit, Time: at, Tool: tool, CallID: id, Path: path, EditKind: kind})
+}
+
+// toolResult attaches output to the call with the given ID. exitCode, when
+// known, is copied onto the matching shell_command event.
+func (b *eventBuilder) toolResult(id, output string, isError bool, exitCode *int) {
+	if i, ok := b.calls[id]; ok {
+		b.events[i].Result = truncateEventText(output)
+		b.events[i].IsError = isError
+	}
+	if i, ok := b.shells[id]; ok {
+		if exitCode == nil {
+			exitCode = exitCodeFromOutput(output, isError)
+		}
+		b.events[i].ExitCode = exitCode
+	}
+}
+
+// usage records one turn's token counts. model may be empty when the
+// transcript doesn't name it.
+func (b *eventBuilder) usage(model string, in, out, cacheRead int64, at *time.Time) {
+	if in == 0 && out == 0 && cacheRead == 0 {
+		return
+	}
+	b.add(models.SessionEvent{Type: models.SessionEventTokenUsage, Time: at, TokensIn: in, TokensOut: out, CacheReadTokens: cacheRead, Model: model})
+}
+
+// finish numbers the events in transcript order.
+func (b *eventBuilder) finish() []models.SessionEvent {
+	for i := range b.events {
+		b.events[i].Seq = i + 1
+	}
+	return b.events
+}
+
+// Tool name classification. Names are matched case-insensitively and
+// cover the built-in tools of every supported backend.
+var (
+	shellToolNames = map[string]bool{
+		"bash": true, "shell": true, "local_shell": true, "exec_command": true,
+		"run_shell_command": true, "run_terminal_cmd": true, "terminal": true,
+	}
+	fileEditToolNames = map[string]string{
+		"write": models.FileEditWrite, "write_file": models.FileEditWrite, "create_file": models.FileEditWrite,
+		"edit": models.FileEditModify, "multiedit": models.FileEditModify, "notebookedit": models.FileEditModify,
+		"edit_file": models.FileEditModify, "replace": models.FileEditModify, "str_replace_editor": models.FileEditModify,
+		"str_replace_based_edit_tool": models.FileEditModify, "search_replace": models.FileEditModify,
+		"patch": models.FileEditModify, "apply_patch": models.FileEditModify,
+		"delete_file": models.FileEditDelete,
+	}
+)
+
+func isShellTool(name string) bool {
+	return shellToolNames[strings.ToLower(name)]
+}
+
+func fileEditKind(name string) (string, bool) {
+	kind, ok := fileEditToolNames[strings.ToLower(name)]
+	return kind, ok
+}
+
+// decodeToolArgs parses tool arguments into a map. Some transcripts
+// (Codex function_call) carry the arguments as a JSON-encoded string.
+func decodeToolArgs(raw json.RawMessage) map[string]any {
+	if len(raw) == 0 {
+		return nil
+	}
+	var m map[string]any
+	if json.Unmarshal(raw, &m) == nil {
+		return m
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		if json.Unmarshal([]byte(s), &m) == nil {
+			return m
+		}
+		// A bare string argument (e.g. apply_patch input).
+		return map[string]any{"input": s}
+	}
+	return nil
+}
+
+func compactArgs(raw json.RawMessage, parsed map[string]any) string {
+	if parsed == nil {
+		return ""
+	}
+	out, err := json.Marshal(parsed)
+	if err != nil {
+		return truncateEventText(string(raw))
+	}
+	return truncateEventText(string(out))
+}
+
+// commandFromArgs pulls the command line out of shell tool arguments.
+// Argv-style commands of the form [sh, -c, script] collapse to script.
+func commandFromArgs(args map[string]any) string {
+	for _, key := range []string{"command", "cmd"} {
+		switch v := args[key].(type) {
+		case string:
+			return strings.TrimSpace(v)
+		case []any:
+			argv := make([]string, 0, len(v))
+			for _, a := range v {
+				if s, ok := a.(string); ok {
+					argv = append(argv, s)
+				}
+			}
+			if len(argv) == 3 && (argv[1] == "-c" || argv[1] == "-lc") {
+				return strings.TrimSpace(argv[2])
+			}
+			return strings.Join(argv, " ")
+		}
+	}
+	return ""
+}
+
+var patchFileRe = regexp.MustCompile(`(?m)^\*\*\* (?:Add|Update|Delete) File: (.+)$`)
+
+// pathsFromArgs returns the file(s) a file-editing tool touched. Patch
+// tools name every file inside the patch body.
+func pathsFromArgs(args map[string]any) []string {
+	for _, key := range []string{"file_path", "path", "absolute_path", "filePath", "notebook_path", "target_file", "filename"} {
+		if s, ok := args[key].(string); ok && s != "" {
+			return []string{s}
+		}
+	}
+	for _, key := range []string{"input", "patch"} {
+		if s, ok := args[key].(string); ok {
+			var paths []string
+			for _, m := range patchFileRe.FindAllStringSubmatch(s, -1) {
+				paths = append(paths, strings.TrimSpace(m[1]))
+			}
+			if len(paths) > 0 {
+				return paths
+			}
+		}
+	}
+	return nil
+}
+
+var exitCodeRe = regexp.MustCompile(`(?i)exit (?:code|status)[:= ]+(-?\d+)`)
+
+// exitCodeFromOutput infers a shell exit code when the transcript only
+// has the output text: an explicit "Exit code N" wins, otherwise success
+// maps to 0 and an error result stays unknown.
+func exitCodeFromOutput(output string, isError bool) *int {
+	if m := exitCodeRe.FindStringSubmatch(output); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			return &n
+		}
+	}
+	if !isError {
+		zero := 0
+		return &zero
+	}
+	return nil
+}
+
+func truncateEventText(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= maxEventResultLen {
+		return s
+	}
+	cut := maxEventResultLen
+	for cut > 0 && !isRuneBoundary(s, cut) {
+		cut--
+	}
+	return s[:cut] + "…"
+}
+
+func isRuneBoundary(s string, i int) bool {
+	return i == len(s) || s[i]&0xC0 != 0x80
+}
+
+// parseEventTime accepts the RFC3339 timestamps transcripts carry.
+func parseEventTime(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// typedEvent is the union of fields seen in the type-discriminated JSONL
+// logs written by Codex, Copilot CLI and Cursor Agent CLI. Two shapes are
+// understood: the flat `item.message` / `item.tool_use` records the
+// formatters already handle, and Codex's structured `item.completed`
+// (agent_message, command_execution, file_change, mcp_tool_call) and
+// `response_item` (message, function_call, function_call_output) records.
+type typedEvent struct {
+	Type      string          `json:"type"`
+	Timestamp string          `json:"timestamp"`
+	Role      string          `json:"role"`
+	Content   json.RawMessage `json:"content"`
+	Text      string          `json:"text"`
+	Name      string          `json:"name"`
+	Tool      string          `json:"tool"`
+	Command   json.RawMessage `json:"command"`
+	ID        string          `json:"id"`
+	CallID    string          `json:"call_id"`
+	ToolUseID string          `json:"tool_use_id"`
+	Input     json.RawMessage `json:"input"`
+	Args      json.RawMessage `json:"args"`
+	Arguments json.RawMessage `json:"arguments"`
+	Output    json.RawMessage `json:"output"`
+	ExitCode  *int            `json:"exit_code"`
+	IsError   bool            `json:"is_error"`
+	Status    string          `json:"status"`
+	Usage     *typedUsage     `json:"usage"`
+	Info      *struct {
+		LastTokenUsage *typedUsage `json:"last_token_usage"`
+	} `json:"info"`
+	AggregatedOutput string `json:"aggregated_output"`
+	Changes          []struct {
+		Path string `json:"path"`
+		Kind string `json:"kind"`
+	} `json:"changes"`
+
+	Payload json.RawMessage `json:"payload"`
+	Data    json.RawMessage `json:"data"`
+	Item    json.RawMessage `json:"item"`
+}
+
+type typedUsage struct {
+	InputTokens       int64 `json:"input_tokens"`
+	OutputTokens      int64 `json:"output_tokens"`
+	CachedInputTokens int64 `json:"cached_input_tokens"`
+}
+
+// extractTypedEvents walks a Codex/Copilot/Cursor style JSONL log.
+func extractTypedEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	f, err := os.Open(jsonlPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	b := newEventBuilder()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var evt typedEvent
+		if err := json.Unmarshal(scanner.Bytes(), &evt); err != nil {
+			continue
+		}
+		at := parseEventTime(evt.Timestamp)
+		// Unwrap envelopes: {type, payload:{...}} / {type, data:{...}} /
+		// {type:"item.completed", item:{...}}.
+		inner := evt
+		for _, nested := range []json.RawMessage{evt.Payload, evt.Data, evt.Item} {
+			if len(nested) == 0 || nested[0] != '{' {
+				continue
+			}
+			var n typedEvent
+			if json.Unmarshal(nested, &n) == nil {
+				inner = n
+				break
+			}
+		}
+		if inner.Type == "" {
+			inner.Type = evt.Type
+		}
+		switch evt.Type {
+		case "item.started", "item.updated":
+			continue // item.completed carries the final state
+		}
+		typedEventInto(b, evt.Type, inner, at)
+	}
+	return b.finish(), scanner.Err()
+}
+
+func typedEventInto(b *eventBuilder, outer string, e typedEvent, at *time.Time) {
+	callID := firstNonEmpty(e.CallID, e.ToolUseID, e.ID)
+	switch e.Type {
+	case "item.message", "message", "agent_message", "user_message":
+		role := e.Role
+		switch e.Type {
+		case "agent_message":
+			role = "assistant"
+		case "user_message":
+			role = "user"
+		}
+		b.message(role, typedText(e), at)
+	case "item.tool_use", "tool_use", "function_call", "custom_tool_call", "mcp_tool_call":
+		name := firstNonEmpty(e.Name, e.Tool)
+		args := firstRaw(e.Input, e.Args, e.Arguments)
+		if len(args) == 0 && len(e.Command) > 0 {
+			// Flat form: {"type":"item.tool_use","name":"shell","command":"ls"}.
+			args = json.RawMessage(`{"command":` + string(e.Command) + `}`)
+			if name == "" {
+				name = "shell"
+			}
+		}
+		b.toolCall(callID, name, args, at)
+	case "item.tool_result", "tool_result", "function_call_output", "custom_tool_call_output":
+		out, exit := typedOutput(e.Output)
+		if out == "" {
+			out = typedText(e)
+		}
+		if e.ExitCode != nil {
+			exit = e.ExitCode
+		}
+		b.toolResult(callID, out, e.IsError, exit)
+	case "command_execution":
+		var cmd string
+		if json.Unmarshal(e.Command, &cmd) != nil {
+			cmd = commandFromArgs(decodeToolArgs(json.RawMessage(`{"command":` + string(e.Command) + `}`)))
+		}
+		b.toolCall(callID, "shell", json.RawMessage(`{"command":`+strconv.Quote(cmd)+`}`), at)
+		b.toolResult(callID, e.AggregatedOutput, e.Status == "failed" || (e.ExitCode != nil && *e.ExitCode != 0), e.ExitCode)
+	case "file_change":
+		for _, c := range e.Changes {
+			kind := models.FileEditModify
+			switch c.Kind {
+			case "add":
+				kind = models.FileEditWrite
+			case "delete":
+				kind = models.FileEditDelete
+			}
+			b.fileEdit(callID, "apply_patch", c.Path, kind, at)
+		}
+	case "token_count":
+		if e.Info != nil && e.Info.LastTokenUsage != nil {
+			u := e.Info.LastTokenUsage
+			b.usage("", u.InputTokens, u.OutputTokens, u.CachedInputTokens, at)
+		}
+	}
+	if outer == "turn.completed" && e.Usage != nil {
+		b.usage("", e.Usage.InputTokens, e.Usage.OutputTokens, e.Usage.CachedInputTokens, at)
+	}
+}
+
+// typedText extracts message text from content blocks, a plain-string
+// content, or the text field.
+func typedText(e typedEvent) string {
+	if len(e.Content) > 0 {
+		var s string
+		if json.Unmarshal(e.Content, &s) == nil {
+			return s
+		}
+		var blocks []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		}
+		if json.Unmarshal(e.Content, &blocks) == nil {
+			var parts []string
+			for _, blk := range blocks {
+				switch blk.Type {
+				case "", "text", "output_text", "input_text":
+					if strings.TrimSpace(blk.Text) != "" {
+						parts = append(parts, blk.Text)
+					}
+				}
+			}
+			return strings.Join(parts, "\n\n")
+		}
+	}
+	return e.Text
+}
+
+// typedOutput decodes a tool output that is either plain text or (Codex
+// function_call_output) a JSON string wrapping {output, metadata}.
+func typedOutput(raw json.RawMessage) (string, *int) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		return string(raw), nil
+	}
+	var wrapped struct {
+		Output   string `json:"output"`
+		Metadata struct {
+			ExitCode *int `json:"exit_code"`
+		} `json:"metadata"`
+	}
+	if strings.HasPrefix(strings.TrimSpace(s), "{") && json.Unmarshal([]byte(s), &wrapped) == nil {
+		return wrapped.Output, wrapped.Metadata.ExitCode
+	}
+	return s, nil
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func firstRaw(vals ...json.RawMessage) json.RawMessage {
+	for _, v := range vals {
+		if len(v) > 0 && string(v) != "null" {
+			return v
+		}
+	}
+	return nil
+}
@@ -294,6 +294,16 @@ type geminiMessage struct {
 	Message json.RawMessage `json:"message"`
 	// Older exports sometimes emit a parallel toolCalls array.
 	ToolCalls []geminiToolCall `json:"toolCalls"`
+	// Read by ExtractEvents only.
+	Timestamp string        `json:"timestamp"`
+	Model     string        `json:"model"`
+	Tokens    *geminiTokens `json:"tokens"`
+}
+
+type geminiTokens struct {
+	Input  int64 `json:"input"`
+	Output int64 `json:"output"`
+	Cached int64 `json:"cached"`
 }
 
 type geminiPart struct {
@@ -304,16 +314,23 @@ type geminiPart struct {
 }
 
 type geminiFunctionCall struct {
+	ID   string          `json:"id"`
 	Name string          `json:"name"`
 	Args json.RawMessage `json:"args"`
 }
 
 type geminiFunctionResp struct {
-	Name string `json:"name"`
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	Response json.RawMessage `json:"response"`
 }
 
 type geminiToolCall struct {
-	Name string `json:"name"`
+	ID     string          `json:"id"`
+	Name   string          `json:"name"`
+	Args   json.RawMessage `json:"args"`
+	Result json.RawMessage `json:"result"`
+	Status string          `json:"status"`
 }
 
 // FormatTranscript reads a gemini transcript (JSONL session log or
@@ -321,9 +338,20 @@ type geminiToolCall struct {
 // "## User" / "## Assistant" shape the other backends produce so the
 // log viewer renders every backend identically.
 func (g *Gemini) FormatTranscript(jsonlPath string) (string, error) {
+	msgs, err := readGeminiMessages(jsonlPath)
+	var sb strings.Builder
+	for _, m := range msgs {
+		formatGeminiMessage(&sb, m)
+	}
+	return sb.String(), err
+}
+
+// readGeminiMessages decodes either transcript shape into messages. On a
+// JSONL read error the messages parsed so far are returned with it.
+func readGeminiMessages(jsonlPath string) ([]geminiMessage, error) {
 	f, err := os.Open(jsonlPath)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer func() { _ = f.Close() }()
 
@@ -334,56 +362,175 @@ func (g *Gemini) FormatTranscript(jsonlPath string) (string, error) {
 	for {
 		b, err := br.ReadByte()
 		if err != nil {
-			return "", nil
+			return nil, nil
 		}
 		if b == ' ' || b == '\t' || b == '\n' || b == '\r' {
 			continue
 		}
 		prefix = b
 		if err := br.UnreadByte(); err != nil {
-			return "", err
+			return nil, err
 		}
 		break
 	}
 
-	var sb strings.Builder
 	if prefix == '[' {
 		var msgs []geminiMessage
 		if err := json.NewDecoder(br).Decode(&msgs); err != nil {
-			return "", err
+			return nil, err
 		}
-		for _, m := range msgs {
-			formatGeminiMessage(&sb, m)
+		return msgs, nil
+	}
+
+	var msgs []geminiMessage
+	scanner := bufio.NewScanner(br)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
 		}
-	} else {
-		scanner := bufio.NewScanner(br)
-		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
-		for scanner.Scan() {
-			line := scanner.Bytes()
-			if len(line) == 0 {
-				continue
+		var msg geminiMessage
+		if err := json.Unmarshal(line, &msg); err != nil {
+			continue
+		}
+		if msg.Type == "session_metadata" {
+			continue
+		}
+		// Some schemas nest the payload under "message".
+		if msg.Role == "" && len(msg.Parts) == 0 && len(msg.Content) == 0 && len(msg.Message) > 0 {
+			var inner geminiMessage
+			if err := json.Unmarshal(msg.Message, &inner); err == nil {
+				msg = inner
 			}
-			var msg geminiMessage
-			if err := json.Unmarshal(line, &msg); err != nil {
-				continue
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, scanner.Err()
+}
+
+// ExtractEvents maps a gemini transcript into normalized session events.
+// Function calls in parts carry no ID in older logs, so a response is
+// matched to the most recent unanswered call with the same name.
+func (g *Gemini) ExtractEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	msgs, err := readGeminiMessages(jsonlPath)
+	b := newEventBuilder()
+	pending := map[string][]string{} // tool name → unanswered call IDs
+	n := 0
+	callID := func(id, name string) string {
+		if id == "" {
+			n++
+			id = fmt.Sprintf("%s#%d", name, n)
+		}
+		pending[name] = append(pending[name], id)
+		return id
+	}
+	answer := func(id, name string) string {
+		if id != "" {
+			return id
+		}
+		ids := pending[name]
+		if len(ids) == 0 {
+			return ""
+		}
+		pending[name] = ids[1:]
+		return ids[0]
+	}
+
+	for _, msg := range msgs {
+		at := parseEventTime(msg.Timestamp)
+		role := msg.Role
+		if role == "" {
+			role = msg.Type
+		}
+		if role == "gemini" || role == "model" {
+			role = "assistant"
+		}
+
+		parts := msg.Parts
+		var texts []string
+		if len(parts) == 0 && len(msg.Content) > 0 {
+			var s string
+			if json.Unmarshal(msg.Content, &s) == nil {
+				texts = append(texts, s)
+			} else {
+				_ = json.Unmarshal(msg.Content, &parts)
 			}
-			if msg.Type == "session_metadata" {
+		}
+		for _, p := range parts {
+			switch {
+			case p.Thought:
+			case p.FunctionCall != nil && p.FunctionCall.Name != "":
+				b.toolCall(callID(p.FunctionCall.ID, p.FunctionCall.Name), p.FunctionCall.Name, p.FunctionCall.Args, at)
+			case p.FunctionResponse != nil:
+				id := answer(p.FunctionResponse.ID, p.FunctionResponse.Name)
+				out, isErr := geminiResponseText(p.FunctionResponse.Response)
+				b.toolResult(id, out, isErr, nil)
+			default:
+				texts = append(texts, p.Text)
+			}
+		}
+		if len(texts) == 0 && msg.Text != "" {
+			texts = append(texts, msg.Text)
+		}
+		b.message(role, strings.Join(texts, "\n\n"), at)
+
+		for _, tc := range msg.ToolCalls {
+			if tc.Name == "" {
 				continue
 			}
-			// Some schemas nest the payload under "message".
-			if msg.Role == "" && len(msg.Parts) == 0 && len(msg.Content) == 0 && len(msg.Message) > 0 {
-				var inner geminiMessage
-				if err := json.Unmarshal(msg.Message, &inner); err == nil {
-					msg = inner
-				}
+			id := callID(tc.ID, tc.Name)
+			b.toolCall(id, tc.Name, tc.Args, at)
+			if len(tc.Result) > 0 || tc.Status != "" {
+				answer(id, tc.Name)
+				out := geminiToolCallResult(tc.Result)
+				b.toolResult(id, out, tc.Status == "error", nil)
 			}
-			formatGeminiMessage(&sb, msg)
 		}
-		if err := scanner.Err(); err != nil {
-			return sb.String(), err
+		if t := msg.Tokens; t != nil {
+			b.usage(msg.Model, t.Input, t.Output, t.Cached, at)
+		}
+	}
+	return b.finish(), err
+}
+
+// geminiResponseText flattens a functionResponse.response object, which
+// carries either {output} or {error}.
+func geminiResponseText(raw json.RawMessage) (string, bool) {
+	var r struct {
+		Output string `json:"output"`
+		Error  string `json:"error"`
+	}
+	if json.Unmarshal(raw, &r) == nil {
+		if r.Error != "" {
+			return r.Error, true
+		}
+		return r.Output, false
+	}
+	return string(raw), false
+}
+
+// geminiToolCallResult flattens a toolCalls[].result, which is a list of
+// parts wrapping functionResponse objects, or plain text.
+func geminiToolCallResult(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var parts []geminiPart
+	if json.Unmarshal(raw, &parts) != nil {
+		return ""
+	}
+	var out []string
+	for _, p := range parts {
+		if p.FunctionResponse != nil {
+			text, _ := geminiResponseText(p.FunctionResponse.Response)
+			out = append(out, text)
+		} else if p.Text != "" {
+			out = append(out, p.Text)
 		}
 	}
-	return sb.String(), nil
+	return strings.Join(out, "\n")
 }
 
 func formatGeminiMessage(sb *strings.Builder, msg geminiMessage) {
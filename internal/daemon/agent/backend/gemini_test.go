@@ -349,3 +349,54 @@ func TestGeminiDisplayName(t *testing.T) {
 		t.Errorf("DisplayName = %q, want %q", g.DisplayName(), "Gemini CLI")
 	}
 }
+
+// Gemini's chat recordings carry tool calls inline with their result and
+// status; run_shell_command output reports the exit code as text.
+func TestGeminiExtractEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	lines := []string{
+		`{"type":"user","timestamp":"2026-01-02T10:00:00Z","content":"list files"}`,
+		`{"type":"gemini","timestamp":"2026-01-02T10:00:01Z","content":"Listing.","tokens":{"input":30,"output":5,"cached":10},"toolCalls":[` +
+			`{"id":"c1","name":"run_shell_command","args":{"command":"ls"},"status":"error","result":[{"functionResponse":{"id":"c1","name":"run_shell_command","response":{"output":"Exit Code: 127"}}}]},` +
+			`{"id":"c2","name":"write_file","args":{"file_path":"notes.md","content":"x"},"status":"success","result":[]}]}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := (&Gemini{}).ExtractEvents(path)
+	if err != nil {
+		t.Fatalf("ExtractEvents: %v", err)
+	}
+	counts := map[models.SessionEventType]int{}
+	for _, e := range events {
+		counts[e.Type]++
+		switch e.Type {
+		case models.SessionEventShellCommand:
+			if e.Command != "ls" || e.ExitCode == nil || *e.ExitCode != 127 {
+				t.Errorf("shell command = %+v", e)
+			}
+		case models.SessionEventFileEdit:
+			if e.Path != "notes.md" || e.EditKind != models.FileEditWrite {
+				t.Errorf("file edit = %+v", e)
+			}
+		case models.SessionEventTokenUsage:
+			if e.TokensIn != 30 || e.TokensOut != 5 || e.CacheReadTokens != 10 {
+				t.Errorf("usage = %+v", e)
+			}
+		}
+	}
+	want := map[models.SessionEventType]int{
+		models.SessionEventUserMessage:      1,
+		models.SessionEventAssistantMessage: 1,
+		models.SessionEventToolCall:         2,
+		models.SessionEventShellCommand:     1,
+		models.SessionEventFileEdit:         1,
+		models.SessionEventTokenUsage:       1,
+	}
+	for typ, n := range want {
+		if counts[typ] != n {
+			t.Errorf("%s count = %d, want %d", typ, counts[typ], n)
+		}
+	}
+}
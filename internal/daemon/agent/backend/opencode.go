@@ -396,6 +396,37 @@ type opencodeMessagePart struct {
 	Name    string `json:"name"`
 	Tool    string `json:"tool"`
 	Command string `json:"command"`
+	// Read by ExtractEvents only: tool parts carry their call ID and a
+	// state object holding input, output and completion status.
+	CallID string             `json:"callID"`
+	State  *opencodeToolState `json:"state"`
+}
+
+type opencodeToolState struct {
+	Status   string          `json:"status"`
+	Input    json.RawMessage `json:"input"`
+	Output   string          `json:"output"`
+	Error    string          `json:"error"`
+	Metadata struct {
+		Exit *int `json:"exit"`
+	} `json:"metadata"`
+}
+
+// opencodeInfo is the metadata block opencode stores beside each
+// message's parts; only the fields ExtractEvents needs are decoded.
+type opencodeInfo struct {
+	Role    string `json:"role"`
+	ModelID string `json:"modelID"`
+	Time    struct {
+		Created int64 `json:"created"` // unix millis
+	} `json:"time"`
+	Tokens *struct {
+		Input  int64 `json:"input"`
+		Output int64 `json:"output"`
+		Cache  struct {
+			Read int64 `json:"read"`
+		} `json:"cache"`
+	} `json:"tokens"`
 }
 
 // FormatTranscript reads the synthesized JSONL (one opencode message per line)
@@ -440,6 +471,84 @@ func (o *Opencode) FormatTranscript(jsonlPath string) (string, error) {
 	return sb.String(), scanner.Err()
 }
 
+// ExtractEvents maps the synthesized opencode JSONL into normalized
+// session events. Unlike FormatTranscript it keeps top-level parts when
+// the role lives under "info", since that is where opencode stores
+// token usage.
+func (o *Opencode) ExtractEvents(jsonlPath string) ([]models.SessionEvent, error) {
+	f, err := os.Open(jsonlPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	b := newEventBuilder()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var msg opencodeMessage
+		if err := json.Unmarshal(line, &msg); err != nil {
+			continue
+		}
+		var info opencodeInfo
+		if len(msg.Info) > 0 {
+			_ = json.Unmarshal(msg.Info, &info)
+		}
+		if msg.Role == "" && len(msg.Message) > 0 {
+			var inner opencodeMessage
+			if err := json.Unmarshal(msg.Message, &inner); err == nil {
+				msg = inner
+			}
+		}
+		role := firstNonEmpty(msg.Role, info.Role)
+		var at *time.Time
+		if info.Time.Created > 0 {
+			t := time.UnixMilli(info.Time.Created)
+			at = &t
+		}
+
+		var texts []string
+		for _, p := range msg.Parts {
+			switch p.Type {
+			case "", "text", "output_text", "input_text":
+				texts = append(texts, p.Text)
+			case "tool", "tool_use", "tool-invocation":
+				name := firstNonEmpty(p.Tool, p.Name)
+				var args json.RawMessage
+				if p.State != nil {
+					args = p.State.Input
+				}
+				if len(args) == 0 && p.Command != "" {
+					args, _ = json.Marshal(map[string]string{"command": p.Command})
+					name = firstNonEmpty(name, "shell")
+				}
+				b.toolCall(p.CallID, name, args, at)
+				if st := p.State; st != nil && (st.Status == "completed" || st.Status == "error") {
+					isErr := st.Status == "error"
+					b.toolResult(p.CallID, firstNonEmpty(st.Output, st.Error), isErr, st.Metadata.Exit)
+				}
+			}
+		}
+		if len(texts) == 0 {
+			var s string
+			if len(msg.Content) > 0 && json.Unmarshal(msg.Content, &s) == nil {
+				texts = append(texts, s)
+			} else if msg.Text != "" {
+				texts = append(texts, msg.Text)
+			}
+		}
+		b.message(role, strings.Join(texts, "\n\n"), at)
+		if t := info.Tokens; t != nil {
+			b.usage(info.ModelID, t.Input, t.Output, t.Cache.Read, at)
+		}
+	}
+	return b.finish(), scanner.Err()
+}
+
 func renderOpencodeMessage(sb *strings.Builder, msg opencodeMessage) {
 	role := msg.Role
 	var textParts []string
@@ -356,3 +356,47 @@ func TestOpencodeDisplayName(t *testing.T) {
 		t.Errorf("DisplayName empty")
 	}
 }
+
+// opencode tool parts hold input, output and the shell exit code in a
+// state object, and token usage lives in the sibling info block.
+func TestOpencodeExtractEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	lines := []string{
+		`{"info":{"role":"user","time":{"created":1767348000000}},"parts":[{"type":"text","text":"hi"}]}`,
+		`{"info":{"role":"assistant","time":{"created":1767348001000},"tokens":{"input":7,"output":2,"cache":{"read":1}}},"parts":[` +
+			`{"type":"tool","callID":"c1","tool":"bash","state":{"status":"completed","input":{"command":"make"},"output":"ok","metadata":{"exit":0}}},` +
+			`{"type":"tool","callID":"c2","tool":"edit","state":{"status":"completed","input":{"filePath":"x.go"},"output":""}},` +
+			`{"type":"text","text":"Built."}]}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := (&Opencode{}).ExtractEvents(path)
+	if err != nil {
+		t.Fatalf("ExtractEvents: %v", err)
+	}
+	var got []string
+	for _, e := range events {
+		switch e.Type {
+		case models.SessionEventShellCommand:
+			if e.ExitCode == nil || *e.ExitCode != 0 {
+				t.Errorf("shell exit code = %v", e.ExitCode)
+			}
+			got = append(got, "shell:"+e.Command)
+		case models.SessionEventFileEdit:
+			got = append(got, "edit:"+e.Path)
+		case models.SessionEventTokenUsage:
+			got = append(got, "usage")
+		case models.SessionEventUserMessage, models.SessionEventAssistantMessage:
+			got = append(got, "msg:"+e.Text)
+		}
+	}
+	want := "msg:hi shell:make edit:x.go msg:Built. usage"
+	if strings.Join(got, " ") != want {
+		t.Errorf("events = %q, want %q", strings.Join(got, " "), want)
+	}
+	if events[1].Time == nil {
+		t.Error("assistant events missing timestamp")
+	}
+}
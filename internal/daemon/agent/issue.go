@@ -20,6 +20,10 @@ const (
 	// project path sits inside a sandbox-denied root (e.g. ~/Desktop) — the
 	// agent is never spawned; see sandbox_preflight.go and issue #17.
 	AgentIssueSandboxDenied AgentIssueType = "sandbox_denied"
+	// AgentIssueAwaitingInput is raised while the agent waits on a
+	// question it asked (the ask package); Message is the question. It
+	// stays up until the answer is delivered — output never clears it.
+	AgentIssueAwaitingInput AgentIssueType = "awaiting_input"
 )
 
 // AgentIssue represents a detected issue with the agent.
@@ -29,6 +33,7 @@ type AgentIssue struct {
 	Message       string     // Original error message
 	ResetAt       *time.Time // Parsed reset time (rate limits)
 	CooldownUntil *time.Time // When to auto-resume
+	Options       []string   // Suggested answers (awaiting input)
 }
 
 // Pattern detection for auth errors.
@@ -0,0 +1,200 @@
+package agent
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/task"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// shouldNotifyProject resolves the global + per-project notification
+// preferences for projectPath and runs them through models.ShouldNotify
+// for the given event — the same gate emitRunComplete and
+// emitTaskDoneFailure apply inline.
+func shouldNotifyProject(kind models.NotificationKind, projectPath string) bool {
+	settings, _ := config.LoadSettings()
+	cfg := models.DefaultNotifications()
+	if settings != nil {
+		cfg = settings.Defaults.Notifications
+	}
+	var projectNotif models.ProjectNotifications
+	if proj, _ := config.LoadProject(projectPath); proj != nil {
+		projectNotif = proj.Notifications
+	}
+	return models.ShouldNotify(kind, cfg, projectNotif, time.Now().Local())
+}
+
+// emitLifecycle gates n on the user's preferences for prefKind, then
+// fans it out on the bus and appends the durable JSONL record. Shared by
+// the agent-driven lifecycle events (agent issues, wildfire phases,
+// generated tasks) that have no task-state trigger of their own.
+func emitLifecycle(bus *notify.Bus, prefKind models.NotificationKind, projectPath string, n notify.Notification) {
+	if n.ProjectID == "" || !shouldNotifyProject(prefKind, projectPath) {
+		return
+	}
+	n.ID = notify.MakeID(n.Kind, n.ProjectID, n.TaskNumber, n.EmittedAt)
+	if bus != nil {
+		bus.Emit(n)
+	}
+	if err := notify.AppendLogLine(n); err != nil {
+		log.Printf("[notify] failed to append notifications.log for %s %s: %v", n.ProjectID, n.Kind, err)
+	}
+}
+
+// issueNotificationKinds maps the agent issues worth telling a human
+// about onto their notification kinds. Trust dialogs are answered
+// automatically and sandbox denials fail StartAgent outright, so neither
+// is listed.
+var issueNotificationKinds = map[AgentIssueType]struct {
+	kind notify.Kind
+	pref models.NotificationKind
+	verb string
+}{
+	AgentIssueAuth:      {notify.KindAgentNeedsAuth, models.NotificationAgentNeedsAuth, "needs to sign in"},
+	AgentIssueRateLimit: {notify.KindRateLimited, models.NotificationRateLimited, "is rate limited"},
+}
+
+// watchIssues subscribes to the session's issue stream and emits
+// AGENT_NEEDS_AUTH / RATE_LIMITED on the rising edge of each issue type.
+// The PTY scanner re-detects a banner on every output batch, so the
+// watcher only emits again once the issue cleared (nil) or changed type.
+// Returns when the process exits.
+func (m *Manager) watchIssues(opts StartOptions, proc *Process) {
+	const subID = "lifecycle-notify"
+	ch := proc.SubscribeIssues(subID)
+	defer proc.UnsubscribeIssues(subID)
+
+	var current AgentIssueType
+	for {
+		select {
+		case <-proc.Done():
+			return
+		case issue, ok := <-ch:
+			if !ok {
+				return
+			}
+			if issue == nil {
+				current = AgentIssueNone
+				continue
+			}
+			if issue.Type == current {
+				continue
+			}
+			current = issue.Type
+			emitAgentIssue(m.notifyBus, opts, issue)
+		}
+	}
+}
+
+// emitAgentIssue fires the notification for one newly-detected agent
+// issue. Detail carries the provider's own message so the channel shows
+// exactly what the agent printed.
+func emitAgentIssue(bus *notify.Bus, opts StartOptions, issue *AgentIssue) {
+	spec, ok := issueNotificationKinds[issue.Type]
+	if !ok {
+		return
+	}
+	subject := "Agent"
+	if opts.TaskNumber > 0 {
+		subject = fmt.Sprintf("Agent on task #%04d", opts.TaskNumber)
+	}
+	title := fmt.Sprintf("%s %s", subject, spec.verb)
+	if opts.ProjectName != "" {
+		title = fmt.Sprintf("%s — %s", opts.ProjectName, title)
+	}
+	emitLifecycle(bus, spec.pref, opts.ProjectPath, notify.Notification{
+		Kind:       spec.kind,
+		ProjectID:  opts.ProjectID,
+		TaskNumber: int32(opts.TaskNumber),
+		Title:      title,
+		Body:       issue.Message,
+		Detail:     issue.Message,
+		EmittedAt:  time.Now().UTC(),
+	})
+}
+
+// emitWildfirePhaseChanged fires WILDFIRE_PHASE_CHANGED when a chained
+// wildfire run moves from one phase to another (execute → refine →
+// generate and back).
+func emitWildfirePhaseChanged(bus *notify.Bus, projectID, projectPath, projectName string, prev, next WildfirePhase) {
+	if prev == next {
+		return
+	}
+	title := fmt.Sprintf("Wildfire: %s → %s", phaseName(prev), phaseName(next))
+	if projectName != "" {
+		title = projectName + " — " + title
+	}
+	emitLifecycle(bus, models.NotificationWildfirePhase, projectPath, notify.Notification{
+		Kind:          notify.KindWildfirePhase,
+		ProjectID:     projectID,
+		Title:         title,
+		Body:          fmt.Sprintf("Wildfire moved to the %s phase", phaseName(next)),
+		Phase:         string(next),
+		PreviousPhase: string(prev),
+		EmittedAt:     time.Now().UTC(),
+	})
+}
+
+func phaseName(p WildfirePhase) string {
+	if p == WildfirePhaseNone {
+		return "idle"
+	}
+	return string(p)
+}
+
+// tasksGeneratedApplicable reports whether a session in the given mode /
+// phase writes new task files — the generate-tasks mode and wildfire's
+// generate phase.
+func tasksGeneratedApplicable(mode Mode, phase WildfirePhase) bool {
+	return mode == ModeGenerateTasks || (mode == ModeWildfire && phase == WildfirePhaseGenerate)
+}
+
+// countTasksCreatedSince counts tasks whose CreatedAt is at or after
+// since — the tasks a generation session just wrote.
+func countTasksCreatedSince(tasks []*models.Task, since time.Time) int {
+	n := 0
+	for _, t := range tasks {
+		if t != nil && !t.CreatedAt.Before(since) {
+			n++
+		}
+	}
+	return n
+}
+
+// emitTasksGenerated fires TASKS_GENERATED after a generation session
+// exits, counting the tasks it created. A session that produced nothing
+// stays silent.
+func emitTasksGenerated(bus *notify.Bus, projectID, projectPath, projectName string, mode Mode, phase WildfirePhase, startedAt time.Time) {
+	if !tasksGeneratedApplicable(mode, phase) || startedAt.IsZero() || projectPath == "" {
+		return
+	}
+	tasks, err := task.NewManager().ListTasks(projectPath, task.ListOptions{IncludeDeleted: false})
+	if err != nil {
+		log.Printf("[tasks-generated] failed to load tasks for project %s: %v", projectName, err)
+		return
+	}
+	count := countTasksCreatedSince(tasks, startedAt)
+	if count == 0 {
+		return
+	}
+	noun := "tasks"
+	if count == 1 {
+		noun = "task"
+	}
+	title := fmt.Sprintf("%d new %s generated", count, noun)
+	if projectName != "" {
+		title = projectName + " — " + title
+	}
+	emitLifecycle(bus, models.NotificationTasksGenerated, projectPath, notify.Notification{
+		Kind:      notify.KindTasksGenerated,
+		ProjectID: projectID,
+		Title:     title,
+		Body:      fmt.Sprintf("%d new %s ready to run", count, noun),
+		Count:     count,
+		EmittedAt: time.Now().UTC(),
+	})
+}
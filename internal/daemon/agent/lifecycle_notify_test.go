@@ -0,0 +1,143 @@
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/metrics"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestTasksGeneratedApplicable(t *testing.T) {
+	cases := []struct {
+		mode  Mode
+		phase WildfirePhase
+		want  bool
+	}{
+		{ModeGenerateTasks, WildfirePhaseNone, true},
+		{ModeWildfire, WildfirePhaseGenerate, true},
+		{ModeWildfire, WildfirePhaseRefine, false},
+		{ModeStartAll, WildfirePhaseNone, false},
+		{ModeChat, WildfirePhaseNone, false},
+	}
+	for _, c := range cases {
+		if got := tasksGeneratedApplicable(c.mode, c.phase); got != c.want {
+			t.Errorf("tasksGeneratedApplicable(%s, %q) = %v, want %v", c.mode, c.phase, got, c.want)
+		}
+	}
+}
+
+func TestCountTasksCreatedSince(t *testing.T) {
+	start := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	tasks := []*models.Task{
+		{TaskNumber: 1, CreatedAt: start.Add(-time.Hour)},
+		{TaskNumber: 2, CreatedAt: start},
+		{TaskNumber: 3, CreatedAt: start.Add(time.Minute)},
+		nil,
+	}
+	if got := countTasksCreatedSince(tasks, start); got != 2 {
+		t.Errorf("countTasksCreatedSince = %d, want 2", got)
+	}
+}
+
+func TestEmitAgentIssueKinds(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	bus := notify.NewBus()
+	ch, cancel := bus.Subscribe()
+	defer cancel()
+
+	opts := StartOptions{ProjectID: "proj-1", ProjectPath: t.TempDir(), ProjectName: "demo", TaskNumber: 42}
+	emitAgentIssue(bus, opts, &AgentIssue{Type: AgentIssueRateLimit, Message: "usage limit reached"})
+	emitAgentIssue(bus, opts, &AgentIssue{Type: AgentIssueTrustDialog})
+
+	select {
+	case got := <-ch:
+		if got.Kind != notify.KindRateLimited || got.Detail != "usage limit reached" || got.TaskNumber != 42 {
+			t.Errorf("got %+v, want RATE_LIMITED for task 42 with the provider message", got)
+		}
+		if got.Title != "demo — Agent on task #0042 is rate limited" {
+			t.Errorf("title = %q", got.Title)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("notification not emitted within 2s")
+	}
+	select {
+	case got := <-ch:
+		t.Errorf("trust dialogs must not notify, got %+v", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestEmitWildfirePhaseChanged(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	bus := notify.NewBus()
+	ch, cancel := bus.Subscribe()
+	defer cancel()
+
+	emitWildfirePhaseChanged(bus, "proj-1", t.TempDir(), "demo", WildfirePhaseExecute, WildfirePhaseExecute)
+	emitWildfirePhaseChanged(bus, "proj-1", t.TempDir(), "demo", WildfirePhaseRefine, WildfirePhaseGenerate)
+
+	select {
+	case got := <-ch:
+		if got.Kind != notify.KindWildfirePhase || got.PreviousPhase != "refine" || got.Phase != "generate" {
+			t.Errorf("got %+v, want refine → generate", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("notification not emitted within 2s")
+	}
+}
+
+// A non-task session's spend counts toward budgets, so capturing it
+// checks thresholds just like a task capture does.
+func TestCaptureSessionMetricsChecksBudget(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	p := models.NewProject("proj-chat", "chatty", dir)
+	if err := config.SaveProject(dir, p); err != nil {
+		t.Fatal(err)
+	}
+	index := models.NewProjectsIndex()
+	index.AddProject(models.ProjectEntry{ProjectID: p.ProjectID, Name: p.Name, Path: dir})
+	if err := config.SaveProjectsIndex(index); err != nil {
+		t.Fatal(err)
+	}
+	settings := models.NewSettings()
+	settings.Budget = &models.BudgetConfig{MonthlyUSD: 5, Thresholds: []int{50}}
+	settings.Pricing = &models.PricingConfig{Prices: []models.TokenPrice{{Backend: "claude-code", InputPerMTok: 3, OutputPerMTok: 15}}}
+	if err := config.SaveSettings(settings); err != nil {
+		t.Fatal(err)
+	}
+
+	started := time.Now()
+	entry, err := config.WriteLog(p.ProjectID, 0, 1, "claude-code", "chat", "completed", started, []string{"hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WriteSessionEvents(p.ProjectID, entry.LogID, []models.SessionEvent{
+		{Type: models.SessionEventTokenUsage, TokensIn: 1_000_000},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	bus := notify.NewBus()
+	ch, unsub := bus.Subscribe()
+	defer unsub()
+	captureSessionMetrics(bus, metrics.SessionInfo{
+		ProjectID: p.ProjectID,
+		LogID:     entry.LogID,
+		Agent:     "claude-code",
+		Mode:      "chat",
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+	})
+	select {
+	case n := <-ch:
+		if n.Budget == nil || n.Budget.Threshold != 50 {
+			t.Fatalf("notification = %+v, want the 50%% budget alert", n)
+		}
+	default:
+		t.Fatal("no budget alert after a $3 session against a $5 budget")
+	}
+}
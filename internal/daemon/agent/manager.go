@@ -2,6 +2,7 @@
 package agent
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,11 +12,19 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent/backend"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent/prompts"
+	"github.com/watchfire-io/watchfire/internal/daemon/budget"
+	"github.com/watchfire-io/watchfire/internal/daemon/insights"
+	"github.com/watchfire-io/watchfire/internal/daemon/metrics"
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	"github.com/watchfire-io/watchfire/internal/daemon/task"
+	"github.com/watchfire-io/watchfire/internal/daemon/tracing"
+	"github.com/watchfire-io/watchfire/internal/logsearch"
 	"github.com/watchfire-io/watchfire/internal/models"
 )
 
@@ -73,6 +82,20 @@ type RunningAgent struct {
 	RunStartedAt time.Time
 	Process      *Process
 	userStopped  bool // set by StopAgentByUser to prevent chaining in wildfire/start-all
+
+	runCtx      context.Context // holds the run span every session of the run hangs off
+	sessionSpan trace.Span      // covers the agent process, spawn to exit
+
+	// abortApproval ends a merge-approval wait in the exited agent's
+	// task-done handling, so a stop or a new start isn't held up by it.
+	// Set by monitorProcess under m.mu while that wait can run.
+	abortApproval context.CancelFunc
+
+	// OverrideBudget is forwarded to every chained session of the run, so
+	// a run started past a spent budget isn't stopped at its next task.
+	OverrideBudget bool
+	// StartedBy is who started the run; forwarded the same way.
+	StartedBy string
 }
 
 // StartOptions contains options for starting an agent.
@@ -91,8 +114,22 @@ type StartOptions struct {
 	Cols             int
 	Sandbox          string    // "auto" | "seatbelt" | "landlock" | "bwrap" | "none"
 	RunStartedAt     time.Time // Set by the chain-restart path so the next agent inherits the run-window anchor; zero on a fresh run.
+	// OverrideBudget starts a non-chat agent even when a hard-stop
+	// monthly budget is spent (see internal/daemon/budget).
+	OverrideBudget bool
+	// StartedBy is the identity of whoever started the run (see
+	// models.Task.StartedBy); chained sessions inherit it.
+	StartedBy string
+
+	// runCtx carries the run's trace span from one chained session to the
+	// next; nil on a fresh run, which opens its own.
+	runCtx context.Context
 }
 
+// checkBudget is the budget hard-stop gate; a variable so tests can
+// refuse or allow starts without writing metrics.
+var checkBudget = budget.Check
+
 // ErrAgentBusy is returned when a chat-mode start is refused because it would
 // displace a non-chat agent (a task run, wildfire/start-all, or a one-shot
 // generate/retrofit session). Clients auto-start chat whenever they observe
@@ -138,9 +175,9 @@ type Manager struct {
 	replacing      map[string]bool          // keyed by ProjectID — true while StartAgent is killing a running agent to replace it (v10.0.4)
 	onChangeFn     func()                   // called when agent state changes (for tray updates)
 	nextTaskFn     func(projectID, projectPath string, mode Mode, phase WildfirePhase, rows, cols int) (*StartOptions, error)
-	onTaskDoneFn   func(projectPath string, taskNumber int, worktreePath string) TaskDoneResult // v5.0 — structured outcome; chain advances iff TaskDoneOK
-	watchProjectFn func(projectID, projectPath string)                                          // called to ensure project watcher is active
-	notifyBus      *notify.Bus                                                                  // optional; nil disables in-process fan-out (headless log file is still written)
+	onTaskDoneFn   func(ctx context.Context, projectPath string, taskNumber int, worktreePath string) TaskDoneResult // v5.0 — structured outcome; chain advances iff TaskDoneOK
+	watchProjectFn func(projectID, projectPath string)                                                               // called to ensure project watcher is active
+	notifyBus      *notify.Bus                                                                                       // optional; nil disables in-process fan-out (headless log file is still written)
 	// preflightIssues holds issues detected before any Process exists (e.g.
 	// sandbox_denied at StartAgent preflight, #17). The regular issue
 	// plumbing hangs off a running Process, so these ride AgentStatus.issue
@@ -209,10 +246,10 @@ func (m *Manager) SetNextTaskFn(fn func(projectID, projectPath string, mode Mode
 // SetOnTaskDoneFn sets a callback invoked after an agent exits for a task.
 // Used for git merge + worktree cleanup. The returned TaskDoneResult.Outcome
 // drives chain control (only TaskDoneOK advances the run-all / wildfire
-// queue); TaskDoneMergeFailed additionally surfaces a TASK_FAILED-shaped
-// notification through emitTaskDoneFailure so a silent halt is no longer
+// queue); TaskDoneMergeFailed additionally surfaces a MERGE_FAILED
+// notification through emitMergeFailed so a silent halt is no longer
 // possible (v5.0 spec — "Run-all does not silently halt on a merge failure").
-func (m *Manager) SetOnTaskDoneFn(fn func(projectPath string, taskNumber int, worktreePath string) TaskDoneResult) {
+func (m *Manager) SetOnTaskDoneFn(fn func(ctx context.Context, projectPath string, taskNumber int, worktreePath string) TaskDoneResult) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.onTaskDoneFn = fn
@@ -238,7 +275,42 @@ func (m *Manager) SetNotifyBus(b *notify.Bus) {
 
 // StartAgent starts an agent for the given project.
 // If an agent is already running for this project, it is stopped first.
+//
+// Every autonomous run (anything but chat) is traced as one
+// `watchfire.run` span; each StartAgent adds an `agent.start` span under
+// it, and the chain forwards the run to the next session.
 func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
+	runCtx, ownsRun := opts.runCtx, false
+	if runCtx == nil {
+		runCtx = context.Background()
+		if opts.Mode != ModeChat {
+			runCtx, _ = tracing.Start(runCtx, "watchfire.run",
+				tracing.Project(opts.ProjectID), tracing.Mode(string(opts.Mode)))
+			ownsRun = true
+		}
+	}
+	ctx, span := tracing.Start(runCtx, "agent.start", startAttrs(opts)...)
+	ra, err := m.startAgent(ctx, runCtx, opts)
+	tracing.End(span, err)
+	if err != nil && ownsRun {
+		tracing.End(trace.SpanFromContext(runCtx), err)
+	}
+	return ra, err
+}
+
+// startAttrs are the span attributes known before the backend resolves.
+func startAttrs(opts StartOptions) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{tracing.Project(opts.ProjectID), tracing.Mode(string(opts.Mode))}
+	if opts.TaskNumber > 0 {
+		attrs = append(attrs, tracing.Task(opts.TaskNumber))
+	}
+	if opts.WildfirePhase != "" {
+		attrs = append(attrs, tracing.Phase(string(opts.WildfirePhase)))
+	}
+	return attrs
+}
+
+func (m *Manager) startAgent(ctx, runCtx context.Context, opts StartOptions) (*RunningAgent, error) {
 	// Sandbox preflight (#17): refuse to start inside a denied root (e.g.
 	// ~/Desktop) with the actionable message, BEFORE any PTY is spawned —
 	// otherwise the agent dies opaquely inside the sandbox. The refusal is
@@ -259,6 +331,17 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 	}
 	m.setPreflightIssue(opts.ProjectID, nil)
 
+	// Budget hard stop: refuse anything but chat once a hard-stop monthly
+	// budget covering the project is spent. Spend only moves when a
+	// task's metrics are captured, which lands a few seconds after the
+	// task ends, so a chained run can start one task past the limit.
+	if opts.Mode != ModeChat && !opts.OverrideBudget {
+		if err := checkBudget(opts.ProjectID, time.Now()); err != nil {
+			config.ProjectLogf(opts.ProjectID, "[agent] Start refused: %v", err)
+			return nil, err
+		}
+	}
+
 	m.mu.Lock()
 
 	// A chat start never displaces a working agent or a chain mid-transition
@@ -286,6 +369,7 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 		// re-acquired lock, which is then held through registration, so no
 		// chat start can interleave after that point either.
 		m.replacing[opts.ProjectID] = true
+		existing.stopApprovalWait()
 		m.mu.Unlock() // release lock — monitorProcess needs it for cleanup
 
 		proc.Stop() // blocking: sends SIGTERM, waits for exit
@@ -352,6 +436,7 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 		m.mu.Unlock()
 		return nil, err
 	}
+	trace.SpanFromContext(ctx).SetAttributes(tracing.Backend(be.Name()))
 
 	// Resolve agent binary path via the backend.
 	agentPath, err := be.ResolveExecutable(settings)
@@ -375,7 +460,9 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 		}
 
 		// 1. Create git worktree
+		_, wtSpan := tracing.Start(ctx, "agent.worktree", tracing.Project(opts.ProjectID), tracing.Task(opts.TaskNumber))
 		wt, wtErr := EnsureWorktree(opts.ProjectPath, opts.TaskNumber)
+		tracing.End(wtSpan, wtErr)
 		if wtErr != nil {
 			m.mu.Unlock()
 			return nil, fmt.Errorf("failed to create worktree: %w", wtErr)
@@ -430,7 +517,10 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 	// Deliver the composed system prompt via the backend. For Claude this
 	// is a no-op (the prompt rides the CLI flag); for Codex this materialises
 	// a per-session CODEX_HOME with AGENTS.md plus auth symlinks.
-	if err := installSystemPrompt(be, workDir, sessionName, composedPrompt); err != nil {
+	_, promptSpan := tracing.Start(ctx, "agent.prompt_install", tracing.Project(opts.ProjectID), tracing.Backend(be.Name()))
+	err = installSystemPrompt(be, workDir, sessionName, composedPrompt)
+	tracing.End(promptSpan, err)
+	if err != nil {
 		m.mu.Unlock()
 		return nil, fmt.Errorf("failed to install system prompt: %w", err)
 	}
@@ -474,17 +564,33 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 	// env without losing sandbox-driven changes (StripEnv, TERM, COLORTERM).
 	cmd.Env = mergeBackendEnv(cmd.Env, built.Env)
 
+	// Record the session as asciicast unless the user turned it off.
+	// The file starts under a pending name and is renamed to <logID>.cast
+	// by writeSessionLog once the log ID exists.
+	var recordingPath string
+	if settings != nil && settings.Recordings.Enabled {
+		if pending, recErr := config.NewPendingRecordingPath(opts.ProjectID); recErr == nil {
+			recordingPath = pending
+		} else {
+			config.ProjectLogf(opts.ProjectID, "[recording] disabled for this session: %v", recErr)
+		}
+	}
+
 	// Start in PTY
 	proc, err := NewProcess(ProcessOptions{
-		ProjectID:   opts.ProjectID,
-		Cmd:         cmd,
-		Rows:        opts.Rows,
-		Cols:        opts.Cols,
-		SandboxTmp:  sandboxTmp,
-		BackendName: be.Name(),
+		ProjectID:     opts.ProjectID,
+		Cmd:           cmd,
+		Rows:          opts.Rows,
+		Cols:          opts.Cols,
+		SandboxTmp:    sandboxTmp,
+		BackendName:   be.Name(),
+		RecordingPath: recordingPath,
 	})
 	if err != nil {
 		_ = os.Remove(sandboxTmp)
+		if recordingPath != "" {
+			_ = os.Remove(recordingPath)
+		}
 		m.mu.Unlock()
 		return nil, fmt.Errorf("failed to start agent process: %w", err)
 	}
@@ -496,6 +602,9 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 	// it (the stranded-ready-task / silent-drop-to-chat bug).
 	if isTaskScoped && taskModel != nil {
 		taskModel.Start() // increments AgentSessions, sets StartedAt
+		if opts.StartedBy != "" {
+			taskModel.StartedBy = opts.StartedBy
+		}
 		if taskModel.Status == models.TaskStatusDraft {
 			taskModel.Status = models.TaskStatusReady
 		}
@@ -511,6 +620,8 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 	if runStartedAt.IsZero() {
 		runStartedAt = startedAt
 	}
+	_, sessionSpan := tracing.Start(runCtx, "agent.session",
+		append(startAttrs(opts), tracing.Backend(be.Name()))...)
 
 	ra := &RunningAgent{
 		ProjectID:     opts.ProjectID,
@@ -527,6 +638,11 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 		StartedAt:     startedAt,
 		RunStartedAt:  runStartedAt,
 		Process:       proc,
+		runCtx:        runCtx,
+		sessionSpan:   sessionSpan,
+
+		OverrideBudget: opts.OverrideBudget,
+		StartedBy:      opts.StartedBy,
 	}
 
 	m.agents[opts.ProjectID] = ra
@@ -537,6 +653,9 @@ func (m *Manager) StartAgent(opts StartOptions) (*RunningAgent, error) {
 	// Monitor process in background
 	go m.monitorProcess(opts.ProjectID, proc)
 
+	// Tell the user when the agent stalls on an auth error or rate limit.
+	go m.watchIssues(opts, proc)
+
 	// Poll task status as a safety net for missed watcher events
 	if opts.TaskNumber > 0 {
 		go m.pollTaskStatus(opts.ProjectID, opts.ProjectPath, opts.TaskNumber, proc)
@@ -565,10 +684,29 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 	}
 
 	config.ProjectLogf(projectID, "[agent] exited (mode: %s)", ag.Mode)
+	if ag.sessionSpan != nil {
+		ag.sessionSpan.End()
+	}
+	// The run span ends here unless the run is handed to a chained
+	// session below.
+	runCtx := ag.runCtx
+	if runCtx == nil {
+		runCtx = context.Background()
+	}
+	runChained := false
+	defer func() {
+		if !runChained {
+			trace.SpanFromContext(runCtx).End()
+		}
+	}()
 
 	// Persist scrollback to log file
 	m.writeSessionLog(ag, proc)
 
+	// A generation session announces the tasks it wrote once the lock is
+	// released (every return path below unlocks first).
+	defer emitTasksGenerated(m.notifyBus, projectID, ag.ProjectPath, ag.ProjectName, ag.Mode, ag.WildfirePhase, ag.StartedAt)
+
 	// Session over and transcript exported — remove the per-session agent
 	// home scratch dir under ~/.watchfire/<agent>-home/ (#47).
 	cleanupSessionHome(ag.ProjectID, ag.BackendName, ag.SessionName)
@@ -577,8 +715,8 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 	// v5.0 — onTaskDoneFn now returns a structured TaskDoneResult so
 	// monitorProcess can distinguish a clean merge from a silent-halting
 	// merge failure. TaskDoneMergeFailed still halts the chain (the user
-	// must clean up `main` manually) but additionally fires a TASK_FAILED
-	// notification through emitTaskDoneFailure — without that the dashboard
+	// must clean up `main` manually) but additionally fires a MERGE_FAILED
+	// notification through emitMergeFailed — without that the dashboard
 	// would have no signal that the run-all queue stopped.
 	taskDoneResult := TaskDoneResult{Outcome: TaskDoneOK}
 	if ag.TaskNumber > 0 && m.onTaskDoneFn != nil {
@@ -587,8 +725,18 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 		taskNum := ag.TaskNumber
 		projPath := ag.ProjectPath
 		wtPath := ag.WorktreePath
+		abortCtx, abort := context.WithCancel(context.Background())
+		ag.abortApproval = abort
 		m.mu.Unlock()
-		taskDoneResult = taskDoneFn(projPath, taskNum, wtPath)
+		doneCtx, doneSpan := tracing.Start(runCtx, "task.done",
+			tracing.Project(projectID), tracing.Task(taskNum), tracing.Backend(ag.BackendName))
+		taskDoneResult = taskDoneFn(withApprovalAbort(doneCtx, abortCtx), projPath, taskNum, wtPath)
+		abort()
+		var doneErr error
+		if taskDoneResult.Outcome == TaskDoneMergeFailed {
+			doneErr = errors.New(taskDoneResult.Reason)
+		}
+		tracing.End(doneSpan, doneErr)
 		config.ProjectLogf(projectID, "[chain] onTaskDoneFn returned outcome=%v reason=%q for task #%04d", taskDoneResult.Outcome, taskDoneResult.Reason, taskNum)
 		m.mu.Lock()
 		// Re-check agent is still ours after releasing lock
@@ -599,14 +747,14 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 		}
 	}
 
-	// Surface a merge-failure as a TASK_FAILED-shaped notification so the
+	// Surface a merge-failure as a MERGE_FAILED notification so the
 	// run-all halt is never silent. The v4.0 Beacon dashboard "needs
-	// attention" chip already reacts to TASK_FAILED notifications fanned
-	// out via the Pulse bus; adding the chip's task-state coupling
-	// (mergeFailureReason as an additional flag in hasFailedTask) is the
-	// indirect-coupling story documented in lib/dashboard-filters.ts.
+	// attention" chip reacts to it like TASK_FAILED in the Pulse bus;
+	// adding the chip's task-state coupling (mergeFailureReason as an
+	// additional flag in hasFailedTask) is the indirect-coupling story
+	// documented in lib/dashboard-filters.ts.
 	if taskDoneResult.Outcome == TaskDoneMergeFailed {
-		emitTaskDoneFailure(m.notifyBus, ag.ProjectID, ag.ProjectPath, ag.ProjectName, ag.TaskNumber, taskDoneResult.Reason)
+		emitMergeFailed(m.notifyBus, ag.ProjectID, ag.ProjectPath, ag.ProjectName, ag.TaskNumber, taskDoneResult.Reason)
 	}
 
 	// Before chaining, check if there's an active issue (auth error, rate limit)
@@ -628,6 +776,8 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 		projectColor := ag.ProjectColor
 		prevTaskNumber := ag.TaskNumber
 		runStartedAt := ag.RunStartedAt
+		overrideBudget := ag.OverrideBudget
+		startedBy := ag.StartedBy
 		bus := m.notifyBus
 		rows, cols := proc.TerminalSize()
 
@@ -646,7 +796,9 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 		m.mu.Unlock()
 		defer m.setChaining(projectID, false)
 
+		_, nextSpan := tracing.Start(runCtx, "chain.next_task", tracing.Project(projectID), tracing.Mode(string(agentMode)))
 		nextOpts, err := m.nextTaskFn(projectID, projectPath, agentMode, agentPhase, rows, cols)
+		tracing.End(nextSpan, err)
 		if err != nil {
 			config.ProjectLogf(projectID, "[chain] %s: error finding next task: %v", agentMode, err)
 			emitRunComplete(bus, projectID, projectName, projectPath, agentMode, runStartedAt)
@@ -680,6 +832,7 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 						Mode:         ModeChat,
 						Rows:         rows,
 						Cols:         cols,
+						StartedBy:    startedBy,
 					}
 					m.setChaining(projectID, false)
 					if _, err := m.StartAgent(chatOpts); err != nil {
@@ -696,8 +849,15 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 			}
 
 			// Forward the run-window anchor so the next chained agent
-			// keeps the same RunStartedAt as the first one in this run.
+			// keeps the same RunStartedAt as the first one in this run,
+			// and the run span with it — unless the run is handing over
+			// to chat (wildfire completion), which ends it.
 			nextOpts.RunStartedAt = runStartedAt
+			nextOpts.OverrideBudget = overrideBudget
+			nextOpts.StartedBy = startedBy
+			if nextOpts.Mode != ModeChat {
+				nextOpts.runCtx = runCtx
+			}
 			config.ProjectLogf(projectID, "[chain] %s: starting next — mode=%s phase=%s task=#%04d", agentMode, nextOpts.Mode, nextOpts.WildfirePhase, nextOpts.TaskNumber)
 			// Clear the chaining mark just before handing off: nextOpts may
 			// itself be a chat session (wildfire completion), which the chat
@@ -706,14 +866,21 @@ func (m *Manager) monitorProcess(projectID string, proc *Process) {
 			// (then the gate refuses it) or wins and is deliberately replaced
 			// by it — the run survives both orders.
 			m.setChaining(projectID, false)
-			if _, err := m.StartAgent(*nextOpts); err != nil {
+			_, err = m.StartAgent(*nextOpts)
+			runChained = err == nil && nextOpts.runCtx != nil
+			if err == nil && agentMode == ModeWildfire && nextOpts.Mode == ModeWildfire {
+				emitWildfirePhaseChanged(bus, projectID, projectPath, projectName, agentPhase, nextOpts.WildfirePhase)
+			}
+			if err != nil {
 				config.ProjectLogf(projectID, "[chain] %s: failed to start next (task #%04d): %v", agentMode, nextOpts.TaskNumber, err)
 				// Surface the halt instead of dropping silently to idle. A
 				// launch failure here previously left the chain dead with a
 				// task stamped "started" and no agent behind it; emit a
 				// TASK_FAILED-shaped notification when a task was involved so
 				// the dashboard/notifications reflect the stalled run.
-				if nextOpts.TaskNumber > 0 {
+				// A spent budget isn't the task's fault; the threshold
+				// notification already told the user the run is paused.
+				if nextOpts.TaskNumber > 0 && !errors.Is(err, budget.ErrExceeded) {
 					emitTaskDoneFailure(bus, projectID, projectPath, projectName, nextOpts.TaskNumber, fmt.Sprintf("failed to start agent: %v", err))
 				}
 				emitRunComplete(bus, projectID, projectName, projectPath, agentMode, runStartedAt)
@@ -805,6 +972,9 @@ func (m *Manager) pollSignalFile(projectID, projectPath string, phase WildfirePh
 func (m *Manager) StopAgent(projectID string) error {
 	m.mu.Lock()
 	agent, ok := m.agents[projectID]
+	if ok {
+		agent.stopApprovalWait()
+	}
 	m.mu.Unlock()
 
 	if !ok {
@@ -826,6 +996,7 @@ func (m *Manager) StopAgentByUser(projectID string) error {
 		return fmt.Errorf("no agent running for project: %s", projectID)
 	}
 	ag.userStopped = true
+	ag.stopApprovalWait()
 	m.mu.Unlock()
 
 	ag.Process.Stop()
@@ -847,18 +1018,29 @@ func (m *Manager) StopAgentForTask(projectID string, taskNumber int) error {
 		return fmt.Errorf("agent working on task #%04d, not #%04d", ag.TaskNumber, taskNumber)
 	}
 	proc := ag.Process
+	ag.stopApprovalWait()
 	m.mu.Unlock()
 
 	proc.Stop()
 	return nil
 }
 
+// stopApprovalWait cancels a merge-approval wait still holding the
+// agent's slot after its process exited. The task keeps its approval
+// hold and unmerged branch. Caller holds m.mu.
+func (ra *RunningAgent) stopApprovalWait() {
+	if ra.abortApproval != nil {
+		ra.abortApproval()
+	}
+}
+
 // StopAll stops all running agents. Used during daemon shutdown.
 func (m *Manager) StopAll() {
 	m.mu.Lock()
 	agents := make([]*RunningAgent, 0, len(m.agents))
 	for _, a := range m.agents {
 		a.userStopped = true
+		a.stopApprovalWait()
 		agents = append(agents, a)
 	}
 	m.mu.Unlock()
@@ -901,6 +1083,33 @@ func (m *Manager) ActiveCount() int {
 // writeSessionLog persists the agent's scrollback buffer to a log file.
 // Called from monitorProcess while holding m.mu.
 func (m *Manager) writeSessionLog(ag *RunningAgent, proc *Process) {
+	var logID string
+	defer func() {
+		// Off the manager lock: saving the recording and the retention
+		// sweep touch the filesystem and must not stall agent RPCs.
+		go finishRecording(ag.ProjectID, proc.RecordingPath(), logID)
+		if logID != "" {
+			// Off the manager lock: indexing reads the whole rendered
+			// transcript back.
+			go indexSessionLog(ag.ProjectID, logID)
+			if ag.TaskNumber == 0 {
+				// Task sessions are measured by the task watcher;
+				// everything else is insights "overhead".
+				go captureSessionMetrics(m.notifyBus, metrics.SessionInfo{
+					ProjectID:   ag.ProjectID,
+					ProjectPath: ag.ProjectPath,
+					LogID:       logID,
+					Agent:       ag.BackendName,
+					Mode:        string(ag.Mode),
+					Phase:       string(ag.WildfirePhase),
+					StartedAt:   proc.StartedAt(),
+					EndedAt:     time.Now(),
+					StartedBy:   ag.StartedBy,
+				})
+			}
+		}
+	}()
+
 	scrollback := proc.GetFullScrollback()
 	if len(scrollback) == 0 {
 		return
@@ -943,6 +1152,7 @@ func (m *Manager) writeSessionLog(ag *RunningAgent, proc *Process) {
 		return
 	}
 	config.ProjectLogf(ag.ProjectID, "[session-log] written: %s", entry.LogID)
+	logID = entry.LogID
 
 	// Try to find and copy the agent's JSONL transcript
 	workDir := ag.WorktreePath
@@ -964,10 +1174,83 @@ func (m *Manager) writeSessionLog(ag *RunningAgent, proc *Process) {
 			} else {
 				config.ProjectLogf(ag.ProjectID, "[session-log] Transcript copied: %s.jsonl", entry.LogID)
 			}
+			writeSessionEvents(ag.ProjectID, entry.LogID, be, transcriptPath)
 		}
 	}
 }
 
+// writeSessionEvents stores the normalized event log for a session when
+// its backend knows how to extract one. Failures only cost the event
+// log; the rendered transcript is already on disk.
+func writeSessionEvents(projectID, logID string, be backend.Backend, transcriptPath string) {
+	ex, ok := be.(backend.EventExtractor)
+	if !ok {
+		return
+	}
+	events, err := ex.ExtractEvents(transcriptPath)
+	if err != nil {
+		config.ProjectLogf(projectID, "[session-log] Failed to extract events for %s: %v", logID, err)
+	}
+	if len(events) == 0 {
+		return
+	}
+	if err := config.WriteSessionEvents(projectID, logID, events); err != nil {
+		config.ProjectLogf(projectID, "[session-log] Failed to write event log for %s: %v", logID, err)
+		return
+	}
+	config.ProjectLogf(projectID, "[session-log] Event log written: %s (%d events)", logID, len(events))
+}
+
+// finishRecording files a session's pending recording under its log ID
+// and applies recording retention. A session that produced no log (empty
+// scrollback, write failure) has nothing to replay alongside, so its
+// recording is dropped.
+func finishRecording(projectID, pendingPath, logID string) {
+	if pendingPath == "" {
+		return
+	}
+	if logID == "" {
+		_ = os.Remove(pendingPath)
+		return
+	}
+	if err := config.SaveRecording(projectID, logID, pendingPath); err != nil {
+		config.ProjectLogf(projectID, "[recording] Failed to save recording: %v", err)
+		_ = os.Remove(pendingPath)
+		return
+	}
+	config.ProjectLogf(projectID, "[recording] saved: %s.cast", logID)
+
+	settings, err := config.LoadSettings()
+	if err != nil {
+		return
+	}
+	if n, err := config.PruneRecordings(projectID, *settings.Recordings, time.Now()); err != nil {
+		config.ProjectLogf(projectID, "[recording] Retention sweep failed: %v", err)
+	} else if n > 0 {
+		config.ProjectLogf(projectID, "[recording] Retention removed %d recording(s)", n)
+	}
+}
+
+// captureSessionMetrics records a non-task session for the insights
+// overhead rollup. The logs directory isn't watched, so the project's
+// cached rollups are dropped here for the session to show up. The
+// session's spend counts toward budgets, so thresholds are checked too.
+func captureSessionMetrics(bus *notify.Bus, info metrics.SessionInfo) {
+	if metrics.CaptureSession(info) != nil {
+		insights.InvalidateProjectCache(info.ProjectID)
+		budget.CheckThresholds(bus, time.Now())
+	}
+}
+
+// indexSessionLog adds a freshly written session log to the full-text
+// search index. Failures only cost freshness: the next search reconciles
+// the index with the logs directory anyway.
+func indexSessionLog(projectID, logID string) {
+	if err := logsearch.IndexLog(projectID, logID); err != nil {
+		config.ProjectLogf(projectID, "[logsearch] Failed to index %s: %v", logID, err)
+	}
+}
+
 // persistStateLocked writes the current agent state to ~/.watchfire/agents.yaml.
 // Must be called while holding m.mu.
 func (m *Manager) persistStateLocked() {
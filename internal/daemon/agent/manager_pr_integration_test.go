@@ -38,6 +38,7 @@ type taskDoneFixture struct {
 	githubScopes  []string
 	taskCompleted *time.Time
 	savedTasks    []*models.Task // captured snapshots of every SaveTask call
+	approval      string         // project.yaml `approval:` value
 }
 
 func (f *taskDoneFixture) fns() taskDoneFns {
@@ -56,6 +57,7 @@ func (f *taskDoneFixture) fns() taskDoneFns {
 				DefaultAgent:     "claude-code",
 				AutoMerge:        true,
 				AutoDeleteBranch: true,
+				Approval:         f.approval,
 			}, nil
 		},
 		LoadTask: func(string, int) (*models.Task, error) {
@@ -134,7 +136,7 @@ func TestHandleTaskDoneAutoPRHappyPath(t *testing.T) {
 	resetGHFallbackWarnedForTest()
 	f := &taskDoneFixture{autoPREnabled: true}
 
-	cont := handleTaskDoneWith(f.fns(), "/proj", 42, "/wt", nil)
+	cont := handleTaskDoneWith(context.Background(), f.fns(), "/proj", 42, "/wt", nil)
 	if !cont.ShouldContinueChain() {
 		t.Errorf("handleTaskDoneWith returned outcome=%v, want TaskDoneOK", cont.Outcome)
 	}
@@ -157,8 +159,11 @@ func TestHandleTaskDoneAutoPRHappyPath(t *testing.T) {
 		t.Fatalf("expected 1 notification, got %d", len(f.notifications))
 	}
 	got := f.notifications[0]
-	if got.Kind != notify.KindRunComplete {
-		t.Errorf("notification kind = %s, want RUN_COMPLETE", got.Kind)
+	if got.Kind != notify.KindPROpened {
+		t.Errorf("notification kind = %s, want PR_OPENED", got.Kind)
+	}
+	if got.URL != "https://github.com/owner/repo/pull/42" {
+		t.Errorf("notification URL = %q", got.URL)
 	}
 	if !strings.Contains(got.Body, "https://github.com/owner/repo/pull/42") {
 		t.Errorf("notification body missing PR URL: %q", got.Body)
@@ -191,7 +196,7 @@ func TestHandleTaskDoneAutoPRGHUnavailableFallsBack(t *testing.T) {
 		mergeChanged:  true,
 	}
 
-	cont := handleTaskDoneWith(f.fns(), "/proj", 42, "/wt", nil)
+	cont := handleTaskDoneWith(context.Background(), f.fns(), "/proj", 42, "/wt", nil)
 	if !cont.ShouldContinueChain() {
 		t.Errorf("returned outcome=%v, want TaskDoneOK (silent-merge fallback succeeded)", cont.Outcome)
 	}
@@ -221,7 +226,7 @@ func TestHandleTaskDoneAutoPRGHUnavailableFallsBack(t *testing.T) {
 		openPRErr:     errors.Join(gitpkg.ErrGHUnavailable, errors.New("gh: command not found")),
 		mergeChanged:  true,
 	}
-	_ = handleTaskDoneWith(f2.fns(), "/proj", 43, "/wt2", nil)
+	_ = handleTaskDoneWith(context.Background(), f2.fns(), "/proj", 43, "/wt2", nil)
 	if strings.Contains(logBuf.String(), "github auto-PR enabled but gh CLI unavailable") {
 		t.Errorf("second invocation re-emitted the WARN; should be deduped per project lifetime\n--- log ---\n%s", logBuf.String())
 	}
@@ -233,7 +238,7 @@ func TestHandleTaskDoneAutoPRDisabledUnchanged(t *testing.T) {
 	resetGHFallbackWarnedForTest()
 	f := &taskDoneFixture{autoPREnabled: false, mergeChanged: true}
 
-	cont := handleTaskDoneWith(f.fns(), "/proj", 42, "/wt", nil)
+	cont := handleTaskDoneWith(context.Background(), f.fns(), "/proj", 42, "/wt", nil)
 	if !cont.ShouldContinueChain() {
 		t.Errorf("returned outcome=%v, want TaskDoneOK", cont.Outcome)
 	}
@@ -263,7 +268,7 @@ func TestHandleTaskDoneAutoPRPushFailureFallsBack(t *testing.T) {
 		openPRErr:     errors.New("git push failed: remote rejected"),
 		mergeChanged:  true,
 	}
-	cont := handleTaskDoneWith(f.fns(), "/proj", 42, "/wt", nil)
+	cont := handleTaskDoneWith(context.Background(), f.fns(), "/proj", 42, "/wt", nil)
 	if !cont.ShouldContinueChain() {
 		t.Errorf("returned outcome=%v, want TaskDoneOK (fallback merge succeeded)", cont.Outcome)
 	}
@@ -289,7 +294,7 @@ func TestHandleTaskDoneSilentMergeFailurePopulatesField(t *testing.T) {
 		mergeErr:      errors.New("merge failed: CONFLICT in foo.go"),
 	}
 
-	cont := handleTaskDoneWith(f.fns(), "/proj", 42, "/wt", nil)
+	cont := handleTaskDoneWith(context.Background(), f.fns(), "/proj", 42, "/wt", nil)
 	if cont.Outcome != TaskDoneMergeFailed {
 		t.Errorf("returned outcome=%v, want TaskDoneMergeFailed", cont.Outcome)
 	}
@@ -368,7 +373,7 @@ func TestHandleTaskDoneAutoPRRespectsProjectScopes(t *testing.T) {
 		githubScopes:  []string{"some-other-project"},
 		mergeChanged:  true,
 	}
-	cont := handleTaskDoneWith(f.fns(), "/proj", 42, "/wt", nil)
+	cont := handleTaskDoneWith(context.Background(), f.fns(), "/proj", 42, "/wt", nil)
 	if !cont.ShouldContinueChain() {
 		t.Errorf("returned outcome=%v, want TaskDoneOK", cont.Outcome)
 	}
@@ -379,3 +384,27 @@ func TestHandleTaskDoneAutoPRRespectsProjectScopes(t *testing.T) {
 		t.Errorf("MergeWorktree called %d times, want 1", f.mergeCalled)
 	}
 }
+
+// TestEmitMergeFailedCarriesDetail — the auto-merge failure path emits
+// MERGE_FAILED with the merge error in Detail so relay templates can
+// quote it.
+func TestEmitMergeFailedCarriesDetail(t *testing.T) {
+	bus := notify.NewBus()
+	ch, cancel := bus.Subscribe()
+	defer cancel()
+	t.Setenv("HOME", t.TempDir())
+
+	emitMergeFailed(bus, "proj-1", t.TempDir(), "demo", 42, "merge failed: CONFLICT in foo.go")
+
+	select {
+	case got := <-ch:
+		if got.Kind != notify.KindMergeFailed {
+			t.Errorf("notification kind=%s, want MERGE_FAILED", got.Kind)
+		}
+		if got.Detail != "merge failed: CONFLICT in foo.go" {
+			t.Errorf("notification detail=%q", got.Detail)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("notification not emitted within 2s")
+	}
+}
@@ -32,6 +32,19 @@ import (
 // "is this task in a needs-attention state" predicate so the indicator
 // lights up regardless of whether a notification was delivered.
 func emitTaskDoneFailure(bus *notify.Bus, projectID, projectPath, projectName string, taskNumber int, reason string) {
+	emitTaskDoneIssue(bus, notify.KindTaskFailed, models.NotificationTaskFailed, projectID, projectPath, projectName, taskNumber, reason)
+}
+
+// emitMergeFailed is the MERGE_FAILED counterpart of emitTaskDoneFailure
+// for a post-task auto-merge that failed. The merge error rides in
+// Notification.Detail so relay templates can quote it; the event
+// inherits the task_failed toggle until the user sets merge_failed
+// explicitly, so existing preferences keep receiving it.
+func emitMergeFailed(bus *notify.Bus, projectID, projectPath, projectName string, taskNumber int, reason string) {
+	emitTaskDoneIssue(bus, notify.KindMergeFailed, models.NotificationMergeFailed, projectID, projectPath, projectName, taskNumber, reason)
+}
+
+func emitTaskDoneIssue(bus *notify.Bus, kind notify.Kind, prefKind models.NotificationKind, projectID, projectPath, projectName string, taskNumber int, reason string) {
 	if projectID == "" || taskNumber <= 0 {
 		return
 	}
@@ -45,7 +58,7 @@ func emitTaskDoneFailure(bus *notify.Bus, projectID, projectPath, projectName st
 	if proj, _ := config.LoadProject(projectPath); proj != nil {
 		projectNotif = proj.Notifications
 	}
-	if !models.ShouldNotify(models.NotificationTaskFailed, cfg, projectNotif, time.Now().Local()) {
+	if !models.ShouldNotify(prefKind, cfg, projectNotif, time.Now().Local()) {
 		return
 	}
 
@@ -60,14 +73,17 @@ func emitTaskDoneFailure(bus *notify.Bus, projectID, projectPath, projectName st
 	}
 
 	n := notify.Notification{
-		ID:         notify.MakeID(notify.KindTaskFailed, projectID, int32(taskNumber), emittedAt),
-		Kind:       notify.KindTaskFailed,
+		ID:         notify.MakeID(kind, projectID, int32(taskNumber), emittedAt),
+		Kind:       kind,
 		ProjectID:  projectID,
 		TaskNumber: int32(taskNumber),
 		Title:      title,
 		Body:       body,
 		EmittedAt:  emittedAt,
 	}
+	if kind == notify.KindMergeFailed {
+		n.Detail = body
+	}
 
 	if bus != nil {
 		bus.Emit(n)
@@ -13,8 +13,9 @@ import (
 	"github.com/creack/pty"
 	"github.com/hinshun/vt10x"
 
+	"github.com/watchfire-io/watchfire/internal/asciicast"
 	"github.com/watchfire-io/watchfire/internal/config"
-	"github.com/watchfire-io/watchfire/internal/vtansi"
+	"github.com/watchfire-io/watchfire/internal/daemon/prom"
 )
 
 // Process-level constants.
@@ -27,7 +28,9 @@ const (
 	issueAutoClearThreshold = 3
 )
 
-// ScreenUpdate represents a parsed terminal screen state.
+// ScreenUpdate represents a parsed terminal screen state. Keyframes carry
+// the whole screen in Lines / AnsiContent; delta frames carry only the
+// rows that changed since the frame numbered Seq-1, in RowDeltas.
 type ScreenUpdate struct {
 	ProjectID   string
 	Lines       []string
@@ -36,6 +39,9 @@ type ScreenUpdate struct {
 	Rows        int
 	Cols        int
 	AnsiContent string
+	Seq         uint64
+	Keyframe    bool
+	RowDeltas   []ScreenRowDelta
 }
 
 // ProcessOptions contains options for creating a new agent process.
@@ -46,6 +52,9 @@ type ProcessOptions struct {
 	Cols        int
 	SandboxTmp  string // temp .sb file to clean up on stop
 	BackendName string // agent backend running in this PTY (e.g. "claude-code")
+	// RecordingPath, when set, is where the raw PTY stream is recorded as
+	// an asciicast v2 file. Recording failures are logged, never fatal.
+	RecordingPath string
 }
 
 // Process manages a PTY + vt10x agent process.
@@ -63,7 +72,13 @@ type Process struct {
 
 	subMu      sync.RWMutex
 	rawSubs    map[string]chan []byte
-	screenSubs map[string]chan *ScreenUpdate
+	screenSubs map[string]*screenSub
+
+	// Coalesced screen frames (see screen.go). frameMu serialises frame
+	// rendering and guards frame plus every screenSub.resync flag.
+	screenKick chan struct{}
+	frameMu    sync.Mutex
+	frame      screenFrame
 
 	scrollMu   sync.RWMutex
 	scrollback []string
@@ -76,6 +91,11 @@ type Process struct {
 	rawBuf        []byte // Accumulated raw PTY output for late-join catch-up
 	rawTotalBytes int64  // Total bytes ever broadcast (monotonic). bufStart = rawTotalBytes - len(rawBuf)
 
+	// Session recording. Fed from broadcastRaw and closed by readLoop
+	// before done is closed, so the file is complete once Done() fires.
+	recording     *asciicast.Writer
+	recordingPath string
+
 	// Issue detection
 	issueMu        sync.RWMutex
 	issue          *AgentIssue
@@ -126,14 +146,30 @@ func NewProcess(opts ProcessOptions) (*Process, error) {
 		done:        make(chan struct{}),
 		sandboxTmp:  opts.SandboxTmp,
 		rawSubs:     make(map[string]chan []byte),
-		screenSubs:  make(map[string]chan *ScreenUpdate),
+		screenSubs:  make(map[string]*screenSub),
+		screenKick:  make(chan struct{}, 1),
 		scrollback:  make([]string, 0, scrollbackCapacity),
 		startedAt:   time.Now().UTC(),
 		issueSubs:   make(map[string]chan *AgentIssue),
 		backendName: opts.BackendName,
 	}
 
+	if opts.RecordingPath != "" {
+		rec, recErr := asciicast.Create(opts.RecordingPath, asciicast.Header{
+			Width:  cols,
+			Height: rows,
+			Env:    map[string]string{"TERM": "xterm-256color"},
+		}, p.startedAt)
+		if recErr != nil {
+			p.logf("recording disabled: %v", recErr)
+		} else {
+			p.recording = rec
+			p.recordingPath = opts.RecordingPath
+		}
+	}
+
 	go p.readLoop()
+	go p.screenLoop()
 
 	return p, nil
 }
@@ -153,12 +189,10 @@ func (p *Process) readLoop() {
 			// Broadcast raw data to CLI subscribers
 			p.broadcastRaw(data)
 
-			// Feed data to vt10x terminal emulator
+			// Feed data to vt10x terminal emulator; screenLoop turns
+			// the change into a coalesced frame for GUI subscribers
 			_, _ = p.vt.Write(data)
-
-			// Snapshot screen state and broadcast to GUI subscribers
-			screen := p.snapshotScreen()
-			p.broadcastScreen(screen)
+			p.markScreenDirty()
 
 			// Append to scrollback buffer
 			p.appendScrollback(data)
@@ -170,55 +204,32 @@ func (p *Process) readLoop() {
 
 	// Wait for process to finish
 	p.exitErr = p.cmd.Wait()
-	close(p.done)
-}
-
-// snapshotScreen reads the current vt10x state into a ScreenUpdate.
-func (p *Process) snapshotScreen() *ScreenUpdate {
-	p.mu.RLock()
-	rows := p.rows
-	cols := p.cols
-	p.mu.RUnlock()
-
-	lines := make([]string, rows)
-	for row := 0; row < rows; row++ {
-		var sb strings.Builder
-		for col := 0; col < cols; col++ {
-			g := p.vt.Cell(col, row)
-			if g.Char == 0 {
-				sb.WriteByte(' ')
-			} else {
-				sb.WriteRune(g.Char)
-			}
+	if p.recording != nil {
+		if err := p.recording.Close(); err != nil {
+			p.logf("recording close failed: %v", err)
 		}
-		lines[row] = sb.String()
 	}
+	close(p.done)
+}
 
-	cur := p.vt.Cursor()
-
+// SnapshotScreen returns the current screen state as a standalone
+// keyframe. It renders on demand and leaves the subscriber frame
+// sequence untouched, so it is safe for one-off readers (Telegram
+// /screen) that are not part of a SubscribeScreen stream.
+func (p *Process) SnapshotScreen() *ScreenUpdate {
+	f := p.renderFrame()
 	return &ScreenUpdate{
 		ProjectID:   p.projectID,
-		Lines:       lines,
-		CursorRow:   cur.Y,
-		CursorCol:   cur.X,
-		Rows:        rows,
-		Cols:        cols,
-		AnsiContent: p.renderScreenANSI(rows, cols),
+		Lines:       f.lines,
+		CursorRow:   f.cursorRow,
+		CursorCol:   f.cursorCol,
+		Rows:        f.rows,
+		Cols:        f.cols,
+		AnsiContent: strings.Join(f.ansi, "\r\n"),
+		Keyframe:    true,
 	}
 }
 
-// SnapshotScreen returns the current screen state (exported for initial snapshot on subscribe).
-func (p *Process) SnapshotScreen() *ScreenUpdate {
-	return p.snapshotScreen()
-}
-
-// renderScreenANSI emits the current vt10x grid as ANSI SGR-coloured
-// text. Delegates to the shared vtansi package so the TUI can use the
-// same renderer when driving its own emulator from the raw stream.
-func (p *Process) renderScreenANSI(rows, cols int) string {
-	return vtansi.RenderScreen(p.vt, rows, cols)
-}
-
 // SendInput writes data to the PTY (user input).
 func (p *Process) SendInput(data []byte) error {
 	_, err := p.ptyFile.Write(data)
@@ -240,6 +251,10 @@ func (p *Process) Resize(rows, cols int) error {
 	}
 
 	p.vt.Resize(cols, rows)
+	p.markScreenDirty()
+	if p.recording != nil {
+		_ = p.recording.Resize(time.Now(), cols, rows)
+	}
 	return nil
 }
 
@@ -337,14 +352,25 @@ func (p *Process) UnsubscribeRaw(id string) {
 	}
 }
 
-// SubscribeScreen creates a screen update subscription for the given subscriber ID.
-func (p *Process) SubscribeScreen(id string) chan *ScreenUpdate {
+// SubscribeScreen creates a screen update subscription for the given
+// subscriber ID and returns the keyframe the subscriber starts from.
+// The keyframe is rendered fresh — pending changes are flushed to
+// existing subscribers first — so the first delta on ch (Seq =
+// keyframe.Seq+1) applies cleanly on top of it. With deltas=false every
+// update on ch is a keyframe, which is what clients predating delta
+// frames expect.
+func (p *Process) SubscribeScreen(id string, deltas bool) (keyframe *ScreenUpdate, ch chan *ScreenUpdate) {
+	p.frameMu.Lock()
+	defer p.frameMu.Unlock()
+
+	p.flushScreenLocked()
+	keyframe = p.keyframeLocked()
+
 	p.subMu.Lock()
 	defer p.subMu.Unlock()
-
-	ch := make(chan *ScreenUpdate, screenSubsChannelSize)
-	p.screenSubs[id] = ch
-	return ch
+	ch = make(chan *ScreenUpdate, screenSubsChannelSize)
+	p.screenSubs[id] = &screenSub{ch: ch, deltas: deltas}
+	return keyframe, ch
 }
 
 // UnsubscribeScreen removes a screen update subscription.
@@ -352,8 +378,8 @@ func (p *Process) UnsubscribeScreen(id string) {
 	p.subMu.Lock()
 	defer p.subMu.Unlock()
 
-	if ch, ok := p.screenSubs[id]; ok {
-		close(ch)
+	if sub, ok := p.screenSubs[id]; ok {
+		close(sub.ch)
 		delete(p.screenSubs, id)
 	}
 }
@@ -374,6 +400,9 @@ func (p *Process) broadcastRaw(data []byte) {
 	if len(p.rawBuf) > maxRawBufferSize {
 		p.rawBuf = p.rawBuf[len(p.rawBuf)-maxRawBufferSize:]
 	}
+	if p.recording != nil {
+		_ = p.recording.Output(time.Now(), data)
+	}
 
 	p.subMu.RLock()
 	defer p.subMu.RUnlock()
@@ -399,20 +428,6 @@ func (p *Process) GetRawBuffer() []byte {
 	return buf
 }
 
-// broadcastScreen sends a screen update to all screen subscribers. Non-blocking.
-func (p *Process) broadcastScreen(update *ScreenUpdate) {
-	p.subMu.RLock()
-	defer p.subMu.RUnlock()
-
-	for _, ch := range p.screenSubs {
-		select {
-		case ch <- update:
-		default:
-			// Drop if subscriber can't keep up
-		}
-	}
-}
-
 // appendScrollback adds raw data lines to the scrollback buffer.
 //
 // data is whatever one PTY read returned, so a multi-byte UTF-8 rune can
@@ -536,6 +551,12 @@ func (p *Process) StartedAt() time.Time {
 	return p.startedAt
 }
 
+// RecordingPath returns the file the session is being recorded to, or ""
+// when recording is off or the file couldn't be created.
+func (p *Process) RecordingPath() string {
+	return p.recordingPath
+}
+
 // TerminalSize returns the current terminal dimensions.
 func (p *Process) TerminalSize() (rows, cols int) {
 	p.mu.RLock()
@@ -599,8 +620,9 @@ func (p *Process) detectIssues(data []byte) {
 	}
 
 	// Auto-clear: if we have an active issue and see enough normal output,
-	// the agent has resumed working — clear the issue banner.
-	if hasNonEmpty && p.issue != nil {
+	// the agent has resumed working — clear the issue banner. A question
+	// the agent is waiting on stays up until it is answered.
+	if hasNonEmpty && p.issue != nil && p.issue.Type != AgentIssueAwaitingInput {
 		p.cleanLineCount++
 		if p.cleanLineCount >= issueAutoClearThreshold {
 			p.logf("Issue auto-cleared after %d clean line batches", p.cleanLineCount)
@@ -626,6 +648,11 @@ func stripANSI(s string) string {
 // setIssueLocked sets the current issue and broadcasts to subscribers.
 // Must be called while holding issueMu.
 func (p *Process) setIssueLocked(issue *AgentIssue) {
+	// A banner that keeps scrolling past re-detects the same issue on
+	// every batch; only count it when it is new.
+	if p.issue == nil || p.issue.Type != issue.Type {
+		prom.AgentIssues.Inc(string(issue.Type))
+	}
 	p.issue = issue
 	p.logf("Issue detected: type=%s message=%q", issue.Type, issue.Message)
 
@@ -0,0 +1,234 @@
+package agent
+
+import (
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/vtansi"
+)
+
+// screenFrameInterval caps how often screen subscribers hear about the
+// terminal. PTY reads arrive in bursts of a few hundred bytes while an
+// agent is streaming, so rendering the whole grid per read (the old
+// behaviour) burned CPU proportional to output volume × screen size.
+// Frames are now coalesced: any number of reads inside one interval
+// produce at most one frame.
+const screenFrameInterval = 33 * time.Millisecond // ~30 fps
+
+// ScreenRowDelta is one changed row inside a delta frame. Row is the
+// 0-based screen row; Line is the plain text and Ansi the SGR-coloured
+// rendering of the same row.
+type ScreenRowDelta struct {
+	Row  int
+	Line string
+	Ansi string
+}
+
+// screenSub is a single SubscribeScreen consumer. Delta subscribers get a
+// keyframe first and row deltas afterwards; full subscribers (older
+// clients) get a keyframe on every frame. resync flips when a send was
+// dropped because the consumer fell behind — its view of the screen is
+// now stale, so the next frame it receives is a keyframe.
+type screenSub struct {
+	ch     chan *ScreenUpdate
+	deltas bool
+	resync bool
+}
+
+// screenFrame is the last rendered state of the grid. Deltas are
+// computed against it, and keyframes for new subscribers are built from
+// it, so every subscriber agrees on what a given Seq means.
+type screenFrame struct {
+	seq       uint64
+	lines     []string
+	ansi      []string
+	cursorRow int
+	cursorCol int
+	rows      int
+	cols      int
+}
+
+// markScreenDirty records that the emulator changed and wakes the frame
+// loop. Non-blocking: a pending wake-up already covers this change.
+func (p *Process) markScreenDirty() {
+	select {
+	case p.screenKick <- struct{}{}:
+	default:
+	}
+}
+
+// screenLoop turns dirty notifications into at most one frame per
+// screenFrameInterval. Runs for the lifetime of the process and flushes
+// one final frame on exit so subscribers see the last screen.
+func (p *Process) screenLoop() {
+	var last time.Time
+	for {
+		select {
+		case <-p.screenKick:
+		case <-p.done:
+			select {
+			case <-p.screenKick:
+				p.emitScreenFrame()
+			default:
+			}
+			return
+		}
+		if wait := screenFrameInterval - time.Since(last); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-p.done:
+			}
+		}
+		last = time.Now()
+		p.emitScreenFrame()
+	}
+}
+
+// renderFrame walks the emulator grid once and returns its state. The
+// vt lock is held for the walk so a concurrent Write or Resize can't
+// change the grid dimensions mid-read.
+func (p *Process) renderFrame() screenFrame {
+	p.vt.Lock()
+	defer p.vt.Unlock()
+
+	cols, rows := p.vt.Size()
+	f := screenFrame{
+		lines: make([]string, rows),
+		ansi:  make([]string, rows),
+		rows:  rows,
+		cols:  cols,
+	}
+	for row := 0; row < rows; row++ {
+		var sb strings.Builder
+		sb.Grow(cols)
+		for col := 0; col < cols; col++ {
+			g := p.vt.Cell(col, row)
+			if g.Char == 0 {
+				sb.WriteByte(' ')
+			} else {
+				sb.WriteRune(g.Char)
+			}
+		}
+		f.lines[row] = sb.String()
+		f.ansi[row] = vtansi.RenderRow(p.vt, row, cols)
+	}
+	cur := p.vt.Cursor()
+	f.cursorRow = cur.Y
+	f.cursorCol = cur.X
+	return f
+}
+
+// diffRows returns the rows of next that differ from prev. Both frames
+// must have the same dimensions; callers send a keyframe otherwise.
+func diffRows(prev, next *screenFrame) []ScreenRowDelta {
+	var out []ScreenRowDelta
+	for row := 0; row < next.rows; row++ {
+		if prev.lines[row] == next.lines[row] && prev.ansi[row] == next.ansi[row] {
+			continue
+		}
+		out = append(out, ScreenRowDelta{Row: row, Line: next.lines[row], Ansi: next.ansi[row]})
+	}
+	return out
+}
+
+// keyframeLocked builds a full-screen update from the last rendered
+// frame. Must be called while holding frameMu.
+func (p *Process) keyframeLocked() *ScreenUpdate {
+	f := &p.frame
+	lines := make([]string, len(f.lines))
+	copy(lines, f.lines)
+	return &ScreenUpdate{
+		ProjectID:   p.projectID,
+		Lines:       lines,
+		CursorRow:   f.cursorRow,
+		CursorCol:   f.cursorCol,
+		Rows:        f.rows,
+		Cols:        f.cols,
+		AnsiContent: strings.Join(f.ansi, "\r\n"),
+		Seq:         f.seq,
+		Keyframe:    true,
+	}
+}
+
+// advanceFrameLocked renders the grid and folds it into p.frame. It
+// returns the changed rows and whether anything (rows, cursor or size)
+// changed at all; a resize is reported as changed with nil deltas, which
+// callers must treat as "keyframe required". Must be called while
+// holding frameMu.
+func (p *Process) advanceFrameLocked() (deltas []ScreenRowDelta, resized, changed bool) {
+	next := p.renderFrame()
+	prev := &p.frame
+	switch {
+	case prev.lines == nil || prev.rows != next.rows || prev.cols != next.cols:
+		resized, changed = true, true
+	default:
+		deltas = diffRows(prev, &next)
+		changed = len(deltas) > 0 || prev.cursorRow != next.cursorRow || prev.cursorCol != next.cursorCol
+	}
+	if !changed {
+		return nil, false, false
+	}
+	next.seq = prev.seq + 1
+	p.frame = next
+	return deltas, resized, true
+}
+
+// emitScreenFrame renders the current screen and fans it out. Nothing is
+// rendered while nobody is subscribed — SubscribeScreen renders on
+// demand for the first keyframe.
+func (p *Process) emitScreenFrame() {
+	p.frameMu.Lock()
+	defer p.frameMu.Unlock()
+
+	p.subMu.RLock()
+	n := len(p.screenSubs)
+	p.subMu.RUnlock()
+	if n == 0 {
+		return
+	}
+	p.flushScreenLocked()
+}
+
+// flushScreenLocked advances p.frame to the current grid and sends the
+// resulting frame to every subscriber. Must be called while holding
+// frameMu.
+func (p *Process) flushScreenLocked() {
+	deltas, resized, changed := p.advanceFrameLocked()
+	if !changed {
+		return
+	}
+
+	var key *ScreenUpdate
+	keyframe := func() *ScreenUpdate {
+		if key == nil {
+			key = p.keyframeLocked()
+		}
+		return key
+	}
+	delta := &ScreenUpdate{
+		ProjectID: p.projectID,
+		CursorRow: p.frame.cursorRow,
+		CursorCol: p.frame.cursorCol,
+		Rows:      p.frame.rows,
+		Cols:      p.frame.cols,
+		Seq:       p.frame.seq,
+		RowDeltas: deltas,
+	}
+
+	p.subMu.RLock()
+	defer p.subMu.RUnlock()
+	for _, sub := range p.screenSubs {
+		update := delta
+		if !sub.deltas || sub.resync || resized {
+			update = keyframe()
+		}
+		select {
+		case sub.ch <- update:
+			sub.resync = false
+		default:
+			// Subscriber can't keep up; it just missed a frame, so the
+			// next one it gets must be a keyframe.
+			sub.resync = true
+		}
+	}
+}
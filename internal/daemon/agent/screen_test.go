@@ -0,0 +1,163 @@
+package agent
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hinshun/vt10x"
+)
+
+// newScreenProcess builds the minimum Process needed to exercise frame
+// coalescing and delta encoding — a vt10x emulator, no PTY, no command.
+func newScreenProcess(rows, cols int) *Process {
+	return &Process{
+		projectID:  "p1",
+		vt:         vt10x.New(vt10x.WithSize(cols, rows)),
+		rows:       rows,
+		cols:       cols,
+		done:       make(chan struct{}),
+		screenSubs: make(map[string]*screenSub),
+		screenKick: make(chan struct{}, 1),
+	}
+}
+
+func recvFrame(t *testing.T, ch chan *ScreenUpdate) *ScreenUpdate {
+	t.Helper()
+	select {
+	case u := <-ch:
+		return u
+	default:
+		t.Fatal("expected a frame on the subscriber channel")
+		return nil
+	}
+}
+
+func assertNoFrame(t *testing.T, ch chan *ScreenUpdate) {
+	t.Helper()
+	select {
+	case u := <-ch:
+		t.Fatalf("unexpected frame seq=%d keyframe=%v", u.Seq, u.Keyframe)
+	default:
+	}
+}
+
+// TestSubscribeScreenKeyframeThenDeltas: a delta subscriber starts from a
+// full keyframe and afterwards only hears about the rows that changed,
+// with seq advancing by one per frame.
+func TestSubscribeScreenKeyframeThenDeltas(t *testing.T) {
+	p := newScreenProcess(4, 20)
+	_, _ = p.vt.Write([]byte("hello\r\nworld"))
+
+	key, ch := p.SubscribeScreen("s1", true)
+	defer p.UnsubscribeScreen("s1")
+	if !key.Keyframe {
+		t.Fatal("first frame must be a keyframe")
+	}
+	if len(key.Lines) != 4 || strings.TrimRight(key.Lines[0], " ") != "hello" || strings.TrimRight(key.Lines[1], " ") != "world" {
+		t.Fatalf("keyframe lines = %q", key.Lines)
+	}
+	if !strings.Contains(key.AnsiContent, "hello") || strings.Count(key.AnsiContent, "\r\n") != 3 {
+		t.Fatalf("keyframe ansi = %q", key.AnsiContent)
+	}
+
+	_, _ = p.vt.Write([]byte("\r\nagain"))
+	p.emitScreenFrame()
+
+	d := recvFrame(t, ch)
+	if d.Keyframe {
+		t.Fatal("second frame should be a delta")
+	}
+	if d.Seq != key.Seq+1 {
+		t.Fatalf("delta seq = %d, want %d", d.Seq, key.Seq+1)
+	}
+	if len(d.Lines) != 0 || d.AnsiContent != "" {
+		t.Fatal("delta frames must not carry the full screen")
+	}
+	if len(d.RowDeltas) != 1 || d.RowDeltas[0].Row != 2 || strings.TrimRight(d.RowDeltas[0].Line, " ") != "again" {
+		t.Fatalf("row deltas = %+v", d.RowDeltas)
+	}
+	if d.CursorRow != 2 || d.CursorCol != 5 {
+		t.Fatalf("cursor = (%d,%d), want (2,5)", d.CursorRow, d.CursorCol)
+	}
+}
+
+// TestEmitScreenFrameSkipsUnchanged: a dirty wake-up that didn't change
+// anything visible produces no frame and doesn't burn a sequence number.
+func TestEmitScreenFrameSkipsUnchanged(t *testing.T) {
+	p := newScreenProcess(4, 20)
+	key, ch := p.SubscribeScreen("s1", true)
+	defer p.UnsubscribeScreen("s1")
+
+	p.emitScreenFrame()
+	assertNoFrame(t, ch)
+
+	_, _ = p.vt.Write([]byte("x"))
+	p.emitScreenFrame()
+	if d := recvFrame(t, ch); d.Seq != key.Seq+1 {
+		t.Fatalf("seq = %d, want %d", d.Seq, key.Seq+1)
+	}
+}
+
+// TestSubscribeScreenLegacyGetsKeyframes: clients that don't opt in to
+// deltas keep receiving the full screen on every frame.
+func TestSubscribeScreenLegacyGetsKeyframes(t *testing.T) {
+	p := newScreenProcess(3, 10)
+	_, ch := p.SubscribeScreen("old", false)
+	defer p.UnsubscribeScreen("old")
+
+	_, _ = p.vt.Write([]byte("abc"))
+	p.emitScreenFrame()
+
+	u := recvFrame(t, ch)
+	if !u.Keyframe || len(u.RowDeltas) != 0 {
+		t.Fatalf("legacy frame keyframe=%v deltas=%d", u.Keyframe, len(u.RowDeltas))
+	}
+	if len(u.Lines) != 3 || strings.TrimRight(u.Lines[0], " ") != "abc" {
+		t.Fatalf("legacy lines = %q", u.Lines)
+	}
+}
+
+// TestScreenResyncAfterDrop: a subscriber whose channel overflowed missed
+// a delta, so the next frame it gets is a keyframe.
+func TestScreenResyncAfterDrop(t *testing.T) {
+	p := newScreenProcess(3, 10)
+	_, ch := p.SubscribeScreen("slow", true)
+	defer p.UnsubscribeScreen("slow")
+
+	for i := 0; i < screenSubsChannelSize+1; i++ {
+		_, _ = p.vt.Write([]byte("."))
+		p.emitScreenFrame()
+	}
+	for len(ch) > 0 {
+		<-ch
+	}
+
+	_, _ = p.vt.Write([]byte("!"))
+	p.emitScreenFrame()
+	u := recvFrame(t, ch)
+	if !u.Keyframe {
+		t.Fatal("frame after a drop must be a keyframe")
+	}
+
+	_, _ = p.vt.Write([]byte("?"))
+	p.emitScreenFrame()
+	if u := recvFrame(t, ch); u.Keyframe {
+		t.Fatal("resync should clear once the keyframe is delivered")
+	}
+}
+
+// TestScreenResizeSendsKeyframe: row deltas can't describe a change of
+// dimensions, so a resize forces a keyframe for delta subscribers too.
+func TestScreenResizeSendsKeyframe(t *testing.T) {
+	p := newScreenProcess(3, 10)
+	_, ch := p.SubscribeScreen("s1", true)
+	defer p.UnsubscribeScreen("s1")
+
+	p.vt.Resize(12, 5)
+	p.emitScreenFrame()
+
+	u := recvFrame(t, ch)
+	if !u.Keyframe || u.Rows != 5 || u.Cols != 12 || len(u.Lines) != 5 {
+		t.Fatalf("resize frame keyframe=%v size=%dx%d lines=%d", u.Keyframe, u.Rows, u.Cols, len(u.Lines))
+	}
+}
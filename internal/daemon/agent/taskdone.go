@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"log"
 	"os/exec"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
 	"github.com/watchfire-io/watchfire/internal/daemon/diff"
 	gitpkg "github.com/watchfire-io/watchfire/internal/daemon/git"
 	"github.com/watchfire-io/watchfire/internal/daemon/metrics"
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/prom"
+	"github.com/watchfire-io/watchfire/internal/daemon/tracing"
 	"github.com/watchfire-io/watchfire/internal/models"
 )
 
@@ -38,6 +42,12 @@ type taskDoneFns struct {
 	// can leave them unset and stay off disk.
 	ComputeCodeStats func(projectPath, projectID string, taskNumber int) metrics.CodeStats
 	RecordCodeStats  func(projectPath, projectID string, t *models.Task, cs metrics.CodeStats)
+	// AwaitApproval posts a merge approval request and blocks until it is
+	// answered (approval.Gate.Await). nil — no gate running — means a
+	// project with `approval: required` never merges unattended.
+	// DiffFiles lists the changed files for the request's diff summary.
+	AwaitApproval func(ctx context.Context, req approval.Request, timeout time.Duration) (approval.Response, error)
+	DiffFiles     func(projectPath, projectID string, taskNumber int) []approval.FileChange
 }
 
 var defaultTaskDoneFns = taskDoneFns{
@@ -56,10 +66,11 @@ var defaultTaskDoneFns = taskDoneFns{
 	},
 	ComputeCodeStats: computeCodeStats,
 	RecordCodeStats:  metrics.RecordCodeStats,
+	DiffFiles:        approvalDiffFiles,
 }
 
 // computeCodeStats derives the per-task code-output numbers from the still-live
-// `watchfire/<n>` branch: commit count via `git rev-list` and files/lines via
+// `watchfire/<n>` branch: commit count and SHAs via `git rev-list` and files/lines via
 // the diff package (the same call Inspect + the auto-PR body use, so the
 // numbers line up). MUST run BEFORE the merge — once the branch is folded into
 // the default branch, the merge-base equals the branch tip and the commit
@@ -75,6 +86,11 @@ func computeCodeStats(projectPath, projectID string, taskNumber int) metrics.Cod
 				cs.Commits = c
 			}
 		}
+		// The SHAs let insights notice the task's commits being reverted
+		// later. Capped: a runaway branch shouldn't bloat the sidecar.
+		if shas := gitOutput(projectPath, "rev-list", "--max-count=200", base+".."+branch); shas != "" {
+			cs.CommitSHAs = strings.Fields(shas)
+		}
 	}
 
 	if set, err := diff.TaskDiff(projectPath, projectID, taskNumber); err == nil && set != nil {
@@ -85,6 +101,38 @@ func computeCodeStats(projectPath, projectID string, taskNumber int) metrics.Cod
 	return cs
 }
 
+// approvalDiffFiles lists the task branch's largest changes for an
+// approval request. diff.TaskDiff is cached, so this reuses the parse
+// computeCodeStats just did.
+func approvalDiffFiles(projectPath, projectID string, taskNumber int) []approval.FileChange {
+	set, err := diff.TaskDiff(projectPath, projectID, taskNumber)
+	if err != nil || set == nil {
+		return nil
+	}
+	files := make([]approval.FileChange, 0, len(set.Files))
+	for _, f := range set.Files {
+		fc := approval.FileChange{Path: f.Path, Status: string(f.Status)}
+		for _, h := range f.Hunks {
+			for _, l := range h.Lines {
+				switch l.Kind {
+				case diff.LineAdd:
+					fc.Additions++
+				case diff.LineDel:
+					fc.Deletions++
+				}
+			}
+		}
+		files = append(files, fc)
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Additions+files[i].Deletions > files[j].Additions+files[j].Deletions
+	})
+	if len(files) > approval.MaxFiles {
+		files = files[:approval.MaxFiles]
+	}
+	return files
+}
+
 // gitOutput runs `git <args>` in dir and returns trimmed stdout, or "" on any
 // error (best-effort — callers treat "" as "stat unavailable").
 func gitOutput(dir string, args ...string) string {
@@ -136,11 +184,23 @@ func resetGHFallbackWarnedForTest() { ghFallbackWarned = sync.Map{} }
 // On any auto-PR failure (`gh` missing, non-github origin, push reject, gh
 // api error) the function logs loudly then falls through to silent merge so
 // the user's work never strands inside an unmerged worktree.
-func HandleTaskDone(projectPath string, taskNumber int, worktreePath string, bus *notify.Bus) TaskDoneResult {
-	return handleTaskDoneWith(defaultTaskDoneFns, projectPath, taskNumber, worktreePath, bus)
+//
+// A project with `approval: required` pauses after the code stats are
+// computed: gate posts the diff summary to chat and the merge waits for
+// the answer (see awaitMergeApproval). gate may be nil when no approval
+// transport is running.
+//
+// ctx carries the caller's `task.done` trace span; the code-stats,
+// approval, auto-PR and merge steps are traced as its children.
+func HandleTaskDone(ctx context.Context, projectPath string, taskNumber int, worktreePath string, bus *notify.Bus, gate *approval.Gate) TaskDoneResult {
+	fns := defaultTaskDoneFns
+	if gate != nil {
+		fns.AwaitApproval = gate.Await
+	}
+	return handleTaskDoneWith(ctx, fns, projectPath, taskNumber, worktreePath, bus)
 }
 
-func handleTaskDoneWith(fns taskDoneFns, projectPath string, taskNumber int, worktreePath string, bus *notify.Bus) TaskDoneResult {
+func handleTaskDoneWith(ctx context.Context, fns taskDoneFns, projectPath string, taskNumber int, worktreePath string, bus *notify.Bus) TaskDoneResult {
 	if taskNumber == 0 || worktreePath == "" {
 		log.Printf("[merge] Skipping merge: taskNumber=%d worktreePath=%q", taskNumber, worktreePath)
 		return TaskDoneResult{Outcome: TaskDoneOK}
@@ -175,29 +235,139 @@ func handleTaskDoneWith(fns taskDoneFns, projectPath string, taskNumber int, wor
 	// zero out the commit count). merged/merge_kind are filled per path.
 	var codeStats metrics.CodeStats
 	if fns.ComputeCodeStats != nil {
+		_, span := tracing.Start(ctx, "task.code_stats", tracing.Project(proj.ProjectID), tracing.Task(taskNumber))
 		codeStats = fns.ComputeCodeStats(projectPath, proj.ProjectID, taskNumber)
+		span.End()
+	}
+
+	if proj.RequiresApproval() {
+		if res, held := awaitMergeApproval(ctx, fns, proj, t, projectPath, taskNumber, codeStats); held {
+			return res
+		}
 	}
 
-	if proj.AutoMerge && tryAutoPR(fns, proj, t, projectPath, taskNumber, bus, codeStats) {
+	if proj.AutoMerge && tryAutoPR(ctx, fns, proj, t, projectPath, taskNumber, bus, codeStats) {
 		return TaskDoneResult{Outcome: TaskDoneOK}
 	}
 
-	return runSilentMerge(fns, proj, t, projectPath, taskNumber, codeStats)
+	return runSilentMerge(ctx, fns, proj, t, projectPath, taskNumber, codeStats)
+}
+
+// approvalAbortKey carries, on the task-done ctx, a context whose end
+// cancels a merge-approval wait — and only that: the manager cancels it
+// when the project's agent is stopped or replaced, which must not abort
+// an auto-PR or merge already under way.
+type approvalAbortKey struct{}
+
+func withApprovalAbort(ctx, abort context.Context) context.Context {
+	return context.WithValue(ctx, approvalAbortKey{}, abort)
+}
+
+// awaitMergeApproval asks for a merge approval and applies the answer.
+// held=false means approved: the caller carries on with the normal merge
+// path. Otherwise the returned result ends task-done handling with the
+// branch left unmerged — rejected (the task is marked failed) or retried
+// (the task is reopened). A request that cannot be posted counts as a
+// rejection: an unattended merge is exactly what the project opted out
+// of.
+//
+// The task is saved with an approval hold first and keeps it until a
+// decision comes back, so an undecided branch — gate missing, post
+// failed, daemon restarted mid-wait — is never collected by the janitor.
+func awaitMergeApproval(ctx context.Context, fns taskDoneFns, proj *models.Project, t *models.Task, projectPath string, taskNumber int, codeStats metrics.CodeStats) (res TaskDoneResult, held bool) {
+	t.HoldForApproval(time.Now())
+	if err := fns.SaveTask(projectPath, t); err != nil {
+		config.ProjectLogf(proj.ProjectID, "[approval] Failed to record the approval hold on task #%04d: %v", taskNumber, err)
+	}
+	if fns.AwaitApproval == nil {
+		config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d requires approval but no approval gate is running — leaving it unmerged", taskNumber)
+		return TaskDoneResult{Outcome: TaskDoneRejected, Reason: "approval required but no approval gate is running"}, true
+	}
+	onTimeout, _ := approval.ParseDecision(proj.EffectiveApprovalOnTimeout())
+	req := approval.Request{
+		ProjectID:    proj.ProjectID,
+		ProjectName:  proj.Name,
+		TaskNumber:   taskNumber,
+		TaskTitle:    t.Title,
+		Agent:        resolveAgentNameForPR(proj, t),
+		Branch:       fmt.Sprintf("watchfire/%04d", taskNumber),
+		Commits:      codeStats.Commits,
+		FilesChanged: codeStats.FilesChanged,
+		LinesAdded:   codeStats.LinesAdded,
+		LinesRemoved: codeStats.LinesRemoved,
+		Endpoints:    proj.ApprovalEndpoints,
+		OnTimeout:    onTimeout,
+	}
+	if fns.DiffFiles != nil {
+		req.Files = fns.DiffFiles(projectPath, proj.ProjectID, taskNumber)
+	}
+	timeout := proj.EffectiveApprovalTimeout()
+	config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d waiting for merge approval (timeout %s, then %s)", taskNumber, timeout, onTimeout)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if abort, ok := ctx.Value(approvalAbortKey{}).(context.Context); ok {
+		defer context.AfterFunc(abort, cancel)()
+	}
+	ctx, span := tracing.Start(ctx, "task.approval", tracing.Project(proj.ProjectID), tracing.Task(taskNumber))
+	resp, err := fns.AwaitApproval(ctx, req, timeout)
+	tracing.End(span, err)
+	if errors.Is(err, context.Canceled) {
+		config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d approval wait ended — agent stopped or replaced; branch kept unmerged and held", taskNumber)
+		return TaskDoneResult{Outcome: TaskDoneRejected, Reason: "approval wait cancelled"}, true
+	}
+	if err != nil {
+		config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d approval request failed: %v — leaving it unmerged", taskNumber, err)
+		return TaskDoneResult{Outcome: TaskDoneRejected, Reason: fmt.Sprintf("approval request failed: %v", err)}, true
+	}
+	by := resp.Actor
+	if resp.TimedOut {
+		by = "timeout"
+	} else if by == "" {
+		by = "chat"
+	}
+
+	switch resp.Decision {
+	case approval.Approve:
+		config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d merge approved by %s", taskNumber, by)
+		t.ReleaseApprovalHold()
+		if err := fns.SaveTask(projectPath, t); err != nil {
+			config.ProjectLogf(proj.ProjectID, "[approval] Failed to clear the approval hold on task #%04d: %v", taskNumber, err)
+		}
+		return TaskDoneResult{}, false
+	case approval.Retry:
+		config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d sent back for another run by %s", taskNumber, by)
+		t.Reopen()
+		if err := fns.SaveTask(projectPath, t); err != nil {
+			config.ProjectLogf(proj.ProjectID, "[approval] Failed to reopen task #%04d: %v", taskNumber, err)
+		}
+		return TaskDoneResult{Outcome: TaskDoneReopened, Reason: "retry requested by " + by}, true
+	default:
+		reason := "merge rejected by " + by
+		config.ProjectLogf(proj.ProjectID, "[approval] Task #%04d %s — branch kept unmerged", taskNumber, reason)
+		t.ReleaseApprovalHold()
+		t.MarkDone(false, reason)
+		if err := fns.SaveTask(projectPath, t); err != nil {
+			config.ProjectLogf(proj.ProjectID, "[approval] Failed to record rejection on task #%04d: %v", taskNumber, err)
+		}
+		return TaskDoneResult{Outcome: TaskDoneRejected, Reason: reason}, true
+	}
 }
 
 // tryAutoPR returns true when the auto-PR flow took ownership of the merge
 // (PR opened successfully, worktree cleaned). It returns false in two cases:
 // auto-PR is not enabled for this project, or the PR attempt failed and the
 // caller should fall through to silent merge.
-func tryAutoPR(fns taskDoneFns, proj *models.Project, t *models.Task, projectPath string, taskNumber int, bus *notify.Bus, codeStats metrics.CodeStats) bool {
+func tryAutoPR(ctx context.Context, fns taskDoneFns, proj *models.Project, t *models.Task, projectPath string, taskNumber int, bus *notify.Bus, codeStats metrics.CodeStats) bool {
 	integrations, _ := fns.LoadIntegrations()
 	if integrations == nil || !integrations.GitHub.AutoPRApplies(proj.ProjectID) {
 		return false
 	}
+	ctx, span := tracing.Start(ctx, "task.auto_pr", tracing.Project(proj.ProjectID), tracing.Task(taskNumber))
 
 	config.ProjectLogf(proj.ProjectID, "[auto-pr] Task #%04d: project %s opted into GitHub auto-PR — attempting PR", taskNumber, proj.Name)
 
-	prRes, prErr := fns.OpenPR(context.Background(), gitpkg.OpenPROptions{
+	prRes, prErr := fns.OpenPR(ctx, gitpkg.OpenPROptions{
 		ProjectPath:        projectPath,
 		ProjectID:          proj.ProjectID,
 		TaskNumber:         taskNumber,
@@ -214,9 +384,11 @@ func tryAutoPR(fns taskDoneFns, proj *models.Project, t *models.Task, projectPat
 		// fires from.
 		GitHubHostname: enterpriseHostnameFor(integrations),
 	})
+	tracing.End(span, prErr)
 	if prErr == nil {
 		config.ProjectLogf(proj.ProjectID, "[auto-pr] Task #%04d PR opened: %s", taskNumber, prRes.URL)
 		emitPROpenedNotification(fns, bus, proj, taskNumber, prRes.URL)
+		prom.TasksMerged.Inc(string(models.MergeKindAutoPR))
 		// The work landed as a PR, not a local merge — record merged=false so
 		// rollups don't double-count it as merged-to-default. Done before
 		// worktree cleanup, though codeStats was already snapshotted.
@@ -255,19 +427,31 @@ func logAutoPRFallback(projectID string, taskNumber int, prErr error) {
 	}
 }
 
+// emitPROpenedNotification fires PR_OPENED with the PR URL in
+// Notification.URL. Gated on the `pr_opened` preference, which inherits
+// `run_complete` until set explicitly.
 func emitPROpenedNotification(fns taskDoneFns, bus *notify.Bus, proj *models.Project, taskNumber int, prURL string) {
+	settings, _ := config.LoadSettings()
+	cfg := models.DefaultNotifications()
+	if settings != nil {
+		cfg = settings.Defaults.Notifications
+	}
+	if !models.ShouldNotify(models.NotificationPROpened, cfg, proj.Notifications, time.Now().Local()) {
+		return
+	}
 	emittedAt := time.Now().UTC()
 	title := fmt.Sprintf("%s — PR opened for task #%04d", proj.Name, taskNumber)
 	if proj.Name == "" {
 		title = fmt.Sprintf("PR opened for task #%04d", taskNumber)
 	}
 	n := notify.Notification{
-		ID:         notify.MakeID(notify.KindRunComplete, proj.ProjectID, int32(taskNumber), emittedAt),
-		Kind:       notify.KindRunComplete,
+		ID:         notify.MakeID(notify.KindPROpened, proj.ProjectID, int32(taskNumber), emittedAt),
+		Kind:       notify.KindPROpened,
 		ProjectID:  proj.ProjectID,
 		TaskNumber: int32(taskNumber),
 		Title:      title,
 		Body:       "PR opened: " + prURL,
+		URL:        prURL,
 		EmittedAt:  emittedAt,
 	}
 	if err := fns.EmitNotification(bus, n); err != nil {
@@ -275,20 +459,24 @@ func emitPROpenedNotification(fns taskDoneFns, bus *notify.Bus, proj *models.Pro
 	}
 }
 
-func runSilentMerge(fns taskDoneFns, proj *models.Project, t *models.Task, projectPath string, taskNumber int, codeStats metrics.CodeStats) TaskDoneResult {
+func runSilentMerge(ctx context.Context, fns taskDoneFns, proj *models.Project, t *models.Task, projectPath string, taskNumber int, codeStats metrics.CodeStats) TaskDoneResult {
 	var merged bool
 	var mergeReason string
 	mergeFailed := false
 	if proj.AutoMerge {
 		var mergeErr error
+		_, span := tracing.Start(ctx, "task.merge", tracing.Project(proj.ProjectID), tracing.Task(taskNumber))
 		merged, mergeErr = fns.MergeWorktree(projectPath, taskNumber)
+		tracing.End(span, mergeErr)
 		switch {
 		case mergeErr != nil:
 			config.ProjectLogf(proj.ProjectID, "[merge] Auto-merge failed for task #%04d: %v", taskNumber, mergeErr)
 			mergeFailed = true
 			mergeReason = mergeErr.Error()
+			prom.MergeFailures.Inc()
 		case merged:
 			config.ProjectLogf(proj.ProjectID, "[merge] Auto-merged task #%04d into current branch", taskNumber)
+			prom.TasksMerged.Inc(string(models.MergeKindSilent))
 		default:
 			config.ProjectLogf(proj.ProjectID, "[merge] Task #%04d has no file differences — skipped merge", taskNumber)
 		}
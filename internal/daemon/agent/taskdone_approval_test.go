@@ -0,0 +1,165 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/metrics"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// approvalFns wires the fixture with an approval gate answering resp (or
+// failing with err) and records the request it was handed.
+func approvalFns(f *taskDoneFixture, resp approval.Response, err error, got *approval.Request, timeout *time.Duration) taskDoneFns {
+	fns := f.fns()
+	fns.ComputeCodeStats = func(string, string, int) metrics.CodeStats {
+		return metrics.CodeStats{Commits: 2, FilesChanged: 3, LinesAdded: 40, LinesRemoved: 5}
+	}
+	fns.DiffFiles = func(string, string, int) []approval.FileChange {
+		return []approval.FileChange{{Path: "main.go", Status: "modified", Additions: 30, Deletions: 5}}
+	}
+	fns.AwaitApproval = func(_ context.Context, req approval.Request, d time.Duration) (approval.Response, error) {
+		*got = req
+		*timeout = d
+		return resp, err
+	}
+	return fns
+}
+
+func TestHandleTaskDoneApprovalApproved(t *testing.T) {
+	f := &taskDoneFixture{approval: "required", mergeChanged: true}
+	var req approval.Request
+	var timeout time.Duration
+	fns := approvalFns(f, approval.Response{Decision: approval.Approve, Actor: "slack:U1"}, nil, &req, &timeout)
+
+	res := handleTaskDoneWith(context.Background(), fns, "/proj", 42, "/wt", nil)
+	if res.Outcome != TaskDoneOK || f.mergeCalled != 1 {
+		t.Fatalf("approved: outcome=%v merges=%d, want OK and one merge", res.Outcome, f.mergeCalled)
+	}
+	if n := len(f.savedTasks); n < 2 || f.savedTasks[0].AwaitingApprovalSince == nil || f.savedTasks[n-1].AwaitingApprovalSince != nil {
+		t.Errorf("expected the approval hold saved and then cleared, got %+v", f.savedTasks)
+	}
+	want := approval.Request{
+		ProjectID: "proj-1", ProjectName: "demo", TaskNumber: 42, TaskTitle: "demo task",
+		Agent: "claude-code", Branch: "watchfire/0042",
+		Commits: 2, FilesChanged: 3, LinesAdded: 40, LinesRemoved: 5,
+		Files:     []approval.FileChange{{Path: "main.go", Status: "modified", Additions: 30, Deletions: 5}},
+		OnTimeout: approval.Reject,
+	}
+	if !reflect.DeepEqual(req, want) {
+		t.Errorf("request = %+v\nwant      %+v", req, want)
+	}
+	if timeout != models.DefaultApprovalTimeout {
+		t.Errorf("timeout = %s, want the default", timeout)
+	}
+}
+
+func TestHandleTaskDoneApprovalRejected(t *testing.T) {
+	f := &taskDoneFixture{approval: "required"}
+	var req approval.Request
+	var timeout time.Duration
+	fns := approvalFns(f, approval.Response{Decision: approval.Reject, Actor: "discord:D1"}, nil, &req, &timeout)
+
+	res := handleTaskDoneWith(context.Background(), fns, "/proj", 42, "/wt", nil)
+	if res.Outcome != TaskDoneRejected || res.ShouldContinueChain() {
+		t.Fatalf("rejected: outcome=%v, want TaskDoneRejected halting the chain", res.Outcome)
+	}
+	if f.mergeCalled != 0 || f.removeCalled != 0 || f.openPRCalled != 0 {
+		t.Errorf("a rejected task must keep its branch: merge=%d remove=%d pr=%d", f.mergeCalled, f.removeCalled, f.openPRCalled)
+	}
+	if len(f.savedTasks) != 2 || f.savedTasks[0].AwaitingApprovalSince == nil {
+		t.Fatalf("expected the approval hold then the rejection saved, got %+v", f.savedTasks)
+	}
+	saved := f.savedTasks[1]
+	if saved.Success == nil || *saved.Success || saved.FailureReason != "merge rejected by discord:D1" {
+		t.Errorf("saved task = success %v reason %q", saved.Success, saved.FailureReason)
+	}
+	if saved.AwaitingApprovalSince != nil {
+		t.Errorf("a rejected task must drop its approval hold")
+	}
+}
+
+func TestHandleTaskDoneApprovalRetryReopens(t *testing.T) {
+	f := &taskDoneFixture{approval: "required"}
+	var req approval.Request
+	var timeout time.Duration
+	fns := approvalFns(f, approval.Response{Decision: approval.Retry, TimedOut: true}, nil, &req, &timeout)
+
+	res := handleTaskDoneWith(context.Background(), fns, "/proj", 42, "/wt", nil)
+	if res.Outcome != TaskDoneReopened || !res.ShouldContinueChain() {
+		t.Fatalf("retry: outcome=%v, want TaskDoneReopened continuing the chain", res.Outcome)
+	}
+	if !strings.Contains(res.Reason, "timeout") {
+		t.Errorf("reason = %q, want the timeout named", res.Reason)
+	}
+	if f.mergeCalled != 0 || f.removeCalled != 0 {
+		t.Errorf("a retried task must keep its branch: merge=%d remove=%d", f.mergeCalled, f.removeCalled)
+	}
+	if len(f.savedTasks) != 2 {
+		t.Fatalf("expected the approval hold then the reopen saved, got %d saves", len(f.savedTasks))
+	}
+	if r := f.savedTasks[1]; r.Status != models.TaskStatusReady || r.Success != nil || r.CompletedAt != nil || r.AwaitingApprovalSince != nil {
+		t.Errorf("expected the task reopened as ready, got %+v", r)
+	}
+}
+
+func TestHandleTaskDoneApprovalUnavailable(t *testing.T) {
+	f := &taskDoneFixture{approval: "required"}
+	fns := f.fns()
+	res := handleTaskDoneWith(context.Background(), fns, "/proj", 42, "/wt", nil)
+	if res.Outcome != TaskDoneRejected || f.mergeCalled != 0 {
+		t.Errorf("no gate: outcome=%v merges=%d, want a rejection and no merge", res.Outcome, f.mergeCalled)
+	}
+
+	var req approval.Request
+	var timeout time.Duration
+	fns = approvalFns(f, approval.Response{}, errors.New("no transport"), &req, &timeout)
+	res = handleTaskDoneWith(context.Background(), fns, "/proj", 42, "/wt", nil)
+	if res.Outcome != TaskDoneRejected || !strings.Contains(res.Reason, "no transport") || f.mergeCalled != 0 {
+		t.Errorf("post failure: outcome=%v reason=%q merges=%d", res.Outcome, res.Reason, f.mergeCalled)
+	}
+	// Undecided: the hold stays so the janitor keeps the branch.
+	if n := len(f.savedTasks); n == 0 || f.savedTasks[n-1].AwaitingApprovalSince == nil {
+		t.Errorf("an undecided task must keep its approval hold, got %+v", f.savedTasks)
+	}
+
+	// Projects without the gate never ask.
+	f = &taskDoneFixture{mergeChanged: true}
+	fns = approvalFns(f, approval.Response{Decision: approval.Reject}, nil, &req, &timeout)
+	req = approval.Request{}
+	if res := handleTaskDoneWith(context.Background(), fns, "/proj", 42, "/wt", nil); res.Outcome != TaskDoneOK || req.TaskNumber != 0 {
+		t.Errorf("ungated project: outcome=%v asked=%v", res.Outcome, req.TaskNumber != 0)
+	}
+}
+
+// TestHandleTaskDoneApprovalAbort asserts the manager's abort — a stop
+// or a new start of the project's agent — ends the approval wait with
+// the branch unmerged and the approval hold kept.
+func TestHandleTaskDoneApprovalAbort(t *testing.T) {
+	f := &taskDoneFixture{approval: "required"}
+	fns := f.fns()
+	waiting := make(chan struct{})
+	fns.AwaitApproval = func(ctx context.Context, _ approval.Request, _ time.Duration) (approval.Response, error) {
+		close(waiting)
+		<-ctx.Done()
+		return approval.Response{}, ctx.Err()
+	}
+	abortCtx, abort := context.WithCancel(context.Background())
+	go func() {
+		<-waiting
+		abort()
+	}()
+
+	res := handleTaskDoneWith(withApprovalAbort(context.Background(), abortCtx), fns, "/proj", 42, "/wt", nil)
+	if res.Outcome != TaskDoneRejected || res.ShouldContinueChain() || f.mergeCalled != 0 {
+		t.Fatalf("aborted wait: outcome=%v merges=%d, want a halt and no merge", res.Outcome, f.mergeCalled)
+	}
+	if n := len(f.savedTasks); n == 0 || f.savedTasks[n-1].AwaitingApprovalSince == nil {
+		t.Errorf("an aborted wait must keep the approval hold, got %+v", f.savedTasks)
+	}
+}
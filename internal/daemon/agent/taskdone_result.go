@@ -25,11 +25,23 @@ const (
 	// feature. No current code path produces it; defined so adding the
 	// behaviour later does not require another callback signature change.
 	TaskDoneCancelled
+
+	// TaskDoneRejected — the project requires approval before merging
+	// and the merge was rejected (by a person, the timeout policy, or
+	// because the request could not be posted). The branch is kept
+	// unmerged and the run-all queue halts; Reason says why.
+	TaskDoneRejected
+
+	// TaskDoneReopened — the approval answer was Retry: the task went
+	// back to `ready` with its branch intact. Chaining proceeds, so a
+	// run-all queue picks the task up again.
+	TaskDoneReopened
 )
 
 // TaskDoneResult is what the post-task-done callback returns to the agent
 // manager. `Reason` is a free-text error message populated for
-// `TaskDoneMergeFailed` / `TaskDoneCancelled`; empty for `TaskDoneOK`.
+// `TaskDoneMergeFailed` / `TaskDoneCancelled` / `TaskDoneRejected` /
+// `TaskDoneReopened`; empty for `TaskDoneOK`.
 type TaskDoneResult struct {
 	Outcome TaskDoneOutcome
 	Reason  string
@@ -37,7 +49,8 @@ type TaskDoneResult struct {
 
 // ShouldContinueChain reports whether the run-all / wildfire queue should
 // advance to the next task. Mirrors the pre-v5.0 bare-bool semantics:
-// `TaskDoneOK` is the only outcome that allows chaining.
+// `TaskDoneOK` allows chaining, as does `TaskDoneReopened` (the
+// reopened task is simply ready again).
 func (r TaskDoneResult) ShouldContinueChain() bool {
-	return r.Outcome == TaskDoneOK
+	return r.Outcome == TaskDoneOK || r.Outcome == TaskDoneReopened
 }
@@ -0,0 +1,273 @@
+// Package approval holds a finished task's merge until a person decides
+// on it from chat. When a project sets `approval: required`, the
+// task-done flow builds a Request (the diff summary of the task branch),
+// hands it to the Gate, and blocks until someone presses Approve, Reject
+// or Retry on the message the relay posted to Slack, Discord or
+// Telegram — or until the project's approval timeout applies its
+// on-timeout decision.
+//
+// Pending requests live in memory only, so a daemon restart drops them
+// and the chat buttons stop working. The task itself is saved with an
+// approval hold (Task.AwaitingApprovalSince) before the request is
+// posted: it stays done with its branch unmerged, the retention janitor
+// skips held branches, and the merge can be finished by hand.
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Decision is the answer to an approval request.
+type Decision string
+
+// Decisions.
+const (
+	// Approve lets the merge (or auto-PR) proceed.
+	Approve Decision = "approve"
+	// Reject keeps the branch unmerged and marks the task failed.
+	Reject Decision = "reject"
+	// Retry reopens the task so the agent works it again on the same
+	// branch.
+	Retry Decision = "retry"
+)
+
+// ParseDecision accepts the decision names case-insensitively.
+func ParseDecision(s string) (Decision, bool) {
+	switch d := Decision(strings.ToLower(strings.TrimSpace(s))); d {
+	case Approve, Reject, Retry:
+		return d, true
+	default:
+		return "", false
+	}
+}
+
+// ErrNotPending is returned by Resolve when no approval is waiting for
+// the task — it was already decided, timed out, or never asked.
+var ErrNotPending = errors.New("no approval is pending for this task")
+
+// FileChange is one line of the diff summary.
+type FileChange struct {
+	Path      string
+	Status    string // added | modified | deleted | renamed
+	Additions int
+	Deletions int
+}
+
+// MaxFiles caps how many files a Request lists; the totals still cover
+// the whole diff.
+const MaxFiles = 10
+
+// Request is what the chat transports render: the task, its branch and
+// the diff summary computed before the merge.
+type Request struct {
+	ProjectID   string
+	ProjectName string
+	TaskNumber  int
+	TaskTitle   string
+	Agent       string
+	Branch      string
+
+	Commits      int
+	FilesChanged int
+	LinesAdded   int
+	LinesRemoved int
+	// Files lists the largest changes, at most MaxFiles.
+	Files []FileChange
+
+	// Endpoints narrows which relay endpoints get the request; empty
+	// means every chat endpoint that has not muted the project.
+	Endpoints []string
+
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+	// OnTimeout is applied when ExpiresAt passes without an answer.
+	OnTimeout Decision
+}
+
+// Ref is the `<projectID>|<taskNumber>` reference button payloads
+// carry back.
+func (r Request) Ref() string { return Ref(r.ProjectID, r.TaskNumber) }
+
+// Ref formats the reference for a project's task.
+func Ref(projectID string, taskNumber int) string {
+	return fmt.Sprintf("%s|%d", projectID, taskNumber)
+}
+
+// Button identifiers shared by the relay (which posts the buttons) and
+// the inbound transports (which route the clicks back). Slack buttons
+// carry SlackActionPrefix+decision as action_id and Ref as value;
+// Discord custom_ids and Telegram callback data carry ButtonData.
+const (
+	SlackActionPrefix = "watchfire_approval_"
+	ButtonPrefix      = "approval:"
+)
+
+// ButtonData encodes a decision on a task for a Discord custom_id or a
+// Telegram callback (64 bytes max — a UUID project id fits).
+func ButtonData(d Decision, projectID string, taskNumber int) string {
+	return ButtonPrefix + string(d) + ":" + Ref(projectID, taskNumber)
+}
+
+// ParseButtonData is the inverse of ButtonData.
+func ParseButtonData(s string) (d Decision, projectID string, taskNumber int, ok bool) {
+	rest, found := strings.CutPrefix(s, ButtonPrefix)
+	if !found {
+		return "", "", 0, false
+	}
+	name, ref, found := strings.Cut(rest, ":")
+	if !found {
+		return "", "", 0, false
+	}
+	if d, ok = ParseDecision(name); !ok {
+		return "", "", 0, false
+	}
+	projectID, taskNumber, ok = ParseRef(ref)
+	return d, projectID, taskNumber, ok
+}
+
+// ParseRef splits a `<projectID>|<taskNumber>` reference.
+func ParseRef(ref string) (projectID string, taskNumber int, ok bool) {
+	idx := strings.LastIndex(ref, "|")
+	if idx <= 0 || idx == len(ref)-1 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(ref[idx+1:])
+	if err != nil || n <= 0 {
+		return "", 0, false
+	}
+	return ref[:idx], n, true
+}
+
+// Response is how an approval ended.
+type Response struct {
+	Decision Decision
+	// Actor identifies who decided ("slack:U024BE7LH"); empty when the
+	// timeout decided.
+	Actor    string
+	TimedOut bool
+}
+
+// Poster delivers a Request to the chat transports. An error means no
+// transport received it.
+type Poster func(ctx context.Context, req Request) error
+
+// Gate tracks pending approvals and wakes their waiters.
+type Gate struct {
+	post Poster
+	now  func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]*pending
+}
+
+type pending struct {
+	req  Request
+	done chan Response
+}
+
+// NewGate returns a Gate posting requests through post.
+func NewGate(post Poster) *Gate {
+	return &Gate{post: post, now: time.Now, pending: map[string]*pending{}}
+}
+
+// Await posts req and blocks until it is decided, its timeout passes, or
+// ctx ends. RequestedAt / ExpiresAt are stamped from timeout. The error
+// is non-nil only when the request could not be posted (or another
+// approval for the same task is already waiting); the caller decides
+// what a gate nobody can answer means.
+func (g *Gate) Await(ctx context.Context, req Request, timeout time.Duration) (Response, error) {
+	req.RequestedAt = g.now().UTC()
+	req.ExpiresAt = req.RequestedAt.Add(timeout)
+	if req.OnTimeout == "" {
+		req.OnTimeout = Reject
+	}
+	key := req.Ref()
+	p := &pending{req: req, done: make(chan Response, 1)}
+
+	g.mu.Lock()
+	if _, busy := g.pending[key]; busy {
+		g.mu.Unlock()
+		return Response{}, fmt.Errorf("approval for task #%04d is already pending", req.TaskNumber)
+	}
+	g.pending[key] = p
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		if g.pending[key] == p {
+			delete(g.pending, key)
+		}
+		g.mu.Unlock()
+	}()
+
+	if g.post != nil {
+		if err := g.post(ctx, req); err != nil {
+			return Response{}, fmt.Errorf("post approval request: %w", err)
+		}
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case resp := <-p.done:
+		return resp, nil
+	case <-timer.C:
+		if !g.withdraw(key, p) {
+			return <-p.done, nil
+		}
+		return Response{Decision: req.OnTimeout, TimedOut: true}, nil
+	case <-ctx.Done():
+		if !g.withdraw(key, p) {
+			return <-p.done, nil
+		}
+		return Response{}, ctx.Err()
+	}
+}
+
+// withdraw takes p off the pending set and reports whether it was still
+// there. False means a Resolve already claimed it: its answer is on the
+// way on p.done and wins over the timeout or cancellation.
+func (g *Gate) withdraw(key string, p *pending) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.pending[key] != p {
+		return false
+	}
+	delete(g.pending, key)
+	return true
+}
+
+// Resolve answers the pending approval for a project's task. The first
+// answer wins; later ones — and any arriving after the timeout decided
+// — get ErrNotPending.
+func (g *Gate) Resolve(projectID string, taskNumber int, d Decision, actor string) error {
+	g.mu.Lock()
+	p, ok := g.pending[Ref(projectID, taskNumber)]
+	if ok {
+		delete(g.pending, Ref(projectID, taskNumber))
+	}
+	g.mu.Unlock()
+	if !ok {
+		return ErrNotPending
+	}
+	p.done <- Response{Decision: d, Actor: actor}
+	return nil
+}
+
+// Pending returns the requests currently waiting, oldest first.
+func (g *Gate) Pending() []Request {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	out := make([]Request, 0, len(g.pending))
+	for _, p := range g.pending {
+		out = append(out, p.req)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].RequestedAt.Before(out[j].RequestedAt) })
+	return out
+}
@@ -0,0 +1,103 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestButtonDataRoundTrip(t *testing.T) {
+	data := ButtonData(Retry, "3f2b8c1e-9a4d-4c7e-8b1a-2d5e6f7a8b9c", 1234)
+	if len(data) > 64 {
+		t.Errorf("button data %q is %d bytes, over Telegram's 64", data, len(data))
+	}
+	d, project, n, ok := ParseButtonData(data)
+	if !ok || d != Retry || project != "3f2b8c1e-9a4d-4c7e-8b1a-2d5e6f7a8b9c" || n != 1234 {
+		t.Fatalf("ParseButtonData(%q) = %v %q %d %v", data, d, project, n, ok)
+	}
+	for _, bad := range []string{"use:p1", "approval:merge:p1|2", "approval:approve:p1", "approval:approve:p1|x", "approval:approve"} {
+		if _, _, _, ok := ParseButtonData(bad); ok {
+			t.Errorf("ParseButtonData(%q) should fail", bad)
+		}
+	}
+}
+
+// waitPending blocks until the gate has n pending requests.
+func waitPending(t *testing.T, g *Gate, n int) []Request {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if p := g.Pending(); len(p) == n {
+			return p
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("expected %d pending approvals, have %d", n, len(g.Pending()))
+	return nil
+}
+
+func TestGateResolve(t *testing.T) {
+	var posted []Request
+	g := NewGate(func(_ context.Context, req Request) error {
+		posted = append(posted, req)
+		return nil
+	})
+
+	done := make(chan Response, 1)
+	go func() {
+		resp, err := g.Await(context.Background(), Request{ProjectID: "p1", TaskNumber: 7}, time.Hour)
+		if err != nil {
+			t.Errorf("Await: %v", err)
+		}
+		done <- resp
+	}()
+	pending := waitPending(t, g, 1)
+	if pending[0].ExpiresAt.Sub(pending[0].RequestedAt) != time.Hour || pending[0].OnTimeout != Reject {
+		t.Errorf("pending request = %+v", pending[0])
+	}
+
+	if err := g.Resolve("p1", 8, Approve, "slack:U1"); !errors.Is(err, ErrNotPending) {
+		t.Errorf("resolving another task: want ErrNotPending, got %v", err)
+	}
+	if err := g.Resolve("p1", 7, Approve, "slack:U1"); err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if resp := <-done; resp.Decision != Approve || resp.Actor != "slack:U1" || resp.TimedOut {
+		t.Errorf("response = %+v", resp)
+	}
+	if len(posted) != 1 || posted[0].Ref() != "p1|7" {
+		t.Errorf("posted = %+v", posted)
+	}
+	if err := g.Resolve("p1", 7, Reject, "slack:U2"); !errors.Is(err, ErrNotPending) {
+		t.Errorf("second answer: want ErrNotPending, got %v", err)
+	}
+}
+
+func TestGateTimeoutAndPostFailure(t *testing.T) {
+	g := NewGate(nil)
+	resp, err := g.Await(context.Background(), Request{ProjectID: "p1", TaskNumber: 1, OnTimeout: Approve}, 10*time.Millisecond)
+	if err != nil || resp.Decision != Approve || !resp.TimedOut {
+		t.Errorf("timeout: resp=%+v err=%v", resp, err)
+	}
+	if len(g.Pending()) != 0 {
+		t.Error("an expired approval must not stay pending")
+	}
+	if err := g.Resolve("p1", 1, Reject, "slack:U1"); !errors.Is(err, ErrNotPending) {
+		t.Errorf("click after the timeout decided: want ErrNotPending, got %v", err)
+	}
+
+	failing := NewGate(func(context.Context, Request) error { return errors.New("no transport") })
+	if _, err := failing.Await(context.Background(), Request{ProjectID: "p1", TaskNumber: 1}, time.Hour); err == nil {
+		t.Error("a request nobody received should fail")
+	}
+	if len(failing.Pending()) != 0 {
+		t.Error("a failed post must not stay pending")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := g.Await(ctx, Request{ProjectID: "p1", TaskNumber: 2}, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled ctx: got %v", err)
+	}
+}
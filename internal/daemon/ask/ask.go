@@ -0,0 +1,284 @@
+// Package ask lets a working agent put a question to a person instead
+// of guessing or failing the task. The agent writes
+// `.watchfire/question.yaml`; the daemon turns it into a Question, posts
+// it to the project's chat endpoints (Slack, Discord, Telegram) and
+// surfaces it to the GUI / TUI as an `awaiting_input` agent issue. The
+// first answer — from a chat command, an option button, the GUI or
+// `watchfire answer` — is typed into the agent's session, which picks
+// up where it stopped.
+//
+// Like merge approvals, pending questions live in memory only: one per
+// project, withdrawn when the asking session ends.
+package ask
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxOptions caps how many suggested answers a question carries — one
+// row of buttons on every transport.
+const MaxOptions = 5
+
+// ErrNotPending is returned by Answer when the project has no question
+// waiting — or not the one the caller meant (already answered, or the
+// session that asked it ended).
+var ErrNotPending = errors.New("no question is waiting for an answer")
+
+// File is the shape of question.yaml as the agent writes it.
+type File struct {
+	Question string   `yaml:"question"`
+	Options  []string `yaml:"options,omitempty"`
+}
+
+// ReadFile parses question.yaml. A file with no question text is an
+// error, so the caller can leave a half-written file for the next
+// watcher event.
+func ReadFile(path string) (File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return File{}, err
+	}
+	var f File
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return File{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	f.Question = strings.TrimSpace(f.Question)
+	if f.Question == "" {
+		return File{}, fmt.Errorf("%s has no question", path)
+	}
+	opts := f.Options[:0]
+	for _, o := range f.Options {
+		if o = strings.TrimSpace(o); o != "" && len(opts) < MaxOptions {
+			opts = append(opts, o)
+		}
+	}
+	f.Options = opts
+	return f, nil
+}
+
+// Question is what the transports render.
+type Question struct {
+	// ID is unique per daemon run; buttons carry it so a click on an
+	// old question can't answer a newer one.
+	ID          int
+	ProjectID   string
+	ProjectName string
+	// TaskNumber is 0 when the asking session isn't working a task
+	// (e.g. a wildfire generate phase).
+	TaskNumber int
+	TaskTitle  string
+	Agent      string
+
+	Text    string
+	Options []string
+
+	AskedAt time.Time
+
+	// Session identifies the agent session that asked. Opaque here; the
+	// Deliverer compares it with the running session so an answer is
+	// never typed into a later session of the same task.
+	Session any
+}
+
+// Reply is an answer to a Question. Option, when > 0, picks the
+// question's 1-based suggested answer and overrides Text.
+type Reply struct {
+	QuestionID int // 0 = whichever question the project has waiting
+	Text       string
+	Option     int
+	Actor      string
+}
+
+// Button identifiers shared by the relay (which posts the option
+// buttons) and the inbound transports (which route the clicks back).
+// Slack buttons carry SlackActionPrefix+option as action_id and
+// Ref as value; Discord custom_ids and Telegram callbacks carry
+// ButtonData.
+const (
+	SlackActionPrefix = "watchfire_answer_"
+	ButtonPrefix      = "ask:"
+)
+
+// Ref is the `<projectID>:<questionID>` reference buttons carry back.
+func (q Question) Ref() string { return q.ProjectID + ":" + strconv.Itoa(q.ID) }
+
+// ParseRef splits a `<projectID>:<questionID>` reference.
+func ParseRef(ref string) (projectID string, questionID int, ok bool) {
+	idx := strings.LastIndex(ref, ":")
+	if idx <= 0 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(ref[idx+1:])
+	if err != nil || n <= 0 {
+		return "", 0, false
+	}
+	return ref[:idx], n, true
+}
+
+// ButtonData encodes picking option (1-based) of q for a Discord
+// custom_id or a Telegram callback (64 bytes max — a UUID project id
+// fits).
+func ButtonData(q Question, option int) string {
+	return ButtonPrefix + strconv.Itoa(option) + ":" + q.Ref()
+}
+
+// ParseButtonData is the inverse of ButtonData.
+func ParseButtonData(s string) (projectID string, questionID, option int, ok bool) {
+	rest, found := strings.CutPrefix(s, ButtonPrefix)
+	if !found {
+		return "", 0, 0, false
+	}
+	opt, ref, found := strings.Cut(rest, ":")
+	if !found {
+		return "", 0, 0, false
+	}
+	option, err := strconv.Atoi(opt)
+	if err != nil || option <= 0 || option > MaxOptions {
+		return "", 0, 0, false
+	}
+	projectID, questionID, ok = ParseRef(ref)
+	return projectID, questionID, option, ok
+}
+
+// Poster delivers a Question to the chat transports.
+type Poster func(ctx context.Context, q Question) error
+
+// Deliverer hands an answer to the session that asked. An error keeps
+// the question pending.
+type Deliverer func(q Question, answer Reply) error
+
+// Desk tracks the pending question of each project.
+type Desk struct {
+	post    Poster
+	deliver Deliverer
+	now     func() time.Time
+
+	mu      sync.Mutex
+	nextID  int
+	pending map[string]Question
+	// answering maps a project to the id of the question whose answer
+	// is being delivered right now.
+	answering map[string]int
+}
+
+// NewDesk returns a Desk posting through post and delivering answers
+// through deliver.
+func NewDesk(post Poster, deliver Deliverer) *Desk {
+	return &Desk{post: post, deliver: deliver, now: time.Now, pending: map[string]Question{}, answering: map[string]int{}}
+}
+
+// Ask registers q as its project's pending question — replacing any
+// older one — and posts it. The returned Question carries its ID and
+// AskedAt. A post error is returned with the question still pending:
+// the GUI, TUI and CLI can answer it even when no chat endpoint can.
+func (d *Desk) Ask(ctx context.Context, q Question) (Question, error) {
+	d.mu.Lock()
+	d.nextID++
+	q.ID = d.nextID
+	q.AskedAt = d.now().UTC()
+	d.pending[q.ProjectID] = q
+	d.mu.Unlock()
+
+	if d.post == nil {
+		return q, nil
+	}
+	if err := d.post(ctx, q); err != nil {
+		return q, fmt.Errorf("post question: %w", err)
+	}
+	return q, nil
+}
+
+// Answer delivers r to the project's pending question and clears it.
+// The first answer wins; later ones — including any arriving while the
+// first is still being delivered — get ErrNotPending. Delivery types
+// into a PTY, so it runs without the lock; the question stays pending
+// (and can be withdrawn) until it succeeds.
+func (d *Desk) Answer(projectID string, r Reply) (Question, error) {
+	q, r, err := d.claim(projectID, r)
+	if err != nil {
+		return Question{}, err
+	}
+	if d.deliver != nil {
+		err = d.deliver(q, r)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.answering[projectID] == q.ID {
+		delete(d.answering, projectID)
+	}
+	if err != nil {
+		return Question{}, err
+	}
+	if cur, ok := d.pending[projectID]; ok && cur.ID == q.ID {
+		delete(d.pending, projectID)
+	}
+	return q, nil
+}
+
+// claim validates r against the project's pending question and marks
+// the question as being answered, returning it with the normalised
+// reply.
+func (d *Desk) claim(projectID string, r Reply) (Question, Reply, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	q, ok := d.pending[projectID]
+	if !ok || (r.QuestionID != 0 && r.QuestionID != q.ID) || d.answering[projectID] == q.ID {
+		return Question{}, r, ErrNotPending
+	}
+	if r.Option > 0 {
+		if r.Option > len(q.Options) {
+			return Question{}, r, fmt.Errorf("question has no option %d", r.Option)
+		}
+		r.Text = q.Options[r.Option-1]
+	}
+	r.Text = strings.TrimSpace(r.Text)
+	if r.Text == "" {
+		return Question{}, r, errors.New("the answer is empty")
+	}
+	r.QuestionID = q.ID
+	d.answering[projectID] = q.ID
+	return q, r, nil
+}
+
+// Withdraw drops question id of the project (its session ended) and
+// reports whether it was still pending.
+func (d *Desk) Withdraw(projectID string, id int) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if q, ok := d.pending[projectID]; ok && q.ID == id {
+		delete(d.pending, projectID)
+		return true
+	}
+	return false
+}
+
+// Get returns the project's pending question.
+func (d *Desk) Get(projectID string) (Question, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	q, ok := d.pending[projectID]
+	return q, ok
+}
+
+// Pending returns every waiting question, oldest first.
+func (d *Desk) Pending() []Question {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := make([]Question, 0, len(d.pending))
+	for _, q := range d.pending {
+		out = append(out, q)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
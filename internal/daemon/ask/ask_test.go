@@ -0,0 +1,152 @@
+package ask
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "question.yaml")
+
+	write := func(body string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("question: |\n  Which database should the cache use?\noptions: [Redis, \" \", Memcached, a, b, c, d]\n")
+	f, err := ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if f.Question != "Which database should the cache use?" {
+		t.Errorf("question = %q", f.Question)
+	}
+	if want := []string{"Redis", "Memcached", "a", "b", "c"}; !reflect.DeepEqual(f.Options, want) {
+		t.Errorf("options = %q, want %q (blanks dropped, capped at %d)", f.Options, want, MaxOptions)
+	}
+
+	write("")
+	if _, err := ReadFile(path); err == nil {
+		t.Error("a half-written file should not parse")
+	}
+}
+
+func TestButtonDataRoundTrip(t *testing.T) {
+	q := Question{ID: 12345, ProjectID: "3f2b8c1e-9a4d-4c7e-8b1a-2d5e6f7a8b9c"}
+	data := ButtonData(q, 5)
+	if len(data) > 64 {
+		t.Errorf("button data %q is %d bytes, over Telegram's 64", data, len(data))
+	}
+	project, id, option, ok := ParseButtonData(data)
+	if !ok || project != q.ProjectID || id != 12345 || option != 5 {
+		t.Fatalf("ParseButtonData(%q) = %q %d %d %v", data, project, id, option, ok)
+	}
+	for _, bad := range []string{"approval:approve:p|1", "ask:0:p:1", "ask:9:p:1", "ask:1:p", "ask:1:p:x", "ask:1"} {
+		if _, _, _, ok := ParseButtonData(bad); ok {
+			t.Errorf("ParseButtonData(%q) should fail", bad)
+		}
+	}
+}
+
+func TestDeskAnswer(t *testing.T) {
+	var posted []Question
+	var delivered []Reply
+	d := NewDesk(func(_ context.Context, q Question) error {
+		posted = append(posted, q)
+		return nil
+	}, func(_ Question, r Reply) error {
+		delivered = append(delivered, r)
+		return nil
+	})
+
+	old, _ := d.Ask(context.Background(), Question{ProjectID: "p1", TaskNumber: 3, Text: "first?"})
+	q, err := d.Ask(context.Background(), Question{ProjectID: "p1", TaskNumber: 3, Text: "Which?", Options: []string{"A", "B"}})
+	if err != nil {
+		t.Fatalf("Ask: %v", err)
+	}
+	if q.ID == old.ID || q.AskedAt.IsZero() || len(posted) != 2 {
+		t.Fatalf("ask: q=%+v posted=%d", q, len(posted))
+	}
+
+	if _, err := d.Answer("p1", Reply{QuestionID: old.ID, Text: "late"}); !errors.Is(err, ErrNotPending) {
+		t.Errorf("a replaced question: want ErrNotPending, got %v", err)
+	}
+	if _, err := d.Answer("p1", Reply{Option: 3}); err == nil {
+		t.Error("an out-of-range option should fail")
+	}
+	if _, err := d.Answer("p1", Reply{Text: "  "}); err == nil {
+		t.Error("an empty answer should fail")
+	}
+	if len(delivered) != 0 {
+		t.Fatalf("rejected answers were delivered: %+v", delivered)
+	}
+
+	got, err := d.Answer("p1", Reply{QuestionID: q.ID, Option: 2, Actor: "slack:U1"})
+	if err != nil || got.ID != q.ID {
+		t.Fatalf("Answer: %+v %v", got, err)
+	}
+	if want := []Reply{{QuestionID: q.ID, Text: "B", Option: 2, Actor: "slack:U1"}}; !reflect.DeepEqual(delivered, want) {
+		t.Errorf("delivered = %+v, want %+v", delivered, want)
+	}
+	if _, err := d.Answer("p1", Reply{Text: "again"}); !errors.Is(err, ErrNotPending) {
+		t.Errorf("second answer: want ErrNotPending, got %v", err)
+	}
+}
+
+func TestDeskDeliveryFailureKeepsQuestion(t *testing.T) {
+	fail := errors.New("agent gone")
+	d := NewDesk(func(context.Context, Question) error { return errors.New("no chat") }, func(Question, Reply) error { return fail })
+
+	q, err := d.Ask(context.Background(), Question{ProjectID: "p1", Text: "?"})
+	if err == nil {
+		t.Error("a post failure should be reported")
+	}
+	if _, ok := d.Get("p1"); !ok {
+		t.Fatal("a post failure must keep the question pending for the GUI / TUI")
+	}
+	if _, err := d.Answer("p1", Reply{Text: "yes"}); !errors.Is(err, fail) {
+		t.Errorf("Answer: want delivery error, got %v", err)
+	}
+	if len(d.Pending()) != 1 {
+		t.Error("a failed delivery must keep the question pending")
+	}
+	if d.Withdraw("p1", q.ID+1) {
+		t.Error("withdrawing another question id must be a no-op")
+	}
+	if !d.Withdraw("p1", q.ID) || len(d.Pending()) != 0 {
+		t.Error("Withdraw should drop the question")
+	}
+}
+
+// TestDeskDeliversWithoutLock asserts delivery runs off the desk lock —
+// the desk stays readable mid-delivery — and that an answer arriving
+// while the first is being typed is refused.
+func TestDeskDeliversWithoutLock(t *testing.T) {
+	var d *Desk
+	var second error
+	d = NewDesk(nil, func(q Question, _ Reply) error {
+		if _, ok := d.Get(q.ProjectID); !ok {
+			t.Error("question should stay pending during delivery")
+		}
+		_, second = d.Answer(q.ProjectID, Reply{Text: "no"})
+		return nil
+	})
+	if _, err := d.Ask(context.Background(), Question{ProjectID: "p1", Text: "?"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Answer("p1", Reply{Text: "yes"}); err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if !errors.Is(second, ErrNotPending) {
+		t.Errorf("answer during delivery: want ErrNotPending, got %v", second)
+	}
+	if len(d.Pending()) != 0 {
+		t.Error("a delivered answer must clear the question")
+	}
+}
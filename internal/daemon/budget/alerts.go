@@ -0,0 +1,159 @@
+package budget
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// alertsFileName records which thresholds have already been notified
+// this month, so a daemon restart doesn't repeat them.
+const alertsFileName = "budget_alerts.yaml"
+
+// alertState is the on-disk shape of alertsFileName. Alerted maps
+// "global" or a project ID to the highest threshold notified in Month;
+// a new month starts from empty.
+type alertState struct {
+	Month   string         `yaml:"month"`
+	Alerted map[string]int `yaml:"alerted"`
+}
+
+// alertMu serializes CheckThresholds: captures finishing together must
+// not both see a threshold as new.
+var alertMu sync.Mutex
+
+func alertsPath() (string, error) {
+	dir, err := config.GlobalDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, alertsFileName), nil
+}
+
+// CheckThresholds emits one BUDGET_THRESHOLD notification for every
+// budget that has reached a threshold higher than any already notified
+// this month. Only the highest newly reached threshold is sent, so a
+// single expensive task crossing 50% and 80% at once yields one alert.
+// Called after each metrics capture — a task's or a non-task session's —
+// since those are the only times spend moves.
+//
+// Gating follows the other emitters (master toggle, project mute and
+// overrides, quiet hours); a suppressed alert still counts as sent.
+func CheckThresholds(bus *notify.Bus, now time.Time) {
+	statuses, err := Compute(now)
+	if err != nil || len(statuses) == 0 {
+		return
+	}
+
+	alertMu.Lock()
+	defer alertMu.Unlock()
+
+	path, err := alertsPath()
+	if err != nil {
+		return
+	}
+	var state alertState
+	if config.FileExists(path) {
+		if err := config.LoadYAML(path, &state); err != nil {
+			log.Printf("[budget] failed to read %s: %v", alertsFileName, err)
+		}
+	}
+	month := MonthKey(now)
+	if state.Month != month || state.Alerted == nil {
+		state = alertState{Month: month, Alerted: map[string]int{}}
+	}
+
+	changed := false
+	for _, s := range statuses {
+		key := ScopeGlobal
+		if s.Scope == ScopeProject {
+			key = s.ProjectID
+		}
+		if s.Threshold == 0 || s.Threshold <= state.Alerted[key] {
+			continue
+		}
+		state.Alerted[key] = s.Threshold
+		changed = true
+		emitThreshold(bus, s, now)
+	}
+	if changed {
+		if err := config.SaveYAML(path, &state); err != nil {
+			log.Printf("[budget] failed to write %s: %v", alertsFileName, err)
+		}
+	}
+}
+
+func emitThreshold(bus *notify.Bus, s Status, now time.Time) {
+	settings, _ := config.LoadSettings()
+	cfg := models.DefaultNotifications()
+	if settings != nil {
+		cfg = settings.Defaults.Notifications
+	}
+	var projectNotif models.ProjectNotifications
+	if s.Scope == ScopeProject {
+		if entry := findProject(s.ProjectID); entry != nil {
+			if proj, _ := config.LoadProject(entry.Path); proj != nil {
+				projectNotif = proj.Notifications
+			}
+		}
+	}
+	if !models.ShouldNotify(models.NotificationBudgetThreshold, cfg, projectNotif, now.Local()) {
+		log.Printf("[budget] %s budget at %d%% — notification suppressed by preferences", s.Label(), s.Threshold)
+		return
+	}
+
+	emittedAt := now.UTC()
+	title := fmt.Sprintf("Watchfire — %d%% of the monthly budget used", s.Threshold)
+	if s.Scope == ScopeProject {
+		title = fmt.Sprintf("%s — %d%% of the monthly budget used", s.Label(), s.Threshold)
+	}
+	body := fmt.Sprintf("$%.2f of $%.2f spent in %s.", s.SpentUSD, s.LimitUSD, s.Month)
+	if s.Blocking() {
+		body += " New agent runs are paused until next month."
+	}
+	idScope := "_global"
+	if s.Scope == ScopeProject {
+		idScope = s.ProjectID
+	}
+	n := notify.Notification{
+		ID:        notify.MakeID(notify.KindBudgetThreshold, idScope, int32(s.Threshold), emittedAt),
+		Kind:      notify.KindBudgetThreshold,
+		ProjectID: s.ProjectID,
+		Title:     title,
+		Body:      body,
+		EmittedAt: emittedAt,
+		Budget: &notify.BudgetAlert{
+			Scope:     s.Scope,
+			Month:     s.Month,
+			Threshold: s.Threshold,
+			SpentUSD:  s.SpentUSD,
+			LimitUSD:  s.LimitUSD,
+			HardStop:  s.HardStop,
+		},
+	}
+
+	bus.Emit(n)
+	appendLog := notify.AppendLogLine
+	if n.ProjectID == "" {
+		appendLog = notify.AppendGlobalLogLine
+	}
+	if err := appendLog(n); err != nil {
+		log.Printf("[budget] failed to append notification log: %v", err)
+	} else {
+		log.Printf("[budget] %s budget at %d%% ($%.2f of $%.2f)", s.Label(), s.Threshold, s.SpentUSD, s.LimitUSD)
+	}
+}
+
+func findProject(projectID string) *models.ProjectEntry {
+	index, err := config.LoadProjectsIndex()
+	if err != nil {
+		return nil
+	}
+	return index.FindProject(projectID)
+}
@@ -0,0 +1,200 @@
+// Package budget enforces monthly spend budgets. Spend is derived from
+// the metrics sidecars — the cost_usd captured (or estimated from the
+// pricing table) when each task or non-task session finished — summed
+// over the current calendar month in local time.
+//
+// Two scopes exist: the global budget in settings.yaml covers every
+// registered project, and a project's own budget in project.yaml covers
+// just that project. Both apply; whichever is exhausted first stops new
+// agent starts when its hard_stop is set.
+package budget
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// Scopes.
+const (
+	ScopeGlobal  = "global"
+	ScopeProject = "project"
+)
+
+// ErrExceeded is matched (via errors.Is) by every *ExceededError.
+var ErrExceeded = errors.New("monthly budget exceeded")
+
+// Status is one budget's standing for the month.
+type Status struct {
+	Scope       string
+	ProjectID   string // empty for the global budget
+	ProjectName string
+	Month       string // YYYY-MM
+	LimitUSD    float64
+	SpentUSD    float64
+	// Threshold is the highest configured threshold spend has reached;
+	// 0 when none has been.
+	Threshold int
+	HardStop  bool
+}
+
+// Percent is spend as a percentage of the limit.
+func (s Status) Percent() float64 {
+	if s.LimitUSD <= 0 {
+		return 0
+	}
+	return s.SpentUSD / s.LimitUSD * 100
+}
+
+// Exceeded reports whether spend has reached the limit.
+func (s Status) Exceeded() bool {
+	return s.LimitUSD > 0 && s.SpentUSD >= s.LimitUSD
+}
+
+// Blocking reports whether this budget refuses new agent starts.
+func (s Status) Blocking() bool {
+	return s.HardStop && s.Exceeded()
+}
+
+// Label names the budget for messages: "global" or the project name.
+func (s Status) Label() string {
+	if s.Scope == ScopeGlobal {
+		return "global"
+	}
+	if s.ProjectName != "" {
+		return s.ProjectName
+	}
+	return s.ProjectID
+}
+
+// ExceededError is returned by Check when a hard-stop budget is spent.
+type ExceededError struct {
+	Status Status
+}
+
+func (e *ExceededError) Error() string {
+	s := e.Status
+	return fmt.Sprintf("%s: %s budget spent ($%.2f of $%.2f for %s); raise the budget or start with the budget override",
+		ErrExceeded, s.Label(), s.SpentUSD, s.LimitUSD, s.Month)
+}
+
+// Is lets errors.Is(err, ErrExceeded) match.
+func (e *ExceededError) Is(target error) bool { return target == ErrExceeded }
+
+// MonthKey formats t's local calendar month as YYYY-MM.
+func MonthKey(t time.Time) string {
+	return t.Local().Format("2006-01")
+}
+
+// monthStart returns the first instant of t's local calendar month.
+func monthStart(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+}
+
+// ProjectSpend sums cost_usd over the project's metrics sidecars
+// captured in now's calendar month: the task sidecars plus the session
+// records of its non-task (chat / generate / wildfire overhead) sessions.
+// Sidecars of deleted tasks count: the money was spent either way.
+func ProjectSpend(projectID, projectPath string, now time.Time) float64 {
+	from := monthStart(now)
+	to := from.AddDate(0, 1, 0)
+	inMonth := func(cost *float64, at time.Time) bool {
+		return cost != nil && !at.Before(from) && at.Before(to)
+	}
+	var total float64
+	if entries, err := os.ReadDir(config.ProjectTasksDir(projectPath)); err == nil {
+		for _, e := range entries {
+			if e.IsDir() || !strings.HasSuffix(e.Name(), config.MetricsFileSuffix) {
+				continue
+			}
+			var m models.TaskMetrics
+			if err := config.LoadYAML(filepath.Join(config.ProjectTasksDir(projectPath), e.Name()), &m); err != nil {
+				continue
+			}
+			if inMonth(m.CostUSD, m.CapturedAt) {
+				total += *m.CostUSD
+			}
+		}
+	}
+	sessions, _ := config.ListSessionMetrics(projectID)
+	for _, m := range sessions {
+		if inMonth(m.CostUSD, m.CapturedAt) {
+			total += *m.CostUSD
+		}
+	}
+	return total
+}
+
+// Compute returns the standing of every configured budget: the global
+// one first (when set), then each project that has its own.
+func Compute(now time.Time) ([]Status, error) {
+	index, err := config.LoadProjectsIndex()
+	if err != nil {
+		return nil, err
+	}
+	settings, _ := config.LoadSettings()
+	month := MonthKey(now)
+
+	var global float64
+	var out []Status
+	for _, entry := range index.Projects {
+		spent := ProjectSpend(entry.ProjectID, entry.Path, now)
+		global += spent
+		proj, perr := config.LoadProject(entry.Path)
+		if perr != nil || proj == nil {
+			continue
+		}
+		if b := proj.EffectiveBudget(); b != nil && b.MonthlyUSD > 0 {
+			out = append(out, newStatus(ScopeProject, entry.ProjectID, entry.Name, month, *b, spent))
+		}
+	}
+	if settings != nil && settings.Budget != nil && settings.Budget.MonthlyUSD > 0 {
+		out = append([]Status{newStatus(ScopeGlobal, "", "", month, *settings.Budget, global)}, out...)
+	}
+	return out, nil
+}
+
+func newStatus(scope, projectID, projectName, month string, b models.BudgetConfig, spent float64) Status {
+	s := Status{
+		Scope:       scope,
+		ProjectID:   projectID,
+		ProjectName: projectName,
+		Month:       month,
+		LimitUSD:    b.MonthlyUSD,
+		SpentUSD:    spent,
+		HardStop:    b.HardStop,
+	}
+	pct := s.Percent()
+	for _, t := range b.Thresholds {
+		if pct >= float64(t) {
+			s.Threshold = t
+		}
+	}
+	return s
+}
+
+// Check returns an *ExceededError when a hard-stop budget covering
+// projectID — the global one or the project's own — is spent, and nil
+// otherwise. Failing to compute spend never blocks a start.
+func Check(projectID string, now time.Time) error {
+	statuses, err := Compute(now)
+	if err != nil {
+		return nil
+	}
+	for _, s := range statuses {
+		if s.Scope == ScopeProject && s.ProjectID != projectID {
+			continue
+		}
+		if s.Blocking() {
+			return &ExceededError{Status: s}
+		}
+	}
+	return nil
+}
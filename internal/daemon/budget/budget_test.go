@@ -0,0 +1,159 @@
+package budget
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// setup registers one project under a temp HOME with a global $100
+// budget and a $20 project budget, both hard-stop.
+func setup(t *testing.T) (projectID, projectPath string) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+
+	p := models.NewProject("proj-budget", "spendy", dir)
+	p.Budget = &models.BudgetConfig{MonthlyUSD: 20, HardStop: true}
+	if err := config.SaveProject(dir, p); err != nil {
+		t.Fatal(err)
+	}
+	index := models.NewProjectsIndex()
+	index.AddProject(models.ProjectEntry{ProjectID: p.ProjectID, Name: p.Name, Path: dir})
+	if err := config.SaveProjectsIndex(index); err != nil {
+		t.Fatal(err)
+	}
+	settings := models.NewSettings()
+	settings.Budget = &models.BudgetConfig{MonthlyUSD: 100, Thresholds: []int{50, 80, 100}, HardStop: true}
+	if err := config.SaveSettings(settings); err != nil {
+		t.Fatal(err)
+	}
+	return p.ProjectID, dir
+}
+
+func spend(t *testing.T, projectPath string, n int, usd float64, at time.Time) {
+	t.Helper()
+	m := &models.TaskMetrics{TaskNumber: n, CostUSD: &usd, CapturedAt: at}
+	if err := config.WriteMetrics(projectPath, m); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestComputeSumsCurrentMonthOnly(t *testing.T) {
+	projectID, dir := setup(t)
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.Local)
+	spend(t, dir, 1, 9, now.Add(-time.Hour))
+	spend(t, dir, 2, 500, now.AddDate(0, -1, 0)) // last month
+
+	statuses, err := Compute(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("got %d statuses, want 2", len(statuses))
+	}
+	global, proj := statuses[0], statuses[1]
+	if global.Scope != ScopeGlobal || global.SpentUSD != 9 || global.Threshold != 0 {
+		t.Errorf("global = %+v", global)
+	}
+	if proj.ProjectID != projectID || proj.Month != "2026-05" || proj.Threshold != 0 {
+		t.Errorf("project = %+v", proj)
+	}
+	if err := Check(projectID, now); err != nil {
+		t.Errorf("Check under budget = %v", err)
+	}
+}
+
+// Chat / generate overhead sessions count toward the cap alongside
+// task sidecars.
+func TestProjectSpendIncludesSessionOverhead(t *testing.T) {
+	projectID, dir := setup(t)
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.Local)
+	spend(t, dir, 1, 9, now)
+	chat, old := 4.5, 100.0
+	for _, m := range []*models.SessionMetrics{
+		{ProjectID: projectID, LogID: "chat-1-2026-05-15T10-00-00", Mode: "chat", CostUSD: &chat, CapturedAt: now.Add(-time.Hour)},
+		{ProjectID: projectID, LogID: "chat-1-2026-04-15T10-00-00", Mode: "chat", CostUSD: &old, CapturedAt: now.AddDate(0, -1, 0)},
+	} {
+		if err := config.WriteSessionMetrics(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := ProjectSpend(projectID, dir, now); got != 13.5 {
+		t.Fatalf("ProjectSpend = %v, want 13.5", got)
+	}
+}
+
+func TestCheckRefusesSpentHardStopBudget(t *testing.T) {
+	projectID, dir := setup(t)
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.Local)
+	spend(t, dir, 1, 21, now)
+
+	err := Check(projectID, now)
+	if !errors.Is(err, ErrExceeded) {
+		t.Fatalf("Check = %v, want ErrExceeded", err)
+	}
+	var ee *ExceededError
+	if !errors.As(err, &ee) || ee.Status.Scope != ScopeProject {
+		t.Errorf("exceeded status = %+v", ee)
+	}
+	// The project budget doesn't cover other projects; the global one
+	// still has room.
+	if err := Check("some-other-project", now); err != nil {
+		t.Errorf("Check for another project = %v", err)
+	}
+}
+
+func TestCheckThresholdsAlertsOncePerThreshold(t *testing.T) {
+	_, dir := setup(t)
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.Local)
+	bus := notify.NewBus()
+	ch, unsub := bus.Subscribe()
+	defer unsub()
+
+	drain := func() []notify.Notification {
+		var got []notify.Notification
+		for {
+			select {
+			case n := <-ch:
+				got = append(got, n)
+			default:
+				return got
+			}
+		}
+	}
+
+	// $11 is 55% of the project budget and 11% of the global one.
+	spend(t, dir, 1, 11, now)
+	CheckThresholds(bus, now)
+	got := drain()
+	if len(got) != 1 || got[0].Budget == nil || got[0].Budget.Threshold != 50 || got[0].Budget.Scope != ScopeProject {
+		t.Fatalf("first check = %+v", got)
+	}
+
+	// Nothing new reached: no repeat.
+	CheckThresholds(bus, now)
+	if got := drain(); len(got) != 0 {
+		t.Fatalf("repeat check emitted %+v", got)
+	}
+
+	// Jumping past 80% and 100% at once yields only the highest.
+	spend(t, dir, 2, 10, now)
+	CheckThresholds(bus, now)
+	got = drain()
+	if len(got) != 1 || got[0].Budget.Threshold != 100 {
+		t.Fatalf("after crossing = %+v", got)
+	}
+
+	// A new month starts over.
+	next := now.AddDate(0, 1, 0)
+	spend(t, dir, 3, 15, next)
+	CheckThresholds(bus, next)
+	if got := drain(); len(got) != 1 || got[0].Budget.Month != "2026-06" || got[0].Budget.Threshold != 50 {
+		t.Fatalf("next month = %+v", got)
+	}
+}
@@ -269,6 +269,13 @@ func (l *lazyDaemonState) DigestsDir() string {
 	return ""
 }
 
+func (l *lazyDaemonState) Budgets() []tray.BudgetInfo {
+	if srv := l.getSrv(); srv != nil {
+		return server.NewTrayState(srv).Budgets()
+	}
+	return nil
+}
+
 // waitForPort polls until a TCP connection to the given port succeeds or the timeout expires.
 func waitForPort(port int, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
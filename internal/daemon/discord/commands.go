@@ -5,7 +5,7 @@
 // register-discord <guild>` — manual fallback, see
 // `internal/cli/integrations_discord.go`) and the v8.x Echo daemon
 // auto-registrar (this directory's `gateway.go` + `registrar.go`). Both
-// callers POST the same three commands; only the trigger differs.
+// callers POST the same commands; only the trigger differs.
 //
 // Discord deduplicates by command name on POST, so re-running registration
 // is idempotent — both the CLI and the auto-registrar rely on this. See:
@@ -49,8 +49,10 @@ type CommandOption struct {
 }
 
 // WatchfireSlashCommands is the canonical command roster Watchfire registers
-// for v8.0 Echo. Adding a fourth command later is a constant edit + a
-// re-register; the registrar will land it on the next guild event.
+// for v8.0 Echo, plus `answer` for agent questions and the run controls
+// (`run`, `stop`, `new`, `screen`, `watch`). Adding a command is a
+// constant edit + a re-register; the registrar will land it on the next
+// guild event.
 func WatchfireSlashCommands() []SlashCommand {
 	return []SlashCommand{
 		{
@@ -77,6 +79,57 @@ func WatchfireSlashCommands() []SlashCommand {
 				{Type: 3, Name: "task", Description: "Task id or task number", Required: true},
 			},
 		},
+		{
+			Name:        "answer",
+			Type:        1,
+			Description: "Answer a question a Watchfire agent is waiting on",
+			Options: []CommandOption{
+				{Type: 3, Name: "text", Description: "Your answer; start with #<task> when several agents wait", Required: true},
+			},
+		},
+		{
+			Name:        "run",
+			Type:        1,
+			Description: "Start a Watchfire agent",
+			Options: []CommandOption{
+				{Type: 3, Name: "what", Description: "Task number, all, wildfire, plan or generate", Required: true},
+				{Type: 3, Name: "project", Description: "Project, when several are mapped", Required: false},
+			},
+		},
+		{
+			Name:        "stop",
+			Type:        1,
+			Description: "Stop the running Watchfire agent",
+			Options: []CommandOption{
+				{Type: 3, Name: "project", Description: "Project, when several agents run", Required: false},
+			},
+		},
+		{
+			Name:        "new",
+			Type:        1,
+			Description: "Create a ready Watchfire task",
+			Options: []CommandOption{
+				{Type: 3, Name: "title", Description: "Task title (also its prompt)", Required: true},
+				{Type: 3, Name: "project", Description: "Project, when several are mapped", Required: false},
+			},
+		},
+		{
+			Name:        "screen",
+			Type:        1,
+			Description: "Show the running Watchfire agent's screen",
+			Options: []CommandOption{
+				{Type: 3, Name: "project", Description: "Project, when several agents run", Required: false},
+			},
+		},
+		{
+			Name:        "watch",
+			Type:        1,
+			Description: "Post a Watchfire agent's screen here while it runs",
+			Options: []CommandOption{
+				{Type: 3, Name: "state", Description: "on or off; leave out to see the current setting", Required: false},
+				{Type: 3, Name: "project", Description: "Project, when several are mapped", Required: false},
+			},
+		},
 	}
 }
 
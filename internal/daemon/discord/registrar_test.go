@@ -46,8 +46,8 @@ func TestRegistrarRegistersOnGuildCreate(t *testing.T) {
 
 	gw.events <- GuildEvent{Type: GuildEventCreate, GuildID: "g1", GuildName: "Guild One"}
 
-	// Wait for the registrar to record the status. Three commands × one
-	// guild = 3 POSTs.
+	// Wait for the registrar to record the status. Nine commands × one
+	// guild = 9 POSTs.
 	deadline := time.Now().Add(2 * time.Second)
 	for time.Now().Before(deadline) {
 		if s, ok := reg.Status("g1"); ok && s.Registered {
@@ -66,8 +66,8 @@ func TestRegistrarRegistersOnGuildCreate(t *testing.T) {
 	if s.GuildName != "Guild One" {
 		t.Fatalf("expected guild name preserved, got %q", s.GuildName)
 	}
-	if posts.Load() != 3 {
-		t.Fatalf("expected 3 POSTs (one per command), got %d", posts.Load())
+	if posts.Load() != 9 {
+		t.Fatalf("expected 9 POSTs (one per command), got %d", posts.Load())
 	}
 
 	cancel()
@@ -0,0 +1,41 @@
+package echo
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor tags ctx with the identity of the chat user a command came
+// from ("telegram:123456789", see config.RemoteUser), so whatever the
+// command ends up starting or creating is attributed to them. Transports
+// set it once per inbound update; the daemon's run-control adapters read
+// it back with Actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor is the identity WithActor attached to ctx, or "".
+func Actor(ctx context.Context) string {
+	a, _ := ctx.Value(actorKey{}).(string)
+	return a
+}
+
+type memberRolesKey struct{}
+
+// WithMemberRoles tags ctx with the Discord role ids of the invoking
+// guild member, which the daemon's role resolver matches against the
+// guild's role mapping.
+func WithMemberRoles(ctx context.Context, roles []string) context.Context {
+	if len(roles) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, memberRolesKey{}, roles)
+}
+
+// MemberRoles is the role id list WithMemberRoles attached to ctx.
+func MemberRoles(ctx context.Context) []string {
+	r, _ := ctx.Value(memberRolesKey{}).([]string)
+	return r
+}
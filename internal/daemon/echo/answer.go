@@ -0,0 +1,114 @@
+package echo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+)
+
+// routeAnswer handles `/watchfire answer [#<task>] <text>`: the text is
+// typed into the session of the agent that asked. With one question
+// waiting across the mapped projects the task can be left out; with
+// several, `#<task>` picks one.
+func routeAnswer(ctx context.Context, rest string, cc CommandContext) *CommandResponse {
+	if cc.PendingQuestion == nil || cc.AnswerQuestion == nil {
+		return errorResponse("Agent questions are not enabled on this Watchfire daemon.")
+	}
+	text := strings.TrimSpace(rest)
+	if text == "" {
+		return errorResponse("Usage: `/watchfire answer [#<task>] <text>`")
+	}
+	projects, err := cc.FindProjects(ctx)
+	if err != nil {
+		return errorResponse(fmt.Sprintf("Failed to load projects: %v", err))
+	}
+	var waiting []ask.Question
+	for _, p := range projects {
+		if q, ok := cc.PendingQuestion(ctx, p.ID); ok {
+			waiting = append(waiting, q)
+		}
+	}
+	if len(waiting) == 0 {
+		return errorResponse("No agent is waiting for an answer in the projects mapped here.")
+	}
+
+	target := -1
+	if ref, after, found := strings.Cut(text, " "); found && strings.HasPrefix(ref, "#") {
+		n, convErr := strconv.Atoi(strings.TrimPrefix(ref, "#"))
+		if convErr == nil {
+			for i, q := range waiting {
+				if q.TaskNumber == n {
+					target = i
+					break
+				}
+			}
+			if target < 0 {
+				return errorResponse(fmt.Sprintf("Task #%04d has no question waiting.", n))
+			}
+			text = strings.TrimSpace(after)
+		}
+	}
+	if target < 0 {
+		if len(waiting) > 1 {
+			lines := []string{"Several agents are waiting — pick one with `/watchfire answer #<task> <text>`:"}
+			for _, q := range waiting {
+				lines = append(lines, fmt.Sprintf("• `#%04d` %s — %s", q.TaskNumber, q.ProjectName, q.Text))
+			}
+			return errorResponse(strings.Join(lines, "\n"))
+		}
+		target = 0
+	}
+	q := waiting[target]
+	return RouteAnswer(ctx, q.ProjectID, ask.Reply{QuestionID: q.ID, Text: text}, cc)
+}
+
+// RouteAnswer answers a project's pending question — the `answer`
+// command, option buttons (Slack `watchfire_answer_*` actions, Discord
+// components, Telegram inline buttons) and Telegram plain-text replies
+// all land here. The reply is posted in-channel so everyone who saw
+// the question sees the answer.
+func RouteAnswer(ctx context.Context, projectID string, r ask.Reply, cc CommandContext) *CommandResponse {
+	if cc.AnswerQuestion == nil {
+		return errorResponse("Agent questions are not enabled on this Watchfire daemon.")
+	}
+	if denied := authorize(ctx, "answer", cc); denied != nil {
+		return denied
+	}
+	q, ok := ask.Question{}, false
+	if cc.PendingQuestion != nil {
+		q, ok = cc.PendingQuestion(ctx, projectID)
+	}
+	if !ok || (r.QuestionID != 0 && r.QuestionID != q.ID) {
+		return errorResponse("That question is not waiting for an answer any more — it was already answered or its session ended.")
+	}
+	if r.Option > 0 && r.Option <= len(q.Options) {
+		r.Text = q.Options[r.Option-1]
+	}
+	if err := cc.AnswerQuestion(ctx, projectID, r); err != nil {
+		if errors.Is(err, ask.ErrNotPending) {
+			return errorResponse("That question is not waiting for an answer any more — it was already answered or its session ended.")
+		}
+		return errorResponse(fmt.Sprintf("Answer failed: %v", err))
+	}
+
+	subject := q.ProjectName
+	if q.TaskNumber > 0 {
+		subject = fmt.Sprintf("task #%04d (%s)", q.TaskNumber, q.ProjectName)
+	}
+	by := ""
+	if cc.UserID != "" {
+		by = " by " + cc.UserID
+	}
+	answer := strings.TrimSpace(r.Text)
+	return &CommandResponse{
+		InChannel: true,
+		Text:      fmt.Sprintf("💬 Answered — %s%s: %s", subject, by, answer),
+		Blocks: []Block{
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("💬 *Answered* — %s%s\n>%s", cc.mrkdwn(subject), by, answer)},
+		},
+	}
+}
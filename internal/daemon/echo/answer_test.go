@@ -0,0 +1,180 @@
+package echo
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+)
+
+// questionDesk backs the question callbacks of cc with a real ask.Desk
+// and records the answers it delivers.
+func questionDesk(cc *CommandContext, delivered *[]ask.Reply) *ask.Desk {
+	desk := ask.NewDesk(nil, func(_ ask.Question, r ask.Reply) error {
+		*delivered = append(*delivered, r)
+		return nil
+	})
+	cc.PendingQuestion = func(_ context.Context, projectID string) (ask.Question, bool) {
+		return desk.Get(projectID)
+	}
+	cc.AnswerQuestion = func(_ context.Context, projectID string, r ask.Reply) error {
+		_, err := desk.Answer(projectID, r)
+		return err
+	}
+	return desk
+}
+
+func TestRouteAnswerCommand(t *testing.T) {
+	ctx := context.Background()
+	var delivered []ask.Reply
+	cc := slackTestCommandContext("T1", "U1")
+	cc.FindProjects = func(context.Context) ([]ProjectInfo, error) {
+		return []ProjectInfo{{ID: "proj-abc", Name: "Watchfire"}, {ID: "proj-def", Name: "Ember"}}, nil
+	}
+	desk := questionDesk(&cc, &delivered)
+
+	if resp := Route(ctx, "/watchfire", "answer", "Redis", cc); !resp.Ephemeral || !strings.Contains(resp.Text, "No agent is waiting") {
+		t.Errorf("nothing pending: %+v", resp)
+	}
+
+	_, _ = desk.Ask(ctx, ask.Question{ProjectID: "proj-abc", ProjectName: "Watchfire", TaskNumber: 12, Text: "Which cache?"})
+	resp := Route(ctx, "/watchfire", "answer", "Use Redis", cc)
+	if !resp.InChannel || !strings.Contains(resp.Text, "Answered — task #0012 (Watchfire) by U1: Use Redis") {
+		t.Fatalf("single question reply = %+v", resp)
+	}
+	if len(delivered) != 1 || delivered[0].Text != "Use Redis" {
+		t.Fatalf("delivered = %+v", delivered)
+	}
+
+	_, _ = desk.Ask(ctx, ask.Question{ProjectID: "proj-abc", ProjectName: "Watchfire", TaskNumber: 12, Text: "Which cache?"})
+	_, _ = desk.Ask(ctx, ask.Question{ProjectID: "proj-def", ProjectName: "Ember", TaskNumber: 3, Text: "Which port?"})
+	resp = Route(ctx, "/watchfire", "answer", "8080", cc)
+	if !resp.Ephemeral || !strings.Contains(resp.Text, "Several agents are waiting") || !strings.Contains(resp.Text, "`#0003` Ember — Which port?") {
+		t.Errorf("several waiting: %+v", resp)
+	}
+	if resp := Route(ctx, "/watchfire", "answer", "#9 8080", cc); !strings.Contains(resp.Text, "Task #0009 has no question waiting") {
+		t.Errorf("unknown task: %+v", resp)
+	}
+	resp = Route(ctx, "/watchfire", "answer", "#3   8080", cc)
+	if !resp.InChannel || len(delivered) != 2 || delivered[1].Text != "8080" {
+		t.Fatalf("#3 answer: resp=%+v delivered=%+v", resp, delivered)
+	}
+	if _, waiting := desk.Get("proj-abc"); !waiting {
+		t.Error("answering #3 must leave the other question pending")
+	}
+
+	cc.PendingQuestion, cc.AnswerQuestion = nil, nil
+	if resp := Route(ctx, "/watchfire", "answer", "x", cc); !strings.Contains(resp.Text, "not enabled") {
+		t.Errorf("unwired reply = %+v", resp)
+	}
+}
+
+func TestRouteAnswerStaleQuestion(t *testing.T) {
+	ctx := context.Background()
+	var delivered []ask.Reply
+	cc := slackTestCommandContext("T1", "U1")
+	desk := questionDesk(&cc, &delivered)
+	old, _ := desk.Ask(ctx, ask.Question{ProjectID: "proj-abc", Text: "first?", Options: []string{"A"}})
+	_, _ = desk.Ask(ctx, ask.Question{ProjectID: "proj-abc", Text: "second?", Options: []string{"B"}})
+
+	resp := RouteAnswer(ctx, "proj-abc", ask.Reply{QuestionID: old.ID, Option: 1}, cc)
+	if !resp.Ephemeral || !strings.Contains(resp.Text, "not waiting for an answer any more") || len(delivered) != 0 {
+		t.Errorf("old button: resp=%+v delivered=%+v", resp, delivered)
+	}
+}
+
+func TestSlackHandlerAnswerButton(t *testing.T) {
+	secret := []byte("supersecret")
+	ts := nowSlackTS(t)
+	t.Cleanup(func() { SetClockForTest(nil) })
+
+	var delivered []ask.Reply
+	var desk *ask.Desk
+	h := newSlackHandler(t, secret, func(cfg *SlackHandlerConfig) {
+		cfg.CommandContextFor = func(teamID, userID string) CommandContext {
+			cc := slackTestCommandContext(teamID, userID)
+			desk = questionDesk(&cc, &delivered)
+			_, _ = desk.Ask(context.Background(), ask.Question{ProjectID: "proj-abc", ProjectName: "Watchfire", Text: "Which cache?", Options: []string{"Redis", "Memcached"}})
+			return cc
+		}
+	})
+
+	payload := `{
+		"type":"block_actions",
+		"team":{"id":"T123"},
+		"user":{"id":"U456"},
+		"trigger_id":"trig-answer",
+		"actions":[{"action_id":"watchfire_answer_2","value":"proj-abc:1","type":"button","block_id":"watchfire_answer"}]
+	}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedSlackRequest(t, secret, payload, ts))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d (%s)", w.Code, w.Body.String())
+	}
+	if len(delivered) != 1 || delivered[0].Text != "Memcached" {
+		t.Fatalf("delivered = %+v", delivered)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("malformed response: %v", err)
+	}
+	if text, _ := doc["text"].(string); !strings.Contains(text, "Answered — Watchfire by U456: Memcached") {
+		t.Errorf("text = %q", text)
+	}
+
+	payload = strings.Replace(strings.Replace(payload, "trig-answer", "trig-2", 1), "proj-abc:1", "nope", 1)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedSlackRequest(t, secret, payload, ts))
+	if len(delivered) != 1 || !strings.Contains(w.Body.String(), "malformed") {
+		t.Errorf("malformed button: delivered=%+v body=%s", delivered, w.Body.String())
+	}
+}
+
+func TestDiscordHandlerAnswerComponent(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	now := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	SetClockForTest(func() time.Time { return now })
+	t.Cleanup(func() { SetClockForTest(nil) })
+
+	var delivered []ask.Reply
+	h := NewDiscordHandler(DiscordHandlerConfig{
+		ResolvePublicKey: func() (ed25519.PublicKey, error) { return pub, nil },
+		Idempotency:      NewCache(0, 0),
+		CommandContextFor: func(guildID, userID string) CommandContext {
+			cc := testCommandContext(guildID, userID)
+			desk := questionDesk(&cc, &delivered)
+			_, _ = desk.Ask(context.Background(), ask.Question{ProjectID: "proj-a", ProjectName: "alpha", TaskNumber: 7, Text: "Which port?", Options: []string{"80", "8080"}})
+			return cc
+		},
+	})
+
+	body := []byte(`{
+		"id":"comp-answer",
+		"type":3,
+		"guild_id":"g",
+		"member":{"user":{"id":"D789"}},
+		"data":{"custom_id":"ask:2:proj-a:1","component_type":2}
+	}`)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(t, priv, body, strconv.FormatInt(now.Unix(), 10)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d (%s)", w.Code, w.Body.String())
+	}
+	if len(delivered) != 1 || delivered[0].Text != "8080" {
+		t.Fatalf("delivered = %+v", delivered)
+	}
+	var doc map[string]any
+	_ = json.Unmarshal(w.Body.Bytes(), &doc)
+	content, _ := doc["data"].(map[string]any)["content"].(string)
+	if !strings.Contains(content, "Answered — task #0007 (alpha)") {
+		t.Errorf("content = %q", content)
+	}
+}
@@ -0,0 +1,61 @@
+package echo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+)
+
+// RouteApproval answers a merge approval from a button click — Slack
+// `watchfire_approval_*` actions, Discord components and Telegram inline
+// buttons all land here with the decision and task decoded from the
+// button. The reply is posted in-channel so everyone who saw the request
+// sees how it was answered.
+func RouteApproval(ctx context.Context, projectID string, taskNumber int, decision approval.Decision, cc CommandContext) *CommandResponse {
+	if cc.ResolveApproval == nil {
+		return errorResponse("Merge approvals are not enabled on this Watchfire daemon.")
+	}
+	if denied := authorize(ctx, string(decision), cc); denied != nil {
+		return denied
+	}
+	name := projectID
+	if cc.FindProjects != nil {
+		if projects, err := cc.FindProjects(ctx); err == nil {
+			for _, p := range projects {
+				if p.ID == projectID {
+					name = p.Name
+					break
+				}
+			}
+		}
+	}
+	if err := cc.ResolveApproval(ctx, projectID, taskNumber, decision); err != nil {
+		if errors.Is(err, approval.ErrNotPending) {
+			return errorResponse(fmt.Sprintf("Task #%04d is not waiting for approval any more — it was already answered or has expired.", taskNumber))
+		}
+		return errorResponse(fmt.Sprintf("Approval failed: %v", err))
+	}
+
+	var icon, verb string
+	switch decision {
+	case approval.Approve:
+		icon, verb = "✅", "Merge approved"
+	case approval.Retry:
+		icon, verb = "🔁", "Sent back for another run"
+	default:
+		icon, verb = "⛔", "Merge rejected"
+	}
+	by := ""
+	if cc.UserID != "" {
+		by = " by " + cc.UserID
+	}
+	return &CommandResponse{
+		InChannel: true,
+		Text:      fmt.Sprintf("%s %s — task #%04d (%s)%s", icon, verb, taskNumber, name, by),
+		Blocks: []Block{
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("%s *%s* — task #%04d\n_%s_%s", icon, verb, taskNumber, cc.mrkdwn(name), by)},
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package echo
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+)
+
+// recordApproval returns a ResolveApproval callback that records what it
+// was asked and fails with err.
+func recordApproval(got *string, err error) func(context.Context, string, int, approval.Decision) error {
+	return func(_ context.Context, projectID string, taskNumber int, d approval.Decision) error {
+		*got = fmt.Sprintf("%s %s", d, approval.Ref(projectID, taskNumber))
+		return err
+	}
+}
+
+func TestRouteApproval(t *testing.T) {
+	var got string
+	cc := slackTestCommandContext("T1", "U1")
+	cc.ResolveApproval = recordApproval(&got, nil)
+
+	resp := RouteApproval(context.Background(), "proj-abc", 12, approval.Approve, cc)
+	if got != "approve proj-abc|12" {
+		t.Fatalf("ResolveApproval got %q", got)
+	}
+	if !resp.InChannel || !strings.Contains(resp.Text, "Merge approved — task #0012 (Watchfire) by U1") {
+		t.Errorf("approve reply = %+v", resp)
+	}
+
+	cc.ResolveApproval = recordApproval(&got, approval.ErrNotPending)
+	resp = RouteApproval(context.Background(), "proj-abc", 12, approval.Reject, cc)
+	if !resp.Ephemeral || !strings.Contains(resp.Text, "not waiting for approval") {
+		t.Errorf("stale click reply = %+v", resp)
+	}
+
+	cc.ResolveApproval = nil
+	if resp := RouteApproval(context.Background(), "proj-abc", 12, approval.Retry, cc); !strings.Contains(resp.Text, "not enabled") {
+		t.Errorf("unwired reply = %+v", resp)
+	}
+}
+
+func TestSlackHandlerApprovalButton(t *testing.T) {
+	secret := []byte("supersecret")
+	ts := nowSlackTS(t)
+	t.Cleanup(func() { SetClockForTest(nil) })
+
+	var got string
+	h := newSlackHandler(t, secret, func(cfg *SlackHandlerConfig) {
+		cfg.CommandContextFor = func(teamID, userID string) CommandContext {
+			cc := slackTestCommandContext(teamID, userID)
+			cc.ResolveApproval = recordApproval(&got, nil)
+			return cc
+		}
+	})
+
+	payload := `{
+		"type":"block_actions",
+		"team":{"id":"T123"},
+		"user":{"id":"U456"},
+		"trigger_id":"trig-approve",
+		"actions":[{"action_id":"watchfire_approval_retry","value":"proj-abc|42","type":"button","block_id":"watchfire_approval"}]
+	}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedSlackRequest(t, secret, payload, ts))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d (%s)", w.Code, w.Body.String())
+	}
+	if got != "retry proj-abc|42" {
+		t.Fatalf("ResolveApproval got %q", got)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("malformed response: %v", err)
+	}
+	if text, _ := doc["text"].(string); !strings.Contains(text, "Sent back for another run") {
+		t.Errorf("text = %q", text)
+	}
+
+	// A malformed value never reaches the gate.
+	got = ""
+	payload = strings.Replace(strings.Replace(payload, "trig-approve", "trig-2", 1), "proj-abc|42", "nope", 1)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedSlackRequest(t, secret, payload, ts))
+	if got != "" || !strings.Contains(w.Body.String(), "malformed") {
+		t.Errorf("malformed button: got=%q body=%s", got, w.Body.String())
+	}
+}
+
+func TestDiscordHandlerApprovalComponent(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	now := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	SetClockForTest(func() time.Time { return now })
+	t.Cleanup(func() { SetClockForTest(nil) })
+
+	var got, gotUser string
+	h := NewDiscordHandler(DiscordHandlerConfig{
+		ResolvePublicKey: func() (ed25519.PublicKey, error) { return pub, nil },
+		Idempotency:      NewCache(0, 0),
+		CommandContextFor: func(guildID, userID string) CommandContext {
+			gotUser = userID
+			cc := testCommandContext(guildID, userID)
+			cc.ResolveApproval = recordApproval(&got, nil)
+			return cc
+		},
+	})
+
+	body := []byte(`{
+		"id":"comp-approve",
+		"type":3,
+		"guild_id":"g",
+		"member":{"user":{"id":"D789"}},
+		"data":{"custom_id":"approval:approve:proj-a|7","component_type":2}
+	}`)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest(t, priv, body, strconv.FormatInt(now.Unix(), 10)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d (%s)", w.Code, w.Body.String())
+	}
+	if got != "approve proj-a|7" || gotUser != "D789" {
+		t.Fatalf("ResolveApproval got %q from user %q", got, gotUser)
+	}
+	var doc map[string]any
+	_ = json.Unmarshal(w.Body.Bytes(), &doc)
+	content, _ := doc["data"].(map[string]any)["content"].(string)
+	if !strings.Contains(content, "Merge approved") {
+		t.Errorf("content = %q", content)
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
 	"github.com/watchfire-io/watchfire/internal/models"
 )
 
@@ -56,7 +59,7 @@ type Block struct {
 
 // CommandContext carries the information the router needs to map the
 // invoking guild / team to one or more Watchfire projects, plus the
-// task lifecycle helpers it ultimately calls. The four function
+// task and agent lifecycle helpers it ultimately calls. The function
 // fields are all that the router uses — concrete implementations are
 // injected by the daemon at startup, mocks are injected by tests.
 //
@@ -69,10 +72,17 @@ type CommandContext struct {
 	GuildID string
 	TeamID  string
 
-	// UserID identifies the slash-command invoker. Logged for
-	// auditability; not currently used for authorization.
+	// UserID identifies the slash-command invoker. The daemon's Role
+	// resolver maps it to the user's chat role.
 	UserID string
 
+	// Role resolves the invoker's chat role; Route, RouteApproval and
+	// RouteAnswer refuse verbs above it (see RequiredRole). Denied
+	// records each refusal to the daemon's audit log. A nil Role
+	// disables the checks.
+	Role   func(ctx context.Context) models.ChatRole
+	Denied func(ctx context.Context, verb string, role, need models.ChatRole)
+
 	// Now returns the wall clock; tests inject a deterministic value.
 	Now func() time.Time
 
@@ -106,6 +116,42 @@ type CommandContext struct {
 	// Slack clicks without a modal) pass the empty string and let the
 	// daemon write a default like "cancelled via Slack button".
 	Cancel func(ctx context.Context, projectID string, taskNumber int, reason string) error
+
+	// ResolveApproval answers the merge approval a task of an
+	// `approval: required` project is waiting on. Returns
+	// approval.ErrNotPending when nothing is waiting (already answered
+	// or expired). nil disables the approval buttons.
+	ResolveApproval func(ctx context.Context, projectID string, taskNumber int, decision approval.Decision) error
+
+	// PendingQuestion returns the question a working agent of the
+	// project is waiting on (question.yaml), if any. AnswerQuestion
+	// types the reply into that agent's session; it returns
+	// ask.ErrNotPending when the question was already answered or its
+	// session ended. nil disables the `answer` command and buttons.
+	PendingQuestion func(ctx context.Context, projectID string) (ask.Question, bool)
+	AnswerQuestion  func(ctx context.Context, projectID string, reply ask.Reply) error
+
+	// StartAgent starts an agent session of the given mode (taskNumber
+	// is set for RunTask), replacing the one running for the project —
+	// the GUI mode-button semantics. StopAgent user-stops the running
+	// agent. Both return ErrNotAllowed when the invoking user is not an
+	// admin in this workspace. nil disables `run` / `stop`.
+	StartAgent func(ctx context.Context, projectID string, mode RunMode, taskNumber int) (RunStart, error)
+	StopAgent  func(ctx context.Context, projectID string) error
+
+	// CreateTask adds a ready task titled title (the prompt is the
+	// title too). nil disables `new`.
+	CreateTask func(ctx context.Context, projectID, title string) (*models.Task, error)
+
+	// Screen returns the running agent's normalized terminal screen;
+	// live is false when no agent runs for the project. nil disables
+	// `screen`.
+	Screen func(ctx context.Context, projectID string) (screen string, live bool)
+
+	// Watch turns the calling workspace's session relay for the
+	// project on or off; Watching reports it. nil disables `watch`.
+	Watch    func(ctx context.Context, projectID string, on bool) error
+	Watching func(ctx context.Context, projectID string) bool
 }
 
 // ProjectInfo is the minimum project metadata the router needs for
@@ -134,7 +180,8 @@ var ErrTaskNotFound = fmt.Errorf("task not found")
 // Route dispatches a slash command to the right handler. cmd is the
 // raw command including the leading slash (`/watchfire`); subcmd is
 // the first word of the argument string (`status`, `retry`,
-// `cancel`); rest is everything after that. The split is done here
+// `cancel`, `answer`, `run`, `stop`, `new`, `screen`, `watch`); rest
+// is everything after that. The split is done here
 // (rather than at the transport layer) so Slack's "command + text"
 // model and Discord's "name + options[]" model both feed into the
 // same router after a tiny shim.
@@ -142,13 +189,29 @@ func Route(ctx context.Context, cmd, subcmd, rest string, cc CommandContext) *Co
 	if cc.Now == nil {
 		cc.Now = time.Now
 	}
-	switch strings.ToLower(strings.TrimSpace(subcmd)) {
+	verb := strings.ToLower(strings.TrimSpace(subcmd))
+	if denied := authorize(ctx, verb, cc); denied != nil {
+		return denied
+	}
+	switch verb {
 	case "status", "":
 		return routeStatus(ctx, rest, cc)
 	case "retry":
 		return routeRetry(ctx, rest, cc)
 	case "cancel":
 		return routeCancel(ctx, rest, cc)
+	case "answer":
+		return routeAnswer(ctx, rest, cc)
+	case "run":
+		return routeRun(ctx, rest, cc)
+	case "stop":
+		return routeStop(ctx, rest, cc)
+	case "new":
+		return routeNew(ctx, rest, cc)
+	case "screen":
+		return routeScreen(ctx, rest, cc)
+	case "watch":
+		return routeWatch(ctx, rest, cc)
 	default:
 		return helpResponse(subcmd)
 	}
@@ -167,6 +230,12 @@ func helpResponse(unknown string) *CommandResponse {
 				"`/watchfire status [project]` — show in-flight tasks",
 				"`/watchfire retry <task>` — re-queue a failed task",
 				"`/watchfire cancel <task>` — cancel a running task",
+				"`/watchfire answer [#<task>] <text>` — answer an agent's question",
+				"`/watchfire run [project] <task|all|wildfire|plan|generate>` — start an agent",
+				"`/watchfire stop [project]` — stop the running agent",
+				"`/watchfire new [project] <title>` — create a ready task",
+				"`/watchfire screen [project]` — show the running agent's screen",
+				"`/watchfire watch [project] [on|off]` — post the session screen here while it runs",
 			}, "\n")},
 		},
 	}
@@ -198,7 +267,7 @@ func routeStatus(ctx context.Context, rest string, cc CommandContext) *CommandRe
 		tasks, listErr := cc.ListTopActiveTasks(ctx, p.ID, 3)
 		if listErr != nil {
 			blocks = append(blocks,
-				Block{Type: "section", Markdown: true, Text: fmt.Sprintf("*%s* — failed to load tasks: %v", p.Name, listErr)},
+				Block{Type: "section", Markdown: true, Text: fmt.Sprintf("*%s* — failed to load tasks: %v", cc.mrkdwn(p.Name), listErr)},
 				Block{Type: "divider"},
 			)
 			rendered++
@@ -207,17 +276,17 @@ func routeStatus(ctx context.Context, rest string, cc CommandContext) *CommandRe
 		blocks = append(blocks, Block{
 			Type:     "section",
 			Markdown: true,
-			Text:     fmt.Sprintf("*%s* — %d active task(s)", p.Name, len(tasks)),
+			Text:     fmt.Sprintf("*%s* — %d active task(s)", cc.mrkdwn(p.Name), len(tasks)),
 		})
 		for _, t := range tasks {
 			elapsed := "—"
 			if t.StartedAt != nil {
-				elapsed = formatDuration(cc.Now().Sub(*t.StartedAt))
+				elapsed = formatDuration(t.ActiveDuration(cc.Now()))
 			}
 			blocks = append(blocks, Block{
 				Type:     "section",
 				Markdown: true,
-				Text:     fmt.Sprintf("• `#%04d` %s _(elapsed %s)_", t.TaskNumber, t.Title, elapsed),
+				Text:     fmt.Sprintf("• `#%04d` %s _(elapsed %s)_", t.TaskNumber, cc.mrkdwn(t.Title), elapsed),
 			})
 		}
 		blocks = append(blocks, Block{Type: "divider"})
@@ -258,7 +327,7 @@ func routeRetry(ctx context.Context, rest string, cc CommandContext) *CommandRes
 		InChannel: true,
 		Text:      fmt.Sprintf("✅ Retrying task #%04d — %s (%s)", task.TaskNumber, task.Title, project.Name),
 		Blocks: []Block{
-			{Type: "section", Markdown: true, Text: fmt.Sprintf("✅ *Retrying task #%04d* — %s\n_%s_", task.TaskNumber, task.Title, project.Name)},
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("✅ *Retrying task #%04d* — %s\n_%s_", task.TaskNumber, cc.mrkdwn(task.Title), cc.mrkdwn(project.Name))},
 		},
 	}
 }
@@ -282,7 +351,7 @@ func routeCancel(ctx context.Context, rest string, cc CommandContext) *CommandRe
 		InChannel: true,
 		Text:      fmt.Sprintf("⏹️ Cancelled task #%04d — %s (%s)", task.TaskNumber, task.Title, project.Name),
 		Blocks: []Block{
-			{Type: "section", Markdown: true, Text: fmt.Sprintf("⏹️ *Cancelled task #%04d* — %s\n_%s_", task.TaskNumber, task.Title, project.Name)},
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("⏹️ *Cancelled task #%04d* — %s\n_%s_", task.TaskNumber, cc.mrkdwn(task.Title), cc.mrkdwn(project.Name))},
 		},
 	}
 }
@@ -297,6 +366,16 @@ func errorResponse(msg string) *CommandResponse {
 	}
 }
 
+// mrkdwn escapes user-controlled text (task titles, project names)
+// for a Markdown section. Only Slack reads the escapes; Discord renders
+// the same blocks, so its text passes through untouched.
+func (cc CommandContext) mrkdwn(s string) string {
+	if cc.TeamID == "" {
+		return s
+	}
+	return relay.SlackEscape(s)
+}
+
 func isNotFound(err error) bool {
 	return err == ErrTaskNotFound || (err != nil && strings.Contains(err.Error(), ErrTaskNotFound.Error()))
 }
@@ -9,12 +9,16 @@ import (
 	"log"
 	"net/http"
 	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
 )
 
-// Discord interaction types — only PING and APPLICATION_COMMAND are
-// handled. Everything else (button clicks, modal submits, autocomplete)
-// is acknowledged with a polite "not supported in v8.0" reply so the
-// app stays alive in the user's server even if they wire up extra UI.
+// Discord interaction types — PING, APPLICATION_COMMAND and the
+// MESSAGE_COMPONENT clicks on merge approval buttons are handled.
+// Everything else (other components, modal submits, autocomplete) is
+// acknowledged with a polite "not supported" reply so the app stays
+// alive in the user's server even if they wire up extra UI.
 //
 // https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-object-interaction-type
 const (
@@ -100,7 +104,8 @@ type discordInteraction struct {
 }
 
 type discordMember struct {
-	User *discordUser `json:"user"`
+	User  *discordUser `json:"user"`
+	Roles []string     `json:"roles"`
 }
 
 type discordUser struct {
@@ -112,6 +117,9 @@ type discordApplicationCommandData struct {
 	ID      string                 `json:"id"`
 	Name    string                 `json:"name"`
 	Options []discordCommandOption `json:"options"`
+	// CustomID is set on MESSAGE_COMPONENT interactions instead of
+	// Name / Options.
+	CustomID string `json:"custom_id"`
 }
 
 type discordCommandOption struct {
@@ -177,6 +185,12 @@ func (h *discordHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The member's guild roles feed the daemon's role resolver.
+	ctx := r.Context()
+	if interaction.Member != nil {
+		ctx = WithMemberRoles(ctx, interaction.Member.Roles)
+	}
+
 	switch interaction.Type {
 	case discordInteractionPing:
 		writeDiscordPong(w)
@@ -187,23 +201,18 @@ func (h *discordHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "missing application command data", http.StatusBadRequest)
 			return
 		}
-		userID := ""
-		if interaction.Member != nil && interaction.Member.User != nil {
-			userID = interaction.Member.User.ID
-		} else if interaction.User != nil {
-			userID = interaction.User.ID
-		}
+		userID := discordUserID(&interaction)
 		cc := h.cfg.CommandContextFor(interaction.GuildID, userID)
 
 		// Discord delivers slash-command args as a structured
 		// `options[]` array. The shared router expects a single
 		// `rest` string. Flatten by joining option values with
-		// spaces — for the three v8.0 commands (status / retry /
-		// cancel) only one positional argument exists per command,
-		// so the flatten is unambiguous.
+		// spaces. Most commands take one argument; those that also
+		// take an optional `project` get it first, which is where
+		// the router looks for it (see flattenOptions).
 		rest := flattenOptions(interaction.Data.Options)
 
-		resp := Route(r.Context(), "/"+interaction.Data.Name, interaction.Data.Name, rest, cc)
+		resp := Route(ctx, "/"+interaction.Data.Name, interaction.Data.Name, rest, cc)
 		body := RenderInteraction(resp)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -211,8 +220,38 @@ func (h *discordHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.cfg.Logger.Printf("INFO: echo: discord command %q from guild=%s user=%s", interaction.Data.Name, interaction.GuildID, userID)
 		return
 
-	case discordInteractionMessageComponent,
-		discordInteractionAutocomplete,
+	case discordInteractionMessageComponent:
+		// Approval buttons carry approval.ButtonData as custom_id;
+		// question option buttons carry ask.ButtonData.
+		customID := ""
+		if interaction.Data != nil {
+			customID = interaction.Data.CustomID
+		}
+		userID := discordUserID(&interaction)
+		if projectID, questionID, option, ok := ask.ParseButtonData(customID); ok {
+			cc := h.cfg.CommandContextFor(interaction.GuildID, userID)
+			resp := RouteAnswer(ctx, projectID, ask.Reply{QuestionID: questionID, Option: option}, cc)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(RenderInteraction(resp))
+			h.cfg.Logger.Printf("INFO: echo: discord answer option %d for %s from guild=%s user=%s", option, projectID, interaction.GuildID, userID)
+			return
+		}
+		decision, projectID, taskNumber, ok := approval.ParseButtonData(customID)
+		if !ok {
+			writeDiscordEphemeral(w, "Not supported — Watchfire only handles slash commands, approval and answer buttons.")
+			h.cfg.Logger.Printf("INFO: echo: discord component ignored")
+			return
+		}
+		cc := h.cfg.CommandContextFor(interaction.GuildID, userID)
+		resp := RouteApproval(ctx, projectID, taskNumber, decision, cc)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(RenderInteraction(resp))
+		h.cfg.Logger.Printf("INFO: echo: discord approval %s for %s from guild=%s user=%s", decision, approval.Ref(projectID, taskNumber), interaction.GuildID, userID)
+		return
+
+	case discordInteractionAutocomplete,
 		discordInteractionModalSubmit:
 		writeDiscordEphemeral(w, "Not supported in v8.0 — Watchfire only handles slash commands.")
 		h.cfg.Logger.Printf("INFO: echo: discord interaction type %d ignored", interaction.Type)
@@ -225,6 +264,18 @@ func (h *discordHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// discordUserID is the invoking user: the guild member in a server, the
+// user in a DM.
+func discordUserID(interaction *discordInteraction) string {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User.ID
+	}
+	if interaction.User != nil {
+		return interaction.User.ID
+	}
+	return ""
+}
+
 // writeDiscordPong responds to a Discord PING interaction. Discord's
 // endpoint-verification flow at app registration time fires this
 // before the app can be saved; failing it disables the app.
@@ -258,12 +309,27 @@ func writeDiscordEphemeral(w http.ResponseWriter, content string) {
 	_, _ = w.Write(body)
 }
 
+// flattenOptions joins the option values into the router's `rest`
+// string. Discord sends options in the order the user filled them in,
+// so a `project` option is moved to the front — the router reads a
+// leading project name (`run [project] <task>`).
 func flattenOptions(opts []discordCommandOption) string {
 	if len(opts) == 0 {
 		return ""
 	}
-	parts := make([]string, 0, len(opts))
+	ordered := make([]discordCommandOption, 0, len(opts))
 	for _, opt := range opts {
+		if opt.Name == "project" {
+			ordered = append(ordered, opt)
+		}
+	}
+	for _, opt := range opts {
+		if opt.Name != "project" {
+			ordered = append(ordered, opt)
+		}
+	}
+	parts := make([]string, 0, len(opts))
+	for _, opt := range ordered {
 		// Discord encodes string values with surrounding JSON quotes;
 		// numeric values come through unquoted. json.Unmarshal handles
 		// either path.
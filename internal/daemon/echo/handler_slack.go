@@ -12,6 +12,9 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
 )
 
 // Slack action_id values emitted by the v7.0 Relay outbound TASK_FAILED
@@ -250,6 +253,36 @@ func (h *slackHandler) handleBlockActions(w http.ResponseWriter, r *http.Request
 	action := interaction.Actions[0]
 	cc := h.cfg.CommandContextFor(interaction.Team.ID, interaction.User.ID)
 
+	// Merge approval buttons carry the decision in the action_id
+	// (`watchfire_approval_<decision>`) and the task in the value.
+	if name, ok := strings.CutPrefix(action.ActionID, approval.SlackActionPrefix); ok {
+		decision, okDecision := approval.ParseDecision(name)
+		projectID, taskNumber, okRef := approval.ParseRef(action.Value)
+		if !okDecision || !okRef {
+			writeSlackResponse(w, RenderSlack(errorResponse(fmt.Sprintf("Approval button malformed: %s=%q", action.ActionID, action.Value))))
+			return
+		}
+		resp := RouteApproval(r.Context(), projectID, taskNumber, decision, cc)
+		writeSlackResponse(w, RenderSlack(resp))
+		h.cfg.Logger.Printf("INFO: echo: slack approval %s team=%s user=%s value=%q", decision, interaction.Team.ID, interaction.User.ID, action.Value)
+		return
+	}
+
+	// Agent question buttons carry the 1-based option in the action_id
+	// (`watchfire_answer_<n>`) and the question in the value.
+	if opt, ok := strings.CutPrefix(action.ActionID, ask.SlackActionPrefix); ok {
+		option, optErr := strconv.Atoi(opt)
+		projectID, questionID, okRef := ask.ParseRef(action.Value)
+		if optErr != nil || option <= 0 || !okRef {
+			writeSlackResponse(w, RenderSlack(errorResponse(fmt.Sprintf("Answer button malformed: %s=%q", action.ActionID, action.Value))))
+			return
+		}
+		resp := RouteAnswer(r.Context(), projectID, ask.Reply{QuestionID: questionID, Option: option}, cc)
+		writeSlackResponse(w, RenderSlack(resp))
+		h.cfg.Logger.Printf("INFO: echo: slack answer option %d team=%s user=%s value=%q", option, interaction.Team.ID, interaction.User.ID, action.Value)
+		return
+	}
+
 	switch action.ActionID {
 	case slackActionRetry:
 		taskRef := taskRefFromValue(action.Value)
@@ -263,6 +296,12 @@ func (h *slackHandler) handleBlockActions(w http.ResponseWriter, r *http.Request
 		// to the slash-command equivalent (cancel with empty reason)
 		// so the button is never a dead-end.
 		if h.cfg.OpenModal != nil && interaction.TriggerID != "" {
+			// Refuse before the modal opens rather than after the user
+			// typed a reason.
+			if denied := authorize(r.Context(), "cancel", cc); denied != nil {
+				writeSlackResponse(w, RenderSlack(denied))
+				return
+			}
 			projectID, taskNumber, ok := splitProjectTaskRef(action.Value)
 			if !ok {
 				writeSlackResponse(w, RenderSlack(errorResponse(fmt.Sprintf("Cancel button value malformed: %q", action.Value))))
@@ -343,6 +382,10 @@ func (h *slackHandler) handleViewSubmission(w http.ResponseWriter, r *http.Reque
 			writeViewSubmissionError(w, cancelModalReasonBlock, "Cancel handler not wired.")
 			return
 		}
+		if denied := authorize(r.Context(), "cancel", cc); denied != nil {
+			writeViewSubmissionError(w, cancelModalReasonBlock, denied.Text)
+			return
+		}
 		if err := cc.Cancel(r.Context(), projectID, taskNumber, reason); err != nil {
 			writeViewSubmissionError(w, cancelModalReasonBlock, fmt.Sprintf("Cancel failed: %v", err))
 			h.cfg.Logger.Printf("ERROR: echo: slack cancel modal cc.Cancel: %v", err)
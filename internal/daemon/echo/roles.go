@@ -0,0 +1,62 @@
+package echo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// verbRoles is the role each chat command needs. Verbs missing here
+// (status, help, unknown words) are open to every viewer.
+var verbRoles = map[string]models.ChatRole{
+	"screen": models.ChatRoleViewer,
+	"retry":  models.ChatRoleOperator,
+	"cancel": models.ChatRoleOperator,
+	"answer": models.ChatRoleOperator,
+	"new":    models.ChatRoleOperator,
+	"watch":  models.ChatRoleOperator,
+	"run":    models.ChatRoleAdmin,
+	"stop":   models.ChatRoleAdmin,
+	// Merge approval buttons (RouteApproval).
+	"approve": models.ChatRoleOperator,
+	"reject":  models.ChatRoleOperator,
+}
+
+// RequiredRole is the role verb needs.
+func RequiredRole(verb string) models.ChatRole {
+	if r, ok := verbRoles[verb]; ok {
+		return r
+	}
+	return models.ChatRoleViewer
+}
+
+// Permit checks the invoking user's role against need. A refusal is
+// reported to cc.Denied (the daemon's audit log) and returns the role
+// the user has. A context without a Role resolver permits everything.
+func Permit(ctx context.Context, cc CommandContext, verb string, need models.ChatRole) (models.ChatRole, bool) {
+	if cc.Role == nil {
+		return "", true
+	}
+	role := cc.Role(ctx)
+	if role.Allows(need) {
+		return role, true
+	}
+	if cc.Denied != nil {
+		cc.Denied(ctx, verb, role, need)
+	}
+	return role, false
+}
+
+// authorize gates verb, returning the refusal to send back or nil.
+func authorize(ctx context.Context, verb string, cc CommandContext) *CommandResponse {
+	need := RequiredRole(verb)
+	role, ok := Permit(ctx, cc, verb, need)
+	if ok {
+		return nil
+	}
+	if !role.Valid() {
+		role = "no role"
+	}
+	return errorResponse(fmt.Sprintf("`%s` needs the %s role and you have %s here — ask the Watchfire owner to map your user id (%s) in integrations.yaml.", verb, need, role, cc.UserID))
+}
@@ -0,0 +1,86 @@
+package echo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// withRole gives cc a fixed chat role and records every refusal.
+func withRole(cc CommandContext, role models.ChatRole, denied *[]string) CommandContext {
+	cc.Role = func(context.Context) models.ChatRole { return role }
+	cc.Denied = func(_ context.Context, verb string, have, need models.ChatRole) {
+		*denied = append(*denied, verb+" "+string(have)+"<"+string(need))
+	}
+	return cc
+}
+
+func TestRouteEnforcesChatRoles(t *testing.T) {
+	ctx := context.Background()
+	var denied, calls []string
+	base := runTestContext(&calls)
+	retried := 0
+	base.Retry = func(context.Context, string, int) error { retried++; return nil }
+
+	viewer := withRole(base, models.ChatRoleViewer, &denied)
+	if resp := Route(ctx, "/watchfire", "status", "", viewer); strings.Contains(resp.Text, "needs the") {
+		t.Fatalf("viewer status refused: %+v", resp)
+	}
+	resp := Route(ctx, "/watchfire", "retry", "12", viewer)
+	if !resp.Ephemeral || !strings.Contains(resp.Text, "`retry` needs the operator role and you have viewer") || !strings.Contains(resp.Text, "(U1)") {
+		t.Fatalf("viewer retry = %+v", resp)
+	}
+	if retried != 0 {
+		t.Fatal("a refused retry reached the callback")
+	}
+
+	operator := withRole(base, models.ChatRoleOperator, &denied)
+	if resp := Route(ctx, "/watchfire", "retry", "12", operator); resp.Ephemeral || retried != 1 {
+		t.Fatalf("operator retry = %+v (retried %d)", resp, retried)
+	}
+	if resp := Route(ctx, "/watchfire", "RUN", "12", operator); !strings.Contains(resp.Text, "`run` needs the admin role") || len(calls) != 0 {
+		t.Fatalf("operator run = %+v (calls %v)", resp, calls)
+	}
+
+	admin := withRole(base, models.ChatRoleAdmin, &denied)
+	if resp := Route(ctx, "/watchfire", "stop", "alpha", admin); !resp.InChannel {
+		t.Fatalf("admin stop = %+v", resp)
+	}
+	noRole := withRole(base, "", &denied)
+	if resp := Route(ctx, "/watchfire", "new", "beta Fix it", noRole); !strings.Contains(resp.Text, "you have no role here") {
+		t.Fatalf("unknown role new = %+v", resp)
+	}
+
+	want := "retry viewer<operator|run operator<admin|new <operator"
+	if got := strings.Join(denied, "|"); got != want {
+		t.Fatalf("denied = %q, want %q", got, want)
+	}
+}
+
+// TestButtonsEnforceChatRoles: the approval and answer buttons bypass
+// Route and are gated on their own.
+func TestButtonsEnforceChatRoles(t *testing.T) {
+	ctx := context.Background()
+	var denied []string
+	var got string
+	cc := withRole(slackTestCommandContext("T1", "U1"), models.ChatRoleViewer, &denied)
+	cc.ResolveApproval = recordApproval(&got, nil)
+	cc.AnswerQuestion = func(context.Context, string, ask.Reply) error {
+		t.Fatal("a refused answer reached the callback")
+		return nil
+	}
+
+	if resp := RouteApproval(ctx, "proj-abc", 12, approval.Approve, cc); !strings.Contains(resp.Text, "`approve` needs the operator role") || got != "" {
+		t.Fatalf("viewer approve = %+v (resolved %q)", resp, got)
+	}
+	if resp := RouteAnswer(ctx, "proj-abc", ask.Reply{Option: 1}, cc); !strings.Contains(resp.Text, "`answer` needs the operator role") {
+		t.Fatalf("viewer answer = %+v", resp)
+	}
+	if strings.Join(denied, "|") != "approve viewer<operator|answer viewer<operator" {
+		t.Fatalf("denied = %v", denied)
+	}
+}
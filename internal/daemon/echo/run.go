@@ -0,0 +1,375 @@
+package echo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RunMode names the agent mode a `run` command starts. The values are
+// the agent manager's mode strings, so the daemon passes them through
+// to StartAgent unchanged.
+type RunMode string
+
+// Run modes reachable from chat — the Telegram /run, /runall,
+// /wildfire, /plan and /generate verbs, folded into one `run` command.
+const (
+	RunTask     RunMode = "task"
+	RunAll      RunMode = "start-all"
+	RunWildfire RunMode = "wildfire"
+	RunPlan     RunMode = "generate-tasks"
+	RunGenerate RunMode = "generate-definition"
+)
+
+// RunStart describes the session a `run` command started, for the
+// confirmation reply. Replaced names the agent the start displaced
+// ("task #0004", "chat") — empty when nothing was running.
+type RunStart struct {
+	TaskNumber int
+	TaskTitle  string
+	Replaced   string
+}
+
+// ErrNotAllowed is returned by the run-control callbacks when the
+// invoking user is not an admin of the workspace (see
+// models.ChatRole). Rendered as a friendly ephemeral refusal.
+var ErrNotAllowed = errors.New("not allowed to start or stop agents")
+
+// screenTailLines caps the `screen` snapshot at the last N lines, and
+// screenTailRunes keeps the code block inside Slack's 3000-character
+// section limit.
+const (
+	screenTailLines = 30
+	screenTailRunes = 2800
+)
+
+// runModeWords maps the `run` argument words onto run modes.
+var runModeWords = map[string]RunMode{
+	"all":      RunAll,
+	"runall":   RunAll,
+	"wildfire": RunWildfire,
+	"plan":     RunPlan,
+	"generate": RunGenerate,
+}
+
+// routeRun handles `/watchfire run [project] <task|all|wildfire|plan|generate>`.
+// Like the GUI mode buttons and Telegram's /run, a start replaces the
+// agent running for the project; the reply names what it replaced.
+func routeRun(ctx context.Context, rest string, cc CommandContext) *CommandResponse {
+	if cc.StartAgent == nil {
+		return errorResponse("Run controls are not enabled on this Watchfire daemon.")
+	}
+	usage := "Usage: `/watchfire run [project] <task|all|wildfire|plan|generate>`"
+	project, arg, resp := leadingProject(ctx, rest, cc)
+	if resp != nil {
+		return resp
+	}
+	if arg == "" {
+		return errorResponse(usage)
+	}
+
+	mode, isMode := runModeWords[strings.ToLower(arg)]
+	taskNumber := 0
+	if !isMode {
+		mode = RunTask
+		number, _, ok := ParseTaskRef(arg)
+		if !ok || strings.ContainsAny(arg, " \t") {
+			return errorResponse(usage)
+		}
+		if project == nil || number == 0 {
+			task, owner, err := cc.LookupTask(ctx, arg)
+			if err != nil {
+				if isNotFound(err) {
+					return errorResponse(fmt.Sprintf("Task %q not found in mapped projects.", arg))
+				}
+				return errorResponse(fmt.Sprintf("Failed to find task: %v", err))
+			}
+			if project != nil && owner.ID != project.ID {
+				return errorResponse(fmt.Sprintf("Task %q not found in %s.", arg, project.Name))
+			}
+			project = &owner
+			number = task.TaskNumber
+		}
+		taskNumber = number
+	}
+	if project == nil {
+		var resp *CommandResponse
+		if project, resp = onlyProject(ctx, cc, false); resp != nil {
+			return resp
+		}
+	}
+
+	started, err := cc.StartAgent(ctx, project.ID, mode, taskNumber)
+	if err != nil {
+		return runError("Failed to start the agent", err, cc)
+	}
+	var what string
+	switch mode {
+	case RunTask:
+		what = fmt.Sprintf("task #%04d — %s", started.TaskNumber, started.TaskTitle)
+	case RunAll:
+		what = fmt.Sprintf("run-all, on task #%04d — %s", started.TaskNumber, started.TaskTitle)
+	case RunWildfire:
+		what = "wildfire"
+	case RunPlan:
+		what = "task planning"
+	case RunGenerate:
+		what = "project definition generation"
+	}
+	note := ""
+	if started.Replaced != "" {
+		note = fmt.Sprintf(" Replaced the running %s.", started.Replaced)
+	}
+	return &CommandResponse{
+		InChannel: true,
+		Text:      fmt.Sprintf("▶️ Started %s (%s)%s%s", what, project.Name, byUser(cc), note),
+		Blocks: []Block{
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("▶️ *Started %s*%s\n_%s_%s", cc.mrkdwn(what), byUser(cc), cc.mrkdwn(project.Name), note)},
+		},
+	}
+}
+
+// routeStop handles `/watchfire stop [project]`: user-stops the agent
+// (a wildfire or run-all chain ends with it). Without a project it
+// stops the only running agent among the mapped projects.
+func routeStop(ctx context.Context, rest string, cc CommandContext) *CommandResponse {
+	if cc.StopAgent == nil {
+		return errorResponse("Run controls are not enabled on this Watchfire daemon.")
+	}
+	project, arg, resp := leadingProject(ctx, rest, cc)
+	if resp != nil {
+		return resp
+	}
+	if arg != "" {
+		return errorResponse("Usage: `/watchfire stop [project]`")
+	}
+	if project == nil {
+		if project, resp = onlyProject(ctx, cc, true); resp != nil {
+			return resp
+		}
+	} else if !project.AgentRunning {
+		return errorResponse(fmt.Sprintf("Nothing is running for %s.", project.Name))
+	}
+	if err := cc.StopAgent(ctx, project.ID); err != nil {
+		return runError("Failed to stop the agent", err, cc)
+	}
+	what := "the agent"
+	if project.AgentTaskNumber > 0 {
+		what = fmt.Sprintf("the agent on task #%04d", project.AgentTaskNumber)
+	}
+	return &CommandResponse{
+		InChannel: true,
+		Text:      fmt.Sprintf("🛑 Stopped %s (%s)%s", what, project.Name, byUser(cc)),
+		Blocks: []Block{
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("🛑 *Stopped %s*%s\n_%s_", what, byUser(cc), cc.mrkdwn(project.Name))},
+		},
+	}
+}
+
+// routeNew handles `/watchfire new [project] <title>`: creates a ready
+// task whose prompt is the title. It does not start an agent — `run`
+// does, or the next run-all picks the task up.
+func routeNew(ctx context.Context, rest string, cc CommandContext) *CommandResponse {
+	if cc.CreateTask == nil {
+		return errorResponse("Creating tasks from chat is not enabled on this Watchfire daemon.")
+	}
+	project, title, resp := leadingProject(ctx, rest, cc)
+	if resp != nil {
+		return resp
+	}
+	if title == "" {
+		return errorResponse("Usage: `/watchfire new [project] <title>`")
+	}
+	if project == nil {
+		if project, resp = onlyProject(ctx, cc, false); resp != nil {
+			return resp
+		}
+	}
+	t, err := cc.CreateTask(ctx, project.ID, title)
+	if err != nil {
+		return errorResponse(fmt.Sprintf("Failed to create the task: %v", err))
+	}
+	return &CommandResponse{
+		InChannel: true,
+		Text:      fmt.Sprintf("🆕 Created task #%04d — %s (%s)", t.TaskNumber, t.Title, project.Name),
+		Blocks: []Block{
+			{Type: "section", Markdown: true, Text: fmt.Sprintf("🆕 *Created task #%04d* — %s\n_%s_", t.TaskNumber, cc.mrkdwn(t.Title), cc.mrkdwn(project.Name))},
+			{Type: "context", Text: fmt.Sprintf("Start it with `/watchfire run %d`.", t.TaskNumber)},
+		},
+	}
+}
+
+// routeScreen handles `/watchfire screen [project]`: a one-shot tail
+// of the running agent's terminal, shown only to the caller.
+func routeScreen(ctx context.Context, rest string, cc CommandContext) *CommandResponse {
+	if cc.Screen == nil {
+		return errorResponse("Session snapshots are not enabled on this Watchfire daemon.")
+	}
+	project, arg, resp := leadingProject(ctx, rest, cc)
+	if resp != nil {
+		return resp
+	}
+	if arg != "" {
+		return errorResponse("Usage: `/watchfire screen [project]`")
+	}
+	if project == nil {
+		if project, resp = onlyProject(ctx, cc, true); resp != nil {
+			return resp
+		}
+	}
+	screen, live := cc.Screen(ctx, project.ID)
+	if !live {
+		return errorResponse(fmt.Sprintf("No agent is running for %s.", project.Name))
+	}
+	screen = ScreenTail(screen)
+	if screen == "" {
+		return errorResponse(fmt.Sprintf("The %s session screen is empty right now.", project.Name))
+	}
+	title := project.Name
+	if project.AgentTaskNumber > 0 {
+		title = fmt.Sprintf("%s — task #%04d", project.Name, project.AgentTaskNumber)
+	}
+	return &CommandResponse{
+		Ephemeral: true,
+		Blocks: []Block{
+			{Type: "header", Text: title},
+			{Type: "section", Markdown: true, Text: "```\n" + screen + "\n```"},
+			{Type: "context", Text: fmt.Sprintf("As of %s", cc.Now().UTC().Format("15:04:05 MST"))},
+		},
+	}
+}
+
+// routeWatch handles `/watchfire watch [project] [on|off]`. While on,
+// the daemon posts the project's session screen to this workspace's
+// Watchfire endpoints whenever it changes; without on/off it reports
+// the current setting.
+func routeWatch(ctx context.Context, rest string, cc CommandContext) *CommandResponse {
+	if cc.Watch == nil || cc.Watching == nil {
+		return errorResponse("Session watching is not enabled on this Watchfire daemon.")
+	}
+	project, arg, resp := leadingProject(ctx, rest, cc)
+	if resp != nil {
+		return resp
+	}
+	if project == nil {
+		if project, resp = onlyProject(ctx, cc, false); resp != nil {
+			return resp
+		}
+	}
+	switch strings.ToLower(arg) {
+	case "":
+		state := "off"
+		if cc.Watching(ctx, project.ID) {
+			state = "on"
+		}
+		return errorResponse(fmt.Sprintf("Watch is %s for %s — `/watchfire watch %s on|off` to change it.", state, project.Name, project.Name))
+	case "on", "off":
+	default:
+		return errorResponse("Usage: `/watchfire watch [project] [on|off]`")
+	}
+	on := strings.EqualFold(arg, "on")
+	if err := cc.Watch(ctx, project.ID, on); err != nil {
+		return errorResponse(fmt.Sprintf("Failed to change watch: %v", err))
+	}
+	text := fmt.Sprintf("👀 Watching %s — the session screen is posted here while an agent runs.", project.Name)
+	if !on {
+		text = fmt.Sprintf("Stopped watching %s.", project.Name)
+	}
+	return &CommandResponse{
+		InChannel: true,
+		Text:      text,
+		Blocks:    []Block{{Type: "section", Text: text}},
+	}
+}
+
+// ScreenTail trims a normalized session screen to what a chat message
+// shows: the last screenTailLines lines, further cut from the top to
+// fit screenTailRunes. The daemon's watch posts use it too, so a
+// snapshot looks the same however it was asked for.
+func ScreenTail(screen string) string {
+	lines := strings.Split(strings.TrimRight(screen, "\n"), "\n")
+	if len(lines) > screenTailLines {
+		lines = lines[len(lines)-screenTailLines:]
+	}
+	for len(lines) > 1 && len([]rune(strings.Join(lines, "\n"))) > screenTailRunes {
+		lines = lines[1:]
+	}
+	out := strings.Join(lines, "\n")
+	if r := []rune(out); len(r) > screenTailRunes {
+		out = string(r[len(r)-screenTailRunes:])
+	}
+	// A stray fence would close the code block the screen renders in.
+	return strings.ReplaceAll(out, "```", "'''")
+}
+
+// leadingProject splits an optional leading project name (or id) off
+// rest. project is nil when the first word names no mapped project;
+// resp is non-nil when the projects could not be loaded.
+func leadingProject(ctx context.Context, rest string, cc CommandContext) (*ProjectInfo, string, *CommandResponse) {
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return nil, "", nil
+	}
+	projects, err := cc.FindProjects(ctx)
+	if err != nil {
+		return nil, "", errorResponse(fmt.Sprintf("Failed to load projects: %v", err))
+	}
+	first, after, _ := strings.Cut(rest, " ")
+	for i := range projects {
+		if strings.EqualFold(first, projects[i].Name) || strings.EqualFold(first, projects[i].ID) {
+			return &projects[i], strings.TrimSpace(after), nil
+		}
+	}
+	return nil, rest, nil
+}
+
+// onlyProject picks the project a command without one means: the only
+// mapped project, or — when running is set — the only mapped project
+// with an agent running. Anything else asks the caller to name one.
+func onlyProject(ctx context.Context, cc CommandContext, running bool) (*ProjectInfo, *CommandResponse) {
+	projects, err := cc.FindProjects(ctx)
+	if err != nil {
+		return nil, errorResponse(fmt.Sprintf("Failed to load projects: %v", err))
+	}
+	if len(projects) == 0 {
+		return nil, errorResponse("No Watchfire projects are mapped to this channel — open Settings → Integrations to wire one up.")
+	}
+	candidates := projects
+	if running {
+		candidates = nil
+		for _, p := range projects {
+			if p.AgentRunning {
+				candidates = append(candidates, p)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, errorResponse("No agent is running in the projects mapped here.")
+		}
+	}
+	if len(candidates) == 1 {
+		return &candidates[0], nil
+	}
+	names := make([]string, 0, len(candidates))
+	for _, p := range candidates {
+		names = append(names, "`"+p.Name+"`")
+	}
+	return nil, errorResponse("Several projects match — name one first: " + strings.Join(names, ", "))
+}
+
+// runError renders a failed start or stop, turning ErrNotAllowed into
+// a refusal that tells the caller how to get access.
+func runError(prefix string, err error, cc CommandContext) *CommandResponse {
+	if errors.Is(err, ErrNotAllowed) {
+		return errorResponse(fmt.Sprintf("You are not allowed to start or stop agents here — ask the Watchfire owner to make your user id (%s) an admin in integrations.yaml.", cc.UserID))
+	}
+	return errorResponse(fmt.Sprintf("%s: %v", prefix, err))
+}
+
+// byUser is the " by <user>" attribution suffix of in-channel replies.
+func byUser(cc CommandContext) string {
+	if cc.UserID == "" {
+		return ""
+	}
+	return " by " + cc.UserID
+}
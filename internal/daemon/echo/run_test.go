@@ -0,0 +1,211 @@
+package echo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// runTestContext maps two projects — alpha with an agent on task #0004,
+// beta idle — and records the run-control calls it receives.
+func runTestContext(calls *[]string) CommandContext {
+	cc := slackTestCommandContext("T1", "U1")
+	cc.FindProjects = func(context.Context) ([]ProjectInfo, error) {
+		return []ProjectInfo{
+			{ID: "proj-a", Name: "alpha", AgentRunning: true, AgentTaskNumber: 4},
+			{ID: "proj-b", Name: "beta"},
+		}, nil
+	}
+	cc.LookupTask = func(_ context.Context, ref string) (*models.Task, ProjectInfo, error) {
+		if n, _, _ := ParseTaskRef(ref); n == 12 {
+			return &models.Task{TaskNumber: 12, Title: "Add retries"}, ProjectInfo{ID: "proj-a", Name: "alpha"}, nil
+		}
+		return nil, ProjectInfo{}, ErrTaskNotFound
+	}
+	cc.StartAgent = func(_ context.Context, projectID string, mode RunMode, taskNumber int) (RunStart, error) {
+		*calls = append(*calls, fmt.Sprintf("start %s %s %d", projectID, mode, taskNumber))
+		started := RunStart{TaskNumber: taskNumber, TaskTitle: "Add retries"}
+		if projectID == "proj-a" {
+			started.Replaced = "agent on task #0004"
+		}
+		return started, nil
+	}
+	cc.StopAgent = func(_ context.Context, projectID string) error {
+		*calls = append(*calls, "stop "+projectID)
+		return nil
+	}
+	return cc
+}
+
+func TestRouteRunCommand(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	cc := runTestContext(&calls)
+
+	resp := Route(ctx, "/watchfire", "run", "#12", cc)
+	if !resp.InChannel || !strings.Contains(resp.Text, "Started task #0012 — Add retries (alpha) by U1") || !strings.Contains(resp.Text, "Replaced the running agent on task #0004") {
+		t.Fatalf("run 12 = %+v", resp)
+	}
+	resp = Route(ctx, "/watchfire", "run", "beta all", cc)
+	if !resp.InChannel || !strings.Contains(resp.Text, "run-all") || strings.Contains(resp.Text, "Replaced") {
+		t.Fatalf("run beta all = %+v", resp)
+	}
+	Route(ctx, "/watchfire", "run", "Beta wildfire", cc)
+	Route(ctx, "/watchfire", "run", "beta 3", cc)
+	want := []string{"start proj-a task 12", "start proj-b start-all 0", "start proj-b wildfire 0", "start proj-b task 3"}
+	if strings.Join(calls, "|") != strings.Join(want, "|") {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+
+	for rest, frag := range map[string]string{
+		"":             "Usage",
+		"all":          "Several projects match — name one first: `alpha`, `beta`",
+		"99":           `Task "99" not found`,
+		"beta 12 more": "Usage",
+	} {
+		if resp := Route(ctx, "/watchfire", "run", rest, cc); !resp.Ephemeral || !strings.Contains(resp.Text, frag) {
+			t.Errorf("run %q = %+v, want %q", rest, resp, frag)
+		}
+	}
+	// With a project named, the number is that project's task.
+	if resp := Route(ctx, "/watchfire", "run", "beta #12", cc); !strings.Contains(resp.Text, "Started task #0012") || calls[len(calls)-1] != "start proj-b task 12" {
+		t.Errorf("run beta #12 = %+v (calls %v)", resp, calls)
+	}
+
+	cc.StartAgent = func(context.Context, string, RunMode, int) (RunStart, error) { return RunStart{}, ErrNotAllowed }
+	if resp := Route(ctx, "/watchfire", "run", "12", cc); !resp.Ephemeral || !strings.Contains(resp.Text, "not allowed") || !strings.Contains(resp.Text, "(U1)") {
+		t.Errorf("refused run = %+v", resp)
+	}
+	cc.StartAgent = nil
+	if resp := Route(ctx, "/watchfire", "run", "12", cc); !strings.Contains(resp.Text, "not enabled") {
+		t.Errorf("unwired run = %+v", resp)
+	}
+}
+
+func TestRouteStopCommand(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	cc := runTestContext(&calls)
+
+	// Without a project, stop picks the only running agent.
+	resp := Route(ctx, "/watchfire", "stop", "", cc)
+	if !resp.InChannel || !strings.Contains(resp.Text, "Stopped the agent on task #0004 (alpha)") {
+		t.Fatalf("stop = %+v", resp)
+	}
+	if resp := Route(ctx, "/watchfire", "stop", "beta", cc); !resp.Ephemeral || !strings.Contains(resp.Text, "Nothing is running for beta") {
+		t.Errorf("stop beta = %+v", resp)
+	}
+	if len(calls) != 1 || calls[0] != "stop proj-a" {
+		t.Fatalf("calls = %v", calls)
+	}
+	cc.StopAgent = func(context.Context, string) error { return ErrNotAllowed }
+	if resp := Route(ctx, "/watchfire", "stop", "alpha", cc); !strings.Contains(resp.Text, "not allowed") {
+		t.Errorf("refused stop = %+v", resp)
+	}
+}
+
+func TestRouteNewCommand(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	cc := runTestContext(&calls)
+	cc.CreateTask = func(_ context.Context, projectID, title string) (*models.Task, error) {
+		calls = append(calls, projectID+" "+title)
+		return &models.Task{TaskNumber: 31, Title: title}, nil
+	}
+
+	resp := Route(ctx, "/watchfire", "new", "beta Fix the flaky login test", cc)
+	if !resp.InChannel || !strings.Contains(resp.Text, "Created task #0031 — Fix the flaky login test (beta)") {
+		t.Fatalf("new = %+v", resp)
+	}
+	if len(calls) != 1 || calls[0] != "proj-b Fix the flaky login test" {
+		t.Fatalf("calls = %v", calls)
+	}
+	if resp := Route(ctx, "/watchfire", "new", "Fix it", cc); !strings.Contains(resp.Text, "Several projects match") {
+		t.Errorf("new without a project = %+v", resp)
+	}
+
+	// Slack's mrkdwn section escapes the title; Discord's is left alone.
+	resp = Route(ctx, "/watchfire", "new", "beta Ping <!channel> *now*", cc)
+	if got := resp.Blocks[0].Text; !strings.Contains(got, "— Ping &lt;!channel&gt; ∗now∗\n") {
+		t.Errorf("slack section = %q", got)
+	}
+	cc.TeamID, cc.GuildID = "", "G1"
+	resp = Route(ctx, "/watchfire", "new", "beta Ping <!channel> *now*", cc)
+	if got := resp.Blocks[0].Text; !strings.Contains(got, "— Ping <!channel> *now*\n") {
+		t.Errorf("discord section = %q", got)
+	}
+}
+
+func TestRouteScreenAndWatch(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	cc := runTestContext(&calls)
+	cc.Screen = func(_ context.Context, projectID string) (string, bool) {
+		return "$ go test ./...\nok  watchfire", projectID == "proj-a"
+	}
+	watching := map[string]bool{}
+	cc.Watch = func(_ context.Context, projectID string, on bool) error {
+		watching[projectID] = on
+		return nil
+	}
+	cc.Watching = func(_ context.Context, projectID string) bool { return watching[projectID] }
+
+	resp := Route(ctx, "/watchfire", "screen", "", cc)
+	if !resp.Ephemeral || len(resp.Blocks) != 3 || resp.Blocks[0].Text != "alpha — task #0004" ||
+		resp.Blocks[1].Text != "```\n$ go test ./...\nok  watchfire\n```" {
+		t.Fatalf("screen = %+v", resp)
+	}
+	if resp := Route(ctx, "/watchfire", "screen", "beta", cc); !strings.Contains(resp.Text, "No agent is running for beta") {
+		t.Errorf("screen beta = %+v", resp)
+	}
+
+	if resp := Route(ctx, "/watchfire", "watch", "beta on", cc); !resp.InChannel || !watching["proj-b"] {
+		t.Fatalf("watch beta on = %+v (watching %v)", resp, watching)
+	}
+	if resp := Route(ctx, "/watchfire", "watch", "beta", cc); !strings.Contains(resp.Text, "Watch is on for beta") {
+		t.Errorf("watch status = %+v", resp)
+	}
+	Route(ctx, "/watchfire", "watch", "beta off", cc)
+	if watching["proj-b"] {
+		t.Error("watch beta off left the watch on")
+	}
+	if resp := Route(ctx, "/watchfire", "watch", "beta sideways", cc); !strings.Contains(resp.Text, "Usage") {
+		t.Errorf("bad watch argument = %+v", resp)
+	}
+}
+
+func TestScreenTail(t *testing.T) {
+	var lines []string
+	for i := 1; i <= 50; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	got := strings.Split(ScreenTail(strings.Join(lines, "\n")), "\n")
+	if len(got) != screenTailLines || got[0] != "line 21" || got[len(got)-1] != "line 50" {
+		t.Fatalf("tail = %d lines, %q … %q", len(got), got[0], got[len(got)-1])
+	}
+	wide := strings.Repeat("x", 200)
+	got = strings.Split(ScreenTail(strings.Repeat(wide+"\n", 30)+"last"), "\n")
+	if n := len([]rune(strings.Join(got, "\n"))); n > screenTailRunes || got[len(got)-1] != "last" {
+		t.Fatalf("wide tail = %d runes, ends %q", n, got[len(got)-1])
+	}
+	if ScreenTail("a ``` b") != "a ''' b" {
+		t.Error("code fences in the screen must be defused")
+	}
+}
+
+// TestFlattenOptionsProjectFirst: Discord sends options in the order
+// the user filled them in; the project always leads the router's rest.
+func TestFlattenOptionsProjectFirst(t *testing.T) {
+	opt := func(name, value string) discordCommandOption {
+		raw, _ := json.Marshal(value)
+		return discordCommandOption{Name: name, Type: 3, Value: raw}
+	}
+	got := flattenOptions([]discordCommandOption{opt("what", "all"), opt("project", "beta")})
+	if got != "beta all" {
+		t.Fatalf("flattenOptions = %q, want %q", got, "beta all")
+	}
+}
@@ -216,6 +216,14 @@ func pushBranch(ctx context.Context, projectPath, branch string) error {
 	return nil
 }
 
+// DefaultBranch is the branch task work lands on — the base the auto-PR
+// flow opens against. Exported so history readers (insights' revert scan)
+// walk the same branch the merge path targets rather than whatever
+// happens to be checked out.
+func DefaultBranch(ctx context.Context, projectPath string) string {
+	return resolveDefaultBranch(ctx, projectPath)
+}
+
 // resolveDefaultBranch asks git which branch HEAD points at on the remote.
 // Falls back to "main" if the symbolic-ref lookup fails — that's a sane
 // default for a fresh GitHub repo and keeps the merge path unblocked.
@@ -0,0 +1,64 @@
+package insights
+
+import (
+	"sort"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// loadTaskActivity gathers the shell commands and edited files across
+// every session of a task that left a normalized event log. ok is false
+// when none did. Best-effort: unreadable event logs are skipped.
+func loadTaskActivity(projectID string, taskNumber int) (cmds []CommandRun, files []string, ok bool) {
+	logs, err := config.ListLogs(projectID)
+	if err != nil {
+		return nil, nil, false
+	}
+	var sessions []*models.LogEntry
+	for _, l := range logs {
+		if l.TaskNumber == taskNumber && l.HasEvents {
+			sessions = append(sessions, l)
+		}
+	}
+	// ListLogs is newest first; activity reads in session order.
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartedAt < sessions[j].StartedAt
+	})
+
+	var events [][]models.SessionEvent
+	for _, l := range sessions {
+		evs, err := config.ReadSessionEvents(projectID, l.LogID)
+		if err != nil {
+			continue
+		}
+		events = append(events, evs)
+	}
+	if len(events) == 0 {
+		return nil, nil, false
+	}
+	cmds, files = activityFromEvents(events...)
+	return cmds, files, true
+}
+
+// activityFromEvents folds event logs into the command list and the
+// de-duplicated list of edited files.
+func activityFromEvents(sessions ...[]models.SessionEvent) ([]CommandRun, []string) {
+	var cmds []CommandRun
+	var files []string
+	seen := map[string]bool{}
+	for _, evs := range sessions {
+		for _, e := range evs {
+			switch e.Type {
+			case models.SessionEventShellCommand:
+				cmds = append(cmds, CommandRun{Command: e.Command, ExitCode: e.ExitCode})
+			case models.SessionEventFileEdit:
+				if !seen[e.Path] {
+					seen[e.Path] = true
+					files = append(files, e.Path)
+				}
+			}
+		}
+	}
+	return cmds, files
+}
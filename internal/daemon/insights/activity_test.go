@@ -0,0 +1,43 @@
+package insights
+
+import (
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// Commands keep every run (retries matter), while files are listed once
+// in the order they were first edited across sessions.
+func TestActivityFromEvents(t *testing.T) {
+	zero := 0
+	first := []models.SessionEvent{
+		{Type: models.SessionEventFileEdit, Path: "a.go"},
+		{Type: models.SessionEventShellCommand, Command: "make"},
+		{Type: models.SessionEventToolCall, Tool: "Bash"},
+	}
+	second := []models.SessionEvent{
+		{Type: models.SessionEventFileEdit, Path: "b.go"},
+		{Type: models.SessionEventFileEdit, Path: "a.go"},
+		{Type: models.SessionEventShellCommand, Command: "make", ExitCode: &zero},
+	}
+	cmds, files := activityFromEvents(first, second)
+	if len(cmds) != 2 || cmds[0].ExitCode != nil || cmds[1].ExitCode == nil {
+		t.Errorf("commands = %+v", cmds)
+	}
+	if len(files) != 2 || files[0] != "a.go" || files[1] != "b.go" {
+		t.Errorf("files = %v", files)
+	}
+}
+
+func TestCommandCode(t *testing.T) {
+	cases := map[string]string{
+		"ls -la":            "`ls -la`",
+		"echo `date`":       "`` echo `date` ``",
+		"cat <<EOF\nx\nEOF": "`cat <<EOF …`",
+	}
+	for in, want := range cases {
+		if got := commandCode(in); got != want {
+			t.Errorf("commandCode(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -44,7 +44,32 @@ var cacheMu sync.Mutex
 // 2 (v9.2): `TopProjects` is no longer truncated to MaxTopProjects. A v1
 // entry holds at most 5 projects, so the dashboard would find no churn for
 // anything outside the old top 5 until the next task landed.
-const globalCacheSchema = 2
+//
+// 3: cost totals are summed from the per-task metrics (reported or
+// estimated) instead of always reading 0 with every task "missing cost".
+//
+// 4: adds `AgentComparison`; an older entry would show it empty.
+//
+// 5: adds `Overhead`.
+//
+// 6: adds `Trend`.
+//
+// 7: adds `Users`.
+const globalCacheSchema = 7
+
+// projectCacheSchema is globalCacheSchema's per-project counterpart.
+//
+// 1: cost totals are summed from the per-task metrics. Files written
+// before it carry no schema (0) and always-zero cost.
+//
+// 2: adds `AgentComparison`.
+//
+// 3: adds `Overhead`.
+//
+// 4: adds `Trend`.
+//
+// 5: adds `Users`.
+const projectCacheSchema = 5
 
 // globalCacheFileShape is the on-disk JSON shape. A `map[key]entry`
 // schema lets us cache multiple windows side-by-side (the GUI sometimes
@@ -174,6 +199,7 @@ func InvalidateProjectCache(projectID string) {
 // file. Same `map[key]entry` structure as the global cache so the GUI can
 // flip windows without paying for repeated fan-outs.
 type projectCacheFileShape struct {
+	Schema  int                         `json:"schema"`
 	Entries map[string]*ProjectInsights `json:"entries"`
 }
 
@@ -200,6 +226,9 @@ func readProjectCache(projectID string, start, end time.Time) (*ProjectInsights,
 	if err := json.Unmarshal(bytes, &shape); err != nil {
 		return nil, false
 	}
+	if shape.Schema != projectCacheSchema {
+		return nil, false
+	}
 	entry, ok := shape.Entries[cacheKey(start, end)]
 	if !ok || entry == nil {
 		return nil, false
@@ -220,12 +249,13 @@ func writeProjectCache(out *ProjectInsights) {
 	if err != nil {
 		return
 	}
-	shape := projectCacheFileShape{Entries: map[string]*ProjectInsights{}}
+	shape := projectCacheFileShape{Schema: projectCacheSchema, Entries: map[string]*ProjectInsights{}}
 	if bytes, err := os.ReadFile(path); err == nil { //nolint:gosec // path is daemon-controlled
-		_ = json.Unmarshal(bytes, &shape)
-	}
-	if shape.Entries == nil {
-		shape.Entries = map[string]*ProjectInsights{}
+		// Same rule as writeGlobalCache: never merge an older-schema file.
+		var existing projectCacheFileShape
+		if json.Unmarshal(bytes, &existing) == nil && existing.Schema == projectCacheSchema && existing.Entries != nil {
+			shape.Entries = existing.Entries
+		}
 	}
 	shape.Entries[cacheKey(out.WindowStart, out.WindowEnd)] = out
 	encoded, err := json.MarshalIndent(shape, "", "  ")
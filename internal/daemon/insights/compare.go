@@ -0,0 +1,135 @@
+package insights
+
+import (
+	"sort"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// AgentComparisonRow compares one (backend, model) pair over the window's
+// completed tasks — the "which agent is best for this repo" view, as
+// opposed to AgentBreakdown's per-backend volume totals. Rates are 0..1
+// over Tasks. Model is empty when the task's session log named none
+// (older metrics, backends without token_usage events).
+type AgentComparisonRow struct {
+	Agent       string  `json:"agent"`
+	Model       string  `json:"model"`
+	Tasks       int     `json:"tasks"`
+	Succeeded   int     `json:"succeeded"`
+	SuccessRate float64 `json:"success_rate"`
+
+	MedianDurationMs int64 `json:"median_duration_ms"`
+	P90DurationMs    int64 `json:"p90_duration_ms"`
+
+	TotalCostUSD float64 `json:"total_cost_usd"`
+	// CostPerSuccessUSD spreads the cost of every task, failed ones
+	// included, over the successful ones: what a shipped task costs.
+	CostPerSuccessUSD float64 `json:"cost_per_success_usd"`
+
+	// MergeFailures — tasks the agent finished successfully whose
+	// auto-merge then failed (merge_failure_reason set).
+	MergeFailures    int     `json:"merge_failures"`
+	MergeFailureRate float64 `json:"merge_failure_rate"`
+	// FollowUps — tasks that needed more than one agent session.
+	FollowUps    int     `json:"follow_ups"`
+	FollowUpRate float64 `json:"follow_up_rate"`
+	// Reverted — tasks whose merge or commits were later reverted on the
+	// project's default branch.
+	Reverted   int     `json:"reverted"`
+	RevertRate float64 `json:"revert_rate"`
+
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+}
+
+// ModelLabel is Model, or "(unknown)" when the session named none.
+func (r AgentComparisonRow) ModelLabel() string {
+	if r.Model == "" {
+		return "(unknown)"
+	}
+	return r.Model
+}
+
+type comparisonKey struct {
+	agent, model string
+}
+
+type comparisonAcc struct {
+	row       AgentComparisonRow
+	durations []int64
+}
+
+// comparisonTally accumulates completed tasks into AgentComparisonRows.
+// Shared by the project / global rollups and the exports so all three
+// agree on the definitions.
+type comparisonTally map[comparisonKey]*comparisonAcc
+
+func newComparisonTally() comparisonTally { return comparisonTally{} }
+
+// add counts one completed-in-window task. m may be nil (no metrics:
+// unknown model, zero cost and churn).
+func (c comparisonTally) add(t *models.Task, m *models.TaskMetrics, completedAt time.Time, reverted bool) {
+	key := comparisonKey{agent: agentKey(t.Agent)}
+	if m != nil {
+		key.model = m.Model
+	}
+	acc := c[key]
+	if acc == nil {
+		acc = &comparisonAcc{row: AgentComparisonRow{Agent: key.agent, Model: key.model}}
+		c[key] = acc
+	}
+	r := &acc.row
+	r.Tasks++
+	if t.Success != nil && *t.Success {
+		r.Succeeded++
+	}
+	if t.MergeFailureReason != "" {
+		r.MergeFailures++
+	}
+	if t.AgentSessions > 1 {
+		r.FollowUps++
+	}
+	if reverted {
+		r.Reverted++
+	}
+	if t.StartedAt != nil {
+		if ms := completedAt.Sub(*t.StartedAt).Milliseconds(); ms > 0 {
+			acc.durations = append(acc.durations, ms)
+		}
+	}
+	r.TotalCostUSD += costFieldsFrom(m).costUSD
+	cf := codeFieldsFrom(m)
+	r.LinesAdded += cf.linesAdded
+	r.LinesRemoved += cf.linesRemoved
+}
+
+// rows finalizes the rates, sorted by task count descending, then agent
+// and model for determinism.
+func (c comparisonTally) rows() []AgentComparisonRow {
+	out := make([]AgentComparisonRow, 0, len(c))
+	for _, acc := range c {
+		r := acc.row
+		n := float64(r.Tasks)
+		r.SuccessRate = float64(r.Succeeded) / n
+		r.MergeFailureRate = float64(r.MergeFailures) / n
+		r.FollowUpRate = float64(r.FollowUps) / n
+		r.RevertRate = float64(r.Reverted) / n
+		if r.Succeeded > 0 {
+			r.CostPerSuccessUSD = r.TotalCostUSD / float64(r.Succeeded)
+		}
+		r.MedianDurationMs = percentileInt64(acc.durations, 50)
+		r.P90DurationMs = percentileInt64(acc.durations, 90)
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Tasks != out[j].Tasks {
+			return out[i].Tasks > out[j].Tasks
+		}
+		if out[i].Agent != out[j].Agent {
+			return out[i].Agent < out[j].Agent
+		}
+		return out[i].Model < out[j].Model
+	})
+	return out
+}
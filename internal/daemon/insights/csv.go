@@ -16,14 +16,17 @@ func renderSingleTaskCSV(d SingleTaskData) ([]byte, error) {
 	buf.WriteString("# section: task\n")
 	w := csv.NewWriter(&buf)
 	// Header order is documented + stable: the v8.0 code-output columns
-	// (commits … merge_kind) are appended after the original columns so
-	// existing parsers that index by position keep working.
+	// (commits … merge_kind), the session-activity counts and the cost
+	// columns are appended after the original columns so existing parsers
+	// that index by position keep working.
 	if err := w.Write([]string{
 		"project_id", "project_name", "task_number", "title", "status",
 		"success", "failure_reason", "agent", "agent_sessions",
 		"started_at", "completed_at", "duration_sec", "worktree_branch",
 		"commits", "files_changed", "lines_added", "lines_removed",
 		"net_lines", "merged", "merge_kind",
+		"commands_run", "files_edited",
+		"tokens_in", "tokens_out", "cost_usd", "cost_source",
 	}); err != nil {
 		return nil, err
 	}
@@ -48,6 +51,12 @@ func renderSingleTaskCSV(d SingleTaskData) ([]byte, error) {
 		fmt.Sprintf("%d", d.NetLines),
 		fmt.Sprintf("%t", d.Merged),
 		d.MergeKind,
+		fmt.Sprintf("%d", len(d.CommandsRun)),
+		fmt.Sprintf("%d", len(d.FilesEdited)),
+		int64PtrString(d.TokensIn),
+		int64PtrString(d.TokensOut),
+		usdPtrString(d.CostUSD),
+		d.CostSource,
 	}); err != nil {
 		return nil, err
 	}
@@ -95,16 +104,26 @@ func renderProjectCSV(d ProjectData) ([]byte, error) {
 	if err := writeCodeSection(&buf, d.Code); err != nil {
 		return nil, err
 	}
+	if err := writeSpendSection(&buf, d.Spend); err != nil {
+		return nil, err
+	}
 	if err := writeDailySection(&buf, d.Daily); err != nil {
 		return nil, err
 	}
 	if err := writeAgentsSection(&buf, d.Agents); err != nil {
 		return nil, err
 	}
+	if err := writeComparisonSection(&buf, d.Comparison); err != nil {
+		return nil, err
+	}
+	if err := writeOverheadSections(&buf, d.Overhead); err != nil {
+		return nil, err
+	}
 	return []byte(buf.String()), nil
 }
 
-// renderGlobalCSV emits five sections: kpis, code, daily, top_projects, agents.
+// renderGlobalCSV emits nine sections: kpis, code, spend, daily,
+// top_projects, agents, agent_comparison, overhead, overhead_by_kind.
 func renderGlobalCSV(d GlobalData) ([]byte, error) {
 	var buf strings.Builder
 
@@ -136,6 +155,9 @@ func renderGlobalCSV(d GlobalData) ([]byte, error) {
 	if err := writeCodeSection(&buf, d.Code); err != nil {
 		return nil, err
 	}
+	if err := writeSpendSection(&buf, d.Spend); err != nil {
+		return nil, err
+	}
 
 	if err := writeDailySection(&buf, d.Daily); err != nil {
 		return nil, err
@@ -172,6 +194,12 @@ func renderGlobalCSV(d GlobalData) ([]byte, error) {
 	if err := writeAgentsSection(&buf, d.Agents); err != nil {
 		return nil, err
 	}
+	if err := writeComparisonSection(&buf, d.Comparison); err != nil {
+		return nil, err
+	}
+	if err := writeOverheadSections(&buf, d.Overhead); err != nil {
+		return nil, err
+	}
 	return []byte(buf.String()), nil
 }
 
@@ -204,6 +232,29 @@ func writeCodeSection(buf *strings.Builder, c CodeOutput) error {
 	return w.Error()
 }
 
+// writeSpendSection emits the cost totals as a single-row section.
+// estimated_cost_usd is the part of total_cost_usd computed from the
+// pricing table rather than reported by the backend.
+func writeSpendSection(buf *strings.Builder, s Spend) error {
+	buf.WriteString("# section: spend\n")
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{
+		"total_cost_usd", "estimated_cost_usd", "tasks_estimated_cost", "tasks_missing_cost",
+	}); err != nil {
+		return err
+	}
+	if err := w.Write([]string{
+		usdString(s.TotalCostUSD),
+		usdString(s.EstimatedCostUSD),
+		fmt.Sprintf("%d", s.TasksEstimatedCost),
+		fmt.Sprintf("%d", s.TasksMissingCost),
+	}); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
+
 func writeDailySection(buf *strings.Builder, daily []DayBucket) error {
 	buf.WriteString("# section: daily\n")
 	w := csv.NewWriter(buf)
@@ -230,6 +281,7 @@ func writeAgentsSection(buf *strings.Builder, agents []AgentBreakdown) error {
 	if err := w.Write([]string{
 		"agent", "tasks", "done", "failed", "avg_duration_sec",
 		"commits", "lines_added", "lines_removed",
+		"tokens_in", "tokens_out", "cost_usd",
 	}); err != nil {
 		return err
 	}
@@ -243,6 +295,97 @@ func writeAgentsSection(buf *strings.Builder, agents []AgentBreakdown) error {
 			fmt.Sprintf("%d", a.Commits),
 			fmt.Sprintf("%d", a.LinesAdded),
 			fmt.Sprintf("%d", a.LinesRemoved),
+			fmt.Sprintf("%d", a.TokensIn),
+			fmt.Sprintf("%d", a.TokensOut),
+			usdString(a.CostUSD),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// writeComparisonSection emits one row per (agent, model) pair. Rates
+// are 0..1 fractions.
+func writeComparisonSection(buf *strings.Builder, rows []AgentComparisonRow) error {
+	buf.WriteString("# section: agent_comparison\n")
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{
+		"agent", "model", "tasks", "succeeded", "success_rate",
+		"median_duration_ms", "p90_duration_ms", "cost_usd", "cost_per_success_usd",
+		"merge_failures", "merge_failure_rate", "follow_ups", "follow_up_rate",
+		"reverted", "revert_rate", "lines_added", "lines_removed",
+	}); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := w.Write([]string{
+			r.Agent,
+			r.Model,
+			fmt.Sprintf("%d", r.Tasks),
+			fmt.Sprintf("%d", r.Succeeded),
+			rateString(r.SuccessRate),
+			fmt.Sprintf("%d", r.MedianDurationMs),
+			fmt.Sprintf("%d", r.P90DurationMs),
+			usdString(r.TotalCostUSD),
+			usdString(r.CostPerSuccessUSD),
+			fmt.Sprintf("%d", r.MergeFailures),
+			rateString(r.MergeFailureRate),
+			fmt.Sprintf("%d", r.FollowUps),
+			rateString(r.FollowUpRate),
+			fmt.Sprintf("%d", r.Reverted),
+			rateString(r.RevertRate),
+			fmt.Sprintf("%d", r.LinesAdded),
+			fmt.Sprintf("%d", r.LinesRemoved),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// writeOverheadSections emits the non-task session totals as a single-row
+// section, then one row per session kind.
+func writeOverheadSections(buf *strings.Builder, o OverheadSummary) error {
+	buf.WriteString("# section: overhead\n")
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{
+		"sessions", "duration_ms", "tokens_in", "tokens_out", "cost_usd",
+		"sessions_missing_cost", "cost_share",
+	}); err != nil {
+		return err
+	}
+	if err := w.Write([]string{
+		fmt.Sprintf("%d", o.Sessions),
+		fmt.Sprintf("%d", o.DurationMs),
+		fmt.Sprintf("%d", o.TokensIn),
+		fmt.Sprintf("%d", o.TokensOut),
+		usdString(o.CostUSD),
+		fmt.Sprintf("%d", o.SessionsMissingCost),
+		rateString(o.CostShare),
+	}); err != nil {
+		return err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	buf.WriteString("# section: overhead_by_kind\n")
+	w = csv.NewWriter(buf)
+	if err := w.Write([]string{"kind", "sessions", "duration_ms", "tokens_in", "tokens_out", "cost_usd"}); err != nil {
+		return err
+	}
+	for _, r := range o.ByKind {
+		if err := w.Write([]string{
+			r.Kind,
+			fmt.Sprintf("%d", r.Sessions),
+			fmt.Sprintf("%d", r.DurationMs),
+			fmt.Sprintf("%d", r.TokensIn),
+			fmt.Sprintf("%d", r.TokensOut),
+			usdString(r.CostUSD),
 		}); err != nil {
 			return err
 		}
@@ -251,6 +394,10 @@ func writeAgentsSection(buf *strings.Builder, agents []AgentBreakdown) error {
 	return w.Error()
 }
 
+func rateString(v float64) string {
+	return fmt.Sprintf("%.4f", v)
+}
+
 func boolPtrString(b *bool) string {
 	if b == nil {
 		return ""
@@ -261,6 +408,26 @@ func boolPtrString(b *bool) string {
 	return "false"
 }
 
+func int64PtrString(n *int64) string {
+	if n == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", *n)
+}
+
+// usdString renders a dollar amount to four places — enough for the
+// sub-cent costs of short tasks.
+func usdString(v float64) string {
+	return fmt.Sprintf("%.4f", v)
+}
+
+func usdPtrString(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return usdString(*v)
+}
+
 func timePtrString(t *time.Time) string {
 	if t == nil {
 		return ""
@@ -1,7 +1,9 @@
 // Package insights renders v6.0 Ember reports — single-task / per-project /
-// fleet rollups in CSV + Markdown. The package is split into a data layer
-// (this file: shapes that are easy to fixture for golden tests) and a
-// rendering layer (csv.go + markdown.go) that consumes those shapes.
+// fleet rollups in CSV, Markdown, JSON and HTML. The package is split into a
+// data layer (this file: shapes that are easy to fixture for golden tests)
+// and a rendering layer (csv.go, markdown.go, json.go, html.go) that
+// consumes those shapes. The JSON tags here are the JSON export's schema;
+// see JSONSchemaVersion before changing one.
 //
 // Render-from-data is the testable seam: production loads tasks/projects
 // from disk and builds these shapes; tests construct them inline so a single
@@ -21,78 +23,108 @@ const (
 	ScopeGlobal
 )
 
-// Format selects CSV vs Markdown.
+// Format selects the export's file format.
 type Format int
 
-// Format values match proto/watchfire.proto:ExportFormat (CSV=0, MARKDOWN=1).
+// Format values match proto/watchfire.proto:ExportFormat (CSV=0, MARKDOWN=1,
+// JSON=2, HTML=3).
 const (
 	FormatCSV Format = iota
 	FormatMarkdown
+	FormatJSON
+	FormatHTML
 )
 
 // SingleTaskData covers one task. Used for ScopeSingleTask. Keeps everything
 // needed for a paste-into-PR description: identity, outcome, timing, the
 // worktree branch (so reviewers can `git log <branch>` the diff).
 type SingleTaskData struct {
-	ProjectID      string
-	ProjectName    string
-	TaskNumber     int
-	Title          string
-	Status         string // "draft" | "ready" | "done"
-	Success        *bool  // nil when not done
-	FailureReason  string
-	Agent          string
-	AgentSessions  int
-	StartedAt      *time.Time
-	CompletedAt    *time.Time
-	DurationSec    int64 // CompletedAt - StartedAt; 0 when one of them is missing
-	WorktreeBranch string
-	Prompt         string // First 240 chars of the prompt, for context
+	ProjectID      string     `json:"project_id"`
+	ProjectName    string     `json:"project_name"`
+	TaskNumber     int        `json:"task_number"`
+	Title          string     `json:"title"`
+	Status         string     `json:"status"`  // "draft" | "ready" | "done"
+	Success        *bool      `json:"success"` // nil when not done
+	FailureReason  string     `json:"failure_reason"`
+	Agent          string     `json:"agent"`
+	AgentSessions  int        `json:"agent_sessions"`
+	StartedAt      *time.Time `json:"started_at"`
+	CompletedAt    *time.Time `json:"completed_at"`
+	DurationSec    int64      `json:"duration_sec"` // CompletedAt - StartedAt; 0 when one of them is missing
+	WorktreeBranch string     `json:"worktree_branch"`
+	Prompt         string     `json:"prompt"` // First 240 chars of the prompt, for context
 
 	// v8.0 Inferno — code-output stats pulled from the task's
 	// `<n>.metrics.yaml` (task 0114). HasCode reports whether the metrics
 	// file carried any code-output signal; when false the numbers are all
 	// zero and the export renders an em-dash rather than "0".
-	Commits      int
-	FilesChanged int
-	LinesAdded   int
-	LinesRemoved int
-	NetLines     int
-	Merged       bool
-	MergeKind    string // "silent" | "auto_pr" | ""
-	HasCode      bool
+	Commits      int    `json:"commits"`
+	FilesChanged int    `json:"files_changed"`
+	LinesAdded   int    `json:"lines_added"`
+	LinesRemoved int    `json:"lines_removed"`
+	NetLines     int    `json:"net_lines"`
+	Merged       bool   `json:"merged"`
+	MergeKind    string `json:"merge_kind"` // "silent" | "auto_pr" | ""
+	HasCode      bool   `json:"has_code"`
+
+	// Token + cost figures from the metrics file; nil when not captured.
+	// CostSource is "reported" or "estimated" (see models.CostSource).
+	TokensIn   *int64   `json:"tokens_in"`
+	TokensOut  *int64   `json:"tokens_out"`
+	CostUSD    *float64 `json:"cost_usd"`
+	CostSource string   `json:"cost_source"`
+
+	// Session activity from the normalized event logs of the task's
+	// sessions, oldest session first. HasActivity is false when no
+	// session left an event log (older logs, backends without an
+	// extractor); the export then says so instead of listing nothing.
+	CommandsRun []CommandRun `json:"commands_run"`
+	FilesEdited []string     `json:"files_edited"` // unique paths, in first-edit order
+	HasActivity bool         `json:"has_activity"`
+}
+
+// CommandRun is one shell command an agent ran during a task. ExitCode is
+// nil when the transcript didn't report one.
+type CommandRun struct {
+	Command  string `json:"command"`
+	ExitCode *int   `json:"exit_code"`
 }
 
 // AgentBreakdown row — one per backend agent that touched tasks in the window.
 type AgentBreakdown struct {
-	Agent          string
-	Done           int
-	Failed         int
-	AvgDurationSec int64
-	Tasks          int
+	Agent          string `json:"agent"`
+	Done           int    `json:"done"`
+	Failed         int    `json:"failed"`
+	AvgDurationSec int64  `json:"avg_duration_sec"`
+	Tasks          int    `json:"tasks"`
 
 	// v8.0 Inferno — shipped-code totals for this agent across the window,
 	// so reports compare output-per-agent, not only task count.
-	Commits      int
-	LinesAdded   int
-	LinesRemoved int
+	Commits      int `json:"commits"`
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+
+	// Token + cost totals; CostUSD includes estimated costs.
+	TokensIn  int64   `json:"tokens_in"`
+	TokensOut int64   `json:"tokens_out"`
+	CostUSD   float64 `json:"cost_usd"`
 }
 
 // DayBucket — one calendar-day worth of activity counts.
 type DayBucket struct {
-	Date    string // YYYY-MM-DD (local zone)
-	Done    int
-	Failed  int
-	Created int
+	Date    string `json:"date"` // YYYY-MM-DD (local zone)
+	Done    int    `json:"done"`
+	Failed  int    `json:"failed"`
+	Created int    `json:"created"`
 }
 
 // KPIs — the shared headline numbers for ScopeProject + ScopeGlobal reports.
 type KPIs struct {
-	TotalDone      int
-	TotalFailed    int
-	TotalCreated   int
-	TotalInFlight  int
-	AvgDurationSec int64
+	TotalDone      int   `json:"total_done"`
+	TotalFailed    int   `json:"total_failed"`
+	TotalCreated   int   `json:"total_created"`
+	TotalInFlight  int   `json:"total_in_flight"`
+	AvgDurationSec int64 `json:"avg_duration_sec"`
 }
 
 // CodeOutput — the shared "what shipped" totals for ScopeProject +
@@ -102,55 +134,80 @@ type KPIs struct {
 // code signal so the report can honestly caveat "based on N of M tasks"
 // (mirror of KPIs/TasksMissingCost).
 type CodeOutput struct {
-	TotalCommits       int
-	TotalFilesChanged  int
-	TotalLinesAdded    int
-	TotalLinesRemoved  int
-	NetLines           int
-	TasksMerged        int
-	TasksViaPR         int
-	MetricsMissingCode int
+	TotalCommits       int `json:"total_commits"`
+	TotalFilesChanged  int `json:"total_files_changed"`
+	TotalLinesAdded    int `json:"total_lines_added"`
+	TotalLinesRemoved  int `json:"total_lines_removed"`
+	NetLines           int `json:"net_lines"`
+	TasksMerged        int `json:"tasks_merged"`
+	TasksViaPR         int `json:"tasks_via_pr"`
+	MetricsMissingCode int `json:"metrics_missing_code"`
+}
+
+// Spend — the shared cost totals for ScopeProject + ScopeGlobal reports.
+// TotalCostUSD sums every completed task that has a cost; the part of it
+// computed from the settings.yaml pricing table (because the backend
+// didn't report a cost) is broken out as EstimatedCostUSD, so readers can
+// tell a bill from a guess. TasksMissingCost counts tasks with neither.
+type Spend struct {
+	TotalCostUSD       float64 `json:"total_cost_usd"`
+	EstimatedCostUSD   float64 `json:"estimated_cost_usd"`
+	TasksEstimatedCost int     `json:"tasks_estimated_cost"`
+	TasksMissingCost   int     `json:"tasks_missing_cost"`
 }
 
 // ProjectData covers one project across a window. Used for ScopeProject and
 // embedded inside GlobalData.TopProjects.
 type ProjectData struct {
-	ProjectID   string
-	ProjectName string
-	WindowStart time.Time
-	WindowEnd   time.Time
-
-	KPIs   KPIs
-	Code   CodeOutput
-	Daily  []DayBucket
-	Agents []AgentBreakdown
+	ProjectID   string    `json:"project_id"`
+	ProjectName string    `json:"project_name"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+
+	KPIs   KPIs             `json:"kpis"`
+	Code   CodeOutput       `json:"code"`
+	Spend  Spend            `json:"spend"`
+	Daily  []DayBucket      `json:"daily"`
+	Agents []AgentBreakdown `json:"agents"`
+
+	// Comparison rates each (backend, model) pair; see AgentComparisonRow.
+	Comparison []AgentComparisonRow `json:"agent_comparison"`
+	// Overhead is the spend of sessions that ran outside a task.
+	Overhead OverheadSummary `json:"overhead"`
+	// Users splits the window's work and spend per person.
+	Users []UserRow `json:"users"`
 }
 
 // GlobalData covers fleet-wide rollups across every registered project.
 type GlobalData struct {
-	WindowStart time.Time
-	WindowEnd   time.Time
-
-	KPIs         KPIs
-	Code         CodeOutput
-	Daily        []DayBucket
-	TopProjects  []ProjectSummary
-	Agents       []AgentBreakdown
-	ProjectCount int
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+
+	KPIs         KPIs             `json:"kpis"`
+	Code         CodeOutput       `json:"code"`
+	Spend        Spend            `json:"spend"`
+	Daily        []DayBucket      `json:"daily"`
+	TopProjects  []ProjectSummary `json:"top_projects"`
+	Agents       []AgentBreakdown `json:"agents"`
+	ProjectCount int              `json:"project_count"`
+
+	Comparison []AgentComparisonRow `json:"agent_comparison"`
+	Overhead   OverheadSummary      `json:"overhead"`
+	Users      []UserRow            `json:"users"`
 }
 
 // ProjectSummary is one row of the GlobalData "top projects" table.
 type ProjectSummary struct {
-	ProjectID   string
-	ProjectName string
-	Done        int
-	Failed      int
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	Done        int    `json:"done"`
+	Failed      int    `json:"failed"`
 
 	// v8.0 Inferno — per-project shipped-code totals over the window so the
 	// fleet report can rank/compare projects by churn, not only task count.
-	Commits      int
-	LinesAdded   int
-	LinesRemoved int
-	NetLines     int
-	Merges       int
+	Commits      int `json:"commits"`
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+	NetLines     int `json:"net_lines"`
+	Merges       int `json:"merges"`
 }
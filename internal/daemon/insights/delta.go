@@ -0,0 +1,147 @@
+package insights
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// Regression thresholds. A period only counts when both it and the one
+// it's compared with completed at least RegressionMinTasks tasks, so a
+// single failure in a quiet week isn't flagged.
+const (
+	RegressionMinTasks = 3
+	// RegressionSuccessDrop is the success-rate fall, in points (0..1),
+	// that counts as a regression.
+	RegressionSuccessDrop = 0.10
+	// RegressionCostRise is the rise in cost per completed task, as a
+	// fraction, that counts as a regression.
+	RegressionCostRise = 0.50
+)
+
+// PeriodTotals are the figures a comparison looks at, for one window.
+type PeriodTotals struct {
+	Tasks     int
+	Succeeded int
+	CostUSD   float64
+	NetLines  int
+}
+
+// Totals picks the compared figures out of a project rollup.
+func (p *ProjectInsights) Totals() PeriodTotals {
+	return PeriodTotals{Tasks: p.TasksTotal, Succeeded: p.TasksSucceeded, CostUSD: p.TotalCostUSD, NetLines: p.NetLines}
+}
+
+// Totals picks the compared figures out of a fleet rollup.
+func (g *GlobalInsights) Totals() PeriodTotals {
+	return PeriodTotals{Tasks: g.TasksTotal, Succeeded: g.TasksSucceeded, CostUSD: g.TotalCostUSD, NetLines: g.NetLines}
+}
+
+// Add sums two periods, e.g. projects into a fleet total.
+func (t PeriodTotals) Add(o PeriodTotals) PeriodTotals {
+	return PeriodTotals{
+		Tasks:     t.Tasks + o.Tasks,
+		Succeeded: t.Succeeded + o.Succeeded,
+		CostUSD:   t.CostUSD + o.CostUSD,
+		NetLines:  t.NetLines + o.NetLines,
+	}
+}
+
+// AddTask counts one completed task; m may be nil.
+func (t *PeriodTotals) AddTask(task *models.Task, m *models.TaskMetrics) {
+	t.Tasks++
+	if task.Success != nil && *task.Success {
+		t.Succeeded++
+	}
+	t.CostUSD += costFieldsFrom(m).costUSD
+	cf := codeFieldsFrom(m)
+	t.NetLines += cf.linesAdded - cf.linesRemoved
+}
+
+// SuccessRate is Succeeded over Tasks, 0 with no tasks.
+func (t PeriodTotals) SuccessRate() float64 {
+	if t.Tasks == 0 {
+		return 0
+	}
+	return float64(t.Succeeded) / float64(t.Tasks)
+}
+
+// MetricDelta is one figure in the current window against the comparison
+// window. Change is Current − Previous (points for rates); ChangePct is
+// Change over |Previous|, 0 when Previous is 0.
+type MetricDelta struct {
+	Current   float64 `json:"current"`
+	Previous  float64 `json:"previous"`
+	Change    float64 `json:"change"`
+	ChangePct float64 `json:"change_pct"`
+}
+
+func newMetricDelta(current, previous float64) MetricDelta {
+	d := MetricDelta{Current: current, Previous: previous, Change: current - previous}
+	if previous != 0 {
+		d.ChangePct = d.Change / math.Abs(previous)
+	}
+	return d
+}
+
+// InsightsComparison sets a rollup against an earlier window — by default
+// the period of the same length just before it. WindowStart / WindowEnd
+// are the comparison window's bounds. Regressions are human-readable
+// reasons ("success rate down 15 pts (80% → 65%)"), empty when none of
+// the thresholds above is crossed.
+type InsightsComparison struct {
+	WindowStart time.Time   `json:"window_start"`
+	WindowEnd   time.Time   `json:"window_end"`
+	Tasks       MetricDelta `json:"tasks"`
+	SuccessRate MetricDelta `json:"success_rate"`
+	CostUSD     MetricDelta `json:"cost_usd"`
+	NetLines    MetricDelta `json:"net_lines"`
+	Regressions []string    `json:"regressions"`
+}
+
+// PreviousWindow is the window of the same length ending just before
+// start. ok is false for a window open at either end, which has no
+// "previous period".
+func PreviousWindow(start, end time.Time) (prevStart, prevEnd time.Time, ok bool) {
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return time.Time{}, time.Time{}, false
+	}
+	prevEnd = start.Add(-time.Nanosecond)
+	return start.Add(-end.Sub(start)), prevEnd, true
+}
+
+// Compare builds the comparison of cur against prev, which covered
+// [prevStart, prevEnd].
+func Compare(cur, prev PeriodTotals, prevStart, prevEnd time.Time) *InsightsComparison {
+	return &InsightsComparison{
+		WindowStart: prevStart,
+		WindowEnd:   prevEnd,
+		Tasks:       newMetricDelta(float64(cur.Tasks), float64(prev.Tasks)),
+		SuccessRate: newMetricDelta(cur.SuccessRate(), prev.SuccessRate()),
+		CostUSD:     newMetricDelta(cur.CostUSD, prev.CostUSD),
+		NetLines:    newMetricDelta(float64(cur.NetLines), float64(prev.NetLines)),
+		Regressions: Regressions(cur, prev),
+	}
+}
+
+// Regressions lists what got worse between prev and cur, by the
+// Regression* thresholds.
+func Regressions(cur, prev PeriodTotals) []string {
+	if cur.Tasks < RegressionMinTasks || prev.Tasks < RegressionMinTasks {
+		return []string{}
+	}
+	out := []string{}
+	if drop := prev.SuccessRate() - cur.SuccessRate(); drop >= RegressionSuccessDrop-1e-9 {
+		out = append(out, fmt.Sprintf("success rate down %.0f pts (%.0f%% → %.0f%%)",
+			drop*100, prev.SuccessRate()*100, cur.SuccessRate()*100))
+	}
+	prevPer := prev.CostUSD / float64(prev.Tasks)
+	curPer := cur.CostUSD / float64(cur.Tasks)
+	if prevPer > 0 && (curPer-prevPer)/prevPer >= RegressionCostRise {
+		out = append(out, fmt.Sprintf("cost per task up %.0f%% ($%.2f → $%.2f)",
+			(curPer-prevPer)/prevPer*100, prevPer, curPer))
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package insights
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPreviousWindow(t *testing.T) {
+	start := time.Date(2026, 5, 8, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	ps, pe, ok := PreviousWindow(start, end)
+	if !ok || !ps.Equal(start.AddDate(0, 0, -7)) || !pe.Before(start) || pe.Before(start.Add(-time.Second)) {
+		t.Errorf("PreviousWindow = %v, %v, %v", ps, pe, ok)
+	}
+	if _, _, ok := PreviousWindow(time.Time{}, end); ok {
+		t.Error("open-start window should have no previous window")
+	}
+}
+
+func TestCompareAndRegressions(t *testing.T) {
+	prev := PeriodTotals{Tasks: 5, Succeeded: 5, CostUSD: 5, NetLines: 100}
+	cur := PeriodTotals{Tasks: 4, Succeeded: 3, CostUSD: 4, NetLines: -20}
+
+	c := Compare(cur, prev, time.Time{}, time.Time{})
+	if c.Tasks.Change != -1 || c.Tasks.ChangePct != -0.2 {
+		t.Errorf("tasks delta = %+v", c.Tasks)
+	}
+	if c.SuccessRate.Current != 0.75 || c.SuccessRate.Change != -0.25 {
+		t.Errorf("success rate delta = %+v", c.SuccessRate)
+	}
+	if c.NetLines.ChangePct != -1.2 {
+		t.Errorf("net lines delta = %+v", c.NetLines)
+	}
+	// Cost per task is flat ($1), so only the success rate regressed.
+	if len(c.Regressions) != 1 || c.Regressions[0] != "success rate down 25 pts (100% → 75%)" {
+		t.Errorf("regressions = %q", c.Regressions)
+	}
+
+	// Too few tasks on either side: nothing is flagged.
+	if got := Regressions(PeriodTotals{Tasks: 2}, prev); len(got) != 0 {
+		t.Errorf("quiet week regressions = %q", got)
+	}
+	if d := Compare(cur, PeriodTotals{}, time.Time{}, time.Time{}); d.Tasks.ChangePct != 0 {
+		t.Errorf("change_pct against zero = %v, want 0", d.Tasks.ChangePct)
+	}
+}
@@ -31,6 +31,10 @@ func ExportSingleTaskFromData(d SingleTaskData, format Format, at time.Time) (Re
 		content, err = renderSingleTaskCSV(d)
 	case FormatMarkdown:
 		content, err = renderSingleTaskMarkdown(d)
+	case FormatJSON:
+		content, err = renderSingleTaskJSON(d, at)
+	case FormatHTML:
+		content, err = renderSingleTaskHTML(d, at)
 	default:
 		return Result{}, fmt.Errorf("insights: unknown format %d", format)
 	}
@@ -56,6 +60,10 @@ func ExportProjectFromData(d ProjectData, format Format, at time.Time) (Result,
 		content, err = renderProjectCSV(d)
 	case FormatMarkdown:
 		content, err = renderProjectMarkdown(d)
+	case FormatJSON:
+		content, err = renderProjectJSON(d, at)
+	case FormatHTML:
+		content, err = renderProjectHTML(d, at)
 	default:
 		return Result{}, fmt.Errorf("insights: unknown format %d", format)
 	}
@@ -81,6 +89,10 @@ func ExportGlobalFromData(d GlobalData, format Format, at time.Time) (Result, er
 		content, err = renderGlobalCSV(d)
 	case FormatMarkdown:
 		content, err = renderGlobalMarkdown(d)
+	case FormatJSON:
+		content, err = renderGlobalJSON(d, at)
+	case FormatHTML:
+		content, err = renderGlobalHTML(d, at)
 	default:
 		return Result{}, fmt.Errorf("insights: unknown format %d", format)
 	}
@@ -116,7 +128,9 @@ func LoadSingleTaskData(singleTaskID string) (SingleTaskData, error) {
 		return SingleTaskData{}, fmt.Errorf("insights: task #%d not found in project %q", taskNumber, projectID)
 	}
 	m := readMetricsBestEffort(projectPath, t)
-	return singleTaskFromTask(projectID, projectName, t, m), nil
+	d := singleTaskFromTask(projectID, projectName, t, m)
+	d.CommandsRun, d.FilesEdited, d.HasActivity = loadTaskActivity(projectID, taskNumber)
+	return d, nil
 }
 
 func singleTaskFromTask(projectID, projectName string, t *models.Task, m *models.TaskMetrics) SingleTaskData {
@@ -149,6 +163,16 @@ func singleTaskFromTask(projectID, projectName string, t *models.Task, m *models
 	d.HasCode = cf.hasCode
 	if m != nil {
 		d.MergeKind = string(m.MergeKind)
+		d.TokensIn = m.TokensIn
+		d.TokensOut = m.TokensOut
+		d.CostUSD = m.CostUSD
+		if m.CostUSD != nil {
+			d.CostSource = string(m.CostSource)
+			if d.CostSource == "" {
+				// Metrics written before estimation only held reported costs.
+				d.CostSource = string(models.CostSourceReported)
+			}
+		}
 	}
 	return d
 }
@@ -181,14 +205,18 @@ func LoadProjectData(projectID string, windowStart, windowEnd time.Time) (Projec
 	metricsFor := func(t *models.Task) *models.TaskMetrics {
 		return readMetricsBestEffort(projectPath, t)
 	}
-	pd := buildProjectData(projectID, projectName, tasks, windowStart, windowEnd, metricsFor)
+	pd := buildProjectData(projectID, projectName, tasks, windowStart, windowEnd, metricsFor, scanReverts(projectPath).reverted)
+	sessions := loadSessionMetrics(projectID)
+	pd.Overhead = ComputeOverhead(sessions, windowStart, windowEnd, pd.Spend.TotalCostUSD)
+	pd.Users = WithSessionUsers(pd.Users, sessions, windowStart, windowEnd)
 	return pd, nil
 }
 
 // buildProjectData aggregates a task list into a ProjectData. metricsFor
 // resolves a task's `<n>.metrics.yaml` for the v8.0 code-output rollup; it
 // may be nil (no code rollup) or return nil for a task without metrics.
-func buildProjectData(projectID, projectName string, tasks []*models.Task, windowStart, windowEnd time.Time, metricsFor func(t *models.Task) *models.TaskMetrics) ProjectData {
+// reverted feeds the agent comparison's revert count and may be nil.
+func buildProjectData(projectID, projectName string, tasks []*models.Task, windowStart, windowEnd time.Time, metricsFor func(t *models.Task) *models.TaskMetrics, reverted func(t *models.Task, m *models.TaskMetrics) bool) ProjectData {
 	pd := ProjectData{
 		ProjectID:   projectID,
 		ProjectName: projectName,
@@ -196,6 +224,7 @@ func buildProjectData(projectID, projectName string, tasks []*models.Task, windo
 		WindowEnd:   windowEnd,
 	}
 	stats := newWindowStats(windowStart, windowEnd)
+	stats.reverted = reverted
 	for _, t := range tasks {
 		if t == nil || t.HiddenFromInsights() {
 			continue
@@ -208,8 +237,11 @@ func buildProjectData(projectID, projectName string, tasks []*models.Task, windo
 	}
 	pd.KPIs = stats.kpis()
 	pd.Code = stats.codeOutput()
+	pd.Spend = stats.spend()
 	pd.Daily = stats.daily()
 	pd.Agents = stats.agents()
+	pd.Comparison = stats.comparison.rows()
+	pd.Users = stats.users.rows()
 	return pd
 }
 
@@ -241,12 +273,15 @@ func LoadGlobalData(windowStart, windowEnd time.Time) (GlobalData, error) {
 	}
 	stats := newWindowStats(windowStart, windowEnd)
 	var per []projectCount
+	var ids []string
 	for _, entry := range index.Projects {
+		ids = append(ids, entry.ProjectID)
 		tasks, err := config.LoadAllTasks(entry.Path)
 		if err != nil {
 			continue
 		}
 		ps := projectCount{id: entry.ProjectID, name: entry.Name}
+		stats.reverted = scanReverts(entry.Path).reverted
 		for _, t := range tasks {
 			if t == nil || t.HiddenFromInsights() {
 				continue
@@ -276,8 +311,13 @@ func LoadGlobalData(windowStart, windowEnd time.Time) (GlobalData, error) {
 	gd.ProjectCount = len(index.Projects)
 	gd.KPIs = stats.kpis()
 	gd.Code = stats.codeOutput()
+	gd.Spend = stats.spend()
 	gd.Daily = stats.daily()
 	gd.Agents = stats.agents()
+	gd.Comparison = stats.comparison.rows()
+	sessions := loadSessionMetrics(ids...)
+	gd.Overhead = ComputeOverhead(sessions, windowStart, windowEnd, gd.Spend.TotalCostUSD)
+	gd.Users = WithSessionUsers(stats.users.rows(), sessions, windowStart, windowEnd)
 	gd.TopProjects = topProjectsFrom(per)
 	return gd, nil
 }
@@ -27,6 +27,8 @@ func fixtureSingleTask() SingleTaskData {
 	started := time.Date(2026, 4, 28, 9, 30, 0, 0, time.UTC)
 	completed := time.Date(2026, 4, 28, 11, 12, 0, 0, time.UTC)
 	success := true
+	ok, failed := 0, 1
+	tokensIn, tokensOut, cost := int64(184_220), int64(21_904), 0.8812
 	return SingleTaskData{
 		ProjectID:      "watchfire-pid",
 		ProjectName:    "watchfire",
@@ -49,6 +51,17 @@ func fixtureSingleTask() SingleTaskData {
 		Merged:         true,
 		MergeKind:      "silent",
 		HasCode:        true,
+		TokensIn:       &tokensIn,
+		TokensOut:      &tokensOut,
+		CostUSD:        &cost,
+		CostSource:     "reported",
+		CommandsRun: []CommandRun{
+			{Command: "go test ./internal/daemon/insights/", ExitCode: &failed},
+			{Command: "go test ./internal/daemon/insights/", ExitCode: &ok},
+			{Command: "make proto"},
+		},
+		FilesEdited: []string{"internal/daemon/insights/csv.go", "internal/daemon/insights/markdown.go"},
+		HasActivity: true,
 	}
 }
 
@@ -79,6 +92,12 @@ func fixtureProject() ProjectData {
 			TasksViaPR:         1,
 			MetricsMissingCode: 1,
 		},
+		Spend: Spend{
+			TotalCostUSD:       6.4175,
+			EstimatedCostUSD:   1.2175,
+			TasksEstimatedCost: 2,
+			TasksMissingCost:   1,
+		},
 		Daily: []DayBucket{
 			{Date: "2026-04-26", Done: 0, Failed: 1, Created: 2},
 			{Date: "2026-04-28", Done: 2, Failed: 0, Created: 3},
@@ -86,8 +105,17 @@ func fixtureProject() ProjectData {
 			{Date: "2026-05-01", Done: 1, Failed: 0, Created: 2},
 		},
 		Agents: []AgentBreakdown{
-			{Agent: "claude-code", Tasks: 4, Done: 3, Failed: 1, AvgDurationSec: 5400, Commits: 9, LinesAdded: 720, LinesRemoved: 150},
-			{Agent: "codex", Tasks: 2, Done: 1, Failed: 1, AvgDurationSec: 3000, Commits: 3, LinesAdded: 260, LinesRemoved: 60},
+			{Agent: "claude-code", Tasks: 4, Done: 3, Failed: 1, AvgDurationSec: 5400, Commits: 9, LinesAdded: 720, LinesRemoved: 150, TokensIn: 910_000, TokensOut: 120_000, CostUSD: 5.2},
+			{Agent: "codex", Tasks: 2, Done: 1, Failed: 1, AvgDurationSec: 3000, Commits: 3, LinesAdded: 260, LinesRemoved: 60, TokensIn: 414_000, TokensOut: 70_000, CostUSD: 1.2175},
+		},
+		Comparison: []AgentComparisonRow{
+			{Agent: "claude-code", Model: "claude-sonnet-4", Tasks: 3, Succeeded: 3, SuccessRate: 1, MedianDurationMs: 4_800_000, P90DurationMs: 7_200_000, TotalCostUSD: 3.9, CostPerSuccessUSD: 1.3, FollowUps: 1, FollowUpRate: 1.0 / 3, LinesAdded: 600, LinesRemoved: 120},
+			{Agent: "claude-code", Model: "claude-opus-4", Tasks: 1, Succeeded: 0, MedianDurationMs: 9_000_000, P90DurationMs: 9_000_000, TotalCostUSD: 1.3, LinesAdded: 120, LinesRemoved: 30},
+			{Agent: "codex", Tasks: 2, Succeeded: 1, SuccessRate: 0.5, MedianDurationMs: 3_000_000, P90DurationMs: 3_600_000, TotalCostUSD: 1.2175, CostPerSuccessUSD: 1.2175, MergeFailures: 1, MergeFailureRate: 0.5, Reverted: 1, RevertRate: 0.5, LinesAdded: 260, LinesRemoved: 60},
+		}, Users: []UserRow{
+			{User: "jane@example.com", Tasks: 4, Succeeded: 3, SuccessRate: 0.75, TaskCostUSD: 5.2, TasksCreated: 5, Sessions: 1, SessionCostUSD: 0.4, TotalCostUSD: 5.6},
+			{User: "slack:U024BE7LH", Tasks: 2, Succeeded: 1, SuccessRate: 0.5, TaskCostUSD: 1.2175, TasksCreated: 2, TotalCostUSD: 1.2175},
+			{TasksCreated: 1},
 		},
 	}
 }
@@ -117,6 +145,12 @@ func fixtureGlobal() GlobalData {
 			TasksViaPR:         1,
 			MetricsMissingCode: 2,
 		},
+		Spend: Spend{
+			TotalCostUSD:       14.9,
+			EstimatedCostUSD:   2.35,
+			TasksEstimatedCost: 4,
+			TasksMissingCost:   2,
+		},
 		Daily: []DayBucket{
 			{Date: "2026-04-26", Done: 1, Failed: 1, Created: 4},
 			{Date: "2026-04-28", Done: 4, Failed: 0, Created: 5},
@@ -129,9 +163,26 @@ func fixtureGlobal() GlobalData {
 			{ProjectID: "infra-pid", ProjectName: "infra", Done: 2, Failed: 1, Commits: 6, LinesAdded: 400, LinesRemoved: 170, NetLines: 230, Merges: 2},
 		},
 		Agents: []AgentBreakdown{
-			{Agent: "claude-code", Tasks: 8, Done: 6, Failed: 2, AvgDurationSec: 5400, Commits: 18, LinesAdded: 1500, LinesRemoved: 380},
-			{Agent: "codex", Tasks: 3, Done: 2, Failed: 1, AvgDurationSec: 3600, Commits: 7, LinesAdded: 520, LinesRemoved: 140},
-			{Agent: "opencode", Tasks: 1, Done: 1, Failed: 0, AvgDurationSec: 1800, Commits: 2, LinesAdded: 120, LinesRemoved: 40},
+			{Agent: "claude-code", Tasks: 8, Done: 6, Failed: 2, AvgDurationSec: 5400, Commits: 18, LinesAdded: 1500, LinesRemoved: 380, TokensIn: 2_100_000, TokensOut: 310_000, CostUSD: 12.55},
+			{Agent: "codex", Tasks: 3, Done: 2, Failed: 1, AvgDurationSec: 3600, Commits: 7, LinesAdded: 520, LinesRemoved: 140, TokensIn: 640_000, TokensOut: 96_000, CostUSD: 1.76},
+			{Agent: "opencode", Tasks: 1, Done: 1, Failed: 0, AvgDurationSec: 1800, Commits: 2, LinesAdded: 120, LinesRemoved: 40, TokensIn: 210_000, TokensOut: 38_000, CostUSD: 0.59},
+		},
+		Comparison: []AgentComparisonRow{
+			{Agent: "claude-code", Model: "claude-sonnet-4", Tasks: 8, Succeeded: 6, SuccessRate: 0.75, MedianDurationMs: 5_100_000, P90DurationMs: 8_400_000, TotalCostUSD: 12.55, CostPerSuccessUSD: 12.55 / 6, MergeFailures: 1, MergeFailureRate: 0.125, FollowUps: 2, FollowUpRate: 0.25, Reverted: 1, RevertRate: 0.125, LinesAdded: 1500, LinesRemoved: 380},
+			{Agent: "codex", Model: "gpt-5-codex", Tasks: 3, Succeeded: 2, SuccessRate: 2.0 / 3, MedianDurationMs: 3_600_000, P90DurationMs: 4_200_000, TotalCostUSD: 1.76, CostPerSuccessUSD: 0.88, LinesAdded: 520, LinesRemoved: 140},
+			{Agent: "opencode", Tasks: 1, Succeeded: 1, SuccessRate: 1, MedianDurationMs: 1_800_000, P90DurationMs: 1_800_000, TotalCostUSD: 0.59, CostPerSuccessUSD: 0.59, LinesAdded: 120, LinesRemoved: 40},
+		},
+		Overhead: OverheadSummary{
+			Sessions: 5, DurationMs: 5_400_000, TokensIn: 820_000, TokensOut: 61_000, CostUSD: 2.1,
+			SessionsMissingCost: 1, CostShare: 2.1 / 17,
+			ByKind: []OverheadKindRow{
+				{Kind: "chat", Sessions: 3, DurationMs: 2_700_000, TokensIn: 500_000, TokensOut: 40_000, CostUSD: 1.4},
+				{Kind: "wildfire-refine", Sessions: 1, DurationMs: 1_800_000, TokensIn: 320_000, TokensOut: 21_000, CostUSD: 0.7},
+				{Kind: "generate-definition", Sessions: 1, DurationMs: 900_000},
+			},
+		}, Users: []UserRow{
+			{User: "jane@example.com", Tasks: 7, Succeeded: 5, SuccessRate: 5.0 / 7, TaskCostUSD: 11.3, TasksCreated: 12, Sessions: 4, SessionCostUSD: 1.7, TotalCostUSD: 13},
+			{User: "mcp:claude-desktop", Tasks: 5, Succeeded: 4, SuccessRate: 0.8, TaskCostUSD: 3.6, TasksCreated: 6, Sessions: 1, SessionCostUSD: 0.4, TotalCostUSD: 4},
 		},
 	}
 }
@@ -187,6 +238,48 @@ func TestGoldens(t *testing.T) {
 			},
 			file: "global.md",
 		},
+		{
+			name: "single_task_json",
+			run: func() (Result, error) {
+				return ExportSingleTaskFromData(fixtureSingleTask(), FormatJSON, fixedReportTime)
+			},
+			file: "single_task.json",
+		},
+		{
+			name: "single_task_html",
+			run: func() (Result, error) {
+				return ExportSingleTaskFromData(fixtureSingleTask(), FormatHTML, fixedReportTime)
+			},
+			file: "single_task.html",
+		},
+		{
+			name: "project_json",
+			run: func() (Result, error) {
+				return ExportProjectFromData(fixtureProject(), FormatJSON, fixedReportTime)
+			},
+			file: "project.json",
+		},
+		{
+			name: "project_html",
+			run: func() (Result, error) {
+				return ExportProjectFromData(fixtureProject(), FormatHTML, fixedReportTime)
+			},
+			file: "project.html",
+		},
+		{
+			name: "global_json",
+			run: func() (Result, error) {
+				return ExportGlobalFromData(fixtureGlobal(), FormatJSON, fixedReportTime)
+			},
+			file: "global.json",
+		},
+		{
+			name: "global_html",
+			run: func() (Result, error) {
+				return ExportGlobalFromData(fixtureGlobal(), FormatHTML, fixedReportTime)
+			},
+			file: "global.html",
+		},
 	}
 
 	for _, tc := range cases {
@@ -228,6 +321,8 @@ func TestFilenames(t *testing.T) {
 		{"project empty name", ProjectFilename("", FormatCSV, at), "watchfire-project-project-2026-05-02.csv"},
 		{"global md", GlobalFilename(FormatMarkdown, at), "watchfire-global-2026-05-02.md"},
 		{"global csv", GlobalFilename(FormatCSV, at), "watchfire-global-2026-05-02.csv"},
+		{"global json", GlobalFilename(FormatJSON, at), "watchfire-global-2026-05-02.json"},
+		{"project html", ProjectFilename("Watchfire", FormatHTML, at), "watchfire-project-watchfire-2026-05-02.html"},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
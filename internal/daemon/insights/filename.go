@@ -33,20 +33,32 @@ func GlobalFilename(format Format, at time.Time) string {
 }
 
 func formatExt(f Format) string {
-	if f == FormatCSV {
+	switch f {
+	case FormatCSV:
 		return "csv"
+	case FormatJSON:
+		return "json"
+	case FormatHTML:
+		return "html"
+	default:
+		return "md"
 	}
-	return "md"
 }
 
 // MimeType maps a Format to the IANA media type the GUI uses when triggering
 // a Blob URL download. text/markdown is registered (RFC 7763) so most OSes
 // will pick a sensible viewer.
 func MimeType(f Format) string {
-	if f == FormatCSV {
+	switch f {
+	case FormatCSV:
 		return "text/csv"
+	case FormatJSON:
+		return "application/json"
+	case FormatHTML:
+		return "text/html"
+	default:
+		return "text/markdown"
 	}
-	return "text/markdown"
 }
 
 // slugify lower-cases, replaces runs of non-alphanumeric runes with single
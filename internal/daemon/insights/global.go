@@ -36,6 +36,9 @@ type GlobalInsights struct {
 	TotalDurationMs  int64   `json:"total_duration_ms"`
 	TotalCostUSD     float64 `json:"total_cost_usd"`
 	TasksMissingCost int     `json:"tasks_missing_cost"`
+	// Mirrors ProjectInsights: the estimated share of TotalCostUSD.
+	EstimatedCostUSD   float64 `json:"estimated_cost_usd"`
+	TasksEstimatedCost int     `json:"tasks_estimated_cost"`
 
 	// v8.0 Inferno — fleet-wide shipped-code rollup (task 0115). Mirrors
 	// ProjectInsights: tasks lacking code metrics contribute zeros and
@@ -49,6 +52,16 @@ type GlobalInsights struct {
 	TasksMerged        int `json:"tasks_merged"`
 	TasksViaPR         int `json:"tasks_via_pr"`
 	MetricsMissingCode int `json:"metrics_missing_code"`
+
+	AgentComparison []AgentComparisonRow `json:"agent_comparison"`
+	Overhead        OverheadSummary      `json:"overhead"`
+
+	// Trend is the TrendWeeks weeks ending with the window's last week,
+	// whatever the window's start.
+	Trend []TrendWeek `json:"trend"`
+
+	// Users splits the window's work and spend per person.
+	Users []UserRow `json:"users"`
 }
 
 // GlobalDayBucket — one calendar-day worth of completed-task counts.
@@ -115,10 +128,9 @@ const MaxTopProjects = 5
 // LoadGlobalInsights computes the fleet-wide rollup. Reads the cache first
 // and falls back to a fresh fan-out across every registered project.
 //
-// Cost data is currently absent — task 0056 (per-task metrics capture) is
-// the source of truth for token / cost numbers, and lands in a separate
-// PR. Until then `TotalCostUSD` is 0 and every completed task counts as
-// `tasks_missing_cost`, which is what the GUI partial-data caveat reads.
+// Token and cost totals come from each task's `<n>.metrics.yaml`; tasks
+// without a cost count as `tasks_missing_cost`, which is what the GUI
+// partial-data caveat reads.
 func LoadGlobalInsights(windowStart, windowEnd time.Time) (*GlobalInsights, error) {
 	if cached, ok := readGlobalCache(windowStart, windowEnd); ok {
 		return cached, nil
@@ -139,12 +151,16 @@ func LoadGlobalInsights(windowStart, windowEnd time.Time) (*GlobalInsights, erro
 // code-output rollup; it may be nil (no rollup) or return nil for a task
 // without a metrics file. Either way the task is counted in
 // MetricsMissingCode and contributes zero code output.
+//
+// revertedFor returns a project's revert check for the agent comparison
+// (see ComputeProjectInsightsForTasks); it and its result may be nil.
 func ComputeGlobalInsightsForTasks(
 	windowStart, windowEnd time.Time,
 	projects []models.ProjectEntry,
 	tasksFor func(p models.ProjectEntry) []*models.Task,
 	colorFor func(p models.ProjectEntry) string,
 	metricsFor func(p models.ProjectEntry, t *models.Task) *models.TaskMetrics,
+	revertedFor func(p models.ProjectEntry) func(t *models.Task, m *models.TaskMetrics) bool,
 ) *GlobalInsights {
 	g := &GlobalInsights{WindowStart: windowStart, WindowEnd: windowEnd}
 
@@ -159,16 +175,25 @@ func ComputeGlobalInsightsForTasks(
 	commitsByAgent := map[string]int{}
 	linesAddedByAgent := map[string]int{}
 	linesRemovedByAgent := map[string]int{}
+	costByAgent := newCostTally()
+	comparison := newComparisonTally()
+	trend := newTrendTally(windowEnd)
+	users := newUserTally()
 
 	var perProject []rollupProjTally
 
 	for _, entry := range projects {
 		tally := rollupProjTally{entry: entry, color: colorFor(entry)}
 		tasks := tasksFor(entry)
+		var reverted func(t *models.Task, m *models.TaskMetrics) bool
+		if revertedFor != nil && len(tasks) > 0 {
+			reverted = revertedFor(entry)
+		}
 		for _, t := range tasks {
 			if t == nil || t.HiddenFromInsights() {
 				continue
 			}
+			users.addCreated(t, windowStart, windowEnd)
 			if t.Status != models.TaskStatusDone {
 				continue
 			}
@@ -180,6 +205,9 @@ func ComputeGlobalInsightsForTasks(
 				m = metricsFor(entry, t)
 			}
 			completedAt := EffectiveCompletedAt(t, m)
+			if completedAt != nil {
+				trend.add(t, m, *completedAt)
+			}
 			if completedAt == nil || !inWindow(*completedAt, windowStart, windowEnd) {
 				continue
 			}
@@ -187,7 +215,6 @@ func ComputeGlobalInsightsForTasks(
 			agent := agentKey(t.Agent)
 			tally.count++
 			g.TasksTotal++
-			g.TasksMissingCost++ // task 0056 not yet wired — cost is always missing
 			if t.Success != nil && *t.Success {
 				g.TasksSucceeded++
 				dayDone[key]++
@@ -231,6 +258,20 @@ func ComputeGlobalInsightsForTasks(
 			tally.commits += cf.commits
 			tally.linesAdded += cf.linesAdded
 			tally.linesRemoved += cf.linesRemoved
+
+			cost := costFieldsFrom(m)
+			switch {
+			case !cost.hasCost:
+				g.TasksMissingCost++
+			case cost.estimated:
+				g.TasksEstimatedCost++
+				g.EstimatedCostUSD += cost.costUSD
+			}
+			g.TotalCostUSD += cost.costUSD
+			costByAgent.add(agent, cost)
+
+			comparison.add(t, m, *completedAt, reverted != nil && reverted(t, m))
+			users.addTask(t, m, *completedAt)
 		}
 		if tally.count > 0 {
 			perProject = append(perProject, tally)
@@ -241,9 +282,12 @@ func ComputeGlobalInsightsForTasks(
 	g.TasksByDay = mergeDayBuckets(dayDone, dayFailed, dayLinesAdded, dayLinesRemoved)
 	g.AgentBreakdown = buildAgentRows(
 		doneByAgent, failedByAgent, durationsByAgent,
-		commitsByAgent, linesAddedByAgent, linesRemovedByAgent,
+		commitsByAgent, linesAddedByAgent, linesRemovedByAgent, costByAgent,
 	)
 	g.TopProjects = pickTopProjects(perProject)
+	g.AgentComparison = comparison.rows()
+	g.Trend = trend.series()
+	g.Users = users.rows()
 
 	return g
 }
@@ -282,7 +326,19 @@ func computeGlobalInsights(windowStart, windowEnd time.Time) (*GlobalInsights, e
 		return m
 	}
 
-	return ComputeGlobalInsightsForTasks(windowStart, windowEnd, index.Projects, tasksFor, colorFor, metricsFor), nil
+	revertedFor := func(p models.ProjectEntry) func(t *models.Task, m *models.TaskMetrics) bool {
+		return scanReverts(p.Path).reverted
+	}
+
+	g := ComputeGlobalInsightsForTasks(windowStart, windowEnd, index.Projects, tasksFor, colorFor, metricsFor, revertedFor)
+	ids := make([]string, 0, len(index.Projects))
+	for _, p := range index.Projects {
+		ids = append(ids, p.ProjectID)
+	}
+	sessions := loadSessionMetrics(ids...)
+	g.Overhead = ComputeOverhead(sessions, windowStart, windowEnd, g.TotalCostUSD)
+	g.Users = WithSessionUsers(g.Users, sessions, windowStart, windowEnd)
+	return g, nil
 }
 
 func mergeDayBuckets(dayDone, dayFailed, dayLinesAdded, dayLinesRemoved map[string]int) []GlobalDayBucket {
@@ -307,6 +363,7 @@ func buildAgentRows(
 	done, failed map[string]int,
 	durations map[string][]int64,
 	commits, linesAdded, linesRemoved map[string]int,
+	cost costTally,
 ) []GlobalAgentRow {
 	all := unionKeys(done, failed)
 	out := make([]GlobalAgentRow, 0, len(all))
@@ -320,6 +377,9 @@ func buildAgentRows(
 		row.Commits = commits[k]
 		row.LinesAdded = linesAdded[k]
 		row.LinesRemoved = linesRemoved[k]
+		row.TotalTokensIn = cost.tokensIn[k]
+		row.TotalTokensOut = cost.tokensOut[k]
+		row.TotalCostUSD = cost.cost[k]
 		out = append(out, row)
 	}
 	sort.Slice(out, func(i, j int) bool {
@@ -70,6 +70,7 @@ func TestComputeGlobalInsights_FleetTotalsAndTopProjects(t *testing.T) {
 		func(p models.ProjectEntry) []*models.Task { return tasksByProject[p.ProjectID] },
 		func(p models.ProjectEntry) string { return colors[p.ProjectID] },
 		nil,
+		nil,
 	)
 
 	if g.TasksTotal != 4 {
@@ -81,7 +82,7 @@ func TestComputeGlobalInsights_FleetTotalsAndTopProjects(t *testing.T) {
 	if g.TasksFailed != 1 {
 		t.Errorf("TasksFailed = %d, want 1", g.TasksFailed)
 	}
-	if g.TasksMissingCost != 4 { // task 0056 not yet wired
+	if g.TasksMissingCost != 4 { // no metrics — no task has a cost
 		t.Errorf("TasksMissingCost = %d, want 4", g.TasksMissingCost)
 	}
 	if g.TotalDurationMs <= 0 {
@@ -160,6 +161,7 @@ func TestComputeGlobalInsights_CodeOutputRollup(t *testing.T) {
 		func(p models.ProjectEntry, t *models.Task) *models.TaskMetrics {
 			return metricsByProject[p.ProjectID][t.TaskNumber]
 		},
+		nil,
 	)
 
 	if g.TotalCommits != 3 {
@@ -229,6 +231,7 @@ func TestComputeGlobalInsights_RespectsWindow(t *testing.T) {
 		func(p models.ProjectEntry) []*models.Task { return tasksByProject[p.ProjectID] },
 		func(_ models.ProjectEntry) string { return "" },
 		nil,
+		nil,
 	)
 	if g.TasksTotal != 1 {
 		t.Errorf("TasksTotal = %d, want 1 (other task is outside window)", g.TasksTotal)
@@ -263,6 +266,7 @@ func TestComputeGlobalInsights_TopProjectsUncappedButOrdered(t *testing.T) {
 		func(p models.ProjectEntry) []*models.Task { return tasksByProject[p.ProjectID] },
 		func(_ models.ProjectEntry) string { return "" },
 		nil,
+		nil,
 	)
 	if got, want := len(g.TopProjects), 8; got != want {
 		t.Errorf("TopProjects len = %d, want %d (every active project)", got, want)
@@ -0,0 +1,278 @@
+package insights
+
+import (
+	"path"
+	"sort"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/diff"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// DefaultHotspotLimit / MaxHotspotLimit bound how many rows each hotspot
+// list carries.
+const (
+	DefaultHotspotLimit = 10
+	MaxHotspotLimit     = 100
+)
+
+// Hotspots aggregates the per-task diffs of a project's tasks completed in
+// the window: which paths the agents touch most, which turn up in failed
+// tasks or failed (conflicted) merges, and each file's churn per week.
+// Weeks are the TrendWeeks week starts the files' WeeklyChurn lines up
+// with. TasksWithoutDiff counts tasks whose diff couldn't be resolved
+// (branch and merge commit both gone, or git failed); they're left out.
+type Hotspots struct {
+	ProjectID   string    `json:"project_id"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+
+	TasksScanned     int `json:"tasks_scanned"`
+	TasksWithoutDiff int `json:"tasks_without_diff"`
+
+	// Files are the most-touched files: most tasks first, then most churn.
+	Files []HotspotFile `json:"files"`
+	// FailureFiles are the files touched by failed tasks or failed
+	// merges, most such tasks first.
+	FailureFiles []HotspotFile      `json:"failure_files"`
+	Directories  []HotspotDirectory `json:"directories"`
+	Weeks        []string           `json:"weeks"`
+}
+
+// HotspotFile is one path's tally. A renamed file is counted under its
+// new path.
+type HotspotFile struct {
+	Path          string    `json:"path"`
+	Tasks         int       `json:"tasks"`
+	FailedTasks   int       `json:"failed_tasks"`
+	MergeFailures int       `json:"merge_failures"`
+	LinesAdded    int       `json:"lines_added"`
+	LinesRemoved  int       `json:"lines_removed"`
+	LastTouched   time.Time `json:"last_touched"`
+	WeeklyChurn   []int     `json:"weekly_churn"` // lines added + removed, per Weeks entry
+}
+
+// HotspotDirectory rolls up every file under a directory, at each depth
+// ("internal", "internal/daemon", …). Tasks counts distinct tasks.
+type HotspotDirectory struct {
+	Path          string `json:"path"`
+	Tasks         int    `json:"tasks"`
+	Files         int    `json:"files"`
+	FailedTasks   int    `json:"failed_tasks"`
+	MergeFailures int    `json:"merge_failures"`
+	LinesAdded    int    `json:"lines_added"`
+	LinesRemoved  int    `json:"lines_removed"`
+}
+
+// LoadHotspots computes the hotspot report for one project. Diffs come
+// from diff.TaskDiff, whose per-task cache makes repeat calls cheap.
+func LoadHotspots(projectID string, windowStart, windowEnd time.Time, limit int) (*Hotspots, error) {
+	index, err := config.LoadProjectsIndex()
+	if err != nil {
+		return nil, err
+	}
+	var entry *models.ProjectEntry
+	if index != nil {
+		entry = index.FindProject(projectID)
+	}
+	if entry == nil {
+		return ComputeHotspotsForTasks(projectID, windowStart, windowEnd, nil, nil, nil, limit), nil
+	}
+	tasks, err := config.LoadAllTasks(entry.Path)
+	if err != nil {
+		return nil, err
+	}
+	metricsFor := func(t *models.Task) *models.TaskMetrics {
+		m, merr := config.ReadMetrics(entry.Path, t.TaskNumber)
+		if merr != nil {
+			return nil
+		}
+		return m
+	}
+	diffFor := func(t *models.Task) *diff.FileDiffSet {
+		set, derr := diff.TaskDiff(entry.Path, projectID, t.TaskNumber)
+		if derr != nil {
+			return nil
+		}
+		return set
+	}
+	return ComputeHotspotsForTasks(projectID, windowStart, windowEnd, tasks, metricsFor, diffFor, limit), nil
+}
+
+type hotspotDirAcc struct {
+	row   HotspotDirectory
+	tasks map[int]bool
+	files map[string]bool
+}
+
+// ComputeHotspotsForTasks is the testable seam behind LoadHotspots.
+// metricsFor may be nil; diffFor returning nil (or an empty set) counts
+// the task as without a diff.
+func ComputeHotspotsForTasks(
+	projectID string,
+	windowStart, windowEnd time.Time,
+	tasks []*models.Task,
+	metricsFor func(t *models.Task) *models.TaskMetrics,
+	diffFor func(t *models.Task) *diff.FileDiffSet,
+	limit int,
+) *Hotspots {
+	if limit <= 0 {
+		limit = DefaultHotspotLimit
+	}
+	if limit > MaxHotspotLimit {
+		limit = MaxHotspotLimit
+	}
+	h := &Hotspots{ProjectID: projectID, WindowStart: windowStart, WindowEnd: windowEnd}
+	weeks := newTrendTally(windowEnd)
+	for _, w := range weeks.weeks {
+		h.Weeks = append(h.Weeks, w.WeekStart)
+	}
+
+	files := map[string]*HotspotFile{}
+	dirs := map[string]*hotspotDirAcc{}
+
+	for _, t := range tasks {
+		if t == nil || t.HiddenFromInsights() || t.Status != models.TaskStatusDone {
+			continue
+		}
+		var m *models.TaskMetrics
+		if metricsFor != nil {
+			m = metricsFor(t)
+		}
+		completedAt := EffectiveCompletedAt(t, m)
+		if completedAt == nil || !inWindow(*completedAt, windowStart, windowEnd) {
+			continue
+		}
+		h.TasksScanned++
+		var set *diff.FileDiffSet
+		if diffFor != nil {
+			set = diffFor(t)
+		}
+		if set == nil || len(set.Files) == 0 {
+			h.TasksWithoutDiff++
+			continue
+		}
+		failed := t.Success == nil || !*t.Success
+		mergeFailed := t.MergeFailureReason != ""
+		week := weeks.index(*completedAt)
+
+		for _, fd := range set.Files {
+			added, removed := fileDiffLines(fd)
+			f := files[fd.Path]
+			if f == nil {
+				f = &HotspotFile{Path: fd.Path, WeeklyChurn: make([]int, len(h.Weeks))}
+				files[fd.Path] = f
+			}
+			f.Tasks++
+			f.LinesAdded += added
+			f.LinesRemoved += removed
+			if failed {
+				f.FailedTasks++
+			}
+			if mergeFailed {
+				f.MergeFailures++
+			}
+			if completedAt.After(f.LastTouched) {
+				f.LastTouched = *completedAt
+			}
+			if week >= 0 {
+				f.WeeklyChurn[week] += added + removed
+			}
+
+			for dir := path.Dir(fd.Path); dir != "." && dir != "/"; dir = path.Dir(dir) {
+				d := dirs[dir]
+				if d == nil {
+					d = &hotspotDirAcc{row: HotspotDirectory{Path: dir}, tasks: map[int]bool{}, files: map[string]bool{}}
+					dirs[dir] = d
+				}
+				d.files[fd.Path] = true
+				d.row.LinesAdded += added
+				d.row.LinesRemoved += removed
+				if !d.tasks[t.TaskNumber] {
+					d.tasks[t.TaskNumber] = true
+					if failed {
+						d.row.FailedTasks++
+					}
+					if mergeFailed {
+						d.row.MergeFailures++
+					}
+				}
+			}
+		}
+	}
+
+	all := make([]HotspotFile, 0, len(files))
+	for _, f := range files {
+		all = append(all, *f)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Tasks != all[j].Tasks {
+			return all[i].Tasks > all[j].Tasks
+		}
+		ci, cj := all[i].LinesAdded+all[i].LinesRemoved, all[j].LinesAdded+all[j].LinesRemoved
+		if ci != cj {
+			return ci > cj
+		}
+		return all[i].Path < all[j].Path
+	})
+	h.Files = truncateHotspots(all, limit)
+
+	var failures []HotspotFile
+	for _, f := range all {
+		if f.FailedTasks+f.MergeFailures > 0 {
+			failures = append(failures, f)
+		}
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].FailedTasks+failures[i].MergeFailures > failures[j].FailedTasks+failures[j].MergeFailures
+	})
+	h.FailureFiles = truncateHotspots(failures, limit)
+
+	h.Directories = make([]HotspotDirectory, 0, len(dirs))
+	for _, d := range dirs {
+		row := d.row
+		row.Tasks = len(d.tasks)
+		row.Files = len(d.files)
+		h.Directories = append(h.Directories, row)
+	}
+	sort.Slice(h.Directories, func(i, j int) bool {
+		a, b := h.Directories[i], h.Directories[j]
+		if a.Tasks != b.Tasks {
+			return a.Tasks > b.Tasks
+		}
+		if ca, cb := a.LinesAdded+a.LinesRemoved, b.LinesAdded+b.LinesRemoved; ca != cb {
+			return ca > cb
+		}
+		return a.Path < b.Path
+	})
+	if len(h.Directories) > limit {
+		h.Directories = h.Directories[:limit]
+	}
+	return h
+}
+
+func truncateHotspots(rows []HotspotFile, limit int) []HotspotFile {
+	if rows == nil {
+		return []HotspotFile{}
+	}
+	if len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
+
+// fileDiffLines counts a file's added and removed lines from its hunks.
+func fileDiffLines(fd diff.FileDiff) (added, removed int) {
+	for _, h := range fd.Hunks {
+		for _, l := range h.Lines {
+			switch l.Kind {
+			case diff.LineAdd:
+				added++
+			case diff.LineDel:
+				removed++
+			}
+		}
+	}
+	return added, removed
+}
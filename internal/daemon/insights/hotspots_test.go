@@ -0,0 +1,131 @@
+package insights
+
+import (
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/diff"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// fileDiff builds a one-hunk FileDiff with the given added / removed lines.
+func fileDiff(p string, added, removed int) diff.FileDiff {
+	var lines []diff.DiffLine
+	for i := 0; i < added; i++ {
+		lines = append(lines, diff.DiffLine{Kind: diff.LineAdd})
+	}
+	for i := 0; i < removed; i++ {
+		lines = append(lines, diff.DiffLine{Kind: diff.LineDel})
+	}
+	lines = append(lines, diff.DiffLine{Kind: diff.LineContext})
+	return diff.FileDiff{Path: p, Status: diff.StatusModified, Hunks: []diff.Hunk{{Lines: lines}}}
+}
+
+func TestComputeHotspotsForTasks(t *testing.T) {
+	t.Parallel()
+	day := func(m time.Month, d int) time.Time { return time.Date(2026, m, d, 12, 0, 0, 0, time.UTC) }
+	start, end := day(time.May, 1), day(time.May, 31)
+
+	merged := makeTask(3, "claude-code", true, day(time.May, 21), day(time.May, 21))
+	merged.MergeFailureReason = "conflict in internal/sub/b.go"
+	tasks := []*models.Task{
+		makeTask(1, "claude-code", true, day(time.May, 5), day(time.May, 5)),
+		makeTask(2, "claude-code", false, day(time.May, 20), day(time.May, 20)),
+		merged,
+		makeTask(4, "claude-code", true, day(time.May, 22), day(time.May, 22)),   // diff unavailable
+		makeTask(5, "claude-code", true, day(time.April, 1), day(time.April, 1)), // outside the window
+	}
+	diffs := map[int]*diff.FileDiffSet{
+		1: {Files: []diff.FileDiff{fileDiff("internal/a.go", 3, 1), fileDiff("README.md", 1, 0)}},
+		2: {Files: []diff.FileDiff{fileDiff("internal/a.go", 2, 0), fileDiff("internal/sub/b.go", 1, 1)}},
+		3: {Files: []diff.FileDiff{fileDiff("internal/sub/b.go", 1, 0)}},
+		5: {Files: []diff.FileDiff{fileDiff("old.go", 50, 0)}},
+	}
+	diffFor := func(t *models.Task) *diff.FileDiffSet { return diffs[t.TaskNumber] }
+
+	h := ComputeHotspotsForTasks("proj", start, end, tasks, nil, diffFor, 0)
+
+	if h.TasksScanned != 4 || h.TasksWithoutDiff != 1 {
+		t.Fatalf("scanned/without diff = %d/%d, want 4/1", h.TasksScanned, h.TasksWithoutDiff)
+	}
+	if len(h.Weeks) != TrendWeeks {
+		t.Fatalf("weeks = %d, want %d", len(h.Weeks), TrendWeeks)
+	}
+
+	var paths []string
+	for _, f := range h.Files {
+		paths = append(paths, f.Path)
+	}
+	if want := []string{"internal/a.go", "internal/sub/b.go", "README.md"}; !equalStrings(paths, want) {
+		t.Fatalf("files = %v, want %v", paths, want)
+	}
+	a := h.Files[0]
+	if a.Tasks != 2 || a.FailedTasks != 1 || a.MergeFailures != 0 || a.LinesAdded != 5 || a.LinesRemoved != 1 {
+		t.Errorf("internal/a.go = %+v", a)
+	}
+	if !a.LastTouched.Equal(day(time.May, 20)) {
+		t.Errorf("internal/a.go last touched %v, want May 20", a.LastTouched)
+	}
+	weekOf := func(at time.Time) int {
+		for i, w := range h.Weeks {
+			if w == weekStart(at).Format("2006-01-02") {
+				return i
+			}
+		}
+		t.Fatalf("no week for %v in %v", at, h.Weeks)
+		return -1
+	}
+	if got := a.WeeklyChurn[weekOf(day(time.May, 5))]; got != 4 {
+		t.Errorf("internal/a.go churn week of May 5 = %d, want 4", got)
+	}
+	if got := a.WeeklyChurn[weekOf(day(time.May, 20))]; got != 2 {
+		t.Errorf("internal/a.go churn week of May 20 = %d, want 2", got)
+	}
+
+	if len(h.FailureFiles) != 2 || h.FailureFiles[0].Path != "internal/sub/b.go" || h.FailureFiles[1].Path != "internal/a.go" {
+		t.Fatalf("failure files = %+v", h.FailureFiles)
+	}
+	if b := h.FailureFiles[0]; b.FailedTasks != 1 || b.MergeFailures != 1 {
+		t.Errorf("internal/sub/b.go = %+v", b)
+	}
+
+	if len(h.Directories) != 2 {
+		t.Fatalf("directories = %+v, want internal and internal/sub", h.Directories)
+	}
+	d := h.Directories[0]
+	if d.Path != "internal" || d.Tasks != 3 || d.Files != 2 || d.FailedTasks != 1 || d.MergeFailures != 1 ||
+		d.LinesAdded != 7 || d.LinesRemoved != 2 {
+		t.Errorf("internal = %+v", d)
+	}
+	if sub := h.Directories[1]; sub.Path != "internal/sub" || sub.Tasks != 2 || sub.Files != 1 {
+		t.Errorf("internal/sub = %+v", sub)
+	}
+
+	limited := ComputeHotspotsForTasks("proj", start, end, tasks, nil, diffFor, 1)
+	if len(limited.Files) != 1 || len(limited.FailureFiles) != 1 || len(limited.Directories) != 1 {
+		t.Errorf("limit 1: files %d, failure files %d, dirs %d", len(limited.Files), len(limited.FailureFiles), len(limited.Directories))
+	}
+}
+
+func TestComputeHotspotsForTasksEmpty(t *testing.T) {
+	t.Parallel()
+	h := ComputeHotspotsForTasks("proj", time.Time{}, time.Time{}, nil, nil, nil, 0)
+	if h.Files == nil || h.FailureFiles == nil || h.Directories == nil {
+		t.Errorf("empty report must carry empty, non-nil lists: %+v", h)
+	}
+	if h.TasksScanned != 0 || len(h.Weeks) != TrendWeeks {
+		t.Errorf("empty report = %+v", h)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
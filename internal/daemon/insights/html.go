@@ -0,0 +1,195 @@
+package insights
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed templates/*.html.tmpl
+var htmlTemplatesFS embed.FS
+
+// The HTML report is a single self-contained file: inline CSS, inline SVG
+// charts, no scripts or external assets, so it can be mailed or attached
+// to a ticket as-is. html/template escapes every data field; the charts
+// are built here and escape their own labels.
+var (
+	htmlTemplateOnce  sync.Once
+	htmlTemplateStore *htmltemplate.Template
+	htmlTemplateErr   error
+)
+
+func loadHTMLTemplates() (*htmltemplate.Template, error) {
+	htmlTemplateOnce.Do(func() {
+		root := htmltemplate.New("insights-html").
+			Funcs(htmltemplate.FuncMap(templateFuncs())).
+			Funcs(htmltemplate.FuncMap{
+				"dailyChart":  dailyChartSVG,
+				"agentChart":  agentChartSVG,
+				"generatedAt": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 UTC") },
+			})
+		htmlTemplateStore, htmlTemplateErr = root.ParseFS(htmlTemplatesFS, "templates/*.html.tmpl")
+	})
+	return htmlTemplateStore, htmlTemplateErr
+}
+
+// The page types add the generation stamp to each scope's data; embedding
+// keeps the templates' field paths identical to the Markdown ones.
+type (
+	htmlSingleTask struct {
+		SingleTaskData
+		GeneratedAt time.Time
+	}
+	htmlProject struct {
+		ProjectData
+		GeneratedAt time.Time
+	}
+	htmlGlobal struct {
+		GlobalData
+		GeneratedAt time.Time
+	}
+)
+
+func renderSingleTaskHTML(d SingleTaskData, at time.Time) ([]byte, error) {
+	return executeHTMLTemplate("single_task.html.tmpl", htmlSingleTask{d, at})
+}
+
+func renderProjectHTML(d ProjectData, at time.Time) ([]byte, error) {
+	return executeHTMLTemplate("project.html.tmpl", htmlProject{d, at})
+}
+
+func renderGlobalHTML(d GlobalData, at time.Time) ([]byte, error) {
+	return executeHTMLTemplate("global.html.tmpl", htmlGlobal{d, at})
+}
+
+func executeHTMLTemplate(name string, data interface{}) ([]byte, error) {
+	tpl, err := loadHTMLTemplates()
+	if err != nil {
+		return nil, err
+	}
+	t := tpl.Lookup(name)
+	if t == nil {
+		return nil, fmt.Errorf("insights: template %s not found", name)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	out := buf.Bytes()
+	if len(out) == 0 || out[len(out)-1] != '\n' {
+		out = append(out, '\n')
+	}
+	return out, nil
+}
+
+// Chart palette, shared with the stylesheet in report.html.tmpl.
+const (
+	chartDone   = "#16a34a"
+	chartFailed = "#dc2626"
+	chartAxis   = "#9ca3af"
+	chartText   = "#4b5563"
+)
+
+// dailyChartSVG draws the day buckets as stacked done / failed bars, with
+// the peak on the y axis and the first and last dates under the x axis.
+// Each bar carries a <title> tooltip with the day's numbers.
+func dailyChartSVG(days []DayBucket) htmltemplate.HTML {
+	if len(days) == 0 {
+		return ""
+	}
+	const (
+		width, height = 720, 180
+		left, right   = 36, 8
+		top, bottom   = 8, 24
+	)
+	plotW := float64(width - left - right)
+	plotH := float64(height - top - bottom)
+	peak := 1
+	for _, d := range days {
+		if n := d.Done + d.Failed; n > peak {
+			peak = n
+		}
+	}
+	slot := plotW / float64(len(days))
+	barW := slot * 0.7
+	if barW > 40 {
+		barW = 40
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg class="chart" role="img" aria-label="Tasks per day" viewBox="0 0 %d %d" width="%d" height="%d" xmlns="http://www.w3.org/2000/svg">`, width, height, width, height)
+	baseY := float64(top) + plotH
+	fmt.Fprintf(&b, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f" stroke="%s"/>`, left, baseY, width-right, baseY, chartAxis)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="11" fill="%s" text-anchor="end">%d</text>`, left-6, top+10, chartText, peak)
+	fmt.Fprintf(&b, `<text x="%d" y="%.1f" font-size="11" fill="%s" text-anchor="end">0</text>`, left-6, baseY, chartText)
+	for i, d := range days {
+		x := float64(left) + float64(i)*slot + (slot-barW)/2
+		doneH := plotH * float64(d.Done) / float64(peak)
+		failedH := plotH * float64(d.Failed) / float64(peak)
+		fmt.Fprintf(&b, `<g><title>%s: %d done, %d failed, %d created</title>`, htmltemplate.HTMLEscapeString(d.Date), d.Done, d.Failed, d.Created)
+		if doneH > 0 {
+			fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`, x, baseY-doneH, barW, doneH, chartDone)
+		}
+		if failedH > 0 {
+			fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`, x, baseY-doneH-failedH, barW, failedH, chartFailed)
+		}
+		b.WriteString(`</g>`)
+	}
+	labelY := height - 6
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="11" fill="%s">%s</text>`, left, labelY, chartText, htmltemplate.HTMLEscapeString(days[0].Date))
+	if len(days) > 1 {
+		fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="11" fill="%s" text-anchor="end">%s</text>`, width-right, labelY, chartText, htmltemplate.HTMLEscapeString(days[len(days)-1].Date))
+	}
+	b.WriteString(`</svg>`)
+	return htmltemplate.HTML(b.String()) //nolint:gosec // every interpolated label is escaped above
+}
+
+// agentChartSVG draws one horizontal done / failed bar per agent, scaled
+// to the busiest agent, with the task count after the bar.
+func agentChartSVG(agents []AgentBreakdown) htmltemplate.HTML {
+	if len(agents) == 0 {
+		return ""
+	}
+	const (
+		width      = 720
+		labelW     = 140
+		countW     = 48
+		rowH       = 26
+		barH       = 16
+		topPadding = 4
+	)
+	height := topPadding*2 + rowH*len(agents)
+	plotW := float64(width - labelW - countW)
+	peak := 1
+	for _, a := range agents {
+		if a.Tasks > peak {
+			peak = a.Tasks
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg class="chart" role="img" aria-label="Tasks per agent" viewBox="0 0 %d %d" width="%d" height="%d" xmlns="http://www.w3.org/2000/svg">`, width, height, width, height)
+	for i, a := range agents {
+		y := float64(topPadding + i*rowH)
+		barY := y + float64(rowH-barH)/2
+		doneW := plotW * float64(a.Done) / float64(peak)
+		failedW := plotW * float64(a.Failed) / float64(peak)
+		label := htmltemplate.HTMLEscapeString(a.Agent)
+		fmt.Fprintf(&b, `<g><title>%s: %d tasks, %d done, %d failed</title>`, label, a.Tasks, a.Done, a.Failed)
+		fmt.Fprintf(&b, `<text x="%d" y="%.1f" font-size="12" fill="%s" text-anchor="end">%s</text>`, labelW-8, barY+barH-4, chartText, label)
+		if doneW > 0 {
+			fmt.Fprintf(&b, `<rect x="%d" y="%.1f" width="%.1f" height="%d" fill="%s"/>`, labelW, barY, doneW, barH, chartDone)
+		}
+		if failedW > 0 {
+			fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%d" fill="%s"/>`, float64(labelW)+doneW, barY, failedW, barH, chartFailed)
+		}
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="12" fill="%s">%d</text>`, float64(labelW)+doneW+failedW+6, barY+barH-4, chartText, a.Tasks)
+		b.WriteString(`</g>`)
+	}
+	b.WriteString(`</svg>`)
+	return htmltemplate.HTML(b.String()) //nolint:gosec // every interpolated label is escaped above
+}
@@ -0,0 +1,87 @@
+package insights
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JSONSchema names the JSON export format, and JSONSchemaVersion versions
+// it. The payload is the data layer's shapes under their JSON tags, so a
+// change to one of those tags is a schema change: adding a field is
+// backward compatible and keeps the version; renaming, removing or
+// changing the meaning of one bumps it, so BI ingestion can branch on
+// `schema_version` instead of guessing.
+const (
+	JSONSchema        = "watchfire.insights.report"
+	JSONSchemaVersion = 1
+)
+
+// jsonReport is the JSON export's envelope. Exactly one of Task, Project
+// and Global is set, matching Scope.
+type jsonReport struct {
+	Schema        string          `json:"schema"`
+	SchemaVersion int             `json:"schema_version"`
+	Scope         string          `json:"scope"` // "single_task" | "project" | "global"
+	GeneratedAt   time.Time       `json:"generated_at"`
+	Task          *SingleTaskData `json:"task,omitempty"`
+	Project       *ProjectData    `json:"project,omitempty"`
+	Global        *GlobalData     `json:"global,omitempty"`
+}
+
+func renderSingleTaskJSON(d SingleTaskData, at time.Time) ([]byte, error) {
+	if d.CommandsRun == nil {
+		d.CommandsRun = []CommandRun{}
+	}
+	if d.FilesEdited == nil {
+		d.FilesEdited = []string{}
+	}
+	return marshalReport(jsonReport{Scope: "single_task", GeneratedAt: at, Task: &d})
+}
+
+func renderProjectJSON(d ProjectData, at time.Time) ([]byte, error) {
+	d.Daily, d.Agents, d.Comparison, d.Overhead = emptyLists(d.Daily, d.Agents, d.Comparison, d.Overhead)
+	if d.Users == nil {
+		d.Users = []UserRow{}
+	}
+	return marshalReport(jsonReport{Scope: "project", GeneratedAt: at, Project: &d})
+}
+
+func renderGlobalJSON(d GlobalData, at time.Time) ([]byte, error) {
+	d.Daily, d.Agents, d.Comparison, d.Overhead = emptyLists(d.Daily, d.Agents, d.Comparison, d.Overhead)
+	if d.Users == nil {
+		d.Users = []UserRow{}
+	}
+	if d.TopProjects == nil {
+		d.TopProjects = []ProjectSummary{}
+	}
+	return marshalReport(jsonReport{Scope: "global", GeneratedAt: at, Global: &d})
+}
+
+// emptyLists swaps nil lists for empty ones so consumers always see an
+// array, never null.
+func emptyLists(daily []DayBucket, agents []AgentBreakdown, comparison []AgentComparisonRow, overhead OverheadSummary) ([]DayBucket, []AgentBreakdown, []AgentComparisonRow, OverheadSummary) {
+	if daily == nil {
+		daily = []DayBucket{}
+	}
+	if agents == nil {
+		agents = []AgentBreakdown{}
+	}
+	if comparison == nil {
+		comparison = []AgentComparisonRow{}
+	}
+	if overhead.ByKind == nil {
+		overhead.ByKind = []OverheadKindRow{}
+	}
+	return daily, agents, comparison, overhead
+}
+
+func marshalReport(r jsonReport) ([]byte, error) {
+	r.Schema = JSONSchema
+	r.SchemaVersion = JSONSchemaVersion
+	r.GeneratedAt = r.GeneratedAt.UTC()
+	out, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
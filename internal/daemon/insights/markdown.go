@@ -8,6 +8,8 @@ import (
 	"sync"
 	"text/template"
 	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
 )
 
 //go:embed templates/*.md.tmpl
@@ -40,6 +42,12 @@ func templateFuncs() template.FuncMap {
 		"windowLabel":   windowLabel,
 		"netLabel":      netLabel,
 		"yesNo":         yesNo,
+		"commandCode":   commandCode,
+		"exitLabel":     exitLabel,
+		"usd":           usd,
+		"costCell":      costCell,
+		"pct":           pct,
+		"msHuman":       msHuman,
 	}
 }
 
@@ -173,3 +181,66 @@ func windowLabel(start, end time.Time) string {
 		end.Local().Format("Mon, Jan 2 2006"),
 	)
 }
+
+// commandCode renders a shell command as an inline code span. Multi-line
+// commands keep their first line only, and the fence grows past any
+// backtick run inside the command so it can't close early.
+func commandCode(cmd string) string {
+	cmd = strings.TrimSpace(cmd)
+	if i := strings.IndexByte(cmd, '\n'); i >= 0 {
+		cmd = strings.TrimSpace(cmd[:i]) + " …"
+	}
+	longest, run := 0, 0
+	for _, r := range cmd {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+	if longest > 0 {
+		return fence + " " + cmd + " " + fence
+	}
+	return fence + cmd + fence
+}
+
+// usd renders a dollar amount for reports: cents, or four places below a
+// cent so a cheap task doesn't read as free.
+func usd(v float64) string {
+	if v != 0 && v < 0.01 && v > -0.01 {
+		return fmt.Sprintf("$%.4f", v)
+	}
+	return fmt.Sprintf("$%.2f", v)
+}
+
+// costCell renders a single task's cost with its source, or "—".
+func costCell(v *float64, source string) string {
+	if v == nil {
+		return "—"
+	}
+	if source == string(models.CostSourceEstimated) {
+		return usd(*v) + " (estimated)"
+	}
+	return usd(*v)
+}
+
+// pct renders a 0..1 rate as a whole percentage.
+func pct(rate float64) string {
+	return fmt.Sprintf("%.0f%%", rate*100)
+}
+
+// msHuman is durationHuman for millisecond durations.
+func msHuman(ms int64) string {
+	return durationHuman(ms / 1000)
+}
+
+func exitLabel(code *int) string {
+	if code == nil {
+		return "exit ?"
+	}
+	return fmt.Sprintf("exit %d", *code)
+}
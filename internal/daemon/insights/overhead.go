@@ -0,0 +1,109 @@
+package insights
+
+import (
+	"sort"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// OverheadSummary rolls up the agent sessions that ran outside a task —
+// chat, wildfire refine / generate, and definition / task generation —
+// from their `<logID>.metrics.yaml` records. None of it shows up in the
+// task totals, so it is reported alongside them as "overhead".
+type OverheadSummary struct {
+	Sessions            int     `json:"sessions"`
+	DurationMs          int64   `json:"duration_ms"`
+	TokensIn            int64   `json:"tokens_in"`
+	TokensOut           int64   `json:"tokens_out"`
+	CostUSD             float64 `json:"cost_usd"`
+	SessionsMissingCost int     `json:"sessions_missing_cost"`
+	// CostShare is CostUSD over task plus overhead spend, 0..1.
+	CostShare float64 `json:"cost_share"`
+
+	ByKind []OverheadKindRow `json:"by_kind"`
+}
+
+// OverheadKindRow is one session kind's share of the overhead. Kind is
+// the agent mode, qualified by the wildfire phase ("wildfire-refine").
+type OverheadKindRow struct {
+	Kind       string  `json:"kind"`
+	Sessions   int     `json:"sessions"`
+	DurationMs int64   `json:"duration_ms"`
+	TokensIn   int64   `json:"tokens_in"`
+	TokensOut  int64   `json:"tokens_out"`
+	CostUSD    float64 `json:"cost_usd"`
+}
+
+// ComputeOverhead sums the sessions that ended inside the window.
+// taskCostUSD is the window's task spend, the other half of CostShare.
+func ComputeOverhead(sessions []*models.SessionMetrics, windowStart, windowEnd time.Time, taskCostUSD float64) OverheadSummary {
+	var o OverheadSummary
+	byKind := map[string]*OverheadKindRow{}
+	for _, s := range sessions {
+		if s == nil || !inWindow(s.EndedAt, windowStart, windowEnd) {
+			continue
+		}
+		kind := s.Kind()
+		row := byKind[kind]
+		if row == nil {
+			row = &OverheadKindRow{Kind: kind}
+			byKind[kind] = row
+		}
+		in, out := derefInt64(s.TokensIn), derefInt64(s.TokensOut)
+		row.Sessions++
+		row.DurationMs += s.DurationMs
+		row.TokensIn += in
+		row.TokensOut += out
+		o.Sessions++
+		o.DurationMs += s.DurationMs
+		o.TokensIn += in
+		o.TokensOut += out
+		if s.CostUSD == nil {
+			o.SessionsMissingCost++
+			continue
+		}
+		row.CostUSD += *s.CostUSD
+		o.CostUSD += *s.CostUSD
+	}
+	if total := o.CostUSD + taskCostUSD; total > 0 {
+		o.CostShare = o.CostUSD / total
+	}
+	o.ByKind = make([]OverheadKindRow, 0, len(byKind))
+	for _, row := range byKind {
+		o.ByKind = append(o.ByKind, *row)
+	}
+	sort.Slice(o.ByKind, func(i, j int) bool {
+		a, b := o.ByKind[i], o.ByKind[j]
+		if a.CostUSD != b.CostUSD {
+			return a.CostUSD > b.CostUSD
+		}
+		if a.DurationMs != b.DurationMs {
+			return a.DurationMs > b.DurationMs
+		}
+		return a.Kind < b.Kind
+	})
+	return o
+}
+
+// loadSessionMetrics reads the session metrics of every given project,
+// skipping projects whose logs can't be read.
+func loadSessionMetrics(projectIDs ...string) []*models.SessionMetrics {
+	var out []*models.SessionMetrics
+	for _, id := range projectIDs {
+		sessions, err := config.ListSessionMetrics(id)
+		if err != nil {
+			continue
+		}
+		out = append(out, sessions...)
+	}
+	return out
+}
+
+func derefInt64(p *int64) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
@@ -0,0 +1,55 @@
+package insights
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestComputeOverhead(t *testing.T) {
+	windowEnd := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+	windowStart := windowEnd.AddDate(0, 0, -7)
+	cost := func(v float64) *float64 { return &v }
+	tokens := func(v int64) *int64 { return &v }
+	sessions := []*models.SessionMetrics{
+		{Mode: "chat", EndedAt: windowEnd.Add(-time.Hour), DurationMs: 600_000, TokensIn: tokens(1000), TokensOut: tokens(100), CostUSD: cost(1)},
+		{Mode: "chat", EndedAt: windowEnd.Add(-2 * time.Hour), DurationMs: 300_000, CostUSD: cost(0.5)},
+		{Mode: "wildfire", Phase: "refine", EndedAt: windowStart.Add(time.Hour), DurationMs: 120_000, CostUSD: cost(2)},
+		{Mode: "generate-tasks", EndedAt: windowStart.Add(time.Hour), DurationMs: 60_000}, // no cost
+		{Mode: "chat", EndedAt: windowStart.Add(-time.Hour), CostUSD: cost(100)},          // before window
+		nil,
+	}
+
+	o := ComputeOverhead(sessions, windowStart, windowEnd, 6.5)
+	if o.Sessions != 4 || o.DurationMs != 1_080_000 || o.TokensIn != 1000 || o.TokensOut != 100 {
+		t.Errorf("totals = %+v", o)
+	}
+	if math.Abs(o.CostUSD-3.5) > 1e-9 || o.SessionsMissingCost != 1 {
+		t.Errorf("cost = %v, missing %d; want 3.5, 1", o.CostUSD, o.SessionsMissingCost)
+	}
+	if math.Abs(o.CostShare-0.35) > 1e-9 {
+		t.Errorf("CostShare = %v, want 0.35", o.CostShare)
+	}
+	var kinds []string
+	for _, r := range o.ByKind {
+		kinds = append(kinds, r.Kind)
+	}
+	want := []string{"wildfire-refine", "chat", "generate-tasks"}
+	if len(kinds) != len(want) {
+		t.Fatalf("kinds = %v, want %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Fatalf("kinds = %v, want %v (by cost, then time)", kinds, want)
+		}
+	}
+	if chat := o.ByKind[1]; chat.Sessions != 2 || chat.DurationMs != 900_000 {
+		t.Errorf("chat row = %+v", chat)
+	}
+
+	if empty := ComputeOverhead(nil, windowStart, windowEnd, 0); empty.Sessions != 0 || empty.CostShare != 0 || len(empty.ByKind) != 0 {
+		t.Errorf("empty = %+v", empty)
+	}
+}
@@ -5,9 +5,9 @@
 // dashboard's per-project Insights tab and the TUI per-project overlay
 // (key `i`) both read the cached output.
 //
-// Cost / token data still ride task 0056 (per-task metrics capture). Until
-// that lands, every completed task is counted as "missing cost" so the GUI
-// partial-data caveat fires.
+// Cost and token totals are summed from each task's `<n>.metrics.yaml`.
+// Tasks without a cost count as "missing cost" so the GUI partial-data
+// caveat fires; estimated costs are broken out from reported ones.
 package insights
 
 import (
@@ -39,6 +39,10 @@ type ProjectInsights struct {
 
 	TotalCostUSD     float64 `json:"total_cost_usd"`
 	TasksMissingCost int     `json:"tasks_missing_cost"`
+	// The part of TotalCostUSD estimated from the pricing table for
+	// backends that don't report cost, and how many tasks it covers.
+	EstimatedCostUSD   float64 `json:"estimated_cost_usd"`
+	TasksEstimatedCost int     `json:"tasks_estimated_cost"`
 
 	// v8.0 Inferno — shipped-code rollup (task 0115), summed from the
 	// per-task code-output metrics captured in 0114. Tasks whose metrics
@@ -53,6 +57,16 @@ type ProjectInsights struct {
 	TasksMerged        int `json:"tasks_merged"`
 	TasksViaPR         int `json:"tasks_via_pr"`
 	MetricsMissingCode int `json:"metrics_missing_code"`
+
+	AgentComparison []AgentComparisonRow `json:"agent_comparison"`
+	Overhead        OverheadSummary      `json:"overhead"`
+
+	// Trend is the TrendWeeks weeks ending with the window's last week,
+	// whatever the window's start.
+	Trend []TrendWeek `json:"trend"`
+
+	// Users splits the window's work and spend per person.
+	Users []UserRow `json:"users"`
 }
 
 // ProjectDayBucket — one calendar day in the per-project breakdown. Shape
@@ -109,11 +123,15 @@ func LoadProjectInsights(projectID string, windowStart, windowEnd time.Time) (*P
 // code-output rollup; it may be nil (no rollup) or return nil for a task
 // without a metrics file. Either way the task is counted in
 // MetricsMissingCode and contributes zero code output.
+//
+// reverted reports whether a task's work was later reverted, for the
+// agent comparison; nil means no revert data.
 func ComputeProjectInsightsForTasks(
 	projectID string,
 	windowStart, windowEnd time.Time,
 	tasks []*models.Task,
 	metricsFor func(t *models.Task) *models.TaskMetrics,
+	reverted func(t *models.Task, m *models.TaskMetrics) bool,
 ) *ProjectInsights {
 	p := &ProjectInsights{
 		ProjectID:   projectID,
@@ -131,12 +149,17 @@ func ComputeProjectInsightsForTasks(
 	commitsByAgent := map[string]int{}
 	linesAddedByAgent := map[string]int{}
 	linesRemovedByAgent := map[string]int{}
+	costByAgent := newCostTally()
+	comparison := newComparisonTally()
+	trend := newTrendTally(windowEnd)
+	users := newUserTally()
 	var allDurationsMs []int64
 
 	for _, t := range tasks {
 		if t == nil || t.HiddenFromInsights() {
 			continue
 		}
+		users.addCreated(t, windowStart, windowEnd)
 		if t.Status != models.TaskStatusDone {
 			continue
 		}
@@ -148,13 +171,15 @@ func ComputeProjectInsightsForTasks(
 			m = metricsFor(t)
 		}
 		completedAt := EffectiveCompletedAt(t, m)
+		if completedAt != nil {
+			trend.add(t, m, *completedAt)
+		}
 		if completedAt == nil || !inWindow(*completedAt, windowStart, windowEnd) {
 			continue
 		}
 		key := bucketKey(*completedAt)
 		agent := agentKey(t.Agent)
 		p.TasksTotal++
-		p.TasksMissingCost++ // task 0056 not yet wired — every row is missing cost
 		if t.Success != nil && *t.Success {
 			p.TasksSucceeded++
 			dayDone[key]++
@@ -193,14 +218,31 @@ func ComputeProjectInsightsForTasks(
 		commitsByAgent[agent] += cf.commits
 		linesAddedByAgent[agent] += cf.linesAdded
 		linesRemovedByAgent[agent] += cf.linesRemoved
+
+		cost := costFieldsFrom(m)
+		switch {
+		case !cost.hasCost:
+			p.TasksMissingCost++
+		case cost.estimated:
+			p.TasksEstimatedCost++
+			p.EstimatedCostUSD += cost.costUSD
+		}
+		p.TotalCostUSD += cost.costUSD
+		costByAgent.add(agent, cost)
+
+		comparison.add(t, m, *completedAt, reverted != nil && reverted(t, m))
+		users.addTask(t, m, *completedAt)
 	}
 
 	p.NetLines = p.TotalLinesAdded - p.TotalLinesRemoved
 	p.TasksByDay = mergeProjectDayBuckets(dayDone, dayFailed, dayLinesAdded, dayLinesRemoved)
 	p.AgentBreakdown = buildProjectAgentRows(
 		doneByAgent, failedByAgent, durationsByAgent,
-		commitsByAgent, linesAddedByAgent, linesRemovedByAgent,
+		commitsByAgent, linesAddedByAgent, linesRemovedByAgent, costByAgent,
 	)
+	p.AgentComparison = comparison.rows()
+	p.Trend = trend.series()
+	p.Users = users.rows()
 	if n := len(allDurationsMs); n > 0 {
 		p.AvgDurationMs = p.TotalDurationMs / int64(n)
 		p.P50DurationMs = percentileInt64(allDurationsMs, 50)
@@ -236,7 +278,11 @@ func computeProjectInsights(projectID string, windowStart, windowEnd time.Time)
 		}
 		return m
 	}
-	return ComputeProjectInsightsForTasks(projectID, windowStart, windowEnd, tasks, metricsFor), nil
+	p := ComputeProjectInsightsForTasks(projectID, windowStart, windowEnd, tasks, metricsFor, scanReverts(entry.Path).reverted)
+	sessions := loadSessionMetrics(projectID)
+	p.Overhead = ComputeOverhead(sessions, windowStart, windowEnd, p.TotalCostUSD)
+	p.Users = WithSessionUsers(p.Users, sessions, windowStart, windowEnd)
+	return p, nil
 }
 
 func mergeProjectDayBuckets(dayDone, dayFailed, dayLinesAdded, dayLinesRemoved map[string]int) []ProjectDayBucket {
@@ -261,6 +307,7 @@ func buildProjectAgentRows(
 	done, failed map[string]int,
 	durations map[string][]int64,
 	commits, linesAdded, linesRemoved map[string]int,
+	cost costTally,
 ) []ProjectAgentRow {
 	all := unionKeys(done, failed)
 	out := make([]ProjectAgentRow, 0, len(all))
@@ -274,6 +321,9 @@ func buildProjectAgentRows(
 		row.Commits = commits[k]
 		row.LinesAdded = linesAdded[k]
 		row.LinesRemoved = linesRemoved[k]
+		row.TotalTokensIn = cost.tokensIn[k]
+		row.TotalTokensOut = cost.tokensOut[k]
+		row.TotalCostUSD = cost.cost[k]
 		out = append(out, row)
 	}
 	sort.Slice(out, func(i, j int) bool {
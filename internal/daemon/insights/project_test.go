@@ -27,6 +27,7 @@ func TestComputeProjectInsights_TotalsAndAgentBreakdown(t *testing.T) {
 		"proj-a", day(1), day(30),
 		tasks,
 		nil, // no metrics — code rollup stays zero, MetricsMissingCode counts all
+		nil,
 	)
 
 	if p.TasksTotal != 3 {
@@ -36,7 +37,7 @@ func TestComputeProjectInsights_TotalsAndAgentBreakdown(t *testing.T) {
 		t.Errorf("succeeded/failed = %d/%d, want 2/1", p.TasksSucceeded, p.TasksFailed)
 	}
 	if p.TasksMissingCost != 3 {
-		t.Errorf("TasksMissingCost = %d, want 3 (every task counted while 0056 not landed)", p.TasksMissingCost)
+		t.Errorf("TasksMissingCost = %d, want 3 (no metrics — no task has a cost)", p.TasksMissingCost)
 	}
 	if p.TotalDurationMs <= 0 || p.AvgDurationMs <= 0 {
 		t.Errorf("expected positive total + avg duration, got %d / %d", p.TotalDurationMs, p.AvgDurationMs)
@@ -85,6 +86,7 @@ func TestComputeProjectInsights_CodeOutputRollup(t *testing.T) {
 		"proj-a", day(1), day(30),
 		tasks,
 		func(t *models.Task) *models.TaskMetrics { return metrics[t.TaskNumber] },
+		nil,
 	)
 
 	if p.TotalCommits != 4 {
@@ -136,6 +138,59 @@ func TestComputeProjectInsights_CodeOutputRollup(t *testing.T) {
 	}
 }
 
+// TestComputeProjectInsights_CostRollup checks that reported and
+// estimated costs both count toward the total, the estimated share is
+// broken out, and tasks with tokens but no cost stay "missing cost".
+func TestComputeProjectInsights_CostRollup(t *testing.T) {
+	t.Parallel()
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 12, 0, 0, 0, time.UTC) }
+	i64 := func(n int64) *int64 { return &n }
+	f64 := func(v float64) *float64 { return &v }
+
+	tasks := []*models.Task{
+		makeTask(1, "claude-code", true, day(2).Add(-10*time.Minute), day(2)),
+		makeTask(2, "cursor", true, day(3).Add(-5*time.Minute), day(3)),
+		makeTask(3, "cursor", true, day(3).Add(-2*time.Minute), day(3)),
+		makeTask(4, "claude-code", false, day(3).Add(-1*time.Minute), day(3)),
+	}
+	metrics := map[int]*models.TaskMetrics{
+		1: {TaskNumber: 1, TokensIn: i64(1000), TokensOut: i64(200), CostUSD: f64(1.5), CostSource: models.CostSourceReported},
+		2: {TaskNumber: 2, TokensIn: i64(4000), TokensOut: i64(800), CostUSD: f64(0.25), CostSource: models.CostSourceEstimated},
+		// Pre-estimation file: a cost with no source is a reported one.
+		3: {TaskNumber: 3, CostUSD: f64(0.5)},
+		// Tokens the pricing table couldn't price.
+		4: {TaskNumber: 4, TokensIn: i64(300), TokensOut: i64(30)},
+	}
+
+	p := ComputeProjectInsightsForTasks(
+		"proj-a", day(1), day(30),
+		tasks,
+		func(t *models.Task) *models.TaskMetrics { return metrics[t.TaskNumber] },
+		nil,
+	)
+
+	if p.TotalCostUSD != 2.25 {
+		t.Errorf("TotalCostUSD = %v, want 2.25", p.TotalCostUSD)
+	}
+	if p.EstimatedCostUSD != 0.25 || p.TasksEstimatedCost != 1 {
+		t.Errorf("estimated = %v over %d tasks, want 0.25 over 1", p.EstimatedCostUSD, p.TasksEstimatedCost)
+	}
+	if p.TasksMissingCost != 1 {
+		t.Errorf("TasksMissingCost = %d, want 1 (task 4)", p.TasksMissingCost)
+	}
+
+	byAgent := map[string]ProjectAgentRow{}
+	for _, r := range p.AgentBreakdown {
+		byAgent[r.Agent] = r
+	}
+	if cc := byAgent["claude-code"]; cc.TotalCostUSD != 1.5 || cc.TotalTokensIn != 1300 || cc.TotalTokensOut != 230 {
+		t.Errorf("claude-code row = $%v %d/%d, want $1.5 1300/230", cc.TotalCostUSD, cc.TotalTokensIn, cc.TotalTokensOut)
+	}
+	if cu := byAgent["cursor"]; cu.TotalCostUSD != 0.75 || cu.TotalTokensIn != 4000 {
+		t.Errorf("cursor row = $%v in=%d, want $0.75 in=4000", cu.TotalCostUSD, cu.TotalTokensIn)
+	}
+}
+
 func TestComputeProjectInsights_AllMetricsMissing(t *testing.T) {
 	t.Parallel()
 	day := func(d int) time.Time { return time.Date(2026, 5, d, 12, 0, 0, 0, time.UTC) }
@@ -149,7 +204,7 @@ func TestComputeProjectInsights_AllMetricsMissing(t *testing.T) {
 		nil,
 		func(*models.Task) *models.TaskMetrics { return nil },
 	} {
-		p := ComputeProjectInsightsForTasks("proj-a", day(1), day(30), tasks, mf)
+		p := ComputeProjectInsightsForTasks("proj-a", day(1), day(30), tasks, mf, nil)
 		if p.MetricsMissingCode != 2 {
 			t.Errorf("MetricsMissingCode = %d, want 2", p.MetricsMissingCode)
 		}
@@ -169,7 +224,7 @@ func TestComputeProjectInsights_EmptyAndDeleted(t *testing.T) {
 		{TaskNumber: 1, Status: models.TaskStatusDone, Agent: "claude-code", DeletedAt: &deleted, CompletedAt: timePtr(day(2))},
 	}
 
-	p := ComputeProjectInsightsForTasks("proj-empty", day(1), day(30), tasks, nil)
+	p := ComputeProjectInsightsForTasks("proj-empty", day(1), day(30), tasks, nil, nil)
 	if p.TasksTotal != 0 {
 		t.Errorf("deleted task should be excluded; TasksTotal = %d", p.TasksTotal)
 	}
@@ -191,13 +246,13 @@ func TestComputeProjectInsights_RetrofitArchivedStillCounts(t *testing.T) {
 		{TaskNumber: 3, Status: models.TaskStatusDone, Agent: "claude-code", CompletedAt: timePtr(day(2)), DeletedAt: &deleted},
 	}
 
-	before := ComputeProjectInsightsForTasks("proj-retro", day(1), day(30), tasks[:1], nil)
-	after := ComputeProjectInsightsForTasks("proj-retro", day(1), day(30), tasks[:2], nil)
+	before := ComputeProjectInsightsForTasks("proj-retro", day(1), day(30), tasks[:1], nil, nil)
+	after := ComputeProjectInsightsForTasks("proj-retro", day(1), day(30), tasks[:2], nil, nil)
 	if after.TasksTotal != before.TasksTotal+1 {
 		t.Errorf("retrofit-archived task must still count: before=%d after=%d", before.TasksTotal, after.TasksTotal)
 	}
 
-	all := ComputeProjectInsightsForTasks("proj-retro", day(1), day(30), tasks, nil)
+	all := ComputeProjectInsightsForTasks("proj-retro", day(1), day(30), tasks, nil, nil)
 	if all.TasksTotal != 2 {
 		t.Errorf("ordinary trashed task must stay excluded; TasksTotal = %d, want 2", all.TasksTotal)
 	}
@@ -296,14 +351,14 @@ func BenchmarkProjectInsights(b *testing.B) {
 
 	b.Run("cold", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_ = ComputeProjectInsightsForTasks("proj-x", time.Time{}, time.Time{}, tasks, nil)
+			_ = ComputeProjectInsightsForTasks("proj-x", time.Time{}, time.Time{}, tasks, nil, nil)
 		}
 	})
 
 	b.Run("cache-hit", func(b *testing.B) {
 		tmp := b.TempDir()
 		b.Setenv("HOME", tmp)
-		seeded := ComputeProjectInsightsForTasks("proj-x", time.Time{}, time.Time{}, tasks, nil)
+		seeded := ComputeProjectInsightsForTasks("proj-x", time.Time{}, time.Time{}, tasks, nil, nil)
 		writeProjectCache(seeded)
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
@@ -339,6 +394,7 @@ func TestComputeProjectInsights_CompletedAtFallback(t *testing.T) {
 		"proj-a", day(1), day(30),
 		[]*models.Task{viaMetrics, viaUpdated, outside},
 		func(t *models.Task) *models.TaskMetrics { return metrics[t.TaskNumber] },
+		nil,
 	)
 
 	if p.TasksTotal != 2 {
@@ -356,3 +412,65 @@ func TestComputeProjectInsights_CompletedAtFallback(t *testing.T) {
 		t.Errorf("TotalDurationMs = %d, want > 0", p.TotalDurationMs)
 	}
 }
+
+func TestComputeProjectInsights_AgentComparison(t *testing.T) {
+	t.Parallel()
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 12, 0, 0, 0, time.UTC) }
+	cost := func(v float64) *float64 { return &v }
+
+	tasks := []*models.Task{
+		makeTask(1, "claude-code", true, day(2).Add(-10*time.Minute), day(2)),
+		makeTask(2, "claude-code", true, day(3).Add(-30*time.Minute), day(3)),
+		makeTask(3, "claude-code", false, day(4).Add(-20*time.Minute), day(4)),
+		makeTask(4, "codex", true, day(4).Add(-5*time.Minute), day(4)),
+		makeTask(5, "claude-code", true, day(5).Add(-1*time.Minute), day(5)),
+	}
+	tasks[1].AgentSessions = 2
+	tasks[3].MergeFailureReason = "conflict"
+	metrics := map[int]*models.TaskMetrics{
+		1: {Model: "claude-sonnet-4", CostUSD: cost(1), LinesAdded: 10},
+		2: {Model: "claude-sonnet-4", CostUSD: cost(2), LinesAdded: 20, CommitSHAs: []string{"abc"}},
+		3: {Model: "claude-sonnet-4", CostUSD: cost(3)},
+		4: {CostUSD: cost(0.5)},
+		5: {Model: "claude-opus-4", CostUSD: cost(4)},
+	}
+	reverted := func(t *models.Task, m *models.TaskMetrics) bool {
+		return m != nil && len(m.CommitSHAs) > 0
+	}
+
+	p := ComputeProjectInsightsForTasks(
+		"proj-a", day(1), day(30),
+		tasks,
+		func(t *models.Task) *models.TaskMetrics { return metrics[t.TaskNumber] },
+		reverted,
+	)
+
+	if len(p.AgentComparison) != 3 {
+		t.Fatalf("AgentComparison len = %d, want 3: %+v", len(p.AgentComparison), p.AgentComparison)
+	}
+	sonnet := p.AgentComparison[0]
+	if sonnet.Agent != "claude-code" || sonnet.Model != "claude-sonnet-4" || sonnet.Tasks != 3 || sonnet.Succeeded != 2 {
+		t.Fatalf("first row = %+v, want claude-code/claude-sonnet-4 with 2 of 3", sonnet)
+	}
+	if sonnet.CostPerSuccessUSD != 3 {
+		t.Errorf("CostPerSuccessUSD = %v, want 3 (all $6 over 2 successes)", sonnet.CostPerSuccessUSD)
+	}
+	if sonnet.MedianDurationMs != (20 * time.Minute).Milliseconds() {
+		t.Errorf("MedianDurationMs = %d, want 20m", sonnet.MedianDurationMs)
+	}
+	if sonnet.P90DurationMs != (30 * time.Minute).Milliseconds() {
+		t.Errorf("P90DurationMs = %d, want 30m", sonnet.P90DurationMs)
+	}
+	if sonnet.FollowUps != 1 || sonnet.Reverted != 1 || sonnet.LinesAdded != 30 {
+		t.Errorf("follow-ups/reverted/lines = %d/%d/%d, want 1/1/30", sonnet.FollowUps, sonnet.Reverted, sonnet.LinesAdded)
+	}
+
+	// Ties on task count sort by agent, then model ("" first).
+	codex := p.AgentComparison[2]
+	if codex.Agent != "codex" || codex.Model != "" || codex.MergeFailures != 1 || codex.MergeFailureRate != 1 {
+		t.Errorf("codex row = %+v, want unknown model with a merge failure", codex)
+	}
+	if got := p.AgentComparison[1]; got.Model != "claude-opus-4" || got.SuccessRate != 1 {
+		t.Errorf("opus row = %+v", got)
+	}
+}
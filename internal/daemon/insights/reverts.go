@@ -0,0 +1,153 @@
+package insights
+
+import (
+	"context"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/git"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+var (
+	revertsCommitRe = regexp.MustCompile(`This reverts commit ([0-9a-f]{40})`)
+	taskBranchRe    = regexp.MustCompile(`watchfire/(\d+)`)
+	taskPRTitleRe   = regexp.MustCompile(`^\[task (\d+)\]`)
+)
+
+// revertScan is what a project's default branch says was reverted: the
+// commits named by `git revert` messages, and the tasks one of those
+// commits belongs to.
+type revertScan struct {
+	shas  map[string]bool
+	tasks map[int]bool
+}
+
+// scanReverts reads the history of the branch task work merges into.
+// A reverted commit is pinned to a task when it is:
+//
+//   - a local merge ("Merge watchfire/<n>") or a GitHub merge commit
+//     ("Merge pull request … from …/watchfire/<n>");
+//   - a GitHub squash of the auto-PR, whose subject keeps the PR title
+//     ("[task <n>] …");
+//   - the daemon's auto-commit on the task branch;
+//   - one of the individual commits a task merge brought in.
+//
+// Fast-forwarded or rebased task commits carry no marker; reverted()
+// matches those against the SHAs recorded in the task's metrics.
+// Best-effort: no git, no repo or no reverts all yield an empty scan.
+func scanReverts(projectPath string) revertScan {
+	r := revertScan{shas: map[string]bool{}, tasks: map[int]bool{}}
+	ref := revertScanRef(projectPath)
+	bodies := gitOutput(projectPath, "log", "--format=%B", "--grep=This reverts commit", ref)
+	for _, m := range revertsCommitRe.FindAllStringSubmatch(bodies, -1) {
+		r.shas[m[1]] = true
+	}
+	if len(r.shas) == 0 {
+		return r
+	}
+
+	type taskMerge struct {
+		task     int
+		from, to string
+	}
+	var merges []taskMerge
+	unclaimed := len(r.shas)
+	marked := gitOutput(projectPath, "log", "--format=%H %P%x09%s", "-F",
+		"--grep=watchfire/", "--grep=[task ", ref)
+	for _, line := range strings.Split(marked, "\n") {
+		head, subject, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		n, ok := taskFromSubject(subject)
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(head)
+		if r.shas[fields[0]] {
+			r.tasks[n] = true
+			unclaimed--
+		} else if len(fields) == 3 {
+			merges = append(merges, taskMerge{task: n, from: fields[1], to: fields[2]})
+		}
+	}
+
+	// Commits reverted one by one out of a merged task: walk what each
+	// remaining task merge brought in. Only when a reverted commit is
+	// still unaccounted for — this is one git call per merge.
+	for _, tm := range merges {
+		if unclaimed <= 0 {
+			break
+		}
+		if r.tasks[tm.task] {
+			continue
+		}
+		for _, sha := range strings.Fields(gitOutput(projectPath, "rev-list", tm.from+".."+tm.to)) {
+			if r.shas[sha] {
+				r.tasks[tm.task] = true
+				unclaimed--
+				break
+			}
+		}
+	}
+	return r
+}
+
+// revertScanRef is the ref scanReverts walks: the default branch the
+// merge path targets, its remote-tracking copy when there is no local
+// one, and HEAD only when neither resolves.
+func revertScanRef(projectPath string) string {
+	branch := git.DefaultBranch(context.Background(), projectPath)
+	for _, ref := range []string{branch, "origin/" + branch} {
+		if gitOutput(projectPath, "rev-parse", "--verify", "--quiet", ref+"^{commit}") != "" {
+			return ref
+		}
+	}
+	return "HEAD"
+}
+
+// taskFromSubject extracts the task number a commit subject names, by
+// its watchfire/<n> branch or its "[task <n>]" PR title.
+func taskFromSubject(subject string) (int, bool) {
+	m := taskPRTitleRe.FindStringSubmatch(subject)
+	if m == nil {
+		m = taskBranchRe.FindStringSubmatch(subject)
+	}
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(m[1])
+	return n, err == nil
+}
+
+// reverted reports whether t's merge, or any commit recorded in its
+// metrics, was reverted.
+func (r revertScan) reverted(t *models.Task, m *models.TaskMetrics) bool {
+	if t == nil {
+		return false
+	}
+	if r.tasks[t.TaskNumber] {
+		return true
+	}
+	if m != nil {
+		for _, sha := range m.CommitSHAs {
+			if r.shas[sha] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func gitOutput(dir string, args ...string) string {
+	cmd := exec.Command("git", args...) //nolint:gosec // args are produced internally
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
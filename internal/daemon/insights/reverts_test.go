@@ -0,0 +1,90 @@
+package insights
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// TestScanReverts builds a repo with merged task branches, then reverts
+// task 1's merge commit, one of task 2's commits and task 4's GitHub-style
+// squash — and scans from a checked-out feature branch, which must not
+// hide reverts on the default branch.
+func TestScanReverts(t *testing.T) {
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	commitFile := func(name string) string {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", name)
+		git("commit", "-q", "-m", "add "+name)
+		return git("rev-parse", "HEAD")
+	}
+	git("init", "-q", "-b", "main")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test")
+	git("config", "commit.gpgsign", "false")
+	commitFile("README")
+
+	git("checkout", "-q", "-b", "watchfire/0001")
+	commitFile("one")
+	git("checkout", "-q", "main")
+	git("merge", "-q", "--no-ff", "watchfire/0001", "-m", "Merge watchfire/0001")
+	merge1 := git("rev-parse", "HEAD")
+
+	git("checkout", "-q", "-b", "watchfire/0002")
+	sha2 := commitFile("two")
+	git("checkout", "-q", "main")
+	git("merge", "-q", "--no-ff", "watchfire/0002", "-m", "Merge watchfire/0002")
+
+	git("checkout", "-q", "-b", "watchfire/0003")
+	sha3 := commitFile("three")
+	git("checkout", "-q", "main")
+	git("merge", "-q", "--no-ff", "watchfire/0003", "-m", "Merge watchfire/0003")
+
+	commitFile("four")
+	git("commit", "-q", "--amend", "-m", "[task 0004] Add four (#12)")
+	squash4 := git("rev-parse", "HEAD")
+
+	git("revert", "--no-edit", "-m", "1", merge1)
+	git("revert", "--no-edit", sha2)
+	git("revert", "--no-edit", squash4)
+	git("checkout", "-q", "-b", "feature", merge1)
+
+	scan := scanReverts(dir)
+	cases := []struct {
+		task *models.Task
+		m    *models.TaskMetrics
+		want bool
+	}{
+		{&models.Task{TaskNumber: 1}, nil, true},
+		{&models.Task{TaskNumber: 2}, &models.TaskMetrics{CommitSHAs: []string{sha2}}, true},
+		{&models.Task{TaskNumber: 2}, nil, true}, // no recorded SHAs: found through the task merge
+		{&models.Task{TaskNumber: 3}, &models.TaskMetrics{CommitSHAs: []string{sha3}}, false},
+		{&models.Task{TaskNumber: 4}, nil, true},
+	}
+	for _, c := range cases {
+		if got := scan.reverted(c.task, c.m); got != c.want {
+			t.Errorf("task %d (metrics %v): reverted = %v, want %v", c.task.TaskNumber, c.m != nil, got, c.want)
+		}
+	}
+
+	if got := scanReverts(t.TempDir()); len(got.shas) != 0 || len(got.tasks) != 0 {
+		t.Errorf("non-repo scan = %+v, want empty", got)
+	}
+}
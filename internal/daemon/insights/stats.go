@@ -43,6 +43,22 @@ type windowStats struct {
 	agentCommits      map[string]int
 	agentLinesAdded   map[string]int
 	agentLinesRemoved map[string]int
+
+	// Spend over completed-in-window tasks, split by where the cost came
+	// from.
+	totalCostUSD       float64
+	estimatedCostUSD   float64
+	tasksEstimatedCost int
+	tasksMissingCost   int
+	agentCost          costTally
+
+	// comparison rates agents per model. reverted, when set, reports
+	// whether a task's work was later reverted; global loaders swap it
+	// per project.
+	comparison comparisonTally
+	reverted   func(t *models.Task, m *models.TaskMetrics) bool
+
+	users userTally
 }
 
 func newWindowStats(start, end time.Time) *windowStats {
@@ -59,6 +75,9 @@ func newWindowStats(start, end time.Time) *windowStats {
 		agentCommits:      map[string]int{},
 		agentLinesAdded:   map[string]int{},
 		agentLinesRemoved: map[string]int{},
+		agentCost:         newCostTally(),
+		comparison:        newComparisonTally(),
+		users:             newUserTally(),
 	}
 }
 
@@ -71,6 +90,7 @@ func (w *windowStats) add(t *models.Task, m *models.TaskMetrics) {
 		w.totalCreated++
 		w.dayCreated[bucketKey(t.CreatedAt)]++
 	}
+	w.users.addCreated(t, w.start, w.end)
 
 	// In-flight — instantaneous, no window filtering. A task that was
 	// created last year but is still ready/draft today is "in flight" for
@@ -124,6 +144,20 @@ func (w *windowStats) add(t *models.Task, m *models.TaskMetrics) {
 		w.agentCommits[agent] += cf.commits
 		w.agentLinesAdded[agent] += cf.linesAdded
 		w.agentLinesRemoved[agent] += cf.linesRemoved
+
+		cost := costFieldsFrom(m)
+		switch {
+		case !cost.hasCost:
+			w.tasksMissingCost++
+		case cost.estimated:
+			w.tasksEstimatedCost++
+			w.estimatedCostUSD += cost.costUSD
+		}
+		w.totalCostUSD += cost.costUSD
+		w.agentCost.add(agent, cost)
+
+		w.comparison.add(t, m, *completedAt, w.reverted != nil && w.reverted(t, m))
+		w.users.addTask(t, m, *completedAt)
 	}
 }
 
@@ -151,6 +185,16 @@ func (w *windowStats) codeOutput() CodeOutput {
 	}
 }
 
+// spend returns the rolled-up cost totals for the window.
+func (w *windowStats) spend() Spend {
+	return Spend{
+		TotalCostUSD:       w.totalCostUSD,
+		EstimatedCostUSD:   w.estimatedCostUSD,
+		TasksEstimatedCost: w.tasksEstimatedCost,
+		TasksMissingCost:   w.tasksMissingCost,
+	}
+}
+
 // daily returns one row per calendar day that had any activity, ordered
 // chronologically. Days inside the window with zero activity are not
 // emitted — keeping the table compact for typical reports.
@@ -183,6 +227,9 @@ func (w *windowStats) agents() []AgentBreakdown {
 			Commits:        w.agentCommits[name],
 			LinesAdded:     w.agentLinesAdded[name],
 			LinesRemoved:   w.agentLinesRemoved[name],
+			TokensIn:       w.agentCost.tokensIn[name],
+			TokensOut:      w.agentCost.tokensOut[name],
+			CostUSD:        w.agentCost.cost[name],
 		})
 	}
 	sort.Slice(out, func(i, j int) bool {
@@ -282,6 +329,55 @@ func codeFieldsFrom(m *models.TaskMetrics) codeFields {
 	return c
 }
 
+// costFields holds the per-task token + cost numbers pulled from a
+// TaskMetrics record. hasCost is false when the record has no cost at all
+// (no metrics file, or a backend whose tokens couldn't be priced); it
+// drives the TasksMissingCost honesty counter. estimated marks a cost
+// computed from the pricing table rather than reported by the backend.
+type costFields struct {
+	tokensIn  int64
+	tokensOut int64
+	costUSD   float64
+	hasCost   bool
+	estimated bool
+}
+
+func costFieldsFrom(m *models.TaskMetrics) costFields {
+	if m == nil {
+		return costFields{}
+	}
+	var c costFields
+	if m.TokensIn != nil {
+		c.tokensIn = *m.TokensIn
+	}
+	if m.TokensOut != nil {
+		c.tokensOut = *m.TokensOut
+	}
+	if m.CostUSD != nil {
+		c.costUSD = *m.CostUSD
+		c.hasCost = true
+		c.estimated = m.CostSource == models.CostSourceEstimated
+	}
+	return c
+}
+
+// costTally accumulates costFields per key (agent name).
+type costTally struct {
+	tokensIn  map[string]int64
+	tokensOut map[string]int64
+	cost      map[string]float64
+}
+
+func newCostTally() costTally {
+	return costTally{tokensIn: map[string]int64{}, tokensOut: map[string]int64{}, cost: map[string]float64{}}
+}
+
+func (t costTally) add(key string, c costFields) {
+	t.tokensIn[key] += c.tokensIn
+	t.tokensOut[key] += c.tokensOut
+	t.cost[key] += c.costUSD
+}
+
 func averageInt64(xs []int64) int64 {
 	if len(xs) == 0 {
 		return 0
@@ -48,7 +48,7 @@ func TestBuildProjectData(t *testing.T) {
 			DeletedAt:   timePtr(mkTime(30, 12))},
 	}
 
-	pd := buildProjectData("p", "Project", tasks, windowStart, windowEnd, nil)
+	pd := buildProjectData("p", "Project", tasks, windowStart, windowEnd, nil, nil)
 
 	// Tasks 1 + 5 succeeded in window → TotalDone == 2; task 2 failed in
 	// window → TotalFailed == 1; task 3 is out of window; task 6 soft-
@@ -111,7 +111,7 @@ func TestBuildProjectDataCodeOutput(t *testing.T) {
 	}
 	metricsFor := func(t *models.Task) *models.TaskMetrics { return metrics[t.TaskNumber] }
 
-	pd := buildProjectData("p", "Project", tasks, windowStart, windowEnd, metricsFor)
+	pd := buildProjectData("p", "Project", tasks, windowStart, windowEnd, metricsFor, nil)
 
 	if pd.Code.TotalCommits != 4 {
 		t.Errorf("TotalCommits = %d want 4", pd.Code.TotalCommits)
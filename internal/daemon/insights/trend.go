@@ -0,0 +1,92 @@
+package insights
+
+import (
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// TrendWeeks is the length of the rolling trend series.
+const TrendWeeks = 12
+
+// TrendWeek is one Monday-to-Sunday week (local time) of the rolling
+// trend. Weeks without completed tasks are kept, zeroed, so the series
+// always has TrendWeeks points for a sparkline.
+type TrendWeek struct {
+	WeekStart   string  `json:"week_start"` // YYYY-MM-DD, the Monday
+	Tasks       int     `json:"tasks"`
+	Succeeded   int     `json:"succeeded"`
+	SuccessRate float64 `json:"success_rate"`
+	CostUSD     float64 `json:"cost_usd"`
+	NetLines    int     `json:"net_lines"`
+}
+
+// trendTally buckets completed tasks into the TrendWeeks weeks ending
+// with the week that contains end. Unlike the rest of a rollup it
+// ignores the query window's start: the trend is always the trailing
+// twelve weeks.
+type trendTally struct {
+	first, end time.Time
+	weeks      []TrendWeek
+}
+
+// newTrendTally starts a series ending at end; a zero end means now.
+func newTrendTally(end time.Time) *trendTally {
+	if end.IsZero() {
+		end = time.Now()
+	}
+	last := weekStart(end)
+	first := last.AddDate(0, 0, -7*(TrendWeeks-1))
+	weeks := make([]TrendWeek, TrendWeeks)
+	for i := range weeks {
+		weeks[i].WeekStart = first.AddDate(0, 0, 7*i).Format("2006-01-02")
+	}
+	return &trendTally{first: first, end: end, weeks: weeks}
+}
+
+// add counts one done task completed at completedAt. m may be nil.
+func (tt *trendTally) add(t *models.Task, m *models.TaskMetrics, completedAt time.Time) {
+	i := tt.index(completedAt)
+	if i < 0 {
+		return
+	}
+	w := &tt.weeks[i]
+	w.Tasks++
+	if t.Success != nil && *t.Success {
+		w.Succeeded++
+	}
+	w.CostUSD += costFieldsFrom(m).costUSD
+	cf := codeFieldsFrom(m)
+	w.NetLines += cf.linesAdded - cf.linesRemoved
+}
+
+// index is the week at falls in, or -1 outside the series.
+func (tt *trendTally) index(at time.Time) int {
+	if at.Before(tt.first) || at.After(tt.end) {
+		return -1
+	}
+	// Half a day of slack absorbs DST's 23- and 25-hour days.
+	i := int(weekStart(at).Sub(tt.first).Hours()+12) / (7 * 24)
+	if i >= len(tt.weeks) {
+		return -1
+	}
+	return i
+}
+
+func (tt *trendTally) series() []TrendWeek {
+	out := make([]TrendWeek, len(tt.weeks))
+	copy(out, tt.weeks)
+	for i := range out {
+		if out[i].Tasks > 0 {
+			out[i].SuccessRate = float64(out[i].Succeeded) / float64(out[i].Tasks)
+		}
+	}
+	return out
+}
+
+// weekStart is local midnight on the Monday of t's week.
+func weekStart(t time.Time) time.Time {
+	t = t.Local()
+	offset := (int(t.Weekday()) + 6) % 7 // Monday = 0
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,40 @@
+package insights
+
+import (
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestTrendSeries(t *testing.T) {
+	end := time.Date(2026, 5, 13, 12, 0, 0, 0, time.Local) // a Wednesday
+	tt := newTrendTally(end)
+	ok, fail := true, false
+	for _, c := range []struct {
+		at      time.Time
+		success *bool
+	}{
+		{time.Date(2026, 5, 11, 9, 0, 0, 0, time.Local), &ok},  // this week's Monday
+		{time.Date(2026, 5, 10, 23, 0, 0, 0, time.Local), &ok}, // last week's Sunday
+		{time.Date(2026, 5, 9, 9, 0, 0, 0, time.Local), &fail},
+		{time.Date(2026, 5, 14, 9, 0, 0, 0, time.Local), &ok}, // after end
+		{time.Date(2025, 1, 1, 9, 0, 0, 0, time.Local), &ok},  // before the first week
+	} {
+		tt.add(&models.Task{Success: c.success}, nil, c.at)
+	}
+	weeks := tt.series()
+	if len(weeks) != TrendWeeks {
+		t.Fatalf("got %d weeks, want %d", len(weeks), TrendWeeks)
+	}
+	last, prev := weeks[TrendWeeks-1], weeks[TrendWeeks-2]
+	if last.WeekStart != "2026-05-11" || last.Tasks != 1 || last.SuccessRate != 1 {
+		t.Errorf("last week = %+v", last)
+	}
+	if prev.WeekStart != "2026-05-04" || prev.Tasks != 2 || prev.SuccessRate != 0.5 {
+		t.Errorf("previous week = %+v", prev)
+	}
+	if weeks[0].WeekStart != "2026-02-23" || weeks[0].Tasks != 0 {
+		t.Errorf("first week = %+v", weeks[0])
+	}
+}
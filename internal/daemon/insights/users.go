@@ -0,0 +1,148 @@
+package insights
+
+import (
+	"sort"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// UserRow is one person's usage over the window — the "who is spending
+// what" view for a team lead. Tasks are the completed-in-window tasks
+// attributed to the person (see TaskUser); TasksCreated counts the tasks
+// they added in the window, done or not; Sessions are the non-task
+// sessions they started. User is empty for unattributed work.
+type UserRow struct {
+	User        string  `json:"user"`
+	Tasks       int     `json:"tasks"`
+	Succeeded   int     `json:"succeeded"`
+	SuccessRate float64 `json:"success_rate"`
+	DurationMs  int64   `json:"duration_ms"`
+	TaskCostUSD float64 `json:"task_cost_usd"`
+	NetLines    int     `json:"net_lines"`
+
+	TasksCreated   int     `json:"tasks_created"`
+	Sessions       int     `json:"sessions"`
+	SessionCostUSD float64 `json:"session_cost_usd"`
+	// TotalCostUSD is TaskCostUSD plus SessionCostUSD.
+	TotalCostUSD float64 `json:"total_cost_usd"`
+}
+
+// UserLabel is User, or "(unattributed)" for work nobody is recorded
+// against.
+func (r UserRow) UserLabel() string {
+	if r.User == "" {
+		return "(unattributed)"
+	}
+	return r.User
+}
+
+// TaskUser is who a completed task's work and spend are charged to: the
+// person who started its (last) run, else the one who created it.
+// Metrics sidecars win over the task YAML, which may have been edited
+// since. m may be nil.
+func TaskUser(t *models.Task, m *models.TaskMetrics) string {
+	if m != nil && m.StartedBy != "" {
+		return m.StartedBy
+	}
+	if t.StartedBy != "" {
+		return t.StartedBy
+	}
+	if m != nil && m.CreatedBy != "" {
+		return m.CreatedBy
+	}
+	return t.CreatedBy
+}
+
+// userTally accumulates UserRows, keyed by identity.
+type userTally map[string]*UserRow
+
+func newUserTally() userTally { return userTally{} }
+
+func (u userTally) row(user string) *UserRow {
+	r := u[user]
+	if r == nil {
+		r = &UserRow{User: user}
+		u[user] = r
+	}
+	return r
+}
+
+// addCreated counts t against its creator when it was created inside
+// the window.
+func (u userTally) addCreated(t *models.Task, windowStart, windowEnd time.Time) {
+	if t.CreatedAt.IsZero() || !inWindow(t.CreatedAt, windowStart, windowEnd) {
+		return
+	}
+	u.row(t.CreatedBy).TasksCreated++
+}
+
+// addTask counts one completed-in-window task. m may be nil.
+func (u userTally) addTask(t *models.Task, m *models.TaskMetrics, completedAt time.Time) {
+	r := u.row(TaskUser(t, m))
+	r.Tasks++
+	if t.Success != nil && *t.Success {
+		r.Succeeded++
+	}
+	if t.StartedAt != nil {
+		if ms := completedAt.Sub(*t.StartedAt).Milliseconds(); ms > 0 {
+			r.DurationMs += ms
+		}
+	}
+	r.TaskCostUSD += costFieldsFrom(m).costUSD
+	cf := codeFieldsFrom(m)
+	r.NetLines += cf.linesAdded - cf.linesRemoved
+}
+
+// addSessions counts the non-task sessions that ended inside the window,
+// the same ones ComputeOverhead sums.
+func (u userTally) addSessions(sessions []*models.SessionMetrics, windowStart, windowEnd time.Time) {
+	for _, s := range sessions {
+		if s == nil || !inWindow(s.EndedAt, windowStart, windowEnd) {
+			continue
+		}
+		r := u.row(s.StartedBy)
+		r.Sessions++
+		if s.CostUSD != nil {
+			r.SessionCostUSD += *s.CostUSD
+		}
+	}
+}
+
+// rows finalizes the rates, highest total spend first, then most tasks,
+// then identity for determinism.
+func (u userTally) rows() []UserRow {
+	out := make([]UserRow, 0, len(u))
+	for _, r := range u {
+		row := *r
+		if row.Tasks > 0 {
+			row.SuccessRate = float64(row.Succeeded) / float64(row.Tasks)
+		}
+		row.TotalCostUSD = row.TaskCostUSD + row.SessionCostUSD
+		out = append(out, row)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.TotalCostUSD != b.TotalCostUSD {
+			return a.TotalCostUSD > b.TotalCostUSD
+		}
+		if a.Tasks != b.Tasks {
+			return a.Tasks > b.Tasks
+		}
+		return a.User < b.User
+	})
+	return out
+}
+
+// WithSessionUsers folds non-task sessions into rows computed from tasks
+// alone — the rollups' testable seams don't read session metrics, so the
+// disk-backed loaders add them afterwards.
+func WithSessionUsers(rows []UserRow, sessions []*models.SessionMetrics, windowStart, windowEnd time.Time) []UserRow {
+	u := newUserTally()
+	for i := range rows {
+		r := rows[i]
+		u[r.User] = &r
+	}
+	u.addSessions(sessions, windowStart, windowEnd)
+	return u.rows()
+}
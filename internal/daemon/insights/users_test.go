@@ -0,0 +1,101 @@
+package insights
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestTaskUserPrecedence(t *testing.T) {
+	task := &models.Task{CreatedBy: "creator", StartedBy: "starter"}
+	if got := TaskUser(task, &models.TaskMetrics{StartedBy: "metrics-starter", CreatedBy: "x"}); got != "metrics-starter" {
+		t.Errorf("metrics starter: got %q", got)
+	}
+	if got := TaskUser(task, &models.TaskMetrics{CreatedBy: "metrics-creator"}); got != "starter" {
+		t.Errorf("task starter over metrics creator: got %q", got)
+	}
+	if got := TaskUser(&models.Task{CreatedBy: "creator"}, &models.TaskMetrics{CreatedBy: "metrics-creator"}); got != "metrics-creator" {
+		t.Errorf("metrics creator: got %q", got)
+	}
+	if got := TaskUser(&models.Task{CreatedBy: "creator"}, nil); got != "creator" {
+		t.Errorf("task creator: got %q", got)
+	}
+	if got := TaskUser(&models.Task{}, nil); got != "" {
+		t.Errorf("unattributed: got %q", got)
+	}
+}
+
+func TestComputeProjectInsightsUsers(t *testing.T) {
+	t.Parallel()
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 12, 0, 0, 0, time.UTC) }
+	cost := func(v float64) *float64 { return &v }
+
+	t1 := makeTask(1, "claude-code", true, day(2).Add(-10*time.Minute), day(2))
+	t1.CreatedAt, t1.CreatedBy, t1.StartedBy = day(1), "jane", "sam"
+	t2 := makeTask(2, "codex", false, day(3).Add(-5*time.Minute), day(3))
+	t2.CreatedAt, t2.CreatedBy = day(2), "jane"
+	t3 := makeTask(3, "codex", true, day(4).Add(-5*time.Minute), day(4))
+	t3.CreatedAt = day(3)
+	// Created in window, not done yet: counts as created only.
+	t4 := &models.Task{TaskNumber: 4, Status: models.TaskStatusReady, CreatedAt: day(5), CreatedBy: "sam"}
+	tasks := []*models.Task{t1, t2, t3, t4}
+
+	metrics := map[int]*models.TaskMetrics{
+		1: {TaskNumber: 1, CostUSD: cost(3)},
+		2: {TaskNumber: 2, CostUSD: cost(1)},
+	}
+	p := ComputeProjectInsightsForTasks("proj-a", day(1), day(30), tasks,
+		func(t *models.Task) *models.TaskMetrics { return metrics[t.TaskNumber] }, nil)
+
+	byUser := map[string]UserRow{}
+	for _, r := range p.Users {
+		byUser[r.User] = r
+	}
+	if len(p.Users) != 3 {
+		t.Fatalf("Users = %+v, want sam, jane and unattributed", p.Users)
+	}
+	if p.Users[0].User != "sam" {
+		t.Errorf("Users[0] = %q, want sam (highest spend)", p.Users[0].User)
+	}
+	if sam := byUser["sam"]; sam.Tasks != 1 || sam.Succeeded != 1 || sam.TasksCreated != 1 || sam.TotalCostUSD != 3 {
+		t.Errorf("sam = %+v", sam)
+	}
+	if jane := byUser["jane"]; jane.Tasks != 1 || jane.SuccessRate != 0 || jane.TasksCreated != 2 || jane.TaskCostUSD != 1 {
+		t.Errorf("jane = %+v", jane)
+	}
+	if none := byUser[""]; none.Tasks != 1 || none.TasksCreated != 1 || none.UserLabel() != "(unattributed)" {
+		t.Errorf("unattributed = %+v", none)
+	}
+}
+
+func TestWithSessionUsers(t *testing.T) {
+	windowEnd := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+	windowStart := windowEnd.AddDate(0, 0, -7)
+	cost := func(v float64) *float64 { return &v }
+	rows := []UserRow{
+		{User: "jane", Tasks: 2, Succeeded: 1, TaskCostUSD: 2},
+	}
+	sessions := []*models.SessionMetrics{
+		{Mode: "chat", StartedBy: "jane", EndedAt: windowEnd.Add(-time.Hour), CostUSD: cost(0.5)},
+		{Mode: "chat", StartedBy: "telegram:42", EndedAt: windowEnd.Add(-time.Hour), CostUSD: cost(4)},
+		{Mode: "chat", StartedBy: "jane", EndedAt: windowStart.Add(-time.Hour), CostUSD: cost(100)}, // before window
+		nil,
+	}
+
+	got := WithSessionUsers(rows, sessions, windowStart, windowEnd)
+	if len(got) != 2 || got[0].User != "telegram:42" || got[1].User != "jane" {
+		t.Fatalf("rows = %+v, want telegram:42 then jane", got)
+	}
+	jane := got[1]
+	if jane.Sessions != 1 || math.Abs(jane.SessionCostUSD-0.5) > 1e-9 || math.Abs(jane.TotalCostUSD-2.5) > 1e-9 {
+		t.Errorf("jane = %+v", jane)
+	}
+	if jane.SuccessRate != 0.5 {
+		t.Errorf("jane success rate = %v, want 0.5", jane.SuccessRate)
+	}
+	if rows[0].Sessions != 0 {
+		t.Errorf("input rows mutated: %+v", rows[0])
+	}
+}
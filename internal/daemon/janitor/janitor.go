@@ -0,0 +1,446 @@
+// Package janitor enforces retention policies on what Watchfire
+// accumulates over time: session logs (with their transcripts, event logs
+// and recordings), the insights and diff caches under ~/.watchfire, relay
+// deliveries in the outbox's dead-letter store, and watchfire/* branches
+// left behind by finished or deleted tasks.
+//
+// Run plans and (unless DryRun) applies one pass; it backs both the
+// periodic daemon Janitor and `watchfire gc`.
+package janitor
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/agent"
+	"github.com/watchfire-io/watchfire/internal/daemon/diff"
+	"github.com/watchfire-io/watchfire/internal/daemon/insights"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	"github.com/watchfire-io/watchfire/internal/logsearch"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// Item kinds.
+const (
+	KindLog           = "log"
+	KindRecording     = "recording"
+	KindInsightsCache = "insights_cache"
+	KindDiffCache     = "diff_cache"
+	KindDeadDelivery  = "dead_delivery"
+	KindBranch        = "branch"
+)
+
+// Item is one thing a pass removes (or, on a dry run, would remove).
+type Item struct {
+	Kind      string
+	ProjectID string // empty for fleet-wide caches
+	Path      string // file or directory path; branch name for KindBranch
+	Bytes     int64  // 0 for branches
+	Reason    string
+
+	logID       string
+	taskNumber  int
+	projectPath string
+}
+
+// Report is the outcome of one pass.
+type Report struct {
+	DryRun bool
+	Items  []Item
+	Errors []string // removals that failed; those items are not in Items
+}
+
+// TotalBytes sums the size of every item in the report.
+func (r *Report) TotalBytes() int64 {
+	var n int64
+	for _, it := range r.Items {
+		n += it.Bytes
+	}
+	return n
+}
+
+// Options controls a pass.
+type Options struct {
+	DryRun bool
+	// ProjectID limits the pass to one project. Fleet-wide caches are
+	// skipped when set.
+	ProjectID string
+	// Scheduled marks a periodic pass: projects whose effective policy
+	// has Enabled=false are skipped. Manual passes ignore Enabled.
+	Scheduled bool
+	// Busy reports whether an agent is working on the task right now;
+	// its branch is never touched. May be nil.
+	Busy func(projectID string, taskNumber int) bool
+	Now  time.Time
+}
+
+// project is a registered project with its effective policy.
+type project struct {
+	id, path string
+	policy   models.RetentionConfig
+}
+
+// Run plans a retention pass and, unless opts.DryRun, applies it.
+func Run(opts Options) (*Report, error) {
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	settings, err := config.LoadSettings()
+	if err != nil {
+		return nil, fmt.Errorf("load settings: %w", err)
+	}
+	global := *settings.Retention
+
+	projects, err := registeredProjects(global)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ProjectID != "" {
+		if _, ok := projects[opts.ProjectID]; !ok {
+			return nil, fmt.Errorf("project not found: %s", opts.ProjectID)
+		}
+	}
+
+	// policyFor returns the policy for a project ID, falling back to the
+	// global one for projects that are no longer registered.
+	policyFor := func(id string) (models.RetentionConfig, bool) {
+		if p, ok := projects[id]; ok {
+			return p.policy, !opts.Scheduled || p.policy.Enabled
+		}
+		return global, !opts.Scheduled || global.Enabled
+	}
+	wanted := func(id string) bool { return opts.ProjectID == "" || opts.ProjectID == id }
+
+	report := &Report{DryRun: opts.DryRun}
+	var items []Item
+
+	logIDs, err := config.LogProjectIDs()
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("list logs: %v", err))
+	}
+	for _, id := range logIDs {
+		policy, ok := policyFor(id)
+		if !wanted(id) || !ok {
+			continue
+		}
+		items = append(items, planLogs(id, policy, *settings.Recordings, opts.Now, report)...)
+	}
+
+	items = append(items, planInsightsCache(projects, global, opts, policyFor)...)
+	items = append(items, planDiffCache(projects, opts, policyFor)...)
+	items = append(items, planDeadDeliveries(global, opts, report)...)
+
+	for _, p := range projects {
+		if !wanted(p.id) || (opts.Scheduled && !p.policy.Enabled) {
+			continue
+		}
+		items = append(items, planBranches(p, opts, report)...)
+	}
+
+	if opts.DryRun {
+		report.Items = items
+		return report, nil
+	}
+	for _, it := range items {
+		if err := remove(it); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s %s: %v", it.Kind, it.Path, err))
+			continue
+		}
+		if it.ProjectID != "" {
+			config.ProjectLogf(it.ProjectID, "[janitor] removed %s %s (%s)", it.Kind, it.Path, it.Reason)
+		}
+		report.Items = append(report.Items, it)
+	}
+	if len(report.Items) > 0 {
+		log.Printf("[janitor] removed %d item(s), %d bytes", len(report.Items), report.TotalBytes())
+	}
+	return report, nil
+}
+
+func registeredProjects(global models.RetentionConfig) (map[string]project, error) {
+	index, err := config.LoadProjectsIndex()
+	if err != nil {
+		return nil, fmt.Errorf("load projects index: %w", err)
+	}
+	out := make(map[string]project, len(index.Projects))
+	for _, e := range index.Projects {
+		p, loadErr := config.LoadProject(e.Path)
+		if loadErr != nil {
+			// Unreadable project.yaml: fall back to the global policy
+			// rather than treating the project as unregistered.
+			p = nil
+		}
+		out[e.ProjectID] = project{id: e.ProjectID, path: e.Path, policy: p.EffectiveRetention(global)}
+	}
+	return out, nil
+}
+
+// planLogs selects expired session logs, then recordings that outlive
+// the recordings policy on logs that are being kept.
+func planLogs(projectID string, policy models.RetentionConfig, rec models.RecordingsConfig, now time.Time, report *Report) []Item {
+	sessions, err := config.ListLogSessions(projectID)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("list logs for %s: %v", projectID, err))
+		return nil
+	}
+	var items []Item
+	gone := map[string]bool{}
+	for _, s := range config.ExpiredSessions(sessions, policy, now) {
+		items = append(items, Item{
+			Kind: KindLog, ProjectID: projectID, Path: s.Paths[0], Bytes: s.Bytes, Reason: s.Reason, logID: s.LogID,
+		})
+		for _, p := range s.Paths {
+			gone[p] = true
+		}
+	}
+	recs, err := config.ExpiredRecordings(projectID, rec, now)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("list recordings for %s: %v", projectID, err))
+	}
+	for _, f := range recs {
+		if gone[f.Path] {
+			continue
+		}
+		items = append(items, Item{Kind: KindRecording, ProjectID: projectID, Path: f.Path, Bytes: f.Bytes, Reason: f.Reason})
+	}
+	return items
+}
+
+// planInsightsCache selects rollup cache files for unregistered projects
+// and files older than the max age. Caches rebuild on the next read.
+func planInsightsCache(projects map[string]project, global models.RetentionConfig, opts Options, policyFor func(string) (models.RetentionConfig, bool)) []Item {
+	dir, err := cacheDir(insights.CacheDirName)
+	if err != nil {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var items []Item
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		info, infoErr := e.Info()
+		if infoErr != nil {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if name == insights.GlobalCacheFile {
+			if opts.ProjectID != "" || (opts.Scheduled && !global.Enabled) {
+				continue
+			}
+			if reason := ageReason(info.ModTime(), global.MaxAgeDays, opts.Now); reason != "" {
+				items = append(items, Item{Kind: KindInsightsCache, Path: path, Bytes: info.Size(), Reason: reason})
+			}
+			continue
+		}
+		id := strings.TrimSuffix(name, ".json")
+		if opts.ProjectID != "" && opts.ProjectID != id {
+			continue
+		}
+		if _, ok := projects[id]; !ok {
+			items = append(items, Item{Kind: KindInsightsCache, ProjectID: id, Path: path, Bytes: info.Size(), Reason: "project no longer registered"})
+			continue
+		}
+		policy, ok := policyFor(id)
+		if !ok {
+			continue
+		}
+		if reason := ageReason(info.ModTime(), policy.MaxAgeDays, opts.Now); reason != "" {
+			items = append(items, Item{Kind: KindInsightsCache, ProjectID: id, Path: path, Bytes: info.Size(), Reason: reason})
+		}
+	}
+	return items
+}
+
+// planDiffCache selects per-task diff caches whose project or task is
+// gone, or that are older than the max age.
+func planDiffCache(projects map[string]project, opts Options, policyFor func(string) (models.RetentionConfig, bool)) []Item {
+	dir, err := cacheDir(diff.CacheDirName)
+	if err != nil {
+		return nil
+	}
+	projectDirs, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var items []Item
+	for _, pd := range projectDirs {
+		id := pd.Name()
+		if !pd.IsDir() || (opts.ProjectID != "" && opts.ProjectID != id) {
+			continue
+		}
+		path := filepath.Join(dir, id)
+		p, registered := projects[id]
+		if !registered {
+			items = append(items, Item{Kind: KindDiffCache, ProjectID: id, Path: path, Bytes: dirSize(path), Reason: "project no longer registered"})
+			continue
+		}
+		policy, ok := policyFor(id)
+		if !ok {
+			continue
+		}
+		files, _ := os.ReadDir(path)
+		for _, f := range files {
+			info, infoErr := f.Info()
+			if f.IsDir() || infoErr != nil {
+				continue
+			}
+			filePath := filepath.Join(path, f.Name())
+			reason := ageReason(info.ModTime(), policy.MaxAgeDays, opts.Now)
+			if n, convErr := strconv.Atoi(strings.TrimSuffix(f.Name(), ".json")); convErr == nil {
+				if t, _ := config.LoadTask(p.path, n); t == nil {
+					reason = fmt.Sprintf("task #%d deleted", n)
+				}
+			}
+			if reason != "" {
+				items = append(items, Item{Kind: KindDiffCache, ProjectID: id, Path: filePath, Bytes: info.Size(), Reason: reason})
+			}
+		}
+	}
+	return items
+}
+
+// planDeadDeliveries selects relay deliveries that have sat in the
+// outbox's dead-letter store for longer than the global max age. Nothing
+// else removes them: only a replay takes one out, and a letter nobody
+// replayed by then records an outage long past.
+func planDeadDeliveries(global models.RetentionConfig, opts Options, report *Report) []Item {
+	if opts.ProjectID != "" || (opts.Scheduled && !global.Enabled) {
+		return nil
+	}
+	dir, err := config.GlobalRelayOutboxDir()
+	if err != nil {
+		return nil
+	}
+	outbox := relay.NewOutbox(dir)
+	dead, err := outbox.Dead()
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("list dead deliveries: %v", err))
+		return nil
+	}
+	var items []Item
+	for _, dl := range dead {
+		reason := ageReason(dl.DeadAt, global.MaxAgeDays, opts.Now)
+		if reason == "" {
+			continue
+		}
+		path := outbox.DeadPath(dl.ID)
+		var size int64
+		if info, statErr := os.Stat(path); statErr == nil {
+			size = info.Size()
+		}
+		items = append(items, Item{Kind: KindDeadDelivery, Path: path, Bytes: size, Reason: reason})
+	}
+	return items
+}
+
+// planBranches selects watchfire/* branches whose task is done, trashed
+// or gone and whose tip is older than BranchMaxAgeDays. These are mostly
+// failed tasks: RemoveWorktree keeps unmerged branches on purpose, so
+// nothing else ever deletes them. A done task still awaiting merge
+// approval is kept however old: its branch is the work waiting on a
+// person.
+func planBranches(p project, opts Options, report *Report) []Item {
+	if p.policy.BranchMaxAgeDays <= 0 {
+		return nil
+	}
+	cmd := exec.Command("git", "for-each-ref", "--format=%(refname:short)|%(committerdate:unix)", "refs/heads/watchfire/")
+	cmd.Dir = p.path
+	out, err := cmd.Output()
+	if err != nil {
+		// Not a git repo (or git missing) — nothing to collect.
+		return nil
+	}
+	maxAge := time.Duration(p.policy.BranchMaxAgeDays) * 24 * time.Hour
+	var items []Item
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		name, tsStr, ok := strings.Cut(strings.TrimSpace(line), "|")
+		if !ok {
+			continue
+		}
+		n, convErr := strconv.Atoi(strings.TrimPrefix(name, "watchfire/"))
+		ts, tsErr := strconv.ParseInt(tsStr, 10, 64)
+		if convErr != nil || tsErr != nil || n <= 0 {
+			continue
+		}
+		age := opts.Now.Sub(time.Unix(ts, 0))
+		if age <= maxAge || (opts.Busy != nil && opts.Busy(p.id, n)) {
+			continue
+		}
+		t, loadErr := config.LoadTask(p.path, n)
+		if loadErr != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("load task #%d in %s: %v", n, p.id, loadErr))
+			continue
+		}
+		var reason string
+		switch {
+		case t == nil:
+			reason = "task deleted"
+		case t.DeletedAt != nil:
+			reason = "task in trash"
+		case t.Status == models.TaskStatusDone && t.AwaitingApprovalSince != nil:
+			continue // merge never approved or rejected
+		case t.Status == models.TaskStatusDone:
+			reason = "task done"
+		default:
+			continue // draft/ready work in progress
+		}
+		reason = fmt.Sprintf("%s, last commit %dd ago", reason, int(age.Hours()/24))
+		items = append(items, Item{Kind: KindBranch, ProjectID: p.id, Path: name, Reason: reason, taskNumber: n, projectPath: p.path})
+	}
+	return items
+}
+
+func remove(it Item) error {
+	switch it.Kind {
+	case KindLog:
+		if err := config.DeleteLog(it.ProjectID, it.logID); err != nil {
+			return err
+		}
+		_ = logsearch.RemoveLog(it.ProjectID, it.logID)
+		return nil
+	case KindBranch:
+		// merged=true forces `git branch -D`: these branches are past
+		// retention precisely because the safe delete refused them.
+		return agent.RemoveWorktree(it.projectPath, it.taskNumber, true)
+	default:
+		return os.RemoveAll(it.Path)
+	}
+}
+
+func ageReason(modTime time.Time, maxAgeDays int, now time.Time) string {
+	if maxAgeDays > 0 && now.Sub(modTime) > time.Duration(maxAgeDays)*24*time.Hour {
+		return fmt.Sprintf("older than %dd", maxAgeDays)
+	}
+	return ""
+}
+
+func cacheDir(name string) (string, error) {
+	dir, err := config.GlobalDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+func dirSize(dir string) int64 {
+	var n int64
+	_ = filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			n += info.Size()
+		}
+		return nil
+	})
+	return n
+}
@@ -0,0 +1,255 @@
+package janitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// setupProject registers a git-backed project under a temp HOME with
+// three watchfire/* branches, all with a tip commit 60 days old:
+// 0001 for a done task, 0002 for a ready task, 0003 for a deleted task.
+// The project opts into branch cleanup after 30 days.
+func setupProject(t *testing.T) (projectID, projectPath string) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	old := time.Now().Add(-60 * 24 * time.Hour).Format(time.RFC3339)
+
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_COMMITTER_DATE="+old, "GIT_AUTHOR_DATE="+old)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q", "-b", "main")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test")
+	git("config", "commit.gpgsign", "false")
+	git("commit", "-q", "--allow-empty", "-m", "init")
+	for _, b := range []string{"watchfire/0001", "watchfire/0002", "watchfire/0003"} {
+		git("branch", b)
+	}
+
+	p := models.NewProject("proj-gc", "gc", dir)
+	policy := models.DefaultRetention()
+	policy.BranchMaxAgeDays = 30
+	p.Retention = &policy
+	if err := config.SaveProject(dir, p); err != nil {
+		t.Fatal(err)
+	}
+	for n, status := range map[int]models.TaskStatus{1: models.TaskStatusDone, 2: models.TaskStatusReady} {
+		task := models.NewTask(fmt.Sprintf("task%04d", n), n, "task", "do it")
+		task.Status = status
+		if err := config.SaveTask(dir, task); err != nil {
+			t.Fatal(err)
+		}
+	}
+	index := models.NewProjectsIndex()
+	index.AddProject(models.ProjectEntry{ProjectID: p.ProjectID, Name: p.Name, Path: dir})
+	if err := config.SaveProjectsIndex(index); err != nil {
+		t.Fatal(err)
+	}
+	return p.ProjectID, dir
+}
+
+func kinds(items []Item) []string {
+	var out []string
+	for _, it := range items {
+		out = append(out, it.Kind+":"+it.Path)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// A dry run reports stale branches (done and deleted tasks, never the
+// ready one) and caches of unregistered projects without touching them;
+// the real run then removes exactly those.
+func TestRunDryRunThenApply(t *testing.T) {
+	projectID, projectPath := setupProject(t)
+	global, _ := config.GlobalDir()
+	orphanCache := filepath.Join(global, "insights-cache", "gone.json")
+	if err := os.MkdirAll(filepath.Dir(orphanCache), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(orphanCache, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	liveCache := filepath.Join(global, "insights-cache", projectID+".json")
+	if err := os.WriteFile(liveCache, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Run(Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	want := []string{"branch:watchfire/0001", "branch:watchfire/0003", "insights_cache:" + orphanCache}
+	if got := kinds(report.Items); !equal(got, want) {
+		t.Fatalf("dry run items = %v, want %v", got, want)
+	}
+	if _, err := os.Stat(orphanCache); err != nil {
+		t.Fatal("dry run removed a file")
+	}
+
+	if _, err := Run(Options{}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	out, _ := exec.Command("git", "-C", projectPath, "branch", "--list", "watchfire/*").Output()
+	if got := string(out); got != "  watchfire/0002\n" {
+		t.Errorf("branches left = %q, want only watchfire/0002", got)
+	}
+	if _, err := os.Stat(orphanCache); !os.IsNotExist(err) {
+		t.Error("orphan insights cache survived")
+	}
+	if _, err := os.Stat(liveCache); err != nil {
+		t.Error("live project's insights cache was removed")
+	}
+}
+
+// A done task whose merge is still held for approval keeps its branch
+// however old — a restart dropped the request, not the work.
+func TestRunKeepsBranchAwaitingApproval(t *testing.T) {
+	_, projectPath := setupProject(t)
+	task, err := config.LoadTask(projectPath, 1)
+	if err != nil || task == nil {
+		t.Fatalf("load task: %v", err)
+	}
+	task.HoldForApproval(time.Now().Add(-45 * 24 * time.Hour))
+	if err := config.SaveTask(projectPath, task); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Run(Options{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := kinds(report.Items); !equal(got, []string{"branch:watchfire/0003"}) {
+		t.Errorf("items with a held task = %v, want only the deleted task's branch", got)
+	}
+}
+
+// Branch cleanup force-deletes unmerged work, so the default policy
+// leaves every branch alone.
+func TestRunKeepsBranchesByDefault(t *testing.T) {
+	_, projectPath := setupProject(t)
+	p, _ := config.LoadProject(projectPath)
+	p.Retention = nil
+	if err := config.SaveProject(projectPath, p); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Run(Options{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 0 {
+		t.Errorf("default policy items = %v, want none", kinds(report.Items))
+	}
+}
+
+// Busy tasks keep their branch, and a scheduled pass skips projects
+// whose own policy turns the janitor off.
+func TestRunRespectsBusyAndProjectOptOut(t *testing.T) {
+	projectID, projectPath := setupProject(t)
+
+	report, err := Run(Options{DryRun: true, Busy: func(_ string, n int) bool { return n == 1 }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := kinds(report.Items); !equal(got, []string{"branch:watchfire/0003"}) {
+		t.Errorf("items with busy task = %v", got)
+	}
+
+	p, _ := config.LoadProject(projectPath)
+	off := models.DefaultRetention()
+	off.Enabled = false
+	p.Retention = &off
+	if err := config.SaveProject(projectPath, p); err != nil {
+		t.Fatal(err)
+	}
+	report, err = Run(Options{DryRun: true, Scheduled: true, ProjectID: projectID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 0 {
+		t.Errorf("scheduled pass touched opted-out project: %v", kinds(report.Items))
+	}
+}
+
+type deadAdapter struct{}
+
+func (deadAdapter) ID() string                                { return "ep" }
+func (deadAdapter) Kind() string                              { return "webhook" }
+func (deadAdapter) Supports(notify.Kind) bool                 { return true }
+func (deadAdapter) Send(context.Context, relay.Payload) error { return nil }
+
+// Dead letters go once they are older than the global max age; newer
+// ones stay for a replay.
+func TestRunAgesOutDeadDeliveries(t *testing.T) {
+	setupProject(t)
+	dir, err := config.GlobalRelayOutboxDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outbox := relay.NewOutbox(dir)
+	now := time.Now()
+	kill := func(deadAt time.Time) string {
+		t.Helper()
+		dl, err := outbox.Enqueue(deadAdapter{}, relay.Payload{Kind: "TASK_FAILED"}, deadAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dl.Attempts = relay.OutboxMaxAttempts - 1
+		if dead, err := outbox.Fail(dl, errors.New("down"), deadAt); err != nil || !dead {
+			t.Fatalf("Fail = %v, %v", dead, err)
+		}
+		return dl.ID
+	}
+	old := kill(now.Add(-120 * 24 * time.Hour))
+	recent := kill(now.Add(-time.Hour))
+
+	report, err := Run(Options{Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var removed []string
+	for _, it := range report.Items {
+		if it.Kind == KindDeadDelivery {
+			removed = append(removed, it.Path)
+		}
+	}
+	if !equal(removed, []string{outbox.DeadPath(old)}) {
+		t.Fatalf("dead letters removed = %v, want only %s", removed, old)
+	}
+	left, err := outbox.Dead()
+	if err != nil || len(left) != 1 || left[0].ID != recent {
+		t.Fatalf("dead letters left = %+v, %v", left, err)
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
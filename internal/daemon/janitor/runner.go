@@ -0,0 +1,63 @@
+package janitor
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// startupDelay keeps the first pass out of the daemon's busy start.
+	startupDelay = 10 * time.Minute
+	// interval between scheduled passes. Retention limits are measured
+	// in days, so a few passes a day is plenty.
+	interval = 6 * time.Hour
+)
+
+// Janitor runs scheduled retention passes in the daemon. Each pass
+// re-reads settings, so policy edits apply from the next pass on.
+type Janitor struct {
+	busy func(projectID string, taskNumber int) bool
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+// New returns a dormant Janitor. busy reports tasks with a running
+// agent, whose branches must be left alone.
+func New(busy func(projectID string, taskNumber int) bool) *Janitor {
+	return &Janitor{busy: busy, stopCh: make(chan struct{})}
+}
+
+// Start runs the schedule in a goroutine until Stop.
+func (j *Janitor) Start() {
+	go j.run()
+}
+
+// Stop ends the schedule. Safe to call multiple times.
+func (j *Janitor) Stop() {
+	j.stopOnce.Do(func() { close(j.stopCh) })
+}
+
+func (j *Janitor) run() {
+	wait := startupDelay
+	for {
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-j.stopCh:
+			t.Stop()
+			return
+		}
+		wait = interval
+
+		report, err := Run(Options{Scheduled: true, Busy: j.busy})
+		if err != nil {
+			log.Printf("[janitor] pass failed: %v", err)
+			continue
+		}
+		for _, e := range report.Errors {
+			log.Printf("[janitor] %s", e)
+		}
+	}
+}
@@ -29,6 +29,7 @@ type CodeStats struct {
 	LinesRemoved int
 	Merged       bool
 	MergeKind    models.MergeKind
+	CommitSHAs   []string
 }
 
 // RecordCodeStats merges code-output stats into a task's `<n>.metrics.yaml`,
@@ -63,6 +64,7 @@ func RecordCodeStats(projectPath, projectID string, t *models.Task, cs CodeStats
 	m.NetLines = cs.LinesAdded - cs.LinesRemoved
 	m.Merged = cs.Merged
 	m.MergeKind = cs.MergeKind
+	m.CommitSHAs = cs.CommitSHAs
 
 	if writeErr := config.WriteMetrics(projectPath, m); writeErr != nil {
 		log.Printf("[metrics] failed to persist code stats for project %s task #%04d: %v", projectID, t.TaskNumber, writeErr)
@@ -84,6 +86,8 @@ func BuildBaseMetrics(projectID string, t *models.Task) *models.TaskMetrics {
 		DurationMs: durationMs(t),
 		ExitReason: exit,
 		CapturedAt: time.Now().UTC(),
+		CreatedBy:  t.CreatedBy,
+		StartedBy:  t.StartedBy,
 	}
 }
 
@@ -99,13 +103,13 @@ var (
 // written asynchronously by `agent.Manager.writeSessionLog` after the
 // agent process exits, so this helper polls briefly for the log to
 // appear before parsing it. A missed log just means duration-only
-// metrics — still better than nothing.
-func CaptureFromTask(projectPath, projectID string, t *models.Task) {
+// metrics — still better than nothing. Returns the record Capture built.
+func CaptureFromTask(projectPath, projectID string, t *models.Task) *models.TaskMetrics {
 	if t == nil || t.TaskNumber <= 0 {
-		return
+		return nil
 	}
 	sessionLogPath := waitForSessionLog(projectID, t.TaskNumber)
-	Capture(projectPath, projectID, sessionLogPath, t)
+	return Capture(projectPath, projectID, sessionLogPath, t)
 }
 
 func waitForSessionLog(projectID string, taskNumber int) string {
@@ -125,17 +129,19 @@ func waitForSessionLog(projectID string, taskNumber int) string {
 }
 
 // Capture builds the base metrics for `t`, runs the per-backend parser
-// against `sessionLogPath`, and persists the resulting `<n>.metrics.yaml`
+// against `sessionLogPath`, estimates cost from the pricing table when
+// the backend reported none (see applyCost), and persists the resulting `<n>.metrics.yaml`
 // next to the canonical task file. Failures degrade silently (we'd
 // rather have duration-only metrics than no metrics) — parser errors are
-// logged at WARN.
+// logged at WARN. Returns the record it built (nil only when `t` is),
+// whether or not the write succeeded, so the caller can export it.
 //
 // Safe to invoke from a goroutine; all I/O is bounded to the project
 // directory + session log.
-func Capture(projectPath, projectID, sessionLogPath string, t *models.Task) {
+func Capture(projectPath, projectID, sessionLogPath string, t *models.Task) *models.TaskMetrics {
 	m := BuildBaseMetrics(projectID, t)
 	if m == nil {
-		return
+		return nil
 	}
 
 	if sessionLogPath != "" {
@@ -149,6 +155,8 @@ func Capture(projectPath, projectID, sessionLogPath string, t *models.Task) {
 			m.CostUSD = cost
 		}
 	}
+	applyCost(m, projectPath, projectID, sessionLogPath)
+	m.Model = sessionModel(projectID, sessionLogPath)
 
 	metricsFileMu.Lock()
 	defer metricsFileMu.Unlock()
@@ -164,11 +172,13 @@ func Capture(projectPath, projectID, sessionLogPath string, t *models.Task) {
 		m.NetLines = existing.NetLines
 		m.Merged = existing.Merged
 		m.MergeKind = existing.MergeKind
+		m.CommitSHAs = existing.CommitSHAs
 	}
 
 	if err := config.WriteMetrics(projectPath, m); err != nil {
 		log.Printf("[metrics] failed to persist metrics for project %s task #%04d: %v", projectID, t.TaskNumber, err)
 	}
+	return m
 }
 
 func exitReason(t *models.Task) models.MetricsExitReason {
@@ -189,8 +199,5 @@ func durationMs(t *models.Task) int64 {
 	if t.CompletedAt != nil {
 		end = *t.CompletedAt
 	}
-	if end.Before(*t.StartedAt) {
-		return 0
-	}
-	return end.Sub(*t.StartedAt).Milliseconds()
+	return t.ActiveDuration(end).Milliseconds()
 }
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
@@ -103,6 +104,9 @@ func TestCaptureWritesMetricsWithParserOutput(t *testing.T) {
 	if got.CostUSD == nil || *got.CostUSD != 0.0099 {
 		t.Errorf("CostUSD=%v want 0.0099", got.CostUSD)
 	}
+	if got.CostSource != models.CostSourceReported {
+		t.Errorf("CostSource=%q want reported", got.CostSource)
+	}
 	if got.ExitReason != models.MetricsExitCompleted {
 		t.Errorf("ExitReason=%q want completed", got.ExitReason)
 	}
@@ -132,6 +136,92 @@ func TestCaptureDegradesToDurationOnly(t *testing.T) {
 	}
 }
 
+// pinPricing swaps the settings Capture prices against for the test.
+func pinPricing(t *testing.T, prices ...models.TokenPrice) {
+	t.Helper()
+	prev := loadSettings
+	loadSettings = func() *models.Settings {
+		s := models.NewSettings()
+		s.Pricing = &models.PricingConfig{Prices: prices}
+		return s
+	}
+	t.Cleanup(func() { loadSettings = prev })
+}
+
+// TestCaptureEstimatesCostFromEvents covers a backend whose log has no
+// cost line: tokens and cost come from the session's event log, each
+// turn priced at its model's row (longest prefix wins) with cache reads
+// at the cached rate.
+func TestCaptureEstimatesCostFromEvents(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	pinPricing(t,
+		models.TokenPrice{Backend: "claude-code", InputPerMTok: 3, OutputPerMTok: 15, CachedPerMTok: 0.3},
+		models.TokenPrice{Backend: "claude-code", Model: "claude-opus-4", InputPerMTok: 15, OutputPerMTok: 75, CachedPerMTok: 1.5},
+	)
+	dir := t.TempDir()
+	if err := os.MkdirAll(config.ProjectTasksDir(dir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	entry, err := config.WriteLog("proj-est", 4, 1, "claude-code", "task", "completed", time.Now(), []string{"no summary line"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WriteSessionEvents("proj-est", entry.LogID, []models.SessionEvent{
+		{Type: models.SessionEventTokenUsage, Model: "claude-sonnet-4-5", TokensIn: 1_000_000, TokensOut: 100_000},
+		{Type: models.SessionEventTokenUsage, Model: "claude-opus-4-1", TokensIn: 100_000, CacheReadTokens: 1_000_000},
+		{Type: models.SessionEventShellCommand, Command: "make"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	logPath := LocateSessionLog("proj-est", 4)
+
+	m := Capture(dir, "proj-est", logPath, newDoneTask(4, "claude-code", true, 1_000))
+	// sonnet: 3 + 1.5; opus: 1.5 + 1.5
+	if m.CostUSD == nil || math.Abs(*m.CostUSD-7.5) > 1e-9 {
+		t.Fatalf("CostUSD=%v want 7.5", m.CostUSD)
+	}
+	if m.CostSource != models.CostSourceEstimated {
+		t.Errorf("CostSource=%q want estimated", m.CostSource)
+	}
+	if m.TokensIn == nil || *m.TokensIn != 1_100_000 || m.TokensOut == nil || *m.TokensOut != 100_000 {
+		t.Errorf("tokens = %v/%v, want summed from events", m.TokensIn, m.TokensOut)
+	}
+	if m.TokensCached == nil || *m.TokensCached != 1_000_000 {
+		t.Errorf("TokensCached=%v want 1000000", m.TokensCached)
+	}
+	if m.Model != "claude-sonnet-4-5" {
+		t.Errorf("Model=%q want the model with the most output", m.Model)
+	}
+}
+
+// TestCaptureEstimatesCostFromParserTokens covers a log with a token
+// summary but no cost and no event log: the backend's fallback row
+// prices the parser's totals. A backend absent from the table stays
+// missing rather than being priced at zero.
+func TestCaptureEstimatesCostFromParserTokens(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	pinPricing(t, models.TokenPrice{Backend: "codex", InputPerMTok: 2, OutputPerMTok: 8})
+	dir := t.TempDir()
+	if err := os.MkdirAll(config.ProjectTasksDir(dir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(dir, "codex.log")
+	if err := os.WriteFile(logPath, []byte("tokens: input=500000 output=250000\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := Capture(dir, "proj", logPath, newDoneTask(2, "codex", true, 1_000))
+	if m.CostUSD == nil || math.Abs(*m.CostUSD-3) > 1e-9 || m.CostSource != models.CostSourceEstimated {
+		t.Fatalf("cost = %v (%q), want estimated 3", m.CostUSD, m.CostSource)
+	}
+
+	pinPricing(t)
+	m = Capture(dir, "proj", logPath, newDoneTask(3, "codex", true, 1_000))
+	if m.CostUSD != nil || m.CostSource != "" {
+		t.Errorf("unpriced backend got cost %v (%q), want none", m.CostUSD, m.CostSource)
+	}
+}
+
 func TestCaptureNilTaskNoOp(t *testing.T) {
 	dir := t.TempDir()
 	Capture(dir, "p", "", nil)
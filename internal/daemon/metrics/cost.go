@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/agent/backend"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// loadSettings reads settings.yaml for the pricing table and default
+// agent. A variable so tests can pin a table without writing settings.
+var loadSettings = func() *models.Settings {
+	s, err := config.LoadSettings()
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+// applyCost stamps m.CostSource. A cost the parser found is "reported";
+// otherwise the cost is estimated from token counts and the pricing
+// table. The session's event log is preferred for the estimate because
+// it carries per-turn models and cache reads — and it fills in token
+// counts for backends whose parser finds none. With no priceable tokens
+// CostUSD stays nil and the task remains "missing cost" in insights.
+func applyCost(m *models.TaskMetrics, projectPath, projectID, sessionLogPath string) {
+	if m.CostUSD != nil {
+		m.CostSource = models.CostSourceReported
+		return
+	}
+	settings := loadSettings()
+	var pricing *models.PricingConfig
+	if settings != nil {
+		pricing = settings.Pricing
+	}
+	agent := pricingBackend(projectPath, m.Agent, settings)
+
+	u := sessionUsage(projectID, sessionLogPath, agent, pricing)
+	if u.turns > 0 {
+		if m.TokensIn == nil {
+			in := u.in
+			m.TokensIn = &in
+		}
+		if m.TokensOut == nil {
+			out := u.out
+			m.TokensOut = &out
+		}
+		if u.cached > 0 {
+			cached := u.cached
+			m.TokensCached = &cached
+		}
+	}
+
+	var cost *float64
+	switch {
+	case u.turns > 0 && u.priced:
+		c := u.cost
+		cost = &c
+	case m.TokensIn != nil || m.TokensOut != nil:
+		if p := pricing.Lookup(agent, ""); p != nil {
+			c := p.Cost(deref(m.TokensIn), deref(m.TokensOut), 0)
+			cost = &c
+		}
+	}
+	if cost != nil {
+		m.CostUSD = cost
+		m.CostSource = models.CostSourceEstimated
+	}
+}
+
+// usageTotals sums a session's token_usage events. priced is false when
+// any turn had no matching pricing row, in which case cost is partial
+// and must not be used.
+type usageTotals struct {
+	turns           int
+	in, out, cached int64
+	cost            float64
+	priced          bool
+}
+
+func sessionUsage(projectID, sessionLogPath, backend string, pricing *models.PricingConfig) usageTotals {
+	u := usageTotals{priced: true}
+	if sessionLogPath == "" {
+		return u
+	}
+	logID := strings.TrimSuffix(filepath.Base(sessionLogPath), ".log")
+	events, err := config.ReadSessionEvents(projectID, logID)
+	if err != nil {
+		return u
+	}
+	for _, e := range events {
+		if e.Type != models.SessionEventTokenUsage {
+			continue
+		}
+		u.turns++
+		u.in += e.TokensIn
+		u.out += e.TokensOut
+		u.cached += e.CacheReadTokens
+		if p := pricing.Lookup(backend, e.Model); p != nil {
+			u.cost += p.Cost(e.TokensIn, e.TokensOut, e.CacheReadTokens)
+		} else {
+			u.priced = false
+		}
+	}
+	return u
+}
+
+// sessionModel names the model that produced the most output tokens in
+// the session's token_usage events, or "" when none named a model.
+func sessionModel(projectID, sessionLogPath string) string {
+	if sessionLogPath == "" {
+		return ""
+	}
+	logID := strings.TrimSuffix(filepath.Base(sessionLogPath), ".log")
+	events, err := config.ReadSessionEvents(projectID, logID)
+	if err != nil {
+		return ""
+	}
+	out := map[string]int64{}
+	var order []string
+	for _, e := range events {
+		if e.Type != models.SessionEventTokenUsage || e.Model == "" {
+			continue
+		}
+		if _, seen := out[e.Model]; !seen {
+			order = append(order, e.Model)
+		}
+		out[e.Model] += e.TokensOut
+	}
+	best := ""
+	for _, model := range order {
+		if best == "" || out[model] > out[best] {
+			best = model
+		}
+	}
+	return best
+}
+
+// pricingBackend resolves the backend a task ran on with the agent
+// manager's chain: task agent, project default, global default, Claude.
+func pricingBackend(projectPath, agent string, settings *models.Settings) string {
+	name := strings.TrimSpace(agent)
+	if name == "" {
+		if proj, err := config.LoadProject(projectPath); err == nil && proj != nil {
+			name = strings.TrimSpace(proj.DefaultAgent)
+		}
+	}
+	if name == "" && settings != nil {
+		name = strings.TrimSpace(settings.Defaults.DefaultAgent)
+	}
+	if name == "" {
+		name = backend.ClaudeBackendName
+	}
+	return name
+}
+
+func deref(p *int64) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
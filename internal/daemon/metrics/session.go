@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// SessionInfo describes a finished agent session that ran outside a task,
+// as known to the agent manager once its session log is on disk.
+type SessionInfo struct {
+	ProjectID   string
+	ProjectPath string
+	LogID       string
+	Agent       string
+	Mode        string
+	Phase       string
+	StartedAt   time.Time
+	EndedAt     time.Time
+	StartedBy   string
+}
+
+// CaptureSession is the non-task sibling of Capture: it parses the
+// session log with the backend's parser, estimates cost the same way
+// (applyCost), and persists `<logID>.metrics.yaml` next to the log.
+// Failures degrade to duration-only metrics, as for tasks. Returns the
+// record it built.
+func CaptureSession(info SessionInfo) *models.SessionMetrics {
+	if info.ProjectID == "" || info.LogID == "" {
+		return nil
+	}
+	var sessionLogPath string
+	if logsDir, err := config.GlobalLogsDir(); err == nil {
+		sessionLogPath = filepath.Join(logsDir, info.ProjectID, info.LogID+".log")
+	}
+
+	// Reuse the task pipeline on a scratch TaskMetrics so sessions and
+	// tasks agree on token and cost semantics.
+	tm := &models.TaskMetrics{ProjectID: info.ProjectID, Agent: info.Agent}
+	if sessionLogPath != "" {
+		in, out, cost, err := GetParser(info.Agent).Parse(sessionLogPath)
+		if err != nil {
+			log.Printf("[metrics] parser %q failed for project %s session %s: %v — writing duration-only metrics", info.Agent, info.ProjectID, info.LogID, err)
+		} else {
+			tm.TokensIn = in
+			tm.TokensOut = out
+			tm.CostUSD = cost
+		}
+	}
+	applyCost(tm, info.ProjectPath, info.ProjectID, sessionLogPath)
+
+	m := &models.SessionMetrics{
+		LogID:        info.LogID,
+		ProjectID:    info.ProjectID,
+		Agent:        info.Agent,
+		Mode:         info.Mode,
+		Phase:        info.Phase,
+		StartedAt:    info.StartedAt.UTC(),
+		EndedAt:      info.EndedAt.UTC(),
+		TokensIn:     tm.TokensIn,
+		TokensOut:    tm.TokensOut,
+		TokensCached: tm.TokensCached,
+		CostUSD:      tm.CostUSD,
+		CostSource:   tm.CostSource,
+		Model:        sessionModel(info.ProjectID, sessionLogPath),
+		CapturedAt:   time.Now().UTC(),
+		StartedBy:    info.StartedBy,
+	}
+	if info.EndedAt.After(info.StartedAt) {
+		m.DurationMs = info.EndedAt.Sub(info.StartedAt).Milliseconds()
+	}
+	if err := config.WriteSessionMetrics(m); err != nil {
+		log.Printf("[metrics] failed to persist metrics for project %s session %s: %v", info.ProjectID, info.LogID, err)
+	}
+	return m
+}
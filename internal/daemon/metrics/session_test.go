@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestCaptureSessionWritesNextToLog(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	pinPricing(t, models.TokenPrice{Backend: "claude-code", Model: "claude-sonnet-4-5", InputPerMTok: 3, OutputPerMTok: 15})
+	started := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	entry, err := config.WriteLog("proj-chat", 0, 0, "claude-code", "wildfire", "completed", started, []string{"refining"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WriteSessionEvents("proj-chat", entry.LogID, []models.SessionEvent{
+		{Type: models.SessionEventTokenUsage, Model: "claude-sonnet-4-5", TokensIn: 1_000_000, TokensOut: 100_000},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	m := CaptureSession(SessionInfo{
+		ProjectID: "proj-chat",
+		LogID:     entry.LogID,
+		Agent:     "claude-code",
+		Mode:      "wildfire",
+		Phase:     "refine",
+		StartedAt: started,
+		EndedAt:   started.Add(90 * time.Second),
+	})
+	if m == nil {
+		t.Fatal("CaptureSession returned nil")
+	}
+	if m.DurationMs != 90_000 || m.Kind() != "wildfire-refine" || m.Model != "claude-sonnet-4-5" {
+		t.Errorf("metrics = %+v", m)
+	}
+	if m.CostUSD == nil || math.Abs(*m.CostUSD-4.5) > 1e-9 || m.CostSource != models.CostSourceEstimated {
+		t.Errorf("cost = %v (%q), want estimated 4.5", m.CostUSD, m.CostSource)
+	}
+
+	got, err := config.ReadSessionMetrics("proj-chat", entry.LogID)
+	if err != nil || got == nil || got.Kind() != "wildfire-refine" || got.TokensOut == nil || *got.TokensOut != 100_000 {
+		t.Fatalf("ReadSessionMetrics = %+v, %v", got, err)
+	}
+	// The record carries spend, so retention leaves it when the log goes.
+	sessions, err := config.ListLogSessions("proj-chat")
+	if err != nil || len(sessions) != 1 || len(sessions[0].Paths) != 2 {
+		t.Fatalf("ListLogSessions = %+v, %v; want log + events", sessions, err)
+	}
+	path, _ := config.SessionMetricsPath("proj-chat", entry.LogID)
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("metrics file missing: %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,8 +23,49 @@ const (
 	KindTaskFailed   Kind = "TASK_FAILED"
 	KindRunComplete  Kind = "RUN_COMPLETE"
 	KindWeeklyDigest Kind = "WEEKLY_DIGEST"
+
+	KindBudgetThreshold Kind = "BUDGET_THRESHOLD"
+
+	KindTaskSucceeded  Kind = "TASK_SUCCEEDED"
+	KindMergeFailed    Kind = "MERGE_FAILED"
+	KindPROpened       Kind = "PR_OPENED"
+	KindAgentNeedsAuth Kind = "AGENT_NEEDS_AUTH"
+	KindRateLimited    Kind = "RATE_LIMITED"
+	KindWildfirePhase  Kind = "WILDFIRE_PHASE_CHANGED"
+	KindTasksGenerated Kind = "TASKS_GENERATED"
 )
 
+// Kinds lists every kind the relay can deliver, in the order settings
+// surfaces show them.
+var Kinds = []Kind{
+	KindTaskFailed,
+	KindRunComplete,
+	KindWeeklyDigest,
+	KindBudgetThreshold,
+	KindTaskSucceeded,
+	KindMergeFailed,
+	KindPROpened,
+	KindAgentNeedsAuth,
+	KindRateLimited,
+	KindWildfirePhase,
+	KindTasksGenerated,
+}
+
+// EventKey is the kind's config key — the lower-cased name, e.g.
+// "task_failed" — used by integration event lists and per-project
+// overrides.
+func (k Kind) EventKey() string { return strings.ToLower(string(k)) }
+
+// KindForEventKey maps a config key back to its Kind.
+func KindForEventKey(key string) (Kind, bool) {
+	for _, k := range Kinds {
+		if k.EventKey() == key {
+			return k, true
+		}
+	}
+	return "", false
+}
+
 // Notification is a single notification event fanned out over the Bus.
 type Notification struct {
 	ID         string    `json:"id"`
@@ -33,6 +75,35 @@ type Notification struct {
 	Title      string    `json:"title"`
 	Body       string    `json:"body"`
 	EmittedAt  time.Time `json:"emitted_at"`
+
+	// Budget is set on BUDGET_THRESHOLD notifications only.
+	Budget *BudgetAlert `json:"budget,omitempty"`
+
+	// Detail is the kind-specific reason: the merge error for
+	// MERGE_FAILED, the agent's message for AGENT_NEEDS_AUTH and
+	// RATE_LIMITED.
+	Detail string `json:"detail,omitempty"`
+	// URL is the pull request a PR_OPENED notification points at.
+	URL string `json:"url,omitempty"`
+	// Phase / PreviousPhase are the wildfire phases a
+	// WILDFIRE_PHASE_CHANGED notification moved between.
+	Phase         string `json:"phase,omitempty"`
+	PreviousPhase string `json:"previous_phase,omitempty"`
+	// Count is the number of tasks a TASKS_GENERATED notification
+	// reports.
+	Count int `json:"count,omitempty"`
+}
+
+// BudgetAlert carries the numbers behind a BUDGET_THRESHOLD notification
+// so relay adapters can render them without recomputing spend. Scope is
+// "global" (ProjectID empty) or "project".
+type BudgetAlert struct {
+	Scope     string  `json:"scope"`
+	Month     string  `json:"month"` // YYYY-MM, local time
+	Threshold int     `json:"threshold"`
+	SpentUSD  float64 `json:"spent_usd"`
+	LimitUSD  float64 `json:"limit_usd"`
+	HardStop  bool    `json:"hard_stop"`
 }
 
 // Bus fans Notification events out to in-process subscribers (gRPC streams,
@@ -125,9 +196,10 @@ func AppendLogLine(n Notification) error {
 }
 
 // AppendGlobalLogLine appends a single Notification record to the fleet-wide
-// `~/.watchfire/logs/digests.log`. Used for WEEKLY_DIGEST notifications which
-// span all projects and have no `ProjectID`. The tray's notifications submenu
-// reads this file in addition to the per-project `notifications.log`s.
+// `~/.watchfire/logs/digests.log`. Used for notifications which span all
+// projects and have no `ProjectID` — WEEKLY_DIGEST and the global
+// BUDGET_THRESHOLD. The tray's notifications submenu reads this file in
+// addition to the per-project `notifications.log`s.
 func AppendGlobalLogLine(n Notification) error {
 	if err := config.EnsureGlobalLogsDir(); err != nil {
 		return err
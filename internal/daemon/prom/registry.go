@@ -0,0 +1,323 @@
+// Package prom is a small Prometheus text-exposition registry for the
+// daemon's opt-in `/metrics` endpoint. It covers exactly what the daemon
+// exports — labelled counters, scrape-time gauges and fixed-bucket
+// histograms — without pulling the full client library into the binary.
+//
+// Not to be confused with internal/daemon/metrics, which captures the
+// per-task token / cost records that feed insights.
+package prom
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the Prometheus text exposition format, version 0.0.4.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// family is one metric name with its HELP / TYPE header and samples.
+type family interface {
+	metricName() string
+	write(w *bufio.Writer)
+}
+
+// Registry holds the metric families served by one endpoint.
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]family
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]family)}
+}
+
+// register adds f, replacing any family already registered under the
+// same name. Replacing (rather than rejecting) keeps scrape-time gauges
+// whose collector closes over a daemon Server correct when a test builds
+// more than one Server in the same process.
+func (r *Registry) register(f family) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.families[f.metricName()] = f
+}
+
+// Write renders every family in the text exposition format, sorted by
+// metric name so consecutive scrapes diff cleanly.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	fams := make([]family, 0, len(r.families))
+	for _, f := range r.families {
+		fams = append(fams, f)
+	}
+	r.mu.Unlock()
+	sort.Slice(fams, func(i, j int) bool { return fams[i].metricName() < fams[j].metricName() })
+
+	bw := bufio.NewWriter(w)
+	for _, f := range fams {
+		f.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler serves the registry over HTTP.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		_ = r.Write(w)
+	})
+}
+
+// CounterVec is a monotonically increasing counter partitioned by label
+// values.
+type CounterVec struct {
+	name, help string
+	labels     []string
+
+	mu     sync.Mutex
+	series map[string]*counterSeries
+}
+
+type counterSeries struct {
+	values []string
+	v      float64
+}
+
+// NewCounterVec registers a counter. By convention name ends in _total.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{name: name, help: help, labels: labels, series: make(map[string]*counterSeries)}
+	r.register(c)
+	return c
+}
+
+// Inc adds 1 to the series identified by values.
+func (c *CounterVec) Inc(values ...string) { c.Add(1, values...) }
+
+// Add adds v to the series identified by values. Calls with the wrong
+// number of label values or a negative delta are dropped — metrics are
+// best-effort and must never take the daemon down.
+func (c *CounterVec) Add(v float64, values ...string) {
+	if len(values) != len(c.labels) || v < 0 {
+		return
+	}
+	key := seriesKey(values)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.series[key]
+	if !ok {
+		s = &counterSeries{values: append([]string(nil), values...)}
+		c.series[key] = s
+	}
+	s.v += v
+}
+
+// Value returns the current value of one series (0 if never touched).
+func (c *CounterVec) Value(values ...string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if s, ok := c.series[seriesKey(values)]; ok {
+		return s.v
+	}
+	return 0
+}
+
+func (c *CounterVec) metricName() string { return c.name }
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	writeHeader(w, c.name, c.help, "counter")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range sortedKeys(c.series) {
+		s := c.series[key]
+		writeSample(w, c.name, c.labels, s.values, "", "", s.v)
+	}
+}
+
+// Sample is one labelled value returned by a GaugeFunc collector.
+type Sample struct {
+	Values []string // label values, in the order the gauge declared them
+	Value  float64
+}
+
+// GaugeFunc is a gauge computed at scrape time. It suits state the
+// daemon already tracks elsewhere (running agents) and would otherwise
+// have to mirror on every transition.
+type GaugeFunc struct {
+	name, help string
+	labels     []string
+	collect    func() []Sample
+}
+
+// NewGaugeFunc registers a scrape-time gauge, replacing any earlier
+// gauge of the same name.
+func (r *Registry) NewGaugeFunc(name, help string, labels []string, collect func() []Sample) *GaugeFunc {
+	g := &GaugeFunc{name: name, help: help, labels: labels, collect: collect}
+	r.register(g)
+	return g
+}
+
+func (g *GaugeFunc) metricName() string { return g.name }
+
+func (g *GaugeFunc) write(w *bufio.Writer) {
+	writeHeader(w, g.name, g.help, "gauge")
+	if g.collect == nil {
+		return
+	}
+	samples := g.collect()
+	sort.Slice(samples, func(i, j int) bool {
+		return seriesKey(samples[i].Values) < seriesKey(samples[j].Values)
+	})
+	for _, s := range samples {
+		if len(s.Values) != len(g.labels) {
+			continue
+		}
+		writeSample(w, g.name, g.labels, s.Values, "", "", s.Value)
+	}
+}
+
+// HistogramVec counts observations into fixed cumulative buckets,
+// partitioned by label values.
+type HistogramVec struct {
+	name, help string
+	labels     []string
+	buckets    []float64
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	values []string
+	counts []uint64 // per bucket, non-cumulative; rendered cumulatively
+	count  uint64
+	sum    float64
+}
+
+// NewHistogramVec registers a histogram with the given upper bounds. The
+// +Inf bucket is implicit.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	h := &HistogramVec{name: name, help: help, labels: labels, buckets: b, series: make(map[string]*histogramSeries)}
+	r.register(h)
+	return h
+}
+
+// Observe records v in the series identified by values. Mismatched
+// label counts and NaN observations are dropped.
+func (h *HistogramVec) Observe(v float64, values ...string) {
+	if len(values) != len(h.labels) || math.IsNaN(v) {
+		return
+	}
+	key := seriesKey(values)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{values: append([]string(nil), values...), counts: make([]uint64, len(h.buckets))}
+		h.series[key] = s
+	}
+	if i := sort.SearchFloat64s(h.buckets, v); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += v
+}
+
+// Count returns the number of observations in one series.
+func (h *HistogramVec) Count(values ...string) uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s, ok := h.series[seriesKey(values)]; ok {
+		return s.count
+	}
+	return 0
+}
+
+func (h *HistogramVec) metricName() string { return h.name }
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	writeHeader(w, h.name, h.help, "histogram")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, key := range sortedKeys(h.series) {
+		s := h.series[key]
+		var cum uint64
+		for i, ub := range h.buckets {
+			cum += s.counts[i]
+			writeSample(w, h.name+"_bucket", h.labels, s.values, "le", formatFloat(ub), float64(cum))
+		}
+		writeSample(w, h.name+"_bucket", h.labels, s.values, "le", "+Inf", float64(s.count))
+		writeSample(w, h.name+"_sum", h.labels, s.values, "", "", s.sum)
+		writeSample(w, h.name+"_count", h.labels, s.values, "", "", float64(s.count))
+	}
+}
+
+// seriesKey joins label values with a byte that cannot appear in UTF-8
+// text, so distinct value tuples never collide.
+func seriesKey(values []string) string { return strings.Join(values, "\xff") }
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	w.WriteString("# HELP " + name + " " + escapeHelp(help) + "\n")
+	w.WriteString("# TYPE " + name + " " + typ + "\n")
+}
+
+// writeSample writes one `name{labels} value` line. extraName/extraValue
+// append one more label (the histogram `le`) after the declared ones.
+func writeSample(w *bufio.Writer, name string, labels, values []string, extraName, extraValue string, v float64) {
+	w.WriteString(name)
+	if len(labels) > 0 || extraName != "" {
+		w.WriteByte('{')
+		for i, l := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(l + `="` + escapeLabel(values[i]) + `"`)
+		}
+		if extraName != "" {
+			if len(labels) > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(extraName + `="` + extraValue + `"`)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string  { return helpEscaper.Replace(s) }
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
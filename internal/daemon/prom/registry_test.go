@@ -0,0 +1,137 @@
+package prom
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// TestRegistryWrite pins the exposition output byte-for-byte: families
+// sorted by name, series sorted by label values, cumulative histogram
+// buckets with an implicit +Inf, and label / help escaping. Scrapers are
+// strict about this format, so any drift should fail loudly.
+func TestRegistryWrite(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("t_events_total", "Events.\nSecond line.", "kind")
+	c.Inc("b")
+	c.Add(2, "a")
+	c.Inc(`q"uo\te`)
+	c.Inc("too", "many") // dropped
+	c.Add(-1, "a")       // dropped
+
+	h := r.NewHistogramVec("t_latency_seconds", "Latency.", []float64{1, 0.5}, "op")
+	h.Observe(0.2, "get")
+	h.Observe(0.5, "get")
+	h.Observe(3, "get")
+
+	r.NewGaugeFunc("t_up", "Up.", nil, func() []Sample { return []Sample{{Value: 1}} })
+
+	var sb strings.Builder
+	if err := r.Write(&sb); err != nil {
+		t.Fatal(err)
+	}
+	want := `# HELP t_events_total Events.\nSecond line.
+# TYPE t_events_total counter
+t_events_total{kind="a"} 2
+t_events_total{kind="b"} 1
+t_events_total{kind="q\"uo\\te"} 1
+# HELP t_latency_seconds Latency.
+# TYPE t_latency_seconds histogram
+t_latency_seconds_bucket{op="get",le="0.5"} 2
+t_latency_seconds_bucket{op="get",le="1"} 2
+t_latency_seconds_bucket{op="get",le="+Inf"} 3
+t_latency_seconds_sum{op="get"} 3.7
+t_latency_seconds_count{op="get"} 3
+# HELP t_up Up.
+# TYPE t_up gauge
+t_up 1
+`
+	if got := sb.String(); got != want {
+		t.Errorf("exposition mismatch\n got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// TestGaugeFuncReplacedOnReregister covers the daemon building a second
+// Server in one process: the newer collector must win.
+func TestGaugeFuncReplacedOnReregister(t *testing.T) {
+	r := NewRegistry()
+	r.NewGaugeFunc("t_g", "G.", []string{"l"}, func() []Sample { return []Sample{{Values: []string{"old"}, Value: 1}} })
+	r.NewGaugeFunc("t_g", "G.", []string{"l"}, func() []Sample { return []Sample{{Values: []string{"new"}, Value: 2}} })
+
+	var sb strings.Builder
+	_ = r.Write(&sb)
+	if out := sb.String(); strings.Contains(out, "old") || !strings.Contains(out, `t_g{l="new"} 2`) {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+// TestObserveTask checks the mapping from a captured metrics record to
+// the completion counter and histograms, including records without cost
+// (backends that don't report it) and without a backend name.
+func TestObserveTask(t *testing.T) {
+	cost := 1.25
+	ObserveTask(&models.TaskMetrics{Agent: "observe-test", DurationMs: 90_000, CostUSD: &cost, ExitReason: models.MetricsExitCompleted})
+	ObserveTask(&models.TaskMetrics{Agent: "observe-test", ExitReason: models.MetricsExitFailed})
+	ObserveTask(&models.TaskMetrics{})
+	ObserveTask(nil)
+
+	if got := TasksCompleted.Value("observe-test", "success"); got != 1 {
+		t.Errorf("success = %v, want 1", got)
+	}
+	if got := TasksCompleted.Value("observe-test", "failure"); got != 1 {
+		t.Errorf("failure = %v, want 1", got)
+	}
+	if got := TasksCompleted.Value("unknown", "success"); got != 1 {
+		t.Errorf("unknown backend = %v, want 1", got)
+	}
+	if got := TaskDuration.Count("observe-test"); got != 1 {
+		t.Errorf("duration observations = %d, want 1 (zero durations skipped)", got)
+	}
+	if got := TaskCost.Count("observe-test"); got != 1 {
+		t.Errorf("cost observations = %d, want 1", got)
+	}
+}
+
+// TestServe scrapes a live endpoint and checks it shuts down with its
+// context.
+func TestServe(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounterVec("t_hits_total", "Hits.").Inc()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- serveListener(ctx, ln, r) }()
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != ContentType {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if !strings.Contains(string(body), "t_hits_total 1\n") {
+		t.Errorf("body missing counter:\n%s", body)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serve returned %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("endpoint did not stop after cancel")
+	}
+}
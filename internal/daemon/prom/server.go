@@ -0,0 +1,52 @@
+package prom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+)
+
+// shutdownDrain bounds how long an in-flight scrape may run once the
+// endpoint is told to stop.
+const shutdownDrain = 2 * time.Second
+
+// Serve binds addr and serves reg at GET /metrics until ctx is
+// cancelled. A bind failure returns immediately; once bound, Serve blocks
+// until shutdown.
+func Serve(ctx context.Context, addr string, reg *Registry) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("prom: bind %s: %w", addr, err)
+	}
+	return serveListener(ctx, listener, reg)
+}
+
+func serveListener(ctx context.Context, listener net.Listener, reg *Registry) error {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", reg.Handler())
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("[prom] Prometheus endpoint listening on http://%s/metrics", listener.Addr())
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- srv.Serve(listener) }()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownDrain)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+		return nil
+	case err := <-serveErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
@@ -0,0 +1,83 @@
+package prom
+
+import (
+	"github.com/watchfire-io/watchfire/internal/buildinfo"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// Default is the registry served by the daemon's `/metrics` endpoint.
+// Instrumentation sites update the package-level metrics below directly,
+// the same way they would with the upstream client's default registry.
+var Default = NewRegistry()
+
+// Daemon metrics. Label values are bounded: backends, modes, issue types,
+// merge kinds and relay adapter ids are all small fixed sets; project
+// and task identifiers are deliberately NOT labels.
+var (
+	// TasksCompleted counts tasks reaching status done, by backend and
+	// result (success / failure).
+	TasksCompleted = Default.NewCounterVec("watchfire_tasks_completed_total",
+		"Tasks that reached status done, by agent backend and result.", "backend", "result")
+	// TasksMerged counts finished tasks whose work landed, by how it
+	// landed: `silent` (local merge) or `auto_pr` (pull request opened).
+	TasksMerged = Default.NewCounterVec("watchfire_tasks_merged_total",
+		"Finished tasks whose branch was merged locally (silent) or opened as a pull request (auto_pr).", "kind")
+	// MergeFailures counts auto-merges that failed and left the worktree
+	// in place for a manual retry.
+	MergeFailures = Default.NewCounterVec("watchfire_merge_failures_total",
+		"Auto-merges of a finished task that failed.")
+	// AgentIssues counts agent issues (auth_required, rate_limited, ...)
+	// as they are raised.
+	AgentIssues = Default.NewCounterVec("watchfire_agent_issues_total",
+		"Agent issues raised, by issue type.", "type")
+	// RelayDeliveries counts outbound notification deliveries per adapter.
+	// result is `sent`, `failed` (retries exhausted), `skipped` (breaker
+	// open) or `dead` (moved to the outbox dead-letter store).
+	RelayDeliveries = Default.NewCounterVec("watchfire_relay_deliveries_total",
+		"Outbound relay deliveries, by adapter and result (sent, failed, skipped, dead).", "adapter", "result")
+	// RelayBreakerTrips counts closed-to-open transitions of a relay
+	// adapter's circuit breaker.
+	RelayBreakerTrips = Default.NewCounterVec("watchfire_relay_breaker_trips_total",
+		"Times a relay adapter's circuit breaker opened.", "adapter")
+	// TaskDuration is the started→completed wall time of finished tasks.
+	TaskDuration = Default.NewHistogramVec("watchfire_task_duration_seconds",
+		"Wall-clock duration of finished tasks.",
+		[]float64{60, 300, 600, 1200, 1800, 3600, 7200, 14400}, "backend")
+	// TaskCost is the reported cost of finished tasks whose backend
+	// reports one.
+	TaskCost = Default.NewHistogramVec("watchfire_task_cost_usd",
+		"Cost of finished tasks in USD, for backends that report it.",
+		[]float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 25}, "backend")
+)
+
+func init() {
+	Default.NewGaugeFunc("watchfire_build_info", "Daemon build information; always 1.",
+		[]string{"version"}, func() []Sample {
+			return []Sample{{Values: []string{buildinfo.Version}, Value: 1}}
+		})
+}
+
+// ObserveTask records a finished task's captured metrics: the completion
+// counter and the duration / cost histograms. Call it once per task —
+// on the first observed done transition — since counters can't be
+// un-counted if the same task is captured twice.
+func ObserveTask(m *models.TaskMetrics) {
+	if m == nil {
+		return
+	}
+	backend := m.Agent
+	if backend == "" {
+		backend = "unknown"
+	}
+	result := "success"
+	if m.ExitReason == models.MetricsExitFailed {
+		result = "failure"
+	}
+	TasksCompleted.Inc(backend, result)
+	if m.DurationMs > 0 {
+		TaskDuration.Observe(float64(m.DurationMs)/1000, backend)
+	}
+	if m.CostUSD != nil {
+		TaskCost.Observe(*m.CostUSD, backend)
+	}
+}
@@ -0,0 +1,341 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
+)
+
+// ApprovalPoster is implemented by the chat adapters that can post a
+// merge approval request with Approve / Reject / Retry buttons: Slack
+// (Block Kit actions), Discord (message components) and Telegram
+// (inline keyboard). The clicks come back through the inbound handlers,
+// which resolve the approval.Gate.
+type ApprovalPoster interface {
+	PostApproval(ctx context.Context, req approval.Request) error
+}
+
+// ErrNoApprovalTransport is returned by PostApproval when no configured
+// adapter can take the request.
+var ErrNoApprovalTransport = errors.New("no Slack, Discord or Telegram endpoint can receive approval requests for this project")
+
+// PostApproval posts req to every ApprovalPoster adapter that has not
+// muted the project — or only to req.Endpoints when set. Posts are sent
+// once, outside the retry / outbox path: an approval request is only
+// useful while its task waits. It fails when no adapter received it.
+func (d *Dispatcher) PostApproval(ctx context.Context, req approval.Request) error {
+	var errs []error
+	reached := 0
+	for _, a := range d.Adapters() {
+		poster, ok := a.(ApprovalPoster)
+		if !ok {
+			continue
+		}
+		if len(req.Endpoints) > 0 && !slices.Contains(req.Endpoints, a.ID()) {
+			continue
+		}
+		if mp, ok := a.(interface{ IsProjectMuted(string) bool }); ok && mp.IsProjectMuted(req.ProjectID) {
+			continue
+		}
+		if err := poster.PostApproval(ctx, req); err != nil {
+			d.logger.Printf("WARN: relay dispatcher: approval request for task #%04d via %q: %v", req.TaskNumber, a.ID(), err)
+			errs = append(errs, err)
+			continue
+		}
+		reached++
+	}
+	if reached > 0 {
+		return nil
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return ErrNoApprovalTransport
+}
+
+// approvalHeadline is the one-line title every transport leads with.
+func approvalHeadline(req approval.Request) string {
+	if req.ProjectName == "" {
+		return "Approval needed"
+	}
+	return "Approval needed — " + req.ProjectName
+}
+
+// approvalTask labels the task the way notifications do.
+func approvalTask(req approval.Request) string {
+	return taskLabel(Payload{TaskNumber: req.TaskNumber, TaskTitle: req.TaskTitle})
+}
+
+// approvalStats summarises the diff: "3 commits · 5 files · +120 −30".
+func approvalStats(req approval.Request) string {
+	return fmt.Sprintf("%s · %s · +%d −%d",
+		plural(req.Commits, "commit"), plural(req.FilesChanged, "file"), req.LinesAdded, req.LinesRemoved)
+}
+
+// approvalFileLines renders the listed files as "M path +10 −2", with a
+// trailing "… and N more" when the diff touched more than were listed.
+func approvalFileLines(req approval.Request) []string {
+	lines := make([]string, 0, len(req.Files)+1)
+	for _, f := range req.Files {
+		lines = append(lines, fmt.Sprintf("%s %s +%d −%d", fileStatusLetter(f.Status), f.Path, f.Additions, f.Deletions))
+	}
+	if more := req.FilesChanged - len(req.Files); more > 0 && len(req.Files) > 0 {
+		lines = append(lines, fmt.Sprintf("… and %d more", more))
+	}
+	return lines
+}
+
+// approvalFooter names the agent, the branch and what happens when the
+// request expires.
+func approvalFooter(req approval.Request) string {
+	parts := []string{}
+	if req.Agent != "" {
+		parts = append(parts, req.Agent)
+	}
+	if req.Branch != "" {
+		parts = append(parts, req.Branch)
+	}
+	if !req.ExpiresAt.IsZero() {
+		parts = append(parts, fmt.Sprintf("%s automatically at %s", timeoutVerb(req.OnTimeout), req.ExpiresAt.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(parts, " · ")
+}
+
+func timeoutVerb(d approval.Decision) string {
+	switch d {
+	case approval.Approve:
+		return "approves"
+	case approval.Retry:
+		return "retries"
+	default:
+		return "rejects"
+	}
+}
+
+func fileStatusLetter(status string) string {
+	switch status {
+	case "added":
+		return "A"
+	case "deleted":
+		return "D"
+	case "renamed":
+		return "R"
+	default:
+		return "M"
+	}
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// approvalButtons lists the three decisions in display order.
+var approvalButtons = []struct {
+	decision approval.Decision
+	label    string
+}{
+	{approval.Approve, "Approve"},
+	{approval.Reject, "Reject"},
+	{approval.Retry, "Retry"},
+}
+
+// PostApproval posts the request as a Block Kit message whose buttons
+// carry `watchfire_approval_<decision>` action ids; Slack delivers the
+// clicks to the interactivity endpoint.
+func (s *SlackAdapter) PostApproval(ctx context.Context, req approval.Request) error {
+	if s.endpoint.URL == "" {
+		return fmt.Errorf("slack adapter %q: webhook URL not resolved (keyring miss?)", s.endpoint.ID)
+	}
+	body, err := json.Marshal(slackApprovalMessage(req))
+	if err != nil {
+		return fmt.Errorf("slack adapter %q: encode approval: %w", s.endpoint.ID, err)
+	}
+	return s.post(ctx, body)
+}
+
+func slackApprovalMessage(req approval.Request) map[string]any {
+	mrkdwn := func(text string) map[string]any { return map[string]any{"type": "mrkdwn", "text": text} }
+	blocks := []map[string]any{
+		{"type": "header", "text": map[string]any{"type": "plain_text", "text": trimRunes(approvalHeadline(req), 150)}},
+		{"type": "section", "text": mrkdwn(fmt.Sprintf("*%s*\n%s", SlackEscape(approvalTask(req)), approvalStats(req)))},
+	}
+	if files := approvalFileLines(req); len(files) > 0 {
+		blocks = append(blocks, map[string]any{"type": "section", "text": mrkdwn("```" + strings.Join(files, "\n") + "```")})
+	}
+	if footer := approvalFooter(req); footer != "" {
+		blocks = append(blocks, map[string]any{"type": "context", "elements": []any{mrkdwn(SlackEscape(footer))}})
+	}
+	var buttons []any
+	for _, b := range approvalButtons {
+		button := map[string]any{
+			"type":      "button",
+			"action_id": approval.SlackActionPrefix + string(b.decision),
+			"text":      map[string]any{"type": "plain_text", "text": b.label},
+			"value":     req.Ref(),
+		}
+		switch b.decision {
+		case approval.Approve:
+			button["style"] = "primary"
+		case approval.Reject:
+			button["style"] = "danger"
+		}
+		buttons = append(buttons, button)
+	}
+	blocks = append(blocks, map[string]any{"type": "actions", "block_id": "watchfire_approval", "elements": buttons})
+	return map[string]any{
+		"text":   approvalHeadline(req) + ": " + approvalTask(req),
+		"blocks": blocks,
+	}
+}
+
+// Discord component types and button styles used by the approval
+// message.
+//
+// https://discord.com/developers/docs/interactions/message-components
+const (
+	discordComponentActionRow = 1
+	discordComponentButton    = 2
+
+	discordButtonSecondary = 2
+	discordButtonSuccess   = 3
+	discordButtonDanger    = 4
+)
+
+// PostApproval posts the request as an embed with a row of buttons whose
+// custom_ids carry approval.ButtonData. Discord only keeps interactive
+// components on webhooks owned by an application, so the endpoint's
+// webhook must belong to the Watchfire Discord app for the clicks to
+// reach the interactions endpoint.
+func (d *DiscordAdapter) PostApproval(ctx context.Context, req approval.Request) error {
+	if d.endpoint.URL == "" {
+		return fmt.Errorf("discord adapter %q: webhook URL not resolved (keyring miss?)", d.endpoint.ID)
+	}
+	target, err := withComponents(d.endpoint.URL)
+	if err != nil {
+		return fmt.Errorf("discord adapter %q: parse webhook URL: %w", d.endpoint.ID, err)
+	}
+	body, err := json.Marshal(discordApprovalMessage(req))
+	if err != nil {
+		return fmt.Errorf("discord adapter %q: encode approval: %w", d.endpoint.ID, err)
+	}
+	return d.post(ctx, target, body)
+}
+
+// withComponents adds `with_components=true` to a webhook URL — without
+// it Discord drops the buttons of a webhook message.
+func withComponents(webhookURL string) (string, error) {
+	target, err := url.Parse(webhookURL)
+	if err != nil {
+		return "", err
+	}
+	q := target.Query()
+	q.Set("with_components", "true")
+	target.RawQuery = q.Encode()
+	return target.String(), nil
+}
+
+func discordApprovalMessage(req approval.Request) map[string]any {
+	description := approvalTask(req) + "\n" + approvalStats(req)
+	if files := approvalFileLines(req); len(files) > 0 {
+		description += "\n```" + strings.Join(files, "\n") + "```"
+	}
+	embed := map[string]any{
+		"title":       trimRunes(approvalHeadline(req), 256),
+		"description": trimRunes(description, discordEmbedDescriptionLimit),
+		"color":       0xf59e0b,
+	}
+	if footer := approvalFooter(req); footer != "" {
+		embed["footer"] = map[string]any{"text": footer}
+	}
+	styles := map[approval.Decision]int{
+		approval.Approve: discordButtonSuccess,
+		approval.Reject:  discordButtonDanger,
+		approval.Retry:   discordButtonSecondary,
+	}
+	var buttons []any
+	for _, b := range approvalButtons {
+		buttons = append(buttons, map[string]any{
+			"type":      discordComponentButton,
+			"style":     styles[b.decision],
+			"label":     b.label,
+			"custom_id": approval.ButtonData(b.decision, req.ProjectID, req.TaskNumber),
+		})
+	}
+	return map[string]any{
+		"embeds":     []any{embed},
+		"components": []any{map[string]any{"type": discordComponentActionRow, "components": buttons}},
+	}
+}
+
+// PostApproval sends the request with an inline keyboard to every
+// unmuted paired chat. Like Send, per-chat failures are joined; the
+// request counts as delivered when any chat received it.
+func (t *TelegramAdapter) PostApproval(ctx context.Context, req approval.Request) error {
+	if t.cfg.BotToken == "" {
+		return fmt.Errorf("telegram adapter: bot token not resolved (keyring miss?)")
+	}
+	text := FormatTelegramApproval(req)
+	keyboard := [][]telegrambot.InlineKeyboardButton{make([]telegrambot.InlineKeyboardButton, 0, len(approvalButtons))}
+	for _, b := range approvalButtons {
+		keyboard[0] = append(keyboard[0], telegrambot.InlineKeyboardButton{
+			Text:         b.label,
+			CallbackData: approval.ButtonData(b.decision, req.ProjectID, req.TaskNumber),
+		})
+	}
+	var errs []error
+	sent := 0
+	for _, chat := range t.cfg.PairedChats {
+		if chat.Muted {
+			continue
+		}
+		if _, err := t.client.SendMessageWithKeyboard(ctx, t.cfg.BotToken, chat.ChatID, text, keyboard); err != nil {
+			errs = append(errs, fmt.Errorf("telegram adapter: chat %d: %w", chat.ChatID, err))
+			continue
+		}
+		sent++
+	}
+	if sent > 0 {
+		for _, err := range errs {
+			t.logger.Printf("WARN: %v", err)
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("telegram adapter: no unmuted paired chats")
+	}
+	return errors.Join(errs...)
+}
+
+// FormatTelegramApproval renders an approval request as Telegram HTML.
+func FormatTelegramApproval(req approval.Request) string {
+	lines := []string{
+		"🔎 <b>" + telegramEscape(approvalHeadline(req)) + "</b>",
+		"<b>" + telegramEscape(approvalTask(req)) + "</b>",
+		telegramEscape(approvalStats(req)),
+	}
+	if files := approvalFileLines(req); len(files) > 0 {
+		lines = append(lines, "<pre>"+telegramEscape(strings.Join(files, "\n"))+"</pre>")
+	}
+	if footer := approvalFooter(req); footer != "" {
+		lines = append(lines, "<i>"+telegramEscape(footer)+"</i>")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Compile-time assertions for the approval-capable adapters.
+var (
+	_ ApprovalPoster = (*SlackAdapter)(nil)
+	_ ApprovalPoster = (*DiscordAdapter)(nil)
+	_ ApprovalPoster = (*TelegramAdapter)(nil)
+)
@@ -0,0 +1,231 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func approvalFixture() approval.Request {
+	return approval.Request{
+		ProjectID:    "proj-abc",
+		ProjectName:  "Watchfire",
+		TaskNumber:   42,
+		TaskTitle:    "Build the Discord adapter",
+		Agent:        "Claude Code",
+		Branch:       "watchfire/0042",
+		Commits:      3,
+		FilesChanged: 12,
+		LinesAdded:   120,
+		LinesRemoved: 30,
+		Files: []approval.FileChange{
+			{Path: "internal/daemon/relay/discord.go", Status: "added", Additions: 90},
+			{Path: "README.md", Status: "modified", Additions: 4, Deletions: 1},
+		},
+		RequestedAt: time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC),
+		ExpiresAt:   time.Date(2026, 5, 3, 12, 0, 0, 0, time.UTC),
+		OnTimeout:   approval.Reject,
+	}
+}
+
+// captureServer records the last request body and query it received.
+func captureServer(t *testing.T) (*httptest.Server, func() (body []byte, query string)) {
+	t.Helper()
+	var (
+		mu    sync.Mutex
+		body  []byte
+		query string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		body, query = b, r.URL.RawQuery
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() ([]byte, string) {
+		mu.Lock()
+		defer mu.Unlock()
+		return body, query
+	}
+}
+
+func TestSlackPostApproval(t *testing.T) {
+	srv, captured := captureServer(t)
+	s := newSlackAdapterForTest(t, models.SlackEndpoint{ID: "ep", URL: srv.URL})
+
+	if err := s.PostApproval(context.Background(), approvalFixture()); err != nil {
+		t.Fatalf("PostApproval: %v", err)
+	}
+	body, _ := captured()
+	var msg struct {
+		Blocks []struct {
+			Type     string `json:"type"`
+			BlockID  string `json:"block_id"`
+			Elements []struct {
+				ActionID string `json:"action_id"`
+				Value    string `json:"value"`
+				Style    string `json:"style"`
+			} `json:"elements"`
+		} `json:"blocks"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		t.Fatalf("decode: %v\n%s", err, body)
+	}
+	last := msg.Blocks[len(msg.Blocks)-1]
+	if last.Type != "actions" || last.BlockID != "watchfire_approval" || len(last.Elements) != 3 {
+		t.Fatalf("actions block = %+v", last)
+	}
+	want := []struct{ actionID, style string }{
+		{"watchfire_approval_approve", "primary"},
+		{"watchfire_approval_reject", "danger"},
+		{"watchfire_approval_retry", ""},
+	}
+	for i, w := range want {
+		el := last.Elements[i]
+		if el.ActionID != w.actionID || el.Style != w.style || el.Value != "proj-abc|42" {
+			t.Errorf("button %d = %+v, want %s/%q", i, el, w.actionID, w.style)
+		}
+	}
+	for _, frag := range []string{
+		"3 commits · 12 files · +120 −30",
+		"A internal/daemon/relay/discord.go +90 −0",
+		"… and 10 more",
+		"rejects automatically at 2026-05-03T12:00:00Z",
+	} {
+		if !strings.Contains(string(body), frag) {
+			t.Errorf("body missing %q:\n%s", frag, body)
+		}
+	}
+}
+
+func TestSlackApprovalEscapesTitle(t *testing.T) {
+	req := approvalFixture()
+	req.TaskTitle = "Fix <!channel> *everything* & more"
+	msg := slackApprovalMessage(req)
+	section := msg["blocks"].([]map[string]any)[1]["text"].(map[string]any)["text"].(string)
+	want := "*Task #0042 — Fix &lt;!channel&gt; ∗everything∗ &amp; more*"
+	if !strings.HasPrefix(section, want+"\n") {
+		t.Errorf("section = %q, want prefix %q", section, want)
+	}
+}
+
+func TestDiscordPostApproval(t *testing.T) {
+	srv, captured := captureServer(t)
+	d, err := NewDiscordAdapter(models.DiscordEndpoint{ID: "ep", URL: srv.URL + "/api/webhooks/1/tok?thread_id=9"}, nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewDiscordAdapter: %v", err)
+	}
+
+	if err := d.PostApproval(context.Background(), approvalFixture()); err != nil {
+		t.Fatalf("PostApproval: %v", err)
+	}
+	body, query := captured()
+	if !strings.Contains(query, "with_components=true") || !strings.Contains(query, "thread_id=9") {
+		t.Errorf("query = %q, want with_components=true alongside the existing params", query)
+	}
+	var msg struct {
+		Components []struct {
+			Type       int `json:"type"`
+			Components []struct {
+				Type     int    `json:"type"`
+				Style    int    `json:"style"`
+				CustomID string `json:"custom_id"`
+			} `json:"components"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		t.Fatalf("decode: %v\n%s", err, body)
+	}
+	if len(msg.Components) != 1 || msg.Components[0].Type != 1 || len(msg.Components[0].Components) != 3 {
+		t.Fatalf("components = %+v", msg.Components)
+	}
+	for i, w := range []string{"approval:approve:proj-abc|42", "approval:reject:proj-abc|42", "approval:retry:proj-abc|42"} {
+		if got := msg.Components[0].Components[i]; got.Type != 2 || got.CustomID != w {
+			t.Errorf("button %d = %+v, want custom_id %q", i, got, w)
+		}
+	}
+}
+
+func TestTelegramPostApprovalSkipsMutedChats(t *testing.T) {
+	api := startFakeTelegramAPI(t)
+	cfg := telegramTestConfig(
+		models.TelegramPairedChat{ChatID: 111},
+		models.TelegramPairedChat{ChatID: 222, Muted: true},
+	)
+	a := NewTelegramAdapter(cfg, telegrambot.New(), nil)
+
+	if err := a.PostApproval(context.Background(), approvalFixture()); err != nil {
+		t.Fatalf("PostApproval: %v", err)
+	}
+	sends := api.Sends()
+	if len(sends) != 1 || sends[0].ChatID != 111 {
+		t.Fatalf("sends = %+v, want only chat 111", sends)
+	}
+	if !strings.Contains(sends[0].Text, "<pre>A internal/daemon/relay/discord.go +90 −0") {
+		t.Errorf("text = %q", sends[0].Text)
+	}
+	if !strings.Contains(sends[0].ReplyMarkup, `"callback_data":"approval:retry:proj-abc|42"`) {
+		t.Errorf("reply_markup = %q", sends[0].ReplyMarkup)
+	}
+
+	muted := NewTelegramAdapter(telegramTestConfig(models.TelegramPairedChat{ChatID: 222, Muted: true}), telegrambot.New(), nil)
+	if err := muted.PostApproval(context.Background(), approvalFixture()); err == nil {
+		t.Error("posting with every chat muted should fail")
+	}
+}
+
+// approvalStub is a stubAdapter that can also post approvals.
+type approvalStub struct {
+	stubAdapter
+	postErr error
+	posted  []approval.Request
+}
+
+func (s *approvalStub) PostApproval(_ context.Context, req approval.Request) error {
+	s.posted = append(s.posted, req)
+	return s.postErr
+}
+
+func TestDispatcherPostApproval(t *testing.T) {
+	plain := &stubAdapter{id: "webhook"}
+	muted := &approvalStub{stubAdapter: stubAdapter{id: "muted", mutedIDs: map[string]bool{"proj-abc": true}}}
+	failing := &approvalStub{stubAdapter: stubAdapter{id: "failing"}, postErr: errors.New("boom")}
+	ok := &approvalStub{stubAdapter: stubAdapter{id: "ok"}}
+	factory := func() ([]Adapter, error) { return []Adapter{plain, muted, failing, ok}, nil }
+	d := NewDispatcher(nil, passthroughResolver, factory, WithLogger(log.New(io.Discard, "", 0)))
+
+	if err := d.PostApproval(context.Background(), approvalFixture()); err != nil {
+		t.Fatalf("PostApproval: %v", err)
+	}
+	if len(muted.posted) != 0 || len(failing.posted) != 1 || len(ok.posted) != 1 {
+		t.Errorf("posted: muted=%d failing=%d ok=%d", len(muted.posted), len(failing.posted), len(ok.posted))
+	}
+
+	req := approvalFixture()
+	req.Endpoints = []string{"failing"}
+	if err := d.PostApproval(context.Background(), req); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("only a failing endpoint selected: got %v", err)
+	}
+	if len(ok.posted) != 1 {
+		t.Error("endpoints filter must skip unlisted adapters")
+	}
+
+	req.Endpoints = []string{"webhook", "muted"}
+	if err := d.PostApproval(context.Background(), req); !errors.Is(err, ErrNoApprovalTransport) {
+		t.Errorf("no approval-capable endpoint: want ErrNoApprovalTransport, got %v", err)
+	}
+}
@@ -3,28 +3,17 @@ package relay
 import (
 	"bytes"
 	"context"
-	_ "embed"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"text/template"
 	"time"
 
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	"github.com/watchfire-io/watchfire/internal/models"
 )
 
-//go:embed templates/discord_task_failed.json.tmpl
-var discordTaskFailedTmpl string
-
-//go:embed templates/discord_run_complete.json.tmpl
-var discordRunCompleteTmpl string
-
-//go:embed templates/discord_weekly_digest.json.tmpl
-var discordWeeklyDigestTmpl string
-
 // discordEmbedDescriptionLimit is the defensive cap applied before
 // posting to Discord. Discord's hard limit is 4096; we trim at 4000 and
 // log a WARN so the user finds out a template overflow happened without
@@ -45,9 +34,7 @@ type DiscordAdapter struct {
 	httpClient *http.Client
 	logger     *log.Logger
 
-	taskFailedTmpl   *template.Template
-	runCompleteTmpl  *template.Template
-	weeklyDigestTmpl *template.Template
+	templates *templateSet
 }
 
 // NewDiscordAdapter builds an adapter for the given Discord endpoint.
@@ -60,25 +47,15 @@ func NewDiscordAdapter(endpoint models.DiscordEndpoint, client *http.Client, log
 	if logger == nil {
 		logger = log.Default()
 	}
-	tf, err := template.New("discord_task_failed").Funcs(TemplateFuncs()).Parse(discordTaskFailedTmpl)
-	if err != nil {
-		return nil, fmt.Errorf("parse discord_task_failed template: %w", err)
-	}
-	rc, err := template.New("discord_run_complete").Funcs(TemplateFuncs()).Parse(discordRunCompleteTmpl)
-	if err != nil {
-		return nil, fmt.Errorf("parse discord_run_complete template: %w", err)
-	}
-	wd, err := template.New("discord_weekly_digest").Funcs(TemplateFuncs()).Parse(discordWeeklyDigestTmpl)
+	tmpls, err := loadTemplateSet("discord", endpoint.ID, logger)
 	if err != nil {
-		return nil, fmt.Errorf("parse discord_weekly_digest template: %w", err)
+		return nil, err
 	}
 	return &DiscordAdapter{
-		endpoint:         endpoint,
-		httpClient:       client,
-		logger:           logger,
-		taskFailedTmpl:   tf,
-		runCompleteTmpl:  rc,
-		weeklyDigestTmpl: wd,
+		endpoint:   endpoint,
+		httpClient: client,
+		logger:     logger,
+		templates:  tmpls,
 	}, nil
 }
 
@@ -92,15 +69,7 @@ func (d *DiscordAdapter) Kind() string { return "discord" }
 // false for any kind the user has unchecked; the dispatcher skips Send
 // without ever opening a connection.
 func (d *DiscordAdapter) Supports(kind notify.Kind) bool {
-	switch kind {
-	case notify.KindTaskFailed:
-		return d.endpoint.EnabledEvents.TaskFailed
-	case notify.KindRunComplete:
-		return d.endpoint.EnabledEvents.RunComplete
-	case notify.KindWeeklyDigest:
-		return d.endpoint.EnabledEvents.WeeklyDigest
-	}
-	return false
+	return d.endpoint.EnabledEvents.Enabled(kind.EventKey())
 }
 
 // IsProjectMuted reports whether the source project sits inside the
@@ -120,18 +89,19 @@ func (d *DiscordAdapter) Send(ctx context.Context, p Payload) error {
 		return fmt.Errorf("discord adapter %q: webhook URL not resolved (keyring miss?)", d.endpoint.ID)
 	}
 
-	tmpl, err := d.templateFor(notify.Kind(p.Kind))
-	if err != nil {
-		return err
-	}
-	body, renderErr := d.render(tmpl, p)
+	body, renderErr := d.render(p)
 	if renderErr != nil {
 		return renderErr
 	}
 
 	body = d.truncateEmbedDescriptions(body)
+	return d.post(ctx, d.endpoint.URL, body)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.endpoint.URL, bytes.NewReader(body))
+// post delivers a rendered message to target — the webhook URL, possibly
+// with query parameters added.
+func (d *DiscordAdapter) post(ctx context.Context, target string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("discord adapter %q: build request: %w", d.endpoint.ID, err)
 	}
@@ -150,24 +120,13 @@ func (d *DiscordAdapter) Send(ctx context.Context, p Payload) error {
 	return nil
 }
 
-func (d *DiscordAdapter) templateFor(kind notify.Kind) (*template.Template, error) {
-	switch kind {
-	case notify.KindTaskFailed:
-		return d.taskFailedTmpl, nil
-	case notify.KindRunComplete:
-		return d.runCompleteTmpl, nil
-	case notify.KindWeeklyDigest:
-		return d.weeklyDigestTmpl, nil
-	}
-	return nil, fmt.Errorf("discord adapter %q: unsupported notification kind %q", d.endpoint.ID, kind)
-}
-
-func (d *DiscordAdapter) render(tmpl *template.Template, p Payload) ([]byte, error) {
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, p); err != nil {
-		return nil, fmt.Errorf("discord adapter %q: render template %q: %w", d.endpoint.ID, tmpl.Name(), err)
+// render executes the kind's template against the payload.
+func (d *DiscordAdapter) render(p Payload) ([]byte, error) {
+	body, err := d.templates.execute(notify.Kind(p.Kind), p)
+	if err != nil {
+		return nil, fmt.Errorf("discord adapter %q: %w", d.endpoint.ID, err)
 	}
-	return buf.Bytes(), nil
+	return body, nil
 }
 
 // truncateEmbedDescriptions walks the rendered JSON, trims any embed
@@ -68,6 +68,53 @@ func weeklyDigestFixture() Payload {
 	}
 }
 
+func budgetThresholdFixture() Payload {
+	return Payload{
+		Version:     1,
+		Kind:        string(notify.KindBudgetThreshold),
+		EmittedAt:   fixedEmittedAt,
+		ProjectID:   "proj-abc",
+		ProjectName: "Watchfire",
+		DeepLink:    "watchfire://project/proj-abc",
+		Budget: &notify.BudgetAlert{
+			Scope:     "project",
+			Month:     "2026-05",
+			Threshold: 100,
+			SpentUSD:  101.25,
+			LimitUSD:  100,
+			HardStop:  true,
+		},
+	}
+}
+
+// extendedFixture builds the payload for one of the lifecycle events
+// added after the original four kinds. Task-bound kinds reuse the task
+// 42 metadata; project-level kinds link to the project.
+func extendedFixture(kind notify.Kind) Payload {
+	p := runCompleteFixture()
+	p.Kind = string(kind)
+	switch kind {
+	case notify.KindMergeFailed:
+		p.ProjectColor = "#ef4444"
+		p.Detail = "merge conflict in internal/daemon/relay/discord.go"
+	case notify.KindPROpened:
+		p.URL = "https://github.com/watchfire-io/watchfire/pull/7"
+	case notify.KindAgentNeedsAuth:
+		p.Detail = "Invalid API key · Please run /login"
+	case notify.KindRateLimited:
+		p.Detail = "5-hour limit reached ∙ resets 3pm"
+	case notify.KindWildfirePhase:
+		p.TaskNumber, p.TaskTitle = 0, ""
+		p.DeepLink = "watchfire://project/proj-abc"
+		p.Phase, p.PreviousPhase = "generate", "refine"
+	case notify.KindTasksGenerated:
+		p.TaskNumber, p.TaskTitle = 0, ""
+		p.DeepLink = "watchfire://project/proj-abc"
+		p.Count = 3
+	}
+	return p
+}
+
 // ---- golden tests --------------------------------------------------------
 
 func TestDiscordTemplateGoldens(t *testing.T) {
@@ -79,16 +126,20 @@ func TestDiscordTemplateGoldens(t *testing.T) {
 		{"task_failed", failedFixture(), "discord_task_failed.json"},
 		{"run_complete", runCompleteFixture(), "discord_run_complete.json"},
 		{"weekly_digest", weeklyDigestFixture(), "discord_weekly_digest.json"},
+		{"budget_threshold", budgetThresholdFixture(), "discord_budget_threshold.json"},
+		{"task_succeeded", extendedFixture(notify.KindTaskSucceeded), "discord_task_succeeded.json"},
+		{"merge_failed", extendedFixture(notify.KindMergeFailed), "discord_merge_failed.json"},
+		{"pr_opened", extendedFixture(notify.KindPROpened), "discord_pr_opened.json"},
+		{"agent_needs_auth", extendedFixture(notify.KindAgentNeedsAuth), "discord_agent_needs_auth.json"},
+		{"rate_limited", extendedFixture(notify.KindRateLimited), "discord_rate_limited.json"},
+		{"wildfire_phase_changed", extendedFixture(notify.KindWildfirePhase), "discord_wildfire_phase_changed.json"},
+		{"tasks_generated", extendedFixture(notify.KindTasksGenerated), "discord_tasks_generated.json"},
 	}
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			d := newAdapterForTest(t, models.DiscordEndpoint{ID: "test", URL: "https://example.invalid"})
-			tmpl, err := d.templateFor(notify.Kind(tc.fixture.Kind))
-			if err != nil {
-				t.Fatalf("templateFor: %v", err)
-			}
-			rendered, err := d.render(tmpl, tc.fixture)
+			rendered, err := d.render(tc.fixture)
 			if err != nil {
 				t.Fatalf("render: %v", err)
 			}
@@ -480,6 +531,47 @@ func TestBuildPayloadDeepLink(t *testing.T) {
 			t.Errorf("digest deep link = %q", p.DeepLink)
 		}
 	})
+	t.Run("global budget", func(t *testing.T) {
+		p := BuildPayload(PayloadInput{
+			Notification: notify.Notification{
+				Kind:      notify.KindBudgetThreshold,
+				EmittedAt: fixedEmittedAt,
+				Budget:    &notify.BudgetAlert{Scope: "global", Month: "2026-05", Threshold: 80},
+			},
+		})
+		if p.DeepLink != "watchfire://budget/2026-05" {
+			t.Errorf("budget deep link = %q", p.DeepLink)
+		}
+		if p.Budget == nil || p.Budget.Threshold != 80 {
+			t.Errorf("budget not carried into payload: %+v", p.Budget)
+		}
+	})
+	t.Run("tasks generated", func(t *testing.T) {
+		p := BuildPayload(PayloadInput{
+			Notification: notify.Notification{
+				Kind:      notify.KindTasksGenerated,
+				ProjectID: "proj-1",
+				EmittedAt: fixedEmittedAt,
+				Count:     3,
+			},
+		})
+		if p.DeepLink != "watchfire://project/proj-1" || p.Count != 3 {
+			t.Errorf("tasks generated payload = %+v", p)
+		}
+	})
+	t.Run("agent issue outside a task", func(t *testing.T) {
+		p := BuildPayload(PayloadInput{
+			Notification: notify.Notification{
+				Kind:      notify.KindRateLimited,
+				ProjectID: "proj-1",
+				EmittedAt: fixedEmittedAt,
+				Detail:    "limit reached",
+			},
+		})
+		if p.DeepLink != "watchfire://project/proj-1" || p.Detail != "limit reached" {
+			t.Errorf("rate limited payload = %+v", p)
+		}
+	})
 }
 
 // ---- helpers -------------------------------------------------------------
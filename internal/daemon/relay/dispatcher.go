@@ -3,11 +3,14 @@ package relay
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/prom"
 )
 
 // Default retry policy for v7.0 Relay outbound delivery. The schedule
@@ -31,8 +34,23 @@ var (
 	// exhausted) tolerated inside `CircuitBreakerWindow` before the
 	// breaker opens for that adapter.
 	CircuitBreakerThreshold = 3
+	// OutboxPollInterval is how often the dispatcher scans the durable
+	// outbox for deliveries whose backoff has elapsed.
+	OutboxPollInterval = 15 * time.Second
+	// OutboxDrainPerAdapter caps how many stored deliveries one outbox
+	// pass re-sends per adapter; the rest stay due for the next pass so
+	// a long queue for one endpoint can't starve the others.
+	OutboxDrainPerAdapter = 20
 )
 
+// ErrOutboxDisabled is returned by the delivery introspection methods
+// when the dispatcher was built without WithOutbox.
+var ErrOutboxDisabled = errors.New("relay dispatcher: durable outbox not configured")
+
+// errBreakerOpen is the Send outcome recorded against an outbox delivery
+// that was skipped because its adapter's breaker is open.
+var errBreakerOpen = errors.New("circuit breaker open")
+
 // PayloadResolver lifts a Notification into a fully-populated Payload.
 // Implementations look up the project name + color and the per-task
 // title + failure reason via whatever stores the daemon has on hand.
@@ -50,28 +68,42 @@ type AdapterFactory func() ([]Adapter, error)
 // configured outbound adapter. It runs a single goroutine that pulls
 // from a Subscribe channel and dispatches each notification to every
 // adapter whose `Supports(kind)` returns true and whose per-project
-// mute does not include the source project.
+// mute does not include the source project — or, when a routing rule
+// (WithRoutes) matches, to the rule's targets instead.
 //
 // Per-adapter retry: 3 attempts with `DefaultRetryDelays` between them.
 // Per-adapter circuit breaker: 3 hard failures within 5 minutes opens
 // the breaker so a misconfigured endpoint doesn't flood the daemon log.
+// With WithOutbox, each delivery is persisted before the first attempt
+// and a failed one is re-driven from disk on the Outbox backoff curve,
+// surviving daemon restarts, until it is dead-lettered. The outbox is
+// drained on a goroutine of its own so a backlog never delays live
+// notifications.
 type Dispatcher struct {
 	bus      *notify.Bus
 	resolve  PayloadResolver
 	factory  AdapterFactory
+	routes   RouteFactory
 	logger   *log.Logger
 	retry    []time.Duration
 	cbWindow time.Duration
 	cbLimit  int
 	now      func() time.Time
+	outbox   *Outbox
+	poll     time.Duration
 
 	mu       sync.RWMutex
 	adapters []Adapter
+	router   *Router
 	cbState  map[string]*breakerState
+	// inflight holds the outbox delivery ids being sent right now, so
+	// the live path and the outbox drain never both send one record.
+	inflight map[string]bool
 
 	subCh     <-chan notify.Notification
 	subCancel func()
 
+	kick chan struct{}
 	stop chan struct{}
 	done chan struct{}
 }
@@ -115,6 +147,18 @@ func WithClock(now func() time.Time) DispatcherOption {
 	return func(d *Dispatcher) { d.now = now }
 }
 
+// WithOutbox makes delivery durable: every Send is recorded in outbox
+// first and failures are retried from disk across restarts.
+func WithOutbox(outbox *Outbox) DispatcherOption {
+	return func(d *Dispatcher) { d.outbox = outbox }
+}
+
+// WithRoutes installs the routing rules evaluated before the default
+// fan-out. The factory is re-run on every Reload, like the adapters.
+func WithRoutes(routes RouteFactory) DispatcherOption {
+	return func(d *Dispatcher) { d.routes = routes }
+}
+
 // NewDispatcher builds a Dispatcher subscribing to bus with adapters
 // resolved through factory. Call `Run(ctx)` to start the goroutine and
 // `Stop()` to terminate.
@@ -128,7 +172,10 @@ func NewDispatcher(bus *notify.Bus, resolve PayloadResolver, factory AdapterFact
 		cbWindow: CircuitBreakerWindow,
 		cbLimit:  CircuitBreakerThreshold,
 		now:      time.Now,
+		poll:     OutboxPollInterval,
 		cbState:  make(map[string]*breakerState),
+		inflight: make(map[string]bool),
+		kick:     make(chan struct{}, 1),
 		stop:     make(chan struct{}),
 		done:     make(chan struct{}),
 	}
@@ -157,15 +204,34 @@ func (d *Dispatcher) Adapters() []Adapter {
 	return out
 }
 
-// Reload rebuilds the adapter list from the factory. The server calls
-// this on `EventIntegrationsChanged`. Failures inside the factory are
-// logged and the previous adapter slice is preserved so a malformed
-// edit doesn't drain the dispatcher mid-flight.
+// Reload rebuilds the adapter list and routing rules from their
+// factories. The server calls this on `EventIntegrationsChanged`.
+// Failures inside a factory are logged and the previous adapters /
+// rules are preserved so a malformed edit doesn't drain the dispatcher
+// mid-flight.
 func (d *Dispatcher) Reload() {
 	d.rebuildAdapters()
 }
 
+// Plan reports where a payload would be delivered under the current
+// adapters and routing rules, without sending anything.
+func (d *Dispatcher) Plan(p Payload) RoutePlan {
+	d.mu.RLock()
+	router := d.router
+	d.mu.RUnlock()
+	return router.Plan(d.Adapters(), p)
+}
+
 func (d *Dispatcher) rebuildAdapters() {
+	if d.routes != nil {
+		if router, err := d.routes(); err != nil {
+			d.logger.Printf("WARN: relay dispatcher: rebuild routes: %v", err)
+		} else {
+			d.mu.Lock()
+			d.router = router
+			d.mu.Unlock()
+		}
+	}
 	if d.factory == nil {
 		return
 	}
@@ -202,6 +268,18 @@ func (d *Dispatcher) Run(ctx context.Context) {
 	if d.subCh == nil {
 		return
 	}
+	if d.outbox != nil {
+		ctx, cancel := context.WithCancel(ctx)
+		drained := make(chan struct{})
+		go func() {
+			defer close(drained)
+			d.runOutbox(ctx)
+		}()
+		defer func() {
+			cancel()
+			<-drained
+		}()
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -217,6 +295,28 @@ func (d *Dispatcher) Run(ctx context.Context) {
 	}
 }
 
+// runOutbox re-drives stored deliveries until ctx is cancelled or Stop
+// is called: once at start — deliveries left pending by a previous
+// daemon run are due (or overdue) — then on every poll tick and every
+// ReplayDelivery kick.
+func (d *Dispatcher) runOutbox(ctx context.Context) {
+	d.drainOutbox(ctx)
+	ticker := time.NewTicker(d.poll)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			d.drainOutbox(ctx)
+		case <-d.kick:
+			d.drainOutbox(ctx)
+		}
+	}
+}
+
 // Stop signals the Run goroutine to exit and blocks until it has.
 func (d *Dispatcher) Stop() {
 	select {
@@ -228,37 +328,204 @@ func (d *Dispatcher) Stop() {
 	<-d.done
 }
 
-// dispatch fans n out to every adapter that supports the kind and is
-// not muted for the project. Each adapter Send runs through the retry
-// + circuit-breaker policy.
+// dispatch routes n through Plan — the matching rules' targets, or
+// every adapter that supports the kind — skipping adapters muted for
+// the project. Each adapter Send runs through the retry +
+// circuit-breaker policy.
 func (d *Dispatcher) dispatch(ctx context.Context, n notify.Notification) {
 	payload, err := d.resolve(n)
 	if err != nil {
 		d.logger.Printf("WARN: relay dispatcher: resolve payload for %s/%s: %v", n.Kind, n.ProjectID, err)
 		return
 	}
-	for _, a := range d.Adapters() {
-		if !a.Supports(n.Kind) {
+	plan := d.Plan(payload)
+	for _, id := range plan.Unknown {
+		d.logger.Printf("WARN: relay dispatcher: route target %q is not configured (rules: %s)", id, strings.Join(plan.Rules, ", "))
+	}
+	for _, t := range plan.Targets {
+		d.deliver(ctx, t.send(), payload)
+	}
+}
+
+// deliver runs one adapter Send through the retry policy. Without an
+// outbox this is sendWithRetry alone; with one, the delivery is
+// persisted first, removed on success and rescheduled (or dead-lettered)
+// on failure. A cancelled send leaves the record due so the next daemon
+// start picks it up.
+func (d *Dispatcher) deliver(ctx context.Context, a Adapter, p Payload) {
+	if d.outbox == nil {
+		_ = d.sendWithRetry(ctx, a, p)
+		return
+	}
+	dl, err := d.outbox.Enqueue(a, p, d.now())
+	if err != nil {
+		d.logger.Printf("WARN: relay dispatcher: persist delivery for adapter %q: %v", a.ID(), err)
+		_ = d.sendWithRetry(ctx, a, p)
+		return
+	}
+	if !d.claim(dl.ID) {
+		// A fresh record is due at once; the outbox drain got to it first.
+		return
+	}
+	defer d.release(dl.ID)
+	d.settle(ctx, dl, d.sendWithRetry(ctx, a, p))
+}
+
+// drainOutbox re-drives the pending deliveries whose backoff has
+// elapsed, at most OutboxDrainPerAdapter per adapter. Each gets a single
+// attempt — the in-memory retry schedule already ran when the delivery
+// was first sent. A record is only replayed while the current routes,
+// mutes and event filters would still send it to its adapter; one they
+// no longer would is dropped.
+func (d *Dispatcher) drainOutbox(ctx context.Context) {
+	due, err := d.outbox.Due(d.now())
+	if err != nil {
+		d.logger.Printf("WARN: relay dispatcher: read outbox: %v", err)
+		return
+	}
+	sent := map[string]int{}
+	for _, dl := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case <-d.stop:
+			return
+		default:
+		}
+		if sent[dl.AdapterID] >= OutboxDrainPerAdapter {
 			continue
 		}
-		if mp, ok := a.(interface{ IsProjectMuted(string) bool }); ok && n.ProjectID != "" {
-			if mp.IsProjectMuted(n.ProjectID) {
-				continue
-			}
+		if !d.claim(dl.ID) {
+			continue
+		}
+		sent[dl.AdapterID]++
+		d.replay(ctx, dl)
+		d.release(dl.ID)
+	}
+}
+
+// replay sends one stored delivery, re-planned against the current
+// settings: the adapter must still be a target of the record's payload.
+func (d *Dispatcher) replay(ctx context.Context, dl *Delivery) {
+	if d.adapterByID(dl.AdapterID) == nil {
+		d.settle(ctx, dl, fmt.Errorf("adapter %q is not configured", dl.AdapterID))
+		return
+	}
+	for _, t := range d.Plan(dl.Payload).Targets {
+		if t.Adapter.ID() == dl.AdapterID {
+			d.settle(ctx, dl, d.sendOnce(ctx, t.send(), dl.Payload))
+			return
 		}
-		d.sendWithRetry(ctx, a, payload)
+	}
+	d.logger.Printf("WARN: relay dispatcher: dropping delivery %s for adapter %q — muted or no longer routed for %s/%s",
+		dl.ID, dl.AdapterID, dl.Payload.Kind, dl.Payload.ProjectID)
+	if err := d.outbox.Complete(dl.ID); err != nil {
+		d.logger.Printf("WARN: relay dispatcher: drop delivery %s: %v", dl.ID, err)
 	}
 }
 
+// claim marks an outbox delivery as being sent; false when another
+// goroutine already is.
+func (d *Dispatcher) claim(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.inflight[id] {
+		return false
+	}
+	d.inflight[id] = true
+	return true
+}
+
+func (d *Dispatcher) release(id string) {
+	d.mu.Lock()
+	delete(d.inflight, id)
+	d.mu.Unlock()
+}
+
+// settle records the outcome of one delivery round in the outbox.
+func (d *Dispatcher) settle(ctx context.Context, dl *Delivery, sendErr error) {
+	if sendErr == nil {
+		if err := d.outbox.Complete(dl.ID); err != nil {
+			d.logger.Printf("WARN: relay dispatcher: complete delivery %s: %v", dl.ID, err)
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	dead, err := d.outbox.Fail(dl, sendErr, d.now())
+	if err != nil {
+		d.logger.Printf("WARN: relay dispatcher: update delivery %s: %v", dl.ID, err)
+		return
+	}
+	if dead {
+		prom.RelayDeliveries.Inc(dl.AdapterID, "dead")
+		d.logger.Printf("ERROR: relay dispatcher: relay_delivery_dead id=%s adapter=%q attempts=%d last_err=%v",
+			dl.ID, dl.AdapterID, dl.Attempts, sendErr)
+		return
+	}
+	d.logger.Printf("WARN: relay dispatcher: delivery %s for adapter %q queued, next attempt at %s",
+		dl.ID, dl.AdapterID, dl.NextAttemptAt.Format(time.RFC3339))
+}
+
+func (d *Dispatcher) adapterByID(id string) Adapter {
+	for _, a := range d.Adapters() {
+		if a.ID() == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// FailedDeliveries returns the dead-lettered deliveries, oldest first.
+// includePending adds the deliveries still being retried from disk.
+func (d *Dispatcher) FailedDeliveries(includePending bool) ([]*Delivery, error) {
+	if d.outbox == nil {
+		return nil, ErrOutboxDisabled
+	}
+	dead, err := d.outbox.Dead()
+	if err != nil {
+		return nil, err
+	}
+	if !includePending {
+		return dead, nil
+	}
+	pending, err := d.outbox.Pending()
+	if err != nil {
+		return nil, err
+	}
+	return append(pending, dead...), nil
+}
+
+// ReplayDelivery re-queues a dead-lettered (or still pending) delivery
+// for an immediate attempt and wakes the Run goroutine to send it.
+func (d *Dispatcher) ReplayDelivery(id string) (*Delivery, error) {
+	if d.outbox == nil {
+		return nil, ErrOutboxDisabled
+	}
+	dl, err := d.outbox.Replay(id, d.now())
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case d.kick <- struct{}{}:
+	default:
+	}
+	return dl, nil
+}
+
 // sendWithRetry runs the per-adapter retry + circuit-breaker policy
 // against a single Send. It is invoked synchronously from dispatch so
 // adapters land in the order they were registered; for the retry
 // budget (~10s worst case) this trades a small amount of dispatch
 // latency for predictable ordering and a bounded goroutine count.
-func (d *Dispatcher) sendWithRetry(ctx context.Context, a Adapter, p Payload) {
+// Returns nil once a Send succeeds, otherwise the reason it gave up.
+func (d *Dispatcher) sendWithRetry(ctx context.Context, a Adapter, p Payload) error {
 	if d.breakerOpen(a.ID()) {
 		d.logger.Printf("WARN: relay dispatcher: adapter %q breaker open, skipping send", a.ID())
-		return
+		prom.RelayDeliveries.Inc(a.ID(), "skipped")
+		return errBreakerOpen
 	}
 
 	attempts := 1 + len(d.retry)
@@ -268,27 +535,51 @@ func (d *Dispatcher) sendWithRetry(ctx context.Context, a Adapter, p Payload) {
 			delay := d.retry[attempt-1]
 			select {
 			case <-ctx.Done():
-				return
+				return ctx.Err()
 			case <-d.stop:
-				return
+				return context.Canceled
 			case <-time.After(delay):
 			}
 		}
 		err := a.Send(ctx, p)
 		if err == nil {
-			return
+			prom.RelayDeliveries.Inc(a.ID(), "sent")
+			return nil
 		}
 		lastErr = err
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 			d.logger.Printf("WARN: relay dispatcher: adapter %q send cancelled: %v", a.ID(), err)
-			return
+			return err
 		}
 		d.logger.Printf("WARN: relay dispatcher: adapter %q send attempt %d/%d failed: %v",
 			a.ID(), attempt+1, attempts, err)
 	}
+	prom.RelayDeliveries.Inc(a.ID(), "failed")
 	d.recordFailure(a.ID())
 	d.logger.Printf("ERROR: relay dispatcher: relay_send_failed adapter=%q attempts=%d last_err=%v",
 		a.ID(), attempts, lastErr)
+	return lastErr
+}
+
+// sendOnce is the single-attempt variant used when re-driving the
+// outbox: same breaker gate and metrics as sendWithRetry, no in-memory
+// retries.
+func (d *Dispatcher) sendOnce(ctx context.Context, a Adapter, p Payload) error {
+	if d.breakerOpen(a.ID()) {
+		prom.RelayDeliveries.Inc(a.ID(), "skipped")
+		return errBreakerOpen
+	}
+	err := a.Send(ctx, p)
+	if err == nil {
+		prom.RelayDeliveries.Inc(a.ID(), "sent")
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	prom.RelayDeliveries.Inc(a.ID(), "failed")
+	d.recordFailure(a.ID())
+	return err
 }
 
 // breakerOpen reports whether the named adapter has tripped its
@@ -316,7 +607,7 @@ func (d *Dispatcher) breakerOpen(adapterID string) bool {
 
 // recordFailure stamps a hard-failure timestamp for the named adapter.
 // Called once per send that exhausted retries; the breaker opens on
-// the (cbLimit)th failure inside cbWindow.
+// the (cbLimit)th failure inside cbWindow, which counts as one trip.
 func (d *Dispatcher) recordFailure(adapterID string) {
 	if d.cbLimit <= 0 {
 		return
@@ -337,4 +628,7 @@ func (d *Dispatcher) recordFailure(adapterID string) {
 		}
 	}
 	st.failures = pruned
+	if len(st.failures) == d.cbLimit {
+		prom.RelayBreakerTrips.Inc(adapterID)
+	}
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/prom"
 )
 
 // stubAdapter is a test-only Adapter that records every Send call and
@@ -227,3 +228,40 @@ func waitFor(t *testing.T, timeout time.Duration, cond func() bool) bool {
 	}
 	return cond()
 }
+
+// TestDispatcherExportsDeliveryMetrics asserts the Prometheus counters
+// see every outcome: failed sends up to the trip, exactly one breaker
+// trip, then skipped sends while the breaker stays open. Adapter ids are
+// unique to this test because the counters live in a process-wide
+// registry.
+func TestDispatcherExportsDeliveryMetrics(t *testing.T) {
+	bad := &stubAdapter{id: "metrics-bad", sendErr: errors.New("server down")}
+	good := &stubAdapter{id: "metrics-good"}
+
+	d := NewDispatcher(
+		nil,
+		passthroughResolver,
+		func() ([]Adapter, error) { return nil, nil },
+		WithRetryDelays(nil),
+		WithCircuitBreaker(5*time.Minute, 2),
+	)
+	for i := 0; i < 4; i++ {
+		d.sendWithRetry(context.Background(), bad, samplePayload())
+	}
+	d.sendWithRetry(context.Background(), good, samplePayload())
+
+	checks := []struct {
+		got, want float64
+		name      string
+	}{
+		{prom.RelayDeliveries.Value("metrics-bad", "failed"), 2, "failed"},
+		{prom.RelayDeliveries.Value("metrics-bad", "skipped"), 2, "skipped"},
+		{prom.RelayBreakerTrips.Value("metrics-bad"), 1, "trips"},
+		{prom.RelayDeliveries.Value("metrics-good", "sent"), 1, "sent"},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
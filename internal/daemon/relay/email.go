@@ -0,0 +1,401 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// smtpSessionTimeout bounds one whole SMTP session (dial through QUIT)
+// when the caller's context carries no earlier deadline.
+const smtpSessionTimeout = 30 * time.Second
+
+//go:embed templates/email.html.tmpl
+var emailHTMLTemplate string
+
+var emailHTML = htmltemplate.Must(htmltemplate.New("email").Parse(emailHTMLTemplate))
+
+// EmailAdapter delivers Relay notifications over SMTP as multipart
+// text + HTML mail. One adapter binds to one `models.EmailEndpoint` (one
+// server + sender); each Send goes to the endpoint's recipients that
+// selected the payload's event, in a single SMTP transaction.
+//
+// Transport security follows the endpoint: STARTTLS (the default, and
+// refused rather than downgraded when the server does not offer it),
+// implicit TLS, or none for a trusted local relay. The SMTP password is
+// resolved from the OS keyring at dispatcher build time; net/smtp's
+// PLAIN auth itself refuses to send it over an unencrypted connection
+// to anything but localhost.
+type EmailAdapter struct {
+	endpoint models.EmailEndpoint
+	logger   *log.Logger
+
+	// tlsConfig is cloned for every connection with ServerName set to
+	// the endpoint host. Nil means the system roots; tests inject the
+	// stand-in server's CA.
+	tlsConfig *tls.Config
+}
+
+// NewEmailAdapter returns an adapter for the endpoint. The logger falls
+// back to log.Default() so production callers can pass nil.
+func NewEmailAdapter(endpoint models.EmailEndpoint, logger *log.Logger) *EmailAdapter {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &EmailAdapter{endpoint: endpoint, logger: logger}
+}
+
+// ID returns the stable id from the IntegrationsConfig entry.
+func (e *EmailAdapter) ID() string { return e.endpoint.ID }
+
+// Kind reports the adapter kind for the dispatcher's per-kind routing.
+func (e *EmailAdapter) Kind() string { return "email" }
+
+// Supports reports whether at least one recipient selected the event.
+// The dispatcher skips Send entirely when nobody wants it.
+func (e *EmailAdapter) Supports(kind notify.Kind) bool {
+	return len(e.endpoint.RecipientsFor(kind.EventKey())) > 0
+}
+
+// IsProjectMuted reports whether the source project sits inside the
+// adapter's per-project mute list.
+func (e *EmailAdapter) IsProjectMuted(projectID string) bool {
+	return IsProjectMuted(e.endpoint.ProjectMuteIDs, projectID)
+}
+
+// Send renders the payload and mails it to every recipient that selected
+// its event. A payload nobody selected is a no-op.
+func (e *EmailAdapter) Send(ctx context.Context, p Payload) error {
+	to := e.endpoint.RecipientsFor(notify.Kind(p.Kind).EventKey())
+	if len(to) == 0 {
+		return nil
+	}
+	if e.endpoint.Host == "" || e.endpoint.From == "" {
+		return fmt.Errorf("email adapter %q: SMTP host and from address are required", e.endpoint.ID)
+	}
+	if e.endpoint.Username != "" && e.endpoint.Password == "" {
+		return fmt.Errorf("email adapter %q: SMTP password not resolved (keyring miss?)", e.endpoint.ID)
+	}
+	from, err := mail.ParseAddress(e.endpoint.From)
+	if err != nil {
+		return fmt.Errorf("email adapter %q: invalid from address %q: %w", e.endpoint.ID, e.endpoint.From, err)
+	}
+	rcpts := make([]string, 0, len(to))
+	for _, addr := range to {
+		a, err := mail.ParseAddress(addr)
+		if err != nil {
+			return fmt.Errorf("email adapter %q: invalid recipient %q: %w", e.endpoint.ID, addr, err)
+		}
+		rcpts = append(rcpts, a.Address)
+	}
+	msg, err := buildEmailMessage(from, rcpts, p)
+	if err != nil {
+		return fmt.Errorf("email adapter %q: %w", e.endpoint.ID, err)
+	}
+	if err := e.deliver(ctx, from.Address, rcpts, msg); err != nil {
+		return fmt.Errorf("email adapter %q: %w", e.endpoint.ID, err)
+	}
+	return nil
+}
+
+// deliver runs one SMTP session: connect (implicit TLS or plain),
+// STARTTLS when configured, AUTH when a username is set, then one
+// MAIL / RCPT… / DATA transaction.
+func (e *EmailAdapter) deliver(ctx context.Context, from string, to []string, msg []byte) error {
+	ep := e.endpoint
+	mode := ep.SecurityMode()
+	addr := net.JoinHostPort(ep.Host, strconv.Itoa(ep.ServerPort()))
+	tlsCfg := &tls.Config{}
+	if e.tlsConfig != nil {
+		tlsCfg = e.tlsConfig.Clone()
+	}
+	tlsCfg.ServerName = ep.Host
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if mode == models.EmailSecurityTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsCfg}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect %s: %w", addr, err)
+	}
+	deadline := time.Now().Add(smtpSessionTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+	// Cancelling the context aborts a session blocked on the server.
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, ep.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("SMTP greeting: %w", err)
+	}
+	defer c.Close()
+
+	if mode == models.EmailSecurityStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.New("server does not offer STARTTLS (set security to \"tls\" or \"none\" explicitly)")
+		}
+		if err := c.StartTLS(tlsCfg); err != nil {
+			return fmt.Errorf("STARTTLS: %w", err)
+		}
+	}
+	if ep.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("server does not offer AUTH")
+		}
+		if err := c.Auth(smtp.PlainAuth("", ep.Username, ep.Password, ep.Host)); err != nil {
+			return fmt.Errorf("AUTH: %w", err)
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return fmt.Errorf("MAIL FROM: %w", err)
+	}
+	for _, rcpt := range to {
+		if err := c.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("RCPT TO %s: %w", rcpt, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("DATA: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("DATA: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("DATA: %w", err)
+	}
+	return c.Quit()
+}
+
+// emailField is one "label: value" row of a notification email.
+type emailField struct {
+	Label string
+	Value string
+}
+
+// emailContent is the channel-neutral shape of one notification email;
+// renderEmailText and the HTML template both render from it.
+type emailContent struct {
+	Subject   string
+	Heading   string
+	Accent    string
+	Fields    []emailField
+	Markdown  string // weekly digest body; text part verbatim, HTML part converted
+	Body      htmltemplate.HTML
+	LinkLabel string
+	Link      htmltemplate.URL
+	Footer    string
+}
+
+// composeEmail maps a payload onto its email content.
+func composeEmail(p Payload) (emailContent, error) {
+	c := emailContent{
+		Accent:    "#64748b",
+		LinkLabel: "View in Watchfire",
+		Link:      emailLink(p.DeepLink),
+		Footer:    fmt.Sprintf("%s · %s", p.ProjectName, rfc3339(p.EmittedAt)),
+	}
+	project := emailField{"Project", p.ProjectName}
+	task := emailField{"Task", taskLabel(p)}
+	headed := func(headline string) {
+		c.Heading = headline
+		c.Subject = headline + " — " + p.ProjectName
+	}
+	withDetail := func(label string) {
+		if p.Detail != "" {
+			c.Fields = append(c.Fields, emailField{label, p.Detail})
+		}
+	}
+	switch notify.Kind(p.Kind) {
+	case notify.KindTaskFailed:
+		headed("Task failed")
+		c.Accent = "#ef4444"
+		c.Fields = []emailField{project, task, {"Reason", p.TaskFailureReason}}
+	case notify.KindRunComplete:
+		headed("Run complete")
+		c.Accent = "#3b82f6"
+		c.Fields = []emailField{project, task}
+	case notify.KindWeeklyDigest:
+		c.Heading = "Watchfire — your week"
+		if p.DigestDate != "" {
+			c.Heading = fmt.Sprintf("Watchfire — your week (%s)", p.DigestDate)
+		}
+		c.Subject = c.Heading
+		c.Accent = "#f97316"
+		c.Markdown = p.DigestBody
+		c.Body = htmltemplate.HTML(markdownToHTML(p.DigestBody))
+		c.LinkLabel = "Open the digest in Watchfire"
+		c.Footer = "Weekly digest · " + rfc3339(p.EmittedAt)
+	case notify.KindBudgetThreshold:
+		c.Heading = budgetHeadline(p)
+		c.Subject = c.Heading
+		c.Accent = "#eab308"
+		c.Fields = []emailField{{"Spend", budgetSummary(p)}}
+		c.Footer = "Monthly budget · " + rfc3339(p.EmittedAt)
+	case notify.KindTaskSucceeded:
+		headed("Task succeeded")
+		c.Accent = "#22c55e"
+		c.Fields = []emailField{project, task}
+	case notify.KindMergeFailed:
+		headed("Merge failed")
+		c.Accent = "#ef4444"
+		c.Fields = []emailField{project, task}
+		withDetail("Error")
+	case notify.KindPROpened:
+		headed("PR opened")
+		c.Accent = "#8b5cf6"
+		c.Fields = []emailField{project, task, {"Pull request", p.URL}}
+		if p.URL != "" {
+			c.LinkLabel, c.Link = "Open pull request", emailLink(p.URL)
+		}
+	case notify.KindAgentNeedsAuth:
+		headed("Agent needs to sign in")
+		c.Accent = "#eab308"
+		c.Fields = []emailField{project, task}
+		withDetail("Agent said")
+	case notify.KindRateLimited:
+		headed("Rate limited")
+		c.Accent = "#eab308"
+		c.Fields = []emailField{project, task}
+		withDetail("Agent said")
+	case notify.KindWildfirePhase:
+		headed("Wildfire")
+		c.Accent = "#f97316"
+		c.Fields = []emailField{project, {"Phase", phaseLabel(p.PreviousPhase) + " → " + phaseLabel(p.Phase)}}
+	case notify.KindTasksGenerated:
+		headed(tasksGeneratedHeadline(p))
+		c.Accent = "#3b82f6"
+		c.Fields = []emailField{project}
+	default:
+		return emailContent{}, fmt.Errorf("unsupported notification kind %q", p.Kind)
+	}
+	return c, nil
+}
+
+// emailLink admits the link schemes the daemon emits (its own
+// watchfire:// deep links and http(s) PR URLs) as a trusted URL;
+// html/template would otherwise rewrite watchfire:// to "#ZgotmplZ".
+// Anything else is dropped.
+func emailLink(raw string) htmltemplate.URL {
+	for _, scheme := range []string{"watchfire://", "https://", "http://"} {
+		if strings.HasPrefix(raw, scheme) {
+			return htmltemplate.URL(raw)
+		}
+	}
+	return ""
+}
+
+// renderEmailText renders the text/plain part.
+func renderEmailText(c emailContent) string {
+	var b strings.Builder
+	b.WriteString(c.Heading + "\n\n")
+	for _, f := range c.Fields {
+		fmt.Fprintf(&b, "%s: %s\n", f.Label, f.Value)
+	}
+	if c.Markdown != "" {
+		if len(c.Fields) > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(strings.TrimRight(c.Markdown, "\n") + "\n")
+	}
+	if c.Link != "" {
+		fmt.Fprintf(&b, "\n%s: %s\n", c.LinkLabel, c.Link)
+	}
+	b.WriteString("\n-- \n" + c.Footer + "\n")
+	return b.String()
+}
+
+// buildEmailMessage assembles the RFC 5322 message: headers plus a
+// multipart/alternative body with quoted-printable text and HTML parts.
+// The Message-ID is derived from the payload, so a retried delivery
+// carries the same id and mail clients thread or de-duplicate it.
+func buildEmailMessage(from *mail.Address, to []string, p Payload) ([]byte, error) {
+	c, err := composeEmail(p)
+	if err != nil {
+		return nil, err
+	}
+	var html bytes.Buffer
+	if err := emailHTML.Execute(&html, c); err != nil {
+		return nil, fmt.Errorf("render HTML part: %w", err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct{ ctype, content string }{
+		{"text/plain", renderEmailText(c)},
+		{"text/html", html.String()},
+	} {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", part.ctype+"; charset=utf-8")
+		h.Set("Content-Transfer-Encoding", "quoted-printable")
+		pw, err := mw.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(pw)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	domain := "watchfire.local"
+	if at := strings.LastIndex(from.Address, "@"); at >= 0 {
+		domain = from.Address[at+1:]
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%s", p.Kind, p.ProjectID, p.TaskNumber, rfc3339(p.EmittedAt))))
+
+	var msg bytes.Buffer
+	header := func(k, v string) { fmt.Fprintf(&msg, "%s: %s\r\n", k, v) }
+	header("From", from.String())
+	header("To", strings.Join(to, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", "[Watchfire] "+c.Subject))
+	header("Date", p.EmittedAt.UTC().Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<watchfire-%s@%s>", hex.EncodeToString(sum[:12]), domain))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	header("Auto-Submitted", "auto-generated")
+	header(EventHeader, p.Kind)
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+// Compile-time assertion that EmailAdapter satisfies the Adapter
+// interface.
+var _ Adapter = (*EmailAdapter)(nil)
@@ -0,0 +1,74 @@
+package relay
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	mdBold = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	mdCode = regexp.MustCompile("`([^`]+)`")
+)
+
+// markdownToHTML renders the Markdown subset the weekly digest uses —
+// `#`-headings, `-` bullet lists (nested by two-space indent), whole-line
+// `_emphasis_`, `**bold**` and `code` spans — as HTML for the email
+// adapter's text/html part. Every piece of text is escaped first, so the
+// output is safe to embed as-is; anything outside the subset renders as
+// a plain paragraph.
+func markdownToHTML(md string) string {
+	var b strings.Builder
+	depth := 0
+	closeLists := func(to int) {
+		for depth > to {
+			b.WriteString("</li>\n</ul>\n")
+			depth--
+		}
+	}
+	for _, line := range strings.Split(md, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		content := strings.TrimLeft(line, " ")
+		if strings.HasPrefix(content, "- ") {
+			level := (len(line)-len(content))/2 + 1
+			if level > depth+1 {
+				level = depth + 1
+			}
+			switch {
+			case level > depth:
+				b.WriteString("<ul>\n<li>")
+				depth = level
+			case level == depth:
+				b.WriteString("</li>\n<li>")
+			default:
+				closeLists(level)
+				b.WriteString("</li>\n<li>")
+			}
+			b.WriteString(markdownInline(content[2:]))
+			continue
+		}
+		closeLists(0)
+		switch {
+		case content == "":
+		case strings.HasPrefix(content, "### "):
+			b.WriteString("<h4>" + markdownInline(content[4:]) + "</h4>\n")
+		case strings.HasPrefix(content, "## "):
+			b.WriteString("<h3>" + markdownInline(content[3:]) + "</h3>\n")
+		case strings.HasPrefix(content, "# "):
+			b.WriteString("<h2>" + markdownInline(content[2:]) + "</h2>\n")
+		case len(content) > 2 && strings.HasPrefix(content, "_") && strings.HasSuffix(content, "_"):
+			b.WriteString("<p><em>" + markdownInline(content[1:len(content)-1]) + "</em></p>\n")
+		default:
+			b.WriteString("<p>" + markdownInline(content) + "</p>\n")
+		}
+	}
+	closeLists(0)
+	return b.String()
+}
+
+// markdownInline escapes s and applies the bold + code span rules.
+func markdownInline(s string) string {
+	s = html.EscapeString(s)
+	s = mdBold.ReplaceAllString(s, "<strong>$1</strong>")
+	return mdCode.ReplaceAllString(s, "<code>$1</code>")
+}
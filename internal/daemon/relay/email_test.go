@@ -0,0 +1,371 @@
+package relay
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// smtpStandIn is a minimal in-process SMTP server: EHLO, optional
+// STARTTLS, AUTH PLAIN, MAIL / RCPT / DATA, QUIT. It records every
+// accepted message so tests can assert on the wire result.
+type smtpStandIn struct {
+	ln       net.Listener
+	tlsCfg   *tls.Config
+	rootCAs  *x509.CertPool
+	startTLS bool
+
+	mu   sync.Mutex
+	msgs []smtpReceived
+}
+
+type smtpReceived struct {
+	from     string
+	to       []string
+	data     []byte
+	tls      bool
+	authUser string
+	authPass string
+}
+
+// newSMTPStandIn starts the stand-in on 127.0.0.1. implicitTLS wraps the
+// listener in TLS from the first byte; startTLS advertises STARTTLS on a
+// plain listener. The certificate comes from httptest's TLS server.
+func newSMTPStandIn(t *testing.T, implicitTLS, startTLS bool) *smtpStandIn {
+	t.Helper()
+	certSrv := httptest.NewUnstartedServer(http.NotFoundHandler())
+	certSrv.StartTLS()
+	t.Cleanup(certSrv.Close)
+	s := &smtpStandIn{
+		tlsCfg:   &tls.Config{Certificates: certSrv.TLS.Certificates},
+		rootCAs:  certSrv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+		startTLS: startTLS,
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if implicitTLS {
+		ln = tls.NewListener(ln, s.tlsCfg)
+	}
+	s.ln = ln
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn, implicitTLS)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStandIn) port() int { return s.ln.Addr().(*net.TCPAddr).Port }
+
+func (s *smtpStandIn) received() []smtpReceived {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]smtpReceived(nil), s.msgs...)
+}
+
+func (s *smtpStandIn) serve(conn net.Conn, secure bool) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP stand-in")
+	var cur smtpReceived
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			ext := []string{"localhost"}
+			if s.startTLS && !secure {
+				ext = append(ext, "STARTTLS")
+			}
+			ext = append(ext, "AUTH PLAIN", "8BITMIME")
+			for i, e := range ext {
+				sep := "-"
+				if i == len(ext)-1 {
+					sep = " "
+				}
+				_ = tp.PrintfLine("250%s%s", sep, e)
+			}
+		case "STARTTLS":
+			_ = tp.PrintfLine("220 ready")
+			tc := tls.Server(conn, s.tlsCfg)
+			if err := tc.Handshake(); err != nil {
+				return
+			}
+			conn, secure = tc, true
+			tp = textproto.NewConn(tc)
+		case "AUTH":
+			raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(arg, "PLAIN "))
+			parts := strings.Split(string(raw), "\x00")
+			if len(parts) == 3 {
+				cur.authUser, cur.authPass = parts[1], parts[2]
+			}
+			_ = tp.PrintfLine("235 authenticated")
+		case "MAIL":
+			cur.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			if i := strings.Index(cur.from, ">"); i >= 0 {
+				cur.from = cur.from[:i]
+			}
+			_ = tp.PrintfLine("250 ok")
+		case "RCPT":
+			cur.to = append(cur.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			cur.data, cur.tls = data, secure
+			s.mu.Lock()
+			s.msgs = append(s.msgs, cur)
+			s.mu.Unlock()
+			cur = smtpReceived{authUser: cur.authUser, authPass: cur.authPass}
+			_ = tp.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("250 ok")
+		}
+	}
+}
+
+// emailParts parses a received message into its headers and its decoded
+// text/plain + text/html parts.
+func emailParts(t *testing.T, data []byte) (mail.Header, string, string) {
+	t.Helper()
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q (%v), want multipart/alternative", msg.Header.Get("Content-Type"), err)
+	}
+	var text, html string
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+		body, _ := io.ReadAll(bufio.NewReader(part))
+		switch {
+		case strings.HasPrefix(part.Header.Get("Content-Type"), "text/plain"):
+			text = string(body)
+		case strings.HasPrefix(part.Header.Get("Content-Type"), "text/html"):
+			html = string(body)
+		}
+	}
+	return msg.Header, text, html
+}
+
+func emailEndpointForTest(s *smtpStandIn, security string) models.EmailEndpoint {
+	var oncall, manager models.EmailRecipient
+	oncall.Address = "oncall@example.com"
+	oncall.EnabledEvents.Set(models.EventTaskFailed, true)
+	manager.Address = "Manager <manager@example.com>"
+	manager.EnabledEvents.Set(models.EventWeeklyDigest, true)
+	return models.EmailEndpoint{
+		ID:         "mail",
+		Host:       "127.0.0.1",
+		Port:       s.port(),
+		Security:   security,
+		From:       "Watchfire <watchfire@example.com>",
+		Recipients: []models.EmailRecipient{oncall, manager},
+	}
+}
+
+func newEmailAdapterForTest(s *smtpStandIn, ep models.EmailEndpoint) *EmailAdapter {
+	a := NewEmailAdapter(ep, nil)
+	a.tlsConfig = &tls.Config{RootCAs: s.rootCAs}
+	return a
+}
+
+func TestEmailSendStartTLSMultipart(t *testing.T) {
+	s := newSMTPStandIn(t, false, true)
+	ep := emailEndpointForTest(s, models.EmailSecurityStartTLS)
+	ep.Username, ep.PasswordRef, ep.Password = "relay", "ref", "s3cret"
+	a := newEmailAdapterForTest(s, ep)
+
+	if err := a.Send(context.Background(), failedFixture()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	msgs := s.received()
+	if len(msgs) != 1 {
+		t.Fatalf("want 1 message, got %d", len(msgs))
+	}
+	got := msgs[0]
+	if !got.tls {
+		t.Error("message was not sent over TLS")
+	}
+	if got.authUser != "relay" || got.authPass != "s3cret" {
+		t.Errorf("AUTH = %q/%q", got.authUser, got.authPass)
+	}
+	if got.from != "watchfire@example.com" {
+		t.Errorf("MAIL FROM = %q", got.from)
+	}
+	if len(got.to) != 1 || got.to[0] != "oncall@example.com" {
+		t.Errorf("RCPT TO = %v, want only the recipient that selected task_failed", got.to)
+	}
+
+	header, text, html := emailParts(t, got.data)
+	subject, _ := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	if subject != "[Watchfire] Task failed — Watchfire" {
+		t.Errorf("Subject = %q", subject)
+	}
+	if header.Get(EventHeader) != string(notify.KindTaskFailed) || header.Get("Auto-Submitted") != "auto-generated" {
+		t.Errorf("unexpected headers: %v", header)
+	}
+	if !strings.HasSuffix(header.Get("Message-ID"), "@example.com>") {
+		t.Errorf("Message-ID = %q", header.Get("Message-ID"))
+	}
+	for _, want := range []string{"Task: Task #0042 — Build the Discord adapter", "Reason: tests failed: 3 of 12", "watchfire://project/proj-abc/task/0042"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text part missing %q:\n%s", want, text)
+		}
+	}
+	for _, want := range []string{"<h2", "Task failed</h2>", ">Reason</td>", "tests failed: 3 of 12", `href="watchfire://project/proj-abc/task/0042"`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("html part missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestEmailDigestOverImplicitTLS(t *testing.T) {
+	s := newSMTPStandIn(t, true, false)
+	a := newEmailAdapterForTest(s, emailEndpointForTest(s, models.EmailSecurityTLS))
+
+	if err := a.Send(context.Background(), weeklyDigestFixture()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	msgs := s.received()
+	if len(msgs) != 1 || len(msgs[0].to) != 1 || msgs[0].to[0] != "manager@example.com" {
+		t.Fatalf("want the digest delivered to the manager only, got %+v", msgs)
+	}
+	_, text, html := emailParts(t, msgs[0].data)
+	if !strings.Contains(text, "## This week\n\n- 12 tasks completed across 3 projects\n- 2 failures") {
+		t.Errorf("text part should carry the digest Markdown verbatim:\n%s", text)
+	}
+	for _, want := range []string{"<h3>This week</h3>", "<li>12 tasks completed across 3 projects</li>", "<li>2 failures</li>"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("html part missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestEmailStartTLSRequired(t *testing.T) {
+	s := newSMTPStandIn(t, false, false)
+	a := newEmailAdapterForTest(s, emailEndpointForTest(s, models.EmailSecurityStartTLS))
+
+	err := a.Send(context.Background(), failedFixture())
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("want a STARTTLS error, got %v", err)
+	}
+	if n := len(s.received()); n != 0 {
+		t.Fatalf("message delivered in the clear (%d)", n)
+	}
+}
+
+func TestEmailSupportsAndNoRecipients(t *testing.T) {
+	s := newSMTPStandIn(t, false, true)
+	ep := emailEndpointForTest(s, models.EmailSecurityStartTLS)
+	ep.ProjectMuteIDs = []string{"proj-muted"}
+	a := newEmailAdapterForTest(s, ep)
+
+	if !a.Supports(notify.KindTaskFailed) || !a.Supports(notify.KindWeeklyDigest) {
+		t.Error("kinds selected by a recipient should be supported")
+	}
+	if a.Supports(notify.KindRunComplete) || a.Supports(notify.KindTaskSucceeded) {
+		t.Error("kinds no recipient selected should not be supported")
+	}
+	if !a.IsProjectMuted("proj-muted") || a.IsProjectMuted("proj-abc") {
+		t.Error("project mute list not honoured")
+	}
+	if err := a.Send(context.Background(), runCompleteFixture()); err != nil {
+		t.Fatalf("Send with no recipients: %v", err)
+	}
+	if n := len(s.received()); n != 0 {
+		t.Fatalf("want no message, got %d", n)
+	}
+}
+
+func TestEmailSendUnresolvedPasswordIsError(t *testing.T) {
+	s := newSMTPStandIn(t, false, true)
+	ep := emailEndpointForTest(s, models.EmailSecurityStartTLS)
+	ep.Username, ep.PasswordRef = "relay", "ref"
+	if err := newEmailAdapterForTest(s, ep).Send(context.Background(), failedFixture()); err == nil {
+		t.Fatal("want error when the keyring password did not resolve")
+	}
+}
+
+func TestEmailMessageIDStableAcrossRetries(t *testing.T) {
+	from := &mail.Address{Address: "watchfire@example.com"}
+	id := func() string {
+		data, err := buildEmailMessage(from, []string{"oncall@example.com"}, failedFixture())
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg.Header.Get("Message-ID")
+	}
+	if a, b := id(), id(); a == "" || a != b {
+		t.Fatalf("Message-ID not stable: %q vs %q", a, b)
+	}
+}
+
+func TestEmailComposesEveryKind(t *testing.T) {
+	for _, kind := range notify.Kinds {
+		c, err := composeEmail(fixtureFor(kind))
+		if err != nil {
+			t.Errorf("%s: %v", kind, err)
+			continue
+		}
+		if c.Subject == "" || c.Heading == "" {
+			t.Errorf("%s: empty subject or heading: %+v", kind, c)
+		}
+	}
+}
+
+func TestMarkdownToHTML(t *testing.T) {
+	md := "# Week <1>\n\n_quiet week_\n\n- **alpha** shipped\n  - task `0042`\n- beta\n\nplain & simple"
+	want := "<h2>Week &lt;1&gt;</h2>\n" +
+		"<p><em>quiet week</em></p>\n" +
+		"<ul>\n<li><strong>alpha</strong> shipped<ul>\n<li>task <code>0042</code></li>\n</ul>\n</li>\n<li>beta</li>\n</ul>\n" +
+		"<p>plain &amp; simple</p>\n"
+	if got := markdownToHTML(md); got != want {
+		t.Errorf("markdownToHTML:\n got %q\nwant %q", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// MatrixAdapter posts Relay notifications into one Matrix room as
+// `m.notice` events (plain body plus `org.matrix.custom.html`) through
+// the client-server API. One adapter binds to one room; the dispatcher
+// builds one MatrixAdapter per configured `models.MatrixEndpoint`.
+//
+// The access token of the posting account lives in the OS keyring.
+// Each event is PUT with a transaction id derived from the payload, so
+// a retried or replayed delivery is deduplicated by the homeserver
+// instead of posting the same notice twice.
+type MatrixAdapter struct {
+	endpoint   models.MatrixEndpoint
+	httpClient *http.Client
+	logger     *log.Logger
+
+	templates *templateSet
+}
+
+// NewMatrixAdapter parses the embedded per-kind message templates once
+// and returns a ready-to-use adapter. The HTTP client and logger fall
+// back to sane defaults so production callers can pass nil.
+func NewMatrixAdapter(endpoint models.MatrixEndpoint, client *http.Client, logger *log.Logger) (*MatrixAdapter, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if logger == nil {
+		logger = log.Default()
+	}
+	tmpls, err := loadTemplateSet("matrix", endpoint.ID, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &MatrixAdapter{
+		endpoint:   endpoint,
+		httpClient: client,
+		logger:     logger,
+		templates:  tmpls,
+	}, nil
+}
+
+// ID returns the stable id from the IntegrationsConfig entry.
+func (m *MatrixAdapter) ID() string { return m.endpoint.ID }
+
+// Kind reports the adapter kind for the dispatcher's per-kind routing.
+func (m *MatrixAdapter) Kind() string { return "matrix" }
+
+// Supports gates the adapter on the per-endpoint event bitmask.
+func (m *MatrixAdapter) Supports(kind notify.Kind) bool {
+	return m.endpoint.EnabledEvents.Enabled(kind.EventKey())
+}
+
+// IsProjectMuted reports whether the source project sits inside the
+// adapter's per-project mute list.
+func (m *MatrixAdapter) IsProjectMuted(projectID string) bool {
+	return IsProjectMuted(m.endpoint.ProjectMuteIDs, projectID)
+}
+
+// Send renders the message content for the payload's kind and PUTs it
+// as a room event. Any non-2xx is surfaced so the dispatcher's retry +
+// outbox can act on it.
+func (m *MatrixAdapter) Send(ctx context.Context, p Payload) error {
+	if m.endpoint.HomeserverURL == "" || m.endpoint.RoomID == "" {
+		return fmt.Errorf("matrix adapter %q: homeserver URL and room ID are required", m.endpoint.ID)
+	}
+	if m.endpoint.AccessToken == "" {
+		return fmt.Errorf("matrix adapter %q: access token not resolved (keyring miss?)", m.endpoint.ID)
+	}
+	body, err := m.render(p)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, m.sendURL(p), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("matrix adapter %q: build request: %w", m.endpoint.ID, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.endpoint.AccessToken)
+	req.Header.Set("User-Agent", "watchfire-relay/1")
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("matrix adapter %q: PUT: %w", m.endpoint.ID, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("matrix adapter %q: HTTP %d", m.endpoint.ID, resp.StatusCode)
+	}
+	return nil
+}
+
+// sendURL is `<homeserver>/_matrix/client/v3/rooms/<room>/send/m.room.message/<txn>`.
+func (m *MatrixAdapter) sendURL(p Payload) string {
+	return fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		strings.TrimRight(m.endpoint.HomeserverURL, "/"),
+		url.PathEscape(m.endpoint.RoomID),
+		matrixTxnID(p))
+}
+
+// matrixTxnID derives the event transaction id from the fields that
+// identify one notification, so every retry of it reuses the same id.
+func matrixTxnID(p Payload) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%s", p.Kind, p.ProjectID, p.TaskNumber, rfc3339(p.EmittedAt))))
+	return "watchfire-" + hex.EncodeToString(sum[:12])
+}
+
+// render executes the kind's template against the payload.
+func (m *MatrixAdapter) render(p Payload) ([]byte, error) {
+	body, err := m.templates.execute(notify.Kind(p.Kind), p)
+	if err != nil {
+		return nil, fmt.Errorf("matrix adapter %q: %w", m.endpoint.ID, err)
+	}
+	return body, nil
+}
+
+// Compile-time assertion that MatrixAdapter satisfies the Adapter
+// interface.
+var _ Adapter = (*MatrixAdapter)(nil)
@@ -0,0 +1,112 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestMatrixTemplateGoldens(t *testing.T) {
+	a := newMatrixAdapterForTest(t, models.MatrixEndpoint{ID: "test"})
+	for _, kind := range notify.Kinds {
+		kind := kind
+		t.Run(kind.EventKey(), func(t *testing.T) {
+			rendered, err := a.render(fixtureFor(kind))
+			if err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			assertGolden(t, rendered, "matrix_"+kind.EventKey()+".json")
+		})
+	}
+}
+
+func TestMatrixSendEndToEnd(t *testing.T) {
+	var paths []string
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s, want PUT", r.Method)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer syt_secret" {
+			t.Errorf("Authorization = %q", auth)
+		}
+		paths = append(paths, r.URL.EscapedPath())
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"event_id":"$abc"}`))
+	}))
+	defer srv.Close()
+
+	a := newMatrixAdapterForTest(t, models.MatrixEndpoint{
+		ID: "ep", HomeserverURL: srv.URL + "/", RoomID: "!room:example.org", AccessToken: "syt_secret",
+	})
+	p := failedFixture()
+	for i := 0; i < 2; i++ {
+		if err := a.Send(context.Background(), p); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	const prefix = "/_matrix/client/v3/rooms/%21room:example.org/send/m.room.message/watchfire-"
+	if !strings.HasPrefix(paths[0], prefix) {
+		t.Fatalf("path = %q, want prefix %q", paths[0], prefix)
+	}
+	if paths[0] != paths[1] {
+		t.Errorf("retries of one payload should reuse the txn id: %q vs %q", paths[0], paths[1])
+	}
+	if got["msgtype"] != "m.notice" || got["format"] != "org.matrix.custom.html" ||
+		!strings.Contains(got["formatted_body"], "<b>🚨 Task failed — Watchfire</b>") {
+		t.Errorf("unexpected content: %+v", got)
+	}
+
+	p.TaskNumber = 43
+	if err := a.Send(context.Background(), p); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if paths[2] == paths[0] {
+		t.Error("different payloads should get different txn ids")
+	}
+}
+
+func TestMatrixSendMissingConfigIsError(t *testing.T) {
+	for _, ep := range []models.MatrixEndpoint{
+		{ID: "ep", RoomID: "!r:x", AccessToken: "t"},
+		{ID: "ep", HomeserverURL: "https://matrix.example.org", AccessToken: "t"},
+		{ID: "ep", HomeserverURL: "https://matrix.example.org", RoomID: "!r:x"},
+	} {
+		a := newMatrixAdapterForTest(t, ep)
+		if err := a.Send(context.Background(), failedFixture()); err == nil {
+			t.Errorf("expected error for incomplete endpoint %+v", ep)
+		}
+	}
+}
+
+func TestMatrixSendHTTPFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+	a := newMatrixAdapterForTest(t, models.MatrixEndpoint{
+		ID: "ep", HomeserverURL: srv.URL, RoomID: "!r:x", AccessToken: "t",
+	})
+	if err := a.Send(context.Background(), failedFixture()); err == nil {
+		t.Error("expected error for 403 response")
+	}
+}
+
+func newMatrixAdapterForTest(t *testing.T, ep models.MatrixEndpoint) *MatrixAdapter {
+	t.Helper()
+	a, err := NewMatrixAdapter(ep, nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewMatrixAdapter: %v", err)
+	}
+	return a
+}
@@ -0,0 +1,147 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// NtfyAdapter publishes Relay notifications to one ntfy topic using
+// ntfy's JSON publishing API (title, message, tags, priority, click
+// action). One adapter binds to one topic URL; the dispatcher builds one
+// NtfyAdapter per configured `models.NtfyEndpoint`.
+//
+// Public topics need no credentials; protected ones take an access
+// token, stored in the OS keyring and sent as a Bearer header.
+type NtfyAdapter struct {
+	endpoint   models.NtfyEndpoint
+	httpClient *http.Client
+	logger     *log.Logger
+
+	templates *templateSet
+}
+
+// NewNtfyAdapter parses the embedded per-kind message templates once and
+// returns a ready-to-use adapter. The HTTP client and logger fall back
+// to sane defaults so production callers can pass nil.
+func NewNtfyAdapter(endpoint models.NtfyEndpoint, client *http.Client, logger *log.Logger) (*NtfyAdapter, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if logger == nil {
+		logger = log.Default()
+	}
+	tmpls, err := loadTemplateSet("ntfy", endpoint.ID, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &NtfyAdapter{
+		endpoint:   endpoint,
+		httpClient: client,
+		logger:     logger,
+		templates:  tmpls,
+	}, nil
+}
+
+// ID returns the stable id from the IntegrationsConfig entry.
+func (n *NtfyAdapter) ID() string { return n.endpoint.ID }
+
+// Kind reports the adapter kind for the dispatcher's per-kind routing.
+func (n *NtfyAdapter) Kind() string { return "ntfy" }
+
+// Supports gates the adapter on the per-endpoint event bitmask.
+func (n *NtfyAdapter) Supports(kind notify.Kind) bool {
+	return n.endpoint.EnabledEvents.Enabled(kind.EventKey())
+}
+
+// IsProjectMuted reports whether the source project sits inside the
+// adapter's per-project mute list.
+func (n *NtfyAdapter) IsProjectMuted(projectID string) bool {
+	return IsProjectMuted(n.endpoint.ProjectMuteIDs, projectID)
+}
+
+// Send renders the message for the payload's kind, adds the topic, and
+// POSTs it to the server root. Any non-2xx is surfaced so the
+// dispatcher's retry + outbox can act on it.
+func (n *NtfyAdapter) Send(ctx context.Context, p Payload) error {
+	server, topic, err := splitNtfyTopicURL(n.endpoint.URL)
+	if err != nil {
+		return fmt.Errorf("ntfy adapter %q: %w", n.endpoint.ID, err)
+	}
+	if n.endpoint.TokenRef != "" && n.endpoint.Token == "" {
+		return fmt.Errorf("ntfy adapter %q: access token not resolved (keyring miss?)", n.endpoint.ID)
+	}
+	body, err := n.render(p, topic)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("ntfy adapter %q: build request: %w", n.endpoint.ID, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "watchfire-relay/1")
+	if n.endpoint.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.endpoint.Token)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("ntfy adapter %q: POST: %w", n.endpoint.ID, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("ntfy adapter %q: HTTP %d", n.endpoint.ID, resp.StatusCode)
+	}
+	return nil
+}
+
+// render executes the kind's template and sets the topic on the result
+// — the templates describe the message, the endpoint decides where it
+// goes.
+func (n *NtfyAdapter) render(p Payload, topic string) ([]byte, error) {
+	body, err := n.templates.execute(notify.Kind(p.Kind), p)
+	if err != nil {
+		return nil, fmt.Errorf("ntfy adapter %q: %w", n.endpoint.ID, err)
+	}
+	var msg map[string]any
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("ntfy adapter %q: template rendered invalid JSON: %w", n.endpoint.ID, err)
+	}
+	msg["topic"] = topic
+	return json.Marshal(msg)
+}
+
+// splitNtfyTopicURL splits `https://ntfy.sh/alerts` into the server
+// root the JSON API is published to and the topic name.
+func splitNtfyTopicURL(raw string) (server, topic string, err error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", "", fmt.Errorf("invalid topic URL %q", raw)
+	}
+	path := strings.Trim(u.Path, "/")
+	i := strings.LastIndex(path, "/")
+	topic = path[i+1:]
+	if topic == "" {
+		return "", "", fmt.Errorf("topic URL %q has no topic", raw)
+	}
+	u.Path = "/" + path[:i+1]
+	u.RawQuery, u.Fragment = "", ""
+	return u.String(), topic, nil
+}
+
+// Compile-time assertion that NtfyAdapter satisfies the Adapter
+// interface.
+var _ Adapter = (*NtfyAdapter)(nil)
@@ -0,0 +1,107 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func TestNtfyTemplateGoldens(t *testing.T) {
+	a := newNtfyAdapterForTest(t, models.NtfyEndpoint{ID: "test"})
+	for _, kind := range notify.Kinds {
+		kind := kind
+		t.Run(kind.EventKey(), func(t *testing.T) {
+			rendered, err := a.render(fixtureFor(kind), "watchfire-alerts")
+			if err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			assertGolden(t, rendered, "ntfy_"+kind.EventKey()+".json")
+		})
+	}
+}
+
+func TestNtfySendEndToEnd(t *testing.T) {
+	var gotPath, gotAuth string
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAuth = r.URL.Path, r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	a := newNtfyAdapterForTest(t, models.NtfyEndpoint{
+		ID: "ep", URL: srv.URL + "/team/watchfire-alerts", TokenRef: "ref", Token: "tk_secret",
+	})
+	if err := a.Send(context.Background(), failedFixture()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if gotPath != "/team/" {
+		t.Errorf("published to %q, want the server root /team/", gotPath)
+	}
+	if gotAuth != "Bearer tk_secret" {
+		t.Errorf("Authorization = %q", gotAuth)
+	}
+	if got["topic"] != "watchfire-alerts" || got["title"] != "Task failed — Watchfire" ||
+		got["click"] != "watchfire://project/proj-abc/task/0042" {
+		t.Errorf("unexpected message: %+v", got)
+	}
+}
+
+func TestNtfyPublicTopicSendsNoAuth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("public topic sent Authorization %q", auth)
+		}
+	}))
+	defer srv.Close()
+	a := newNtfyAdapterForTest(t, models.NtfyEndpoint{ID: "ep", URL: srv.URL + "/alerts"})
+	if err := a.Send(context.Background(), runCompleteFixture()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+}
+
+func TestNtfySendErrors(t *testing.T) {
+	cases := map[string]models.NtfyEndpoint{
+		"no url":        {ID: "ep"},
+		"no topic":      {ID: "ep", URL: "https://ntfy.sh/"},
+		"token missing": {ID: "ep", URL: "https://ntfy.sh/alerts", TokenRef: "ref"},
+	}
+	for name, ep := range cases {
+		a := newNtfyAdapterForTest(t, ep)
+		if err := a.Send(context.Background(), failedFixture()); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestSplitNtfyTopicURL(t *testing.T) {
+	cases := []struct{ in, server, topic string }{
+		{"https://ntfy.sh/alerts", "https://ntfy.sh/", "alerts"},
+		{"https://ntfy.example.org/sub/path/alerts/", "https://ntfy.example.org/sub/path/", "alerts"},
+		{" http://localhost:8080/t?x=1 ", "http://localhost:8080/", "t"},
+	}
+	for _, tc := range cases {
+		server, topic, err := splitNtfyTopicURL(tc.in)
+		if err != nil || server != tc.server || topic != tc.topic {
+			t.Errorf("splitNtfyTopicURL(%q) = %q, %q, %v; want %q, %q", tc.in, server, topic, err, tc.server, tc.topic)
+		}
+	}
+}
+
+func newNtfyAdapterForTest(t *testing.T, ep models.NtfyEndpoint) *NtfyAdapter {
+	t.Helper()
+	a, err := NewNtfyAdapter(ep, nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewNtfyAdapter: %v", err)
+	}
+	return a
+}
@@ -0,0 +1,289 @@
+package relay
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Outbox retry policy. A delivery that exhausts the dispatcher's
+// in-memory retries is re-driven from disk on an exponential schedule
+// (30s, 1m, 2m, … capped at 1h) until OutboxMaxAttempts rounds have
+// failed — roughly six hours end to end, long enough to ride out a
+// laptop sleep or a receiver outage — and is then moved to the
+// dead-letter store for a manual replay.
+var (
+	OutboxBaseDelay   = 30 * time.Second
+	OutboxMaxDelay    = time.Hour
+	OutboxMaxAttempts = 12
+)
+
+// ErrDeliveryNotFound is returned by Replay when no pending or dead
+// record carries the requested id.
+var ErrDeliveryNotFound = errors.New("relay outbox: delivery not found")
+
+// Delivery is one outbox record: a rendered-once canonical Payload bound
+// to the adapter that should receive it, plus the retry bookkeeping.
+// Records are JSON files named `<id>.json` under `pending/` while the
+// dispatcher is still retrying and under `dead/` once retries ran out.
+type Delivery struct {
+	ID            string    `json:"id"`
+	AdapterID     string    `json:"adapter_id"`
+	AdapterKind   string    `json:"adapter_kind"`
+	Payload       Payload   `json:"payload"`
+	Chats         []int64   `json:"chats,omitempty"` // chat-scoped Telegram delivery from a routing rule
+	Attempts      int       `json:"attempts"`
+	CreatedAt     time.Time `json:"created_at"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	LastError     string    `json:"last_error,omitempty"`
+	DeadAt        time.Time `json:"dead_at,omitzero"`
+}
+
+// Dead reports whether the record sits in the dead-letter store.
+func (dl *Delivery) Dead() bool { return !dl.DeadAt.IsZero() }
+
+// OutboxBackoff returns the delay before the next attempt after the
+// given number of failed rounds.
+func OutboxBackoff(attempts int) time.Duration {
+	delay := OutboxBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= OutboxMaxDelay {
+			return OutboxMaxDelay
+		}
+	}
+	return delay
+}
+
+// Outbox is the relay dispatcher's durable delivery queue. Every
+// delivery is written to `pending/` before the first Send so a daemon
+// restart mid-retry re-drives it on the next start; a successful Send
+// removes the file. Delivery is therefore at-least-once — a crash
+// between a successful Send and the remove re-sends on restart.
+type Outbox struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewOutbox returns an outbox rooted at dir (normally
+// `config.GlobalRelayOutboxDir()`). Directories are created lazily on
+// the first write.
+func NewOutbox(dir string) *Outbox {
+	return &Outbox{dir: dir}
+}
+
+func (o *Outbox) pendingDir() string { return filepath.Join(o.dir, "pending") }
+func (o *Outbox) deadDir() string    { return filepath.Join(o.dir, "dead") }
+
+// DeadPath returns the file holding dead-lettered delivery id. The
+// janitor ages dead letters out by removing it.
+func (o *Outbox) DeadPath(id string) string { return filepath.Join(o.deadDir(), id+".json") }
+
+// Enqueue persists a new pending delivery for adapter, due immediately.
+func (o *Outbox) Enqueue(a Adapter, p Payload, now time.Time) (*Delivery, error) {
+	id, err := newDeliveryID()
+	if err != nil {
+		return nil, err
+	}
+	dl := &Delivery{
+		ID:            id,
+		AdapterID:     a.ID(),
+		AdapterKind:   a.Kind(),
+		Payload:       p,
+		CreatedAt:     now.UTC(),
+		NextAttemptAt: now.UTC(),
+	}
+	if cs, ok := a.(chatScopedAdapter); ok {
+		dl.Chats = cs.chats
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := writeDelivery(o.pendingDir(), dl); err != nil {
+		return nil, err
+	}
+	return dl, nil
+}
+
+// Complete drops a pending delivery after a successful Send.
+func (o *Outbox) Complete(id string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	err := os.Remove(filepath.Join(o.pendingDir(), id+".json"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Fail records a failed round against a pending delivery. The record is
+// rescheduled on the OutboxBackoff curve, or moved to the dead-letter
+// store once OutboxMaxAttempts rounds have failed. Returns true when the
+// delivery was dead-lettered.
+func (o *Outbox) Fail(dl *Delivery, sendErr error, now time.Time) (bool, error) {
+	dl.Attempts++
+	if sendErr != nil {
+		dl.LastError = sendErr.Error()
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if dl.Attempts < OutboxMaxAttempts {
+		dl.NextAttemptAt = now.Add(OutboxBackoff(dl.Attempts)).UTC()
+		return false, writeDelivery(o.pendingDir(), dl)
+	}
+	dl.NextAttemptAt = time.Time{}
+	dl.DeadAt = now.UTC()
+	if err := writeDelivery(o.deadDir(), dl); err != nil {
+		return false, err
+	}
+	if err := os.Remove(filepath.Join(o.pendingDir(), dl.ID+".json")); err != nil && !os.IsNotExist(err) {
+		return true, err
+	}
+	return true, nil
+}
+
+// Due returns the pending deliveries whose next attempt is at or before
+// now, oldest first.
+func (o *Outbox) Due(now time.Time) ([]*Delivery, error) {
+	pending, err := o.Pending()
+	if err != nil {
+		return nil, err
+	}
+	out := pending[:0]
+	for _, dl := range pending {
+		if !dl.NextAttemptAt.After(now) {
+			out = append(out, dl)
+		}
+	}
+	return out, nil
+}
+
+// Pending returns every delivery still being retried, oldest first.
+func (o *Outbox) Pending() ([]*Delivery, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return readDeliveries(o.pendingDir())
+}
+
+// Dead returns every dead-lettered delivery, oldest first.
+func (o *Outbox) Dead() ([]*Delivery, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return readDeliveries(o.deadDir())
+}
+
+// Replay moves a dead-lettered delivery back to pending with a fresh
+// attempt budget, due immediately. Replaying a delivery that is still
+// pending pulls its next attempt forward to now.
+func (o *Outbox) Replay(id string, now time.Time) (*Delivery, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return nil, ErrDeliveryNotFound
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, dir := range []string{o.deadDir(), o.pendingDir()} {
+		path := filepath.Join(dir, id+".json")
+		dl, err := readDelivery(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if dl.Dead() {
+			dl.Attempts = 0
+			dl.DeadAt = time.Time{}
+		}
+		dl.NextAttemptAt = now.UTC()
+		if err := writeDelivery(o.pendingDir(), dl); err != nil {
+			return nil, err
+		}
+		if dir == o.deadDir() {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+		return dl, nil
+	}
+	return nil, ErrDeliveryNotFound
+}
+
+// writeDelivery atomically writes dl to `<dir>/<id>.json` (temp file +
+// rename) so a crash mid-write never leaves a truncated record behind.
+func writeDelivery(dir string, dl *Delivery) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("relay outbox: %w", err)
+	}
+	data, err := json.MarshalIndent(dl, "", "  ")
+	if err != nil {
+		return fmt.Errorf("relay outbox: marshal %s: %w", dl.ID, err)
+	}
+	path := filepath.Join(dir, dl.ID+".json")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("relay outbox: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("relay outbox: %w", err)
+	}
+	return nil
+}
+
+func readDelivery(path string) (*Delivery, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var dl Delivery
+	if err := json.Unmarshal(data, &dl); err != nil {
+		return nil, fmt.Errorf("relay outbox: parse %s: %w", filepath.Base(path), err)
+	}
+	return &dl, nil
+}
+
+// readDeliveries loads every record in dir sorted by CreatedAt. A
+// missing directory is an empty outbox; unreadable records are skipped
+// so one corrupt file cannot wedge the whole queue.
+func readDeliveries(dir string) ([]*Delivery, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("relay outbox: %w", err)
+	}
+	var out []*Delivery
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		dl, err := readDelivery(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		out = append(out, dl)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+func newDeliveryID() (string, error) {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("relay outbox: generate id: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,283 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+)
+
+func TestOutboxBackoffDoublesAndCaps(t *testing.T) {
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tc := range cases {
+		if got := OutboxBackoff(tc.attempts); got != tc.want {
+			t.Errorf("OutboxBackoff(%d) = %v, want %v", tc.attempts, got, tc.want)
+		}
+	}
+}
+
+// TestOutboxFailReschedulesThenDeadLetters walks one delivery through
+// the whole lifecycle: enqueue, rescheduled failures, dead letter,
+// replay back to pending with a fresh budget, and completion.
+func TestOutboxFailReschedulesThenDeadLetters(t *testing.T) {
+	dir := t.TempDir()
+	o := NewOutbox(dir)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	a := &stubAdapter{id: "hook"}
+
+	dl, err := o.Enqueue(a, samplePayload(), now)
+	if err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	if due, _ := o.Due(now); len(due) != 1 || due[0].ID != dl.ID {
+		t.Fatalf("fresh delivery should be due immediately, got %+v", due)
+	}
+
+	dead, err := o.Fail(dl, errors.New("HTTP 503"), now)
+	if err != nil || dead {
+		t.Fatalf("first Fail: dead=%v err=%v", dead, err)
+	}
+	if due, _ := o.Due(now.Add(29 * time.Second)); len(due) != 0 {
+		t.Fatalf("delivery due before its backoff elapsed")
+	}
+	due, _ := o.Due(now.Add(30 * time.Second))
+	if len(due) != 1 || due[0].Attempts != 1 || due[0].LastError != "HTTP 503" {
+		t.Fatalf("rescheduled record not persisted: %+v", due)
+	}
+
+	for i := 1; i < OutboxMaxAttempts; i++ {
+		dead, err = o.Fail(dl, errors.New("HTTP 503"), now)
+		if err != nil {
+			t.Fatalf("Fail #%d: %v", i+1, err)
+		}
+	}
+	if !dead {
+		t.Fatalf("delivery not dead-lettered after %d attempts", OutboxMaxAttempts)
+	}
+	if pending, _ := o.Pending(); len(pending) != 0 {
+		t.Fatalf("dead delivery still pending: %+v", pending)
+	}
+	letters, _ := o.Dead()
+	if len(letters) != 1 || !letters[0].Dead() || letters[0].Payload.TaskTitle != "Boom" {
+		t.Fatalf("dead letters = %+v", letters)
+	}
+
+	replayed, err := o.Replay(dl.ID, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if replayed.Dead() || replayed.Attempts != 0 {
+		t.Fatalf("replay should reset the record, got %+v", replayed)
+	}
+	if letters, _ := o.Dead(); len(letters) != 0 {
+		t.Fatalf("replayed delivery still dead-lettered")
+	}
+	if due, _ := o.Due(now.Add(time.Hour)); len(due) != 1 {
+		t.Fatalf("replayed delivery not due")
+	}
+
+	if err := o.Complete(dl.ID); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if pending, _ := o.Pending(); len(pending) != 0 {
+		t.Fatalf("completed delivery still pending")
+	}
+	if _, err := o.Replay(dl.ID, now); !errors.Is(err, ErrDeliveryNotFound) {
+		t.Fatalf("Replay of a completed delivery: want ErrDeliveryNotFound, got %v", err)
+	}
+}
+
+func TestOutboxSkipsCorruptRecords(t *testing.T) {
+	dir := t.TempDir()
+	o := NewOutbox(dir)
+	if _, err := o.Enqueue(&stubAdapter{id: "hook"}, samplePayload(), time.Now()); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "pending", "broken.json"), []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	pending, err := o.Pending()
+	if err != nil || len(pending) != 1 {
+		t.Fatalf("Pending = %d records, err %v; want the one valid record", len(pending), err)
+	}
+}
+
+// TestDispatcherOutboxSurvivesRestart simulates a delivery that fails
+// in one dispatcher and is re-driven by a fresh one over the same
+// outbox directory once the receiver recovers.
+func TestDispatcherOutboxSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Unix(1_700_000_000, 0).UTC()
+	clock := &testClock{t: now}
+	a := &stubAdapter{
+		id:       "outbox-restart",
+		supports: map[notify.Kind]bool{notify.KindTaskFailed: true},
+		sendErr:  errors.New("connection refused"),
+	}
+	factory := func() ([]Adapter, error) { return []Adapter{a}, nil }
+
+	first := NewDispatcher(nil, passthroughResolver, factory,
+		WithRetryDelays(nil), WithCircuitBreaker(0, 0), WithClock(clock.Now),
+		WithOutbox(NewOutbox(dir)))
+	first.dispatch(context.Background(), notify.Notification{
+		Kind: notify.KindTaskFailed, ProjectID: "proj-1", TaskNumber: 7, EmittedAt: now,
+	})
+	pending, err := first.FailedDeliveries(true)
+	if err != nil || len(pending) != 1 || pending[0].Attempts != 1 {
+		t.Fatalf("failed send not queued: %+v err %v", pending, err)
+	}
+
+	a.mu.Lock()
+	a.sendErr = nil
+	a.mu.Unlock()
+	second := NewDispatcher(nil, passthroughResolver, factory,
+		WithRetryDelays(nil), WithCircuitBreaker(0, 0), WithClock(clock.Now),
+		WithOutbox(NewOutbox(dir)))
+
+	second.drainOutbox(context.Background())
+	if got := len(a.Calls()); got != 1 {
+		t.Fatalf("drain before backoff elapsed sent %d times, want only the original attempt", got)
+	}
+
+	clock.t = now.Add(OutboxBackoff(1))
+	second.drainOutbox(context.Background())
+	calls := a.Calls()
+	if len(calls) != 2 || calls[1].TaskNumber != 7 {
+		t.Fatalf("calls = %+v, want the persisted payload re-sent", calls)
+	}
+	if left, _ := second.FailedDeliveries(true); len(left) != 0 {
+		t.Fatalf("delivered record left in outbox: %+v", left)
+	}
+}
+
+// TestDispatcherReplayDeadLetter asserts a dead-lettered delivery can
+// be replayed through the dispatcher and is sent on the next drain.
+func TestDispatcherReplayDeadLetter(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Unix(1_700_000_000, 0).UTC()
+	a := &stubAdapter{id: "outbox-replay", supports: map[notify.Kind]bool{notify.KindTaskFailed: true}}
+	outbox := NewOutbox(dir)
+	dl, err := outbox.Enqueue(a, samplePayload(), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < OutboxMaxAttempts; i++ {
+		if _, err := outbox.Fail(dl, errors.New("HTTP 500"), now); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d := NewDispatcher(nil, passthroughResolver,
+		func() ([]Adapter, error) { return []Adapter{a}, nil },
+		WithClock(func() time.Time { return now }), WithOutbox(outbox))
+	dead, err := d.FailedDeliveries(false)
+	if err != nil || len(dead) != 1 {
+		t.Fatalf("FailedDeliveries = %+v err %v", dead, err)
+	}
+	if _, err := d.ReplayDelivery(dl.ID); err != nil {
+		t.Fatalf("ReplayDelivery: %v", err)
+	}
+	d.drainOutbox(context.Background())
+	if got := len(a.Calls()); got != 1 {
+		t.Fatalf("replayed delivery sent %d times, want 1", got)
+	}
+	if left, _ := d.FailedDeliveries(true); len(left) != 0 {
+		t.Fatalf("replayed delivery still in outbox: %+v", left)
+	}
+}
+
+// TestDispatcherOutboxMissingAdapterCountsAsFailure pins that a record
+// whose adapter vanished keeps backing off instead of being dropped, so
+// a transient factory error at startup cannot discard the queue.
+func TestDispatcherOutboxMissingAdapterCountsAsFailure(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	outbox := NewOutbox(t.TempDir())
+	if _, err := outbox.Enqueue(&stubAdapter{id: "gone"}, samplePayload(), now); err != nil {
+		t.Fatal(err)
+	}
+	d := NewDispatcher(nil, passthroughResolver,
+		func() ([]Adapter, error) { return nil, nil },
+		WithClock(func() time.Time { return now }), WithOutbox(outbox))
+	d.drainOutbox(context.Background())
+
+	pending, _ := outbox.Pending()
+	if len(pending) != 1 || pending[0].Attempts != 1 || pending[0].LastError == "" {
+		t.Fatalf("pending = %+v, want one rescheduled record with an error", pending)
+	}
+}
+
+// TestDispatcherOutboxRechecksSettings asserts a stored delivery is
+// dropped, not sent, once its project is muted for the adapter or the
+// adapter stops taking the event kind.
+func TestDispatcherOutboxRechecksSettings(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	muted := &stubAdapter{id: "muted", supports: map[notify.Kind]bool{notify.KindTaskFailed: true},
+		mutedIDs: map[string]bool{"proj-1": true}}
+	unsubscribed := &stubAdapter{id: "unsubscribed"}
+	outbox := NewOutbox(t.TempDir())
+	for _, a := range []*stubAdapter{muted, unsubscribed} {
+		if _, err := outbox.Enqueue(a, samplePayload(), now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := NewDispatcher(nil, passthroughResolver,
+		func() ([]Adapter, error) { return []Adapter{muted, unsubscribed}, nil },
+		WithClock(func() time.Time { return now }), WithOutbox(outbox))
+	d.drainOutbox(context.Background())
+
+	if n := len(muted.Calls()) + len(unsubscribed.Calls()); n != 0 {
+		t.Fatalf("drain sent %d deliveries, want none", n)
+	}
+	if pending, _ := outbox.Pending(); len(pending) != 0 {
+		t.Fatalf("dropped deliveries still pending: %+v", pending)
+	}
+}
+
+// TestDispatcherOutboxDrainCapsPerAdapter asserts one pass re-sends at
+// most OutboxDrainPerAdapter records per adapter and leaves the rest due.
+func TestDispatcherOutboxDrainCapsPerAdapter(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	a := &stubAdapter{id: "backlog", supports: map[notify.Kind]bool{notify.KindTaskFailed: true}}
+	outbox := NewOutbox(t.TempDir())
+	for i := 0; i < OutboxDrainPerAdapter+5; i++ {
+		if _, err := outbox.Enqueue(a, samplePayload(), now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := NewDispatcher(nil, passthroughResolver,
+		func() ([]Adapter, error) { return []Adapter{a}, nil },
+		WithClock(func() time.Time { return now }), WithOutbox(outbox))
+
+	d.drainOutbox(context.Background())
+	if got := len(a.Calls()); got != OutboxDrainPerAdapter {
+		t.Fatalf("first pass sent %d, want %d", got, OutboxDrainPerAdapter)
+	}
+	d.drainOutbox(context.Background())
+	if got := len(a.Calls()); got != OutboxDrainPerAdapter+5 {
+		t.Fatalf("second pass left the queue at %d sends, want %d", got, OutboxDrainPerAdapter+5)
+	}
+}
+
+func TestDispatcherWithoutOutboxRejectsDeliveryCalls(t *testing.T) {
+	d := NewDispatcher(nil, passthroughResolver, func() ([]Adapter, error) { return nil, nil })
+	if _, err := d.FailedDeliveries(true); !errors.Is(err, ErrOutboxDisabled) {
+		t.Fatalf("FailedDeliveries: want ErrOutboxDisabled, got %v", err)
+	}
+	if _, err := d.ReplayDelivery("x"); !errors.Is(err, ErrOutboxDisabled) {
+		t.Fatalf("ReplayDelivery: want ErrOutboxDisabled, got %v", err)
+	}
+}
@@ -12,7 +12,9 @@ package relay
 //
 //   {
 //     "version":             1,
-//     "kind":                "TASK_FAILED" | "RUN_COMPLETE" | "WEEKLY_DIGEST",
+//     "kind":                "TASK_FAILED" | "RUN_COMPLETE" | "WEEKLY_DIGEST" | "BUDGET_THRESHOLD" |
+//                            "TASK_SUCCEEDED" | "MERGE_FAILED" | "PR_OPENED" | "AGENT_NEEDS_AUTH" |
+//                            "RATE_LIMITED" | "WILDFIRE_PHASE_CHANGED" | "TASKS_GENERATED",
 //     "emitted_at":          "2026-05-02T09:30:00Z",
 //     "project_id":          "<uuid>",
 //     "project_name":        "<display>",
@@ -23,7 +25,18 @@ package relay
 //     "deep_link":           "watchfire://...", // task or digest
 //     "digest_date":         "2026-05-02",      // WEEKLY_DIGEST only
 //     "digest_path":         "/.../digests/<date>.md",
-//     "digest_body":         "<rendered markdown>"
+//     "digest_body":         "<rendered markdown>",
+//     "detail":              "<merge error / agent issue message>", // MERGE_FAILED, AGENT_NEEDS_AUTH, RATE_LIMITED
+//     "url":                 "https://github.com/.../pull/7",        // PR_OPENED only
+//     "phase":               "execute",         // WILDFIRE_PHASE_CHANGED only
+//     "previous_phase":      "refine",
+//     "count":               3,                 // TASKS_GENERATED only
+//     "agent":               "codex",           // task-bound kinds: the agent backend
+//     "duration_seconds":    1260,              // task-bound kinds: started → completed (or emitted)
+//     "budget": {                              // BUDGET_THRESHOLD only
+//       "scope": "global" | "project", "month": "2026-05", "threshold": 80,
+//       "spent_usd": 81.5, "limit_usd": 100, "hard_stop": true
+//     }
 //   }
 //
 // Receivers MUST ignore unknown fields. Adding a new field is a minor
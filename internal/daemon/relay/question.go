@@ -0,0 +1,254 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
+)
+
+// QuestionPoster is implemented by the chat adapters that can post an
+// agent's question: Slack, Discord and Telegram. Suggested answers
+// become buttons; free-text answers come back through the `answer`
+// command of each inbound transport.
+type QuestionPoster interface {
+	PostQuestion(ctx context.Context, q ask.Question) error
+}
+
+// ErrNoQuestionTransport is returned by PostQuestion when no configured
+// adapter can take the question.
+var ErrNoQuestionTransport = errors.New("no Slack, Discord or Telegram endpoint can receive questions for this project")
+
+// PostQuestion posts q to every QuestionPoster adapter that has not
+// muted the project. Like approval requests, questions are sent once,
+// outside the retry / outbox path. It fails when no adapter received
+// it.
+func (d *Dispatcher) PostQuestion(ctx context.Context, q ask.Question) error {
+	var errs []error
+	reached := 0
+	for _, a := range d.Adapters() {
+		poster, ok := a.(QuestionPoster)
+		if !ok {
+			continue
+		}
+		if mp, ok := a.(interface{ IsProjectMuted(string) bool }); ok && mp.IsProjectMuted(q.ProjectID) {
+			continue
+		}
+		if err := poster.PostQuestion(ctx, q); err != nil {
+			d.logger.Printf("WARN: relay dispatcher: question for %q via %q: %v", q.ProjectID, a.ID(), err)
+			errs = append(errs, err)
+			continue
+		}
+		reached++
+	}
+	if reached > 0 {
+		return nil
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return ErrNoQuestionTransport
+}
+
+// questionHeadline is the one-line title every transport leads with.
+func questionHeadline(q ask.Question) string {
+	if q.ProjectName == "" {
+		return "The agent has a question"
+	}
+	return "The agent has a question — " + q.ProjectName
+}
+
+// questionSubject names what the agent is working on: the task, or
+// the agent alone outside task sessions.
+func questionSubject(q ask.Question) string {
+	if q.TaskNumber > 0 {
+		return taskLabel(Payload{TaskNumber: q.TaskNumber, TaskTitle: q.TaskTitle})
+	}
+	return q.Agent
+}
+
+// questionOptionLines numbers the suggested answers.
+func questionOptionLines(q ask.Question) []string {
+	lines := make([]string, 0, len(q.Options))
+	for i, o := range q.Options {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, o))
+	}
+	return lines
+}
+
+// questionFooter tells the reader how to answer on transport, whose
+// answer command is answerCmd.
+func questionFooter(q ask.Question, answerCmd string) string {
+	how := "Answer with " + answerCmd + " <text>"
+	if len(q.Options) > 0 {
+		how = "Pick an option or answer with " + answerCmd + " <text>"
+	}
+	if q.TaskNumber > 0 && q.Agent != "" {
+		return q.Agent + " · " + how
+	}
+	return how
+}
+
+// PostQuestion posts q as a Block Kit message; suggested answers become
+// buttons with `watchfire_answer_<n>` action ids.
+func (s *SlackAdapter) PostQuestion(ctx context.Context, q ask.Question) error {
+	if s.endpoint.URL == "" {
+		return fmt.Errorf("slack adapter %q: webhook URL not resolved (keyring miss?)", s.endpoint.ID)
+	}
+	body, err := json.Marshal(slackQuestionMessage(q))
+	if err != nil {
+		return fmt.Errorf("slack adapter %q: encode question: %w", s.endpoint.ID, err)
+	}
+	return s.post(ctx, body)
+}
+
+func slackQuestionMessage(q ask.Question) map[string]any {
+	mrkdwn := func(text string) map[string]any { return map[string]any{"type": "mrkdwn", "text": text} }
+	text := ">" + strings.ReplaceAll(q.Text, "\n", "\n>")
+	if subject := questionSubject(q); subject != "" {
+		text = "*" + subject + "*\n" + text
+	}
+	blocks := []map[string]any{
+		{"type": "header", "text": map[string]any{"type": "plain_text", "text": trimRunes(questionHeadline(q), 150)}},
+		{"type": "section", "text": mrkdwn(trimRunes(text, 3000))},
+	}
+	if len(q.Options) > 0 {
+		var buttons []any
+		for i, o := range q.Options {
+			buttons = append(buttons, map[string]any{
+				"type":      "button",
+				"action_id": fmt.Sprintf("%s%d", ask.SlackActionPrefix, i+1),
+				"text":      map[string]any{"type": "plain_text", "text": trimRunes(o, 75)},
+				"value":     q.Ref(),
+			})
+		}
+		blocks = append(blocks, map[string]any{"type": "actions", "block_id": "watchfire_answer", "elements": buttons})
+	}
+	blocks = append(blocks, map[string]any{"type": "context", "elements": []any{mrkdwn(questionFooter(q, "`/watchfire answer`"))}})
+	return map[string]any{
+		"text":   questionHeadline(q) + ": " + q.Text,
+		"blocks": blocks,
+	}
+}
+
+// PostQuestion posts q as an embed; suggested answers become a row of
+// buttons carrying ask.ButtonData (the webhook must be owned by the
+// Watchfire app, as for approval requests).
+func (d *DiscordAdapter) PostQuestion(ctx context.Context, q ask.Question) error {
+	if d.endpoint.URL == "" {
+		return fmt.Errorf("discord adapter %q: webhook URL not resolved (keyring miss?)", d.endpoint.ID)
+	}
+	target := d.endpoint.URL
+	if len(q.Options) > 0 {
+		var err error
+		if target, err = withComponents(d.endpoint.URL); err != nil {
+			return fmt.Errorf("discord adapter %q: parse webhook URL: %w", d.endpoint.ID, err)
+		}
+	}
+	body, err := json.Marshal(discordQuestionMessage(q))
+	if err != nil {
+		return fmt.Errorf("discord adapter %q: encode question: %w", d.endpoint.ID, err)
+	}
+	return d.post(ctx, target, body)
+}
+
+func discordQuestionMessage(q ask.Question) map[string]any {
+	description := q.Text
+	if subject := questionSubject(q); subject != "" {
+		description = "**" + subject + "**\n" + description
+	}
+	if lines := questionOptionLines(q); len(lines) > 0 {
+		description += "\n\n" + strings.Join(lines, "\n")
+	}
+	msg := map[string]any{
+		"embeds": []any{map[string]any{
+			"title":       trimRunes(questionHeadline(q), 256),
+			"description": trimRunes(description, discordEmbedDescriptionLimit),
+			"color":       0x3b82f6,
+			"footer":      map[string]any{"text": questionFooter(q, "/watchfire answer")},
+		}},
+	}
+	if len(q.Options) > 0 {
+		var buttons []any
+		for i, o := range q.Options {
+			buttons = append(buttons, map[string]any{
+				"type":      discordComponentButton,
+				"style":     discordButtonSecondary,
+				"label":     trimRunes(o, 80),
+				"custom_id": ask.ButtonData(q, i+1),
+			})
+		}
+		msg["components"] = []any{map[string]any{"type": discordComponentActionRow, "components": buttons}}
+	}
+	return msg
+}
+
+// PostQuestion sends q to every unmuted paired chat, with an inline
+// keyboard of the suggested answers.
+func (t *TelegramAdapter) PostQuestion(ctx context.Context, q ask.Question) error {
+	if t.cfg.BotToken == "" {
+		return fmt.Errorf("telegram adapter: bot token not resolved (keyring miss?)")
+	}
+	text := FormatTelegramQuestion(q)
+	var keyboard [][]telegrambot.InlineKeyboardButton
+	if len(q.Options) > 0 {
+		row := make([]telegrambot.InlineKeyboardButton, 0, len(q.Options))
+		for i, o := range q.Options {
+			row = append(row, telegrambot.InlineKeyboardButton{Text: o, CallbackData: ask.ButtonData(q, i+1)})
+		}
+		keyboard = append(keyboard, row)
+	}
+	var errs []error
+	sent := 0
+	for _, chat := range t.cfg.PairedChats {
+		if chat.Muted {
+			continue
+		}
+		var err error
+		if keyboard != nil {
+			_, err = t.client.SendMessageWithKeyboard(ctx, t.cfg.BotToken, chat.ChatID, text, keyboard)
+		} else {
+			_, err = t.client.SendMessage(ctx, t.cfg.BotToken, chat.ChatID, text)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("telegram adapter: chat %d: %w", chat.ChatID, err))
+			continue
+		}
+		sent++
+	}
+	if sent > 0 {
+		for _, err := range errs {
+			t.logger.Printf("WARN: %v", err)
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("telegram adapter: no unmuted paired chats")
+	}
+	return errors.Join(errs...)
+}
+
+// FormatTelegramQuestion renders a question as Telegram HTML.
+func FormatTelegramQuestion(q ask.Question) string {
+	lines := []string{"❓ <b>" + telegramEscape(questionHeadline(q)) + "</b>"}
+	if subject := questionSubject(q); subject != "" {
+		lines = append(lines, "<b>"+telegramEscape(subject)+"</b>")
+	}
+	lines = append(lines, "<blockquote>"+telegramEscape(trimRunes(q.Text, 3000))+"</blockquote>")
+	if opts := questionOptionLines(q); len(opts) > 0 {
+		lines = append(lines, telegramEscape(strings.Join(opts, "\n")))
+	}
+	lines = append(lines, "<i>"+telegramEscape(questionFooter(q, "/answer"))+"</i>")
+	return strings.Join(lines, "\n")
+}
+
+// Compile-time assertions for the question-capable adapters.
+var (
+	_ QuestionPoster = (*SlackAdapter)(nil)
+	_ QuestionPoster = (*DiscordAdapter)(nil)
+	_ QuestionPoster = (*TelegramAdapter)(nil)
+)
@@ -0,0 +1,188 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func questionFixture() ask.Question {
+	return ask.Question{
+		ID:          7,
+		ProjectID:   "proj-abc",
+		ProjectName: "Watchfire",
+		TaskNumber:  42,
+		TaskTitle:   "Build the Discord adapter",
+		Agent:       "Claude Code",
+		Text:        "Which database should the cache use?",
+		Options:     []string{"Redis", "Memcached"},
+		AskedAt:     time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestSlackPostQuestion(t *testing.T) {
+	srv, captured := captureServer(t)
+	s := newSlackAdapterForTest(t, models.SlackEndpoint{ID: "ep", URL: srv.URL})
+
+	if err := s.PostQuestion(context.Background(), questionFixture()); err != nil {
+		t.Fatalf("PostQuestion: %v", err)
+	}
+	body, _ := captured()
+	var msg struct {
+		Blocks []struct {
+			Type     string `json:"type"`
+			BlockID  string `json:"block_id"`
+			Elements []struct {
+				ActionID string `json:"action_id"`
+				Value    string `json:"value"`
+			} `json:"elements"`
+		} `json:"blocks"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		t.Fatalf("decode: %v\n%s", err, body)
+	}
+	var actions bool
+	for _, b := range msg.Blocks {
+		if b.Type != "actions" {
+			continue
+		}
+		actions = true
+		if b.BlockID != "watchfire_answer" || len(b.Elements) != 2 {
+			t.Fatalf("actions block = %+v", b)
+		}
+		for i, el := range b.Elements {
+			if want := ask.SlackActionPrefix + string(rune('1'+i)); el.ActionID != want || el.Value != "proj-abc:7" {
+				t.Errorf("button %d = %+v, want %s / proj-abc:7", i, el, want)
+			}
+		}
+	}
+	if !actions {
+		t.Fatalf("no option buttons:\n%s", body)
+	}
+	for _, frag := range []string{"*Task #0042 — Build the Discord adapter*", "Which database should the cache use?", "`/watchfire answer`"} {
+		if !strings.Contains(string(body), frag) {
+			t.Errorf("body missing %q:\n%s", frag, body)
+		}
+	}
+}
+
+func TestDiscordPostQuestion(t *testing.T) {
+	srv, captured := captureServer(t)
+	d, err := NewDiscordAdapter(models.DiscordEndpoint{ID: "ep", URL: srv.URL + "/api/webhooks/1/tok"}, nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewDiscordAdapter: %v", err)
+	}
+
+	if err := d.PostQuestion(context.Background(), questionFixture()); err != nil {
+		t.Fatalf("PostQuestion: %v", err)
+	}
+	body, query := captured()
+	if !strings.Contains(query, "with_components=true") {
+		t.Errorf("query = %q, want with_components=true", query)
+	}
+	var msg struct {
+		Components []struct {
+			Components []struct {
+				Label    string `json:"label"`
+				CustomID string `json:"custom_id"`
+			} `json:"components"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		t.Fatalf("decode: %v\n%s", err, body)
+	}
+	if len(msg.Components) != 1 || len(msg.Components[0].Components) != 2 {
+		t.Fatalf("components = %+v", msg.Components)
+	}
+	if got := msg.Components[0].Components[1]; got.Label != "Memcached" || got.CustomID != "ask:2:proj-abc:7" {
+		t.Errorf("second button = %+v", got)
+	}
+
+	// A free-text question has no buttons, so the webhook needs no
+	// component support.
+	q := questionFixture()
+	q.Options = nil
+	if err := d.PostQuestion(context.Background(), q); err != nil {
+		t.Fatalf("PostQuestion: %v", err)
+	}
+	body, query = captured()
+	if strings.Contains(query, "with_components") || strings.Contains(string(body), `"components"`) {
+		t.Errorf("free-text question: query=%q body=%s", query, body)
+	}
+}
+
+func TestTelegramPostQuestion(t *testing.T) {
+	api := startFakeTelegramAPI(t)
+	cfg := telegramTestConfig(
+		models.TelegramPairedChat{ChatID: 111},
+		models.TelegramPairedChat{ChatID: 222, Muted: true},
+	)
+	a := NewTelegramAdapter(cfg, telegrambot.New(), nil)
+
+	if err := a.PostQuestion(context.Background(), questionFixture()); err != nil {
+		t.Fatalf("PostQuestion: %v", err)
+	}
+	q := questionFixture()
+	q.Options = nil
+	if err := a.PostQuestion(context.Background(), q); err != nil {
+		t.Fatalf("PostQuestion: %v", err)
+	}
+	sends := api.Sends()
+	if len(sends) != 2 || sends[0].ChatID != 111 || sends[1].ChatID != 111 {
+		t.Fatalf("sends = %+v, want two to chat 111", sends)
+	}
+	if !strings.Contains(sends[0].Text, "<blockquote>Which database should the cache use?</blockquote>") ||
+		!strings.Contains(sends[0].Text, "/answer") {
+		t.Errorf("text = %q", sends[0].Text)
+	}
+	if !strings.Contains(sends[0].ReplyMarkup, `"callback_data":"ask:1:proj-abc:7"`) {
+		t.Errorf("reply_markup = %q", sends[0].ReplyMarkup)
+	}
+	if sends[1].ReplyMarkup != "" {
+		t.Errorf("free-text question got a keyboard: %q", sends[1].ReplyMarkup)
+	}
+}
+
+// questionStub is a stubAdapter that can also post questions.
+type questionStub struct {
+	stubAdapter
+	postErr error
+	posted  []ask.Question
+}
+
+func (s *questionStub) PostQuestion(_ context.Context, q ask.Question) error {
+	s.posted = append(s.posted, q)
+	return s.postErr
+}
+
+func TestDispatcherPostQuestion(t *testing.T) {
+	plain := &stubAdapter{id: "webhook"}
+	muted := &questionStub{stubAdapter: stubAdapter{id: "muted", mutedIDs: map[string]bool{"proj-abc": true}}}
+	failing := &questionStub{stubAdapter: stubAdapter{id: "failing"}, postErr: errors.New("boom")}
+	ok := &questionStub{stubAdapter: stubAdapter{id: "ok"}}
+	adapters := []Adapter{plain, muted, failing, ok}
+	factory := func() ([]Adapter, error) { return adapters, nil }
+	d := NewDispatcher(nil, passthroughResolver, factory, WithLogger(log.New(io.Discard, "", 0)))
+
+	if err := d.PostQuestion(context.Background(), questionFixture()); err != nil {
+		t.Fatalf("PostQuestion: %v", err)
+	}
+	if len(muted.posted) != 0 || len(failing.posted) != 1 || len(ok.posted) != 1 {
+		t.Errorf("posted: muted=%d failing=%d ok=%d", len(muted.posted), len(failing.posted), len(ok.posted))
+	}
+
+	adapters = []Adapter{plain, muted}
+	d.Reload()
+	if err := d.PostQuestion(context.Background(), questionFixture()); !errors.Is(err, ErrNoQuestionTransport) {
+		t.Errorf("no question-capable endpoint: want ErrNoQuestionTransport, got %v", err)
+	}
+}
@@ -41,6 +41,15 @@ type Payload struct {
 	DigestDate        string    `json:"digest_date,omitempty"`
 	DigestPath        string    `json:"digest_path,omitempty"`
 	DigestBody        string    `json:"digest_body,omitempty"`
+	Detail            string    `json:"detail,omitempty"`
+	URL               string    `json:"url,omitempty"`
+	Phase             string    `json:"phase,omitempty"`
+	PreviousPhase     string    `json:"previous_phase,omitempty"`
+	Count             int       `json:"count,omitempty"`
+	Agent             string    `json:"agent,omitempty"`
+	DurationSeconds   int64     `json:"duration_seconds,omitempty"`
+
+	Budget *notify.BudgetAlert `json:"budget,omitempty"`
 }
 
 // PayloadInput carries everything BuildPayload needs to derive a Payload
@@ -52,6 +61,8 @@ type PayloadInput struct {
 	ProjectColor      string
 	TaskTitle         string
 	TaskFailureReason string
+	Agent             string
+	Duration          time.Duration
 	DigestDate        string
 	DigestPath        string
 	DigestBody        string
@@ -59,17 +70,33 @@ type PayloadInput struct {
 
 // BuildPayload turns a PayloadInput into a canonical Payload. The deep
 // link is derived from the notification's project + task fields:
-// `watchfire://project/<id>/task/<n>` for task-bound notifications, or
-// `watchfire://digest/<date>` for the weekly digest.
+// `watchfire://project/<id>/task/<n>` for task-bound notifications,
+// `watchfire://digest/<date>` for the weekly digest, and
+// `watchfire://project/<id>` or `watchfire://budget/<month>` for a
+// project or global budget alert. Project-level events (wildfire phase
+// changes, generated tasks, and agent issues outside a task session)
+// link to the project.
 func BuildPayload(in PayloadInput) Payload {
 	n := in.Notification
 	deepLink := fmt.Sprintf("watchfire://project/%s/task/%04d", n.ProjectID, n.TaskNumber)
-	if n.Kind == notify.KindWeeklyDigest {
+	switch n.Kind {
+	case notify.KindWeeklyDigest:
 		date := in.DigestDate
 		if date == "" {
 			date = n.EmittedAt.UTC().Format("2006-01-02")
 		}
 		deepLink = "watchfire://digest/" + date
+	case notify.KindBudgetThreshold:
+		deepLink = "watchfire://project/" + n.ProjectID
+		if n.ProjectID == "" && n.Budget != nil {
+			deepLink = "watchfire://budget/" + n.Budget.Month
+		}
+	case notify.KindWildfirePhase, notify.KindTasksGenerated:
+		deepLink = "watchfire://project/" + n.ProjectID
+	default:
+		if n.TaskNumber == 0 {
+			deepLink = "watchfire://project/" + n.ProjectID
+		}
 	}
 	return Payload{
 		Version:           1,
@@ -85,6 +112,14 @@ func BuildPayload(in PayloadInput) Payload {
 		DigestDate:        in.DigestDate,
 		DigestPath:        in.DigestPath,
 		DigestBody:        in.DigestBody,
+		Detail:            n.Detail,
+		URL:               n.URL,
+		Phase:             n.Phase,
+		PreviousPhase:     n.PreviousPhase,
+		Count:             n.Count,
+		Agent:             in.Agent,
+		DurationSeconds:   int64(in.Duration / time.Second),
+		Budget:            n.Budget,
 	}
 }
 
@@ -0,0 +1,293 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// ChatRouter is implemented by adapters that fan out to several chats
+// (Telegram) and can deliver to a subset of them when a routing rule
+// names specific chats.
+type ChatRouter interface {
+	SendToChats(ctx context.Context, chatIDs []int64, p Payload) error
+}
+
+// RouteFactory returns the current routing rules. Like AdapterFactory it
+// runs at construction and on every Reload.
+type RouteFactory func() (*Router, error)
+
+// Router is the compiled form of the `routes:` rules in
+// integrations.yaml. A nil Router routes everything by default.
+type Router struct {
+	routes []*route
+}
+
+type route struct {
+	name        string
+	events      []notify.Kind
+	projects    []string
+	agents      []string
+	title       *regexp.Regexp
+	reason      *regexp.Regexp
+	minDuration time.Duration
+	maxDuration time.Duration
+	endpoints   []string
+	chats       []int64
+	cont        bool
+	keepDefault bool
+}
+
+// CompileRoutes validates rules and compiles their patterns. Every rule
+// needs a name and at least one target; events, regexes and durations
+// must parse.
+func CompileRoutes(rules []models.RouteRule) (*Router, error) {
+	r := &Router{}
+	for i, rule := range rules {
+		name := strings.TrimSpace(rule.Name)
+		if name == "" {
+			return nil, fmt.Errorf("route %d: name is required", i+1)
+		}
+		rt := &route{
+			name:        name,
+			endpoints:   rule.To.Endpoints,
+			chats:       rule.To.TelegramChats,
+			cont:        rule.Continue,
+			keepDefault: rule.KeepDefault,
+		}
+		if len(rt.endpoints) == 0 && len(rt.chats) == 0 {
+			return nil, fmt.Errorf("route %q: no endpoints or telegram_chats to route to", name)
+		}
+		m := rule.Match
+		for _, key := range m.Events {
+			kind, ok := notify.KindForEventKey(strings.ToLower(strings.TrimSpace(key)))
+			if !ok {
+				return nil, fmt.Errorf("route %q: unknown event %q", name, key)
+			}
+			rt.events = append(rt.events, kind)
+		}
+		for _, p := range m.Projects {
+			rt.projects = append(rt.projects, strings.TrimSpace(p))
+		}
+		for _, a := range m.Agents {
+			rt.agents = append(rt.agents, strings.ToLower(strings.TrimSpace(a)))
+		}
+		var err error
+		if rt.title, err = compileRoutePattern(m.Title); err != nil {
+			return nil, fmt.Errorf("route %q: title: %w", name, err)
+		}
+		if rt.reason, err = compileRoutePattern(m.FailureReason); err != nil {
+			return nil, fmt.Errorf("route %q: failure_reason: %w", name, err)
+		}
+		if rt.minDuration, err = parseRouteDuration(m.MinDuration); err != nil {
+			return nil, fmt.Errorf("route %q: min_duration: %w", name, err)
+		}
+		if rt.maxDuration, err = parseRouteDuration(m.MaxDuration); err != nil {
+			return nil, fmt.Errorf("route %q: max_duration: %w", name, err)
+		}
+		r.routes = append(r.routes, rt)
+	}
+	return r, nil
+}
+
+func compileRoutePattern(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+func parseRouteDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err == nil && d < 0 {
+		err = fmt.Errorf("negative duration %q", s)
+	}
+	return d, err
+}
+
+// matches reports whether every filter the rule sets accepts p.
+func (rt *route) matches(p Payload) bool {
+	if len(rt.events) > 0 && !slices.Contains(rt.events, notify.Kind(p.Kind)) {
+		return false
+	}
+	if len(rt.projects) > 0 && !slices.ContainsFunc(rt.projects, func(want string) bool {
+		return want == p.ProjectID || (p.ProjectName != "" && strings.EqualFold(want, p.ProjectName))
+	}) {
+		return false
+	}
+	if len(rt.agents) > 0 && !slices.Contains(rt.agents, strings.ToLower(p.Agent)) {
+		return false
+	}
+	if rt.title != nil && !rt.title.MatchString(p.TaskTitle) {
+		return false
+	}
+	if rt.reason != nil {
+		reason := p.TaskFailureReason
+		if reason == "" {
+			reason = p.Detail
+		}
+		if !rt.reason.MatchString(reason) {
+			return false
+		}
+	}
+	if rt.minDuration > 0 || rt.maxDuration > 0 {
+		d := time.Duration(p.DurationSeconds) * time.Second
+		if d <= 0 || (rt.minDuration > 0 && d < rt.minDuration) || (rt.maxDuration > 0 && d > rt.maxDuration) {
+			return false
+		}
+	}
+	return true
+}
+
+// Target is one delivery a notification is routed to.
+type Target struct {
+	Adapter Adapter
+	// Chats narrows a ChatRouter adapter to these chat ids; empty means
+	// every chat.
+	Chats []int64
+	// Rule names the routing rule that selected the target; empty for
+	// the default routing.
+	Rule string
+}
+
+// send returns the adapter to hand the payload to — the adapter itself,
+// or a chat-scoped view of it.
+func (t Target) send() Adapter { return scopeToChats(t.Adapter, t.Chats) }
+
+// RoutePlan is where one notification goes: the rules that matched,
+// whether the default routing applied, and the resulting targets.
+type RoutePlan struct {
+	Rules   []string
+	Default bool
+	Targets []Target
+	// Muted lists adapter ids dropped because the project is muted.
+	Muted []string
+	// Unknown lists rule targets that name no configured adapter (or a
+	// Telegram chat when Telegram is not set up).
+	Unknown []string
+}
+
+// Plan routes p across adapters. Rules run in order and the first match
+// wins unless it sets continue; with no match (or a keep_default match)
+// every adapter that Supports the kind is added. Rule targets bypass the
+// event toggles — the rule is the more specific instruction — but every
+// target is dropped for a project its adapter has muted.
+func (r *Router) Plan(adapters []Adapter, p Payload) RoutePlan {
+	var plan RoutePlan
+	byID := make(map[string]Adapter, len(adapters))
+	for _, a := range adapters {
+		byID[a.ID()] = a
+	}
+	index := map[string]int{}
+	add := func(a Adapter, chats []int64, rule string) {
+		i, ok := index[a.ID()]
+		if !ok {
+			index[a.ID()] = len(plan.Targets)
+			plan.Targets = append(plan.Targets, Target{Adapter: a, Chats: chats, Rule: rule})
+			return
+		}
+		t := &plan.Targets[i]
+		if len(t.Chats) == 0 || len(chats) == 0 {
+			t.Chats = nil
+			return
+		}
+		for _, c := range chats {
+			if !slices.Contains(t.Chats, c) {
+				t.Chats = append(t.Chats, c)
+			}
+		}
+	}
+
+	matched, keepDefault := false, false
+	if r != nil {
+		for _, rt := range r.routes {
+			if !rt.matches(p) {
+				continue
+			}
+			matched = true
+			keepDefault = keepDefault || rt.keepDefault
+			plan.Rules = append(plan.Rules, rt.name)
+			for _, id := range rt.endpoints {
+				a, ok := byID[id]
+				if !ok {
+					plan.Unknown = append(plan.Unknown, id)
+					continue
+				}
+				add(a, nil, rt.name)
+			}
+			if len(rt.chats) > 0 {
+				if a := chatRouterAdapter(adapters); a != nil {
+					add(a, slices.Clone(rt.chats), rt.name)
+				} else {
+					plan.Unknown = append(plan.Unknown, "telegram")
+				}
+			}
+			if !rt.cont {
+				break
+			}
+		}
+	}
+	if !matched || keepDefault {
+		plan.Default = true
+		for _, a := range adapters {
+			if a.Supports(notify.Kind(p.Kind)) {
+				add(a, nil, "")
+			}
+		}
+	}
+
+	if p.ProjectID != "" {
+		kept := plan.Targets[:0]
+		for _, t := range plan.Targets {
+			if mp, ok := t.Adapter.(interface{ IsProjectMuted(string) bool }); ok && mp.IsProjectMuted(p.ProjectID) {
+				plan.Muted = append(plan.Muted, t.Adapter.ID())
+				continue
+			}
+			kept = append(kept, t)
+		}
+		plan.Targets = kept
+	}
+	return plan
+}
+
+func chatRouterAdapter(adapters []Adapter) Adapter {
+	for _, a := range adapters {
+		if _, ok := a.(ChatRouter); ok {
+			return a
+		}
+	}
+	return nil
+}
+
+// chatScopedAdapter is a ChatRouter adapter narrowed to some of its
+// chats. It keeps the adapter's id so retries, the circuit breaker and
+// the outbox treat it as the same adapter.
+type chatScopedAdapter struct {
+	Adapter
+	chats []int64
+}
+
+func (c chatScopedAdapter) Send(ctx context.Context, p Payload) error {
+	return c.Adapter.(ChatRouter).SendToChats(ctx, c.chats, p)
+}
+
+// scopeToChats narrows a to chats when it is a ChatRouter and chats is
+// non-empty; otherwise a is returned as is.
+func scopeToChats(a Adapter, chats []int64) Adapter {
+	if len(chats) == 0 {
+		return a
+	}
+	if _, ok := a.(ChatRouter); !ok {
+		return a
+	}
+	return chatScopedAdapter{Adapter: a, chats: chats}
+}
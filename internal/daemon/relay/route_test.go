@@ -0,0 +1,222 @@
+package relay
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// chatStub is a ChatRouter adapter recording which chats each send
+// reached; a plain Send reaches every chat (nil).
+type chatStub struct {
+	stubAdapter
+	chatMu    sync.Mutex
+	chatCalls [][]int64
+}
+
+func (c *chatStub) Send(ctx context.Context, p Payload) error {
+	return c.SendToChats(ctx, nil, p)
+}
+
+func (c *chatStub) SendToChats(_ context.Context, chatIDs []int64, _ Payload) error {
+	c.chatMu.Lock()
+	defer c.chatMu.Unlock()
+	c.chatCalls = append(c.chatCalls, chatIDs)
+	return nil
+}
+
+func (c *chatStub) ChatCalls() [][]int64 {
+	c.chatMu.Lock()
+	defer c.chatMu.Unlock()
+	return append([][]int64(nil), c.chatCalls...)
+}
+
+func mustRoutes(t *testing.T, rules ...models.RouteRule) *Router {
+	t.Helper()
+	r, err := CompileRoutes(rules)
+	if err != nil {
+		t.Fatalf("CompileRoutes: %v", err)
+	}
+	return r
+}
+
+func targetIDs(plan RoutePlan) []string {
+	var out []string
+	for _, t := range plan.Targets {
+		out = append(out, t.Adapter.ID())
+	}
+	return out
+}
+
+func TestCompileRoutesRejects(t *testing.T) {
+	to := models.RouteTargets{Endpoints: []string{"a"}}
+	for name, rule := range map[string]models.RouteRule{
+		"no name":      {To: to},
+		"no targets":   {Name: "r"},
+		"bad event":    {Name: "r", To: to, Match: models.RouteMatch{Events: []string{"nope"}}},
+		"bad title":    {Name: "r", To: to, Match: models.RouteMatch{Title: "("}},
+		"bad reason":   {Name: "r", To: to, Match: models.RouteMatch{FailureReason: "[a-"}},
+		"bad duration": {Name: "r", To: to, Match: models.RouteMatch{MinDuration: "soon"}},
+		"negative":     {Name: "r", To: to, Match: models.RouteMatch{MaxDuration: "-1m"}},
+	} {
+		if _, err := CompileRoutes([]models.RouteRule{rule}); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestRouteMatching(t *testing.T) {
+	base := Payload{
+		Kind: string(notify.KindTaskFailed), ProjectID: "p1", ProjectName: "Watchfire",
+		Agent: "codex", TaskTitle: "Deploy the API", TaskFailureReason: "OOM killed",
+		DurationSeconds: 40 * 60,
+	}
+	cases := []struct {
+		name  string
+		match models.RouteMatch
+		want  bool
+	}{
+		{"empty matches everything", models.RouteMatch{}, true},
+		{"event", models.RouteMatch{Events: []string{"run_complete", "task_failed"}}, true},
+		{"other event", models.RouteMatch{Events: []string{"run_complete"}}, false},
+		{"project id", models.RouteMatch{Projects: []string{"p1"}}, true},
+		{"project name", models.RouteMatch{Projects: []string{"watchfire"}}, true},
+		{"other project", models.RouteMatch{Projects: []string{"p2"}}, false},
+		{"agent", models.RouteMatch{Agents: []string{"Codex"}}, true},
+		{"other agent", models.RouteMatch{Agents: []string{"claude-code"}}, false},
+		{"title regex", models.RouteMatch{Title: "(?i)deploy"}, true},
+		{"reason regex", models.RouteMatch{FailureReason: "timeout|OOM"}, true},
+		{"reason miss", models.RouteMatch{FailureReason: "timeout"}, false},
+		{"min duration", models.RouteMatch{MinDuration: "30m"}, true},
+		{"max duration", models.RouteMatch{MaxDuration: "30m"}, false},
+		{"all fields", models.RouteMatch{Events: []string{"task_failed"}, Projects: []string{"p1"}, Agents: []string{"codex"}, MinDuration: "10m", MaxDuration: "1h"}, true},
+	}
+	for _, tc := range cases {
+		r := mustRoutes(t, models.RouteRule{Name: "r", Match: tc.match, To: models.RouteTargets{Endpoints: []string{"a"}}})
+		if got := r.routes[0].matches(base); got != tc.want {
+			t.Errorf("%s: matches = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	// A detail stands in for the failure reason on merge / agent issues,
+	// and a payload without a duration never matches duration bounds.
+	r := mustRoutes(t, models.RouteRule{Name: "r", Match: models.RouteMatch{FailureReason: "conflict", MinDuration: "1s"}, To: models.RouteTargets{Endpoints: []string{"a"}}})
+	merge := Payload{Kind: string(notify.KindMergeFailed), Detail: "merge conflict in README.md", DurationSeconds: 5}
+	if !r.routes[0].matches(merge) {
+		t.Error("failure_reason should fall back to the detail")
+	}
+	merge.DurationSeconds = 0
+	if r.routes[0].matches(merge) {
+		t.Error("a payload without a duration must not match duration bounds")
+	}
+}
+
+func TestRouterPlan(t *testing.T) {
+	failed := map[notify.Kind]bool{notify.KindTaskFailed: true}
+	general := &stubAdapter{id: "general", supports: failed}
+	alerts := &stubAdapter{id: "x-alerts"}
+	muted := &stubAdapter{id: "muted", supports: failed, mutedIDs: map[string]bool{"p1": true}}
+	tg := &chatStub{stubAdapter: stubAdapter{id: "telegram", supports: failed}}
+	adapters := []Adapter{general, alerts, muted, tg}
+	p := Payload{Kind: string(notify.KindTaskFailed), ProjectID: "p1", Agent: "codex"}
+	codex := models.RouteMatch{Agents: []string{"codex"}}
+
+	// No rules: every adapter with the event enabled, minus mutes.
+	plan := (*Router)(nil).Plan(adapters, p)
+	if !plan.Default || !reflect.DeepEqual(targetIDs(plan), []string{"general", "telegram"}) || !reflect.DeepEqual(plan.Muted, []string{"muted"}) {
+		t.Fatalf("default plan = %+v", plan)
+	}
+
+	// A matching rule replaces the default and bypasses event toggles.
+	r := mustRoutes(t,
+		models.RouteRule{Name: "codex", Match: codex, To: models.RouteTargets{Endpoints: []string{"x-alerts", "gone"}, TelegramChats: []int64{7}}},
+		models.RouteRule{Name: "never-reached", To: models.RouteTargets{Endpoints: []string{"general"}}},
+	)
+	plan = r.Plan(adapters, p)
+	if plan.Default || !reflect.DeepEqual(plan.Rules, []string{"codex"}) || !reflect.DeepEqual(targetIDs(plan), []string{"x-alerts", "telegram"}) {
+		t.Fatalf("routed plan = %+v", plan)
+	}
+	if got := plan.Targets[1].Chats; !reflect.DeepEqual(got, []int64{7}) {
+		t.Errorf("telegram chats = %v, want [7]", got)
+	}
+	if !reflect.DeepEqual(plan.Unknown, []string{"gone"}) {
+		t.Errorf("unknown = %v, want [gone]", plan.Unknown)
+	}
+
+	// continue adds later rules; keep_default adds the default fan-out,
+	// and "telegram" as an endpoint widens a chat subset to every chat.
+	r = mustRoutes(t,
+		models.RouteRule{Name: "chat", Match: codex, To: models.RouteTargets{TelegramChats: []int64{7}}, Continue: true},
+		models.RouteRule{Name: "alerts", To: models.RouteTargets{Endpoints: []string{"x-alerts", "muted"}}, KeepDefault: true},
+	)
+	plan = r.Plan(adapters, p)
+	if !plan.Default || !reflect.DeepEqual(plan.Rules, []string{"chat", "alerts"}) {
+		t.Fatalf("continue plan = %+v", plan)
+	}
+	if !reflect.DeepEqual(targetIDs(plan), []string{"telegram", "x-alerts", "general"}) {
+		t.Errorf("targets = %v", targetIDs(plan))
+	}
+	if plan.Targets[0].Chats != nil {
+		t.Errorf("default routing should widen telegram to every chat, got %v", plan.Targets[0].Chats)
+	}
+	if !slices.Contains(plan.Muted, "muted") {
+		t.Errorf("routed targets must still honour project mutes: %+v", plan.Muted)
+	}
+
+	// Non-matching rules fall through to the default.
+	plan = r.Plan(adapters, Payload{Kind: string(notify.KindTaskFailed), ProjectID: "p2", Agent: "claude-code"})
+	if !reflect.DeepEqual(plan.Rules, []string{"alerts"}) {
+		t.Errorf("rules = %v", plan.Rules)
+	}
+}
+
+// TestDispatcherRoutesToChatsDurably drives a routed notification through
+// the dispatcher: the chat-scoped Telegram send reaches only the rule's
+// chats, and a failed chat-scoped delivery keeps its chats in the outbox.
+func TestDispatcherRoutesToChatsDurably(t *testing.T) {
+	general := &stubAdapter{id: "general", supports: map[notify.Kind]bool{notify.KindTaskFailed: true}}
+	tg := &chatStub{stubAdapter: stubAdapter{id: "telegram"}}
+	outbox := NewOutbox(t.TempDir())
+	bus := notify.NewBus()
+	d := NewDispatcher(bus, passthroughResolver,
+		func() ([]Adapter, error) { return []Adapter{general, tg}, nil },
+		WithRetryDelays(nil),
+		WithOutbox(outbox),
+		WithRoutes(func() (*Router, error) {
+			return CompileRoutes([]models.RouteRule{{
+				Name: "p1-chat", Match: models.RouteMatch{Projects: []string{"p1"}},
+				To: models.RouteTargets{TelegramChats: []int64{42}},
+			}})
+		}),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Run(ctx)
+	defer d.Stop()
+
+	bus.Emit(notify.Notification{Kind: notify.KindTaskFailed, ProjectID: "p1", EmittedAt: time.Now()})
+	bus.Emit(notify.Notification{Kind: notify.KindTaskFailed, ProjectID: "p2", EmittedAt: time.Now()})
+	if !waitFor(t, time.Second, func() bool { return len(tg.ChatCalls()) == 1 && len(general.Calls()) == 1 }) {
+		t.Fatalf("telegram calls = %v, general calls = %d", tg.ChatCalls(), len(general.Calls()))
+	}
+	if got := tg.ChatCalls()[0]; !reflect.DeepEqual(got, []int64{42}) {
+		t.Errorf("telegram chats = %v, want [42]", got)
+	}
+	if got := general.Calls()[0].ProjectID; got != "p2" {
+		t.Errorf("general got project %q, want only the unrouted p2", got)
+	}
+
+	dl, err := outbox.Enqueue(scopeToChats(tg, []int64{42}), Payload{Kind: string(notify.KindTaskFailed)}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dl.Chats, []int64{42}) {
+		t.Errorf("outbox delivery chats = %v, want [42]", dl.Chats)
+	}
+}
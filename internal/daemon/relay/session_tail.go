@@ -0,0 +1,131 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SessionTail is one screen snapshot of a watched agent session — what
+// the Slack / Discord `watch` command posts while an agent runs.
+// Screen is already normalized and trimmed (echo.ScreenTail); Ended
+// marks the last snapshot, posted after the session exited.
+type SessionTail struct {
+	ProjectID   string
+	ProjectName string
+	TaskNumber  int
+	TaskTitle   string
+	Mode        string
+	Screen      string
+	Ended       bool
+}
+
+// SessionTailPoster is implemented by the adapters the chat `watch`
+// command can post to: Slack and Discord. (Telegram has its own live
+// relay in the bridge.)
+type SessionTailPoster interface {
+	PostSessionTail(ctx context.Context, t SessionTail) error
+}
+
+// ErrNoSessionTailTransport is returned by PostSessionTail when no
+// adapter selected by the caller can take the snapshot.
+var ErrNoSessionTailTransport = errors.New("no Slack or Discord endpoint can receive session snapshots for this project")
+
+// PostSessionTail posts t to every SessionTailPoster adapter match
+// accepts and that has not muted the project. Snapshots are sent once,
+// outside the retry / outbox path — the next one supersedes a lost one.
+// It fails when no adapter received it.
+func (d *Dispatcher) PostSessionTail(ctx context.Context, t SessionTail, match func(Adapter) bool) error {
+	var errs []error
+	reached := 0
+	for _, a := range d.Adapters() {
+		poster, ok := a.(SessionTailPoster)
+		if !ok || (match != nil && !match(a)) {
+			continue
+		}
+		if mp, ok := a.(interface{ IsProjectMuted(string) bool }); ok && mp.IsProjectMuted(t.ProjectID) {
+			continue
+		}
+		if err := poster.PostSessionTail(ctx, t); err != nil {
+			d.logger.Printf("WARN: relay dispatcher: session snapshot for %q via %q: %v", t.ProjectID, a.ID(), err)
+			errs = append(errs, err)
+			continue
+		}
+		reached++
+	}
+	if reached > 0 {
+		return nil
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return ErrNoSessionTailTransport
+}
+
+// sessionTailTitle names the session a snapshot comes from.
+func sessionTailTitle(t SessionTail) string {
+	title := t.ProjectName
+	switch {
+	case t.TaskNumber > 0:
+		title += " — " + taskLabel(Payload{TaskNumber: t.TaskNumber, TaskTitle: t.TaskTitle})
+	case t.Mode != "":
+		title += " — " + t.Mode
+	}
+	if t.Ended {
+		title += " (session ended)"
+	}
+	return title
+}
+
+// PostSessionTail posts t as a header plus a code block.
+func (s *SlackAdapter) PostSessionTail(ctx context.Context, t SessionTail) error {
+	if s.endpoint.URL == "" {
+		return fmt.Errorf("slack adapter %q: webhook URL not resolved (keyring miss?)", s.endpoint.ID)
+	}
+	title := sessionTailTitle(t)
+	body, err := json.Marshal(map[string]any{
+		"text": "👀 " + title,
+		"blocks": []map[string]any{
+			{"type": "header", "text": map[string]any{"type": "plain_text", "text": trimRunes("👀 "+title, 150)}},
+			{"type": "section", "text": map[string]any{"type": "mrkdwn", "text": "```\n" + trimRunes(t.Screen, 2900) + "\n```"}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("slack adapter %q: encode session snapshot: %w", s.endpoint.ID, err)
+	}
+	return s.post(ctx, body)
+}
+
+// PostSessionTail posts t as an embed whose description is the screen.
+func (d *DiscordAdapter) PostSessionTail(ctx context.Context, t SessionTail) error {
+	if d.endpoint.URL == "" {
+		return fmt.Errorf("discord adapter %q: webhook URL not resolved (keyring miss?)", d.endpoint.ID)
+	}
+	body, err := json.Marshal(map[string]any{
+		"embeds": []any{map[string]any{
+			"title":       trimRunes("👀 "+sessionTailTitle(t), 256),
+			"description": "```\n" + trimRunes(t.Screen, discordEmbedDescriptionLimit-10) + "\n```",
+			"color":       0x64748b,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("discord adapter %q: encode session snapshot: %w", d.endpoint.ID, err)
+	}
+	return d.post(ctx, d.endpoint.URL, body)
+}
+
+// GuildID is the Discord guild the endpoint's webhook posts into, when
+// recorded — how the `watch` command keeps a guild's snapshots in that
+// guild.
+func (d *DiscordAdapter) GuildID() string { return d.endpoint.GuildID }
+
+// TeamID is the Slack workspace the endpoint's webhook posts into, when
+// recorded — the Slack counterpart of GuildID.
+func (a *SlackAdapter) TeamID() string { return a.endpoint.TeamID }
+
+// Compile-time assertions for the snapshot-capable adapters.
+var (
+	_ SessionTailPoster = (*SlackAdapter)(nil)
+	_ SessionTailPoster = (*DiscordAdapter)(nil)
+)
@@ -0,0 +1,85 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func sessionTailFixture() SessionTail {
+	return SessionTail{
+		ProjectID:   "proj-abc",
+		ProjectName: "Watchfire",
+		TaskNumber:  42,
+		TaskTitle:   "Build the Discord adapter",
+		Mode:        "task",
+		Screen:      "$ go test ./...\nok",
+	}
+}
+
+func TestSlackAndDiscordPostSessionTail(t *testing.T) {
+	srv, captured := captureServer(t)
+	s := newSlackAdapterForTest(t, models.SlackEndpoint{ID: "ep", URL: srv.URL})
+	if err := s.PostSessionTail(context.Background(), sessionTailFixture()); err != nil {
+		t.Fatalf("slack PostSessionTail: %v", err)
+	}
+	body, _ := captured()
+	for _, frag := range []string{"Watchfire — Task #0042 — Build the Discord adapter", "```\\n$ go test ./...\\nok\\n```"} {
+		if !strings.Contains(string(body), frag) {
+			t.Errorf("slack body missing %q:\n%s", frag, body)
+		}
+	}
+
+	d, err := NewDiscordAdapter(models.DiscordEndpoint{ID: "ep", URL: srv.URL + "/api/webhooks/1/tok", GuildID: "g-1"}, nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewDiscordAdapter: %v", err)
+	}
+	tail := sessionTailFixture()
+	tail.Ended = true
+	if err := d.PostSessionTail(context.Background(), tail); err != nil {
+		t.Fatalf("discord PostSessionTail: %v", err)
+	}
+	body, _ = captured()
+	if !strings.Contains(string(body), "(session ended)") || !strings.Contains(string(body), `"description":"`+"```") {
+		t.Errorf("discord body:\n%s", body)
+	}
+	if d.GuildID() != "g-1" {
+		t.Errorf("GuildID = %q", d.GuildID())
+	}
+}
+
+// tailStub is a stubAdapter that records session snapshots.
+type tailStub struct {
+	stubAdapter
+	posted []SessionTail
+}
+
+func (s *tailStub) PostSessionTail(_ context.Context, t SessionTail) error {
+	s.posted = append(s.posted, t)
+	return nil
+}
+
+func TestDispatcherPostSessionTail(t *testing.T) {
+	picked := &tailStub{stubAdapter: stubAdapter{id: "picked"}}
+	skipped := &tailStub{stubAdapter: stubAdapter{id: "skipped"}}
+	muted := &tailStub{stubAdapter: stubAdapter{id: "muted", mutedIDs: map[string]bool{"proj-abc": true}}}
+	adapters := []Adapter{&stubAdapter{id: "webhook"}, picked, skipped, muted}
+	d := NewDispatcher(nil, passthroughResolver, func() ([]Adapter, error) { return adapters, nil }, WithLogger(log.New(io.Discard, "", 0)))
+
+	match := func(a Adapter) bool { return a.ID() != "skipped" }
+	if err := d.PostSessionTail(context.Background(), sessionTailFixture(), match); err != nil {
+		t.Fatalf("PostSessionTail: %v", err)
+	}
+	if len(picked.posted) != 1 || len(skipped.posted) != 0 || len(muted.posted) != 0 {
+		t.Errorf("posted: picked=%d skipped=%d muted=%d", len(picked.posted), len(skipped.posted), len(muted.posted))
+	}
+	none := func(Adapter) bool { return false }
+	if err := d.PostSessionTail(context.Background(), sessionTailFixture(), none); !errors.Is(err, ErrNoSessionTailTransport) {
+		t.Errorf("nothing selected: want ErrNoSessionTailTransport, got %v", err)
+	}
+}
@@ -3,26 +3,28 @@ package relay
 import (
 	"bytes"
 	"context"
-	_ "embed"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"text/template"
+	"strings"
 	"time"
 
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	"github.com/watchfire-io/watchfire/internal/models"
 )
 
-//go:embed templates/slack_task_failed.json.tmpl
-var slackTaskFailedTmpl string
+// slackMrkdwnEscaper neutralises user-controlled text for a mrkdwn
+// field. &, < and > are the three characters Slack asks to be entity
+// escaped — left raw, a title could smuggle in `<!channel>` pings or
+// `<url|text>` links. Slack has no escape for its formatting markers,
+// so *, ~ and ` become lookalikes that read the same without bolding,
+// striking or code-spanning the rest of the message.
+var slackMrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "*", "∗", "~", "∼", "`", "ˋ")
 
-//go:embed templates/slack_run_complete.json.tmpl
-var slackRunCompleteTmpl string
-
-//go:embed templates/slack_weekly_digest.json.tmpl
-var slackWeeklyDigestTmpl string
+// SlackEscape escapes user-controlled text (task titles, project
+// names) for a Slack mrkdwn field. The echo responses share it.
+func SlackEscape(s string) string { return slackMrkdwnEscaper.Replace(s) }
 
 // SlackAdapter renders v7.0 Relay notifications as Block Kit messages
 // and POSTs them to a Slack incoming-webhook URL. One adapter binds to
@@ -40,12 +42,10 @@ type SlackAdapter struct {
 	httpClient *http.Client
 	logger     *log.Logger
 
-	taskFailedTmpl   *template.Template
-	runCompleteTmpl  *template.Template
-	weeklyDigestTmpl *template.Template
+	templates *templateSet
 }
 
-// NewSlackAdapter parses the three embedded Block Kit templates once
+// NewSlackAdapter parses the embedded per-kind Block Kit templates once
 // and returns a ready-to-use adapter. The HTTP client and logger fall
 // back to sane defaults so production callers can pass nil.
 func NewSlackAdapter(endpoint models.SlackEndpoint, client *http.Client, logger *log.Logger) (*SlackAdapter, error) {
@@ -55,25 +55,15 @@ func NewSlackAdapter(endpoint models.SlackEndpoint, client *http.Client, logger
 	if logger == nil {
 		logger = log.Default()
 	}
-	tf, err := template.New("slack_task_failed").Funcs(TemplateFuncs()).Parse(slackTaskFailedTmpl)
-	if err != nil {
-		return nil, fmt.Errorf("parse slack_task_failed template: %w", err)
-	}
-	rc, err := template.New("slack_run_complete").Funcs(TemplateFuncs()).Parse(slackRunCompleteTmpl)
+	tmpls, err := loadTemplateSet("slack", endpoint.ID, logger)
 	if err != nil {
-		return nil, fmt.Errorf("parse slack_run_complete template: %w", err)
-	}
-	wd, err := template.New("slack_weekly_digest").Funcs(TemplateFuncs()).Parse(slackWeeklyDigestTmpl)
-	if err != nil {
-		return nil, fmt.Errorf("parse slack_weekly_digest template: %w", err)
+		return nil, err
 	}
 	return &SlackAdapter{
-		endpoint:         endpoint,
-		httpClient:       client,
-		logger:           logger,
-		taskFailedTmpl:   tf,
-		runCompleteTmpl:  rc,
-		weeklyDigestTmpl: wd,
+		endpoint:   endpoint,
+		httpClient: client,
+		logger:     logger,
+		templates:  tmpls,
 	}, nil
 }
 
@@ -87,15 +77,7 @@ func (s *SlackAdapter) Kind() string { return "slack" }
 // dispatcher skips Send entirely (no connection opened) when this
 // returns false.
 func (s *SlackAdapter) Supports(kind notify.Kind) bool {
-	switch kind {
-	case notify.KindTaskFailed:
-		return s.endpoint.EnabledEvents.TaskFailed
-	case notify.KindRunComplete:
-		return s.endpoint.EnabledEvents.RunComplete
-	case notify.KindWeeklyDigest:
-		return s.endpoint.EnabledEvents.WeeklyDigest
-	}
-	return false
+	return s.endpoint.EnabledEvents.Enabled(kind.EventKey())
 }
 
 // IsProjectMuted reports whether the source project sits inside the
@@ -114,15 +96,15 @@ func (s *SlackAdapter) Send(ctx context.Context, p Payload) error {
 		return fmt.Errorf("slack adapter %q: webhook URL not resolved (keyring miss?)", s.endpoint.ID)
 	}
 
-	tmpl, err := s.templateFor(notify.Kind(p.Kind))
-	if err != nil {
-		return err
-	}
-	body, err := s.render(tmpl, p)
+	body, err := s.render(p)
 	if err != nil {
 		return err
 	}
+	return s.post(ctx, body)
+}
 
+// post delivers a rendered Block Kit message to the webhook.
+func (s *SlackAdapter) post(ctx context.Context, body []byte) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint.URL, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("slack adapter %q: build request: %w", s.endpoint.ID, err)
@@ -142,24 +124,13 @@ func (s *SlackAdapter) Send(ctx context.Context, p Payload) error {
 	return nil
 }
 
-func (s *SlackAdapter) templateFor(kind notify.Kind) (*template.Template, error) {
-	switch kind {
-	case notify.KindTaskFailed:
-		return s.taskFailedTmpl, nil
-	case notify.KindRunComplete:
-		return s.runCompleteTmpl, nil
-	case notify.KindWeeklyDigest:
-		return s.weeklyDigestTmpl, nil
-	}
-	return nil, fmt.Errorf("slack adapter %q: unsupported notification kind %q", s.endpoint.ID, kind)
-}
-
-func (s *SlackAdapter) render(tmpl *template.Template, p Payload) ([]byte, error) {
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, p); err != nil {
-		return nil, fmt.Errorf("slack adapter %q: render template %q: %w", s.endpoint.ID, tmpl.Name(), err)
+// render executes the kind's template against the payload.
+func (s *SlackAdapter) render(p Payload) ([]byte, error) {
+	body, err := s.templates.execute(notify.Kind(p.Kind), p)
+	if err != nil {
+		return nil, fmt.Errorf("slack adapter %q: %w", s.endpoint.ID, err)
 	}
-	return buf.Bytes(), nil
+	return body, nil
 }
 
 // Compile-time assertion that SlackAdapter satisfies the Adapter
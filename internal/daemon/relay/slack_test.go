@@ -28,16 +28,20 @@ func TestSlackTemplateGoldens(t *testing.T) {
 		{"task_failed", failedFixture(), "slack_task_failed.json"},
 		{"run_complete", runCompleteFixture(), "slack_run_complete.json"},
 		{"weekly_digest", weeklyDigestFixture(), "slack_weekly_digest.json"},
+		{"budget_threshold", budgetThresholdFixture(), "slack_budget_threshold.json"},
+		{"task_succeeded", extendedFixture(notify.KindTaskSucceeded), "slack_task_succeeded.json"},
+		{"merge_failed", extendedFixture(notify.KindMergeFailed), "slack_merge_failed.json"},
+		{"pr_opened", extendedFixture(notify.KindPROpened), "slack_pr_opened.json"},
+		{"agent_needs_auth", extendedFixture(notify.KindAgentNeedsAuth), "slack_agent_needs_auth.json"},
+		{"rate_limited", extendedFixture(notify.KindRateLimited), "slack_rate_limited.json"},
+		{"wildfire_phase_changed", extendedFixture(notify.KindWildfirePhase), "slack_wildfire_phase_changed.json"},
+		{"tasks_generated", extendedFixture(notify.KindTasksGenerated), "slack_tasks_generated.json"},
 	}
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			s := newSlackAdapterForTest(t, models.SlackEndpoint{ID: "test", URL: "https://example.invalid"})
-			tmpl, err := s.templateFor(notify.Kind(tc.fixture.Kind))
-			if err != nil {
-				t.Fatalf("templateFor: %v", err)
-			}
-			rendered, err := s.render(tmpl, tc.fixture)
+			rendered, err := s.render(tc.fixture)
 			if err != nil {
 				t.Fatalf("render: %v", err)
 			}
@@ -381,6 +385,21 @@ func TestSlackSupportsBitmask(t *testing.T) {
 	}
 }
 
+func TestSlackSupportsExtendedEvents(t *testing.T) {
+	ev := models.EventBitmask{TaskFailed: true}
+	ev.Set(models.EventPROpened, true)
+	s := newSlackAdapterForTest(t, models.SlackEndpoint{EnabledEvents: ev})
+	if !s.Supports(notify.KindPROpened) {
+		t.Error("PR_OPENED was enabled explicitly")
+	}
+	if !s.Supports(notify.KindMergeFailed) {
+		t.Error("MERGE_FAILED should inherit the task_failed toggle")
+	}
+	if s.Supports(notify.KindTaskSucceeded) || s.Supports(notify.KindTasksGenerated) {
+		t.Error("opt-in events should default off")
+	}
+}
+
 // ---- helpers -------------------------------------------------------------
 
 func newSlackAdapterForTest(t *testing.T, ep models.SlackEndpoint) *SlackAdapter {
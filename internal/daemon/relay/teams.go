@@ -0,0 +1,114 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// TeamsAdapter renders Relay notifications as Adaptive Cards and POSTs
+// them to a Microsoft Teams incoming webhook. One adapter binds to one
+// endpoint (one webhook URL = one Teams channel); the dispatcher builds
+// one TeamsAdapter per configured `models.TeamsEndpoint`.
+//
+// The card is wrapped in the `{"type":"message","attachments":[…]}`
+// envelope both the Workflows webhook trigger and the legacy connector
+// URLs accept. Authentication is by URL secrecy, as with Slack, so the
+// URL lives in the OS keyring and a keyring miss fails Send loudly.
+type TeamsAdapter struct {
+	endpoint   models.TeamsEndpoint
+	httpClient *http.Client
+	logger     *log.Logger
+
+	templates *templateSet
+}
+
+// NewTeamsAdapter parses the embedded per-kind card templates once and
+// returns a ready-to-use adapter. The HTTP client and logger fall back
+// to sane defaults so production callers can pass nil.
+func NewTeamsAdapter(endpoint models.TeamsEndpoint, client *http.Client, logger *log.Logger) (*TeamsAdapter, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if logger == nil {
+		logger = log.Default()
+	}
+	tmpls, err := loadTemplateSet("teams", endpoint.ID, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &TeamsAdapter{
+		endpoint:   endpoint,
+		httpClient: client,
+		logger:     logger,
+		templates:  tmpls,
+	}, nil
+}
+
+// ID returns the stable id from the IntegrationsConfig entry.
+func (t *TeamsAdapter) ID() string { return t.endpoint.ID }
+
+// Kind reports the adapter kind for the dispatcher's per-kind routing.
+func (t *TeamsAdapter) Kind() string { return "teams" }
+
+// Supports gates the adapter on the per-endpoint event bitmask.
+func (t *TeamsAdapter) Supports(kind notify.Kind) bool {
+	return t.endpoint.EnabledEvents.Enabled(kind.EventKey())
+}
+
+// IsProjectMuted reports whether the source project sits inside the
+// adapter's per-project mute list.
+func (t *TeamsAdapter) IsProjectMuted(projectID string) bool {
+	return IsProjectMuted(t.endpoint.ProjectMuteIDs, projectID)
+}
+
+// Send renders the card for the payload's kind and POSTs it. Teams
+// answers 200 (connectors) or 202 (Workflows) on success; any non-2xx
+// is surfaced so the dispatcher's retry + outbox can act on it.
+func (t *TeamsAdapter) Send(ctx context.Context, p Payload) error {
+	if t.endpoint.URL == "" {
+		return fmt.Errorf("teams adapter %q: webhook URL not resolved (keyring miss?)", t.endpoint.ID)
+	}
+	body, err := t.render(p)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("teams adapter %q: build request: %w", t.endpoint.ID, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "watchfire-relay/1")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("teams adapter %q: POST: %w", t.endpoint.ID, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("teams adapter %q: HTTP %d", t.endpoint.ID, resp.StatusCode)
+	}
+	return nil
+}
+
+// render executes the kind's template against the payload.
+func (t *TeamsAdapter) render(p Payload) ([]byte, error) {
+	body, err := t.templates.execute(notify.Kind(p.Kind), p)
+	if err != nil {
+		return nil, fmt.Errorf("teams adapter %q: %w", t.endpoint.ID, err)
+	}
+	return body, nil
+}
+
+// Compile-time assertion that TeamsAdapter satisfies the Adapter
+// interface.
+var _ Adapter = (*TeamsAdapter)(nil)
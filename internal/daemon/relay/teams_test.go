@@ -0,0 +1,146 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// fixtureFor returns the shared test payload for kind — the four
+// original fixtures plus extendedFixture for everything added since.
+func fixtureFor(kind notify.Kind) Payload {
+	switch kind {
+	case notify.KindTaskFailed:
+		return failedFixture()
+	case notify.KindRunComplete:
+		return runCompleteFixture()
+	case notify.KindWeeklyDigest:
+		return weeklyDigestFixture()
+	case notify.KindBudgetThreshold:
+		return budgetThresholdFixture()
+	}
+	return extendedFixture(kind)
+}
+
+// assertGolden compares rendered JSON against
+// templates/testdata/<fileName>, ignoring key order and whitespace.
+func assertGolden(t *testing.T, rendered []byte, fileName string) {
+	t.Helper()
+	goldenPath := filepath.Join("templates", "testdata", fileName)
+	wantBytes, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("read golden %s: %v", goldenPath, err)
+	}
+	got, want := normalizeJSON(t, rendered), normalizeJSON(t, wantBytes)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rendered JSON does not match golden %s\n--- got\n%s\n--- want\n%s",
+			goldenPath, mustMarshalIndent(got), mustMarshalIndent(want))
+	}
+}
+
+func TestTeamsTemplateGoldens(t *testing.T) {
+	a := newTeamsAdapterForTest(t, models.TeamsEndpoint{ID: "test", URL: "https://example.invalid"})
+	for _, kind := range notify.Kinds {
+		kind := kind
+		t.Run(kind.EventKey(), func(t *testing.T) {
+			rendered, err := a.render(fixtureFor(kind))
+			if err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			assertGolden(t, rendered, "teams_"+kind.EventKey()+".json")
+		})
+	}
+}
+
+func TestTeamsSendEndToEnd(t *testing.T) {
+	var got struct {
+		Type        string `json:"type"`
+		Attachments []struct {
+			ContentType string `json:"contentType"`
+			Content     struct {
+				Type    string `json:"type"`
+				Body    []map[string]any
+				Actions []struct {
+					Type string `json:"type"`
+					URL  string `json:"url"`
+				} `json:"actions"`
+			} `json:"content"`
+		} `json:"attachments"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected request %s %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	a := newTeamsAdapterForTest(t, models.TeamsEndpoint{ID: "ep", URL: srv.URL})
+	if err := a.Send(context.Background(), failedFixture()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if got.Type != "message" || len(got.Attachments) != 1 {
+		t.Fatalf("unexpected envelope: %+v", got)
+	}
+	card := got.Attachments[0]
+	if card.ContentType != "application/vnd.microsoft.card.adaptive" || card.Content.Type != "AdaptiveCard" {
+		t.Fatalf("attachment is not an Adaptive Card: %+v", card)
+	}
+	if len(card.Content.Actions) == 0 || card.Content.Actions[0].URL != "watchfire://project/proj-abc/task/0042" {
+		t.Errorf("missing deep-link action: %+v", card.Content.Actions)
+	}
+}
+
+func TestTeamsSendMissingURLIsError(t *testing.T) {
+	a := newTeamsAdapterForTest(t, models.TeamsEndpoint{ID: "ep"})
+	if err := a.Send(context.Background(), failedFixture()); err == nil {
+		t.Fatal("expected error for missing URL")
+	}
+}
+
+func TestTeamsSendHTTPFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+	a := newTeamsAdapterForTest(t, models.TeamsEndpoint{ID: "ep", URL: srv.URL})
+	if err := a.Send(context.Background(), failedFixture()); err == nil {
+		t.Error("expected error for 4xx response")
+	}
+}
+
+func TestTeamsSupportsAndMutes(t *testing.T) {
+	a := newTeamsAdapterForTest(t, models.TeamsEndpoint{
+		ID:             "ep",
+		EnabledEvents:  models.EventBitmask{TaskFailed: true},
+		ProjectMuteIDs: []string{"muted"},
+	})
+	if !a.Supports(notify.KindTaskFailed) || a.Supports(notify.KindRunComplete) {
+		t.Error("Supports should follow the event bitmask")
+	}
+	if !a.IsProjectMuted("muted") || a.IsProjectMuted("other") {
+		t.Error("IsProjectMuted should follow the mute list")
+	}
+}
+
+func newTeamsAdapterForTest(t *testing.T, ep models.TeamsEndpoint) *TeamsAdapter {
+	t.Helper()
+	a, err := NewTeamsAdapter(ep, nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewTeamsAdapter: %v", err)
+	}
+	return a
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
@@ -67,15 +68,7 @@ func (t *TelegramAdapter) Kind() string { return "telegram" }
 // dispatcher skips Send entirely (no connection opened) when this
 // returns false.
 func (t *TelegramAdapter) Supports(kind notify.Kind) bool {
-	switch kind {
-	case notify.KindTaskFailed:
-		return t.cfg.EnabledEvents.TaskFailed
-	case notify.KindRunComplete:
-		return t.cfg.EnabledEvents.RunComplete
-	case notify.KindWeeklyDigest:
-		return t.cfg.EnabledEvents.WeeklyDigest
-	}
-	return false
+	return t.cfg.EnabledEvents.Enabled(kind.EventKey())
 }
 
 // Send formats the payload once and fans it out to every paired chat
@@ -102,6 +95,28 @@ func (t *TelegramAdapter) Send(ctx context.Context, p Payload) error {
 	return errors.Join(errs...)
 }
 
+// SendToChats is Send narrowed to the listed chats — the ones a routing
+// rule selected. Ids that are not paired, and muted chats, are skipped.
+func (t *TelegramAdapter) SendToChats(ctx context.Context, chatIDs []int64, p Payload) error {
+	if t.cfg.BotToken == "" {
+		return fmt.Errorf("telegram adapter: bot token not resolved (keyring miss?)")
+	}
+	text, err := FormatTelegramMessage(p)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, chat := range t.cfg.PairedChats {
+		if chat.Muted || !slices.Contains(chatIDs, chat.ChatID) {
+			continue
+		}
+		if _, sendErr := t.client.SendMessage(ctx, t.cfg.BotToken, chat.ChatID, text); sendErr != nil {
+			errs = append(errs, fmt.Errorf("telegram adapter: chat %d: %w", chat.ChatID, sendErr))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // SendToChat delivers the payload to a single chat, bypassing the mute
 // check. Used by the TestIntegration handler so it can report per-chat
 // success instead of Send's aggregate.
@@ -154,11 +169,62 @@ func FormatTelegramMessage(p Payload) (string, error) {
 		}
 		lines = append(lines, fmt.Sprintf("<i>Weekly digest · %s</i>", rfc3339(p.EmittedAt)))
 		return strings.Join(lines, "\n"), nil
+	case notify.KindBudgetThreshold:
+		return strings.Join([]string{
+			fmt.Sprintf("💸 <b>%s</b>", telegramEscape(budgetHeadline(p))),
+			telegramEscape(budgetSummary(p)),
+			fmt.Sprintf("<i>Monthly budget · %s</i>", rfc3339(p.EmittedAt)),
+		}, "\n"), nil
+	case notify.KindTaskSucceeded:
+		return telegramTaskMessage(p, "🎉", "Task succeeded"), nil
+	case notify.KindMergeFailed:
+		var reason string
+		if p.Detail != "" {
+			reason = "<b>Error</b>: " + telegramEscape(p.Detail)
+		}
+		return telegramTaskMessage(p, "⚠️", "Merge failed", reason), nil
+	case notify.KindPROpened:
+		return telegramTaskMessage(p, "🔀", "PR opened", telegramEscape(p.URL)), nil
+	case notify.KindAgentNeedsAuth:
+		return telegramTaskMessage(p, "🔑", "Agent needs to sign in", telegramEscape(p.Detail)), nil
+	case notify.KindRateLimited:
+		return telegramTaskMessage(p, "⏳", "Rate limited", telegramEscape(p.Detail)), nil
+	case notify.KindWildfirePhase:
+		return strings.Join([]string{
+			fmt.Sprintf("🔥 <b>Wildfire — %s</b>", telegramEscape(p.ProjectName)),
+			fmt.Sprintf("Phase: <b>%s</b> → <b>%s</b>", phaseLabel(p.PreviousPhase), phaseLabel(p.Phase)),
+			fmt.Sprintf("<i>%s · %s</i>", telegramEscape(p.ProjectName), rfc3339(p.EmittedAt)),
+		}, "\n"), nil
+	case notify.KindTasksGenerated:
+		return strings.Join([]string{
+			fmt.Sprintf("🧩 <b>%s — %s</b>", tasksGeneratedHeadline(p), telegramEscape(p.ProjectName)),
+			fmt.Sprintf("<i>%s · %s</i>", telegramEscape(p.ProjectName), rfc3339(p.EmittedAt)),
+		}, "\n"), nil
 	}
 	return "", fmt.Errorf("telegram adapter: unsupported notification kind %q", p.Kind)
 }
 
+// telegramTaskMessage renders the common "headline / task / extras /
+// footer" shape shared by the task-lifecycle kinds. Empty extras are
+// dropped so a missing detail never leaves a blank line.
+func telegramTaskMessage(p Payload, icon, headline string, extras ...string) string {
+	lines := []string{
+		fmt.Sprintf("%s <b>%s — %s</b>", icon, headline, telegramEscape(p.ProjectName)),
+		"<b>" + telegramEscape(taskLabel(p)) + "</b>",
+	}
+	for _, e := range extras {
+		if e != "" {
+			lines = append(lines, e)
+		}
+	}
+	lines = append(lines, fmt.Sprintf("<i>%s · %s</i>", telegramEscape(p.ProjectName), rfc3339(p.EmittedAt)))
+	return strings.Join(lines, "\n")
+}
+
 // Compile-time assertion that TelegramAdapter satisfies the Adapter
-// interface — the dispatcher iterates `[]Adapter` so this catches
+// and ChatRouter interfaces — the dispatcher iterates `[]Adapter` so this catches
 // accidental signature drift at build time.
-var _ Adapter = (*TelegramAdapter)(nil)
+var (
+	_ Adapter    = (*TelegramAdapter)(nil)
+	_ ChatRouter = (*TelegramAdapter)(nil)
+)
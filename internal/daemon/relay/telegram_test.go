@@ -17,7 +17,7 @@ import (
 )
 
 // fakeTelegramAPI is an httptest stand-in for api.telegram.org. It
-// records every sendMessage (chat_id + text) and can be told to fail
+// records every sendMessage (chat_id, text, reply_markup) and can be told to fail
 // specific chats (per-chat 400) or everything (500).
 type fakeTelegramAPI struct {
 	mu        sync.Mutex
@@ -29,8 +29,9 @@ type fakeTelegramAPI struct {
 }
 
 type fakeTelegramSend struct {
-	ChatID int64
-	Text   string
+	ChatID      int64
+	Text        string
+	ReplyMarkup string
 }
 
 // startFakeTelegramAPI spins up the fake and points telegrambot.APIBase
@@ -54,7 +55,7 @@ func startFakeTelegramAPI(t *testing.T) *fakeTelegramAPI {
 		failAll := f.failAll
 		failChat := f.failChats[chatID]
 		if !failAll && !failChat {
-			f.sends = append(f.sends, fakeTelegramSend{ChatID: chatID, Text: r.PostFormValue("text")})
+			f.sends = append(f.sends, fakeTelegramSend{ChatID: chatID, Text: r.PostFormValue("text"), ReplyMarkup: r.PostFormValue("reply_markup")})
 		}
 		f.mu.Unlock()
 		w.Header().Set("Content-Type", "application/json")
@@ -151,6 +152,57 @@ func TestFormatTelegramMessageSnapshots(t *testing.T) {
 				"## Your week\n\n5 tasks done &amp; &lt;2 failed&gt;\n" +
 				"<i>Weekly digest · 2026-08-17T12:00:00Z</i>",
 		},
+		{
+			name: "budget_threshold",
+			payload: Payload{
+				Kind:        string(notify.KindBudgetThreshold),
+				EmittedAt:   telegramSnapshotTime,
+				ProjectName: "R&D",
+				Budget: &notify.BudgetAlert{
+					Scope: "project", Month: "2026-08", Threshold: 80, SpentUSD: 41.5, LimitUSD: 50,
+				},
+			},
+			want: "💸 <b>80% of the monthly budget used — R&amp;D</b>\n" +
+				"$41.50 of $50.00 spent in 2026-08.\n" +
+				"<i>Monthly budget · 2026-08-17T12:00:00Z</i>",
+		},
+		{
+			name: "merge_failed",
+			payload: Payload{
+				Kind:        string(notify.KindMergeFailed),
+				EmittedAt:   telegramSnapshotTime,
+				ProjectName: "Watchfire",
+				TaskNumber:  7,
+				TaskTitle:   "Fix crash",
+				Detail:      "conflict in <main.go>",
+			},
+			want: "⚠️ <b>Merge failed — Watchfire</b>\n" +
+				"<b>Task #0007 — Fix crash</b>\n" +
+				"<b>Error</b>: conflict in &lt;main.go&gt;\n" +
+				"<i>Watchfire · 2026-08-17T12:00:00Z</i>",
+		},
+		{
+			name: "rate_limited_without_task",
+			payload: Payload{
+				Kind:        string(notify.KindRateLimited),
+				EmittedAt:   telegramSnapshotTime,
+				ProjectName: "Watchfire",
+			},
+			want: "⏳ <b>Rate limited — Watchfire</b>\n" +
+				"<b>Agent session</b>\n" +
+				"<i>Watchfire · 2026-08-17T12:00:00Z</i>",
+		},
+		{
+			name: "tasks_generated",
+			payload: Payload{
+				Kind:        string(notify.KindTasksGenerated),
+				EmittedAt:   telegramSnapshotTime,
+				ProjectName: "Watchfire",
+				Count:       1,
+			},
+			want: "🧩 <b>1 new task — Watchfire</b>\n" +
+				"<i>Watchfire · 2026-08-17T12:00:00Z</i>",
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -201,8 +253,8 @@ func TestTelegramSupportsHonorsEnabledEvents(t *testing.T) {
 	if !a.Supports(notify.KindTaskFailed) {
 		t.Fatal("TASK_FAILED should be supported")
 	}
-	if a.Supports(notify.KindRunComplete) || a.Supports(notify.KindWeeklyDigest) {
-		t.Fatal("RUN_COMPLETE / WEEKLY_DIGEST should be gated off")
+	if a.Supports(notify.KindRunComplete) || a.Supports(notify.KindWeeklyDigest) || a.Supports(notify.KindBudgetThreshold) {
+		t.Fatal("RUN_COMPLETE / WEEKLY_DIGEST / BUDGET_THRESHOLD should be gated off")
 	}
 	if a.Supports(notify.Kind("MYSTERY")) {
 		t.Fatal("unknown kinds must not be supported")
@@ -238,6 +290,31 @@ func TestTelegramSendSkipsMutedChats(t *testing.T) {
 	}
 }
 
+// TestTelegramSendToChatsNarrowsFanOut covers a routing rule naming
+// chats: only those paired, unmuted chats receive the message.
+func TestTelegramSendToChatsNarrowsFanOut(t *testing.T) {
+	api := startFakeTelegramAPI(t)
+	cfg := telegramTestConfig(
+		models.TelegramPairedChat{ChatID: 111},
+		models.TelegramPairedChat{ChatID: 222, Muted: true},
+		models.TelegramPairedChat{ChatID: 333},
+	)
+	a := NewTelegramAdapter(cfg, telegrambot.New(), nil)
+
+	err := a.SendToChats(context.Background(), []int64{222, 333, 999}, Payload{
+		Kind:        string(notify.KindTaskFailed),
+		EmittedAt:   telegramSnapshotTime,
+		ProjectName: "p",
+		TaskNumber:  1,
+	})
+	if err != nil {
+		t.Fatalf("SendToChats: %v", err)
+	}
+	if sends := api.Sends(); len(sends) != 1 || sends[0].ChatID != 333 {
+		t.Fatalf("expected only chat 333, got %+v", sends)
+	}
+}
+
 // TestTelegramSendAggregatesPartialFailure asserts a per-chat failure
 // still delivers to the healthy chats and surfaces one aggregate error
 // so the dispatcher's retry policy applies.
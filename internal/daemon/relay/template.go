@@ -9,7 +9,13 @@
 // dependency into the merge path.
 package relay
 
-import _ "embed"
+import (
+	"embed"
+	"fmt"
+	"text/template"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+)
 
 // PRBodyTemplate is the Go text/template source rendered into a GitHub
 // pull-request body when the v7.0 auto-PR flow opens a PR. Consumers must
@@ -18,3 +24,30 @@ import _ "embed"
 //
 //go:embed templates/pr_body.md.tmpl
 var PRBodyTemplate string
+
+// kindTemplates holds the per-kind Slack / Discord / Teams / Matrix /
+// ntfy payload templates, one file per `<channel>_<event key>.json.tmpl`.
+//
+//go:embed templates/*.json.tmpl
+var kindTemplates embed.FS
+
+// parseKindTemplates parses the embedded template for every notify.Kind
+// on the given channel ("slack", "discord", "teams", …). A missing or
+// malformed file is a build-time bug, so it surfaces as a constructor error
+// rather than a per-send failure.
+func parseKindTemplates(channel string) (map[notify.Kind]*template.Template, error) {
+	out := make(map[notify.Kind]*template.Template, len(notify.Kinds))
+	for _, kind := range notify.Kinds {
+		name := channel + "_" + kind.EventKey()
+		src, err := kindTemplates.ReadFile("templates/" + name + ".json.tmpl")
+		if err != nil {
+			return nil, fmt.Errorf("read %s template: %w", name, err)
+		}
+		tmpl, err := template.New(name).Funcs(TemplateFuncs()).Parse(string(src))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s template: %w", name, err)
+		}
+		out[kind] = tmpl
+	}
+	return out, nil
+}
@@ -2,6 +2,7 @@ package relay
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 	"text/template"
@@ -69,15 +70,78 @@ func digestSnippet(s string, n int) string {
 	return s
 }
 
+// budgetHeadline is the title every adapter gives a BUDGET_THRESHOLD
+// alert: "80% of the monthly budget used — <project | all projects>".
+func budgetHeadline(p Payload) string {
+	if p.Budget == nil {
+		return "Monthly budget alert"
+	}
+	scope := "all projects"
+	if p.Budget.Scope != "global" {
+		scope = p.ProjectName
+	}
+	return fmt.Sprintf("%d%% of the monthly budget used — %s", p.Budget.Threshold, scope)
+}
+
+// budgetSummary is the spend line under the headline, noting when the
+// hard stop has paused new agent runs.
+func budgetSummary(p Payload) string {
+	b := p.Budget
+	if b == nil {
+		return ""
+	}
+	s := fmt.Sprintf("$%.2f of $%.2f spent in %s.", b.SpentUSD, b.LimitUSD, b.Month)
+	if b.HardStop && b.SpentUSD >= b.LimitUSD {
+		s += " New agent runs are paused until next month."
+	}
+	return s
+}
+
+// taskLabel names the task a notification is about — "Task #0042 —
+// <title>" — or "Agent session" for chat / wildfire sessions that are
+// not bound to a task.
+func taskLabel(p Payload) string {
+	if p.TaskNumber == 0 {
+		return "Agent session"
+	}
+	if p.TaskTitle == "" {
+		return fmt.Sprintf("Task #%04d", p.TaskNumber)
+	}
+	return fmt.Sprintf("Task #%04d — %s", p.TaskNumber, p.TaskTitle)
+}
+
+// phaseLabel capitalises a wildfire phase ("refine" → "Refine"); an
+// empty phase reads as "Idle" so the first transition renders cleanly.
+func phaseLabel(phase string) string {
+	if phase == "" {
+		return "Idle"
+	}
+	return strings.ToUpper(phase[:1]) + phase[1:]
+}
+
+// tasksGeneratedHeadline is the "3 new tasks" title for a
+// TASKS_GENERATED notification.
+func tasksGeneratedHeadline(p Payload) string {
+	if p.Count == 1 {
+		return "1 new task"
+	}
+	return fmt.Sprintf("%d new tasks", p.Count)
+}
+
 // TemplateFuncs returns the FuncMap shared by every relay adapter
 // template. Centralised so adding a new helper only requires editing
 // one file.
 func TemplateFuncs() template.FuncMap {
 	return template.FuncMap{
-		"hexToInt":      hexToInt,
-		"rfc3339":       rfc3339,
-		"jsonStr":       jsonStr,
-		"digestSnippet": digestSnippet,
-		"slackEmoji":    slackEmojiForColor,
+		"hexToInt":               hexToInt,
+		"rfc3339":                rfc3339,
+		"jsonStr":                jsonStr,
+		"digestSnippet":          digestSnippet,
+		"slackEmoji":             slackEmojiForColor,
+		"budgetHeadline":         budgetHeadline,
+		"budgetSummary":          budgetSummary,
+		"taskLabel":              taskLabel,
+		"phaseLabel":             phaseLabel,
+		"tasksGeneratedHeadline": tasksGeneratedHeadline,
 	}
 }
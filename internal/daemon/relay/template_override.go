@@ -0,0 +1,252 @@
+package relay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+)
+
+// TemplateChannels lists the channels whose per-kind payload templates a
+// user may override.
+var TemplateChannels = []string{"slack", "discord", "teams", "matrix", "ntfy"}
+
+// Template origins reported by ResolveTemplate, most specific first.
+const (
+	TemplateOriginEndpoint = "endpoint" // <dir>/<endpoint id>/<file>
+	TemplateOriginGlobal   = "global"   // <dir>/<file>
+	TemplateOriginBuiltin  = "builtin"  // embedded templates/<file>
+)
+
+var (
+	overrideMu  sync.RWMutex
+	overrideDir string
+)
+
+// SetTemplateOverrideDir points every adapter built afterwards at the
+// user's template overrides (normally `config.GlobalRelayTemplatesDir()`).
+// An empty dir disables overrides.
+func SetTemplateOverrideDir(dir string) {
+	overrideMu.Lock()
+	defer overrideMu.Unlock()
+	overrideDir = dir
+}
+
+// TemplateOverrideDir returns the directory set by SetTemplateOverrideDir.
+func TemplateOverrideDir() string {
+	overrideMu.RLock()
+	defer overrideMu.RUnlock()
+	return overrideDir
+}
+
+// TemplateFileName is the file a channel's template for kind lives in,
+// both embedded and in the override directory.
+func TemplateFileName(channel string, kind notify.Kind) string {
+	return channel + "_" + kind.EventKey() + ".json.tmpl"
+}
+
+// TemplateOverridePath returns where an override for channel + kind is
+// read from: `<dir>/<file>` for every endpoint of the channel, or
+// `<dir>/<endpoint id>/<file>` for one endpoint.
+func TemplateOverridePath(dir, channel, endpointID string, kind notify.Kind) (string, error) {
+	if !slices.Contains(TemplateChannels, channel) {
+		return "", fmt.Errorf("unknown template channel %q", channel)
+	}
+	if endpointID == "" {
+		return filepath.Join(dir, TemplateFileName(channel, kind)), nil
+	}
+	if strings.ContainsAny(endpointID, `/\`) || endpointID == "." || endpointID == ".." {
+		return "", fmt.Errorf("invalid endpoint id %q", endpointID)
+	}
+	return filepath.Join(dir, endpointID, TemplateFileName(channel, kind)), nil
+}
+
+// BuiltinTemplate returns the embedded template source for channel + kind.
+func BuiltinTemplate(channel string, kind notify.Kind) (string, error) {
+	src, err := kindTemplates.ReadFile("templates/" + TemplateFileName(channel, kind))
+	if err != nil {
+		return "", fmt.Errorf("no built-in %s template for %s", channel, kind)
+	}
+	return string(src), nil
+}
+
+// ResolveTemplate returns the template source an endpoint would use for
+// kind — its own override, the channel-wide override, or the built-in —
+// and where it came from. Overrides are returned as found; loadTemplateSet
+// is what skips the invalid ones.
+func ResolveTemplate(dir, channel, endpointID string, kind notify.Kind) (src, origin, path string, err error) {
+	if dir != "" {
+		candidates := []struct{ id, origin string }{{endpointID, TemplateOriginEndpoint}, {"", TemplateOriginGlobal}}
+		for _, c := range candidates {
+			if c.origin == TemplateOriginEndpoint && endpointID == "" {
+				continue
+			}
+			p, err := TemplateOverridePath(dir, channel, c.id, kind)
+			if err != nil {
+				return "", "", "", err
+			}
+			data, err := os.ReadFile(p)
+			if err == nil {
+				return string(data), c.origin, p, nil
+			}
+			if !errors.Is(err, os.ErrNotExist) {
+				return "", "", "", err
+			}
+		}
+	}
+	src, err = BuiltinTemplate(channel, kind)
+	return src, TemplateOriginBuiltin, "", err
+}
+
+// SamplePayload is the representative payload a template is validated
+// and previewed against — every field the kind's templates may read is
+// filled in.
+func SamplePayload(kind notify.Kind, now time.Time) Payload {
+	p := Payload{
+		Version:           1,
+		Kind:              string(kind),
+		EmittedAt:         now.UTC(),
+		ProjectID:         "sample-project",
+		ProjectName:       "Watchfire sample",
+		ProjectColor:      "#f97316",
+		TaskNumber:        42,
+		TaskTitle:         "Sample task",
+		TaskFailureReason: "tests failed: 3 of 12",
+		DeepLink:          "watchfire://project/sample-project/task/0042",
+	}
+	switch kind {
+	case notify.KindWeeklyDigest:
+		p.TaskNumber, p.TaskTitle, p.TaskFailureReason = 0, "", ""
+		p.DigestDate = now.UTC().Format("2006-01-02")
+		p.DigestPath = "digests/" + p.DigestDate + ".md"
+		p.DigestBody = "## This week\n\n- 12 tasks completed across 3 projects\n- 2 failures"
+		p.DeepLink = "watchfire://digest/" + p.DigestDate
+	case notify.KindBudgetThreshold:
+		p.TaskNumber, p.TaskTitle, p.TaskFailureReason = 0, "", ""
+		p.DeepLink = "watchfire://project/sample-project"
+		p.Budget = &notify.BudgetAlert{
+			Scope: "project", Month: now.UTC().Format("2006-01"),
+			Threshold: 80, SpentUSD: 81.5, LimitUSD: 100,
+		}
+	case notify.KindMergeFailed:
+		p.Detail = "merge conflict in README.md"
+	case notify.KindPROpened:
+		p.URL = "https://github.com/watchfire-io/watchfire/pull/1"
+	case notify.KindAgentNeedsAuth:
+		p.Detail = "Invalid API key · Please run /login"
+	case notify.KindRateLimited:
+		p.Detail = "usage limit reached, resets in 1h"
+	case notify.KindWildfirePhase:
+		p.TaskNumber, p.TaskTitle = 0, ""
+		p.DeepLink = "watchfire://project/sample-project"
+		p.PreviousPhase, p.Phase = "refine", "generate"
+	case notify.KindTasksGenerated:
+		p.TaskNumber, p.TaskTitle = 0, ""
+		p.DeepLink = "watchfire://project/sample-project"
+		p.Count = 3
+	}
+	return p
+}
+
+// ValidateTemplate parses src with TemplateFuncs and renders it against
+// the kind's SamplePayload. The rendered body must be valid JSON — every
+// overridable channel posts JSON. Returns the parsed template and the
+// rendered sample.
+func ValidateTemplate(channel string, kind notify.Kind, src string) (*template.Template, []byte, error) {
+	name := strings.TrimSuffix(TemplateFileName(channel, kind), ".json.tmpl")
+	tmpl, err := template.New(name).Funcs(TemplateFuncs()).Parse(src)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse: %w", err)
+	}
+	out, err := executeJSON(tmpl, SamplePayload(kind, time.Now()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tmpl, out, nil
+}
+
+// executeJSON renders tmpl and checks the result is valid JSON.
+func executeJSON(tmpl *template.Template, p Payload) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, p); err != nil {
+		return nil, fmt.Errorf("render template %q: %w", tmpl.Name(), err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("template %q rendered invalid JSON", tmpl.Name())
+	}
+	return buf.Bytes(), nil
+}
+
+// templateSet is one endpoint's per-kind templates: the embedded
+// built-ins plus the valid user overrides found when it was loaded.
+type templateSet struct {
+	builtin   map[notify.Kind]*template.Template
+	overrides map[notify.Kind]*template.Template
+	logger    *log.Logger
+}
+
+// loadTemplateSet parses channel's built-in templates and layers the
+// endpoint's overrides from TemplateOverrideDir on top. An override that
+// fails ValidateTemplate is logged and skipped, so a bad file can never
+// stop the adapter from being built.
+func loadTemplateSet(channel, endpointID string, logger *log.Logger) (*templateSet, error) {
+	builtin, err := parseKindTemplates(channel)
+	if err != nil {
+		return nil, err
+	}
+	set := &templateSet{builtin: builtin, overrides: map[notify.Kind]*template.Template{}, logger: logger}
+	dir := TemplateOverrideDir()
+	if dir == "" {
+		return set, nil
+	}
+	for _, kind := range notify.Kinds {
+		src, origin, path, err := ResolveTemplate(dir, channel, endpointID, kind)
+		if err != nil {
+			logger.Printf("WARN: relay: read %s template override: %v", channel, err)
+			continue
+		}
+		if origin == TemplateOriginBuiltin {
+			continue
+		}
+		tmpl, _, err := ValidateTemplate(channel, kind, src)
+		if err != nil {
+			logger.Printf("WARN: relay: ignoring template override %s: %v (using the built-in template)", path, err)
+			continue
+		}
+		set.overrides[kind] = tmpl
+	}
+	return set, nil
+}
+
+// execute renders the kind's template. An override that fails at send
+// time — an error, or output that is not JSON — is logged and the
+// built-in template renders instead, so a customised message never
+// costs a notification.
+func (s *templateSet) execute(kind notify.Kind, p Payload) ([]byte, error) {
+	if tmpl, ok := s.overrides[kind]; ok {
+		out, err := executeJSON(tmpl, p)
+		if err == nil {
+			return out, nil
+		}
+		s.logger.Printf("WARN: relay: template override failed, using the built-in template: %v", err)
+	}
+	tmpl, ok := s.builtin[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported notification kind %q", kind)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, p); err != nil {
+		return nil, fmt.Errorf("render template %q: %w", tmpl.Name(), err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,161 @@
+package relay
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// withOverrideDir points the package at a fresh override directory for
+// the duration of the test.
+func withOverrideDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	SetTemplateOverrideDir(dir)
+	t.Cleanup(func() { SetTemplateOverrideDir("") })
+	return dir
+}
+
+func writeOverride(t *testing.T, dir, endpointID, channel string, kind notify.Kind, src string) {
+	t.Helper()
+	path, err := TemplateOverridePath(dir, channel, endpointID, kind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBuiltinTemplatesValidate pins that every embedded template passes
+// the same validation a user override must.
+func TestBuiltinTemplatesValidate(t *testing.T) {
+	for _, channel := range TemplateChannels {
+		for _, kind := range notify.Kinds {
+			src, err := BuiltinTemplate(channel, kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := ValidateTemplate(channel, kind, src); err != nil {
+				t.Errorf("%s/%s: %v", channel, kind, err)
+			}
+		}
+	}
+}
+
+func TestValidateTemplateRejects(t *testing.T) {
+	for name, src := range map[string]string{
+		"parse error":   `{"text": {{.ProjectName}`,
+		"unknown field": `{"text": {{jsonStr .Nope}}}`,
+		"not JSON":      `text: {{.ProjectName}}`,
+	} {
+		if _, _, err := ValidateTemplate("slack", notify.KindTaskFailed, src); err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+}
+
+func TestTemplateOverrideResolution(t *testing.T) {
+	dir := withOverrideDir(t)
+	writeOverride(t, dir, "", "slack", notify.KindTaskFailed, `{"text": {{jsonStr (printf "global: %s" .ProjectName)}}}`)
+	writeOverride(t, dir, "ep-2", "slack", notify.KindTaskFailed, `{"text": {{jsonStr (printf "ep-2: %s" .ProjectName)}}}`)
+
+	render := func(id string, p Payload) string {
+		a := newSlackAdapterForTest(t, models.SlackEndpoint{ID: id, URL: "https://example.invalid"})
+		out, err := a.render(p)
+		if err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return string(out)
+	}
+	if got := render("ep-1", failedFixture()); got != `{"text": "global: Watchfire"}` {
+		t.Errorf("ep-1 should use the channel-wide override, got %s", got)
+	}
+	if got := render("ep-2", failedFixture()); got != `{"text": "ep-2: Watchfire"}` {
+		t.Errorf("ep-2 should use its own override, got %s", got)
+	}
+	if got := render("ep-1", runCompleteFixture()); strings.Contains(got, "global:") {
+		t.Errorf("kinds without an override should use the built-in, got %s", got)
+	}
+
+	_, origin, path, err := ResolveTemplate(dir, "slack", "ep-2", notify.KindTaskFailed)
+	if err != nil || origin != TemplateOriginEndpoint || path != filepath.Join(dir, "ep-2", "slack_task_failed.json.tmpl") {
+		t.Errorf("ResolveTemplate = %q %q %v", origin, path, err)
+	}
+	if _, origin, _, _ = ResolveTemplate(dir, "discord", "ep-2", notify.KindTaskFailed); origin != TemplateOriginBuiltin {
+		t.Errorf("discord should resolve to the built-in, got %q", origin)
+	}
+	if _, err := TemplateOverridePath(dir, "slack", "../escape", notify.KindTaskFailed); err == nil {
+		t.Error("endpoint ids with path separators must be rejected")
+	}
+}
+
+func TestTemplateOverrideInvalidFileIsSkipped(t *testing.T) {
+	dir := withOverrideDir(t)
+	writeOverride(t, dir, "", "teams", notify.KindTaskFailed, `{"broken": {{.ProjectName}`)
+
+	var logs bytes.Buffer
+	a, err := NewTeamsAdapter(models.TeamsEndpoint{ID: "test"}, nil, log.New(&logs, "", 0))
+	if err != nil {
+		t.Fatalf("NewTeamsAdapter: %v", err)
+	}
+	rendered, err := a.render(failedFixture())
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	assertGolden(t, rendered, "teams_task_failed.json")
+	if !strings.Contains(logs.String(), "ignoring template override") {
+		t.Errorf("expected a warning for the invalid override, got %q", logs.String())
+	}
+}
+
+// TestTemplateOverrideRuntimeFallback covers an override that validates
+// against the sample payload but fails on a real one: the built-in
+// template renders instead and a warning is logged.
+func TestTemplateOverrideRuntimeFallback(t *testing.T) {
+	dir := withOverrideDir(t)
+	writeOverride(t, dir, "", "matrix", notify.KindTaskFailed,
+		`{"msgtype": "m.notice", "body": {{jsonStr (printf "%s #%d" .TaskTitle .TaskNumber)}}{{if eq .TaskNumber 7}}, "extra": {{.Budget.Threshold}}{{end}}}`)
+
+	var logs bytes.Buffer
+	a, err := NewMatrixAdapter(models.MatrixEndpoint{ID: "test"}, nil, log.New(&logs, "", 0))
+	if err != nil {
+		t.Fatalf("NewMatrixAdapter: %v", err)
+	}
+	out, err := a.render(failedFixture())
+	if err != nil || !strings.Contains(string(out), `"Build the Discord adapter #42"`) {
+		t.Fatalf("override should render for task 42: %s %v", out, err)
+	}
+
+	p := failedFixture()
+	p.TaskNumber = 7
+	out, err = a.render(p)
+	if err != nil {
+		t.Fatalf("render with fallback: %v", err)
+	}
+	if strings.Contains(string(out), "extra") || !strings.Contains(string(out), "Task failed") {
+		t.Errorf("expected the built-in message, got %s", out)
+	}
+	if !strings.Contains(logs.String(), "template override failed") {
+		t.Errorf("expected a fallback warning, got %q", logs.String())
+	}
+}
+
+func TestSamplePayloadCoversEveryKind(t *testing.T) {
+	now := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	for _, kind := range notify.Kinds {
+		if p := SamplePayload(kind, now); p.Kind != string(kind) || p.ProjectName == "" {
+			t.Errorf("%s: incomplete sample %+v", kind, p)
+		}
+	}
+}
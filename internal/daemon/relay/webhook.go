@@ -73,15 +73,7 @@ func (w *WebhookAdapter) Kind() string { return "webhook" }
 // false for any kind the user has unchecked; the dispatcher skips Send
 // without ever opening a connection.
 func (w *WebhookAdapter) Supports(kind notify.Kind) bool {
-	switch kind {
-	case notify.KindTaskFailed:
-		return w.endpoint.EnabledEvents.TaskFailed
-	case notify.KindRunComplete:
-		return w.endpoint.EnabledEvents.RunComplete
-	case notify.KindWeeklyDigest:
-		return w.endpoint.EnabledEvents.WeeklyDigest
-	}
-	return false
+	return w.endpoint.EnabledEvents.Enabled(kind.EventKey())
 }
 
 // IsProjectMuted reports whether the source project sits inside the
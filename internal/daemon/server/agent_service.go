@@ -2,11 +2,14 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/posthog/posthog-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent/prompts"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
 	"github.com/watchfire-io/watchfire/internal/daemon/task"
 	"github.com/watchfire-io/watchfire/internal/daemon/watcher"
 	"github.com/watchfire-io/watchfire/internal/models"
@@ -22,8 +26,9 @@ import (
 
 type agentService struct {
 	pb.UnimplementedAgentServiceServer
-	manager *agent.Manager
-	watcher *watcher.Watcher
+	manager   *agent.Manager
+	watcher   *watcher.Watcher
+	questions *ask.Desk
 }
 
 func (s *agentService) StartAgent(_ context.Context, req *pb.StartAgentRequest) (*pb.AgentStatus, error) {
@@ -92,6 +97,8 @@ func (s *agentService) StartAgent(_ context.Context, req *pb.StartAgentRequest)
 		Rows:             int(req.Rows),
 		Cols:             int(req.Cols),
 		Sandbox:          resolveSandbox(req.Sandbox, proj),
+		OverrideBudget:   req.OverrideBudget,
+		StartedBy:        requestUser(req.Meta),
 	})
 	if err != nil {
 		return nil, err
@@ -192,6 +199,8 @@ func (s *agentService) startWildfireMode(req *pb.StartAgentRequest, projectPath
 		Rows:             int(req.Rows),
 		Cols:             int(req.Cols),
 		Sandbox:          resolveSandbox(req.Sandbox, proj),
+		OverrideBudget:   req.OverrideBudget,
+		StartedBy:        requestUser(req.Meta),
 	})
 	if err != nil {
 		return nil, err
@@ -226,6 +235,8 @@ func (s *agentService) startGenerateMode(req *pb.StartAgentRequest, projectPath
 		Rows:             int(req.Rows),
 		Cols:             int(req.Cols),
 		Sandbox:          resolveSandbox(req.Sandbox, proj),
+		OverrideBudget:   req.OverrideBudget,
+		StartedBy:        requestUser(req.Meta),
 	})
 	if err != nil {
 		return nil, err
@@ -268,6 +279,8 @@ func (s *agentService) startRetrofitMode(req *pb.StartAgentRequest, projectPath
 		Rows:             int(req.Rows),
 		Cols:             int(req.Cols),
 		Sandbox:          resolveSandbox(req.Sandbox, proj),
+		OverrideBudget:   req.OverrideBudget,
+		StartedBy:        requestUser(req.Meta),
 	})
 	if err != nil {
 		return nil, err
@@ -338,6 +351,7 @@ func issueToProto(issue *agent.AgentIssue) *pb.AgentIssue {
 		IssueType:  string(issue.Type),
 		DetectedAt: timestamppb.New(issue.DetectedAt),
 		Message:    issue.Message,
+		Options:    issue.Options,
 	}
 	if issue.ResetAt != nil {
 		pbIssue.ResetAt = timestamppb.New(*issue.ResetAt)
@@ -401,22 +415,12 @@ func (s *agentService) SubscribeScreen(req *pb.SubscribeScreenRequest, stream gr
 	}
 
 	subID := uuid.New().String()
-	ch := running.Process.SubscribeScreen(subID)
+	keyframe, ch := running.Process.SubscribeScreen(subID, req.Deltas)
 	defer running.Process.UnsubscribeScreen(subID)
 
-	// Send initial snapshot so the TUI sees the current screen when connecting
-	if snapshot := running.Process.SnapshotScreen(); snapshot != nil {
-		if err := stream.Send(&pb.ScreenBuffer{
-			ProjectId:   snapshot.ProjectID,
-			Lines:       snapshot.Lines,
-			CursorRow:   int32(snapshot.CursorRow),
-			CursorCol:   int32(snapshot.CursorCol),
-			Rows:        int32(snapshot.Rows),
-			Cols:        int32(snapshot.Cols),
-			AnsiContent: snapshot.AnsiContent,
-		}); err != nil {
-			return err
-		}
+	// Send the keyframe so the client sees the current screen when connecting
+	if err := stream.Send(screenUpdateToProto(keyframe)); err != nil {
+		return err
 	}
 
 	for {
@@ -425,15 +429,7 @@ func (s *agentService) SubscribeScreen(req *pb.SubscribeScreenRequest, stream gr
 			if !ok {
 				return nil
 			}
-			if err := stream.Send(&pb.ScreenBuffer{
-				ProjectId:   update.ProjectID,
-				Lines:       update.Lines,
-				CursorRow:   int32(update.CursorRow),
-				CursorCol:   int32(update.CursorCol),
-				Rows:        int32(update.Rows),
-				Cols:        int32(update.Cols),
-				AnsiContent: update.AnsiContent,
-			}); err != nil {
+			if err := stream.Send(screenUpdateToProto(update)); err != nil {
 				return err
 			}
 		case <-running.Process.Done():
@@ -444,6 +440,29 @@ func (s *agentService) SubscribeScreen(req *pb.SubscribeScreenRequest, stream gr
 	}
 }
 
+// screenUpdateToProto converts a keyframe or delta frame to the wire shape.
+func screenUpdateToProto(u *agent.ScreenUpdate) *pb.ScreenBuffer {
+	buf := &pb.ScreenBuffer{
+		ProjectId:   u.ProjectID,
+		Lines:       u.Lines,
+		CursorRow:   int32(u.CursorRow),
+		CursorCol:   int32(u.CursorCol),
+		Rows:        int32(u.Rows),
+		Cols:        int32(u.Cols),
+		AnsiContent: u.AnsiContent,
+		Seq:         u.Seq,
+		Keyframe:    u.Keyframe,
+	}
+	for _, d := range u.RowDeltas {
+		buf.RowDeltas = append(buf.RowDeltas, &pb.ScreenRowDelta{
+			Row:  int32(d.Row),
+			Line: d.Line,
+			Ansi: d.Ansi,
+		})
+	}
+	return buf
+}
+
 func (s *agentService) SendInput(_ context.Context, req *pb.SendInputRequest) (*emptypb.Empty, error) {
 	running, ok := s.manager.GetAgent(req.ProjectId)
 	if !ok {
@@ -544,3 +563,24 @@ func (s *agentService) ResumeAgent(_ context.Context, req *pb.ProjectId) (*pb.Ag
 	running.Process.ClearIssue()
 	return buildAgentStatus(running), nil
 }
+
+// AnswerQuestion answers the question the project's agent is waiting on
+// from the GUI, TUI or CLI — the local counterpart of the chat `answer`
+// command.
+func (s *agentService) AnswerQuestion(_ context.Context, req *pb.AnswerQuestionRequest) (*emptypb.Empty, error) {
+	if s.questions == nil {
+		return nil, status.Error(codes.Unavailable, "agent questions are not enabled")
+	}
+	_, err := s.questions.Answer(req.ProjectId, ask.Reply{
+		Text:   req.Answer,
+		Option: int(req.Option),
+		Actor:  requestUser(req.Meta),
+	})
+	if errors.Is(err, ask.ErrNotPending) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
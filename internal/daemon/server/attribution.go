@@ -0,0 +1,30 @@
+package server
+
+import (
+	"github.com/watchfire-io/watchfire/internal/config"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// remoteOrigins are the RequestMeta origins that reach the daemon from
+// somewhere other than this machine's user. They name their caller in
+// meta.user themselves; an empty one stays unattributed rather than
+// being charged to the daemon owner.
+var remoteOrigins = map[string]bool{
+	"mcp":      true,
+	"slack":    true,
+	"discord":  true,
+	"telegram": true,
+}
+
+// requestUser is who a request acts for: meta.user when the client set
+// one, else — for local clients (CLI, TUI, GUI, tray), which run as the
+// daemon's own user — config.LocalUser.
+func requestUser(meta *pb.RequestMeta) string {
+	if u := meta.GetUser(); u != "" {
+		return u
+	}
+	if remoteOrigins[meta.GetOrigin()] {
+		return ""
+	}
+	return config.LocalUser()
+}
@@ -64,6 +64,9 @@ func (s *branchService) MergeBranch(_ context.Context, req *pb.MergeBranchReques
 		return nil, fmt.Errorf("merge failed: %w", err)
 	}
 
+	if merged {
+		releaseApprovalHold(projectPath, taskNum)
+	}
 	if req.DeleteAfterMerge && merged {
 		_ = agent.RemoveWorktree(projectPath, taskNum, true)
 	}
@@ -127,6 +130,7 @@ func (s *branchService) BulkMerge(_ context.Context, req *pb.BulkBranchRequest)
 		status := "unmerged"
 		if err == nil && merged {
 			status = "merged"
+			releaseApprovalHold(projectPath, taskNum)
 		}
 		results = append(results, &pb.Branch{
 			Name:       branchName,
@@ -138,6 +142,16 @@ func (s *branchService) BulkMerge(_ context.Context, req *pb.BulkBranchRequest)
 	return &pb.BranchList{Branches: results}, nil
 }
 
+// releaseApprovalHold clears the approval hold of a task whose branch
+// was merged by hand: merging it is the decision the hold waited on.
+func releaseApprovalHold(projectPath string, taskNum int) {
+	t, err := config.LoadTask(projectPath, taskNum)
+	if err != nil || t == nil || !t.ReleaseApprovalHold() {
+		return
+	}
+	_ = config.SaveTask(projectPath, t)
+}
+
 func (s *branchService) BulkDelete(_ context.Context, req *pb.BulkBranchRequest) (*emptypb.Empty, error) {
 	projectPath, err := getProjectPath(req.ProjectId)
 	if err != nil {
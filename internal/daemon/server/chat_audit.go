@@ -0,0 +1,70 @@
+// Audit log of refused chat commands: every Slack / Discord / Telegram
+// command or button a user's chat role did not allow is appended as one
+// JSON line to ~/.watchfire/logs/chat-audit.log, so the owner can see
+// who tried what before widening a role mapping.
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// chatAuditFileName is the audit log's file name in the global logs
+// directory.
+const chatAuditFileName = "chat-audit.log"
+
+// chatAuditRecord is one refused chat command.
+type chatAuditRecord struct {
+	Time      time.Time       `json:"time"`
+	Transport string          `json:"transport"`
+	Workspace string          `json:"workspace,omitempty"` // Slack team id / Discord guild id
+	UserID    string          `json:"user_id"`
+	Actor     string          `json:"actor,omitempty"`
+	Verb      string          `json:"verb"`
+	Role      models.ChatRole `json:"role"`
+	Required  models.ChatRole `json:"required"`
+}
+
+// chatAuditMu serializes appends from concurrent handlers.
+var chatAuditMu sync.Mutex
+
+// appendChatAudit is the production audit sink. A write failure is
+// logged, never surfaced — the refusal itself already happened.
+func appendChatAudit(rec chatAuditRecord) {
+	log.Printf("WARN: chat: refused %s %q for %s user %s (role %s, needs %s)", rec.Transport, rec.Verb, rec.Workspace, rec.UserID, rec.Role, rec.Required)
+	if err := config.EnsureGlobalLogsDir(); err != nil {
+		log.Printf("ERROR: chat audit: %v", err)
+		return
+	}
+	dir, err := config.GlobalLogsDir()
+	if err != nil {
+		log.Printf("ERROR: chat audit: %v", err)
+		return
+	}
+	if err := appendChatAuditFile(filepath.Join(dir, chatAuditFileName), rec); err != nil {
+		log.Printf("ERROR: chat audit: %v", err)
+	}
+}
+
+func appendChatAuditFile(path string, rec chatAuditRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	chatAuditMu.Lock()
+	defer chatAuditMu.Unlock()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,187 @@
+// The Slack / Discord `watch` command: while a workspace watches a
+// project, its agent sessions' screens are posted to that workspace's
+// Watchfire endpoints whenever they change. Telegram has a richer,
+// bot-API-specific relay of its own (telegram/watch.go); this one only
+// posts plain screen snapshots through incoming webhooks, so it polls
+// on a slow interval that stays clear of the webhook rate limits.
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/echo"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegram"
+)
+
+// chatWatchInterval is how often watched sessions are snapshotted. A
+// snapshot is only posted when the screen changed since the last one.
+const chatWatchInterval = 30 * time.Second
+
+// chatWatchKey identifies one workspace watching one project.
+type chatWatchKey struct {
+	Transport string // "slack" / "discord"
+	Workspace string // Slack team id / Discord guild id
+	ProjectID string
+}
+
+// chatWatchState is the relay state of one watch. Only the run loop
+// touches sess / last.
+type chatWatchState struct {
+	projectName string
+	sess        *telegram.WatchedSession
+	last        string
+}
+
+// chatWatch polls the watched projects' sessions and posts their
+// screens. Watches live in memory: a daemon restart turns them off.
+type chatWatch struct {
+	sessions func(projectID string) (*telegram.WatchedSession, bool)
+	post     func(ctx context.Context, key chatWatchKey, t relay.SessionTail) error
+	interval time.Duration
+
+	mu      sync.Mutex
+	watches map[chatWatchKey]*chatWatchState
+}
+
+func newChatWatch(sessions func(string) (*telegram.WatchedSession, bool), post func(context.Context, chatWatchKey, relay.SessionTail) error) *chatWatch {
+	return &chatWatch{
+		sessions: sessions,
+		post:     post,
+		interval: chatWatchInterval,
+		watches:  map[chatWatchKey]*chatWatchState{},
+	}
+}
+
+// Set turns the watch for key on or off.
+func (w *chatWatch) Set(key chatWatchKey, projectName string, on bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !on {
+		delete(w.watches, key)
+		return
+	}
+	if _, ok := w.watches[key]; !ok {
+		w.watches[key] = &chatWatchState{projectName: projectName}
+	}
+}
+
+// Watching reports whether key is watched.
+func (w *chatWatch) Watching(key chatWatchKey) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, ok := w.watches[key]
+	return ok
+}
+
+// Run polls until ctx is cancelled.
+func (w *chatWatch) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.tick(ctx)
+		}
+	}
+}
+
+// tick snapshots every watched session once. A session that ended
+// since the last tick gets a final snapshot marked Ended; a new session
+// starts over with an empty "last posted" screen.
+func (w *chatWatch) tick(ctx context.Context) {
+	w.mu.Lock()
+	watches := make(map[chatWatchKey]*chatWatchState, len(w.watches))
+	for k, st := range w.watches {
+		watches[k] = st
+	}
+	w.mu.Unlock()
+
+	for key, st := range watches {
+		cur, live := w.sessions(key.ProjectID)
+		if st.sess != nil && (!live || cur.Done != st.sess.Done) {
+			w.postScreen(ctx, key, st, st.sess, true)
+			st.sess, st.last = nil, ""
+		}
+		if live {
+			st.sess = cur
+			w.postScreen(ctx, key, st, cur, false)
+		}
+	}
+}
+
+// postScreen posts sess's screen when it changed since the last post
+// (always, for the final snapshot of an ended session).
+func (w *chatWatch) postScreen(ctx context.Context, key chatWatchKey, st *chatWatchState, sess *telegram.WatchedSession, ended bool) {
+	if sess.Snapshot == nil {
+		return
+	}
+	screen := echo.ScreenTail(telegram.NormalizeScreen(sess.Snapshot()))
+	if screen == "" || (screen == st.last && !ended) {
+		return
+	}
+	st.last = screen
+	err := w.post(ctx, key, relay.SessionTail{
+		ProjectID:   key.ProjectID,
+		ProjectName: st.projectName,
+		TaskNumber:  sess.TaskNumber,
+		TaskTitle:   sess.TaskTitle,
+		Mode:        sess.Mode,
+		Screen:      screen,
+		Ended:       ended,
+	})
+	if errors.Is(err, relay.ErrNoSessionTailTransport) {
+		log.Printf("WARN: chat watch: %s %s watches project %s but has no endpoint to post to", key.Transport, key.Workspace, key.ProjectID)
+	}
+}
+
+// postChatWatch is the production post hook: the snapshot goes to the
+// watching workspace's endpoints only — the endpoints bound to the team
+// (see slackWatchTeam) for a Slack watch, the endpoints bound to the
+// guild for a Discord watch.
+func (s *Server) postChatWatch(ctx context.Context, key chatWatchKey, t relay.SessionTail) error {
+	var teams []string
+	for _, a := range s.relayDispatch.Adapters() {
+		if sl, ok := a.(*relay.SlackAdapter); ok {
+			teams = append(teams, sl.TeamID())
+		}
+	}
+	team, slackOK := slackWatchTeam(teams, key.Workspace)
+	return s.relayDispatch.PostSessionTail(ctx, t, func(a relay.Adapter) bool {
+		if a.Kind() != key.Transport {
+			return false
+		}
+		switch ad := a.(type) {
+		case *relay.DiscordAdapter:
+			return ad.GuildID() == key.Workspace
+		case *relay.SlackAdapter:
+			return slackOK && ad.TeamID() == team
+		}
+		return true
+	})
+}
+
+// slackWatchTeam picks the Slack endpoints a team's watch posts to,
+// given the team id of every configured Slack endpoint: the endpoints
+// bound to the team, or else the only endpoint when just one is
+// configured and it is unbound. It returns the team id those endpoints
+// carry; ok=false when no endpoint qualifies — several unbound webhooks
+// could be any workspace's, and posting a session screen to another
+// workspace would leak it.
+func slackWatchTeam(endpointTeams []string, team string) (string, bool) {
+	for _, t := range endpointTeams {
+		if t == team {
+			return team, true
+		}
+	}
+	if len(endpointTeams) == 1 && endpointTeams[0] == "" {
+		return "", true
+	}
+	return "", false
+}
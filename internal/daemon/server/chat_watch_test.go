@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegram"
+)
+
+// TestChatWatchPostsChangedScreens: a watched session's screen is
+// posted when it changes, not on every tick; the session's end gets a
+// final snapshot; turning the watch off stops the posts.
+func TestChatWatchPostsChangedScreens(t *testing.T) {
+	screen := []string{"\x1b[1mBuilding\x1b[0m", "step 1   "}
+	done := make(chan struct{})
+	live := true
+	sess := &telegram.WatchedSession{
+		ProjectID: "p-1", TaskNumber: 7, TaskTitle: "Ship it", Mode: "task",
+		Done:     done,
+		Snapshot: func() []string { return screen },
+	}
+	var posted []relay.SessionTail
+	var keys []chatWatchKey
+	w := newChatWatch(
+		func(string) (*telegram.WatchedSession, bool) { return sess, live },
+		func(_ context.Context, key chatWatchKey, tail relay.SessionTail) error {
+			keys = append(keys, key)
+			posted = append(posted, tail)
+			return nil
+		})
+	key := chatWatchKey{Transport: "slack", Workspace: "T-1", ProjectID: "p-1"}
+	w.Set(key, "Proj", true)
+	ctx := context.Background()
+
+	w.tick(ctx)
+	w.tick(ctx) // unchanged screen — nothing new
+	if len(posted) != 1 || posted[0].Screen != "Building\nstep 1" || posted[0].ProjectName != "Proj" || posted[0].TaskNumber != 7 {
+		t.Fatalf("posted = %+v", posted)
+	}
+	if keys[0] != key {
+		t.Fatalf("posted for %+v, want %+v", keys[0], key)
+	}
+
+	screen = []string{"Building", "step 2"}
+	w.tick(ctx)
+	if len(posted) != 2 || !strings.Contains(posted[1].Screen, "step 2") {
+		t.Fatalf("changed screen not posted: %+v", posted)
+	}
+
+	close(done)
+	live = false
+	w.tick(ctx)
+	w.tick(ctx)
+	if len(posted) != 3 || !posted[2].Ended {
+		t.Fatalf("want one final Ended snapshot, got %+v", posted)
+	}
+
+	w.Set(key, "", false)
+	live, screen = true, []string{"next session"}
+	w.tick(ctx)
+	if len(posted) != 3 || w.Watching(key) {
+		t.Fatalf("posted after the watch was turned off: %+v", posted)
+	}
+}
+
+func TestSlackWatchTeam(t *testing.T) {
+	cases := []struct {
+		name   string
+		teams  []string
+		want   string
+		wantOK bool
+	}{
+		{"bound endpoint wins", []string{"", "T-1", "T-2"}, "T-1", true},
+		{"lone unbound endpoint", []string{""}, "", true},
+		{"several unbound endpoints", []string{"", ""}, "", false},
+		{"only other teams", []string{"T-2"}, "", false},
+		{"none configured", nil, "", false},
+	}
+	for _, c := range cases {
+		got, ok := slackWatchTeam(c.teams, "T-1")
+		if got != c.want || ok != c.wantOK {
+			t.Errorf("%s: slackWatchTeam = %q, %v; want %q, %v", c.name, got, ok, c.want, c.wantOK)
+		}
+	}
+}
@@ -9,8 +9,8 @@
 // router was reachable only from tests. This file is the daemon-side
 // implementation; the v10 Telegram bridge routes through the same
 // callbacks, so keep them transport-agnostic (the only transport hint
-// is the scope's GuildID/TeamID, used for project mapping and the
-// default cancel reason).
+// is the scope's GuildID/TeamID, used for project mapping, run-control
+// authorization, watch keys and the default cancel reason).
 //
 // The implementation lives in `server` (not `echo`) because it reaches
 // into the projects index, per-project YAML, the task manager, and the
@@ -23,10 +23,14 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
 	"github.com/watchfire-io/watchfire/internal/daemon/echo"
 	"github.com/watchfire-io/watchfire/internal/daemon/task"
+	"github.com/watchfire-io/watchfire/internal/daemon/telegram"
 	"github.com/watchfire-io/watchfire/internal/models"
 )
 
@@ -35,7 +39,7 @@ import (
 // Telegram carries no id: a Telegram chat only reaches the router
 // after pairing, and pairing binds the chat to the daemon owner — so
 // the flag alone is the scope (see resolveMappedProjects). UserID is
-// carried for audit logging only.
+// the chat user, resolved to a chat role per command (scopeRole).
 type commandScope struct {
 	GuildID  string
 	TeamID   string
@@ -66,13 +70,35 @@ type commandContextDeps struct {
 	// semantics (no chaining into the next ready task) — a cancel
 	// issued from chat is a user intent, same as the TUI/GUI stop.
 	StopAgentByUser func(projectID string) error
+	// ResolveApproval answers a pending merge approval
+	// (approval.Gate.Resolve). nil when no gate is running.
+	ResolveApproval func(projectID string, taskNumber int, d approval.Decision, actor string) error
+	// PendingQuestion / AnswerQuestion read and answer the question a
+	// working agent is waiting on (ask.Desk Get / Answer). nil when no
+	// desk is running.
+	PendingQuestion func(projectID string) (ask.Question, bool)
+	AnswerQuestion  func(projectID string, r ask.Reply) (ask.Question, error)
+	// StartAgent starts an agent session attributed to origin / actor,
+	// replacing a running one (startAgentAs).
+	StartAgent func(ctx context.Context, origin, actor, projectID, mode string, taskNumber int) (echo.RunStart, error)
+	// CreateTask is the task manager's validated create path.
+	CreateTask func(projectPath string, opts task.CreateOptions) (*models.Task, error)
+	// ScreenLines returns the raw terminal screen of the project's
+	// running agent; ok=false when none runs.
+	ScreenLines func(projectID string) (lines []string, ok bool)
+	// SetWatch / Watching drive the Slack / Discord `watch` relay
+	// (chatWatch). nil when no relay is running.
+	SetWatch func(key chatWatchKey, projectName string, on bool)
+	Watching func(key chatWatchKey) bool
+	// AuditDenied records a command the user's chat role refused.
+	AuditDenied func(rec chatAuditRecord)
 }
 
 // commandContextDeps builds the production dependency set from the
 // server's managers. Loaders resolve per call so config edits (new
 // projects, changed bindings) take effect without a daemon restart.
 func (s *Server) commandContextDeps() commandContextDeps {
-	return commandContextDeps{
+	deps := commandContextDeps{
 		LoadIntegrations:  config.LoadIntegrations,
 		LoadProjectsIndex: config.LoadProjectsIndex,
 		LoadProject:       config.LoadProject,
@@ -88,7 +114,38 @@ func (s *Server) commandContextDeps() commandContextDeps {
 			return ag.TaskNumber, true
 		},
 		StopAgentByUser: s.agentManager.StopAgentByUser,
+		StartAgent: func(ctx context.Context, origin, actor, projectID, mode string, taskNumber int) (echo.RunStart, error) {
+			st, err := startAgentAs(ctx, s.agentManager, s.watcher, origin, actor, projectID, mode, taskNumber)
+			if err != nil {
+				return echo.RunStart{}, err
+			}
+			return echo.RunStart{TaskNumber: int(st.TaskNumber), TaskTitle: st.TaskTitle}, nil
+		},
+		CreateTask:  s.taskManager.CreateTask,
+		AuditDenied: appendChatAudit,
+		ScreenLines: func(projectID string) ([]string, bool) {
+			ag, ok := s.agentManager.GetAgent(projectID)
+			if !ok || ag == nil || ag.Process == nil {
+				return nil, false
+			}
+			if su := ag.Process.SnapshotScreen(); su != nil {
+				return su.Lines, true
+			}
+			return nil, true
+		},
 	}
+	if s.chatWatch != nil {
+		deps.SetWatch = s.chatWatch.Set
+		deps.Watching = s.chatWatch.Watching
+	}
+	if s.approvals != nil {
+		deps.ResolveApproval = s.approvals.Resolve
+	}
+	if s.questions != nil {
+		deps.PendingQuestion = s.questions.Get
+		deps.AnswerQuestion = s.questions.Answer
+	}
+	return deps
 }
 
 // slackCommandContextFor is the `CommandContextFor` factory wired into
@@ -111,7 +168,8 @@ func (s *Server) discordCommandContextFor(guildID, userID string) echo.CommandCo
 // daemon owner — pairing is the authorization boundary, enforced by
 // the bridge before any command reaches the router — so unlike the
 // guild/team-scoped Slack/Discord factories this scope sees every
-// registered project. chatID is accepted for symmetry with the bridge
+// registered project; what each member may do is still set by their
+// chat role (scopeRole). chatID is accepted for symmetry with the bridge
 // callback shape but deliberately unused: per-chat state (the active
 // project) lives in the bridge, not in project visibility.
 func (s *Server) telegramCommandContextFor(chatID, userID int64) echo.CommandContext {
@@ -134,6 +192,30 @@ func newCommandContext(scope commandScope, deps commandContextDeps) echo.Command
 		TeamID:  scope.TeamID,
 		UserID:  scope.UserID,
 
+		Role: func(ctx context.Context) models.ChatRole {
+			return scopeRole(ctx, scope, deps)
+		},
+
+		Denied: func(ctx context.Context, verb string, role, need models.ChatRole) {
+			if deps.AuditDenied == nil {
+				return
+			}
+			workspace := scope.TeamID
+			if workspace == "" {
+				workspace = scope.GuildID
+			}
+			deps.AuditDenied(chatAuditRecord{
+				Time:      time.Now().UTC(),
+				Transport: scopeTransport(scope),
+				Workspace: workspace,
+				UserID:    scope.UserID,
+				Actor:     echo.Actor(ctx),
+				Verb:      verb,
+				Role:      role,
+				Required:  need,
+			})
+		},
+
 		FindProjects: func(ctx context.Context) ([]echo.ProjectInfo, error) {
 			mapped, err := resolveMappedProjects(scope, deps)
 			if err != nil {
@@ -271,6 +353,224 @@ func newCommandContext(scope commandScope, deps commandContextDeps) echo.Command
 			t.MarkDone(false, reason)
 			return deps.SaveTask(m.path, t)
 		},
+
+		ResolveApproval: func(ctx context.Context, projectID string, taskNumber int, decision approval.Decision) error {
+			if deps.ResolveApproval == nil {
+				return approval.ErrNotPending
+			}
+			// Only a chat that can see the project may answer for it.
+			if _, err := mappedProjectByID(scope, deps, projectID); err != nil {
+				return err
+			}
+			return deps.ResolveApproval(projectID, taskNumber, decision, commandActor(ctx, scope))
+		},
+
+		PendingQuestion: func(_ context.Context, projectID string) (ask.Question, bool) {
+			if deps.PendingQuestion == nil {
+				return ask.Question{}, false
+			}
+			if _, err := mappedProjectByID(scope, deps, projectID); err != nil {
+				return ask.Question{}, false
+			}
+			return deps.PendingQuestion(projectID)
+		},
+
+		AnswerQuestion: func(ctx context.Context, projectID string, r ask.Reply) error {
+			if deps.AnswerQuestion == nil {
+				return ask.ErrNotPending
+			}
+			// Only a chat that can see the project may answer for it.
+			if _, err := mappedProjectByID(scope, deps, projectID); err != nil {
+				return err
+			}
+			r.Actor = commandActor(ctx, scope)
+			_, err := deps.AnswerQuestion(projectID, r)
+			return err
+		},
+
+		StartAgent: func(ctx context.Context, projectID string, mode echo.RunMode, taskNumber int) (echo.RunStart, error) {
+			if err := authorizeRun(ctx, scope, deps); err != nil {
+				return echo.RunStart{}, err
+			}
+			if _, err := mappedProjectByID(scope, deps, projectID); err != nil {
+				return echo.RunStart{}, err
+			}
+			replaced := ""
+			if current, running := deps.AgentTaskNumber(projectID); running {
+				replaced = "agent"
+				if current > 0 {
+					replaced = fmt.Sprintf("agent on task #%04d", current)
+				}
+			}
+			started, err := deps.StartAgent(ctx, scopeTransport(scope), commandActor(ctx, scope), projectID, string(mode), taskNumber)
+			started.Replaced = replaced
+			return started, err
+		},
+
+		StopAgent: func(ctx context.Context, projectID string) error {
+			if err := authorizeRun(ctx, scope, deps); err != nil {
+				return err
+			}
+			if _, err := mappedProjectByID(scope, deps, projectID); err != nil {
+				return err
+			}
+			if _, running := deps.AgentTaskNumber(projectID); !running {
+				return fmt.Errorf("nothing is running for this project")
+			}
+			return deps.StopAgentByUser(projectID)
+		},
+
+		CreateTask: func(ctx context.Context, projectID, title string) (*models.Task, error) {
+			m, err := mappedProjectByID(scope, deps, projectID)
+			if err != nil {
+				return nil, err
+			}
+			return deps.CreateTask(m.path, task.CreateOptions{
+				Title:     title,
+				Prompt:    title,
+				Status:    string(models.TaskStatusReady),
+				CreatedBy: commandActor(ctx, scope),
+			})
+		},
+
+		Screen: func(_ context.Context, projectID string) (string, bool) {
+			if _, err := mappedProjectByID(scope, deps, projectID); err != nil {
+				return "", false
+			}
+			lines, ok := deps.ScreenLines(projectID)
+			if !ok {
+				return "", false
+			}
+			return telegram.NormalizeScreen(lines), true
+		},
+
+		Watch: func(_ context.Context, projectID string, on bool) error {
+			if deps.SetWatch == nil {
+				return fmt.Errorf("session watching is not running")
+			}
+			key, ok := watchKey(scope, projectID)
+			if !ok {
+				return fmt.Errorf("watch is only available from Slack and Discord")
+			}
+			m, err := mappedProjectByID(scope, deps, projectID)
+			if err != nil {
+				return err
+			}
+			if on && key.Transport == "slack" {
+				if err := checkSlackWatchEndpoint(deps, scope.TeamID); err != nil {
+					return err
+				}
+			}
+			deps.SetWatch(key, m.info.Name, on)
+			return nil
+		},
+
+		Watching: func(_ context.Context, projectID string) bool {
+			key, ok := watchKey(scope, projectID)
+			return ok && deps.Watching != nil && deps.Watching(key)
+		},
+	}
+}
+
+// authorizeRun refuses a run-control call from a user who is not an
+// admin of the calling workspace. Route already gates `run` / `stop`;
+// this keeps the callbacks safe for callers that bypass it.
+func authorizeRun(ctx context.Context, scope commandScope, deps commandContextDeps) error {
+	if !scopeRole(ctx, scope, deps).Allows(models.ChatRoleAdmin) {
+		return echo.ErrNotAllowed
+	}
+	return nil
+}
+
+// scopeRole resolves the calling user's chat role from the role
+// mappings in integrations.yaml, read per call so edits apply without a
+// restart. A config that fails to load grants nothing.
+func scopeRole(ctx context.Context, scope commandScope, deps commandContextDeps) models.ChatRole {
+	cfg, err := deps.LoadIntegrations()
+	if err != nil || cfg == nil {
+		log.Printf("WARN: chat: load integrations for role of %s user %s: %v", scopeTransport(scope), scope.UserID, err)
+		return ""
+	}
+	switch {
+	case scope.TeamID != "":
+		return cfg.SlackRole(scope.TeamID, scope.UserID)
+	case scope.GuildID != "":
+		return cfg.DiscordRole(scope.GuildID, scope.UserID, echo.MemberRoles(ctx))
+	case scope.Telegram:
+		id, err := strconv.ParseInt(scope.UserID, 10, 64)
+		if err != nil {
+			return ""
+		}
+		return cfg.TelegramRole(id)
+	default:
+		return ""
+	}
+}
+
+// checkSlackWatchEndpoint refuses a Slack `watch` that would have no
+// endpoint known to belong to the calling team: several Slack endpoints
+// are configured and none carries its team_id.
+func checkSlackWatchEndpoint(deps commandContextDeps, teamID string) error {
+	cfg, err := deps.LoadIntegrations()
+	if err != nil {
+		return fmt.Errorf("load integrations: %w", err)
+	}
+	if len(cfg.Slack) <= 1 {
+		return nil
+	}
+	teams := make([]string, 0, len(cfg.Slack))
+	for _, ep := range cfg.Slack {
+		teams = append(teams, ep.TeamID)
+	}
+	if _, ok := slackWatchTeam(teams, teamID); !ok {
+		return fmt.Errorf("several Slack endpoints are configured and none is bound to this workspace — set `team_id: %s` on the endpoint watch should post to in integrations.yaml", teamID)
+	}
+	return nil
+}
+
+// watchKey is the chatWatch key of the calling workspace; ok=false for
+// Telegram, which has its own live relay.
+func watchKey(scope commandScope, projectID string) (chatWatchKey, bool) {
+	switch {
+	case scope.TeamID != "":
+		return chatWatchKey{Transport: "slack", Workspace: scope.TeamID, ProjectID: projectID}, true
+	case scope.GuildID != "":
+		return chatWatchKey{Transport: "discord", Workspace: scope.GuildID, ProjectID: projectID}, true
+	default:
+		return chatWatchKey{}, false
+	}
+}
+
+// commandActor is who a command acts for: the identity the transport
+// tagged ctx with, else the calling chat user.
+func commandActor(ctx context.Context, scope commandScope) string {
+	if actor := echo.Actor(ctx); actor != "" {
+		return actor
+	}
+	return scopeActor(scope)
+}
+
+// scopeActor is the attribution identity for the calling chat user
+// ("slack:U024BE7LH") when the transport did not tag ctx with one.
+func scopeActor(scope commandScope) string {
+	if transport := scopeTransport(scope); transport != "" {
+		return config.RemoteUser(transport, scope.UserID)
+	}
+	return ""
+}
+
+// scopeTransport names the calling transport — also the RequestMeta
+// origin of the agents it starts.
+func scopeTransport(scope commandScope) string {
+	switch {
+	case scope.TeamID != "":
+		return "slack"
+	case scope.GuildID != "":
+		return "discord"
+	case scope.Telegram:
+		return "telegram"
+	default:
+		return ""
 	}
 }
 
@@ -3,6 +3,8 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +28,7 @@ type ccFixture struct {
 	agentRunning bool //
 	stopCalls    []string
 	stopErr      error
+	starts       []string // "origin actor project mode task"
 }
 
 func newCCFixture(t *testing.T) *ccFixture {
@@ -57,6 +60,11 @@ func (f *ccFixture) deps() commandContextDeps {
 			f.stopCalls = append(f.stopCalls, projectID)
 			return f.stopErr
 		},
+		StartAgent: func(_ context.Context, origin, actor, projectID, mode string, taskNumber int) (echo.RunStart, error) {
+			f.starts = append(f.starts, fmt.Sprintf("%s %s %s %s %d", origin, actor, projectID, mode, taskNumber))
+			return echo.RunStart{TaskNumber: taskNumber}, nil
+		},
+		CreateTask: f.taskMgr.CreateTask,
 	}
 }
 
@@ -452,3 +460,188 @@ func TestCancelRefusals(t *testing.T) {
 		t.Fatalf("task marked %s despite stop failure, want ready", tk.Status)
 	}
 }
+
+func TestRunControlRequiresAdmin(t *testing.T) {
+	f := newCCFixture(t)
+	f.addProject("p-1", "Proj", func(p *models.Project) {
+		p.Integrations.DiscordGuildID = "g-1"
+	})
+	f.integrations.Inbound.DiscordRoles = map[string]models.ChatRoleMap{
+		"g-1": {Default: models.ChatRoleOperator, Users: map[string]models.ChatRole{"D-ok": models.ChatRoleAdmin}},
+		"g-2": {Users: map[string]models.ChatRole{"D-nope": models.ChatRoleAdmin}},
+	}
+	f.integrations.Telegram = &models.TelegramConfig{PairedChats: []models.TelegramPairedChat{{ChatID: 100, UserID: 42}}}
+	ctx := context.Background()
+
+	for _, user := range []string{"D-nope", ""} {
+		cc := newCommandContext(commandScope{GuildID: "g-1", UserID: user}, f.deps())
+		if _, err := cc.StartAgent(ctx, "p-1", echo.RunAll, 0); !errors.Is(err, echo.ErrNotAllowed) {
+			t.Fatalf("StartAgent by %q: err = %v, want ErrNotAllowed", user, err)
+		}
+		if err := cc.StopAgent(ctx, "p-1"); !errors.Is(err, echo.ErrNotAllowed) {
+			t.Fatalf("StopAgent by %q: err = %v, want ErrNotAllowed", user, err)
+		}
+	}
+	if len(f.starts) != 0 || len(f.stopCalls) != 0 {
+		t.Fatalf("refused calls reached the agent manager: starts=%v stops=%v", f.starts, f.stopCalls)
+	}
+
+	f.agentRunning, f.agentTask = true, 4
+	cc := newCommandContext(commandScope{GuildID: "g-1", UserID: "D-ok"}, f.deps())
+	started, err := cc.StartAgent(ctx, "p-1", echo.RunTask, 7)
+	if err != nil {
+		t.Fatalf("StartAgent: %v", err)
+	}
+	if want := "discord discord:D-ok p-1 task 7"; len(f.starts) != 1 || f.starts[0] != want {
+		t.Fatalf("starts = %v, want [%s]", f.starts, want)
+	}
+	if started.Replaced != "agent on task #0004" {
+		t.Fatalf("Replaced = %q", started.Replaced)
+	}
+	if err := cc.StopAgent(ctx, "p-1"); err != nil || len(f.stopCalls) != 1 {
+		t.Fatalf("StopAgent: err=%v stops=%v", err, f.stopCalls)
+	}
+	if _, err := cc.StartAgent(ctx, "p-unmapped", echo.RunAll, 0); err == nil {
+		t.Fatal("StartAgent on an unmapped project succeeded")
+	}
+
+	// The user who paired a Telegram chat is an admin without a
+	// mapping; anyone else in it is an operator.
+	tg := newCommandContext(commandScope{Telegram: true, UserID: "42"}, f.deps())
+	if _, err := tg.StartAgent(ctx, "p-1", echo.RunWildfire, 0); err != nil {
+		t.Fatalf("telegram StartAgent: %v", err)
+	}
+	tg = newCommandContext(commandScope{Telegram: true, UserID: "43"}, f.deps())
+	if _, err := tg.StartAgent(ctx, "p-1", echo.RunWildfire, 0); !errors.Is(err, echo.ErrNotAllowed) {
+		t.Fatalf("telegram StartAgent by a group member: err = %v, want ErrNotAllowed", err)
+	}
+}
+
+// TestChatRolesGateRouteAndAudit: Route refuses verbs above the
+// caller's role — Discord member roles count — and each refusal lands
+// in the audit sink; a workspace without a mapping keeps task commands
+// open.
+func TestChatRolesGateRouteAndAudit(t *testing.T) {
+	f := newCCFixture(t)
+	path := f.addProject("p-1", "Proj", func(p *models.Project) {
+		p.Integrations.DiscordGuildID = "g-1"
+	})
+	failed := false
+	f.addTask(path, &models.Task{TaskID: "t0000005", TaskNumber: 5, Title: "failed run", Status: models.TaskStatusDone, Success: &failed})
+	f.integrations.Inbound.DiscordRoles = map[string]models.ChatRoleMap{
+		"g-1": {Roles: map[string]models.ChatRole{"r-ops": models.ChatRoleOperator}},
+	}
+	var audit []chatAuditRecord
+	deps := f.deps()
+	deps.AuditDenied = func(rec chatAuditRecord) { audit = append(audit, rec) }
+	ctx := context.Background()
+
+	viewer := newCommandContext(commandScope{GuildID: "g-1", UserID: "D-1"}, deps)
+	if resp := echo.Route(ctx, "/watchfire", "retry", "5", viewer); !resp.Ephemeral || !strings.Contains(resp.Text, "needs the operator role and you have viewer") {
+		t.Fatalf("viewer retry = %+v", resp)
+	}
+	if resp := echo.Route(ctx, "/watchfire", "status", "", viewer); strings.Contains(resp.Text, "needs the") {
+		t.Fatalf("viewer status refused: %+v", resp)
+	}
+	if len(audit) != 1 || audit[0].Transport != "discord" || audit[0].Workspace != "g-1" || audit[0].UserID != "D-1" ||
+		audit[0].Verb != "retry" || audit[0].Role != models.ChatRoleViewer || audit[0].Required != models.ChatRoleOperator {
+		t.Fatalf("audit = %+v", audit)
+	}
+	if tk, _ := config.LoadTask(path, 5); tk.Status != models.TaskStatusDone {
+		t.Fatalf("refused retry changed the task to %s", tk.Status)
+	}
+
+	operator := newCommandContext(commandScope{GuildID: "g-1", UserID: "D-2"}, deps)
+	if resp := echo.Route(echo.WithMemberRoles(ctx, []string{"r-ops"}), "/watchfire", "retry", "5", operator); !resp.InChannel {
+		t.Fatalf("operator retry = %+v", resp)
+	}
+	if resp := echo.Route(echo.WithMemberRoles(ctx, []string{"r-ops"}), "/watchfire", "stop", "", operator); !strings.Contains(resp.Text, "needs the admin role") {
+		t.Fatalf("operator stop = %+v", resp)
+	}
+	if len(audit) != 2 || audit[1].Verb != "stop" {
+		t.Fatalf("audit = %+v", audit)
+	}
+
+	// No mapping for the workspace: everyone only reads — approving a
+	// merge needs an explicit mapping.
+	f.integrations.Inbound.DiscordRoles = nil
+	if role := scopeRole(ctx, commandScope{GuildID: "g-1", UserID: "D-1"}, deps); role != models.ChatRoleViewer {
+		t.Fatalf("unmapped guild role = %q, want viewer", role)
+	}
+}
+
+func TestCreateTaskFromChat(t *testing.T) {
+	f := newCCFixture(t)
+	path := f.addProject("p-1", "Proj", func(p *models.Project) {
+		p.Integrations.SlackChannel = "#eng"
+	})
+	cc := newCommandContext(commandScope{TeamID: "T-1", UserID: "U-1"}, f.deps())
+
+	created, err := cc.CreateTask(context.Background(), "p-1", "Fix the flaky login test")
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	tk, err := config.LoadTask(path, created.TaskNumber)
+	if err != nil {
+		t.Fatalf("LoadTask: %v", err)
+	}
+	if tk.Status != models.TaskStatusReady || tk.Prompt != "Fix the flaky login test" || tk.CreatedBy != "slack:U-1" {
+		t.Fatalf("task = status %s prompt %q created_by %q", tk.Status, tk.Prompt, tk.CreatedBy)
+	}
+}
+
+func TestWatchIsPerWorkspace(t *testing.T) {
+	f := newCCFixture(t)
+	f.addProject("p-1", "Proj", func(p *models.Project) {
+		p.Integrations.DiscordGuildID = "g-1"
+	})
+	watch := newChatWatch(nil, nil)
+	deps := f.deps()
+	deps.SetWatch, deps.Watching = watch.Set, watch.Watching
+	ctx := context.Background()
+
+	cc := newCommandContext(commandScope{GuildID: "g-1", UserID: "D-1"}, deps)
+	if err := cc.Watch(ctx, "p-1", true); err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if !cc.Watching(ctx, "p-1") || !watch.Watching(chatWatchKey{Transport: "discord", Workspace: "g-1", ProjectID: "p-1"}) {
+		t.Fatal("watch not recorded for the guild")
+	}
+	other := newCommandContext(commandScope{GuildID: "g-2"}, deps)
+	if other.Watching(ctx, "p-1") {
+		t.Fatal("another guild sees the watch")
+	}
+	if err := other.Watch(ctx, "p-1", true); err == nil {
+		t.Fatal("an unmapped guild watched the project")
+	}
+	tg := newCommandContext(commandScope{Telegram: true}, deps)
+	if err := tg.Watch(ctx, "p-1", true); err == nil {
+		t.Fatal("Telegram should use its own /watch relay")
+	}
+}
+
+// A Slack watch is refused while several Slack endpoints are configured
+// and none is bound to the calling team — the snapshots could land in
+// another workspace.
+func TestSlackWatchNeedsATeamEndpoint(t *testing.T) {
+	f := newCCFixture(t)
+	f.addProject("p-1", "Proj", nil)
+	f.integrations.Inbound.SlackTeamID = "T-1"
+	f.integrations.Slack = []models.SlackEndpoint{{ID: "a"}, {ID: "b"}}
+	watch := newChatWatch(nil, nil)
+	deps := f.deps()
+	deps.SetWatch, deps.Watching = watch.Set, watch.Watching
+	ctx := context.Background()
+
+	cc := newCommandContext(commandScope{TeamID: "T-1", UserID: "U-1"}, deps)
+	if err := cc.Watch(ctx, "p-1", true); err == nil || !strings.Contains(err.Error(), "team_id") {
+		t.Fatalf("Watch with two unbound endpoints: want a team_id error, got %v", err)
+	}
+	f.integrations.Slack[1].TeamID = "T-1"
+	if err := cc.Watch(ctx, "p-1", true); err != nil {
+		t.Fatalf("Watch with a bound endpoint: %v", err)
+	}
+	if err := cc.Watch(ctx, "p-1", false); err != nil {
+		t.Fatalf("unwatch: %v", err)
+	}
+}
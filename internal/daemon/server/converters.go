@@ -127,6 +127,8 @@ func modelToProtoTask(t *models.Task, projectID string) *pb.Task {
 		AgentSessions:      int32(t.AgentSessions),
 		CreatedAt:          timestamppb.New(t.CreatedAt),
 		UpdatedAt:          timestamppb.New(t.UpdatedAt),
+		CreatedBy:          t.CreatedBy,
+		StartedBy:          t.StartedBy,
 	}
 
 	if t.Success != nil {
@@ -176,7 +178,62 @@ func modelToProtoSettings(s *models.Settings) *pb.Settings {
 		Appearance: &pb.AppearanceConfig{
 			Theme: s.Appearance.Theme,
 		},
+		Recordings: &pb.RecordingsConfig{
+			Enabled:       s.Recordings.Enabled,
+			MaxAgeDays:    int32(s.Recordings.MaxAgeDays),
+			MaxPerProject: int32(s.Recordings.MaxPerProject),
+		},
+		Retention: &pb.RetentionConfig{
+			Enabled:          s.Retention.Enabled,
+			MaxAgeDays:       int32(s.Retention.MaxAgeDays),
+			MaxLogs:          int32(s.Retention.MaxLogs),
+			MaxSizeMb:        int32(s.Retention.MaxSizeMB),
+			BranchMaxAgeDays: int32(s.Retention.BranchMaxAgeDays),
+		},
+		MetricsEndpoint: &pb.MetricsEndpointConfig{
+			Enabled: s.MetricsEndpoint.Enabled,
+			Listen:  s.MetricsEndpoint.Listen,
+		},
+		Tracing: &pb.TracingConfig{
+			Enabled:  s.Tracing.Enabled,
+			Exporter: s.Tracing.Exporter,
+			Endpoint: s.Tracing.Endpoint,
+			Headers:  s.Tracing.Headers,
+			File:     s.Tracing.File,
+		},
+		Pricing: pricingToProto(s.Pricing),
+		Budget:  budgetConfigToProto(s.Budget),
+	}
+}
+
+func budgetConfigToProto(b *models.BudgetConfig) *pb.BudgetConfig {
+	out := &pb.BudgetConfig{}
+	if b == nil {
+		return out
+	}
+	out.MonthlyUsd = b.MonthlyUSD
+	out.HardStop = b.HardStop
+	for _, t := range b.Thresholds {
+		out.Thresholds = append(out.Thresholds, int32(t))
 	}
+	return out
+}
+
+func pricingToProto(p *models.PricingConfig) *pb.PricingConfig {
+	out := &pb.PricingConfig{}
+	if p == nil {
+		return out
+	}
+	for _, r := range p.Prices {
+		out.Prices = append(out.Prices, &pb.TokenPrice{
+			Backend:       r.Backend,
+			Model:         r.Model,
+			InputPerMtok:  r.InputPerMTok,
+			OutputPerMtok: r.OutputPerMTok,
+			CachedPerMtok: r.CachedPerMTok,
+		})
+	}
+	return out
 }
 
 func notificationsToProto(n models.NotificationsConfig) *pb.NotificationsConfig {
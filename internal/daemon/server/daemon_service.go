@@ -10,6 +10,7 @@ import (
 
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/focus"
+	"github.com/watchfire-io/watchfire/internal/daemon/janitor"
 	"github.com/watchfire-io/watchfire/internal/daemon/tray"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
@@ -46,6 +47,34 @@ func (s *daemonService) GetStatus(_ context.Context, _ *emptypb.Empty) (*pb.Daem
 	}, nil
 }
 
+// RunGC runs one retention pass on demand (`watchfire gc`). Unlike the
+// scheduled janitor it ignores retention.enabled — asking is consent.
+func (s *daemonService) RunGC(_ context.Context, req *pb.RunGCRequest) (*pb.GCReport, error) {
+	report, err := janitor.Run(janitor.Options{
+		DryRun:    req.DryRun,
+		ProjectID: req.ProjectId,
+		Busy:      s.server.taskHasAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.GCReport{
+		DryRun:     report.DryRun,
+		TotalBytes: report.TotalBytes(),
+		Errors:     report.Errors,
+	}
+	for _, it := range report.Items {
+		out.Items = append(out.Items, &pb.GCItem{
+			Kind:      it.Kind,
+			ProjectId: it.ProjectID,
+			Path:      it.Path,
+			Bytes:     it.Bytes,
+			Reason:    it.Reason,
+		})
+	}
+	return out, nil
+}
+
 func (s *daemonService) Ping(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
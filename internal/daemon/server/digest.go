@@ -3,9 +3,11 @@ package server
 import (
 	"fmt"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -263,6 +265,11 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 		LinesAdded   int
 		LinesRemoved int
 		Merges       int
+
+		// This week and the week before, for the comparison and the
+		// regression flags.
+		Current  insights.PeriodTotals
+		Previous insights.PeriodTotals
 	}
 
 	var projects []projectStats
@@ -271,6 +278,11 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 	totalCreated := 0
 	totalInFlight := 0
 	var codeCommits, codeLinesAdded, codeLinesRemoved, codeMerges int
+	// Task spend, for the overhead's share of it, and the sessions that
+	// ran outside a task.
+	var taskCostUSD float64
+	var sessions []*models.SessionMetrics
+	prevStart := windowStart.Add(-windowEnd.Sub(windowStart))
 
 	if index != nil {
 		for _, entry := range index.Projects {
@@ -279,6 +291,9 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 				continue
 			}
 			ps := projectStats{Name: entry.Name}
+			if sm, serr := config.ListSessionMetrics(entry.ProjectID); serr == nil {
+				sessions = append(sessions, sm...)
+			}
 			for _, t := range tasks {
 				if t == nil || t.HiddenFromInsights() {
 					continue
@@ -296,8 +311,13 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 					}
 				}
 				completedAt := insights.EffectiveCompletedAt(t, m)
+				if t.Status == models.TaskStatusDone && completedAt != nil &&
+					completedAt.After(prevStart) && !completedAt.After(windowStart) {
+					ps.Previous.AddTask(t, m)
+				}
 				if t.Status == models.TaskStatusDone && completedAt != nil &&
 					completedAt.After(windowStart) && !completedAt.After(windowEnd) {
+					ps.Current.AddTask(t, m)
 					if t.Success != nil && *t.Success {
 						ps.Done++
 					} else {
@@ -314,6 +334,9 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 						if m.Merged {
 							ps.Merges++
 						}
+						if m.CostUSD != nil {
+							taskCostUSD += *m.CostUSD
+						}
 					}
 				}
 				if t.Status == models.TaskStatusReady || t.Status == models.TaskStatusDraft {
@@ -336,6 +359,20 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 		return strings.ToLower(projects[i].Name) < strings.ToLower(projects[j].Name)
 	})
 
+	var current, previous insights.PeriodTotals
+	type regression struct {
+		name    string
+		reasons []string
+	}
+	var regressions []regression
+	for _, p := range projects {
+		current = current.Add(p.Current)
+		previous = previous.Add(p.Previous)
+		if reasons := insights.Regressions(p.Current, p.Previous); len(reasons) > 0 {
+			regressions = append(regressions, regression{name: p.Name, reasons: reasons})
+		}
+	}
+
 	var b strings.Builder
 	fmt.Fprintf(&b, "# Watchfire — your week\n\n")
 	fmt.Fprintf(&b, "_Window: %s → %s_\n\n",
@@ -349,6 +386,26 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 	fmt.Fprintf(&b, "- **%d** task%s currently in flight\n", totalInFlight, plural(totalInFlight))
 	fmt.Fprintf(&b, "- Across **%d** project%s\n\n", len(projects), plural(len(projects)))
 
+	// Week over week — the same figures for the seven days before the
+	// window, and the projects that got worse.
+	if current.Tasks > 0 || previous.Tasks > 0 {
+		cmp := insights.Compare(current, previous, prevStart, windowStart)
+		fmt.Fprintf(&b, "## Compared with last week\n\n")
+		fmt.Fprintf(&b, "- Tasks completed: **%d** (%s)\n", current.Tasks, digestCountChange(cmp.Tasks))
+		if current.Tasks > 0 && previous.Tasks > 0 {
+			fmt.Fprintf(&b, "- Success rate: **%.0f%%** (%s)\n", current.SuccessRate()*100, digestRateChange(cmp.SuccessRate))
+		}
+		fmt.Fprintf(&b, "- Spend: **$%.2f** (%s)\n", current.CostUSD, digestPctChange(cmp.CostUSD, fmt.Sprintf("$%.2f", math.Abs(cmp.CostUSD.Change))))
+		fmt.Fprintf(&b, "- Net lines: **%s** (%s)\n\n", signedInt(current.NetLines), digestPctChange(cmp.NetLines, strconv.Itoa(int(math.Abs(cmp.NetLines.Change)))))
+	}
+	if len(regressions) > 0 {
+		fmt.Fprintf(&b, "## Regressions\n\n")
+		for _, r := range regressions {
+			fmt.Fprintf(&b, "- **%s**: %s\n", r.name, strings.Join(r.reasons, "; "))
+		}
+		b.WriteString("\n")
+	}
+
 	// Code output — what the agents actually shipped this week. Rank
 	// projects by net churn (added − removed) for the "top by churn" line.
 	netLines := codeLinesAdded - codeLinesRemoved
@@ -387,6 +444,21 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 	}
 	b.WriteString("\n")
 
+	// Overhead — agent time and spend outside tasks: chat, wildfire
+	// refine / generate, definition and task generation.
+	overhead := insights.ComputeOverhead(sessions, windowStart, windowEnd, taskCostUSD)
+	if overhead.Sessions > 0 {
+		fmt.Fprintf(&b, "## Overhead\n\n")
+		fmt.Fprintf(&b, "- **$%.2f** across **%d** session%s outside tasks (%.0f%% of all spend) · %s of agent time\n",
+			overhead.CostUSD, overhead.Sessions, plural(overhead.Sessions),
+			overhead.CostShare*100, digestDuration(overhead.DurationMs))
+		kinds := make([]string, 0, len(overhead.ByKind))
+		for _, k := range overhead.ByKind {
+			kinds = append(kinds, fmt.Sprintf("%s $%.2f (%d)", k.Kind, k.CostUSD, k.Sessions))
+		}
+		fmt.Fprintf(&b, "- By kind: %s\n\n", strings.Join(kinds, " · "))
+	}
+
 	if len(projects) > 0 {
 		fmt.Fprintf(&b, "## By project\n\n")
 		for _, p := range projects {
@@ -407,10 +479,64 @@ func renderDigestMarkdown(windowStart, windowEnd time.Time) (body, summary strin
 
 	summary = fmt.Sprintf("%d done · %d failed · %d new across %d project%s",
 		totalDone, totalFailed, totalCreated, len(projects), plural(len(projects)))
+	if len(regressions) > 0 {
+		summary += fmt.Sprintf(" · %d regression%s", len(regressions), plural(len(regressions)))
+	}
 
 	return b.String(), summary
 }
 
+// digestCountChange renders a count's change: "+3 vs last week".
+func digestCountChange(d insights.MetricDelta) string {
+	if d.Change == 0 {
+		return "same as last week"
+	}
+	return signedInt(int(d.Change)) + " vs last week"
+}
+
+// digestRateChange renders a rate's change in points: "down 12 pts vs
+// last week".
+func digestRateChange(d insights.MetricDelta) string {
+	pts := int(math.Round(d.Change * 100))
+	switch {
+	case pts > 0:
+		return fmt.Sprintf("up %d pts vs last week", pts)
+	case pts < 0:
+		return fmt.Sprintf("down %d pts vs last week", -pts)
+	default:
+		return "unchanged vs last week"
+	}
+}
+
+// digestPctChange renders a change as a percentage of last week's
+// figure, or as the absolute amount when last week's was zero.
+func digestPctChange(d insights.MetricDelta, amount string) string {
+	switch {
+	case d.Change == 0:
+		return "same as last week"
+	case d.Previous == 0:
+		sign := "+"
+		if d.Change < 0 {
+			sign = "−"
+		}
+		return sign + amount + " vs last week"
+	}
+	pct := int(math.Round(d.ChangePct * 100))
+	if pct >= 0 {
+		return fmt.Sprintf("up %d%% vs last week", pct)
+	}
+	return fmt.Sprintf("down %d%% vs last week", -pct)
+}
+
+// digestDuration renders milliseconds as "1h 30m" / "45m".
+func digestDuration(ms int64) string {
+	minutes := ms / 60_000
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
+
 func plural(n int) string {
 	if n == 1 {
 		return ""
@@ -394,6 +394,114 @@ func TestRenderDigestMarkdownCodeOutput(t *testing.T) {
 	}
 }
 
+// TestRenderDigestMarkdownOverhead verifies the overhead block: sessions
+// outside a task that ended in the window are summed from their
+// <logID>.metrics.yaml records, with their share of all spend.
+func TestRenderDigestMarkdownOverhead(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+
+	windowEnd := time.Now()
+	windowStart := windowEnd.AddDate(0, 0, -7)
+	completed := windowStart.AddDate(0, 0, 1)
+	bp := func(b bool) *bool { return &b }
+	fp := func(f float64) *float64 { return &f }
+
+	path := filepath.Join(tmp, "projects", "p1")
+	task := &models.Task{
+		TaskNumber: 1, Title: "task", Status: models.TaskStatusDone,
+		Success: bp(true), CreatedAt: completed, StartedAt: &completed, CompletedAt: &completed,
+	}
+	if err := config.SaveTask(path, task); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WriteMetrics(path, &models.TaskMetrics{TaskNumber: 1, CostUSD: fp(3)}); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []*models.SessionMetrics{
+		{LogID: "chat-0-a", ProjectID: "p1", Mode: "chat", EndedAt: completed, DurationMs: 1_800_000, CostUSD: fp(0.75)},
+		{LogID: "wildfire-0-b", ProjectID: "p1", Mode: "wildfire", Phase: "refine", EndedAt: completed, DurationMs: 2_700_000, CostUSD: fp(0.25)},
+		{LogID: "chat-0-c", ProjectID: "p1", Mode: "chat", EndedAt: windowStart.AddDate(0, 0, -1), CostUSD: fp(50)},
+	} {
+		if err := config.WriteSessionMetrics(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	index := models.NewProjectsIndex()
+	index.AddProject(models.ProjectEntry{ProjectID: "p1", Name: "one", Path: path})
+	if err := config.SaveProjectsIndex(index); err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := renderDigestMarkdown(windowStart, windowEnd)
+	for _, want := range []string{
+		"## Overhead",
+		"**$1.00** across **2** sessions outside tasks (25% of all spend) · 1h 15m of agent time",
+		"By kind: chat $0.75 (1) · wildfire-refine $0.25 (1)",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("digest body missing %q\n--- body ---\n%s", want, body)
+		}
+	}
+}
+
+func TestRenderDigestMarkdownWeekOverWeek(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+
+	windowEnd := time.Now()
+	windowStart := windowEnd.AddDate(0, 0, -7)
+	thisWeek := windowStart.AddDate(0, 0, 1)
+	lastWeek := windowStart.AddDate(0, 0, -3)
+	bp := func(b bool) *bool { return &b }
+	fp := func(f float64) *float64 { return &f }
+
+	// Last week: 4 of 4 succeeded at $1 each. This week: 2 of 4 at $2.
+	path := filepath.Join(tmp, "projects", "p1")
+	for i, c := range []struct {
+		at      time.Time
+		success bool
+		cost    float64
+	}{
+		{lastWeek, true, 1}, {lastWeek, true, 1}, {lastWeek, true, 1}, {lastWeek, true, 1},
+		{thisWeek, true, 2}, {thisWeek, true, 2}, {thisWeek, false, 2}, {thisWeek, false, 2},
+	} {
+		at := c.at
+		task := &models.Task{
+			TaskNumber: i + 1, Title: "task", Status: models.TaskStatusDone,
+			Success: bp(c.success), CreatedAt: at, StartedAt: &at, CompletedAt: &at,
+		}
+		if err := config.SaveTask(path, task); err != nil {
+			t.Fatal(err)
+		}
+		if err := config.WriteMetrics(path, &models.TaskMetrics{TaskNumber: i + 1, CostUSD: fp(c.cost)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	index := models.NewProjectsIndex()
+	index.AddProject(models.ProjectEntry{ProjectID: "p1", Name: "one", Path: path})
+	if err := config.SaveProjectsIndex(index); err != nil {
+		t.Fatal(err)
+	}
+
+	body, summary := renderDigestMarkdown(windowStart, windowEnd)
+	for _, want := range []string{
+		"## Compared with last week",
+		"Tasks completed: **4** (same as last week)",
+		"Success rate: **50%** (down 50 pts vs last week)",
+		"Spend: **$8.00** (up 100% vs last week)",
+		"## Regressions",
+		"**one**: success rate down 50 pts (100% → 50%); cost per task up 100% ($1.00 → $2.00)",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("digest body missing %q\n--- body ---\n%s", want, body)
+		}
+	}
+	if !strings.HasSuffix(summary, "· 1 regression") {
+		t.Errorf("summary = %q, want the regression count", summary)
+	}
+}
+
 // === test helpers ===
 
 // newDigestRunnerForTest returns a runner with a deterministic clock + sleep
@@ -196,9 +196,15 @@ func TestSlackSlashCommandEndToEnd(t *testing.T) {
 		t.Fatalf("SaveTask: %v", err)
 	}
 
-	base := startInboundEcho(t, minimalServer(), models.InboundConfig{
+	// Retry takes the operator role, which an unmapped workspace lacks.
+	in := models.InboundConfig{
 		SlackSecretRef: "test.slack.signing",
-	})
+		SlackRoles:     map[string]models.ChatRoleMap{"T-1": {Default: models.ChatRoleOperator}},
+	}
+	if err := config.SaveIntegrations(&models.IntegrationsConfig{Inbound: in}); err != nil {
+		t.Fatalf("SaveIntegrations: %v", err)
+	}
+	base := startInboundEcho(t, minimalServer(), in)
 
 	post := func(text, triggerID string) string {
 		t.Helper()
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/budget"
 	"github.com/watchfire-io/watchfire/internal/daemon/diff"
 	"github.com/watchfire-io/watchfire/internal/daemon/insights"
 	pb "github.com/watchfire-io/watchfire/proto"
@@ -43,7 +44,37 @@ func (s *insightsService) GetGlobalInsights(_ context.Context, req *pb.GetGlobal
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return globalInsightsToProto(data), nil
+	out := globalInsightsToProto(data)
+	if cs, ce, ok := comparisonWindow(windowStart, windowEnd, req.GetCompareWindowStart(), req.GetCompareWindowEnd()); ok {
+		if prev, perr := insights.LoadGlobalInsights(cs, ce); perr == nil {
+			out.Comparison = comparisonToProto(insights.Compare(data.Totals(), prev.Totals(), cs, ce))
+		}
+	}
+	// Budgets are always the current month, whatever the window; they're
+	// computed fresh because the insights cache is keyed on the window.
+	if budgets, berr := budget.Compute(s.now()); berr == nil {
+		out.Budgets = budgetStatusesToProto(budgets)
+	}
+	return out, nil
+}
+
+func budgetStatusesToProto(in []budget.Status) []*pb.BudgetStatus {
+	out := make([]*pb.BudgetStatus, 0, len(in))
+	for _, b := range in {
+		out = append(out, &pb.BudgetStatus{
+			Scope:       b.Scope,
+			ProjectId:   b.ProjectID,
+			ProjectName: b.ProjectName,
+			Month:       b.Month,
+			LimitUsd:    b.LimitUSD,
+			SpentUsd:    b.SpentUSD,
+			Threshold:   int32(b.Threshold),
+			HardStop:    b.HardStop,
+			Exceeded:    b.Exceeded(),
+			Blocking:    b.Blocking(),
+		})
+	}
+	return out
 }
 
 func (s *insightsService) GetProjectInsights(_ context.Context, req *pb.GetProjectInsightsRequest) (*pb.ProjectInsights, error) {
@@ -60,7 +91,25 @@ func (s *insightsService) GetProjectInsights(_ context.Context, req *pb.GetProje
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return projectInsightsToProto(data), nil
+	out := projectInsightsToProto(data)
+	if cs, ce, ok := comparisonWindow(windowStart, windowEnd, req.GetCompareWindowStart(), req.GetCompareWindowEnd()); ok {
+		if prev, perr := insights.LoadProjectInsights(req.GetProjectId(), cs, ce); perr == nil {
+			out.Comparison = comparisonToProto(insights.Compare(data.Totals(), prev.Totals(), cs, ce))
+		}
+	}
+	return out, nil
+}
+
+// comparisonWindow resolves the window to compare against: the request's
+// compare_window_* when either is set, else the period of the same length
+// just before a window bounded at both ends. ok is false when there's
+// nothing to compare with.
+func comparisonWindow(windowStart, windowEnd time.Time, compareStart, compareEnd *timestamppb.Timestamp) (start, end time.Time, ok bool) {
+	start, end = tsToTime(compareStart), tsToTime(compareEnd)
+	if !start.IsZero() || !end.IsZero() {
+		return start, end, true
+	}
+	return insights.PreviousWindow(windowStart, windowEnd)
 }
 
 func (s *insightsService) ExportReport(_ context.Context, req *pb.ExportReportRequest) (*pb.ExportReportResponse, error) {
@@ -148,6 +197,20 @@ func (s *insightsService) GetTaskDiff(_ context.Context, req *pb.GetTaskDiffRequ
 	return diffSetToProto(out), nil
 }
 
+func (s *insightsService) GetHotspots(_ context.Context, req *pb.GetHotspotsRequest) (*pb.Hotspots, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetHotspotsRequest required")
+	}
+	if req.GetProjectId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id required")
+	}
+	h, err := insights.LoadHotspots(req.GetProjectId(), tsToTime(req.GetWindowStart()), tsToTime(req.GetWindowEnd()), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return hotspotsToProto(h), nil
+}
+
 func (s *insightsService) now() time.Time {
 	if s.nowFn != nil {
 		return s.nowFn()
@@ -161,6 +224,10 @@ func protoFormatToInsights(f pb.ExportFormat) (insights.Format, error) {
 		return insights.FormatCSV, nil
 	case pb.ExportFormat_MARKDOWN:
 		return insights.FormatMarkdown, nil
+	case pb.ExportFormat_JSON:
+		return insights.FormatJSON, nil
+	case pb.ExportFormat_HTML:
+		return insights.FormatHTML, nil
 	default:
 		return 0, fmt.Errorf("unknown ExportFormat: %v", f)
 	}
@@ -213,6 +280,9 @@ func globalInsightsToProto(g *insights.GlobalInsights) *pb.GlobalInsights {
 		TasksMerged:        int32(g.TasksMerged),
 		TasksViaPr:         int32(g.TasksViaPR),
 		MetricsMissingCode: int32(g.MetricsMissingCode),
+
+		EstimatedCostUsd:   g.EstimatedCostUSD,
+		TasksEstimatedCost: int32(g.TasksEstimatedCost),
 	}
 	if !g.WindowStart.IsZero() {
 		out.WindowStart = timestamppb.New(g.WindowStart)
@@ -261,6 +331,10 @@ func globalInsightsToProto(g *insights.GlobalInsights) *pb.GlobalInsights {
 			LinesRemoved:   int32(a.LinesRemoved),
 		})
 	}
+	out.AgentComparison = agentComparisonToProto(g.AgentComparison)
+	out.Overhead = overheadToProto(g.Overhead)
+	out.Trend = trendToProto(g.Trend)
+	out.Users = usersToProto(g.Users)
 	return out
 }
 
@@ -291,6 +365,9 @@ func projectInsightsToProto(p *insights.ProjectInsights) *pb.ProjectInsights {
 		TasksMerged:        int32(p.TasksMerged),
 		TasksViaPr:         int32(p.TasksViaPR),
 		MetricsMissingCode: int32(p.MetricsMissingCode),
+
+		EstimatedCostUsd:   p.EstimatedCostUSD,
+		TasksEstimatedCost: int32(p.TasksEstimatedCost),
 	}
 	if !p.WindowStart.IsZero() {
 		out.WindowStart = timestamppb.New(p.WindowStart)
@@ -324,6 +401,169 @@ func projectInsightsToProto(p *insights.ProjectInsights) *pb.ProjectInsights {
 			LinesRemoved:   int32(a.LinesRemoved),
 		})
 	}
+	out.AgentComparison = agentComparisonToProto(p.AgentComparison)
+	out.Overhead = overheadToProto(p.Overhead)
+	out.Trend = trendToProto(p.Trend)
+	out.Users = usersToProto(p.Users)
+	return out
+}
+
+func agentComparisonToProto(rows []insights.AgentComparisonRow) []*pb.AgentComparison {
+	out := make([]*pb.AgentComparison, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, &pb.AgentComparison{
+			Agent:             r.Agent,
+			Model:             r.Model,
+			Tasks:             int32(r.Tasks),
+			Succeeded:         int32(r.Succeeded),
+			SuccessRate:       r.SuccessRate,
+			MedianDurationMs:  r.MedianDurationMs,
+			P90DurationMs:     r.P90DurationMs,
+			TotalCostUsd:      r.TotalCostUSD,
+			CostPerSuccessUsd: r.CostPerSuccessUSD,
+			MergeFailures:     int32(r.MergeFailures),
+			MergeFailureRate:  r.MergeFailureRate,
+			FollowUps:         int32(r.FollowUps),
+			FollowUpRate:      r.FollowUpRate,
+			Reverted:          int32(r.Reverted),
+			RevertRate:        r.RevertRate,
+			LinesAdded:        int32(r.LinesAdded),
+			LinesRemoved:      int32(r.LinesRemoved),
+		})
+	}
+	return out
+}
+
+func comparisonToProto(c *insights.InsightsComparison) *pb.InsightsComparison {
+	delta := func(d insights.MetricDelta) *pb.MetricDelta {
+		return &pb.MetricDelta{Current: d.Current, Previous: d.Previous, Change: d.Change, ChangePct: d.ChangePct}
+	}
+	out := &pb.InsightsComparison{
+		Tasks:       delta(c.Tasks),
+		SuccessRate: delta(c.SuccessRate),
+		CostUsd:     delta(c.CostUSD),
+		NetLines:    delta(c.NetLines),
+		Regressions: c.Regressions,
+	}
+	if !c.WindowStart.IsZero() {
+		out.WindowStart = timestamppb.New(c.WindowStart)
+	}
+	if !c.WindowEnd.IsZero() {
+		out.WindowEnd = timestamppb.New(c.WindowEnd)
+	}
+	return out
+}
+
+func trendToProto(weeks []insights.TrendWeek) []*pb.TrendWeek {
+	out := make([]*pb.TrendWeek, 0, len(weeks))
+	for _, w := range weeks {
+		out = append(out, &pb.TrendWeek{
+			WeekStart:   w.WeekStart,
+			Tasks:       int32(w.Tasks),
+			Succeeded:   int32(w.Succeeded),
+			SuccessRate: w.SuccessRate,
+			CostUsd:     w.CostUSD,
+			NetLines:    int32(w.NetLines),
+		})
+	}
+	return out
+}
+
+func usersToProto(rows []insights.UserRow) []*pb.UserUsage {
+	out := make([]*pb.UserUsage, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, &pb.UserUsage{
+			User:           r.User,
+			Tasks:          int32(r.Tasks),
+			Succeeded:      int32(r.Succeeded),
+			SuccessRate:    r.SuccessRate,
+			DurationMs:     r.DurationMs,
+			TaskCostUsd:    r.TaskCostUSD,
+			NetLines:       int32(r.NetLines),
+			TasksCreated:   int32(r.TasksCreated),
+			Sessions:       int32(r.Sessions),
+			SessionCostUsd: r.SessionCostUSD,
+			TotalCostUsd:   r.TotalCostUSD,
+		})
+	}
+	return out
+}
+
+func hotspotsToProto(h *insights.Hotspots) *pb.Hotspots {
+	out := &pb.Hotspots{
+		ProjectId:        h.ProjectID,
+		TasksScanned:     int32(h.TasksScanned),
+		TasksWithoutDiff: int32(h.TasksWithoutDiff),
+		Files:            hotspotFilesToProto(h.Files),
+		FailureFiles:     hotspotFilesToProto(h.FailureFiles),
+		Directories:      make([]*pb.HotspotDirectory, 0, len(h.Directories)),
+		Weeks:            h.Weeks,
+	}
+	if !h.WindowStart.IsZero() {
+		out.WindowStart = timestamppb.New(h.WindowStart)
+	}
+	if !h.WindowEnd.IsZero() {
+		out.WindowEnd = timestamppb.New(h.WindowEnd)
+	}
+	for _, d := range h.Directories {
+		out.Directories = append(out.Directories, &pb.HotspotDirectory{
+			Path:          d.Path,
+			Tasks:         int32(d.Tasks),
+			Files:         int32(d.Files),
+			FailedTasks:   int32(d.FailedTasks),
+			MergeFailures: int32(d.MergeFailures),
+			LinesAdded:    int32(d.LinesAdded),
+			LinesRemoved:  int32(d.LinesRemoved),
+		})
+	}
+	return out
+}
+
+func hotspotFilesToProto(files []insights.HotspotFile) []*pb.HotspotFile {
+	out := make([]*pb.HotspotFile, 0, len(files))
+	for _, f := range files {
+		churn := make([]int32, 0, len(f.WeeklyChurn))
+		for _, c := range f.WeeklyChurn {
+			churn = append(churn, int32(c))
+		}
+		row := &pb.HotspotFile{
+			Path:          f.Path,
+			Tasks:         int32(f.Tasks),
+			FailedTasks:   int32(f.FailedTasks),
+			MergeFailures: int32(f.MergeFailures),
+			LinesAdded:    int32(f.LinesAdded),
+			LinesRemoved:  int32(f.LinesRemoved),
+			WeeklyChurn:   churn,
+		}
+		if !f.LastTouched.IsZero() {
+			row.LastTouched = timestamppb.New(f.LastTouched)
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+func overheadToProto(o insights.OverheadSummary) *pb.InsightsOverhead {
+	out := &pb.InsightsOverhead{
+		Sessions:            int32(o.Sessions),
+		DurationMs:          o.DurationMs,
+		TokensIn:            o.TokensIn,
+		TokensOut:           o.TokensOut,
+		CostUsd:             o.CostUSD,
+		SessionsMissingCost: int32(o.SessionsMissingCost),
+		CostShare:           o.CostShare,
+		ByKind:              make([]*pb.OverheadKind, 0, len(o.ByKind)),
+	}
+	for _, r := range o.ByKind {
+		out.ByKind = append(out.ByKind, &pb.OverheadKind{
+			Kind:       r.Kind,
+			Sessions:   int32(r.Sessions),
+			DurationMs: r.DurationMs,
+			TokensIn:   r.TokensIn,
+			TokensOut:  r.TokensOut,
+			CostUsd:    r.CostUSD,
+		})
+	}
 	return out
 }
 
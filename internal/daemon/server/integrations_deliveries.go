@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// Durable relay outbox RPCs. The dispatcher owns the outbox; these
+// handlers only surface its failed / dead-lettered records and re-queue
+// one on request.
+
+// ListFailedDeliveries returns the dead-lettered deliveries, plus the
+// ones still retrying from disk when include_pending is set.
+func (s *integrationsService) ListFailedDeliveries(_ context.Context, req *pb.ListFailedDeliveriesRequest) (*pb.ListFailedDeliveriesResponse, error) {
+	d, err := s.relayDispatcher()
+	if err != nil {
+		return nil, err
+	}
+	records, err := d.FailedDeliveries(req.GetIncludePending())
+	if err != nil {
+		return nil, deliveryError(err)
+	}
+	out := &pb.ListFailedDeliveriesResponse{}
+	for _, dl := range records {
+		out.Deliveries = append(out.Deliveries, deliveryToProto(dl))
+	}
+	return out, nil
+}
+
+// ReplayDelivery re-queues one delivery for an immediate attempt with a
+// fresh retry budget. The send itself happens on the dispatcher
+// goroutine, so the response reports the re-queued record rather than
+// the delivery outcome.
+func (s *integrationsService) ReplayDelivery(_ context.Context, req *pb.ReplayDeliveryRequest) (*pb.RelayDelivery, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery id is required")
+	}
+	d, err := s.relayDispatcher()
+	if err != nil {
+		return nil, err
+	}
+	dl, err := d.ReplayDelivery(req.GetId())
+	if err != nil {
+		return nil, deliveryError(err)
+	}
+	return deliveryToProto(dl), nil
+}
+
+func (s *integrationsService) relayDispatcher() (*relay.Dispatcher, error) {
+	if s.server == nil || s.server.RelayDispatcher() == nil {
+		return nil, status.Error(codes.Unavailable, "relay dispatcher is not running")
+	}
+	return s.server.RelayDispatcher(), nil
+}
+
+func deliveryError(err error) error {
+	switch {
+	case errors.Is(err, relay.ErrDeliveryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, relay.ErrOutboxDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+func deliveryToProto(dl *relay.Delivery) *pb.RelayDelivery {
+	out := &pb.RelayDelivery{
+		Id:          dl.ID,
+		AdapterId:   dl.AdapterID,
+		AdapterKind: dl.AdapterKind,
+		Event:       dl.Payload.Kind,
+		ProjectId:   dl.Payload.ProjectID,
+		ProjectName: dl.Payload.ProjectName,
+		TaskNumber:  int32(dl.Payload.TaskNumber),
+		Attempts:    int32(dl.Attempts),
+		LastError:   dl.LastError,
+		CreatedAt:   timestamppb.New(dl.CreatedAt),
+		Dead:        dl.Dead(),
+	}
+	if dl.Dead() {
+		out.DeadAt = timestamppb.New(dl.DeadAt)
+	} else {
+		out.NextAttemptAt = timestamppb.New(dl.NextAttemptAt)
+	}
+	return out
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// nopRelayAdapter is the adapter the outbox records below are bound to;
+// the RPCs never send, so Send is never reached.
+type nopRelayAdapter struct{}
+
+func (nopRelayAdapter) ID() string                                    { return "hook-1" }
+func (nopRelayAdapter) Kind() string                                  { return "webhook" }
+func (nopRelayAdapter) Supports(notify.Kind) bool                     { return true }
+func (nopRelayAdapter) Send(_ context.Context, _ relay.Payload) error { return nil }
+
+// TestListAndReplayFailedDeliveries drives both outbox RPCs against a
+// dispatcher with one dead-lettered and one still-retrying delivery.
+func TestListAndReplayFailedDeliveries(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	outbox := relay.NewOutbox(t.TempDir())
+	payload := relay.Payload{
+		Version: 1, Kind: string(notify.KindTaskFailed), ProjectID: "p1",
+		ProjectName: "Watchfire", TaskNumber: 7, EmittedAt: now,
+	}
+	dead, err := outbox.Enqueue(nopRelayAdapter{}, payload, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < relay.OutboxMaxAttempts; i++ {
+		if _, err := outbox.Fail(dead, errors.New("HTTP 500"), now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	retrying, err := outbox.Enqueue(nopRelayAdapter{}, payload, now.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := outbox.Fail(retrying, errors.New("timeout"), now); err != nil {
+		t.Fatal(err)
+	}
+
+	d := relay.NewDispatcher(nil,
+		func(notify.Notification) (relay.Payload, error) { return relay.Payload{}, nil },
+		func() ([]relay.Adapter, error) { return []relay.Adapter{nopRelayAdapter{}}, nil },
+		relay.WithClock(func() time.Time { return now }),
+		relay.WithOutbox(outbox),
+	)
+	svc := newIntegrationsService()
+	svc.bindEchoServer(&fakeInboundProvider{dispatcher: d})
+	ctx := context.Background()
+
+	resp, err := svc.ListFailedDeliveries(ctx, &pb.ListFailedDeliveriesRequest{})
+	if err != nil {
+		t.Fatalf("ListFailedDeliveries: %v", err)
+	}
+	if len(resp.GetDeliveries()) != 1 {
+		t.Fatalf("want only the dead letter, got %+v", resp.GetDeliveries())
+	}
+	got := resp.GetDeliveries()[0]
+	if got.GetId() != dead.ID || !got.GetDead() || got.GetEvent() != "TASK_FAILED" ||
+		got.GetAdapterKind() != "webhook" || got.GetTaskNumber() != 7 ||
+		got.GetLastError() != "HTTP 500" || got.GetDeadAt() == nil || got.GetNextAttemptAt() != nil {
+		t.Fatalf("unexpected dead letter: %+v", got)
+	}
+
+	resp, err = svc.ListFailedDeliveries(ctx, &pb.ListFailedDeliveriesRequest{IncludePending: true})
+	if err != nil || len(resp.GetDeliveries()) != 2 {
+		t.Fatalf("include_pending: got %d deliveries, err %v", len(resp.GetDeliveries()), err)
+	}
+
+	replayed, err := svc.ReplayDelivery(ctx, &pb.ReplayDeliveryRequest{Id: dead.ID})
+	if err != nil {
+		t.Fatalf("ReplayDelivery: %v", err)
+	}
+	if replayed.GetDead() || replayed.GetAttempts() != 0 {
+		t.Fatalf("replayed record should be pending with a fresh budget: %+v", replayed)
+	}
+	resp, _ = svc.ListFailedDeliveries(ctx, &pb.ListFailedDeliveriesRequest{})
+	if len(resp.GetDeliveries()) != 0 {
+		t.Fatalf("replayed delivery still dead-lettered")
+	}
+
+	_, err = svc.ReplayDelivery(ctx, &pb.ReplayDeliveryRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("replay unknown id: want NotFound, got %v", err)
+	}
+	_, err = svc.ReplayDelivery(ctx, &pb.ReplayDeliveryRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("replay without id: want InvalidArgument, got %v", err)
+	}
+}
+
+func TestFailedDeliveriesWithoutDispatcher(t *testing.T) {
+	svc := newIntegrationsService()
+	svc.bindEchoServer(&fakeInboundProvider{})
+	_, err := svc.ListFailedDeliveries(context.Background(), &pb.ListFailedDeliveriesRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("want Unavailable, got %v", err)
+	}
+}
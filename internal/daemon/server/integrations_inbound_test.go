@@ -7,6 +7,7 @@ import (
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/discord"
 	"github.com/watchfire-io/watchfire/internal/daemon/echo"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
 	"github.com/watchfire-io/watchfire/internal/daemon/telegram"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
@@ -21,6 +22,7 @@ type fakeInboundProvider struct {
 	bridge              *telegram.Bridge
 	pairing             *telegram.Pairing
 	telegramRestartHits int
+	dispatcher          *relay.Dispatcher
 }
 
 func (f *fakeInboundProvider) EchoServer() *echo.Server             { return f.srv }
@@ -29,6 +31,7 @@ func (f *fakeInboundProvider) restartEchoServer()                   { f.restartH
 func (f *fakeInboundProvider) TelegramBridge() *telegram.Bridge     { return f.bridge }
 func (f *fakeInboundProvider) TelegramPairing() *telegram.Pairing   { return f.pairing }
 func (f *fakeInboundProvider) restartTelegramBridge()               { f.telegramRestartHits++ }
+func (f *fakeInboundProvider) RelayDispatcher() *relay.Dispatcher   { return f.dispatcher }
 
 // TestSaveInboundConfigRoundTrip: SaveInboundConfig persists the listen
 // address + public URL + per-provider secrets, scrubs plaintext secrets
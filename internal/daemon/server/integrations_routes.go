@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// TestRoute dry-runs a notification through the routing rules. The
+// rules are compiled fresh from integrations.yaml — so an edit can be
+// checked before (or without) the dispatcher reloading it — and planned
+// against the dispatcher's live adapters. Nothing is sent.
+func (s *integrationsService) TestRoute(_ context.Context, req *pb.TestRouteRequest) (*pb.TestRouteResponse, error) {
+	kind, ok := notify.KindForEventKey(strings.ToLower(strings.TrimSpace(req.GetEvent())))
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown event %q (want one of: %s)", req.GetEvent(), templateEventKeys())
+	}
+	d, err := s.relayDispatcher()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.LoadIntegrations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load integrations: %v", err)
+	}
+	router, err := relay.CompileRoutes(cfg.Routes)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid routes in integrations.yaml: %v", err)
+	}
+
+	n := notify.Notification{Kind: kind, TaskNumber: req.GetTaskNumber(), EmittedAt: time.Now().UTC()}
+	if project := strings.TrimSpace(req.GetProject()); project != "" {
+		n.ProjectID = project
+		if index, err := config.LoadProjectsIndex(); err == nil {
+			for _, entry := range index.Projects {
+				if entry.ProjectID == project || strings.EqualFold(entry.Name, project) {
+					n.ProjectID = entry.ProjectID
+					break
+				}
+			}
+		}
+	}
+	p, err := resolveNotificationPayload(n)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "resolve notification: %v", err)
+	}
+	if req.GetAgent() != "" {
+		p.Agent = req.GetAgent()
+	}
+	if req.GetTaskTitle() != "" {
+		p.TaskTitle = req.GetTaskTitle()
+	}
+	if req.GetFailureReason() != "" {
+		p.TaskFailureReason = req.GetFailureReason()
+	}
+	if req.GetDurationSeconds() > 0 {
+		p.DurationSeconds = req.GetDurationSeconds()
+	}
+
+	plan := router.Plan(d.Adapters(), p)
+	out := &pb.TestRouteResponse{
+		Rules:           plan.Rules,
+		DefaultRouting:  plan.Default,
+		Muted:           plan.Muted,
+		Unknown:         plan.Unknown,
+		ProjectId:       p.ProjectID,
+		ProjectName:     p.ProjectName,
+		Agent:           p.Agent,
+		TaskTitle:       p.TaskTitle,
+		FailureReason:   p.TaskFailureReason,
+		DurationSeconds: p.DurationSeconds,
+	}
+	for _, t := range plan.Targets {
+		out.Targets = append(out.Targets, &pb.RouteTarget{
+			AdapterId:   t.Adapter.ID(),
+			AdapterKind: t.Adapter.Kind(),
+			ChatIds:     t.Chats,
+			Rule:        t.Rule,
+		})
+	}
+	return out, nil
+}
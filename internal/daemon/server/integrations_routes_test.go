@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	"github.com/watchfire-io/watchfire/internal/models"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// TestTestRoute dry-runs notifications through rules read from
+// integrations.yaml against the dispatcher's adapters.
+func TestTestRoute(t *testing.T) {
+	withTempHomeIntegrations(t)
+	config.SetSecretStoreForTest(&memSecretStoreAdapter{inner: newMemSecretStore()})
+	t.Cleanup(func() { config.SetSecretStoreForTest(nil) })
+
+	cfg := models.NewIntegrationsConfig()
+	cfg.Routes = []models.RouteRule{{
+		Name:  "codex-failures",
+		Match: models.RouteMatch{Events: []string{"task_failed"}, Agents: []string{"codex"}, FailureReason: "OOM"},
+		To:    models.RouteTargets{Endpoints: []string{"hook-1", "missing"}},
+	}}
+	if err := config.SaveIntegrations(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	d := relay.NewDispatcher(nil,
+		func(notify.Notification) (relay.Payload, error) { return relay.Payload{}, nil },
+		func() ([]relay.Adapter, error) { return []relay.Adapter{nopRelayAdapter{}}, nil },
+	)
+	svc := newIntegrationsService()
+	svc.bindEchoServer(&fakeInboundProvider{dispatcher: d})
+	ctx := context.Background()
+
+	resp, err := svc.TestRoute(ctx, &pb.TestRouteRequest{
+		Event: "task_failed", Project: "p1", Agent: "codex", FailureReason: "OOM killed",
+	})
+	if err != nil {
+		t.Fatalf("TestRoute: %v", err)
+	}
+	if resp.GetDefaultRouting() || len(resp.GetRules()) != 1 || resp.GetRules()[0] != "codex-failures" {
+		t.Fatalf("expected the codex rule to match: %+v", resp)
+	}
+	if len(resp.GetTargets()) != 1 || resp.GetTargets()[0].GetAdapterId() != "hook-1" || resp.GetTargets()[0].GetRule() != "codex-failures" {
+		t.Errorf("targets = %+v", resp.GetTargets())
+	}
+	if len(resp.GetUnknown()) != 1 || resp.GetUnknown()[0] != "missing" {
+		t.Errorf("unknown = %v", resp.GetUnknown())
+	}
+	if resp.GetAgent() != "codex" || resp.GetProjectId() != "p1" {
+		t.Errorf("resolved notification = %+v", resp)
+	}
+
+	resp, err = svc.TestRoute(ctx, &pb.TestRouteRequest{Event: "task_failed", Project: "p1", Agent: "claude-code"})
+	if err != nil {
+		t.Fatalf("TestRoute(default): %v", err)
+	}
+	if !resp.GetDefaultRouting() || len(resp.GetRules()) != 0 || len(resp.GetTargets()) != 1 || resp.GetTargets()[0].GetRule() != "" {
+		t.Errorf("expected default routing: %+v", resp)
+	}
+
+	if _, err := svc.TestRoute(ctx, &pb.TestRouteRequest{Event: "nope"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unknown event: want InvalidArgument, got %v", err)
+	}
+	cfg.Routes = append(cfg.Routes, models.RouteRule{Name: "broken", Match: models.RouteMatch{Title: "("}, To: models.RouteTargets{Endpoints: []string{"hook-1"}}})
+	if err := config.SaveIntegrations(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.TestRoute(ctx, &pb.TestRouteRequest{Event: "task_failed"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("invalid routes: want FailedPrecondition, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/mail"
 	"net/url"
 	"strings"
 	"time"
@@ -62,6 +63,10 @@ type inboundProvider interface {
 	TelegramBridge() *telegram.Bridge
 	TelegramPairing() *telegram.Pairing
 	restartTelegramBridge()
+
+	// RelayDispatcher exposes the outbound dispatcher so the delivery
+	// RPCs can list and replay durable-outbox records.
+	RelayDispatcher() *relay.Dispatcher
 }
 
 func newIntegrationsService() *integrationsService {
@@ -114,6 +119,22 @@ func (s *integrationsService) SaveIntegration(_ context.Context, req *pb.SaveInt
 		cfg.GitHub = githubProtoToModel(payload.Github)
 	case *pb.SaveIntegrationRequest_Telegram:
 		upsertTelegram(cfg, payload.Telegram)
+	case *pb.SaveIntegrationRequest_Teams:
+		if err := upsertTeams(cfg, payload.Teams); err != nil {
+			return nil, err
+		}
+	case *pb.SaveIntegrationRequest_Matrix:
+		if err := upsertMatrix(cfg, payload.Matrix); err != nil {
+			return nil, err
+		}
+	case *pb.SaveIntegrationRequest_Ntfy:
+		if err := upsertNtfy(cfg, payload.Ntfy); err != nil {
+			return nil, err
+		}
+	case *pb.SaveIntegrationRequest_Email:
+		if err := upsertEmail(cfg, payload.Email); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("save: missing payload")
 	}
@@ -167,6 +188,18 @@ func (s *integrationsService) DeleteIntegration(_ context.Context, req *pb.Delet
 			_ = config.DeleteIntegrationSecret(config.SecretKeyForIntegration("telegram", "bot_token"))
 		}
 		cfg.Telegram = nil
+	case pb.IntegrationKind_TEAMS:
+		cfg.Teams = removeTeamsByID(cfg.Teams, id)
+		_ = config.DeleteIntegrationSecret(config.SecretKeyForIntegration(id, "url"))
+	case pb.IntegrationKind_MATRIX:
+		cfg.Matrix = removeMatrixByID(cfg.Matrix, id)
+		_ = config.DeleteIntegrationSecret(config.SecretKeyForIntegration(id, "access_token"))
+	case pb.IntegrationKind_NTFY:
+		cfg.Ntfy = removeNtfyByID(cfg.Ntfy, id)
+		_ = config.DeleteIntegrationSecret(config.SecretKeyForIntegration(id, "token"))
+	case pb.IntegrationKind_EMAIL:
+		cfg.Email = removeEmailByID(cfg.Email, id)
+		_ = config.DeleteIntegrationSecret(config.SecretKeyForIntegration(id, "password"))
 	default:
 		return nil, fmt.Errorf("delete: unknown kind")
 	}
@@ -233,6 +266,51 @@ func (s *integrationsService) TestIntegration(ctx context.Context, req *pb.TestI
 		return &pb.TestIntegrationResponse{Ok: true, Message: "github auto-PR enabled (gh auth checked at PR-open time)"}, nil
 	case pb.IntegrationKind_TELEGRAM:
 		return s.deliverTelegramTest(ctx, cfg.Telegram)
+	case pb.IntegrationKind_TEAMS:
+		ep, ok := findTeams(cfg.Teams, id)
+		if !ok {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "teams endpoint not found"}, nil
+		}
+		if ep.URL == "" {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "teams URL not set in keyring"}, nil
+		}
+		adapter, err := relay.NewTeamsAdapter(ep, s.httpClient, nil)
+		if err != nil {
+			return &pb.TestIntegrationResponse{Ok: false, Message: err.Error()}, nil
+		}
+		return deliverChannelTest(ctx, adapter, "Microsoft Teams"), nil
+	case pb.IntegrationKind_MATRIX:
+		ep, ok := findMatrix(cfg.Matrix, id)
+		if !ok {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "matrix endpoint not found"}, nil
+		}
+		if ep.AccessToken == "" {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "matrix access token not set in keyring"}, nil
+		}
+		adapter, err := relay.NewMatrixAdapter(ep, s.httpClient, nil)
+		if err != nil {
+			return &pb.TestIntegrationResponse{Ok: false, Message: err.Error()}, nil
+		}
+		return deliverChannelTest(ctx, adapter, "Matrix"), nil
+	case pb.IntegrationKind_NTFY:
+		ep, ok := findNtfy(cfg.Ntfy, id)
+		if !ok {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "ntfy endpoint not found"}, nil
+		}
+		adapter, err := relay.NewNtfyAdapter(ep, s.httpClient, nil)
+		if err != nil {
+			return &pb.TestIntegrationResponse{Ok: false, Message: err.Error()}, nil
+		}
+		return deliverChannelTest(ctx, adapter, "ntfy"), nil
+	case pb.IntegrationKind_EMAIL:
+		ep, ok := findEmail(cfg.Email, id)
+		if !ok {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "email endpoint not found"}, nil
+		}
+		if ep.Username != "" && ep.Password == "" {
+			return &pb.TestIntegrationResponse{Ok: false, Message: "SMTP password not set in keyring"}, nil
+		}
+		return deliverEmailTest(ctx, relay.NewEmailAdapter(ep, nil)), nil
 	default:
 		return nil, fmt.Errorf("test: unknown kind")
 	}
@@ -258,11 +336,7 @@ func (s *integrationsService) deliverWebhookTest(ctx context.Context, ep models.
 	adapter := relay.NewWebhookAdapter(ep, secret, s.httpClient, nil)
 
 	now := time.Now().UTC()
-	kinds := []notify.Kind{
-		notify.KindTaskFailed,
-		notify.KindRunComplete,
-		notify.KindWeeklyDigest,
-	}
+	kinds := notify.Kinds
 	allOK := true
 	var msgs []string
 	for _, kind := range kinds {
@@ -302,6 +376,13 @@ func syntheticWebhookPayload(kind notify.Kind, now time.Time) relay.Payload {
 		base.DigestDate = now.Format("2006-01-02")
 		base.DeepLink = "watchfire://digest/" + base.DigestDate
 		base.DigestBody = "## Watchfire weekly digest test\n\nIf you can read this, your webhook is receiving WEEKLY_DIGEST notifications."
+	case notify.KindBudgetThreshold:
+		base.TaskNumber = 0
+		base.TaskTitle = ""
+		base.DeepLink = "watchfire://project/test-project"
+		base.Budget = syntheticBudgetAlert(now)
+	default:
+		applySyntheticEventFields(&base, kind)
 	}
 	return base
 }
@@ -319,11 +400,7 @@ func (s *integrationsService) deliverDiscordTest(ctx context.Context, ep models.
 	}
 
 	now := time.Now().UTC()
-	kinds := []notify.Kind{
-		notify.KindTaskFailed,
-		notify.KindRunComplete,
-		notify.KindWeeklyDigest,
-	}
+	kinds := notify.Kinds
 	allOK := true
 	var msgs []string
 	for _, kind := range kinds {
@@ -353,11 +430,7 @@ func (s *integrationsService) deliverSlackTest(ctx context.Context, ep models.Sl
 	}
 
 	now := time.Now().UTC()
-	kinds := []notify.Kind{
-		notify.KindTaskFailed,
-		notify.KindRunComplete,
-		notify.KindWeeklyDigest,
-	}
+	kinds := notify.Kinds
 	allOK := true
 	var msgs []string
 	for _, kind := range kinds {
@@ -396,11 +469,7 @@ func (s *integrationsService) deliverTelegramTest(ctx context.Context, tg *model
 	adapter := relay.NewTelegramAdapter(*tg, client, nil)
 
 	now := time.Now().UTC()
-	kinds := []notify.Kind{
-		notify.KindTaskFailed,
-		notify.KindRunComplete,
-		notify.KindWeeklyDigest,
-	}
+	kinds := notify.Kinds
 	allOK := true
 	var msgs []string
 	tested := 0
@@ -457,6 +526,13 @@ func syntheticTelegramPayload(kind notify.Kind, now time.Time) relay.Payload {
 		base.DigestDate = now.Format("2006-01-02")
 		base.DeepLink = "watchfire://digest/" + base.DigestDate
 		base.DigestBody = "## Watchfire weekly digest test\n\nIf you can read this, your Telegram chat is receiving WEEKLY_DIGEST notifications."
+	case notify.KindBudgetThreshold:
+		base.TaskNumber = 0
+		base.TaskTitle = ""
+		base.DeepLink = "watchfire://project/test-project"
+		base.Budget = syntheticBudgetAlert(now)
+	default:
+		applySyntheticEventFields(&base, kind)
 	}
 	return base
 }
@@ -484,6 +560,13 @@ func syntheticSlackPayload(kind notify.Kind, now time.Time) relay.Payload {
 		base.DigestDate = now.Format("2006-01-02")
 		base.DeepLink = "watchfire://digest/" + base.DigestDate
 		base.DigestBody = "## Watchfire weekly digest test\n\nIf you can read this, your Slack channel is receiving WEEKLY_DIGEST notifications."
+	case notify.KindBudgetThreshold:
+		base.TaskNumber = 0
+		base.TaskTitle = ""
+		base.DeepLink = "watchfire://project/test-project"
+		base.Budget = syntheticBudgetAlert(now)
+	default:
+		applySyntheticEventFields(&base, kind)
 	}
 	return base
 }
@@ -511,29 +594,173 @@ func syntheticDiscordPayload(kind notify.Kind, now time.Time) relay.Payload {
 		base.DigestDate = now.Format("2006-01-02")
 		base.DeepLink = "watchfire://digest/" + base.DigestDate
 		base.DigestBody = "## Watchfire weekly digest test\n\nIf you can read this, your Discord channel is receiving WEEKLY_DIGEST notifications."
+	case notify.KindBudgetThreshold:
+		base.TaskNumber = 0
+		base.TaskTitle = ""
+		base.DeepLink = "watchfire://project/test-project"
+		base.Budget = syntheticBudgetAlert(now)
+	default:
+		applySyntheticEventFields(&base, kind)
 	}
 	return base
 }
 
+// deliverChannelTest sends one synthetic message per supported
+// notification kind through a template-driven channel adapter (Teams,
+// Matrix, ntfy), rolling per-kind status into one response the same way
+// deliverSlackTest does. channel names the adapter in the sample copy.
+func deliverChannelTest(ctx context.Context, adapter relay.Adapter, channel string) *pb.TestIntegrationResponse {
+	now := time.Now().UTC()
+	allOK := true
+	var msgs []string
+	for _, kind := range notify.Kinds {
+		payload := syntheticChannelPayload(kind, now, channel)
+		if sendErr := adapter.Send(ctx, payload); sendErr != nil {
+			allOK = false
+			msgs = append(msgs, fmt.Sprintf("%s: %v", kind, sendErr))
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: OK", kind))
+	}
+	return &pb.TestIntegrationResponse{
+		Ok:      allOK,
+		Message: strings.Join(msgs, " · "),
+	}
+}
+
+// deliverEmailTest is deliverChannelTest for email: recipients pick
+// their own events, so only the kinds at least one recipient selected
+// are sent — each to exactly the addresses that would receive it live.
+func deliverEmailTest(ctx context.Context, adapter *relay.EmailAdapter) *pb.TestIntegrationResponse {
+	now := time.Now().UTC()
+	allOK := true
+	var msgs []string
+	for _, kind := range notify.Kinds {
+		if !adapter.Supports(kind) {
+			continue
+		}
+		if sendErr := adapter.Send(ctx, syntheticChannelPayload(kind, now, "email")); sendErr != nil {
+			allOK = false
+			msgs = append(msgs, fmt.Sprintf("%s: %v", kind, sendErr))
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: OK", kind))
+	}
+	if len(msgs) == 0 {
+		return &pb.TestIntegrationResponse{Ok: false, Message: "no recipient has any event enabled"}
+	}
+	return &pb.TestIntegrationResponse{
+		Ok:      allOK,
+		Message: strings.Join(msgs, " · "),
+	}
+}
+
+// syntheticChannelPayload is the channel-parameterised sibling of
+// syntheticSlackPayload, used by the adapters added after Slack /
+// Discord so each one doesn't grow its own copy.
+func syntheticChannelPayload(kind notify.Kind, now time.Time, channel string) relay.Payload {
+	base := relay.Payload{
+		Version:      1,
+		Kind:         string(kind),
+		EmittedAt:    now,
+		ProjectID:    "test-project",
+		ProjectName:  "Watchfire test",
+		ProjectColor: "#3b82f6",
+		TaskNumber:   1,
+		TaskTitle:    fmt.Sprintf("Watchfire %s adapter test", channel),
+		DeepLink:     "watchfire://project/test-project/task/0001",
+	}
+	switch kind {
+	case notify.KindTaskFailed:
+		base.TaskFailureReason = fmt.Sprintf("synthetic test — your %s adapter is wired up correctly", channel)
+	case notify.KindWeeklyDigest:
+		base.DigestDate = now.Format("2006-01-02")
+		base.DeepLink = "watchfire://digest/" + base.DigestDate
+		base.DigestBody = fmt.Sprintf("## Watchfire weekly digest test\n\nIf you can read this, %s is receiving WEEKLY_DIGEST notifications.", channel)
+	case notify.KindBudgetThreshold:
+		base.TaskNumber = 0
+		base.TaskTitle = ""
+		base.DeepLink = "watchfire://project/test-project"
+		base.Budget = syntheticBudgetAlert(now)
+	default:
+		applySyntheticEventFields(&base, kind)
+	}
+	return base
+}
+
+// applySyntheticEventFields fills the per-kind fields of the lifecycle
+// events (merge failure, PR, agent issues, wildfire, generated tasks)
+// so every channel's Test click previews them with realistic content.
+func applySyntheticEventFields(p *relay.Payload, kind notify.Kind) {
+	switch kind {
+	case notify.KindMergeFailed:
+		p.Detail = "synthetic test — merge conflict in README.md"
+	case notify.KindPROpened:
+		p.URL = "https://github.com/watchfire-io/watchfire/pull/1"
+	case notify.KindAgentNeedsAuth:
+		p.Detail = "synthetic test — the agent asked you to sign in again"
+	case notify.KindRateLimited:
+		p.Detail = "synthetic test — usage limit reached, resets in 1h"
+	case notify.KindWildfirePhase:
+		p.TaskNumber, p.TaskTitle = 0, ""
+		p.DeepLink = "watchfire://project/" + p.ProjectID
+		p.Phase, p.PreviousPhase = "generate", "refine"
+	case notify.KindTasksGenerated:
+		p.TaskNumber, p.TaskTitle = 0, ""
+		p.DeepLink = "watchfire://project/" + p.ProjectID
+		p.Count = 3
+	}
+}
+
+// syntheticBudgetAlert is the sample budget every Test payload carries
+// for BUDGET_THRESHOLD: a project at 80% of a $100 month.
+func syntheticBudgetAlert(now time.Time) *notify.BudgetAlert {
+	return &notify.BudgetAlert{
+		Scope:     "project",
+		Month:     now.Format("2006-01"),
+		Threshold: 80,
+		SpentUSD:  80.5,
+		LimitUSD:  100,
+	}
+}
+
 // --- proto / model converters ---------------------------------------------
 
+// eventsModelToProto resolves every later event through Enabled, so a
+// client sees merge_failed / pr_opened on when they are inherited.
 func eventsModelToProto(e models.EventBitmask) *pb.IntegrationEvents {
-	return &pb.IntegrationEvents{
+	out := &pb.IntegrationEvents{
 		TaskFailed:   e.TaskFailed,
 		RunComplete:  e.RunComplete,
 		WeeklyDigest: e.WeeklyDigest,
+
+		BudgetThreshold: e.BudgetThreshold,
+		Events:          map[string]bool{},
 	}
+	for _, key := range models.ExtendedEvents {
+		out.Events[key] = e.Enabled(key)
+	}
+	return out
 }
 
+// eventsProtoToModel keeps only the keys the client sent: an older
+// client that knows nothing of the events map leaves the inherited
+// defaults in place.
 func eventsProtoToModel(e *pb.IntegrationEvents) models.EventBitmask {
 	if e == nil {
 		return models.EventBitmask{}
 	}
-	return models.EventBitmask{
+	out := models.EventBitmask{
 		TaskFailed:   e.GetTaskFailed(),
 		RunComplete:  e.GetRunComplete(),
 		WeeklyDigest: e.GetWeeklyDigest(),
+
+		BudgetThreshold: e.GetBudgetThreshold(),
+	}
+	for key, on := range e.GetEvents() {
+		out.Set(key, on)
 	}
+	return out
 }
 
 // maskURL returns a display-only label for a URL: scheme stripped, host
@@ -571,6 +798,10 @@ func scrubConfigToProto(cfg *models.IntegrationsConfig) *pb.IntegrationsConfig {
 		Webhooks: make([]*pb.WebhookIntegration, 0, len(cfg.Webhooks)),
 		Slack:    make([]*pb.SlackIntegration, 0, len(cfg.Slack)),
 		Discord:  make([]*pb.DiscordIntegration, 0, len(cfg.Discord)),
+		Teams:    make([]*pb.TeamsIntegration, 0, len(cfg.Teams)),
+		Matrix:   make([]*pb.MatrixIntegration, 0, len(cfg.Matrix)),
+		Ntfy:     make([]*pb.NtfyIntegration, 0, len(cfg.Ntfy)),
+		Email:    make([]*pb.EmailIntegration, 0, len(cfg.Email)),
 		Github: &pb.GitHubIntegration{
 			Enabled:       cfg.GitHub.Enabled,
 			DraftDefault:  cfg.GitHub.DraftDefault,
@@ -642,6 +873,62 @@ func scrubConfigToProto(cfg *models.IntegrationsConfig) *pb.IntegrationsConfig {
 		}
 		out.Telegram = tg
 	}
+	for _, ep := range cfg.Teams {
+		out.Teams = append(out.Teams, &pb.TeamsIntegration{
+			Id:             ep.ID,
+			Label:          ep.Label,
+			Url:            "", // never returned
+			UrlLabel:       maskURL(ep.URL),
+			UrlSet:         ep.URL != "",
+			EnabledEvents:  eventsModelToProto(ep.EnabledEvents),
+			ProjectMuteIds: append([]string(nil), ep.ProjectMuteIDs...),
+		})
+	}
+	for _, ep := range cfg.Matrix {
+		out.Matrix = append(out.Matrix, &pb.MatrixIntegration{
+			Id:             ep.ID,
+			Label:          ep.Label,
+			HomeserverUrl:  ep.HomeserverURL,
+			RoomId:         ep.RoomID,
+			AccessToken:    "", // never returned
+			TokenSet:       ep.AccessToken != "",
+			EnabledEvents:  eventsModelToProto(ep.EnabledEvents),
+			ProjectMuteIds: append([]string(nil), ep.ProjectMuteIDs...),
+		})
+	}
+	for _, ep := range cfg.Ntfy {
+		out.Ntfy = append(out.Ntfy, &pb.NtfyIntegration{
+			Id:             ep.ID,
+			Label:          ep.Label,
+			Url:            ep.URL, // topic URL is non-secret (the token is)
+			Token:          "",     // never returned
+			TokenSet:       ep.Token != "",
+			EnabledEvents:  eventsModelToProto(ep.EnabledEvents),
+			ProjectMuteIds: append([]string(nil), ep.ProjectMuteIDs...),
+		})
+	}
+	for _, ep := range cfg.Email {
+		recipients := make([]*pb.EmailRecipient, 0, len(ep.Recipients))
+		for _, r := range ep.Recipients {
+			recipients = append(recipients, &pb.EmailRecipient{
+				Address:       r.Address,
+				EnabledEvents: eventsModelToProto(r.EnabledEvents),
+			})
+		}
+		out.Email = append(out.Email, &pb.EmailIntegration{
+			Id:             ep.ID,
+			Label:          ep.Label,
+			Host:           ep.Host,
+			Port:           int32(ep.Port),
+			Security:       ep.Security,
+			Username:       ep.Username,
+			Password:       "", // never returned
+			PasswordSet:    ep.Password != "",
+			From:           ep.From,
+			Recipients:     recipients,
+			ProjectMuteIds: append([]string(nil), ep.ProjectMuteIDs...),
+		})
+	}
 	return out
 }
 
@@ -717,6 +1004,8 @@ func upsertSlack(cfg *models.IntegrationsConfig, in *pb.SlackIntegration) error
 	for i, ep := range cfg.Slack {
 		if ep.ID == id {
 			endpoint.URLRef = ep.URLRef
+			// The proto carries no team id; keep the one set in YAML.
+			endpoint.TeamID = ep.TeamID
 			// Empty URL on update preserves the existing keyring entry
 			if in.GetUrl() == "" {
 				endpoint.URL = ep.URL
@@ -758,6 +1047,169 @@ func upsertDiscord(cfg *models.IntegrationsConfig, in *pb.DiscordIntegration) er
 	return nil
 }
 
+func upsertTeams(cfg *models.IntegrationsConfig, in *pb.TeamsIntegration) error {
+	if in == nil {
+		return fmt.Errorf("teams payload missing")
+	}
+	id := strings.TrimSpace(in.GetId())
+	if id == "" {
+		id = uuid.New().String()
+	}
+	endpoint := models.TeamsEndpoint{
+		ID:             id,
+		Label:          in.GetLabel(),
+		URL:            in.GetUrl(), // SaveIntegrations pushes this to keyring
+		EnabledEvents:  eventsProtoToModel(in.GetEnabledEvents()),
+		ProjectMuteIDs: append([]string(nil), in.GetProjectMuteIds()...),
+	}
+	for i, ep := range cfg.Teams {
+		if ep.ID == id {
+			endpoint.URLRef = ep.URLRef
+			if in.GetUrl() == "" {
+				endpoint.URL = ep.URL
+			}
+			cfg.Teams[i] = endpoint
+			return nil
+		}
+	}
+	cfg.Teams = append(cfg.Teams, endpoint)
+	return nil
+}
+
+func upsertMatrix(cfg *models.IntegrationsConfig, in *pb.MatrixIntegration) error {
+	if in == nil {
+		return fmt.Errorf("matrix payload missing")
+	}
+	homeserver := strings.TrimRight(strings.TrimSpace(in.GetHomeserverUrl()), "/")
+	roomID := strings.TrimSpace(in.GetRoomId())
+	if homeserver == "" || roomID == "" {
+		return fmt.Errorf("matrix: homeserver URL and room ID are required")
+	}
+	id := strings.TrimSpace(in.GetId())
+	if id == "" {
+		id = uuid.New().String()
+	}
+	endpoint := models.MatrixEndpoint{
+		ID:             id,
+		Label:          in.GetLabel(),
+		HomeserverURL:  homeserver,
+		RoomID:         roomID,
+		AccessToken:    in.GetAccessToken(), // SaveIntegrations pushes this to keyring
+		EnabledEvents:  eventsProtoToModel(in.GetEnabledEvents()),
+		ProjectMuteIDs: append([]string(nil), in.GetProjectMuteIds()...),
+	}
+	for i, ep := range cfg.Matrix {
+		if ep.ID == id {
+			endpoint.AccessTokenRef = ep.AccessTokenRef
+			if in.GetAccessToken() == "" {
+				endpoint.AccessToken = ep.AccessToken
+			}
+			cfg.Matrix[i] = endpoint
+			return nil
+		}
+	}
+	cfg.Matrix = append(cfg.Matrix, endpoint)
+	return nil
+}
+
+func upsertNtfy(cfg *models.IntegrationsConfig, in *pb.NtfyIntegration) error {
+	if in == nil {
+		return fmt.Errorf("ntfy payload missing")
+	}
+	topicURL := strings.TrimSpace(in.GetUrl())
+	if topicURL == "" {
+		return fmt.Errorf("ntfy: topic URL is required")
+	}
+	id := strings.TrimSpace(in.GetId())
+	if id == "" {
+		id = uuid.New().String()
+	}
+	endpoint := models.NtfyEndpoint{
+		ID:             id,
+		Label:          in.GetLabel(),
+		URL:            topicURL,
+		Token:          in.GetToken(), // SaveIntegrations pushes this to keyring
+		EnabledEvents:  eventsProtoToModel(in.GetEnabledEvents()),
+		ProjectMuteIDs: append([]string(nil), in.GetProjectMuteIds()...),
+	}
+	for i, ep := range cfg.Ntfy {
+		if ep.ID == id {
+			endpoint.TokenRef = ep.TokenRef
+			if in.GetToken() == "" {
+				endpoint.Token = ep.Token
+			}
+			cfg.Ntfy[i] = endpoint
+			return nil
+		}
+	}
+	cfg.Ntfy = append(cfg.Ntfy, endpoint)
+	return nil
+}
+
+func upsertEmail(cfg *models.IntegrationsConfig, in *pb.EmailIntegration) error {
+	if in == nil {
+		return fmt.Errorf("email payload missing")
+	}
+	host := strings.TrimSpace(in.GetHost())
+	from := strings.TrimSpace(in.GetFrom())
+	if host == "" || from == "" {
+		return fmt.Errorf("email: SMTP host and from address are required")
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return fmt.Errorf("email: invalid from address %q: %w", from, err)
+	}
+	switch in.GetSecurity() {
+	case "", models.EmailSecurityStartTLS, models.EmailSecurityTLS, models.EmailSecurityNone:
+	default:
+		return fmt.Errorf("email: security must be %q, %q or %q", models.EmailSecurityStartTLS, models.EmailSecurityTLS, models.EmailSecurityNone)
+	}
+	if in.GetPort() < 0 || in.GetPort() > 65535 {
+		return fmt.Errorf("email: invalid port %d", in.GetPort())
+	}
+	var recipients []models.EmailRecipient
+	for _, r := range in.GetRecipients() {
+		addr := strings.TrimSpace(r.GetAddress())
+		if _, err := mail.ParseAddress(addr); err != nil {
+			return fmt.Errorf("email: invalid recipient %q: %w", addr, err)
+		}
+		recipients = append(recipients, models.EmailRecipient{
+			Address:       addr,
+			EnabledEvents: eventsProtoToModel(r.GetEnabledEvents()),
+		})
+	}
+	if len(recipients) == 0 {
+		return fmt.Errorf("email: at least one recipient is required")
+	}
+	id := strings.TrimSpace(in.GetId())
+	if id == "" {
+		id = uuid.New().String()
+	}
+	endpoint := models.EmailEndpoint{
+		ID:             id,
+		Label:          in.GetLabel(),
+		Host:           host,
+		Port:           int(in.GetPort()),
+		Security:       in.GetSecurity(),
+		Username:       strings.TrimSpace(in.GetUsername()),
+		Password:       in.GetPassword(), // SaveIntegrations pushes this to keyring
+		From:           from,
+		Recipients:     recipients,
+		ProjectMuteIDs: append([]string(nil), in.GetProjectMuteIds()...),
+	}
+	for i, ep := range cfg.Email {
+		if ep.ID == id {
+			endpoint.PasswordRef = ep.PasswordRef
+			if in.GetPassword() == "" {
+				endpoint.Password = ep.Password
+			}
+			cfg.Email[i] = endpoint
+			return nil
+		}
+	}
+	cfg.Email = append(cfg.Email, endpoint)
+	return nil
+}
+
 // upsertTelegram applies a Save payload onto the single-instance
 // Telegram config. Write-only secret convention: a non-empty bot_token
 // is pushed to the keyring by config.SaveIntegrations (the runtime
@@ -831,6 +1283,46 @@ func removeDiscordByID(in []models.DiscordEndpoint, id string) []models.DiscordE
 	}
 	return out
 }
+func removeTeamsByID(in []models.TeamsEndpoint, id string) []models.TeamsEndpoint {
+	out := in[:0]
+	for _, ep := range in {
+		if ep.ID == id {
+			continue
+		}
+		out = append(out, ep)
+	}
+	return out
+}
+func removeMatrixByID(in []models.MatrixEndpoint, id string) []models.MatrixEndpoint {
+	out := in[:0]
+	for _, ep := range in {
+		if ep.ID == id {
+			continue
+		}
+		out = append(out, ep)
+	}
+	return out
+}
+func removeNtfyByID(in []models.NtfyEndpoint, id string) []models.NtfyEndpoint {
+	out := in[:0]
+	for _, ep := range in {
+		if ep.ID == id {
+			continue
+		}
+		out = append(out, ep)
+	}
+	return out
+}
+func removeEmailByID(in []models.EmailEndpoint, id string) []models.EmailEndpoint {
+	out := in[:0]
+	for _, ep := range in {
+		if ep.ID == id {
+			continue
+		}
+		out = append(out, ep)
+	}
+	return out
+}
 
 func findWebhook(in []models.WebhookEndpoint, id string) (models.WebhookEndpoint, bool) {
 	for _, ep := range in {
@@ -856,3 +1348,35 @@ func findDiscord(in []models.DiscordEndpoint, id string) (models.DiscordEndpoint
 	}
 	return models.DiscordEndpoint{}, false
 }
+func findTeams(in []models.TeamsEndpoint, id string) (models.TeamsEndpoint, bool) {
+	for _, ep := range in {
+		if ep.ID == id {
+			return ep, true
+		}
+	}
+	return models.TeamsEndpoint{}, false
+}
+func findMatrix(in []models.MatrixEndpoint, id string) (models.MatrixEndpoint, bool) {
+	for _, ep := range in {
+		if ep.ID == id {
+			return ep, true
+		}
+	}
+	return models.MatrixEndpoint{}, false
+}
+func findNtfy(in []models.NtfyEndpoint, id string) (models.NtfyEndpoint, bool) {
+	for _, ep := range in {
+		if ep.ID == id {
+			return ep, true
+		}
+	}
+	return models.NtfyEndpoint{}, false
+}
+func findEmail(in []models.EmailEndpoint, id string) (models.EmailEndpoint, bool) {
+	for _, ep := range in {
+		if ep.ID == id {
+			return ep, true
+		}
+	}
+	return models.EmailEndpoint{}, false
+}
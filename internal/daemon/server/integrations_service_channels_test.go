@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// TestTeamsMatrixNtfyLifecycle saves one endpoint of each kind, checks
+// List scrubs the keyring-held secrets, runs Test against a Matrix
+// homeserver stand-in, and verifies Delete drops the keyring entries.
+func TestTeamsMatrixNtfyLifecycle(t *testing.T) {
+	withTempHomeIntegrations(t)
+	mem := newMemSecretStore()
+	config.SetSecretStoreForTest(&memSecretStoreAdapter{inner: mem})
+	t.Cleanup(func() { config.SetSecretStoreForTest(nil) })
+
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer syt_secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"event_id":"$e"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	svc := newIntegrationsService()
+	ctx := context.Background()
+	for _, req := range []*pb.SaveIntegrationRequest{
+		{Payload: &pb.SaveIntegrationRequest_Teams{Teams: &pb.TeamsIntegration{
+			Id: "teams-1", Url: "https://example.webhook.office.com/webhookb2/abc",
+		}}},
+		{Payload: &pb.SaveIntegrationRequest_Matrix{Matrix: &pb.MatrixIntegration{
+			Id: "mx-1", HomeserverUrl: srv.URL + "/", RoomId: "!ops:example.org", AccessToken: "syt_secret",
+		}}},
+		{Payload: &pb.SaveIntegrationRequest_Ntfy{Ntfy: &pb.NtfyIntegration{
+			Id: "ntfy-1", Url: "https://ntfy.sh/watchfire", Token: "tk_secret",
+		}}},
+	} {
+		if _, err := svc.SaveIntegration(ctx, req); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	out, err := svc.ListIntegrations(ctx, &pb.ListIntegrationsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.GetTeams()) != 1 || out.GetTeams()[0].GetUrl() != "" || !out.GetTeams()[0].GetUrlSet() {
+		t.Errorf("teams URL should be scrubbed + flagged set: %+v", out.GetTeams())
+	}
+	if len(out.GetMatrix()) != 1 {
+		t.Fatalf("want 1 matrix endpoint, got %d", len(out.GetMatrix()))
+	}
+	if mx := out.GetMatrix()[0]; mx.GetAccessToken() != "" || !mx.GetTokenSet() ||
+		mx.GetHomeserverUrl() != srv.URL || mx.GetRoomId() != "!ops:example.org" {
+		t.Errorf("unexpected matrix entry: %+v", mx)
+	}
+	if len(out.GetNtfy()) != 1 || out.GetNtfy()[0].GetToken() != "" || !out.GetNtfy()[0].GetTokenSet() ||
+		out.GetNtfy()[0].GetUrl() != "https://ntfy.sh/watchfire" {
+		t.Errorf("unexpected ntfy entry: %+v", out.GetNtfy())
+	}
+
+	resp, err := svc.TestIntegration(ctx, &pb.TestIntegrationRequest{Kind: pb.IntegrationKind_MATRIX, Id: "mx-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.GetOk() {
+		t.Fatalf("matrix test failed: %s", resp.GetMessage())
+	}
+	mu.Lock()
+	if len(paths) != len(notify.Kinds) || !strings.HasPrefix(paths[0], "/_matrix/client/v3/rooms/!ops:example.org/send/m.room.message/") {
+		t.Errorf("want one room event per kind, got %v", paths)
+	}
+	mu.Unlock()
+
+	for kind, id := range map[pb.IntegrationKind]string{
+		pb.IntegrationKind_TEAMS:  "teams-1",
+		pb.IntegrationKind_MATRIX: "mx-1",
+		pb.IntegrationKind_NTFY:   "ntfy-1",
+	} {
+		if _, err := svc.DeleteIntegration(ctx, &pb.DeleteIntegrationRequest{Kind: kind, Id: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, key := range []string{
+		config.SecretKeyForIntegration("teams-1", "url"),
+		config.SecretKeyForIntegration("mx-1", "access_token"),
+		config.SecretKeyForIntegration("ntfy-1", "token"),
+	} {
+		if _, ok := mem.Get(key); ok {
+			t.Errorf("%s should be removed from the keyring after delete", key)
+		}
+	}
+}
+
+func TestSaveMatrixRequiresRoom(t *testing.T) {
+	withTempHomeIntegrations(t)
+	config.SetSecretStoreForTest(&memSecretStoreAdapter{inner: newMemSecretStore()})
+	t.Cleanup(func() { config.SetSecretStoreForTest(nil) })
+
+	_, err := newIntegrationsService().SaveIntegration(context.Background(), &pb.SaveIntegrationRequest{
+		Payload: &pb.SaveIntegrationRequest_Matrix{Matrix: &pb.MatrixIntegration{HomeserverUrl: "https://matrix.example.org"}},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a Matrix endpoint without a room ID")
+	}
+}
+
+// TestEmailIntegrationLifecycle saves an SMTP endpoint, checks List
+// scrubs the password but keeps per-recipient events, that an update
+// without a password keeps the stored one, and that Delete drops it.
+func TestEmailIntegrationLifecycle(t *testing.T) {
+	withTempHomeIntegrations(t)
+	mem := newMemSecretStore()
+	config.SetSecretStoreForTest(&memSecretStoreAdapter{inner: mem})
+	t.Cleanup(func() { config.SetSecretStoreForTest(nil) })
+
+	svc := newIntegrationsService()
+	ctx := context.Background()
+	email := &pb.EmailIntegration{
+		Id: "mail-1", Host: "smtp.example.com", Security: "starttls",
+		Username: "relay", Password: "s3cret", From: "Watchfire <watchfire@example.com>",
+		Recipients: []*pb.EmailRecipient{
+			{Address: "oncall@example.com", EnabledEvents: &pb.IntegrationEvents{TaskFailed: true}},
+			{Address: "manager@example.com", EnabledEvents: &pb.IntegrationEvents{WeeklyDigest: true}},
+		},
+	}
+	if _, err := svc.SaveIntegration(ctx, &pb.SaveIntegrationRequest{Payload: &pb.SaveIntegrationRequest_Email{Email: email}}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	secretKey := config.SecretKeyForIntegration("mail-1", "password")
+	if v, ok := mem.Get(secretKey); !ok || v != "s3cret" {
+		t.Fatalf("password not stored in keyring: %q %v", v, ok)
+	}
+
+	email.Password = ""
+	email.Label = "Ops mail"
+	out, err := svc.SaveIntegration(ctx, &pb.SaveIntegrationRequest{Payload: &pb.SaveIntegrationRequest_Email{Email: email}})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if len(out.GetEmail()) != 1 {
+		t.Fatalf("want 1 email endpoint, got %d", len(out.GetEmail()))
+	}
+	got := out.GetEmail()[0]
+	if got.GetPassword() != "" || !got.GetPasswordSet() || got.GetLabel() != "Ops mail" {
+		t.Errorf("password should be scrubbed but kept: %+v", got)
+	}
+	if rs := got.GetRecipients(); len(rs) != 2 || !rs[0].GetEnabledEvents().GetTaskFailed() ||
+		rs[0].GetEnabledEvents().GetWeeklyDigest() || !rs[1].GetEnabledEvents().GetWeeklyDigest() {
+		t.Errorf("per-recipient events not round-tripped: %+v", rs)
+	}
+
+	if _, err := svc.DeleteIntegration(ctx, &pb.DeleteIntegrationRequest{Kind: pb.IntegrationKind_EMAIL, Id: "mail-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := mem.Get(secretKey); ok {
+		t.Error("password should be removed from the keyring after delete")
+	}
+}
+
+func TestSaveEmailValidation(t *testing.T) {
+	withTempHomeIntegrations(t)
+	config.SetSecretStoreForTest(&memSecretStoreAdapter{inner: newMemSecretStore()})
+	t.Cleanup(func() { config.SetSecretStoreForTest(nil) })
+
+	svc := newIntegrationsService()
+	rcpt := []*pb.EmailRecipient{{Address: "oncall@example.com"}}
+	for name, in := range map[string]*pb.EmailIntegration{
+		"no host":        {From: "w@example.com", Recipients: rcpt},
+		"bad from":       {Host: "smtp.example.com", From: "not an address", Recipients: rcpt},
+		"no recipients":  {Host: "smtp.example.com", From: "w@example.com"},
+		"bad recipient":  {Host: "smtp.example.com", From: "w@example.com", Recipients: []*pb.EmailRecipient{{Address: "nope"}}},
+		"bad security":   {Host: "smtp.example.com", From: "w@example.com", Recipients: rcpt, Security: "ssl3"},
+		"port too large": {Host: "smtp.example.com", From: "w@example.com", Recipients: rcpt, Port: 70000},
+	} {
+		_, err := svc.SaveIntegration(context.Background(), &pb.SaveIntegrationRequest{
+			Payload: &pb.SaveIntegrationRequest_Email{Email: in},
+		})
+		if err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+}
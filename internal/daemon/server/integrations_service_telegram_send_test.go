@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
 	"github.com/watchfire-io/watchfire/internal/models"
 	pb "github.com/watchfire-io/watchfire/proto"
@@ -79,7 +80,7 @@ func saveTelegramSendConfig(t *testing.T, chats ...models.TelegramPairedChat) {
 }
 
 // TestTestIntegrationTelegramDeliversPerChat asserts the TELEGRAM test
-// sends all three synthetic kinds to every non-muted paired chat, skips
+// sends every synthetic kind to every non-muted paired chat, skips
 // muted chats, and reports per-chat success.
 func TestTestIntegrationTelegramDeliversPerChat(t *testing.T) {
 	tgTestSetup(t)
@@ -102,11 +103,11 @@ func TestTestIntegrationTelegramDeliversPerChat(t *testing.T) {
 	}
 	for _, chatID := range []int64{111, 333} {
 		texts := api.TextsFor(chatID)
-		if len(texts) != 3 {
-			t.Fatalf("chat %d: want 3 messages (one per kind), got %d", chatID, len(texts))
+		if len(texts) != len(notify.Kinds) {
+			t.Fatalf("chat %d: want %d messages (one per kind), got %d", chatID, len(notify.Kinds), len(texts))
 		}
 		joined := strings.Join(texts, "\n---\n")
-		for _, want := range []string{"Task failed", "Run complete", "your week"} {
+		for _, want := range []string{"Task failed", "Run complete", "your week", "monthly budget used", "Merge failed", "3 new tasks"} {
 			if !strings.Contains(joined, want) {
 				t.Errorf("chat %d: missing %q in delivered texts:\n%s", chatID, want, joined)
 			}
@@ -153,7 +154,7 @@ func TestTestIntegrationTelegramReportsPartialFailure(t *testing.T) {
 	if !strings.Contains(msg, "@nuno: OK") {
 		t.Fatalf("healthy chat should still report OK, got %q", msg)
 	}
-	if got := api.TextsFor(111); len(got) != 3 {
-		t.Fatalf("healthy chat should receive all 3 kinds, got %d", len(got))
+	if got := api.TextsFor(111); len(got) != len(notify.Kinds) {
+		t.Fatalf("healthy chat should receive all %d kinds, got %d", len(notify.Kinds), len(got))
 	}
 }
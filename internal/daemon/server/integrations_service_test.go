@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
@@ -301,9 +302,9 @@ func TestTestIntegrationDelivers(t *testing.T) {
 
 	// The webhook adapter routes each test through the v7.0 Relay
 	// `relay.WebhookAdapter`, which fires one POST per notification
-	// kind (TASK_FAILED, RUN_COMPLETE, WEEKLY_DIGEST) so every wire
-	// shape is exercised in a single command. Aggregate response is
-	// ok=true only when every POST returns 2xx.
+	// kind (TASK_FAILED, RUN_COMPLETE, WEEKLY_DIGEST, BUDGET_THRESHOLD)
+	// so every wire shape is exercised in a single command. Aggregate
+	// response is ok=true only when every POST returns 2xx.
 	resp, err := svc.TestIntegration(ctx, &pb.TestIntegrationRequest{
 		Kind: pb.IntegrationKind_WEBHOOK, Id: "ok",
 	})
@@ -326,8 +327,8 @@ func TestTestIntegrationDelivers(t *testing.T) {
 }
 
 // TestTestIntegrationDiscordPostsAllKinds asserts that calling
-// TestIntegration with a Discord endpoint POSTs three payloads (one per
-// notification kind) — each parses as a Discord webhook envelope — and
+// TestIntegration with a Discord endpoint POSTs one payload per
+// notification kind — each parses as a Discord webhook envelope — and
 // the response message names every kind. Pinned by the v7.0 task 0064
 // acceptance criterion: "POSTs each notification kind through with a
 // valid Discord webhook payload".
@@ -382,10 +383,10 @@ func TestTestIntegrationDiscordPostsAllKinds(t *testing.T) {
 
 	mu.Lock()
 	defer mu.Unlock()
-	if len(calls) != 3 {
-		t.Fatalf("want 3 POSTs (one per kind), got %d", len(calls))
+	if len(calls) != len(notify.Kinds) {
+		t.Fatalf("want %d POSTs (one per kind), got %d", len(notify.Kinds), len(calls))
 	}
-	gotTitles := make([]string, 0, 3)
+	gotTitles := make([]string, 0, len(notify.Kinds))
 	for _, c := range calls {
 		if len(c.Embeds) != 1 {
 			t.Errorf("each POST should carry exactly one embed, got %d", len(c.Embeds))
@@ -396,9 +397,17 @@ func TestTestIntegrationDiscordPostsAllKinds(t *testing.T) {
 	}
 	sort.Strings(gotTitles)
 	wantTitles := []string{
+		"3 new tasks — Watchfire test",
+		"80% of the monthly budget used — Watchfire test",
+		"Agent needs to sign in — Watchfire test",
+		"Merge failed — Watchfire test",
+		"PR opened — Watchfire test",
+		"Rate limited — Watchfire test",
 		"Run complete — Watchfire test",
 		"Task failed — Watchfire test",
+		"Task succeeded — Watchfire test",
 		"Watchfire — your week",
+		"Wildfire — Watchfire test",
 	}
 	if len(gotTitles) != len(wantTitles) {
 		t.Fatalf("titles len = %d, want %d (got %v)", len(gotTitles), len(wantTitles), gotTitles)
@@ -411,8 +420,8 @@ func TestTestIntegrationDiscordPostsAllKinds(t *testing.T) {
 }
 
 // TestTestIntegrationSlackPostsAllKinds asserts that calling
-// TestIntegration with a Slack endpoint POSTs three Block Kit messages
-// (one per notification kind) — each parses as a Block Kit envelope with
+// TestIntegration with a Slack endpoint POSTs one Block Kit message
+// per notification kind — each parses as a Block Kit envelope with
 // the expected header text — and the response message names every kind.
 // Pinned by the v7.0 task 0063 acceptance criterion: "POSTs each
 // notification kind through with the expected Block Kit body".
@@ -482,14 +491,22 @@ func TestTestIntegrationSlackPostsAllKinds(t *testing.T) {
 
 	mu.Lock()
 	defer mu.Unlock()
-	if len(titles) != 3 {
-		t.Fatalf("want 3 POSTs (one per kind), got %d", len(titles))
+	if len(titles) != len(notify.Kinds) {
+		t.Fatalf("want %d POSTs (one per kind), got %d", len(notify.Kinds), len(titles))
 	}
 	sort.Strings(titles)
 	wantTitles := []string{
 		":bar_chart: Watchfire — your week",
+		":fire: Wildfire — Watchfire test",
+		":hourglass_flowing_sand: Rate limited — Watchfire test",
+		":key: Agent needs to sign in — Watchfire test",
+		":money_with_wings: 80% of the monthly budget used — Watchfire test",
 		":rotating_light: Task failed — Watchfire test",
+		":sparkles: 3 new tasks — Watchfire test",
+		":twisted_rightwards_arrows: PR opened — Watchfire test",
+		":warning: Merge failed — Watchfire test",
 		":white_check_mark: Run complete — Watchfire test",
+		":white_check_mark: Task succeeded — Watchfire test",
 	}
 	for i, want := range wantTitles {
 		if titles[i] != want {
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/daemon/relay"
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// templateOriginRequest marks a preview of the source sent in the
+// request rather than one read from disk.
+const templateOriginRequest = "request"
+
+// PreviewTemplate renders a relay message template against the sample
+// payload for one event. A template that fails validation is reported in
+// the response (ok=false) rather than as an RPC error so editors can
+// show it inline; with save set, only a template that validates is
+// written, after which the dispatcher reloads its adapters.
+func (s *integrationsService) PreviewTemplate(_ context.Context, req *pb.PreviewTemplateRequest) (*pb.PreviewTemplateResponse, error) {
+	channel := strings.ToLower(strings.TrimSpace(req.GetChannel()))
+	if !slices.Contains(relay.TemplateChannels, channel) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown template channel %q (want one of: %s)", req.GetChannel(), strings.Join(relay.TemplateChannels, ", "))
+	}
+	kind, ok := notify.KindForEventKey(strings.TrimSpace(req.GetEvent()))
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown event %q (want one of: %s)", req.GetEvent(), templateEventKeys())
+	}
+	dir, err := config.GlobalRelayTemplatesDir()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "resolve templates dir: %v", err)
+	}
+	path, err := relay.TemplateOverridePath(dir, channel, req.GetEndpointId(), kind)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	out := &pb.PreviewTemplateResponse{Source: req.GetSource(), Origin: templateOriginRequest}
+	if out.Source == "" {
+		if req.GetSave() {
+			return nil, status.Error(codes.InvalidArgument, "a template source is required to save")
+		}
+		out.Source, out.Origin, out.Path, err = relay.ResolveTemplate(dir, channel, req.GetEndpointId(), kind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "read template: %v", err)
+		}
+	}
+
+	_, rendered, err := relay.ValidateTemplate(channel, kind, out.Source)
+	if err != nil {
+		out.Error = err.Error()
+		return out, nil
+	}
+	out.Ok = true
+	out.Rendered = string(rendered)
+	if !req.GetSave() {
+		return out, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, status.Errorf(codes.Internal, "create templates dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(out.Source), 0o644); err != nil {
+		return nil, status.Errorf(codes.Internal, "write template: %v", err)
+	}
+	out.Path = path
+	out.Saved = true
+	if d, err := s.relayDispatcher(); err == nil {
+		d.Reload()
+	}
+	return out, nil
+}
+
+// templateEventKeys lists the event keys PreviewTemplate accepts, for
+// error messages.
+func templateEventKeys() string {
+	keys := make([]string, 0, len(notify.Kinds))
+	for _, k := range notify.Kinds {
+		keys = append(keys, k.EventKey())
+	}
+	return strings.Join(keys, ", ")
+}
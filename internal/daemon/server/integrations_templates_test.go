@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/watchfire-io/watchfire/proto"
+)
+
+// TestPreviewTemplate covers previewing the built-in, rejecting an
+// invalid source without saving it, and saving a valid per-endpoint
+// override that later previews resolve to.
+func TestPreviewTemplate(t *testing.T) {
+	withTempHomeIntegrations(t)
+	svc := newIntegrationsService()
+	ctx := context.Background()
+
+	resp, err := svc.PreviewTemplate(ctx, &pb.PreviewTemplateRequest{Channel: "slack", Event: "task_failed"})
+	if err != nil {
+		t.Fatalf("PreviewTemplate(builtin): %v", err)
+	}
+	if !resp.GetOk() || resp.GetOrigin() != "builtin" || !strings.Contains(resp.GetRendered(), "Sample task") {
+		t.Fatalf("unexpected builtin preview: %+v", resp)
+	}
+
+	resp, err = svc.PreviewTemplate(ctx, &pb.PreviewTemplateRequest{
+		Channel: "slack", Event: "task_failed", EndpointId: "ep-1",
+		Source: `{"text": {{.ProjectName}}}`, Save: true,
+	})
+	if err != nil {
+		t.Fatalf("PreviewTemplate(invalid): %v", err)
+	}
+	if resp.GetOk() || resp.GetSaved() || resp.GetError() == "" {
+		t.Fatalf("an invalid template must be reported and not saved: %+v", resp)
+	}
+
+	src := `{"text": {{jsonStr (printf "custom: %s" .TaskTitle)}}}`
+	resp, err = svc.PreviewTemplate(ctx, &pb.PreviewTemplateRequest{
+		Channel: "slack", Event: "task_failed", EndpointId: "ep-1", Source: src, Save: true,
+	})
+	if err != nil {
+		t.Fatalf("PreviewTemplate(save): %v", err)
+	}
+	if !resp.GetOk() || !resp.GetSaved() || resp.GetRendered() != `{"text": "custom: Sample task"}` {
+		t.Fatalf("unexpected save response: %+v", resp)
+	}
+	home, _ := os.UserHomeDir()
+	want := filepath.Join(home, ".watchfire", "templates", "relay", "ep-1", "slack_task_failed.json.tmpl")
+	if resp.GetPath() != want {
+		t.Errorf("saved to %q, want %q", resp.GetPath(), want)
+	}
+	if data, err := os.ReadFile(want); err != nil || string(data) != src {
+		t.Errorf("override file = %q, %v", data, err)
+	}
+
+	resp, err = svc.PreviewTemplate(ctx, &pb.PreviewTemplateRequest{Channel: "slack", Event: "task_failed", EndpointId: "ep-1"})
+	if err != nil || resp.GetOrigin() != "endpoint" || resp.GetSource() != src {
+		t.Errorf("preview after save = %+v, %v", resp, err)
+	}
+
+	for _, req := range []*pb.PreviewTemplateRequest{
+		{Channel: "email", Event: "task_failed"},
+		{Channel: "slack", Event: "nope"},
+		{Channel: "slack", Event: "task_failed", EndpointId: "../x"},
+		{Channel: "slack", Event: "task_failed", Save: true},
+	} {
+		if _, err := svc.PreviewTemplate(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%+v: want InvalidArgument, got %v", req, err)
+		}
+	}
+}
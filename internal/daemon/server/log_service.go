@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,9 +15,15 @@ import (
 
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/project"
+	"github.com/watchfire-io/watchfire/internal/logsearch"
+	"github.com/watchfire-io/watchfire/internal/models"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
+// recordingChunkSize bounds each GetRecording message. Recordings of long
+// sessions run to tens of megabytes, well past gRPC's 4 MiB default.
+const recordingChunkSize = 256 * 1024
+
 type logService struct {
 	pb.UnimplementedLogServiceServer
 	projectMgr *project.Manager
@@ -29,17 +37,7 @@ func (s *logService) ListLogs(_ context.Context, req *pb.ListLogsRequest) (*pb.L
 
 	list := &pb.LogList{Logs: make([]*pb.LogEntry, 0, len(logs))}
 	for _, l := range logs {
-		list.Logs = append(list.Logs, &pb.LogEntry{
-			LogId:         l.LogID,
-			ProjectId:     l.ProjectID,
-			TaskNumber:    int32(l.TaskNumber),
-			SessionNumber: int32(l.SessionNumber),
-			Agent:         l.Agent,
-			Mode:          l.Mode,
-			StartedAt:     l.StartedAt,
-			EndedAt:       l.EndedAt,
-			Status:        l.Status,
-		})
+		list.Logs = append(list.Logs, logEntryToProto(l))
 	}
 	return list, nil
 }
@@ -51,17 +49,7 @@ func (s *logService) GetLog(_ context.Context, req *pb.GetLogRequest) (*pb.LogCo
 	}
 
 	return &pb.LogContent{
-		Entry: &pb.LogEntry{
-			LogId:         entry.LogID,
-			ProjectId:     entry.ProjectID,
-			TaskNumber:    int32(entry.TaskNumber),
-			SessionNumber: int32(entry.SessionNumber),
-			Agent:         entry.Agent,
-			Mode:          entry.Mode,
-			StartedAt:     entry.StartedAt,
-			EndedAt:       entry.EndedAt,
-			Status:        entry.Status,
-		},
+		Entry:   logEntryToProto(entry),
 		Content: strings.ToValidUTF8(content, "\uFFFD"),
 	}, nil
 }
@@ -86,6 +74,185 @@ func (s *logService) DeleteLog(_ context.Context, req *pb.DeleteLogRequest) (*em
 		}
 		return nil, status.Errorf(codes.Internal, "failed to delete log: %v", err)
 	}
+	if err := logsearch.RemoveLog(req.ProjectId, req.LogId); err != nil {
+		config.ProjectLogf(req.ProjectId, "[logsearch] Failed to drop %s from index: %v", req.LogId, err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// GetRecording streams the asciicast recording saved for a session log.
+func (s *logService) GetRecording(req *pb.GetRecordingRequest, stream pb.LogService_GetRecordingServer) error {
+	if strings.TrimSpace(req.ProjectId) == "" {
+		return status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	if strings.TrimSpace(req.LogId) == "" || strings.ContainsAny(req.LogId, `/\`) {
+		return status.Error(codes.InvalidArgument, "invalid log_id")
+	}
+
+	f, err := config.OpenRecording(req.ProjectId, req.LogId)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	buf := make([]byte, recordingChunkSize)
+	for {
+		n, readErr := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.RecordingChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return status.Errorf(codes.Internal, "failed to read recording: %v", readErr)
+		}
+	}
+}
+
+// SearchLogs runs a full-text query over session logs.
+func (s *logService) SearchLogs(_ context.Context, req *pb.SearchLogsRequest) (*pb.SearchLogsResponse, error) {
+	since, err := parseSearchDate(req.Since, false)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid since %q: %v", req.Since, err)
+	}
+	until, err := parseSearchDate(req.Until, true)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid until %q: %v", req.Until, err)
+	}
+
+	hits, err := logsearch.Search(req.Query, logsearch.Filter{
+		ProjectIDs: req.ProjectIds,
+		Agent:      req.Agent,
+		Mode:       req.Mode,
+		Status:     req.Status,
+		TaskNumber: int(req.TaskNumber),
+		Since:      since,
+		Until:      until,
+	}, int(req.Limit))
+	if errors.Is(err, logsearch.ErrEmptyQuery) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "search failed: %v", err)
+	}
+
+	names := map[string]string{}
+	if index, err := config.LoadProjectsIndex(); err == nil {
+		for _, p := range index.Projects {
+			names[p.ProjectID] = p.Name
+		}
+	}
+	resp := &pb.SearchLogsResponse{Hits: make([]*pb.LogSearchHit, 0, len(hits))}
+	for _, h := range hits {
+		snippets := make([]string, len(h.Snippets))
+		for i, sn := range h.Snippets {
+			snippets[i] = strings.ToValidUTF8(sn, "\uFFFD")
+		}
+		resp.Hits = append(resp.Hits, &pb.LogSearchHit{
+			Entry:       logEntryToProto(h.Entry),
+			ProjectName: names[h.Entry.ProjectID],
+			Score:       h.Score,
+			Snippets:    snippets,
+		})
+	}
+	return resp, nil
+}
+
+// GetSessionEvents returns the normalized event log of a session,
+// optionally narrowed to a set of event types.
+func (s *logService) GetSessionEvents(_ context.Context, req *pb.GetSessionEventsRequest) (*pb.SessionEventList, error) {
+	if strings.TrimSpace(req.ProjectId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	if strings.TrimSpace(req.LogId) == "" || strings.ContainsAny(req.LogId, `/\`) {
+		return nil, status.Error(codes.InvalidArgument, "invalid log_id")
+	}
+	if !config.HasSessionEvents(req.ProjectId, req.LogId) {
+		return nil, status.Errorf(codes.NotFound, "no event log for log %s", req.LogId)
+	}
+
+	events, err := config.ReadSessionEvents(req.ProjectId, req.LogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read event log: %v", err)
+	}
+	want := make(map[string]bool, len(req.Types))
+	for _, t := range req.Types {
+		want[t] = true
+	}
+	resp := &pb.SessionEventList{}
+	for i := range events {
+		if len(want) > 0 && !want[string(events[i].Type)] {
+			continue
+		}
+		resp.Events = append(resp.Events, sessionEventToProto(&events[i]))
+	}
+	return resp, nil
+}
+
+// parseSearchDate accepts RFC3339 or a local YYYY-MM-DD. A bare date used
+// as an upper bound means "through the end of that day".
+func parseSearchDate(s string, endOfDay bool) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected RFC3339 or YYYY-MM-DD")
+	}
+	if endOfDay {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d, nil
+}
+
+func logEntryToProto(l *models.LogEntry) *pb.LogEntry {
+	return &pb.LogEntry{
+		LogId:         l.LogID,
+		ProjectId:     l.ProjectID,
+		TaskNumber:    int32(l.TaskNumber),
+		SessionNumber: int32(l.SessionNumber),
+		Agent:         l.Agent,
+		Mode:          l.Mode,
+		StartedAt:     l.StartedAt,
+		EndedAt:       l.EndedAt,
+		Status:        l.Status,
+		HasTranscript: l.HasTranscript,
+		HasRecording:  l.HasRecording,
+		HasEvents:     l.HasEvents,
+	}
+}
+
+func sessionEventToProto(e *models.SessionEvent) *pb.SessionEvent {
+	out := &pb.SessionEvent{
+		Seq:             int32(e.Seq),
+		Type:            string(e.Type),
+		Text:            e.Text,
+		Tool:            e.Tool,
+		CallId:          e.CallID,
+		Args:            e.Args,
+		Result:          e.Result,
+		IsError:         e.IsError,
+		Path:            e.Path,
+		EditKind:        e.EditKind,
+		Command:         e.Command,
+		TokensIn:        e.TokensIn,
+		TokensOut:       e.TokensOut,
+		CacheReadTokens: e.CacheReadTokens,
+	}
+	if e.Time != nil {
+		out.Time = e.Time.Format(time.RFC3339Nano)
+	}
+	if e.ExitCode != nil {
+		code := int32(*e.ExitCode)
+		out.ExitCode = &code
+	}
+	return out
+}
@@ -42,6 +42,12 @@ func (s *notificationService) Subscribe(_ *pb.SubscribeNotificationsRequest, str
 				Body:       n.Body,
 				EmittedAt:  timestamppb.New(n.EmittedAt),
 				Kind:       notifyKindToProto(n.Kind),
+
+				Detail:        n.Detail,
+				Url:           n.URL,
+				Phase:         n.Phase,
+				PreviousPhase: n.PreviousPhase,
+				Count:         int32(n.Count),
 			}); err != nil {
 				return err
 			}
@@ -49,15 +55,11 @@ func (s *notificationService) Subscribe(_ *pb.SubscribeNotificationsRequest, str
 	}
 }
 
+// notifyKindToProto relies on the proto enum names matching notify.Kind
+// string for string; an unknown kind falls back to TASK_FAILED.
 func notifyKindToProto(k notify.Kind) pb.NotificationKind {
-	switch k {
-	case notify.KindRunComplete:
-		return pb.NotificationKind_RUN_COMPLETE
-	case notify.KindTaskFailed:
-		return pb.NotificationKind_TASK_FAILED
-	case notify.KindWeeklyDigest:
-		return pb.NotificationKind_WEEKLY_DIGEST
-	default:
-		return pb.NotificationKind_TASK_FAILED
+	if v, ok := pb.NotificationKind_value[string(k)]; ok {
+		return pb.NotificationKind(v)
 	}
+	return pb.NotificationKind_TASK_FAILED
 }
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"log"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/prom"
+)
+
+// registerRunningAgentsGauge exposes the agent manager's live agents as
+// watchfire_agents_running{mode,backend}. Computed at scrape time, so no
+// start / stop path needs to keep a gauge in step.
+func (s *Server) registerRunningAgentsGauge() {
+	prom.Default.NewGaugeFunc("watchfire_agents_running",
+		"Agents currently running, by mode and backend.",
+		[]string{"mode", "backend"}, s.runningAgentSamples)
+}
+
+func (s *Server) runningAgentSamples() []prom.Sample {
+	type key struct{ mode, backend string }
+	counts := make(map[key]int)
+	for _, a := range s.agentManager.ListAgents() {
+		counts[key{string(a.Mode), a.BackendName}]++
+	}
+	out := make([]prom.Sample, 0, len(counts))
+	for k, n := range counts {
+		out = append(out, prom.Sample{Values: []string{k.mode, k.backend}, Value: float64(n)})
+	}
+	return out
+}
+
+// applyMetricsEndpoint starts, stops or rebinds the Prometheus endpoint
+// to match settings.yaml `metrics_endpoint`. Runs at startup and on every
+// settings write; a no-op when the effective listen address is unchanged.
+// A bind failure is logged and left alone until the config changes again.
+func (s *Server) applyMetricsEndpoint() {
+	settings, err := config.LoadSettings()
+	if err != nil {
+		log.Printf("[prom] Failed to load settings: %v", err)
+		return
+	}
+	addr := ""
+	if cfg := settings.MetricsEndpoint; cfg != nil && cfg.Enabled {
+		addr = cfg.Listen
+	}
+
+	s.metricsMu.Lock()
+	defer s.metricsMu.Unlock()
+	if addr == s.metricsAddr {
+		return
+	}
+	if s.metricsCancel != nil {
+		s.metricsCancel()
+		s.metricsCancel = nil
+		log.Printf("[prom] Prometheus endpoint on %s stopped", s.metricsAddr)
+	}
+	s.metricsAddr = addr
+	if addr == "" {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.metricsCancel = cancel
+	go func() {
+		if err := prom.Serve(ctx, addr, prom.Default); err != nil {
+			log.Printf("ERROR: [prom] %v", err)
+		}
+	}()
+}
+
+// stopMetricsEndpoint shuts the endpoint down, if it is running.
+func (s *Server) stopMetricsEndpoint() {
+	s.metricsMu.Lock()
+	defer s.metricsMu.Unlock()
+	if s.metricsCancel != nil {
+		s.metricsCancel()
+		s.metricsCancel = nil
+	}
+	s.metricsAddr = ""
+}
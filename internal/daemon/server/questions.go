@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/agent"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/watcher"
+)
+
+// answerEnterDelay separates the typed answer from its Enter, as the
+// Telegram bridge does for /say: Claude Code treats text+\r arriving in
+// one write as a paste and leaves it in the input box.
+const answerEnterDelay = 150 * time.Millisecond
+
+// handleQuestionAsked reacts to an agent writing question.yaml: the
+// question is posted to chat, raised as an awaiting_input issue for the
+// GUI / TUI, and the task's clock stops until the answer arrives or the
+// session ends.
+func (s *Server) handleQuestionAsked(event watcher.Event) {
+	config.ProjectLogf(event.ProjectID, "[ask] question signal detected")
+
+	running, ok := s.agentManager.GetAgent(event.ProjectID)
+	if !ok || running.Mode == agent.ModeChat || s.questions == nil {
+		// Nobody to wait for an answer — or, in chat mode, the person
+		// is already at the keyboard.
+		config.ProjectLogf(event.ProjectID, "[ask] No working agent to hold the question — ignoring")
+		_ = os.Remove(event.Path)
+		return
+	}
+
+	f, err := ask.ReadFile(event.Path)
+	if err != nil {
+		// Possibly half-written; the next write fires another event.
+		config.ProjectLogf(event.ProjectID, "[ask] %v", err)
+		return
+	}
+	if err := os.Remove(event.Path); err != nil {
+		config.ProjectLogf(event.ProjectID, "[ask] Failed to delete signal file %s: %v", event.Path, err)
+	}
+
+	proc := running.Process
+	q, err := s.questions.Ask(context.Background(), ask.Question{
+		ProjectID:   running.ProjectID,
+		ProjectName: running.ProjectName,
+		TaskNumber:  running.TaskNumber,
+		TaskTitle:   running.TaskTitle,
+		Agent:       running.BackendName,
+		Text:        f.Question,
+		Options:     f.Options,
+		Session:     proc,
+	})
+	if err != nil {
+		// Still pending: the GUI, TUI and CLI can answer it.
+		config.ProjectLogf(event.ProjectID, "[ask] %v", err)
+	}
+	config.ProjectLogf(event.ProjectID, "[ask] Question %d asked (task #%04d): %q", q.ID, q.TaskNumber, q.Text)
+
+	proc.SetIssue(&agent.AgentIssue{
+		Type:       agent.AgentIssueAwaitingInput,
+		DetectedAt: q.AskedAt,
+		Message:    q.Text,
+		Options:    q.Options,
+	})
+	s.setTaskClock(q.ProjectID, running.ProjectPath, q.TaskNumber, true)
+
+	// A session that ends unanswered withdraws its question.
+	go func() {
+		<-proc.Done()
+		if s.questions.Withdraw(q.ProjectID, q.ID) {
+			config.ProjectLogf(q.ProjectID, "[ask] Question %d withdrawn — session ended", q.ID)
+			s.setTaskClock(q.ProjectID, running.ProjectPath, q.TaskNumber, false)
+		}
+	}()
+}
+
+// deliverAnswer is the ask.Deliverer: it types the answer into the
+// session that asked, clears the awaiting_input issue and restarts the
+// task's clock. A session other than the asking one — even on the same
+// task — never receives the answer.
+func (s *Server) deliverAnswer(q ask.Question, r ask.Reply) error {
+	running, ok := s.agentManager.GetAgent(q.ProjectID)
+	if !ok || running.TaskNumber != q.TaskNumber || running.Process != q.Session {
+		return ask.ErrNotPending
+	}
+	proc := running.Process
+	// One printable line: a newline would submit a partial answer, and
+	// control runes (escape sequences, ^C) would drive the agent's TUI.
+	text := strings.Map(printableRune, strings.Join(strings.Fields(r.Text), " "))
+	if err := proc.SendInput([]byte(text)); err != nil {
+		return fmt.Errorf("type answer: %w", err)
+	}
+	time.Sleep(answerEnterDelay)
+	if err := proc.SendInput([]byte("\r")); err != nil {
+		return fmt.Errorf("submit answer: %w", err)
+	}
+
+	if issue := proc.GetIssue(); issue != nil && issue.Type == agent.AgentIssueAwaitingInput {
+		proc.ClearIssue()
+	}
+	s.setTaskClock(q.ProjectID, running.ProjectPath, q.TaskNumber, false)
+	actor := r.Actor
+	if actor == "" {
+		actor = "unknown"
+	}
+	config.ProjectLogf(q.ProjectID, "[ask] Question %d answered by %s", q.ID, actor)
+	return nil
+}
+
+// printableRune is a strings.Map func keeping only printable runes.
+func printableRune(r rune) rune {
+	if unicode.IsPrint(r) {
+		return r
+	}
+	return -1
+}
+
+// setTaskClock pauses (or resumes) the clock of the task that asked, so
+// the wait for a person doesn't count towards its duration. Sessions
+// outside a task have no clock.
+func (s *Server) setTaskClock(projectID, projectPath string, taskNumber int, pause bool) {
+	if taskNumber <= 0 || projectPath == "" {
+		return
+	}
+	t, err := config.LoadTask(projectPath, taskNumber)
+	if err != nil || t == nil {
+		return
+	}
+	now := time.Now()
+	if pause {
+		t.PauseClock(now)
+	} else if !t.ResumeClock(now) {
+		return
+	}
+	if err := config.SaveTask(projectPath, t); err != nil {
+		config.ProjectLogf(projectID, "[ask] task #%04d: save clock: %v", taskNumber, err)
+	}
+}
@@ -2,9 +2,11 @@ package server
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/agent/backend"
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	"github.com/watchfire-io/watchfire/internal/daemon/relay"
 	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
@@ -30,11 +32,11 @@ var relayHTTPClient = &http.Client{Timeout: 10 * time.Second}
 // EventIntegrationsChanged event).
 //
 // Webhook endpoints carry an HMAC secret resolved from the keyring
-// here; Slack and Discord URLs were already loaded by
-// `config.LoadIntegrations` (their URL is itself the secret). A miss
-// in either case is logged and the adapter is built with an empty
-// secret — Send will then refuse loudly rather than send unsigned /
-// to a broken URL.
+// here; Slack / Discord / Teams URLs, Matrix / ntfy tokens and SMTP
+// passwords were already loaded by `config.LoadIntegrations`. A miss in
+// either case is logged and the adapter is built with an empty secret —
+// Send will then refuse loudly rather than send unsigned / to a broken
+// URL.
 func buildRelayAdapters() ([]relay.Adapter, error) {
 	cfg, err := config.LoadIntegrations()
 	if err != nil {
@@ -42,7 +44,7 @@ func buildRelayAdapters() ([]relay.Adapter, error) {
 	}
 
 	out := make([]relay.Adapter, 0,
-		len(cfg.Webhooks)+len(cfg.Slack)+len(cfg.Discord))
+		len(cfg.Webhooks)+len(cfg.Slack)+len(cfg.Discord)+len(cfg.Teams)+len(cfg.Matrix)+len(cfg.Ntfy)+len(cfg.Email))
 
 	for _, ep := range cfg.Webhooks {
 		secret := lookupWebhookSecret(ep)
@@ -62,6 +64,30 @@ func buildRelayAdapters() ([]relay.Adapter, error) {
 		}
 		out = append(out, a)
 	}
+	for _, ep := range cfg.Teams {
+		a, err := relay.NewTeamsAdapter(ep, relayHTTPClient, nil)
+		if err != nil {
+			continue
+		}
+		out = append(out, a)
+	}
+	for _, ep := range cfg.Matrix {
+		a, err := relay.NewMatrixAdapter(ep, relayHTTPClient, nil)
+		if err != nil {
+			continue
+		}
+		out = append(out, a)
+	}
+	for _, ep := range cfg.Ntfy {
+		a, err := relay.NewNtfyAdapter(ep, relayHTTPClient, nil)
+		if err != nil {
+			continue
+		}
+		out = append(out, a)
+	}
+	for _, ep := range cfg.Email {
+		out = append(out, relay.NewEmailAdapter(ep, nil))
+	}
 	// Telegram (v10.0 Torch) is single-instance: register the adapter
 	// only when the bridge is actually deliverable — enabled, token
 	// resolved from the keyring, and at least one paired chat. The
@@ -74,6 +100,18 @@ func buildRelayAdapters() ([]relay.Adapter, error) {
 	return out, nil
 }
 
+// buildRelayRoutes compiles the `routes:` rules in integrations.yaml.
+// It is the dispatcher's RouteFactory, re-run on every Reload; an
+// invalid rule set is logged by the dispatcher and the previous rules
+// stay in effect.
+func buildRelayRoutes() (*relay.Router, error) {
+	cfg, err := config.LoadIntegrations()
+	if err != nil {
+		return nil, err
+	}
+	return relay.CompileRoutes(cfg.Routes)
+}
+
 func lookupWebhookSecret(ep models.WebhookEndpoint) []byte {
 	if ep.SecretRef == "" {
 		return nil
@@ -87,9 +125,10 @@ func lookupWebhookSecret(ep models.WebhookEndpoint) []byte {
 
 // resolveNotificationPayload converts a notify.Notification into a
 // fully-populated relay.Payload. Project name + color come from the
-// projects index; task title + failure reason come from the on-disk
-// task YAML. WEEKLY_DIGEST notifications carry their digest path /
-// body in the notification's Body field, so we copy that across.
+// projects index; task title, failure reason, agent backend and run
+// duration come from the on-disk task YAML. WEEKLY_DIGEST notifications
+// carry their digest path / body in the notification's Body field, so
+// we copy that across.
 func resolveNotificationPayload(n notify.Notification) (relay.Payload, error) {
 	in := relay.PayloadInput{Notification: n}
 
@@ -98,7 +137,8 @@ func resolveNotificationPayload(n notify.Notification) (relay.Payload, error) {
 		if index, err := config.LoadProjectsIndex(); err == nil {
 			if entry := index.FindProject(n.ProjectID); entry != nil {
 				in.ProjectName = entry.Name
-				if proj, perr := config.LoadProject(entry.Path); perr == nil && proj != nil {
+				proj, perr := config.LoadProject(entry.Path)
+				if perr == nil && proj != nil {
 					in.ProjectColor = proj.Color
 				}
 				// Resolve task metadata for task-bound kinds.
@@ -106,6 +146,8 @@ func resolveNotificationPayload(n notify.Notification) (relay.Payload, error) {
 					if t, terr := config.LoadTask(entry.Path, int(n.TaskNumber)); terr == nil && t != nil {
 						in.TaskTitle = t.Title
 						in.TaskFailureReason = t.FailureReason
+						in.Agent = taskBackendName(t.Agent, proj)
+						in.Duration = taskDuration(t, n.EmittedAt)
 					}
 				}
 			}
@@ -123,3 +165,33 @@ func resolveNotificationPayload(n notify.Notification) (relay.Payload, error) {
 
 	return relay.BuildPayload(in), nil
 }
+
+// taskBackendName resolves the agent backend a task runs on with the
+// agent manager's chain: task agent, project default, global default,
+// Claude. Routing rules match on it.
+func taskBackendName(taskAgent string, proj *models.Project) string {
+	name := strings.TrimSpace(taskAgent)
+	if name == "" && proj != nil {
+		name = strings.TrimSpace(proj.DefaultAgent)
+	}
+	if name == "" {
+		if settings, err := config.LoadSettings(); err == nil && settings != nil {
+			name = strings.TrimSpace(settings.Defaults.DefaultAgent)
+		}
+	}
+	if name == "" {
+		name = backend.ClaudeBackendName
+	}
+	return name
+}
+
+// taskDuration is how long the task has run: started → completed, or
+// started → the notification for a task still in flight, minus any time
+// spent waiting on a person. Zero when the task never started.
+func taskDuration(t *models.Task, emittedAt time.Time) time.Duration {
+	end := emittedAt
+	if t.CompletedAt != nil {
+		end = *t.CompletedAt
+	}
+	return t.ActiveDuration(end)
+}
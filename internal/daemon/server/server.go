@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -23,15 +24,21 @@ import (
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent/prompts"
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/budget"
 	"github.com/watchfire-io/watchfire/internal/daemon/discord"
 	"github.com/watchfire-io/watchfire/internal/daemon/echo"
 	"github.com/watchfire-io/watchfire/internal/daemon/insights"
+	"github.com/watchfire-io/watchfire/internal/daemon/janitor"
 	"github.com/watchfire-io/watchfire/internal/daemon/metrics"
 	"github.com/watchfire-io/watchfire/internal/daemon/notify"
 	"github.com/watchfire-io/watchfire/internal/daemon/project"
+	"github.com/watchfire-io/watchfire/internal/daemon/prom"
 	"github.com/watchfire-io/watchfire/internal/daemon/relay"
 	"github.com/watchfire-io/watchfire/internal/daemon/task"
 	"github.com/watchfire-io/watchfire/internal/daemon/telegram"
+	"github.com/watchfire-io/watchfire/internal/daemon/tracing"
 	"github.com/watchfire-io/watchfire/internal/daemon/tray"
 	"github.com/watchfire-io/watchfire/internal/daemon/watcher"
 	"github.com/watchfire-io/watchfire/internal/models"
@@ -51,10 +58,17 @@ type Server struct {
 	watcher        *watcher.Watcher
 	notifyBus      *notify.Bus
 	digestRunner   *digestRunner
+	janitor        *janitor.Janitor
 	relayDispatch  *relay.Dispatcher
 	relayCancel    context.CancelFunc
 	echoServer     *echo.Server
 	echoCancel     context.CancelFunc
+	// Opt-in Prometheus endpoint. metricsAddr is the address currently
+	// served ("" when off); guarded by metricsMu because settings writes
+	// re-apply it from the watcher loop.
+	metricsMu     sync.Mutex
+	metricsAddr   string
+	metricsCancel context.CancelFunc
 	// v8.x Echo — Discord slash-command auto-registrar. nil when the
 	// inbound config does not (yet) carry a Discord app id + bot token,
 	// or when the gateway client has been torn down for restart.
@@ -69,6 +83,15 @@ type Server struct {
 	telegramPairing *telegram.Pairing
 	integrationsSvc *integrationsService
 	updateState     UpdateState
+	// approvals holds finished tasks of `approval: required` projects
+	// until a chat user approves, rejects or retries the merge.
+	approvals *approval.Gate
+	// questions holds the question each working agent is waiting on
+	// (question.yaml) until someone answers it.
+	questions *ask.Desk
+	// chatWatch posts the screens of sessions a Slack workspace or
+	// Discord guild watches (the `watch` chat command).
+	chatWatch *chatWatch
 }
 
 // New creates a new server listening on the specified port.
@@ -132,8 +155,11 @@ func New(port int) (*Server, error) {
 	// TaskDoneOK. A TaskDoneMergeFailed outcome triggers
 	// emitTaskDoneFailure in the manager so the dashboard "needs attention"
 	// chip and the Pulse notification path both surface a stalled run-all.
-	agentMgr.SetOnTaskDoneFn(func(projectPath string, taskNumber int, worktreePath string) agent.TaskDoneResult {
-		return agent.HandleTaskDone(projectPath, taskNumber, worktreePath, notifyBus)
+	// Projects with `approval: required` wait on srv.approvals (built
+	// below, before any agent can finish) for a chat answer first.
+	var srv *Server
+	agentMgr.SetOnTaskDoneFn(func(ctx context.Context, projectPath string, taskNumber int, worktreePath string) agent.TaskDoneResult {
+		return agent.HandleTaskDone(ctx, projectPath, taskNumber, worktreePath, notifyBus, srv.approvals)
 	})
 
 	// Wire watch-project callback so chained agents re-watch the project
@@ -264,7 +290,7 @@ func New(port int) (*Server, error) {
 		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool { return true }),
 	)
 
-	srv := &Server{
+	srv = &Server{
 		grpcServer:     grpcServer,
 		grpcWebWrapper: grpcWebWrapper,
 		listener:       listener,
@@ -283,20 +309,51 @@ func New(port int) (*Server, error) {
 	// circuit-breaker. The factory re-reads integrations.yaml so the
 	// watcher's EventIntegrationsChanged path can call Reload() without
 	// daemon restart.
+	// Routing rules from the same file decide which endpoints a
+	// notification goes to before the default fan-out applies.
+	// Deliveries are persisted to ~/.watchfire/relay-outbox/ so a
+	// restart or laptop sleep mid-retry re-drives them instead of
+	// dropping them.
+	relayOpts := []relay.DispatcherOption{relay.WithRoutes(buildRelayRoutes)}
+	if outboxDir, err := config.GlobalRelayOutboxDir(); err == nil {
+		relayOpts = append(relayOpts, relay.WithOutbox(relay.NewOutbox(outboxDir)))
+	} else {
+		log.Printf("[relay] durable outbox disabled: %v", err)
+	}
+	// User template overrides (~/.watchfire/templates/relay/) are read
+	// each time the adapters are built, so a Reload picks up edits.
+	if templatesDir, err := config.GlobalRelayTemplatesDir(); err == nil {
+		relay.SetTemplateOverrideDir(templatesDir)
+	} else {
+		log.Printf("[relay] template overrides disabled: %v", err)
+	}
 	srv.relayDispatch = relay.NewDispatcher(
 		notifyBus,
 		resolveNotificationPayload,
 		buildRelayAdapters,
+		relayOpts...,
 	)
 	relayCtx, relayCancel := context.WithCancel(context.Background())
 	srv.relayCancel = relayCancel
 	go srv.relayDispatch.Run(relayCtx)
 
+	// Watched sessions' screens go out through the same adapters.
+	sessions := &agentSessionSource{mgr: agentMgr}
+	srv.chatWatch = newChatWatch(sessions.ActiveSession, srv.postChatWatch)
+	go srv.chatWatch.Run(relayCtx)
+
+	// Merge approvals are posted through the relay's chat adapters; the
+	// answers come back through the Echo / Telegram command contexts.
+	srv.approvals = approval.NewGate(srv.relayDispatch.PostApproval)
+	// Agent questions take the same route out, and their answers are
+	// typed into the asking session.
+	srv.questions = ask.NewDesk(srv.relayDispatch.PostQuestion, srv.deliverAnswer)
+
 	// Register services with generated proto descriptors
 	pb.RegisterProjectServiceServer(grpcServer, &projectService{manager: projectMgr, agentMgr: agentMgr})
 	pb.RegisterTaskServiceServer(grpcServer, &taskService{manager: taskMgr})
 	pb.RegisterDaemonServiceServer(grpcServer, &daemonService{server: srv})
-	pb.RegisterAgentServiceServer(grpcServer, &agentService{manager: agentMgr, watcher: w})
+	pb.RegisterAgentServiceServer(grpcServer, &agentService{manager: agentMgr, watcher: w, questions: srv.questions})
 	pb.RegisterLogServiceServer(grpcServer, &logService{projectMgr: projectMgr})
 	pb.RegisterBranchServiceServer(grpcServer, &branchService{})
 	pb.RegisterSettingsServiceServer(grpcServer, &settingsService{})
@@ -330,9 +387,30 @@ func New(port int) (*Server, error) {
 		srv.digestRunner.Start()
 	}
 
+	// Retention janitor: prunes old session logs, caches and stale
+	// watchfire/* branches every few hours. Gated per pass on
+	// settings.yaml `retention.enabled`.
+	srv.janitor = janitor.New(srv.taskHasAgent)
+	srv.janitor.Start()
+
+	// Prometheus /metrics endpoint. Off unless settings.yaml
+	// `metrics_endpoint.enabled` is set; re-applied on settings writes.
+	srv.registerRunningAgentsGauge()
+	srv.applyMetricsEndpoint()
+
+	// OpenTelemetry span export of the task lifecycle. Off unless
+	// settings.yaml `tracing.enabled` is set; re-applied on settings writes.
+	srv.applyTracing()
+
 	return srv, nil
 }
 
+// taskHasAgent reports whether an agent is currently running on the task.
+func (s *Server) taskHasAgent(projectID string, taskNumber int) bool {
+	a, ok := s.agentManager.GetAgent(projectID)
+	return ok && a.TaskNumber == taskNumber
+}
+
 // Port returns the port the server is listening on.
 func (s *Server) Port() int {
 	return s.port
@@ -379,6 +457,10 @@ func (s *Server) Stop() {
 	if s.digestRunner != nil {
 		s.digestRunner.Stop()
 	}
+	if s.janitor != nil {
+		s.janitor.Stop()
+	}
+	s.stopMetricsEndpoint()
 	// Stop the v7.0 Relay dispatcher before the bus drains so the
 	// goroutine exits cleanly. Cancel the run context first to nudge
 	// the in-flight Send out of any retry sleep, then wait for Stop().
@@ -407,6 +489,8 @@ func (s *Server) Stop() {
 	}
 	// Stop all running agents
 	s.agentManager.StopAll()
+	// Flush any spans still queued for export, the agents' included.
+	tracing.Shutdown()
 	// Shut down HTTP server (which serves both native gRPC and gRPC-Web)
 	if s.httpServer != nil {
 		_ = s.httpServer.Shutdown(context.Background())
@@ -712,6 +796,10 @@ func (s *Server) TelegramBridge() *telegram.Bridge { return s.telegramBridge }
 // TelegramPairing exposes the server-owned pairing manager.
 func (s *Server) TelegramPairing() *telegram.Pairing { return s.telegramPairing }
 
+// RelayDispatcher exposes the outbound relay dispatcher so the
+// IntegrationsService can list and replay durable-outbox deliveries.
+func (s *Server) RelayDispatcher() *relay.Dispatcher { return s.relayDispatch }
+
 // DiscordRegistrar exposes the Discord auto-registrar so the
 // IntegrationsService can surface per-guild registration status in
 // `GetInboundStatus`. Returns nil when the registrar has not been
@@ -756,6 +844,8 @@ func (s *Server) processWatcherEvents() {
 			s.handleGenerateModeEnded(event, agent.ModeGenerateTasks)
 		case watcher.EventRetrofitDone:
 			s.handleRetrofitDone(event)
+		case watcher.EventQuestionAsked:
+			s.handleQuestionAsked(event)
 		case watcher.EventGlobalSettingsChanged:
 			// Settings are read on demand by gates that consult them
 			// (notification dispatch, agent resolution, etc.) — config.LoadSettings
@@ -764,6 +854,11 @@ func (s *Server) processWatcherEvents() {
 			// daemon noticed the change, which is useful for diagnosing UX
 			// reports of "I changed it but nothing happened."
 			log.Printf("[settings-watch] Global settings changed: %s", event.Path)
+			// The metrics endpoint and the span exporter hold resources
+			// (a listener, an export pipeline), so unlike the gates above
+			// they have to be re-applied explicitly.
+			s.applyMetricsEndpoint()
+			s.applyTracing()
 		case watcher.EventMetricsChanged:
 			// v6.0 Ember per-task metrics file write — drop the per-project
 			// rollup (and cascade into the fleet `_global.json` cache) so
@@ -819,20 +914,30 @@ func (s *Server) handleTaskChanged(event watcher.Event) {
 		}
 	}
 
-	// Emit TASK_FAILED before stopping the agent. The emit is gated on
-	// success=false inside emitTaskFailed, and on firstDone here so the
-	// watcher event fired by our own completed_at re-save can't notify a
-	// second time.
+	// Emit TASK_FAILED / TASK_SUCCEEDED before stopping the agent. Each
+	// emit is gated on the task's success flag inside its helper, and on
+	// firstDone here so the watcher event fired by our own completed_at
+	// re-save can't notify a second time.
 	if firstDone {
 		projectName := s.projectNameForID(event.ProjectID)
 		emitTaskFailed(s.notifyBus, event.ProjectID, projectPath, projectName, t)
+		emitTaskSucceeded(s.notifyBus, event.ProjectID, projectPath, projectName, t)
 	}
 
 	// v6.0 Ember per-task metrics capture. Non-blocking and best-effort:
 	// the goroutine waits briefly for the session log to land, runs the
 	// per-backend parser, and persists `<n>.metrics.yaml`. Parser failure
-	// degrades to duration-only metrics with a WARN log line.
-	go metrics.CaptureFromTask(projectPath, event.ProjectID, t)
+	// degrades to duration-only metrics with a WARN log line. Only the
+	// first done transition feeds the Prometheus counters, for the same
+	// double-emit reason as TASK_FAILED above. A fresh capture is when
+	// monthly spend moves, so budget thresholds are checked right after.
+	go func() {
+		m := metrics.CaptureFromTask(projectPath, event.ProjectID, t)
+		if firstDone {
+			prom.ObserveTask(m)
+		}
+		budget.CheckThresholds(s.notifyBus, time.Now())
+	}()
 
 	// Use StopAgentForTask to atomically verify the agent is still working on
 	// this specific task before stopping. Run in a goroutine to avoid blocking
@@ -1150,3 +1255,23 @@ func (t *TrayState) DigestsDir() string {
 	}
 	return dir
 }
+
+// Budgets returns this month's budget standing — the same statuses
+// GetGlobalInsights reports — for the tray's budget rows and tooltip.
+func (t *TrayState) Budgets() []tray.BudgetInfo {
+	statuses, err := budget.Compute(time.Now())
+	if err != nil {
+		return nil
+	}
+	out := make([]tray.BudgetInfo, 0, len(statuses))
+	for _, s := range statuses {
+		out = append(out, tray.BudgetInfo{
+			Label:    s.Label(),
+			SpentUSD: s.SpentUSD,
+			LimitUSD: s.LimitUSD,
+			HardStop: s.HardStop,
+			Blocking: s.Blocking(),
+		})
+	}
+	return out
+}
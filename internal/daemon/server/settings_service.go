@@ -3,8 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/posthog/posthog-go"
 	"google.golang.org/grpc/codes"
@@ -113,6 +116,113 @@ func (s *settingsService) UpdateSettings(_ context.Context, req *pb.UpdateSettin
 		}
 	}
 
+	if req.Recordings != nil {
+		if req.Recordings.MaxAgeDays < 0 || req.Recordings.MaxPerProject < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "recording retention limits must be >= 0 (0 = unlimited)")
+		}
+		settings.Recordings = &models.RecordingsConfig{
+			Enabled:       req.Recordings.Enabled,
+			MaxAgeDays:    int(req.Recordings.MaxAgeDays),
+			MaxPerProject: int(req.Recordings.MaxPerProject),
+		}
+	}
+
+	if r := req.Retention; r != nil {
+		if r.MaxAgeDays < 0 || r.MaxLogs < 0 || r.MaxSizeMb < 0 || r.BranchMaxAgeDays < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "retention limits must be >= 0 (0 = unlimited)")
+		}
+		settings.Retention = &models.RetentionConfig{
+			Enabled:          r.Enabled,
+			MaxAgeDays:       int(r.MaxAgeDays),
+			MaxLogs:          int(r.MaxLogs),
+			MaxSizeMB:        int(r.MaxSizeMb),
+			BranchMaxAgeDays: int(r.BranchMaxAgeDays),
+		}
+	}
+
+	if m := req.MetricsEndpoint; m != nil {
+		listen := strings.TrimSpace(m.Listen)
+		if listen == "" {
+			listen = models.DefaultMetricsListen
+		}
+		if _, _, err := net.SplitHostPort(listen); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid metrics listen address %q: %v", m.Listen, err)
+		}
+		settings.MetricsEndpoint = &models.MetricsEndpointConfig{
+			Enabled: m.Enabled,
+			Listen:  listen,
+		}
+	}
+
+	if tr := req.Tracing; tr != nil {
+		exporter := tr.Exporter
+		if exporter == "" {
+			exporter = models.TracingExporterFile
+		}
+		if exporter != models.TracingExporterFile && exporter != models.TracingExporterOTLP {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown tracing exporter %q (want %q or %q)", tr.Exporter, models.TracingExporterFile, models.TracingExporterOTLP)
+		}
+		endpoint := strings.TrimSpace(tr.Endpoint)
+		if endpoint == "" {
+			endpoint = models.DefaultTracingEndpoint
+		}
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tracing endpoint %q: want an http(s) URL", tr.Endpoint)
+		}
+		settings.Tracing = &models.TracingConfig{
+			Enabled:  tr.Enabled,
+			Exporter: exporter,
+			Endpoint: endpoint,
+			Headers:  tr.Headers,
+			File:     strings.TrimSpace(tr.File),
+		}
+	}
+
+	if pr := req.Pricing; pr != nil {
+		prices := make([]models.TokenPrice, 0, len(pr.Prices))
+		seen := make(map[string]bool, len(pr.Prices))
+		for _, p := range pr.Prices {
+			name := strings.TrimSpace(p.Backend)
+			if _, ok := backend.Get(name); !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "pricing: unknown agent %q", p.Backend)
+			}
+			model := strings.TrimSpace(p.Model)
+			key := name + "\x00" + strings.ToLower(model)
+			if seen[key] {
+				return nil, status.Errorf(codes.InvalidArgument, "pricing: duplicate row for %s model %q", name, model)
+			}
+			seen[key] = true
+			if p.InputPerMtok < 0 || p.OutputPerMtok < 0 || p.CachedPerMtok < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "pricing: negative rate for %s model %q", name, model)
+			}
+			prices = append(prices, models.TokenPrice{
+				Backend:       name,
+				Model:         model,
+				InputPerMTok:  p.InputPerMtok,
+				OutputPerMTok: p.OutputPerMtok,
+				CachedPerMTok: p.CachedPerMtok,
+			})
+		}
+		settings.Pricing = &models.PricingConfig{Prices: prices}
+	}
+	if b := req.Budget; b != nil {
+		if b.MonthlyUsd < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "budget: monthly_usd must not be negative")
+		}
+		thresholds := make([]int, 0, len(b.Thresholds))
+		for _, t := range b.Thresholds {
+			if t <= 0 || t > 1000 {
+				return nil, status.Errorf(codes.InvalidArgument, "budget: threshold %d%% out of range (1-1000)", t)
+			}
+			thresholds = append(thresholds, int(t))
+		}
+		settings.Budget = &models.BudgetConfig{
+			MonthlyUSD: b.MonthlyUsd,
+			Thresholds: thresholds,
+			HardStop:   b.HardStop,
+		}
+	}
+
 	// Merge agent configs. Reject unknown agents so typos in the UI or
 	// stale clients don't silently pollute settings.yaml.
 	for name := range req.Agents {
@@ -318,3 +318,17 @@ func TestUpdateSettingsTerminalShellValidation(t *testing.T) {
 		t.Errorf("TerminalShell not persisted: got=%q want=%q", loaded.Defaults.TerminalShell, exec)
 	}
 }
+
+// A bad recordings block is the caller's mistake, so it comes back as
+// InvalidArgument like the other request validation.
+func TestUpdateSettingsRejectsNegativeRecordingLimits(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	svc := &settingsService{}
+
+	_, err := svc.UpdateSettings(context.Background(), &pb.UpdateSettingsRequest{
+		Recordings: &pb.RecordingsConfig{Enabled: true, MaxAgeDays: -1},
+	})
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Fatalf("error code = %v (%v), want %v", got, err, codes.InvalidArgument)
+	}
+}
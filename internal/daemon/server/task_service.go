@@ -91,9 +91,10 @@ func (s *taskService) CreateTask(_ context.Context, req *pb.CreateTaskRequest) (
 	}
 
 	opts := task.CreateOptions{
-		Title:  req.Title,
-		Prompt: req.Prompt,
-		Status: req.Status,
+		Title:     req.Title,
+		Prompt:    req.Prompt,
+		Status:    req.Status,
+		CreatedBy: requestUser(req.Meta),
 	}
 	if req.AcceptanceCriteria != nil {
 		opts.AcceptanceCriteria = *req.AcceptanceCriteria
@@ -129,7 +130,7 @@ func (s *taskService) CreateTasksBatch(_ context.Context, req *pb.CreateTasksBat
 		return nil, status.Error(codes.InvalidArgument, "no tasks found in input")
 	}
 
-	tasks, err := s.manager.CreateTasksBatch(projectPath, items, req.Status)
+	tasks, err := s.manager.CreateTasksBatch(projectPath, items, req.Status, requestUser(req.Meta))
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid status") {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
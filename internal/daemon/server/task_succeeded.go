@@ -0,0 +1,63 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// emitTaskSucceeded is the success twin of emitTaskFailed: it fires a
+// TASK_SUCCEEDED notification when a task transitions to status=done with
+// success=true. Same task-state trigger, same once-per-completion guard at
+// the call site, same ShouldNotify gate — keyed on `task_succeeded`, which
+// inherits `run_complete` until the user sets it explicitly. Unlike the
+// per-run RUN_COMPLETE summary this fires once per task, so chatty
+// channels can opt out of it without losing the run roll-up.
+func emitTaskSucceeded(bus *notify.Bus, projectID, projectPath, projectName string, t *models.Task) {
+	if t == nil || t.Status != models.TaskStatusDone {
+		return
+	}
+	if t.Success == nil || !*t.Success {
+		return
+	}
+	if projectID == "" {
+		return
+	}
+
+	settings, _ := config.LoadSettings()
+	cfg := models.DefaultNotifications()
+	if settings != nil {
+		cfg = settings.Defaults.Notifications
+	}
+	var projectNotif models.ProjectNotifications
+	if proj, _ := config.LoadProject(projectPath); proj != nil {
+		projectNotif = proj.Notifications
+	}
+	if !models.ShouldNotify(models.NotificationTaskSucceeded, cfg, projectNotif, time.Now().Local()) {
+		return
+	}
+
+	emittedAt := time.Now().UTC()
+	title := fmt.Sprintf("%s — task #%04d succeeded", projectName, t.TaskNumber)
+	if projectName == "" {
+		title = fmt.Sprintf("Task #%04d succeeded", t.TaskNumber)
+	}
+
+	n := notify.Notification{
+		ID:         notify.MakeID(notify.KindTaskSucceeded, projectID, int32(t.TaskNumber), emittedAt),
+		Kind:       notify.KindTaskSucceeded,
+		ProjectID:  projectID,
+		TaskNumber: int32(t.TaskNumber),
+		Title:      title,
+		Body:       t.Title,
+		EmittedAt:  emittedAt,
+	}
+
+	bus.Emit(n)
+	if err := notify.AppendLogLine(n); err != nil {
+		config.ProjectLogf(projectID, "[task-succeeded] failed to append notifications.log for task #%04d: %v", t.TaskNumber, err)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/notify"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+func succeededTask() *models.Task {
+	tk := failedTask()
+	tr := true
+	tk.Success = &tr
+	tk.FailureReason = ""
+	return tk
+}
+
+func TestEmitTaskSucceededHappyPath(t *testing.T) {
+	withTempHome(t)
+	projectPath, projectID := withProjectAndSettings(t, models.NewSettings(), false)
+
+	bus := notify.NewBus()
+	ch, cancel := bus.Subscribe()
+	defer cancel()
+
+	emitTaskSucceeded(bus, projectID, projectPath, "Test Project", succeededTask())
+
+	n := drainOne(t, ch)
+	if n == nil {
+		t.Fatal("expected a TASK_SUCCEEDED notification")
+	}
+	if n.Kind != notify.KindTaskSucceeded || n.TaskNumber != 7 {
+		t.Fatalf("got %+v, want TASK_SUCCEEDED for task 7", n)
+	}
+}
+
+func TestEmitTaskSucceededSkipsFailedTask(t *testing.T) {
+	withTempHome(t)
+	projectPath, projectID := withProjectAndSettings(t, models.NewSettings(), false)
+
+	bus := notify.NewBus()
+	ch, cancel := bus.Subscribe()
+	defer cancel()
+
+	emitTaskSucceeded(bus, projectID, projectPath, "Test Project", failedTask())
+
+	if n := drainOne(t, ch); n != nil {
+		t.Fatalf("expected no notification for success=false task, got %+v", n)
+	}
+}
+
+// TestEmitTaskSucceededFollowsRunComplete pins the inheritance: with no
+// explicit task_succeeded preference the run_complete toggle decides.
+func TestEmitTaskSucceededFollowsRunComplete(t *testing.T) {
+	withTempHome(t)
+	settings := models.NewSettings()
+	settings.Defaults.Notifications.Events.RunComplete = false
+	projectPath, projectID := withProjectAndSettings(t, settings, false)
+
+	bus := notify.NewBus()
+	ch, cancel := bus.Subscribe()
+	defer cancel()
+
+	emitTaskSucceeded(bus, projectID, projectPath, "Test Project", succeededTask())
+
+	if n := drainOne(t, ch); n != nil {
+		t.Fatalf("expected no notification when run_complete is off, got %+v", n)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/watchfire-io/watchfire/internal/daemon/agent"
+	"github.com/watchfire-io/watchfire/internal/daemon/echo"
 	"github.com/watchfire-io/watchfire/internal/daemon/telegram"
 	"github.com/watchfire-io/watchfire/internal/daemon/watcher"
 	pb "github.com/watchfire-io/watchfire/proto"
@@ -96,20 +97,29 @@ func (c *agentRunController) start(ctx context.Context, projectID, mode string,
 // REPLACES a running agent (kill + wait + spawn), which is the desired
 // mode-switch semantics for a human on Telegram; the daemon itself still
 // refuses a chat start over a working non-chat agent or a mid-chain
-// transition.
+// transition. The run is attributed to the chat user the bridge tagged
+// ctx with (echo.WithActor).
 func (c *agentRunController) startUnchecked(ctx context.Context, projectID, mode string, taskNumber int) (telegram.RunStart, error) {
-	svc := &agentService{manager: c.mgr, watcher: c.watcher}
-	st, err := svc.StartAgent(ctx, &pb.StartAgentRequest{
-		Meta:       &pb.RequestMeta{Origin: "telegram"},
+	st, err := startAgentAs(ctx, c.mgr, c.watcher, "telegram", echo.Actor(ctx), projectID, mode, taskNumber)
+	if err != nil {
+		return telegram.RunStart{}, err
+	}
+	return telegram.RunStart{TaskNumber: int(st.TaskNumber), TaskTitle: st.TaskTitle}, nil
+}
+
+// startAgentAs is the chat transports' shared start path: the
+// agentService StartAgent call the gRPC surface goes through, with the
+// tray's synthetic-request shape (rows/cols 0, sandbox "auto") and the
+// request attributed to origin / actor.
+func startAgentAs(ctx context.Context, mgr *agent.Manager, w *watcher.Watcher, origin, actor, projectID, mode string, taskNumber int) (*pb.AgentStatus, error) {
+	svc := &agentService{manager: mgr, watcher: w}
+	return svc.StartAgent(ctx, &pb.StartAgentRequest{
+		Meta:       &pb.RequestMeta{Origin: origin, User: actor},
 		ProjectId:  projectID,
 		Mode:       mode,
 		TaskNumber: int32(taskNumber), //nolint:gosec // task numbers are small
 		Sandbox:    "auto",
 	})
-	if err != nil {
-		return telegram.RunStart{}, err
-	}
-	return telegram.RunStart{TaskNumber: int(st.TaskNumber), TaskTitle: st.TaskTitle}, nil
 }
 
 // SendInput writes raw bytes to the running agent's PTY — the /say
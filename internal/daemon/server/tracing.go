@@ -0,0 +1,22 @@
+package server
+
+import (
+	"log"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/daemon/tracing"
+)
+
+// applyTracing points the span exporter at settings.yaml `tracing`. Runs
+// at startup and on every settings write; tracing.Configure ignores an
+// unchanged config.
+func (s *Server) applyTracing() {
+	settings, err := config.LoadSettings()
+	if err != nil {
+		log.Printf("[tracing] Failed to load settings: %v", err)
+		return
+	}
+	if err := tracing.Configure(settings.Tracing); err != nil {
+		log.Printf("[tracing] %v", err)
+	}
+}
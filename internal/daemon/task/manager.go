@@ -33,6 +33,7 @@ type CreateOptions struct {
 	Agent              string
 	Status             string
 	Position           *int
+	CreatedBy          string // Identity of whoever added the task; empty when unknown
 }
 
 // UpdateOptions contains options for updating a task.
@@ -129,6 +130,7 @@ func (m *Manager) CreateTask(projectPath string, opts CreateOptions) (*models.Ta
 	task := models.NewTask(taskID, taskNumber, opts.Title, opts.Prompt)
 	task.AcceptanceCriteria = opts.AcceptanceCriteria
 	task.Agent = opts.Agent
+	task.CreatedBy = opts.CreatedBy
 
 	// Set status
 	if opts.Status != "" {
@@ -174,8 +176,9 @@ func (m *Manager) CreateTask(projectPath string, opts CreateOptions) (*models.Ta
 // CreateTasksBatch creates one task per item, numbering them consecutively
 // and appending positions in input order. All tasks are built and validated
 // up front — a bad item fails the whole batch before anything is written.
-// Status applies to every task and must be draft or ready.
-func (m *Manager) CreateTasksBatch(projectPath string, items []QuickAddItem, status string) ([]*models.Task, error) {
+// Status applies to every task and must be draft or ready; createdBy
+// attributes every task (empty when unknown).
+func (m *Manager) CreateTasksBatch(projectPath string, items []QuickAddItem, status, createdBy string) ([]*models.Task, error) {
 	if len(items) == 0 {
 		return nil, fmt.Errorf("no tasks found in input")
 	}
@@ -214,6 +217,7 @@ func (m *Manager) CreateTasksBatch(projectPath string, items []QuickAddItem, sta
 		t.AcceptanceCriteria = it.AcceptanceCriteria
 		t.Status = models.TaskStatus(status)
 		t.Position = maxPos + 1 + i
+		t.CreatedBy = createdBy
 		if err := config.ValidateTask(t); err != nil {
 			return nil, fmt.Errorf("task %d (%q): %w", i+1, it.Title, err)
 		}
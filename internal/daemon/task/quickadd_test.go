@@ -240,7 +240,7 @@ func TestCreateTasksBatchNumbersAndPositions(t *testing.T) {
 	}
 
 	items := ParseQuickAdd("- Task one: with colon\n- Task two\n- Task three")
-	created, err := m.CreateTasksBatch(projectPath, items, "ready")
+	created, err := m.CreateTasksBatch(projectPath, items, "ready", "jane@example.com")
 	if err != nil {
 		t.Fatalf("CreateTasksBatch: %v", err)
 	}
@@ -265,6 +265,9 @@ func TestCreateTasksBatchNumbersAndPositions(t *testing.T) {
 		if loaded.Title != ct.Title {
 			t.Errorf("task %d title round-trip: got %q, want %q", i, loaded.Title, ct.Title)
 		}
+		if loaded.CreatedBy != "jane@example.com" {
+			t.Errorf("task %d created_by round-trip: got %q", i, loaded.CreatedBy)
+		}
 	}
 	if created[0].Title != "Task one: with colon" {
 		t.Errorf("colon title = %q", created[0].Title)
@@ -284,11 +287,11 @@ func TestCreateTasksBatchRejectsBadStatusAndEmpty(t *testing.T) {
 	projectPath := setupTempProject(t)
 	m := NewManager()
 
-	if _, err := m.CreateTasksBatch(projectPath, nil, "ready"); err == nil {
+	if _, err := m.CreateTasksBatch(projectPath, nil, "ready", ""); err == nil {
 		t.Error("expected error for empty batch")
 	}
 	items := ParseQuickAdd("- fine")
-	if _, err := m.CreateTasksBatch(projectPath, items, "done"); err == nil {
+	if _, err := m.CreateTasksBatch(projectPath, items, "done", ""); err == nil {
 		t.Error("expected error for status done")
 	}
 	// Nothing must have been written by the failed calls.
@@ -0,0 +1,85 @@
+// Agent questions (the ask package): /answer, the option taps on a
+// question the relay posted, and plain-text replies to a working agent
+// that is waiting on one. All three route through echo.RouteAnswer —
+// the daemon types the answer into the session, never this package.
+package telegram
+
+import (
+	"context"
+	"strings"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/echo"
+)
+
+// cmdAnswer handles /answer [#<task>] <text>. Without a task the chat's
+// active project wins when its agent is waiting; otherwise the echo
+// router picks the one waiting question across the projects (or lists
+// them when several wait).
+func (b *Bridge) cmdAnswer(ctx context.Context, chatID, userID int64, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		b.reply(ctx, chatID, "Usage: /answer [#&lt;task&gt;] &lt;text&gt;")
+		return
+	}
+	cc, ok := b.commandContext(ctx, chatID, userID)
+	if !ok {
+		return
+	}
+	var resp *echo.CommandResponse
+	b.mu.Lock()
+	projectID := b.paired[chatID].DefaultProjectID
+	b.mu.Unlock()
+	if q, waiting := pendingQuestion(ctx, cc, projectID); waiting && !strings.HasPrefix(text, "#") {
+		resp = echo.RouteAnswer(ctx, projectID, ask.Reply{QuestionID: q.ID, Text: text}, cc)
+	} else {
+		resp = echo.Route(ctx, "/watchfire", "answer", text, cc)
+	}
+	for _, chunk := range RenderHTML(resp) {
+		b.reply(ctx, chatID, chunk)
+	}
+}
+
+// answerWaitingAgent treats a plain-text message as the answer when the
+// project's working agent is waiting on a question. It reports whether
+// the message was consumed.
+func (b *Bridge) answerWaitingAgent(ctx context.Context, chatID, userID int64, projectID, text string) bool {
+	if b.cmdCtxFor == nil {
+		return false
+	}
+	cc := b.cmdCtxFor(chatID, userID)
+	q, waiting := pendingQuestion(ctx, cc, projectID)
+	if !waiting {
+		return false
+	}
+	resp := echo.RouteAnswer(ctx, projectID, ask.Reply{QuestionID: q.ID, Text: text}, cc)
+	for _, chunk := range RenderHTML(resp) {
+		b.reply(ctx, chatID, chunk)
+	}
+	return true
+}
+
+// handleAnswerCallback answers a question with the option tapped on the
+// inline keyboard the relay attached to it.
+func (b *Bridge) handleAnswerCallback(ctx context.Context, chatID, userID int64, projectID string, questionID, option int, answer func(string)) {
+	cc, ok := b.commandContext(ctx, chatID, userID)
+	if !ok {
+		answer("")
+		return
+	}
+	resp := echo.RouteAnswer(ctx, projectID, ask.Reply{QuestionID: questionID, Option: option}, cc)
+	answer(resp.Text)
+	if resp.Ephemeral {
+		return
+	}
+	for _, chunk := range RenderHTML(resp) {
+		b.reply(ctx, chatID, chunk)
+	}
+}
+
+func pendingQuestion(ctx context.Context, cc echo.CommandContext, projectID string) (ask.Question, bool) {
+	if projectID == "" || cc.PendingQuestion == nil {
+		return ask.Question{}, false
+	}
+	return cc.PendingQuestion(ctx, projectID)
+}
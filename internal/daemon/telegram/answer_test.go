@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
+	"github.com/watchfire-io/watchfire/internal/daemon/echo"
+)
+
+// TestPlainTextAnswersWaitingAgent: while the working agent waits on a
+// question, plain text is the answer (delivered through the echo
+// callbacks, never SendInput from this package). Once answered, /answer
+// finds nothing waiting.
+func TestPlainTextAnswersWaitingAgent(t *testing.T) {
+	withTestEnv(t)
+	var mu sync.Mutex
+	var delivered []ask.Reply
+	desk := ask.NewDesk(nil, func(_ ask.Question, r ask.Reply) error {
+		mu.Lock()
+		delivered = append(delivered, r)
+		mu.Unlock()
+		return nil
+	})
+	if _, err := desk.Ask(context.Background(), ask.Question{ProjectID: "p1", ProjectName: "Alpha", TaskNumber: 7, Text: "Which cache?"}); err != nil {
+		t.Fatal(err)
+	}
+	base := fakeCommandContextFor(testProjects, nil)
+	ccFor := func(chatID, userID int64) echo.CommandContext {
+		cc := base(chatID, userID)
+		cc.PendingQuestion = func(_ context.Context, projectID string) (ask.Question, bool) { return desk.Get(projectID) }
+		cc.AnswerQuestion = func(_ context.Context, projectID string, r ask.Reply) error {
+			_, err := desk.Answer(projectID, r)
+			return err
+		}
+		return cc
+	}
+
+	fake := newFakeBotAPI(t,
+		updateJSON(1, 42, 42, "nuno", "Use Redis, keep it simple"),
+		updateJSON(2, 42, 42, "nuno", "/answer Postgres"),
+	)
+	runner := &stubRunner{}
+	b := New(Config{
+		Token: testToken, Pairing: NewPairing(), Hostname: "h",
+		PairedChats:       chatOnProject("p1"),
+		CommandContextFor: ccFor,
+		Sessions:          busySession(),
+		Runner:            runner,
+	})
+	startBridge(t, b)
+
+	waitFor(t, "two replies", func() bool { return len(fake.sentMessages()) >= 2 })
+	sent := fake.sentMessages()
+	if !strings.Contains(sent[0].Text, "Answered") || !strings.Contains(sent[0].Text, "#0007") {
+		t.Fatalf("plain text should answer the waiting agent: %q", sent[0].Text)
+	}
+	if !strings.Contains(sent[1].Text, "No agent is waiting") {
+		t.Fatalf("/answer after the answer: %q", sent[1].Text)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(delivered) != 1 || delivered[0].Text != "Use Redis, keep it simple" {
+		t.Fatalf("delivered = %+v", delivered)
+	}
+	if _, _, inputs := runner.snapshot(); len(inputs) != 0 {
+		t.Fatalf("answers go through the daemon, not SendInput: %v", inputs)
+	}
+}
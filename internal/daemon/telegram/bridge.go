@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"html"
 	"log"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -333,13 +334,15 @@ func (b *Bridge) IsPaired(chatID int64) bool {
 // stranger probing the bot learns nothing.
 func (b *Bridge) handleUpdate(ctx context.Context, u telegrambot.Update) {
 	if u.CallbackQuery != nil {
-		b.handleCallback(ctx, u.CallbackQuery)
+		b.handleCallback(echo.WithActor(ctx, telegramActor(u.CallbackQuery.From)), u.CallbackQuery)
 		return
 	}
 	msg := u.Message
 	if msg == nil || msg.From == nil || msg.Text == "" {
 		return
 	}
+	// Anything this update starts is attributed to its sender.
+	ctx = echo.WithActor(ctx, telegramActor(*msg.From))
 	cmd, rest := splitCommand(msg.Text)
 	if cmd == "/start" || cmd == "/pair" {
 		arg := ""
@@ -357,13 +360,23 @@ func (b *Bridge) handleUpdate(ctx context.Context, u telegrambot.Update) {
 		// (v10 follow-up): Telegram is a conversation surface, not only a
 		// command console. Delivery still goes through injectSay — the
 		// package's single sanctioned PTY write — targeting the same
-		// session watch mode streams.
-		b.handlePlainText(ctx, msg.Chat.ID, msg.Text)
+		// session watch mode streams. Typing into a session takes an
+		// operator, like /say.
+		if !b.permit(ctx, msg.Chat.ID, msg.From.ID, "typing to the agent", models.ChatRoleOperator) {
+			return
+		}
+		b.handlePlainText(ctx, msg.Chat.ID, msg.From.ID, msg.Text)
 		return
 	}
 	b.dispatchCommand(ctx, msg, cmd, rest)
 }
 
+// telegramActor is the attribution identity of a Telegram user — by
+// numeric id, which unlike the username never changes.
+func telegramActor(u telegrambot.User) string {
+	return config.RemoteUser("telegram", strconv.FormatInt(u.ID, 10))
+}
+
 // handlePairing runs the /start / /pair flow for one message (any
 // chat, paired or not — redeeming a fresh code from an already-paired
 // chat simply refreshes its record).
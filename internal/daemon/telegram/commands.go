@@ -5,7 +5,9 @@
 // production implementations Slack and Discord use); /status reuses
 // echo.Route verbatim. The run-control verbs (/run /runall /retry
 // /cancel /screen /say /mute /unmute — task 0142) live in
-// runcontrol.go and are dispatched here.
+// runcontrol.go and are dispatched here, as are the Approve / Reject /
+// Retry taps on merge approval requests (echo.RouteApproval) and
+// /answer plus the option taps on agent questions (answer.go).
 package telegram
 
 import (
@@ -14,8 +16,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/watchfire-io/watchfire/internal/daemon/approval"
+	"github.com/watchfire-io/watchfire/internal/daemon/ask"
 	"github.com/watchfire-io/watchfire/internal/daemon/echo"
 	"github.com/watchfire-io/watchfire/internal/daemon/telegrambot"
+	"github.com/watchfire-io/watchfire/internal/models"
 )
 
 // tasksLimit caps the /tasks listing.
@@ -47,16 +52,43 @@ func botCommands() []telegrambot.BotCommand {
 		{Command: "watch", Description: "Toggle the live conversation relay (on|off)"},
 		{Command: "screen", Description: "Plain-text snapshot of the live session"},
 		{Command: "say", Description: "Type into the working session explicitly"},
+		{Command: "answer", Description: "Answer the question an agent is waiting on"},
 		{Command: "mute", Description: "Pause/resume event pushes (on|off)"},
 		{Command: "pair", Description: "Pair this chat with a one-time code"},
 		{Command: "help", Description: "All commands"},
 	}
 }
 
+// commandRoles is the chat role each command needs beyond viewer (see
+// models.ChatRole): acting on tasks and sessions takes an operator,
+// starting, stopping or reconfiguring agents an admin. /retry /cancel
+// /answer and the button taps are checked again by echo.
+var commandRoles = map[string]models.ChatRole{
+	"/retry":    models.ChatRoleOperator,
+	"/cancel":   models.ChatRoleOperator,
+	"/answer":   models.ChatRoleOperator,
+	"/say":      models.ChatRoleOperator,
+	"/watch":    models.ChatRoleOperator,
+	"/mute":     models.ChatRoleOperator,
+	"/unmute":   models.ChatRoleOperator,
+	"/run":      models.ChatRoleAdmin,
+	"/runall":   models.ChatRoleAdmin,
+	"/wildfire": models.ChatRoleAdmin,
+	"/new":      models.ChatRoleAdmin,
+	"/stop":     models.ChatRoleAdmin,
+	"/login":    models.ChatRoleAdmin,
+	"/agent":    models.ChatRoleAdmin,
+	"/generate": models.ChatRoleAdmin,
+	"/plan":     models.ChatRoleAdmin,
+}
+
 // dispatchCommand routes one slash command from a paired chat. rest is
 // the whitespace-normalized remainder of the message after the command.
 func (b *Bridge) dispatchCommand(ctx context.Context, msg *telegrambot.Message, cmd, rest string) {
 	chatID, userID := msg.Chat.ID, msg.From.ID
+	if need, ok := commandRoles[cmd]; ok && !b.permit(ctx, chatID, userID, cmd, need) {
+		return
+	}
 	switch cmd {
 	case "/projects":
 		b.cmdProjects(ctx, chatID, userID)
@@ -106,6 +138,9 @@ func (b *Bridge) dispatchCommand(ctx context.Context, msg *telegrambot.Message,
 		b.cmdRouteVerb(ctx, chatID, userID, "retry", rest)
 	case "/cancel":
 		b.cmdRouteVerb(ctx, chatID, userID, "cancel", rest)
+	case "/answer":
+		// Verbatim like /say: the answer is typed into the session.
+		b.cmdAnswer(ctx, chatID, userID, sayVerbatim(msg.Text))
 	case "/screen":
 		b.cmdScreen(ctx, chatID)
 	case "/say":
@@ -161,6 +196,7 @@ func helpHTML() string {
 		"/watch on|off — relay the live agent conversation here",
 		"/screen — plain-text snapshot of the live session",
 		"/say &lt;text&gt; — type into the working session explicitly",
+		"/answer [#&lt;task&gt;] &lt;text&gt; — answer a question an agent is waiting on (or just reply)",
 		"/mute on|off — pause/resume event pushes to this chat",
 		"",
 		"/pair &lt;code&gt; — pair this chat · /help — this list",
@@ -178,6 +214,24 @@ func (b *Bridge) commandContext(ctx context.Context, chatID, userID int64) (echo
 	return b.cmdCtxFor(chatID, userID), true
 }
 
+// permit checks the sender's chat role against need, replying with the
+// refusal (which echo.Permit records to the audit log) when it falls
+// short. A bridge without a command context factory checks nothing.
+func (b *Bridge) permit(ctx context.Context, chatID, userID int64, verb string, need models.ChatRole) bool {
+	if b.cmdCtxFor == nil {
+		return true
+	}
+	role, ok := echo.Permit(ctx, b.cmdCtxFor(chatID, userID), verb, need)
+	if ok {
+		return true
+	}
+	if !role.Valid() {
+		role = "no role"
+	}
+	b.reply(ctx, chatID, fmt.Sprintf("⛔ %s needs the <b>%s</b> role and you have <b>%s</b> — ask the Watchfire owner to map your Telegram user id (<code>%d</code>) in integrations.yaml.", EscapeHTML(verb), need, role, userID))
+	return false
+}
+
 // cmdProjects renders the numbered project list with one inline button
 // per project, and remembers the ordering so "/use 2" can refer to it.
 func (b *Bridge) cmdProjects(ctx context.Context, chatID, userID int64) {
@@ -252,10 +306,11 @@ func (b *Bridge) cmdUse(ctx context.Context, chatID, userID int64, arg string) {
 	b.reply(ctx, chatID, "✓ Active project set to <b>"+EscapeHTML(target.Name)+"</b>.")
 }
 
-// handleCallback services an inline-keyboard tap. Only the "use:"
-// namespace exists today. Unpaired or malformed callbacks are answered
-// blank (the client stops its spinner) and otherwise ignored — same
-// no-information-leak posture as unpaired messages.
+// handleCallback services an inline-keyboard tap: the "use:" project
+// picker, merge approval buttons and agent question options. Unpaired
+// or malformed callbacks are answered blank (the client stops its
+// spinner) and otherwise ignored — same no-information-leak posture as
+// unpaired messages.
 func (b *Bridge) handleCallback(ctx context.Context, cq *telegrambot.CallbackQuery) {
 	answer := func(text string) {
 		if err := b.client.AnswerCallbackQuery(ctx, b.token, cq.ID, text); err != nil {
@@ -267,7 +322,19 @@ func (b *Bridge) handleCallback(ctx context.Context, cq *telegrambot.CallbackQue
 		return
 	}
 	chatID := cq.Message.Chat.ID
-	if !b.IsPaired(chatID) || !strings.HasPrefix(cq.Data, callbackUsePrefix) {
+	if !b.IsPaired(chatID) {
+		answer("")
+		return
+	}
+	if decision, projectID, taskNumber, ok := approval.ParseButtonData(cq.Data); ok {
+		b.handleApprovalCallback(ctx, chatID, cq.From.ID, projectID, taskNumber, decision, answer)
+		return
+	}
+	if projectID, questionID, option, ok := ask.ParseButtonData(cq.Data); ok {
+		b.handleAnswerCallback(ctx, chatID, cq.From.ID, projectID, questionID, option, answer)
+		return
+	}
+	if !strings.HasPrefix(cq.Data, callbackUsePrefix) {
 		answer("")
 		return
 	}
@@ -302,6 +369,25 @@ func (b *Bridge) handleCallback(ctx context.Context, cq *telegrambot.CallbackQue
 	b.reply(ctx, chatID, "✓ Active project set to <b>"+EscapeHTML(target.Name)+"</b>.")
 }
 
+// handleApprovalCallback answers a merge approval from the inline
+// keyboard the relay attached to the approval request. The outcome is
+// shown as the callback toast and posted to the chat.
+func (b *Bridge) handleApprovalCallback(ctx context.Context, chatID, userID int64, projectID string, taskNumber int, decision approval.Decision, answer func(string)) {
+	cc, ok := b.commandContext(ctx, chatID, userID)
+	if !ok {
+		answer("")
+		return
+	}
+	resp := echo.RouteApproval(ctx, projectID, taskNumber, decision, cc)
+	answer(resp.Text)
+	if resp.Ephemeral {
+		return
+	}
+	for _, chunk := range RenderHTML(resp) {
+		b.reply(ctx, chatID, chunk)
+	}
+}
+
 // cmdStatus routes /status through echo.Route for the chat's active
 // project — the same status handler Slack and Discord use.
 func (b *Bridge) cmdStatus(ctx context.Context, chatID, userID int64) {
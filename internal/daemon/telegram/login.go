@@ -237,7 +237,7 @@ func loginAccountOnScreen(sess *WatchedSession) string {
 	if sess == nil || sess.Snapshot == nil {
 		return ""
 	}
-	m := loggedInAsPattern.FindStringSubmatch(normalizeScreen(sess.Snapshot()))
+	m := loggedInAsPattern.FindStringSubmatch(NormalizeScreen(sess.Snapshot()))
 	if len(m) < 2 {
 		return ""
 	}
@@ -262,7 +262,7 @@ func (b *Bridge) loginURLOnScreen(sess *WatchedSession) string {
 // (observed live in v10.0.4 — the corrupted state travelled to the
 // browser and came back glued to the user's code).
 func loginURLFromScreen(lines []string) string {
-	plain := strings.Split(normalizeScreen(lines), "\n")
+	plain := strings.Split(NormalizeScreen(lines), "\n")
 	for i, l := range plain {
 		l = trimScreenEdges(l)
 		idx := strings.Index(l, "https://")
@@ -325,7 +325,7 @@ func (b *Bridge) waitScreen(ctx context.Context, sess *WatchedSession, pred func
 // — the form the dialog markers are written against (the CLI positions
 // words with cursor escapes, so spacing is not dependable).
 func flattenScreen(lines []string) string {
-	return strings.ToLower(strings.Join(strings.Fields(normalizeScreen(lines)), ""))
+	return strings.ToLower(strings.Join(strings.Fields(NormalizeScreen(lines)), ""))
 }
 
 // loginHint is the auto-relay text when the watched session raises an
@@ -10,7 +10,7 @@
 // the tier-2 normalization from watch mode (task 0141).
 //
 // /say is the ONLY write path into an agent PTY in the entire telegram
-// package: the user's text verbatim plus exactly one carriage return,
+// package: the user's printable text plus exactly one carriage return,
 // injected through injectSay — the single call site the source-guard
 // test (watch_guard_test.go) allowlists. Everything else in this
 // package only ever reads.
@@ -85,8 +85,10 @@ type RunController interface {
 }
 
 // injectSay is the single sanctioned PTY write path in the telegram
-// package: the user's text verbatim, then — after a short beat — one
-// carriage return (Enter) as its own write. Sending text+\r as a
+// package: the user's text, then — after a short beat — one carriage
+// return (Enter) as its own write. Whitespace is typed as spaces and
+// other non-printable runes are dropped, so a message can neither
+// submit early nor carry escape sequences or control keys. Sending text+\r as a
 // single chunk trips the agent CLI's paste detection, which absorbs
 // the trailing Enter into the pasted content instead of submitting
 // (observed live: the message sat in Claude Code's input box, unsent).
@@ -94,6 +96,15 @@ type RunController interface {
 // watch_guard_test source guard allowlists precisely this call site —
 // any other SendInput reference in the package fails the build's tests.
 func (b *Bridge) injectSay(projectID, text string) error {
+	text = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case unicode.IsPrint(r):
+			return r
+		}
+		return -1
+	}, text)
 	for i, chunk := range [][]byte{[]byte(text), {'\r'}} {
 		if i > 0 {
 			b.sleepFn(context.Background(), b.sayEnterDelay)
@@ -238,7 +249,7 @@ func (b *Bridge) cmdScreen(ctx context.Context, chatID int64) {
 	if sess.Snapshot != nil {
 		lines = sess.Snapshot()
 	}
-	normalized := normalizeScreen(lines)
+	normalized := NormalizeScreen(lines)
 	if normalized == "" {
 		b.reply(ctx, chatID, "The session screen is empty right now.")
 		return
@@ -411,10 +422,11 @@ func (b *Bridge) cmdWildfire(ctx context.Context, chatID int64, rest string) {
 //   - live NON-chat session (task / run-all / wildfire / generate) →
 //     never type into a working agent implicitly; explain what's
 //     running and offer options (/watch, /screen, explicit /say,
-//     /cancel).
+//     /cancel). The exception is an agent waiting on a question it
+//     asked: the message is the answer (answer.go).
 //   - nothing running → auto-start a chat agent on the chat's project
 //     and deliver the message once the session is ready.
-func (b *Bridge) handlePlainText(ctx context.Context, chatID int64, text string) {
+func (b *Bridge) handlePlainText(ctx context.Context, chatID, userID int64, text string) {
 	if !b.requireRunner(ctx, chatID) {
 		return
 	}
@@ -432,6 +444,9 @@ func (b *Bridge) handlePlainText(ctx context.Context, chatID int64, text string)
 
 	if sess, live := b.resolveWatchSession(chat.DefaultProjectID); live {
 		if sess.Mode != "chat" {
+			if b.answerWaitingAgent(ctx, chatID, userID, sess.ProjectID, text) {
+				return
+			}
 			b.replyBusyOptions(ctx, chatID, chat, sess)
 			return
 		}
@@ -453,6 +468,10 @@ func (b *Bridge) handlePlainText(ctx context.Context, chatID int64, text string)
 		b.reply(ctx, chatID, "No project selected — send /projects, then /use &lt;name|number&gt;, and I'll start a chat agent there.")
 		return
 	}
+	// Starting the chat agent is a run: admins only.
+	if !b.permit(ctx, chatID, userID, "starting a chat agent", models.ChatRoleAdmin) {
+		return
+	}
 	b.queueChatStart(ctx, chatID, projectID, text, chat.Watching())
 }
 
@@ -239,6 +239,59 @@ func TestRunUsageAndStartError(t *testing.T) {
 	}
 }
 
+// TestChatRolesGateCommands: commands and plain text above the sender's
+// chat role are refused before reaching the runner, and every refusal
+// is reported to the audit hook; the chat's admin is unaffected.
+func TestChatRolesGateCommands(t *testing.T) {
+	withTestEnv(t)
+	fake := newFakeBotAPI(t,
+		updateJSON(1, 42, 43, "guest", "/run 9"),
+		updateJSON(2, 42, 43, "guest", "hello agent"),
+		updateJSON(3, 42, 42, "nuno", "/run 9"),
+	)
+	var mu sync.Mutex
+	var denied []string
+	base := fakeCommandContextFor(testProjects, nil)
+	runner := &stubRunner{}
+	b := runControlBridge(runner, idleSessions(), chatOnProject("p1"))
+	b.cmdCtxFor = func(chatID, userID int64) echo.CommandContext {
+		cc := base(chatID, userID)
+		cc.Role = func(context.Context) models.ChatRole {
+			if userID == 42 {
+				return models.ChatRoleAdmin
+			}
+			return models.ChatRoleViewer
+		}
+		cc.Denied = func(_ context.Context, verb string, role, need models.ChatRole) {
+			mu.Lock()
+			defer mu.Unlock()
+			denied = append(denied, fmt.Sprintf("%s %s<%s", verb, role, need))
+		}
+		return cc
+	}
+	startBridge(t, b)
+
+	waitFor(t, "three replies", func() bool { return len(fake.sentMessages()) >= 3 })
+	sent := fake.sentMessages()
+	if !strings.Contains(sent[0].Text, "/run needs the <b>admin</b> role and you have <b>viewer</b>") || !strings.Contains(sent[0].Text, "<code>43</code>") {
+		t.Fatalf("viewer /run reply: %q", sent[0].Text)
+	}
+	if !strings.Contains(sent[1].Text, "typing to the agent needs the <b>operator</b> role") {
+		t.Fatalf("viewer plain text reply: %q", sent[1].Text)
+	}
+	if !strings.Contains(sent[2].Text, "▶ Started task #0009") {
+		t.Fatalf("admin /run reply: %q", sent[2].Text)
+	}
+	if starts, _, _ := runner.snapshot(); len(starts) != 1 {
+		t.Fatalf("StartTask calls = %v, want only the admin's", starts)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if strings.Join(denied, "|") != "/run viewer<admin|typing to the agent viewer<operator" {
+		t.Fatalf("denied = %v", denied)
+	}
+}
+
 // TestRunAllReplacesRunningAgent: /runall replaces a running agent
 // like /run, naming what it replaced.
 func TestRunAllReplacesRunningAgent(t *testing.T) {
@@ -307,6 +360,23 @@ func TestSayInjectsExactBytes(t *testing.T) {
 	}
 }
 
+// TestSayDropsControlRunes: a /say carrying escape sequences or line
+// breaks reaches the PTY as one printable line — nothing it holds can
+// press keys in the agent's TUI or submit early.
+func TestSayDropsControlRunes(t *testing.T) {
+	withTestEnv(t)
+	fake := newFakeBotAPI(t, updateJSON(1, 42, 42, "nuno", "/say up\u001b[A\nthen\u0003 go"))
+	runner := &stubRunner{}
+	b := runControlBridge(runner, busySession(), chatOnProject("p1"))
+	startBridge(t, b)
+
+	waitFor(t, "say ack", func() bool { return len(fake.sentMessages()) >= 1 })
+	_, _, inputs := runner.snapshot()
+	if len(inputs) != 2 || inputs[0] != "up[A then go" || inputs[1] != "\r" {
+		t.Fatalf("injected chunks = %q, want the printable line then a lone \\r", inputs)
+	}
+}
+
 // TestSayOnlyWhenRunning: /say against an idle project is refused and
 // nothing reaches the PTY seam; an empty /say draws usage.
 func TestSayOnlyWhenRunning(t *testing.T) {
@@ -502,7 +502,7 @@ func runScreenDeltas(ctx context.Context, done <-chan struct{}, snapshot func()
 			final = true
 		default:
 		}
-		if s := normalizeScreen(snapshot()); s != "" && s != last {
+		if s := NormalizeScreen(snapshot()); s != "" && s != last {
 			last = s
 			emit(Emission{Kind: EmissionScreen, Text: s})
 		}
@@ -521,10 +521,11 @@ func runScreenDeltas(ctx context.Context, done <-chan struct{}, snapshot func()
 	}
 }
 
-// normalizeScreen converts raw screen lines to comparable plain text:
+// NormalizeScreen converts raw screen lines to comparable plain text:
 // per-line ANSI strip + \r resolution + right-trim (the mcpserver
-// plainLine approach), then blank top/bottom edges dropped.
-func normalizeScreen(lines []string) string {
+// plainLine approach), then blank top/bottom edges dropped. Exported
+// for the daemon's Slack / Discord `screen` and `watch` commands.
+func NormalizeScreen(lines []string) string {
 	out := make([]string, 0, len(lines))
 	for _, l := range lines {
 		out = append(out, plainScreenLine(l))
@@ -337,7 +337,7 @@ func TestScreenDeltaDebounce(t *testing.T) {
 // TestNormalizeScreen: ANSI stripped, \r overwrites resolved, trailing
 // padding trimmed, blank edges dropped.
 func TestNormalizeScreen(t *testing.T) {
-	got := normalizeScreen([]string{
+	got := NormalizeScreen([]string{
 		"",
 		"   ",
 		"\x1b[31mred text\x1b[0m   ",
@@ -348,9 +348,9 @@ func TestNormalizeScreen(t *testing.T) {
 	// \r resolution picks the last non-empty segment of each line.
 	want := "red text\nfinal\nmiddle"
 	if got != want {
-		t.Fatalf("normalizeScreen = %q, want %q", got, want)
+		t.Fatalf("NormalizeScreen = %q, want %q", got, want)
 	}
-	if normalizeScreen([]string{"", "   ", ""}) != "" {
+	if NormalizeScreen([]string{"", "   ", ""}) != "" {
 		t.Fatal("blank screen should normalize to empty")
 	}
 }
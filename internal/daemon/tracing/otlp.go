@@ -0,0 +1,299 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The OTLP JSON encoding of ExportTraceServiceRequest, per the OTLP
+// specification: ids are lowercase hex, 64-bit integers are decimal
+// strings, enums are their numeric values. Only the fields the daemon
+// produces are modelled.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpValue `json:"values"`
+}
+
+// OTLP status codes. Note the SDK's codes.Ok / codes.Error have
+// different numeric values.
+const (
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+// encodeSpans builds the OTLP JSON request for one export batch. Spans
+// are grouped by instrumentation scope under the first span's resource —
+// every span comes from the same provider, so they share one.
+func encodeSpans(spans []sdktrace.ReadOnlySpan) ([]byte, error) {
+	req := otlpRequest{}
+	if len(spans) > 0 {
+		rs := otlpResourceSpans{}
+		if res := spans[0].Resource(); res != nil {
+			rs.Resource.Attributes = encodeAttrs(res.Attributes())
+		}
+		index := make(map[string]int)
+		for _, s := range spans {
+			scope := s.InstrumentationScope()
+			key := scope.Name + "\x00" + scope.Version
+			i, ok := index[key]
+			if !ok {
+				i = len(rs.ScopeSpans)
+				index[key] = i
+				rs.ScopeSpans = append(rs.ScopeSpans, otlpScopeSpans{Scope: otlpScope{Name: scope.Name, Version: scope.Version}})
+			}
+			rs.ScopeSpans[i].Spans = append(rs.ScopeSpans[i].Spans, encodeSpan(s))
+		}
+		req.ResourceSpans = []otlpResourceSpans{rs}
+	}
+	return json.Marshal(req)
+}
+
+func encodeSpan(s sdktrace.ReadOnlySpan) otlpSpan {
+	sc := s.SpanContext()
+	out := otlpSpan{
+		TraceID:           sc.TraceID().String(),
+		SpanID:            sc.SpanID().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: unixNano(s.StartTime()),
+		EndTimeUnixNano:   unixNano(s.EndTime()),
+		Attributes:        encodeAttrs(s.Attributes()),
+	}
+	// trace.SpanKind and OTLP's enum agree (internal=1 … consumer=5);
+	// the SDK's unspecified (0) is exported as internal.
+	if s.SpanKind() == trace.SpanKindUnspecified {
+		out.Kind = int(trace.SpanKindInternal)
+	}
+	if p := s.Parent(); p.HasSpanID() {
+		out.ParentSpanID = p.SpanID().String()
+	}
+	for _, e := range s.Events() {
+		out.Events = append(out.Events, otlpEvent{
+			TimeUnixNano: unixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   encodeAttrs(e.Attributes),
+		})
+	}
+	switch st := s.Status(); st.Code {
+	case codes.Ok:
+		out.Status.Code = otlpStatusOK
+	case codes.Error:
+		out.Status = otlpStatus{Code: otlpStatusError, Message: st.Description}
+	}
+	return out
+}
+
+func unixNano(t time.Time) string { return strconv.FormatInt(t.UnixNano(), 10) }
+
+func encodeAttrs(attrs []attribute.KeyValue) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]otlpKeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		out = append(out, otlpKeyValue{Key: string(kv.Key), Value: encodeValue(kv.Value)})
+	}
+	return out
+}
+
+func encodeValue(v attribute.Value) otlpValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return otlpValue{BoolValue: &b}
+	case attribute.INT64:
+		s := strconv.FormatInt(v.AsInt64(), 10)
+		return otlpValue{IntValue: &s}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return otlpValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		arr := &otlpArrayValue{}
+		for _, b := range v.AsBoolSlice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.BoolValue(b)))
+		}
+		return otlpValue{ArrayValue: arr}
+	case attribute.INT64SLICE:
+		arr := &otlpArrayValue{}
+		for _, n := range v.AsInt64Slice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.Int64Value(n)))
+		}
+		return otlpValue{ArrayValue: arr}
+	case attribute.FLOAT64SLICE:
+		arr := &otlpArrayValue{}
+		for _, f := range v.AsFloat64Slice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.Float64Value(f)))
+		}
+		return otlpValue{ArrayValue: arr}
+	case attribute.STRINGSLICE:
+		arr := &otlpArrayValue{}
+		for _, s := range v.AsStringSlice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.StringValue(s)))
+		}
+		return otlpValue{ArrayValue: arr}
+	default:
+		s := v.Emit()
+		return otlpValue{StringValue: &s}
+	}
+}
+
+// httpExporter POSTs each batch to an OTLP/HTTP collector using the JSON
+// encoding.
+type httpExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newHTTPExporter(endpoint string, headers map[string]string) *httpExporter {
+	return &httpExporter{
+		url:     strings.TrimRight(endpoint, "/") + "/v1/traces",
+		headers: headers,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (e *httpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	body, err := encodeSpans(spans)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("tracing: export to %s: %w", e.url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("tracing: export to %s: HTTP %d: %s", e.url, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (e *httpExporter) Shutdown(context.Context) error { return nil }
+
+// maxTraceFileBytes is the size at which the trace file rotates to
+// `<file>.1`, replacing the previous rotation. Tracing is a diagnostic
+// aid, so two generations are plenty.
+const maxTraceFileBytes = 64 << 20
+
+// fileExporter appends one OTLP JSON request per line — the layout the
+// OpenTelemetry Collector's file exporter writes and its otlpjsonfile
+// receiver reads, so a trace file can be replayed into any backend.
+type fileExporter struct {
+	path string
+	mu   sync.Mutex
+}
+
+func newFileExporter(path string) *fileExporter { return &fileExporter{path: path} }
+
+func (e *fileExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	line, err := encodeSpans(spans)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(e.path), 0o755); err != nil {
+		return err
+	}
+	if fi, statErr := os.Stat(e.path); statErr == nil && fi.Size()+int64(len(line)) > maxTraceFileBytes {
+		_ = os.Rename(e.path, e.path+".1")
+	}
+	f, err := os.OpenFile(e.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (e *fileExporter) Shutdown(context.Context) error { return nil }
@@ -0,0 +1,191 @@
+// Package tracing exports OpenTelemetry spans for the task lifecycle —
+// agent start, worktree creation, prompt install, the agent session,
+// task-done handling and next-task resolution — so a slow wildfire run
+// shows where its wall time went.
+//
+// Tracing is off unless settings.yaml `tracing.enabled` is set. While it
+// is off every Start returns a no-op span, so call sites never need to
+// check. Spans are exported either to a local JSONL file or to an
+// OTLP/HTTP collector; both carry the same OTLP JSON encoding.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/watchfire-io/watchfire/internal/buildinfo"
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// scopeName identifies the daemon's instrumentation scope in exported
+// spans.
+const scopeName = "github.com/watchfire-io/watchfire/internal/daemon"
+
+// shutdownTimeout bounds the flush of a provider being replaced or shut
+// down, so a dead collector can't hang a settings change or daemon exit.
+const shutdownTimeout = 5 * time.Second
+
+// Attribute keys shared by every lifecycle span.
+const (
+	KeyProject = attribute.Key("watchfire.project.id")
+	KeyTask    = attribute.Key("watchfire.task.number")
+	KeyBackend = attribute.Key("watchfire.agent.backend")
+	KeyMode    = attribute.Key("watchfire.agent.mode")
+	KeyPhase   = attribute.Key("watchfire.wildfire.phase")
+)
+
+// Project tags a span with the project id.
+func Project(id string) attribute.KeyValue { return KeyProject.String(id) }
+
+// Task tags a span with the task number.
+func Task(n int) attribute.KeyValue { return KeyTask.Int(n) }
+
+// Backend tags a span with the agent backend name.
+func Backend(name string) attribute.KeyValue { return KeyBackend.String(name) }
+
+// Mode tags a span with the agent mode.
+func Mode(m string) attribute.KeyValue { return KeyMode.String(m) }
+
+// Phase tags a span with the wildfire phase.
+func Phase(p string) attribute.KeyValue { return KeyPhase.String(p) }
+
+var (
+	// tracer is what Start uses; a no-op tracer while tracing is off.
+	tracer atomic.Pointer[trace.Tracer]
+
+	mu       sync.Mutex
+	provider *sdktrace.TracerProvider
+	active   *models.TracingConfig // config the current provider was built from
+)
+
+func init() { setTracer(noop.NewTracerProvider()) }
+
+func setTracer(p trace.TracerProvider) {
+	t := p.Tracer(scopeName)
+	tracer.Store(&t)
+}
+
+// Start opens a span named name as a child of any span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return (*tracer.Load()).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End closes span, marking it failed when err is non-nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Configure makes the exporter match cfg: it installs a provider when
+// tracing is enabled, swaps it when the exporter settings changed, and
+// removes it when tracing is disabled. An unchanged config is a no-op,
+// so it is safe to call on every settings write. The replaced provider
+// flushes in the background.
+func Configure(cfg *models.TracingConfig) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if cfg != nil && !cfg.Enabled {
+		cfg = nil
+	}
+	if sameConfig(active, cfg) {
+		return nil
+	}
+
+	var next *sdktrace.TracerProvider
+	if cfg != nil {
+		exp, err := newExporter(cfg)
+		if err != nil {
+			return err
+		}
+		next = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exp),
+			sdktrace.WithResource(resource.NewSchemaless(
+				attribute.String("service.name", "watchfire"),
+				attribute.String("service.version", buildinfo.Version),
+			)),
+		)
+	}
+
+	prev := provider
+	provider = next
+	if next != nil {
+		c := *cfg
+		c.Headers = maps.Clone(cfg.Headers)
+		active = &c
+		setTracer(next)
+		log.Printf("[tracing] Exporting spans via %s exporter", cfg.Exporter)
+	} else {
+		active = nil
+		setTracer(noop.NewTracerProvider())
+		log.Printf("[tracing] Span export disabled")
+	}
+	if prev != nil {
+		go shutdownProvider(prev)
+	}
+	return nil
+}
+
+// Shutdown flushes pending spans and disables tracing. Called on daemon
+// stop.
+func Shutdown() {
+	mu.Lock()
+	prev := provider
+	provider, active = nil, nil
+	setTracer(noop.NewTracerProvider())
+	mu.Unlock()
+	if prev != nil {
+		shutdownProvider(prev)
+	}
+}
+
+func shutdownProvider(p *sdktrace.TracerProvider) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := p.Shutdown(ctx); err != nil {
+		log.Printf("[tracing] Flush on shutdown failed: %v", err)
+	}
+}
+
+func sameConfig(a, b *models.TracingConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Exporter == b.Exporter && a.Endpoint == b.Endpoint && a.File == b.File &&
+		maps.Equal(a.Headers, b.Headers)
+}
+
+func newExporter(cfg *models.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case models.TracingExporterOTLP:
+		return newHTTPExporter(cfg.Endpoint, cfg.Headers), nil
+	case models.TracingExporterFile, "":
+		path := cfg.File
+		if path == "" {
+			p, err := config.GlobalTracesFile()
+			if err != nil {
+				return nil, fmt.Errorf("tracing: resolve traces file: %w", err)
+			}
+			path = p
+		}
+		return newFileExporter(path), nil
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", cfg.Exporter)
+	}
+}
@@ -0,0 +1,156 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// readTraceFile decodes every JSONL line of a trace file and returns the
+// spans keyed by name.
+func readTraceFile(t *testing.T, path string) map[string]otlpSpan {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read trace file: %v", err)
+	}
+	spans := make(map[string]otlpSpan)
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var req otlpRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			t.Fatalf("line is not an OTLP JSON request: %v\n%s", err, line)
+		}
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				if ss.Scope.Name != scopeName {
+					t.Errorf("scope = %q, want %q", ss.Scope.Name, scopeName)
+				}
+				for _, s := range ss.Spans {
+					spans[s.Name] = s
+				}
+			}
+		}
+	}
+	return spans
+}
+
+func attr(s otlpSpan, key string) *otlpValue {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return &kv.Value
+		}
+	}
+	return nil
+}
+
+// TestFileExporterRoundTrip drives the exporter the way the agent
+// manager does — a run span with nested children, one of them failing —
+// and checks the JSONL file carries the tree, the attributes and the
+// error status after Shutdown flushes.
+func TestFileExporterRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	if err := Configure(&models.TracingConfig{Enabled: true, Exporter: models.TracingExporterFile, File: path}); err != nil {
+		t.Fatalf("Configure: %v", err)
+	}
+
+	runCtx, run := Start(context.Background(), "watchfire.run", Project("p1"), Mode("wildfire"))
+	startCtx, start := Start(runCtx, "agent.start", Task(7), Backend("claude-code"))
+	_, wt := Start(startCtx, "agent.worktree")
+	End(wt, errors.New("worktree busy"))
+	End(start, nil)
+	End(run, nil)
+	Shutdown()
+
+	spans := readTraceFile(t, path)
+	for _, name := range []string{"watchfire.run", "agent.start", "agent.worktree"} {
+		if _, ok := spans[name]; !ok {
+			t.Fatalf("span %q missing; got %v", name, spans)
+		}
+	}
+	r, s, w := spans["watchfire.run"], spans["agent.start"], spans["agent.worktree"]
+	if r.ParentSpanID != "" || s.ParentSpanID != r.SpanID || w.ParentSpanID != s.SpanID {
+		t.Errorf("parent chain broken: run=%s start=%s(parent %s) worktree=%s(parent %s)",
+			r.SpanID, s.SpanID, s.ParentSpanID, w.SpanID, w.ParentSpanID)
+	}
+	if s.TraceID != r.TraceID || w.TraceID != r.TraceID || len(r.TraceID) != 32 {
+		t.Errorf("spans not in one trace: %s %s %s", r.TraceID, s.TraceID, w.TraceID)
+	}
+	if v := attr(s, string(KeyTask)); v == nil || v.IntValue == nil || *v.IntValue != "7" {
+		t.Errorf("task attribute = %+v, want intValue \"7\"", v)
+	}
+	if v := attr(r, string(KeyProject)); v == nil || v.StringValue == nil || *v.StringValue != "p1" {
+		t.Errorf("project attribute = %+v", v)
+	}
+	if w.Status.Code != otlpStatusError || w.Status.Message != "worktree busy" {
+		t.Errorf("worktree status = %+v, want error", w.Status)
+	}
+	if r.Status.Code != 0 {
+		t.Errorf("run status = %+v, want unset", r.Status)
+	}
+}
+
+// TestDisabledIsNoop checks that with tracing off spans are not
+// recorded, so instrumented call sites cost nothing.
+func TestDisabledIsNoop(t *testing.T) {
+	if err := Configure(&models.TracingConfig{Enabled: false}); err != nil {
+		t.Fatal(err)
+	}
+	_, span := Start(context.Background(), "x")
+	defer span.End()
+	if span.IsRecording() || span.SpanContext().IsValid() {
+		t.Error("disabled tracing produced a recording span")
+	}
+}
+
+// TestHTTPExporter checks the OTLP/HTTP request: path, content type,
+// configured headers, and a body that decodes as a trace request.
+func TestHTTPExporter(t *testing.T) {
+	type received struct {
+		path, contentType, apiKey string
+		body                      []byte
+	}
+	got := make(chan received, 4)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got <- received{r.URL.Path, r.Header.Get("Content-Type"), r.Header.Get("X-Api-Key"), body}
+	}))
+	defer srv.Close()
+
+	cfg := &models.TracingConfig{
+		Enabled:  true,
+		Exporter: models.TracingExporterOTLP,
+		Endpoint: srv.URL + "/",
+		Headers:  map[string]string{"X-Api-Key": "secret"},
+	}
+	if err := Configure(cfg); err != nil {
+		t.Fatalf("Configure: %v", err)
+	}
+	_, span := Start(context.Background(), "task.merge", Project("p2"))
+	End(span, nil)
+	Shutdown()
+
+	select {
+	case r := <-got:
+		if r.path != "/v1/traces" || r.contentType != "application/json" || r.apiKey != "secret" {
+			t.Errorf("request = %s %q key=%q", r.path, r.contentType, r.apiKey)
+		}
+		var req otlpRequest
+		if err := json.Unmarshal(r.body, &req); err != nil || len(req.ResourceSpans) != 1 {
+			t.Fatalf("body did not decode: %v\n%s", err, r.body)
+		}
+		if name := req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name; name != "task.merge" {
+			t.Errorf("span name = %q", name)
+		}
+	default:
+		t.Fatal("collector received nothing")
+	}
+}
@@ -111,6 +111,8 @@ type MenuInputs struct {
 	// UpdateAvailable, when true, surfaces the "Update Available — vX" row.
 	UpdateAvailable bool
 	UpdateVersion   string
+	// Budgets are the configured monthly budgets, global first.
+	Budgets []BudgetInfo
 }
 
 // DigestEntry is a single weekly-digest summary surfaced in the tray.
@@ -128,6 +130,7 @@ type DigestEntry struct {
 // Layout:
 //
 //	[header]              Watchfire (running|stopped)
+//	[budgets]             💰 <label> budget: $spent / $limit rows (when set)
 //	[separator]
 //	[needs attention]     ⚠ Needs attention (N) + per-project rows
 //	[working]             ● Working (N) + per-project rows
@@ -151,6 +154,9 @@ func BuildMenu(in MenuInputs) []MenuNode {
 		headerTitle = "Watchfire (running)"
 	}
 	out = append(out, MenuNode{Title: headerTitle, Disabled: true})
+	for _, b := range in.Budgets {
+		out = append(out, MenuNode{Title: budgetRowTitle(b), Disabled: true})
+	}
 	out = append(out, separator())
 
 	attention, working, idle := bucketProjects(in.Projects)
@@ -314,6 +320,37 @@ func BuildMenu(in MenuInputs) []MenuNode {
 	return out
 }
 
+// budgetRowTitle renders one budget row: spend against the cap, and
+// whether a hard stop is armed or already refusing agent starts.
+func budgetRowTitle(b BudgetInfo) string {
+	title := fmt.Sprintf("💰 %s budget: $%.2f / $%.2f", b.Label, b.SpentUSD, b.LimitUSD)
+	if b.LimitUSD > 0 {
+		title += fmt.Sprintf(" (%.0f%%)", b.SpentUSD/b.LimitUSD*100)
+	}
+	switch {
+	case b.Blocking:
+		title += " — ⛔ hard stop: agents blocked"
+	case b.HardStop:
+		title += " — hard stop"
+	}
+	return title
+}
+
+// budgetTooltip is the tooltip suffix for the budgets: the first
+// (global, when set) budget's spend, or the first blocking one.
+func budgetTooltip(budgets []BudgetInfo) string {
+	if len(budgets) == 0 {
+		return ""
+	}
+	for _, b := range budgets {
+		if b.Blocking {
+			return fmt.Sprintf(" — ⛔ %s budget spent ($%.2f / $%.2f)", b.Label, b.SpentUSD, b.LimitUSD)
+		}
+	}
+	b := budgets[0]
+	return fmt.Sprintf(" — %s budget $%.2f / $%.2f", b.Label, b.SpentUSD, b.LimitUSD)
+}
+
 func separator() MenuNode {
 	return MenuNode{Title: "---", Disabled: true}
 }
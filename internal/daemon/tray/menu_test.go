@@ -203,6 +203,41 @@ func TestBuildMenuIdleOverflow(t *testing.T) {
 	}
 }
 
+// TestBuildMenuBudgetRows asserts each configured budget renders as a
+// disabled row right under the header, with a spent hard-stop budget
+// flagged as blocking, and that the tooltip surfaces the blocking one.
+func TestBuildMenuBudgetRows(t *testing.T) {
+	budgets := []BudgetInfo{
+		{Label: "global", SpentUSD: 12.4, LimitUSD: 100},
+		{Label: "payments", SpentUSD: 55, LimitUSD: 50, HardStop: true, Blocking: true},
+		{Label: "docs", SpentUSD: 1, LimitUSD: 20, HardStop: true},
+	}
+	tree := BuildMenu(MenuInputs{DaemonRunning: true, Budgets: budgets})
+
+	want := []string{
+		"Watchfire (running)",
+		"💰 global budget: $12.40 / $100.00 (12%)",
+		"💰 payments budget: $55.00 / $50.00 (110%) — ⛔ hard stop: agents blocked",
+		"💰 docs budget: $1.00 / $20.00 (5%) — hard stop",
+		"---",
+	}
+	for i, w := range want {
+		if tree[i].Title != w || !tree[i].Disabled {
+			t.Fatalf("row %d = %+v, want disabled %q", i, tree[i], w)
+		}
+	}
+
+	if got := budgetTooltip(budgets); got != " — ⛔ payments budget spent ($55.00 / $50.00)" {
+		t.Fatalf("tooltip = %q", got)
+	}
+	if got := budgetTooltip(budgets[:1]); got != " — global budget $12.40 / $100.00" {
+		t.Fatalf("tooltip = %q", got)
+	}
+	if got := budgetTooltip(nil); got != "" {
+		t.Fatalf("tooltip with no budgets = %q, want empty", got)
+	}
+}
+
 // TestBuildMenuRebuildGoroutineBaseline runs the pure builder 100 times and
 // asserts no goroutine leak — BuildMenu allocates nothing of substance and
 // must NOT spawn helpers. The whole point of extracting the menu builder is
@@ -26,6 +26,22 @@ type DaemonState interface {
 	// (typically `~/.watchfire/digests/`). Used by the Notifications submenu
 	// to surface the most recent weekly digest as the topmost row.
 	DigestsDir() string
+
+	// Budgets returns the month's standing of every configured budget —
+	// the global one first — as GetGlobalInsights reports it. Rendered
+	// as the budget rows under the menu header and in the tooltip.
+	Budgets() []BudgetInfo
+}
+
+// BudgetInfo is one monthly budget's spend against its cap.
+type BudgetInfo struct {
+	Label    string // "global" or the project name
+	SpentUSD float64
+	LimitUSD float64
+	HardStop bool
+	// Blocking is true when a hard-stop budget is spent and new agent
+	// starts are refused.
+	Blocking bool
 }
 
 // AgentInfo describes a running agent for display in the tray menu.
@@ -31,6 +31,7 @@ const (
 	maxWorking   = 20
 	maxIdleSlots = MaxIdleProjects
 	maxNotifSubs = MaxNotifications
+	maxBudgets   = 8
 )
 
 var (
@@ -49,6 +50,9 @@ var (
 	// Header & port.
 	headerItem *systray.MenuItem
 
+	// Budget rows under the header (global first, then per project).
+	budgetRows [maxBudgets]*systray.MenuItem
+
 	// Pre-allocated section header + row pools.
 	attentionHeader *systray.MenuItem
 	attentionRows   [maxAttention]*systray.MenuItem
@@ -121,7 +125,7 @@ func onReady() {
 	iconIdle = iconData
 	iconActive = generateActiveIcon(iconData)
 	setTrayIcon(iconIdle)
-	systray.SetTooltip(formatTooltip(0, 0))
+	systray.SetTooltip(formatTooltip(0, 0, nil))
 
 	focusBus = focus.New()
 
@@ -135,6 +139,12 @@ func onReady() {
 	portItem = systray.AddMenuItem("Starting…", "")
 	portItem.Disable()
 
+	for i := 0; i < maxBudgets; i++ {
+		budgetRows[i] = systray.AddMenuItem("", "")
+		budgetRows[i].Disable()
+		budgetRows[i].Hide()
+	}
+
 	systray.AddSeparator()
 
 	// === Section: Needs attention ===
@@ -443,7 +453,7 @@ func applyRebuild() {
 			activeCount++
 		}
 	}
-	systray.SetTooltip(formatTooltip(len(in.Projects), activeCount))
+	systray.SetTooltip(formatTooltip(len(in.Projects), activeCount, in.Budgets))
 	if activeCount > 0 {
 		setTrayIcon(iconActive)
 	} else {
@@ -516,6 +526,7 @@ func snapshotInputs() MenuInputs {
 		LatestDigest:            latestDigest,
 		UpdateAvailable:         updateAvail,
 		UpdateVersion:           updateVer,
+		Budgets:                 state.Budgets(),
 	}
 }
 
@@ -537,6 +548,7 @@ func applyTreeToSlots(tree []MenuNode) {
 	overflowText := ""
 	notifRootTitle := "Notifications (0 today) ▸"
 	notifRowsUsed := 0
+	budgetRowsUsed := 0
 	updateAvail := false
 	updateAvailTitle := ""
 
@@ -546,6 +558,9 @@ func applyTreeToSlots(tree []MenuNode) {
 	hideAllWorking()
 	hideAllIdle()
 	hideAllNotifRows()
+	for i := 0; i < maxBudgets; i++ {
+		budgetRows[i].Hide()
+	}
 
 	for _, node := range tree {
 		if node.Title == "---" {
@@ -570,6 +585,15 @@ func applyTreeToSlots(tree []MenuNode) {
 			idleHeader.Show()
 			continue
 		}
+		// Budget rows.
+		if node.Disabled && strings.HasPrefix(node.Title, "💰") {
+			if budgetRowsUsed < maxBudgets {
+				budgetRows[budgetRowsUsed].SetTitle(node.Title)
+				budgetRows[budgetRowsUsed].Show()
+				budgetRowsUsed++
+			}
+			continue
+		}
 		// Idle overflow row.
 		if node.Disabled && strings.HasPrefix(node.Title, "…") {
 			overflowText = node.Title
@@ -728,8 +752,8 @@ func detectCompletions(old map[string]AgentInfo, current []AgentInfo) {
 	}
 }
 
-func formatTooltip(projects, active int) string {
-	return fmt.Sprintf("Watchfire — %d projects, %d active", projects, active)
+func formatTooltip(projects, active int, budgets []BudgetInfo) string {
+	return fmt.Sprintf("Watchfire — %d projects, %d active", projects, active) + budgetTooltip(budgets)
 }
 
 // generateActiveIcon overlays a small orange dot (notification badge) on the
@@ -60,6 +60,9 @@ const (
 	// v10 Torch retrofit-definition session finished folding done tasks
 	// into the definition.
 	EventRetrofitDone
+	// EventQuestionAsked fires when question.yaml is written — a working
+	// agent asking a person for input (see the ask package).
+	EventQuestionAsked
 )
 
 // Signal file names for phase completion.
@@ -69,6 +72,9 @@ const (
 	DefinitionDoneFile = "definition_done.yaml"
 	TasksDoneFile      = "tasks_done.yaml"
 	RetrofitDoneFile   = "retrofit_done.yaml"
+	// QuestionFile is not a phase signal: an agent writes it to ask a
+	// person something mid-session and keeps waiting for the answer.
+	QuestionFile = "question.yaml"
 )
 
 // Event represents a file system change event.
@@ -350,6 +356,14 @@ func (w *Watcher) processFileChange(path string, op fsnotify.Op) {
 			}
 			return
 		}
+		if dir == projectDir && filename == QuestionFile {
+			w.eventsChan <- Event{
+				Type:      EventQuestionAsked,
+				ProjectID: projectID,
+				Path:      path,
+			}
+			return
+		}
 
 		// Check for secrets directory changes → treat as project changed
 		secretsDir := config.ProjectSecretsDir(projectPath)
@@ -0,0 +1,297 @@
+// Package logsearch maintains a full-text inverted index over session
+// logs (rendered transcripts, or scrollback when there is none) and
+// answers `watchfire logs search` / LogService.SearchLogs queries.
+//
+// One index file per project lives under ~/.watchfire/search-index/.
+// The daemon adds each session as its log is written (IndexLog, from
+// writeSessionLog) and every search first reconciles the index with the
+// logs directory, so logs written before the index existed — or missed
+// by a crash — are picked up, and deleted logs drop out.
+package logsearch
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// indexVersion is bumped whenever tokenisation or the on-disk layout
+// changes; an index with another version is discarded and rebuilt.
+const indexVersion = 1
+
+// maxIndexedBytes caps how much of one log is indexed. Sessions that
+// stream megabytes of build output would otherwise dominate the index
+// for little search value.
+const maxIndexedBytes = 4 << 20
+
+// doc is one indexed session log.
+type doc struct {
+	LogID      string
+	TaskNumber int
+	Agent      string
+	Mode       string
+	Status     string
+	StartedAt  string
+	Length     int // number of tokens, for BM25 length normalisation
+}
+
+type posting struct {
+	Doc int32
+	TF  int32
+}
+
+// index is the per-project inverted index. Doc slots are never reused
+// while loaded; removed docs leave a nil slot that save compacts away.
+type index struct {
+	Version  int
+	Docs     []*doc
+	Postings map[string][]posting
+
+	byLog map[string]int32 // logID → slot, rebuilt on load
+	dirty bool
+}
+
+var (
+	mu    sync.Mutex
+	cache = map[string]*index{}
+)
+
+func newIndex() *index {
+	return &index{Version: indexVersion, Postings: map[string][]posting{}, byLog: map[string]int32{}}
+}
+
+func indexPath(projectID string) (string, error) {
+	dir, err := config.GlobalSearchIndexDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, projectID+".idx"), nil
+}
+
+// loadLocked returns the project's index, reading it from disk on first
+// use. A missing, unreadable or outdated file yields an empty index that
+// the next reconcile fills. Must be called while holding mu.
+func loadLocked(projectID string) *index {
+	if idx, ok := cache[projectID]; ok {
+		return idx
+	}
+	idx := newIndex()
+	if path, err := indexPath(projectID); err == nil {
+		if f, openErr := os.Open(path); openErr == nil {
+			var onDisk index
+			if gob.NewDecoder(f).Decode(&onDisk) == nil && onDisk.Version == indexVersion {
+				idx = &onDisk
+				if idx.Postings == nil {
+					idx.Postings = map[string][]posting{}
+				}
+				idx.byLog = make(map[string]int32, len(idx.Docs))
+				for i, d := range idx.Docs {
+					if d != nil {
+						idx.byLog[d.LogID] = int32(i)
+					}
+				}
+			}
+			_ = f.Close()
+		}
+	}
+	cache[projectID] = idx
+	return idx
+}
+
+// saveLocked writes the index if it changed, compacting removed slots
+// first. Written to a temp file and renamed so a crash never leaves a
+// truncated index. Must be called while holding mu.
+func (idx *index) saveLocked(projectID string) error {
+	if !idx.dirty {
+		return nil
+	}
+	idx.compact()
+	path, err := indexPath(projectID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(idx); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	idx.dirty = false
+	return nil
+}
+
+// compact drops nil doc slots and renumbers postings.
+func (idx *index) compact() {
+	remap := make([]int32, len(idx.Docs))
+	docs := idx.Docs[:0]
+	for i, d := range idx.Docs {
+		if d == nil {
+			remap[i] = -1
+			continue
+		}
+		remap[i] = int32(len(docs))
+		docs = append(docs, d)
+	}
+	if len(docs) == len(idx.Docs) {
+		return
+	}
+	for i := len(docs); i < len(idx.Docs); i++ {
+		idx.Docs[i] = nil
+	}
+	idx.Docs = docs
+	for term, list := range idx.Postings {
+		kept := list[:0]
+		for _, p := range list {
+			if n := remap[p.Doc]; n >= 0 {
+				kept = append(kept, posting{Doc: n, TF: p.TF})
+			}
+		}
+		if len(kept) == 0 {
+			delete(idx.Postings, term)
+		} else {
+			idx.Postings[term] = kept
+		}
+	}
+	idx.byLog = make(map[string]int32, len(idx.Docs))
+	for i, d := range idx.Docs {
+		idx.byLog[d.LogID] = int32(i)
+	}
+}
+
+// add indexes content under entry, replacing any previous version.
+func (idx *index) add(entry *models.LogEntry, content string) {
+	idx.remove(entry.LogID)
+	if len(content) > maxIndexedBytes {
+		content = content[:maxIndexedBytes]
+	}
+	tf := map[string]int32{}
+	tokens := tokenize(content)
+	for _, t := range tokens {
+		tf[t]++
+	}
+	slot := int32(len(idx.Docs))
+	idx.Docs = append(idx.Docs, &doc{
+		LogID:      entry.LogID,
+		TaskNumber: entry.TaskNumber,
+		Agent:      entry.Agent,
+		Mode:       entry.Mode,
+		Status:     entry.Status,
+		StartedAt:  entry.StartedAt,
+		Length:     len(tokens),
+	})
+	idx.byLog[entry.LogID] = slot
+	for term, n := range tf {
+		idx.Postings[term] = append(idx.Postings[term], posting{Doc: slot, TF: n})
+	}
+	idx.dirty = true
+}
+
+// remove drops a log from the index. Its postings are left pointing at
+// the nil slot until the next compact; search skips them.
+func (idx *index) remove(logID string) bool {
+	slot, ok := idx.byLog[logID]
+	if !ok {
+		return false
+	}
+	idx.Docs[slot] = nil
+	delete(idx.byLog, logID)
+	idx.dirty = true
+	return true
+}
+
+// reconcileLocked brings the index in line with the project's logs
+// directory: unindexed logs are read and added, vanished ones removed.
+// Must be called while holding mu.
+func (idx *index) reconcileLocked(projectID string) error {
+	logsDir, err := config.GlobalLogsDir()
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(filepath.Join(logsDir, projectID))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	present := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !isSessionLog(name) {
+			continue
+		}
+		logID := strings.TrimSuffix(name, ".log")
+		present[logID] = true
+		if _, ok := idx.byLog[logID]; ok {
+			continue
+		}
+		entry, content, readErr := config.ReadLog(projectID, logID)
+		if readErr != nil {
+			continue
+		}
+		entry.LogID = logID
+		idx.add(entry, content)
+	}
+	for logID := range idx.byLog {
+		if !present[logID] {
+			idx.remove(logID)
+		}
+	}
+	return nil
+}
+
+// isSessionLog reports whether a file in a project logs dir is a session
+// log. The per-project daemon.log shares the directory and extension.
+func isSessionLog(name string) bool {
+	return strings.HasSuffix(name, ".log") && name != "daemon.log"
+}
+
+// IndexLog adds (or re-indexes) one session log. The daemon calls it
+// after writeSessionLog has written the log and copied the transcript,
+// so the rendered transcript is what gets indexed.
+func IndexLog(projectID, logID string) error {
+	entry, content, err := config.ReadLog(projectID, logID)
+	if err != nil {
+		return fmt.Errorf("read log for indexing: %w", err)
+	}
+	entry.LogID = logID
+
+	mu.Lock()
+	defer mu.Unlock()
+	idx := loadLocked(projectID)
+	idx.add(entry, content)
+	return idx.saveLocked(projectID)
+}
+
+// RemoveLog drops a deleted session log from the index.
+func RemoveLog(projectID, logID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	idx := loadLocked(projectID)
+	if !idx.remove(logID) {
+		return nil
+	}
+	return idx.saveLocked(projectID)
+}
+
+// ErrEmptyQuery is returned by Search for a query with no searchable
+// terms (only punctuation or single characters).
+var ErrEmptyQuery = errors.New("query has no searchable terms")
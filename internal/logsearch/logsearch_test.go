@@ -0,0 +1,157 @@
+package logsearch
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+)
+
+// setup isolates HOME and the in-memory index cache per test.
+func setup(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	mu.Lock()
+	cache = map[string]*index{}
+	mu.Unlock()
+}
+
+func writeLog(t *testing.T, projectID string, task int, agent, mode, status string, started time.Time, lines ...string) string {
+	t.Helper()
+	entry, err := config.WriteLog(projectID, task, 0, agent, mode, status, started, lines)
+	if err != nil {
+		t.Fatalf("WriteLog: %v", err)
+	}
+	return entry.LogID
+}
+
+func TestParseQuery(t *testing.T) {
+	q := ParseQuery(`Timeout in "merge conflict" a x`)
+	if !reflect.DeepEqual(q.Terms, []string{"merge", "conflict", "timeout", "in"}) {
+		t.Errorf("Terms = %v", q.Terms)
+	}
+	if !reflect.DeepEqual(q.Phrases, []string{"merge conflict"}) {
+		t.Errorf("Phrases = %v", q.Phrases)
+	}
+	if !ParseQuery(`- ! x`).Empty() {
+		t.Error("punctuation-only query should be empty")
+	}
+}
+
+// TestSearchRanksAndFilters — AND semantics, BM25 ranking (more mentions
+// rank higher), and the metadata filters.
+func TestSearchRanksAndFilters(t *testing.T) {
+	setup(t)
+	const pid = "p1"
+	day := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	heavy := writeLog(t, pid, 1, "claude-code", "task", "completed", day,
+		"running go test", "panic: nil map write", "panic again in write_session_log")
+	light := writeLog(t, pid, 2, "codex", "task", "interrupted", day.AddDate(0, 0, 1),
+		"one panic here", "then lots of unrelated output about build steps and linting")
+	writeLog(t, pid, 0, "claude-code", "chat", "completed", day.AddDate(0, 0, 2), "nothing to see")
+
+	hits, err := Search("panic", Filter{ProjectIDs: []string{pid}}, 0)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(hits) != 2 || hits[0].Entry.LogID != heavy || hits[1].Entry.LogID != light {
+		t.Fatalf("hits = %+v, want [%s %s]", hitIDs(hits), heavy, light)
+	}
+	if len(hits[0].Snippets) == 0 || hits[0].Snippets[0] != "panic: nil map write" {
+		t.Errorf("snippets = %q", hits[0].Snippets)
+	}
+
+	cases := []struct {
+		name   string
+		query  string
+		filter Filter
+		want   []string
+	}{
+		{"and", "panic write_session_log", Filter{}, []string{heavy}},
+		{"agent", "panic", Filter{Agent: "codex"}, []string{light}},
+		{"task", "panic", Filter{TaskNumber: 1}, []string{heavy}},
+		{"status", "panic", Filter{Status: "interrupted"}, []string{light}},
+		{"since", "panic", Filter{Since: day.Add(time.Hour)}, []string{light}},
+		{"until", "panic", Filter{Until: day.Add(time.Hour)}, []string{heavy}},
+		{"missing term", "panic zebra", Filter{}, nil},
+	}
+	for _, c := range cases {
+		c.filter.ProjectIDs = []string{pid}
+		hits, err := Search(c.query, c.filter, 0)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if got := hitIDs(hits); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// TestSearchPhrase — both docs contain both words, only one has them
+// adjacent.
+func TestSearchPhrase(t *testing.T) {
+	setup(t)
+	const pid = "p2"
+	now := time.Now()
+	want := writeLog(t, pid, 1, "claude-code", "task", "completed", now, "hit a merge conflict in main.go")
+	writeLog(t, pid, 2, "claude-code", "task", "completed", now.Add(time.Second), "conflict resolved before merge")
+
+	hits, err := Search(`"merge conflict"`, Filter{ProjectIDs: []string{pid}}, 0)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); !reflect.DeepEqual(got, []string{want}) {
+		t.Errorf("got %v, want [%s]", got, want)
+	}
+}
+
+// TestIndexPersistsAndReconciles — the index survives a cache drop, picks
+// up a log written behind its back, and forgets a deleted one.
+func TestIndexPersistsAndReconciles(t *testing.T) {
+	setup(t)
+	const pid = "p3"
+	now := time.Now()
+	first := writeLog(t, pid, 1, "claude-code", "task", "completed", now, "alpha bravo")
+	if err := IndexLog(pid, first); err != nil {
+		t.Fatalf("IndexLog: %v", err)
+	}
+	path, _ := indexPath(pid)
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("index not written: %v", err)
+	}
+
+	mu.Lock()
+	cache = map[string]*index{}
+	mu.Unlock()
+	second := writeLog(t, pid, 2, "claude-code", "task", "completed", now.Add(time.Second), "alpha charlie")
+
+	hits, _ := Search("alpha", Filter{ProjectIDs: []string{pid}}, 0)
+	if len(hits) != 2 {
+		t.Fatalf("hits = %v, want both logs", hitIDs(hits))
+	}
+
+	if err := config.DeleteLog(pid, first); err != nil {
+		t.Fatal(err)
+	}
+	hits, _ = Search("alpha", Filter{ProjectIDs: []string{pid}}, 0)
+	if got := hitIDs(hits); !reflect.DeepEqual(got, []string{second}) {
+		t.Errorf("after delete got %v, want [%s]", got, second)
+	}
+}
+
+func TestSearchEmptyQuery(t *testing.T) {
+	setup(t)
+	if _, err := Search(`"" -`, Filter{ProjectIDs: []string{"p"}}, 0); err != ErrEmptyQuery {
+		t.Errorf("err = %v, want ErrEmptyQuery", err)
+	}
+}
+
+func hitIDs(hits []Hit) []string {
+	var ids []string
+	for _, h := range hits {
+		ids = append(ids, h.Entry.LogID)
+	}
+	return ids
+}
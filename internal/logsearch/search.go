@@ -0,0 +1,281 @@
+package logsearch
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/watchfire-io/watchfire/internal/config"
+	"github.com/watchfire-io/watchfire/internal/models"
+)
+
+// BM25 parameters (the usual defaults).
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Result shaping.
+const (
+	// DefaultLimit is used when the caller passes limit <= 0.
+	DefaultLimit = 20
+	// maxVerified bounds how many ranked candidates are read back from
+	// disk to check phrases and cut snippets.
+	maxVerified     = 200
+	maxSnippets     = 3
+	maxSnippetWidth = 160
+)
+
+// Filter narrows a search. Zero values match everything.
+type Filter struct {
+	ProjectIDs []string // empty = every registered project
+	Agent      string
+	Mode       string
+	Status     string
+	TaskNumber int
+	Since      time.Time // inclusive, compared with the session start
+	Until      time.Time // exclusive
+}
+
+// Hit is one matching session log.
+type Hit struct {
+	Entry    *models.LogEntry
+	Score    float64
+	Snippets []string // matching lines, trimmed around the first match
+}
+
+type candidate struct {
+	projectID string
+	logID     string
+	startedAt string
+	score     float64
+}
+
+// Search runs raw against the indexes of the filtered projects and
+// returns up to limit hits, best first. Every searched project's index is
+// reconciled with its logs directory first.
+func Search(raw string, f Filter, limit int) ([]Hit, error) {
+	q := ParseQuery(raw)
+	if q.Empty() {
+		return nil, ErrEmptyQuery
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	projectIDs := f.ProjectIDs
+	if len(projectIDs) == 0 {
+		idx, err := config.LoadProjectsIndex()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range idx.Projects {
+			projectIDs = append(projectIDs, p.ProjectID)
+		}
+	}
+
+	var cands []candidate
+	for _, pid := range projectIDs {
+		found, err := searchProject(pid, q, f)
+		if err != nil {
+			config.ProjectLogf(pid, "[logsearch] search failed: %v", err)
+			continue
+		}
+		cands = append(cands, found...)
+	}
+	sort.Slice(cands, func(i, j int) bool {
+		if cands[i].score != cands[j].score {
+			return cands[i].score > cands[j].score
+		}
+		return cands[i].startedAt > cands[j].startedAt
+	})
+
+	hits := make([]Hit, 0, limit)
+	for i, c := range cands {
+		if len(hits) == limit || i == maxVerified {
+			break
+		}
+		entry, content, err := config.ReadLog(c.projectID, c.logID)
+		if err != nil {
+			continue
+		}
+		entry.LogID = c.logID
+		lower := strings.ToLower(content)
+		if !containsAll(lower, q.Phrases) {
+			continue
+		}
+		hits = append(hits, Hit{Entry: entry, Score: c.score, Snippets: snippets(content, q)})
+	}
+	return hits, nil
+}
+
+// searchProject scores one project's documents. A document must contain
+// every query term.
+func searchProject(projectID string, q Query, f Filter) ([]candidate, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	idx := loadLocked(projectID)
+	if err := idx.reconcileLocked(projectID); err != nil {
+		return nil, err
+	}
+	if err := idx.saveLocked(projectID); err != nil {
+		config.ProjectLogf(projectID, "[logsearch] failed to save index: %v", err)
+	}
+
+	live, totalLen := 0, 0
+	for _, d := range idx.Docs {
+		if d != nil {
+			live++
+			totalLen += d.Length
+		}
+	}
+	if live == 0 {
+		return nil, nil
+	}
+	avgLen := float64(totalLen) / float64(live)
+
+	type acc struct {
+		score   float64
+		matched int
+	}
+	scores := map[int32]*acc{}
+	for ti, term := range q.Terms {
+		list := idx.Postings[term]
+		df := 0
+		for _, p := range list {
+			if idx.Docs[p.Doc] != nil {
+				df++
+			}
+		}
+		if df == 0 {
+			return nil, nil // AND semantics: one missing term rules out every doc
+		}
+		idf := math.Log(1 + (float64(live)-float64(df)+0.5)/(float64(df)+0.5))
+		for _, p := range list {
+			d := idx.Docs[p.Doc]
+			if d == nil {
+				continue
+			}
+			a := scores[p.Doc]
+			if a == nil {
+				if ti > 0 {
+					continue // missed an earlier term
+				}
+				a = &acc{}
+				scores[p.Doc] = a
+			}
+			if a.matched != ti {
+				continue
+			}
+			tf := float64(p.TF)
+			norm := tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(d.Length)/avgLen))
+			a.score += idf * norm
+			a.matched++
+		}
+	}
+
+	var out []candidate
+	for slot, a := range scores {
+		if a.matched != len(q.Terms) {
+			continue
+		}
+		d := idx.Docs[slot]
+		if !f.matches(d) {
+			continue
+		}
+		out = append(out, candidate{projectID: projectID, logID: d.LogID, startedAt: d.StartedAt, score: a.score})
+	}
+	return out, nil
+}
+
+func (f Filter) matches(d *doc) bool {
+	if f.Agent != "" && !strings.EqualFold(d.Agent, f.Agent) {
+		return false
+	}
+	if f.Mode != "" && !strings.EqualFold(d.Mode, f.Mode) {
+		return false
+	}
+	if f.Status != "" && !strings.EqualFold(d.Status, f.Status) {
+		return false
+	}
+	if f.TaskNumber > 0 && d.TaskNumber != f.TaskNumber {
+		return false
+	}
+	if !f.Since.IsZero() || !f.Until.IsZero() {
+		started, err := time.Parse(time.RFC3339, d.StartedAt)
+		if err != nil {
+			return false
+		}
+		if !f.Since.IsZero() && started.Before(f.Since) {
+			return false
+		}
+		if !f.Until.IsZero() && !started.Before(f.Until) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAll(lower string, phrases []string) bool {
+	for _, p := range phrases {
+		if !strings.Contains(lower, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// snippets returns up to maxSnippets lines that contain a query term,
+// each trimmed to a window around the first match.
+func snippets(content string, q Query) []string {
+	needles := q.Phrases
+	if len(needles) == 0 {
+		needles = q.Terms
+	}
+	var out []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		lower := strings.ToLower(line)
+		at := -1
+		for _, n := range needles {
+			if i := strings.Index(lower, n); i >= 0 && (at < 0 || i < at) {
+				at = i
+			}
+		}
+		if at < 0 {
+			continue
+		}
+		if len(lower) != len(line) {
+			at = 0 // case folding changed byte widths; offsets don't carry over
+		}
+		out = append(out, trimAround(line, at))
+		if len(out) == maxSnippets {
+			break
+		}
+	}
+	return out
+}
+
+// trimAround cuts line to maxSnippetWidth runes centred near byte offset
+// at, marking cut ends with an ellipsis.
+func trimAround(line string, at int) string {
+	runes := []rune(line)
+	if len(runes) <= maxSnippetWidth {
+		return line
+	}
+	pos := utf8.RuneCountInString(line[:at])
+	start := max(0, pos-maxSnippetWidth/3)
+	end := min(len(runes), start+maxSnippetWidth)
+	start = max(0, end-maxSnippetWidth)
+	s := string(runes[start:end])
+	if start > 0 {
+		s = "…" + s
+	}
+	if end < len(runes) {
+		s += "…"
+	}
+	return s
+}
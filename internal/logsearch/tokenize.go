@@ -0,0 +1,88 @@
+package logsearch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token length bounds. Single characters match nearly every document and
+// very long runs are base64 blobs or hashes nobody searches for.
+const (
+	minTokenLen = 2
+	maxTokenLen = 64
+)
+
+// tokenize lowercases s and splits it into index terms: runs of letters,
+// digits and underscores. Underscores stay inside tokens so identifiers
+// like `write_session_log` remain searchable as a unit.
+func tokenize(s string) []string {
+	var out []string
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		if n := end - start; n >= minTokenLen && n <= maxTokenLen {
+			out = append(out, strings.ToLower(s[start:end]))
+		}
+		start = -1
+	}
+	for i, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(s))
+	return out
+}
+
+// Query is a parsed search string: bare words are ANDed terms, and
+// "double-quoted" segments must also appear verbatim (case-insensitive).
+type Query struct {
+	Terms   []string
+	Phrases []string
+}
+
+// ParseQuery splits raw into terms and phrases. Words inside a phrase
+// are added to Terms as well so the index narrows candidates before the
+// phrase is checked against the text.
+func ParseQuery(raw string) Query {
+	var q Query
+	seen := map[string]bool{}
+	addTerms := func(s string) {
+		for _, t := range tokenize(s) {
+			if !seen[t] {
+				seen[t] = true
+				q.Terms = append(q.Terms, t)
+			}
+		}
+	}
+	for {
+		open := strings.IndexByte(raw, '"')
+		if open < 0 {
+			break
+		}
+		closing := strings.IndexByte(raw[open+1:], '"')
+		if closing < 0 {
+			break
+		}
+		phrase := strings.TrimSpace(raw[open+1 : open+1+closing])
+		if phrase != "" {
+			q.Phrases = append(q.Phrases, strings.ToLower(phrase))
+			addTerms(phrase)
+		}
+		addTerms(raw[:open])
+		raw = raw[open+1+closing+1:]
+	}
+	addTerms(raw)
+	return q
+}
+
+// Empty reports whether the query has nothing to search for.
+func (q Query) Empty() bool {
+	return len(q.Terms) == 0
+}
@@ -255,6 +255,7 @@ func TestCatalogSchemaConstraints(t *testing.T) {
 		{tool: "get_insights", prop: "scope", wantEnum: []string{"project", "global"}, wantDefault: "project"},
 		{tool: "wait_for_task", prop: "timeout_seconds", wantDefault: float64(300), wantMin: 1, wantMax: 600},
 		{tool: "get_agent_screen", prop: "lines", wantDefault: float64(100), wantMin: 1, wantMax: 1000},
+		{tool: "get_hotspots", prop: "limit", wantDefault: float64(10), wantMin: 1, wantMax: 100},
 	}
 	for _, tc := range cases {
 		tool, ok := byName[tc.tool]
@@ -307,6 +308,7 @@ func TestCatalogRequiredArguments(t *testing.T) {
 		"get_task_diff":    {"task_number"},
 		"get_agent_screen": {},
 		"get_insights":     {},
+		"get_hotspots":     {},
 		"list_logs":        {},
 		"get_log":          {"log_id"},
 		// v10.1 Torch — Telegram bridge control.
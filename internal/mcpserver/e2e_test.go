@@ -328,7 +328,7 @@ func testFactoryLoop(t *testing.T, env *e2eEnv) {
 		"list_projects", "get_project",
 		"create_task", "list_tasks", "get_task", "update_task", "delete_task",
 		"run_task", "run_all", "start_wildfire", "stop_agent", "get_agent_status", "wait_for_task",
-		"get_task_diff", "get_agent_screen", "get_insights", "list_logs", "get_log",
+		"get_task_diff", "get_agent_screen", "get_insights", "get_hotspots", "list_logs", "get_log",
 	} {
 		tool, ok := got[name]
 		if !ok {
@@ -24,6 +24,7 @@ import (
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/watchfire-io/watchfire/internal/config"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
@@ -140,8 +141,8 @@ func newTool[In any](spec toolSpec, handler func(context.Context, *server, In) (
 				tool.InputSchema = schema
 			}
 			mcp.AddTool(m, tool,
-				func(ctx context.Context, _ *mcp.CallToolRequest, in In) (*mcp.CallToolResult, any, error) {
-					out, err := handler(ctx, s, in)
+				func(ctx context.Context, req *mcp.CallToolRequest, in In) (*mcp.CallToolResult, any, error) {
+					out, err := handler(withClientName(ctx, req), s, in)
 					if err != nil {
 						return nil, nil, err
 					}
@@ -153,6 +154,29 @@ func newTool[In any](spec toolSpec, handler func(context.Context, *server, In) (
 
 func hintPtr(b bool) *bool { return &b }
 
+type clientNameKey struct{}
+
+// withClientName tags ctx with the calling client's name from its
+// initialize handshake ("claude-desktop"), which requestMeta turns into
+// the attribution of the tasks and runs the call creates.
+func withClientName(ctx context.Context, req *mcp.CallToolRequest) context.Context {
+	if req == nil || req.Session == nil {
+		return ctx
+	}
+	if p := req.Session.InitializeParams(); p != nil && p.ClientInfo != nil && p.ClientInfo.Name != "" {
+		return context.WithValue(ctx, clientNameKey{}, p.ClientInfo.Name)
+	}
+	return ctx
+}
+
+// requestMeta is the RequestMeta of a daemon request made on behalf of
+// the calling client: the MCP origin, and "mcp:<client name>" as the
+// acting user when the client named itself.
+func requestMeta(ctx context.Context) *pb.RequestMeta {
+	name, _ := ctx.Value(clientNameKey{}).(string)
+	return &pb.RequestMeta{Origin: mcpOrigin, User: config.RemoteUser(mcpOrigin, name)}
+}
+
 // allTools is the full registry.
 func allTools() []toolDef {
 	var defs []toolDef
@@ -241,7 +265,7 @@ func Serve(ctx context.Context, opts Options) error {
 		insights:     pb.NewInsightsServiceClient(conn),
 		logs:         pb.NewLogServiceClient(conn),
 		integrations: pb.NewIntegrationsServiceClient(conn),
-		readOnly: opts.ReadOnly,
+		readOnly:     opts.ReadOnly,
 	}
 
 	defaultID, err := detectDefaultProject()
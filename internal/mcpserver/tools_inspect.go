@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/x/ansi"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/watchfire-io/watchfire/proto"
 )
@@ -41,10 +43,17 @@ var inspectTools = []toolDef{
 	newTool(toolSpec{
 		Group: groupInspect, Name: "get_insights", Title: "Get insights",
 		ReadOnly: true, Idempotent: true,
-		Description: "Get a compact productivity summary: task throughput (total / succeeded / failed, success rate), duration and cost totals, shipped-code output (commits, files changed, lines added/removed, merges), and a per-agent breakdown. scope \"project\" (the default) summarizes one project; scope \"global\" aggregates every registered project and lists the top projects, ignoring the \"project\" argument.",
+		Description: "Get a compact productivity summary: task throughput (total / succeeded / failed, success rate), duration and cost totals, shipped-code output (commits, files changed, lines added/removed, merges), a per-agent breakdown, and usage and spend per person (who started or created the work). scope \"project\" (the default) summarizes one project; scope \"global\" aggregates every registered project and lists the top projects, ignoring the \"project\" argument.",
 	}, handleGetInsights,
 		enumProperty("scope", "project", "global"),
 		defaultProperty("scope", `"project"`)),
+	newTool(toolSpec{
+		Group: groupInspect, Name: "get_hotspots", Title: "Get hotspots",
+		ReadOnly: true, Idempotent: true,
+		Description: "Find where agents work and where they struggle in a project, aggregated from the diffs of its completed tasks: the most-touched files (task count, lines added/removed, weekly churn over the last 12 weeks), the files that appear in failed tasks or failed / conflicted merges, and the most-touched directories. Optional \"days\" limits it to tasks completed in the last N days (default: all time); \"limit\" caps each list (default 10, max 100). Use it before writing a task prompt to warn the agent about fragile areas.",
+	}, handleGetHotspots,
+		defaultProperty("limit", "10"),
+		rangeProperty("limit", 1, insightsMaxHotspotLimit)),
 	newTool(toolSpec{
 		Group: groupInspect, Name: "list_logs", Title: "List session logs",
 		ReadOnly: true, Idempotent: true,
@@ -298,6 +307,105 @@ func tailWindow(total, n int32) (offset, limit int32) {
 	return total - n, n
 }
 
+// ---------------------------------------------------------------------------
+// get_hotspots
+
+// insightsMaxHotspotLimit mirrors the daemon's clamp (insights.MaxHotspotLimit).
+const insightsMaxHotspotLimit = 100
+
+type getHotspotsArgs struct {
+	Project string `json:"project,omitempty" jsonschema:"Project id or name (see list_projects). Optional when the server runs inside a registered project directory."`
+	Days    int    `json:"days,omitempty" jsonschema:"Only tasks completed in the last N days. Omit for all time."`
+	Limit   int    `json:"limit,omitempty" jsonschema:"Maximum rows per list."`
+}
+
+type hotspotFileRow struct {
+	Path          string  `json:"path"`
+	Tasks         int32   `json:"tasks"`
+	FailedTasks   int32   `json:"failed_tasks,omitempty"`
+	MergeFailures int32   `json:"merge_failures,omitempty"`
+	LinesAdded    int32   `json:"lines_added"`
+	LinesRemoved  int32   `json:"lines_removed"`
+	WeeklyChurn   []int32 `json:"weekly_churn,omitempty"`
+}
+
+type hotspotDirRow struct {
+	Path          string `json:"path"`
+	Tasks         int32  `json:"tasks"`
+	Files         int32  `json:"files"`
+	FailedTasks   int32  `json:"failed_tasks,omitempty"`
+	MergeFailures int32  `json:"merge_failures,omitempty"`
+	LinesAdded    int32  `json:"lines_added"`
+	LinesRemoved  int32  `json:"lines_removed"`
+}
+
+type hotspotsResult struct {
+	ProjectID        string           `json:"project_id"`
+	TasksScanned     int32            `json:"tasks_scanned"`
+	TasksWithoutDiff int32            `json:"tasks_without_diff,omitempty"`
+	Weeks            []string         `json:"weeks"`
+	Files            []hotspotFileRow `json:"files"`
+	FailureFiles     []hotspotFileRow `json:"failure_files"`
+	Directories      []hotspotDirRow  `json:"directories"`
+}
+
+func handleGetHotspots(ctx context.Context, s *server, args getHotspotsArgs) (any, error) {
+	if args.Days < 0 {
+		return nil, fmt.Errorf("\"days\" must be positive")
+	}
+	projectID, err := s.resolveProject(ctx, args.Project)
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.GetHotspotsRequest{ProjectId: projectID, Limit: int32(args.Limit)}
+	if args.Days > 0 {
+		now := time.Now()
+		req.WindowStart = timestamppb.New(now.AddDate(0, 0, -args.Days))
+		req.WindowEnd = timestamppb.New(now)
+	}
+	h, err := s.insights.GetHotspots(ctx, req)
+	if err != nil {
+		return nil, rpcErr("get hotspots", err)
+	}
+	out := hotspotsResult{
+		ProjectID:        h.ProjectId,
+		TasksScanned:     h.TasksScanned,
+		TasksWithoutDiff: h.TasksWithoutDiff,
+		Weeks:            h.Weeks,
+		Files:            hotspotFileRows(h.Files),
+		FailureFiles:     hotspotFileRows(h.FailureFiles),
+		Directories:      make([]hotspotDirRow, 0, len(h.Directories)),
+	}
+	for _, d := range h.Directories {
+		out.Directories = append(out.Directories, hotspotDirRow{
+			Path:          d.Path,
+			Tasks:         d.Tasks,
+			Files:         d.Files,
+			FailedTasks:   d.FailedTasks,
+			MergeFailures: d.MergeFailures,
+			LinesAdded:    d.LinesAdded,
+			LinesRemoved:  d.LinesRemoved,
+		})
+	}
+	return out, nil
+}
+
+func hotspotFileRows(files []*pb.HotspotFile) []hotspotFileRow {
+	rows := make([]hotspotFileRow, 0, len(files))
+	for _, f := range files {
+		rows = append(rows, hotspotFileRow{
+			Path:          f.Path,
+			Tasks:         f.Tasks,
+			FailedTasks:   f.FailedTasks,
+			MergeFailures: f.MergeFailures,
+			LinesAdded:    f.LinesAdded,
+			LinesRemoved:  f.LinesRemoved,
+			WeeklyChurn:   f.WeeklyChurn,
+		})
+	}
+	return rows
+}
+
 // ---------------------------------------------------------------------------
 // get_insights
 
@@ -329,6 +437,15 @@ type topProjectRow struct {
 	NetLines int32  `json:"net_lines"`
 }
 
+type userUsageRow struct {
+	User         string  `json:"user"` // "(unattributed)" for work with no recorded person
+	Tasks        int32   `json:"tasks"`
+	SuccessRate  float64 `json:"success_rate"`
+	TasksCreated int32   `json:"tasks_created"`
+	Sessions     int32   `json:"sessions"`
+	TotalCostUSD float64 `json:"total_cost_usd"`
+}
+
 type insightsSummary struct {
 	Scope            string            `json:"scope"`
 	ProjectID        string            `json:"project_id,omitempty"`
@@ -340,9 +457,11 @@ type insightsSummary struct {
 	AvgDurationMs    int64             `json:"avg_duration_ms,omitempty"`
 	TotalCostUSD     float64           `json:"total_cost_usd"`
 	TasksMissingCost int32             `json:"tasks_missing_cost,omitempty"`
+	EstimatedCostUSD float64           `json:"estimated_cost_usd,omitempty"` // share of total_cost_usd estimated from the pricing table
 	CodeOutput       codeOutputSummary `json:"code_output"`
 	Agents           []agentThroughput `json:"agents,omitempty"`
 	TopProjects      []topProjectRow   `json:"top_projects,omitempty"`
+	Users            []userUsageRow    `json:"users,omitempty"`
 }
 
 func handleGetInsights(ctx context.Context, s *server, args getInsightsArgs) (any, error) {
@@ -376,6 +495,7 @@ func projectInsightsSummary(ctx context.Context, s *server, project string) (any
 		AvgDurationMs:    in.AvgDurationMs,
 		TotalCostUSD:     in.TotalCostUsd,
 		TasksMissingCost: in.TasksMissingCost,
+		EstimatedCostUSD: in.EstimatedCostUsd,
 		CodeOutput: codeOutputSummary{
 			Commits:                 in.TotalCommits,
 			FilesChanged:            in.TotalFilesChanged,
@@ -387,6 +507,7 @@ func projectInsightsSummary(ctx context.Context, s *server, project string) (any
 			TasksMissingCodeMetrics: in.MetricsMissingCode,
 		},
 		Agents: agentRows(in.AgentBreakdown),
+		Users:  userRows(in.Users),
 	}, nil
 }
 
@@ -404,6 +525,7 @@ func globalInsightsSummary(ctx context.Context, s *server) (any, error) {
 		TotalDurationMs:  in.TotalDurationMs,
 		TotalCostUSD:     in.TotalCostUsd,
 		TasksMissingCost: in.TasksMissingCost,
+		EstimatedCostUSD: in.EstimatedCostUsd,
 		CodeOutput: codeOutputSummary{
 			Commits:                 in.TotalCommits,
 			FilesChanged:            in.TotalFilesChanged,
@@ -415,6 +537,7 @@ func globalInsightsSummary(ctx context.Context, s *server) (any, error) {
 			TasksMissingCodeMetrics: in.MetricsMissingCode,
 		},
 		Agents: agentRows(in.AgentBreakdown),
+		Users:  userRows(in.Users),
 	}
 	// v9.2: the daemon no longer truncates `top_projects` (the GUI reads it
 	// for per-project churn lookup), so the compact-summary cap this tool
@@ -436,6 +559,25 @@ func globalInsightsSummary(ctx context.Context, s *server) (any, error) {
 // regardless of how many projects are registered.
 const maxSummaryTopProjects = 5
 
+func userRows(users []*pb.UserUsage) []userUsageRow {
+	rows := make([]userUsageRow, 0, len(users))
+	for _, u := range users {
+		name := u.User
+		if name == "" {
+			name = "(unattributed)"
+		}
+		rows = append(rows, userUsageRow{
+			User:         name,
+			Tasks:        u.Tasks,
+			SuccessRate:  u.SuccessRate,
+			TasksCreated: u.TasksCreated,
+			Sessions:     u.Sessions,
+			TotalCostUSD: u.TotalCostUsd,
+		})
+	}
+	return rows
+}
+
 func agentRows(breakdown []*pb.AgentBreakdown) []agentThroughput {
 	rows := make([]agentThroughput, 0, len(breakdown))
 	for _, a := range breakdown {
@@ -22,6 +22,7 @@ type fakeInsightsClient struct {
 	diffFn    func(*pb.GetTaskDiffRequest) (*pb.FileDiffSet, error)
 	projectFn func(*pb.GetProjectInsightsRequest) (*pb.ProjectInsights, error)
 	globalFn  func(*pb.GetGlobalInsightsRequest) (*pb.GlobalInsights, error)
+	hotspotFn func(*pb.GetHotspotsRequest) (*pb.Hotspots, error)
 }
 
 func (f *fakeInsightsClient) GetTaskDiff(_ context.Context, req *pb.GetTaskDiffRequest, _ ...grpc.CallOption) (*pb.FileDiffSet, error) {
@@ -36,6 +37,10 @@ func (f *fakeInsightsClient) GetGlobalInsights(_ context.Context, req *pb.GetGlo
 	return f.globalFn(req)
 }
 
+func (f *fakeInsightsClient) GetHotspots(_ context.Context, req *pb.GetHotspotsRequest, _ ...grpc.CallOption) (*pb.Hotspots, error) {
+	return f.hotspotFn(req)
+}
+
 type fakeLogClient struct {
 	pb.LogServiceClient
 	listFn func(*pb.ListLogsRequest) (*pb.LogList, error)
@@ -314,6 +319,52 @@ func TestGetAgentScreenClampsAndEmpty(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// get_hotspots
+
+func TestGetHotspots(t *testing.T) {
+	var got *pb.GetHotspotsRequest
+	s := testServer(&fakeTaskClient{})
+	s.insights = &fakeInsightsClient{
+		hotspotFn: func(req *pb.GetHotspotsRequest) (*pb.Hotspots, error) {
+			got = req
+			return &pb.Hotspots{
+				ProjectId: req.ProjectId, TasksScanned: 4, TasksWithoutDiff: 1,
+				Files:       []*pb.HotspotFile{{Path: "internal/a.go", Tasks: 2, FailedTasks: 1, LinesAdded: 5, WeeklyChurn: []int32{0, 4}}},
+				Directories: []*pb.HotspotDirectory{{Path: "internal", Tasks: 3, Files: 2}},
+			}, nil
+		},
+	}
+
+	out, err := handleGetHotspots(context.Background(), s, getHotspotsArgs{Days: 30, Limit: 5})
+	if err != nil {
+		t.Fatalf("handleGetHotspots: %v", err)
+	}
+	if got.ProjectId != "id-demo" || got.Limit != 5 {
+		t.Errorf("unexpected request: %+v", got)
+	}
+	if got.WindowStart == nil || got.WindowEnd == nil {
+		t.Error("days must set a window")
+	}
+	res := out.(hotspotsResult)
+	if res.TasksScanned != 4 || res.TasksWithoutDiff != 1 {
+		t.Errorf("unexpected counts: %+v", res)
+	}
+	if len(res.Files) != 1 || res.Files[0].Path != "internal/a.go" || len(res.Files[0].WeeklyChurn) != 2 {
+		t.Errorf("unexpected files: %+v", res.Files)
+	}
+	if res.FailureFiles == nil || len(res.FailureFiles) != 0 {
+		t.Errorf("failure files must be an empty list: %+v", res.FailureFiles)
+	}
+	if len(res.Directories) != 1 || res.Directories[0].Files != 2 {
+		t.Errorf("unexpected directories: %+v", res.Directories)
+	}
+
+	if _, err := handleGetHotspots(context.Background(), s, getHotspotsArgs{Days: -1}); err == nil {
+		t.Error("negative days must be rejected")
+	}
+}
+
 // ---------------------------------------------------------------------------
 // get_insights
 
@@ -500,6 +551,7 @@ func TestRegisteredToolsReadOnly(t *testing.T) {
 	want := []string{
 		"get_agent_screen",
 		"get_agent_status",
+		"get_hotspots",
 		"get_insights",
 		"get_log",
 		"get_project",
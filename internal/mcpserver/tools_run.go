@@ -117,7 +117,7 @@ func handleRunTask(ctx context.Context, s *server, args taskRefArgs) (any, error
 		return nil, err
 	}
 
-	status, err := s.agents.StartAgent(ctx, startAgentRequest(projectID, "task", args.TaskNumber))
+	status, err := s.agents.StartAgent(ctx, startAgentRequest(ctx, projectID, "task", args.TaskNumber))
 	if err != nil {
 		return nil, rpcErr(fmt.Sprintf("start an agent on task %d", args.TaskNumber), err)
 	}
@@ -137,7 +137,7 @@ func handleRunAll(ctx context.Context, s *server, args agentProjectArgs) (any, e
 		return nil, err
 	}
 
-	status, err := s.agents.StartAgent(ctx, startAgentRequest(projectID, "start-all", 0))
+	status, err := s.agents.StartAgent(ctx, startAgentRequest(ctx, projectID, "start-all", 0))
 	if err != nil {
 		return nil, rpcErr("start run-all", err)
 	}
@@ -158,7 +158,7 @@ func handleStartWildfire(ctx context.Context, s *server, args agentProjectArgs)
 		return nil, err
 	}
 
-	status, err := s.agents.StartAgent(ctx, startAgentRequest(projectID, "wildfire", 0))
+	status, err := s.agents.StartAgent(ctx, startAgentRequest(ctx, projectID, "wildfire", 0))
 	if err != nil {
 		return nil, rpcErr("start wildfire", err)
 	}
@@ -299,10 +299,11 @@ func (s *server) requireNoRunningAgent(ctx context.Context, projectID string) er
 }
 
 // startAgentRequest builds the common StartAgent request: rows/cols 0 (daemon
-// default PTY size) and sandbox "auto" (project setting decides).
-func startAgentRequest(projectID, mode string, taskNumber int32) *pb.StartAgentRequest {
+// default PTY size) and sandbox "auto" (project setting decides), on behalf
+// of the calling client.
+func startAgentRequest(ctx context.Context, projectID, mode string, taskNumber int32) *pb.StartAgentRequest {
 	return &pb.StartAgentRequest{
-		Meta:       &pb.RequestMeta{Origin: "mcp"},
+		Meta:       requestMeta(ctx),
 		ProjectId:  projectID,
 		Mode:       mode,
 		TaskNumber: taskNumber,
@@ -110,6 +110,8 @@ type taskDetail struct {
 	CompletedAt        string `json:"completed_at,omitempty"`
 	UpdatedAt          string `json:"updated_at,omitempty"`
 	DeletedAt          string `json:"deleted_at,omitempty"`
+	CreatedBy          string `json:"created_by,omitempty"`
+	StartedBy          string `json:"started_by,omitempty"`
 }
 
 func handleCreateTask(ctx context.Context, s *server, args createTaskArgs) (any, error) {
@@ -138,6 +140,7 @@ func handleCreateTask(ctx context.Context, s *server, args createTaskArgs) (any,
 	}
 
 	req := &pb.CreateTaskRequest{
+		Meta:      requestMeta(ctx),
 		ProjectId: projectID,
 		Title:     args.Title,
 		Prompt:    args.Prompt,
@@ -294,6 +297,8 @@ func protoTaskDetail(t *pb.Task) taskDetail {
 		CompletedAt:        formatTimestamp(t.CompletedAt),
 		UpdatedAt:          formatTimestamp(t.UpdatedAt),
 		DeletedAt:          formatTimestamp(t.DeletedAt),
+		CreatedBy:          t.CreatedBy,
+		StartedBy:          t.StartedBy,
 	}
 	if t.FailureReason != nil {
 		d.FailureReason = *t.FailureReason
@@ -7,20 +7,142 @@
 // reads / writes these structs and the YAML they serialise to.
 package models
 
-import "time"
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event keys name notification events in config: the integration event
+// lists below and the per-project overrides in ProjectNotifications.
+// Each is the lower-cased notify.Kind.
+const (
+	EventTaskFailed      = "task_failed"
+	EventRunComplete     = "run_complete"
+	EventWeeklyDigest    = "weekly_digest"
+	EventBudgetThreshold = "budget_threshold"
+	EventTaskSucceeded   = "task_succeeded"
+	EventMergeFailed     = "merge_failed"
+	EventPROpened        = "pr_opened"
+	EventAgentNeedsAuth  = "agent_needs_auth"
+	EventRateLimited     = "rate_limited"
+	EventWildfirePhase   = "wildfire_phase_changed"
+	EventTasksGenerated  = "tasks_generated"
+)
 
-// EventBitmask is a tri-event toggle for outbound integrations. Each
+// ExtendedEvents are the events kept in EventBitmask.Events rather than
+// in a fixed field.
+var ExtendedEvents = []string{
+	EventTaskSucceeded,
+	EventMergeFailed,
+	EventPROpened,
+	EventAgentNeedsAuth,
+	EventRateLimited,
+	EventWildfirePhase,
+	EventTasksGenerated,
+}
+
+// NotificationEvents lists every event key, in the order settings
+// surfaces show them.
+var NotificationEvents = append([]string{
+	EventTaskFailed,
+	EventRunComplete,
+	EventWeeklyDigest,
+	EventBudgetThreshold,
+}, ExtendedEvents...)
+
+// EventBitmask selects the events an outbound integration receives. Each
 // integration carries its own copy so the user can fan TASK_FAILED to
 // Slack but RUN_COMPLETE to Discord without duplicating endpoints.
+//
+// The four original events keep their fixed booleans so existing YAML
+// reads unchanged; every later event lives in Events, keyed by event key,
+// so adding one needs no new field. Read through Enabled.
 type EventBitmask struct {
 	TaskFailed   bool `yaml:"task_failed" json:"task_failed"`
 	RunComplete  bool `yaml:"run_complete" json:"run_complete"`
 	WeeklyDigest bool `yaml:"weekly_digest" json:"weekly_digest"`
+	// BudgetThreshold relays monthly-budget alerts. Off by default, like
+	// the digest, so existing integrations don't start receiving them.
+	BudgetThreshold bool `yaml:"budget_threshold,omitempty" json:"budget_threshold,omitempty"`
+	// Events toggles the remaining events ("task_succeeded",
+	// "pr_opened", …). A missing key is off, except merge_failed and
+	// pr_opened, which follow task_failed and run_complete — they were
+	// delivered as those kinds before they had their own.
+	Events map[string]bool `yaml:"events,omitempty" json:"events,omitempty"`
+}
+
+// Enabled reports whether the event with the given key is selected.
+func (e EventBitmask) Enabled(key string) bool {
+	switch key {
+	case EventTaskFailed:
+		return e.TaskFailed
+	case EventRunComplete:
+		return e.RunComplete
+	case EventWeeklyDigest:
+		return e.WeeklyDigest
+	case EventBudgetThreshold:
+		return e.BudgetThreshold
+	}
+	if v, ok := e.Events[key]; ok {
+		return v
+	}
+	switch key {
+	case EventMergeFailed:
+		return e.TaskFailed
+	case EventPROpened:
+		return e.RunComplete
+	}
+	return false
+}
+
+// Set turns the event with the given key on or off. Unknown keys are
+// ignored.
+func (e *EventBitmask) Set(key string, on bool) {
+	switch key {
+	case EventTaskFailed:
+		e.TaskFailed = on
+	case EventRunComplete:
+		e.RunComplete = on
+	case EventWeeklyDigest:
+		e.WeeklyDigest = on
+	case EventBudgetThreshold:
+		e.BudgetThreshold = on
+	default:
+		if !isNotificationEvent(key) {
+			return
+		}
+		if e.Events == nil {
+			e.Events = map[string]bool{}
+		}
+		e.Events[key] = on
+	}
+}
+
+// Keys returns the selected event keys in NotificationEvents order.
+func (e EventBitmask) Keys() []string {
+	var out []string
+	for _, key := range NotificationEvents {
+		if e.Enabled(key) {
+			out = append(out, key)
+		}
+	}
+	return out
 }
 
-// AnySet returns true if at least one event bit is set.
+// AnySet returns true if at least one event is selected.
 func (e EventBitmask) AnySet() bool {
-	return e.TaskFailed || e.RunComplete || e.WeeklyDigest
+	return len(e.Keys()) > 0
+}
+
+func isNotificationEvent(key string) bool {
+	for _, k := range NotificationEvents {
+		if k == key {
+			return true
+		}
+	}
+	return false
 }
 
 // WebhookEndpoint is a single generic outbound webhook target. Secret is
@@ -37,12 +159,16 @@ type WebhookEndpoint struct {
 
 // SlackEndpoint targets a Slack incoming webhook. The URL is itself the
 // secret, so YAML stores only an empty placeholder + the keyring ref;
-// `LoadIntegrations` resolves the URL on demand.
+// `LoadIntegrations` resolves the URL on demand. `TeamID` optionally
+// records the Slack workspace the webhook posts into — a webhook URL
+// doesn't say — so a workspace's `watch` snapshots stay in it, as
+// `DiscordEndpoint.GuildID` does for guilds.
 type SlackEndpoint struct {
 	ID             string       `yaml:"id" json:"id"`
 	Label          string       `yaml:"label" json:"label"`
 	URLRef         string       `yaml:"url_ref,omitempty" json:"url_ref,omitempty"`
 	URL            string       `yaml:"-" json:"-"`
+	TeamID         string       `yaml:"team_id,omitempty" json:"team_id,omitempty"`
 	EnabledEvents  EventBitmask `yaml:"enabled_events" json:"enabled_events"`
 	ProjectMuteIDs []string     `yaml:"project_mute_ids,omitempty" json:"project_mute_ids,omitempty"`
 }
@@ -62,6 +188,113 @@ type DiscordEndpoint struct {
 	ProjectMuteIDs []string     `yaml:"project_mute_ids,omitempty" json:"project_mute_ids,omitempty"`
 }
 
+// TeamsEndpoint targets a Microsoft Teams incoming webhook (a Workflows
+// "post to a channel when a webhook request is received" URL, or a
+// legacy connector URL). Like Slack, the URL is the secret: YAML stores
+// only the keyring ref.
+type TeamsEndpoint struct {
+	ID             string       `yaml:"id" json:"id"`
+	Label          string       `yaml:"label" json:"label"`
+	URLRef         string       `yaml:"url_ref,omitempty" json:"url_ref,omitempty"`
+	URL            string       `yaml:"-" json:"-"`
+	EnabledEvents  EventBitmask `yaml:"enabled_events" json:"enabled_events"`
+	ProjectMuteIDs []string     `yaml:"project_mute_ids,omitempty" json:"project_mute_ids,omitempty"`
+}
+
+// MatrixEndpoint posts into one Matrix room through the client-server
+// API. The homeserver URL and room ID are plain config; the access token
+// of the posting account lives in the keyring under AccessTokenRef.
+type MatrixEndpoint struct {
+	ID             string       `yaml:"id" json:"id"`
+	Label          string       `yaml:"label" json:"label"`
+	HomeserverURL  string       `yaml:"homeserver_url" json:"homeserver_url"`
+	RoomID         string       `yaml:"room_id" json:"room_id"`
+	AccessTokenRef string       `yaml:"access_token_ref,omitempty" json:"access_token_ref,omitempty"`
+	AccessToken    string       `yaml:"-" json:"-"`
+	EnabledEvents  EventBitmask `yaml:"enabled_events" json:"enabled_events"`
+	ProjectMuteIDs []string     `yaml:"project_mute_ids,omitempty" json:"project_mute_ids,omitempty"`
+}
+
+// NtfyEndpoint publishes to one ntfy topic. URL is the full topic URL
+// (`https://ntfy.sh/<topic>` or a self-hosted server); the optional
+// access token for protected topics lives in the keyring under TokenRef.
+type NtfyEndpoint struct {
+	ID             string       `yaml:"id" json:"id"`
+	Label          string       `yaml:"label" json:"label"`
+	URL            string       `yaml:"url" json:"url"`
+	TokenRef       string       `yaml:"token_ref,omitempty" json:"token_ref,omitempty"`
+	Token          string       `yaml:"-" json:"-"`
+	EnabledEvents  EventBitmask `yaml:"enabled_events" json:"enabled_events"`
+	ProjectMuteIDs []string     `yaml:"project_mute_ids,omitempty" json:"project_mute_ids,omitempty"`
+}
+
+// Email transport security modes for EmailEndpoint.Security.
+const (
+	EmailSecurityStartTLS = "starttls" // plain connect, then STARTTLS (required) — port 587
+	EmailSecurityTLS      = "tls"      // implicit TLS from the first byte — port 465
+	EmailSecurityNone     = "none"     // no TLS; only for a trusted local relay
+)
+
+// EmailEndpoint delivers notifications over SMTP. One endpoint is one
+// outgoing server + sender; each recipient picks its own events, so a
+// manager can take only the weekly digest while the on-call engineer
+// takes failures. The SMTP password lives in the keyring under
+// PasswordRef.
+type EmailEndpoint struct {
+	ID             string           `yaml:"id" json:"id"`
+	Label          string           `yaml:"label" json:"label"`
+	Host           string           `yaml:"host" json:"host"`
+	Port           int              `yaml:"port,omitempty" json:"port,omitempty"`
+	Security       string           `yaml:"security,omitempty" json:"security,omitempty"`
+	Username       string           `yaml:"username,omitempty" json:"username,omitempty"`
+	PasswordRef    string           `yaml:"password_ref,omitempty" json:"password_ref,omitempty"`
+	Password       string           `yaml:"-" json:"-"`
+	From           string           `yaml:"from" json:"from"`
+	Recipients     []EmailRecipient `yaml:"recipients" json:"recipients"`
+	ProjectMuteIDs []string         `yaml:"project_mute_ids,omitempty" json:"project_mute_ids,omitempty"`
+}
+
+// EmailRecipient is one address on an EmailEndpoint with its own event
+// selection.
+type EmailRecipient struct {
+	Address       string       `yaml:"address" json:"address"`
+	EnabledEvents EventBitmask `yaml:"enabled_events" json:"enabled_events"`
+}
+
+// SecurityMode returns the configured transport security, defaulting to
+// STARTTLS.
+func (e EmailEndpoint) SecurityMode() string {
+	switch e.Security {
+	case EmailSecurityTLS, EmailSecurityNone:
+		return e.Security
+	}
+	return EmailSecurityStartTLS
+}
+
+// ServerPort returns the configured port, defaulting to the well-known
+// port for the security mode (465 for implicit TLS, 587 otherwise).
+func (e EmailEndpoint) ServerPort() int {
+	if e.Port > 0 {
+		return e.Port
+	}
+	if e.SecurityMode() == EmailSecurityTLS {
+		return 465
+	}
+	return 587
+}
+
+// RecipientsFor returns the addresses that selected the event with the
+// given key, in configured order.
+func (e EmailEndpoint) RecipientsFor(eventKey string) []string {
+	var out []string
+	for _, r := range e.Recipients {
+		if r.Address != "" && r.EnabledEvents.Enabled(eventKey) {
+			out = append(out, r.Address)
+		}
+	}
+	return out
+}
+
 // GitHubConfig is the single-instance auto-PR configuration. Only one
 // GitHubConfig exists per Watchfire install — the project scopes list
 // names which projects get the PR flow instead of the silent merge.
@@ -210,6 +443,60 @@ type InboundConfig struct {
 	DiscordBotUsername      string `yaml:"discord_bot_username,omitempty" json:"discord_bot_username,omitempty"`
 	DiscordBotDiscriminator string `yaml:"discord_bot_discriminator,omitempty" json:"discord_bot_discriminator,omitempty"`
 	DiscordDefaultChannel   string `yaml:"discord_default_channel,omitempty" json:"discord_default_channel,omitempty"`
+
+	// SlackRoles / DiscordRoles are the chat-command role mappings, keyed
+	// by Slack team id / Discord guild id (see ChatRoleMap):
+	//
+	//	slack_roles:
+	//	  T024BE7LH:
+	//	    default: viewer
+	//	    users: {U024BE7LH: admin, U0G9QF9C6: operator}
+	//	discord_roles:
+	//	  "123456789012345678":
+	//	    users: {"987654321098765432": admin}
+	//	    roles: {"555555555555555555": operator}
+	//
+	// A workspace without an entry makes everyone a viewer: acting on
+	// tasks, approving merges and running agents all need a mapping.
+	SlackRoles   map[string]ChatRoleMap `yaml:"slack_roles,omitempty" json:"slack_roles,omitempty"`
+	DiscordRoles map[string]ChatRoleMap `yaml:"discord_roles,omitempty" json:"discord_roles,omitempty"`
+
+	// AgentRunners is the pre-roles `agent_runners` list of users allowed
+	// to start and stop agents, keyed by Slack team id or Discord guild
+	// id. It is only read: FoldAgentRunners moves it into the role maps
+	// on load, so the next save writes admin mappings instead.
+	AgentRunners map[string][]string `yaml:"agent_runners,omitempty" json:"-"`
+}
+
+// FoldAgentRunners maps every user of the legacy AgentRunners list to
+// admin in its workspace's role map — a guild id is all digits, a Slack
+// team id never is — and clears the list. A user the role maps already
+// list keeps that role.
+func (c *InboundConfig) FoldAgentRunners() {
+	for workspace, users := range c.AgentRunners {
+		if workspace == "" {
+			continue
+		}
+		roles := &c.SlackRoles
+		if strings.Trim(workspace, "0123456789") == "" {
+			roles = &c.DiscordRoles
+		}
+		if *roles == nil {
+			*roles = map[string]ChatRoleMap{}
+		}
+		m := (*roles)[workspace]
+		for _, u := range users {
+			if _, ok := m.Users[u]; ok || u == "" {
+				continue
+			}
+			if m.Users == nil {
+				m.Users = map[string]ChatRole{}
+			}
+			m.Users[u] = ChatRoleAdmin
+		}
+		(*roles)[workspace] = m
+	}
+	c.AgentRunners = nil
 }
 
 // EffectiveGitHost returns the GitHost value with empty defaulting to
@@ -260,6 +547,60 @@ type TelegramConfig struct {
 	BotToken      string               `yaml:"-" json:"-"`
 	EnabledEvents EventBitmask         `yaml:"enabled_events" json:"enabled_events"`
 	PairedChats   []TelegramPairedChat `yaml:"paired_chats,omitempty" json:"paired_chats,omitempty"`
+
+	// Roles maps Telegram user ids to chat-command roles. The user who
+	// paired a chat is an admin unless listed otherwise; anyone else in
+	// a paired (group) chat gets Roles.Default, or operator when no
+	// mapping is configured.
+	Roles *ChatRoleMap `yaml:"roles,omitempty" json:"roles,omitempty"`
+}
+
+// RouteRule sends the notifications it matches to chosen endpoints or
+// Telegram chats instead of the default routing (every endpoint whose
+// event toggle is on). Rules are evaluated in order and the first match
+// wins unless it sets Continue; a notification no rule matches is routed
+// by default. Project mutes and muted chats still apply to routed
+// deliveries.
+//
+//	routes:
+//	  - name: x-codex-failures
+//	    match: {events: [task_failed], projects: [x], agents: [codex]}
+//	    to: {endpoints: [slack-x-alerts]}
+type RouteRule struct {
+	Name  string       `yaml:"name" json:"name"`
+	Match RouteMatch   `yaml:"match" json:"match"`
+	To    RouteTargets `yaml:"to" json:"to"`
+	// Continue keeps evaluating later rules after this one matches, so
+	// their targets are added too.
+	Continue bool `yaml:"continue,omitempty" json:"continue,omitempty"`
+	// KeepDefault delivers through the default routing as well as to
+	// this rule's targets.
+	KeepDefault bool `yaml:"keep_default,omitempty" json:"keep_default,omitempty"`
+}
+
+// RouteMatch is a rule's filter. Every field that is set must match;
+// a list matches when any entry does. An empty match matches everything.
+type RouteMatch struct {
+	Events   []string `yaml:"events,omitempty" json:"events,omitempty"`     // event keys
+	Projects []string `yaml:"projects,omitempty" json:"projects,omitempty"` // project id or name
+	Agents   []string `yaml:"agents,omitempty" json:"agents,omitempty"`     // agent backend, e.g. codex
+	// Title and FailureReason are regular expressions. FailureReason is
+	// matched against the task's failure reason, or the notification's
+	// detail (merge error, agent issue) when there is none.
+	Title         string `yaml:"title,omitempty" json:"title,omitempty"`
+	FailureReason string `yaml:"failure_reason,omitempty" json:"failure_reason,omitempty"`
+	// MinDuration / MaxDuration bound how long the task ran ("30m",
+	// "2h"). A notification without a task duration never matches them.
+	MinDuration string `yaml:"min_duration,omitempty" json:"min_duration,omitempty"`
+	MaxDuration string `yaml:"max_duration,omitempty" json:"max_duration,omitempty"`
+}
+
+// RouteTargets names where a matched notification goes. Endpoints are
+// integration ids; "telegram" is every paired chat, and TelegramChats
+// narrows Telegram delivery to the listed chats.
+type RouteTargets struct {
+	Endpoints     []string `yaml:"endpoints,omitempty" json:"endpoints,omitempty"`
+	TelegramChats []int64  `yaml:"telegram_chats,omitempty" json:"telegram_chats,omitempty"`
 }
 
 // IntegrationsConfig is the root document persisted at
@@ -267,7 +608,10 @@ type TelegramConfig struct {
 // here; each subset can be empty. v8.0 Echo adds `Inbound` for the
 // inbound HTTP listener — purely additive, defaults to disabled.
 // v10.0 Torch adds `Telegram` — nil means "not configured", preserving
-// prior behaviour byte-for-byte.
+// prior behaviour byte-for-byte. Teams, Matrix and ntfy are further
+// multi-instance endpoint lists shaped like Slack / Discord; Email adds
+// SMTP endpoints with per-recipient event selection. Routes holds the
+// optional routing rules evaluated before the default fan-out.
 type IntegrationsConfig struct {
 	Webhooks []WebhookEndpoint `yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
 	Slack    []SlackEndpoint   `yaml:"slack,omitempty" json:"slack,omitempty"`
@@ -275,6 +619,11 @@ type IntegrationsConfig struct {
 	GitHub   GitHubConfig      `yaml:"github" json:"github"`
 	Inbound  InboundConfig     `yaml:"inbound,omitempty" json:"inbound,omitempty"`
 	Telegram *TelegramConfig   `yaml:"telegram,omitempty" json:"telegram,omitempty"`
+	Teams    []TeamsEndpoint   `yaml:"teams,omitempty" json:"teams,omitempty"`
+	Matrix   []MatrixEndpoint  `yaml:"matrix,omitempty" json:"matrix,omitempty"`
+	Ntfy     []NtfyEndpoint    `yaml:"ntfy,omitempty" json:"ntfy,omitempty"`
+	Email    []EmailEndpoint   `yaml:"email,omitempty" json:"email,omitempty"`
+	Routes   []RouteRule       `yaml:"routes,omitempty" json:"routes,omitempty"`
 }
 
 // NewIntegrationsConfig returns a zero-value config. Used by the loader
@@ -282,3 +631,107 @@ type IntegrationsConfig struct {
 func NewIntegrationsConfig() *IntegrationsConfig {
 	return &IntegrationsConfig{}
 }
+
+// ChatRole is what a chat user may do through the inbound chat commands
+// (Slack / Discord `/watchfire`, the Telegram bridge). Roles are ordered:
+// a viewer reads status and screens; an operator also acts on tasks
+// (retry, cancel, answer questions, approve merges, create tasks); an
+// admin also starts and stops agents.
+type ChatRole string
+
+const (
+	ChatRoleViewer   ChatRole = "viewer"
+	ChatRoleOperator ChatRole = "operator"
+	ChatRoleAdmin    ChatRole = "admin"
+)
+
+// rank orders the roles; an unknown role ranks below viewer.
+func (r ChatRole) rank() int {
+	switch r {
+	case ChatRoleViewer:
+		return 1
+	case ChatRoleOperator:
+		return 2
+	case ChatRoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Valid reports whether r is one of the known roles.
+func (r ChatRole) Valid() bool { return r.rank() > 0 }
+
+// Allows reports whether r grants everything need does.
+func (r ChatRole) Allows(need ChatRole) bool { return r.rank() >= need.rank() }
+
+// ChatRoleMap assigns chat roles within one workspace. Users maps user
+// ids to a role; Roles maps Discord role ids to a role, the highest one
+// the member holds applying. A user listed in Users gets exactly that
+// role, even when a Discord role would grant more. Default applies to
+// everyone else; empty means viewer.
+type ChatRoleMap struct {
+	Default ChatRole            `yaml:"default,omitempty" json:"default,omitempty"`
+	Users   map[string]ChatRole `yaml:"users,omitempty" json:"users,omitempty"`
+	Roles   map[string]ChatRole `yaml:"roles,omitempty" json:"roles,omitempty"`
+}
+
+// RoleOf resolves userID's role; memberRoles are the Discord role ids
+// the user holds (nil elsewhere).
+func (m ChatRoleMap) RoleOf(userID string, memberRoles []string) ChatRole {
+	if r, ok := m.Users[userID]; ok {
+		return r
+	}
+	best := ChatRole("")
+	for _, id := range memberRoles {
+		if r, ok := m.Roles[id]; ok && r.rank() > best.rank() {
+			best = r
+		}
+	}
+	if best.Valid() {
+		return best
+	}
+	if m.Default.Valid() {
+		return m.Default
+	}
+	return ChatRoleViewer
+}
+
+// SlackRole is the role of Slack user userID in workspace teamID.
+func (c *IntegrationsConfig) SlackRole(teamID, userID string) ChatRole {
+	m, ok := c.Inbound.SlackRoles[teamID]
+	if !ok {
+		return ChatRoleViewer
+	}
+	return m.RoleOf(userID, nil)
+}
+
+// DiscordRole is the role of Discord user userID, holding memberRoles,
+// in guild guildID.
+func (c *IntegrationsConfig) DiscordRole(guildID, userID string, memberRoles []string) ChatRole {
+	m, ok := c.Inbound.DiscordRoles[guildID]
+	if !ok {
+		return ChatRoleViewer
+	}
+	return m.RoleOf(userID, memberRoles)
+}
+
+// TelegramRole is the role of Telegram user userID in a paired chat.
+func (c *IntegrationsConfig) TelegramRole(userID int64) ChatRole {
+	if c.Telegram == nil {
+		return ChatRoleViewer
+	}
+	id := strconv.FormatInt(userID, 10)
+	if m := c.Telegram.Roles; m != nil {
+		if r, ok := m.Users[id]; ok {
+			return r
+		}
+	}
+	if slices.ContainsFunc(c.Telegram.PairedChats, func(p TelegramPairedChat) bool { return p.UserID == userID }) {
+		return ChatRoleAdmin
+	}
+	if m := c.Telegram.Roles; m != nil {
+		return m.RoleOf(id, nil)
+	}
+	return ChatRoleOperator
+}
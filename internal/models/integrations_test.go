@@ -0,0 +1,94 @@
+package models
+
+import "testing"
+
+func TestChatRoleMapRoleOf(t *testing.T) {
+	m := ChatRoleMap{
+		Default: ChatRoleOperator,
+		Users:   map[string]ChatRole{"U-admin": ChatRoleAdmin, "U-demoted": ChatRoleViewer},
+		Roles:   map[string]ChatRole{"r-ops": ChatRoleOperator, "r-leads": ChatRoleAdmin},
+	}
+	for _, tc := range []struct {
+		user  string
+		roles []string
+		want  ChatRole
+	}{
+		{"U-admin", nil, ChatRoleAdmin},
+		{"U-demoted", []string{"r-leads"}, ChatRoleViewer}, // a user entry wins over roles
+		{"U-other", []string{"r-ops", "r-leads"}, ChatRoleAdmin},
+		{"U-other", []string{"r-unknown"}, ChatRoleOperator},
+	} {
+		if got := m.RoleOf(tc.user, tc.roles); got != tc.want {
+			t.Errorf("RoleOf(%q, %v) = %q, want %q", tc.user, tc.roles, got, tc.want)
+		}
+	}
+	if got := (ChatRoleMap{}).RoleOf("U-other", nil); got != ChatRoleViewer {
+		t.Errorf("empty map = %q, want viewer", got)
+	}
+	if !ChatRoleAdmin.Allows(ChatRoleOperator) || ChatRoleViewer.Allows(ChatRoleOperator) || ChatRole("root").Allows(ChatRoleViewer) {
+		t.Error("role ordering is wrong")
+	}
+}
+
+func TestIntegrationsChatRoles(t *testing.T) {
+	c := &IntegrationsConfig{
+		Inbound: InboundConfig{
+			SlackRoles:   map[string]ChatRoleMap{"T-1": {Users: map[string]ChatRole{"U-1": ChatRoleAdmin}}},
+			DiscordRoles: map[string]ChatRoleMap{"g-1": {Roles: map[string]ChatRole{"r-1": ChatRoleOperator}}},
+		},
+		Telegram: &TelegramConfig{
+			PairedChats: []TelegramPairedChat{{ChatID: -100, UserID: 7}},
+			Roles:       &ChatRoleMap{Users: map[string]ChatRole{"9": ChatRoleOperator}},
+		},
+	}
+	checks := []struct {
+		name      string
+		got, want ChatRole
+	}{
+		{"slack listed", c.SlackRole("T-1", "U-1"), ChatRoleAdmin},
+		{"slack unlisted", c.SlackRole("T-1", "U-2"), ChatRoleViewer},
+		{"slack unmapped workspace", c.SlackRole("T-2", "U-2"), ChatRoleViewer},
+		{"discord unmapped guild", c.DiscordRole("g-2", "D-1", []string{"r-1"}), ChatRoleViewer},
+		{"discord member role", c.DiscordRole("g-1", "D-1", []string{"r-1"}), ChatRoleOperator},
+		{"discord no role", c.DiscordRole("g-1", "D-1", nil), ChatRoleViewer},
+		{"telegram pairing user", c.TelegramRole(7), ChatRoleAdmin},
+		{"telegram listed", c.TelegramRole(9), ChatRoleOperator},
+		{"telegram unlisted", c.TelegramRole(8), ChatRoleViewer},
+	}
+	for _, ck := range checks {
+		if ck.got != ck.want {
+			t.Errorf("%s = %q, want %q", ck.name, ck.got, ck.want)
+		}
+	}
+	c.Telegram.Roles = nil
+	if got := c.TelegramRole(8); got != ChatRoleOperator {
+		t.Errorf("telegram without a mapping = %q, want operator", got)
+	}
+}
+
+// The pre-roles agent_runners lists become admin mappings; a user the
+// role maps already list keeps their role.
+func TestFoldAgentRunners(t *testing.T) {
+	in := InboundConfig{
+		SlackRoles: map[string]ChatRoleMap{"T-1": {Users: map[string]ChatRole{"U-2": ChatRoleViewer}}},
+		AgentRunners: map[string][]string{
+			"T-1":                {"U-1", "U-2"},
+			"123456789012345678": {"987654321098765432"},
+		},
+	}
+	in.FoldAgentRunners()
+
+	c := &IntegrationsConfig{Inbound: in}
+	if got := c.SlackRole("T-1", "U-1"); got != ChatRoleAdmin {
+		t.Errorf("slack runner = %q, want admin", got)
+	}
+	if got := c.SlackRole("T-1", "U-2"); got != ChatRoleViewer {
+		t.Errorf("already mapped slack user = %q, want viewer", got)
+	}
+	if got := c.DiscordRole("123456789012345678", "987654321098765432", nil); got != ChatRoleAdmin {
+		t.Errorf("discord runner = %q, want admin", got)
+	}
+	if _, ok := in.SlackRoles["123456789012345678"]; ok || in.AgentRunners != nil {
+		t.Errorf("fold left %+v", in)
+	}
+}
@@ -12,4 +12,6 @@ type LogEntry struct {
 	EndedAt       string `yaml:"ended_at"`
 	Status        string `yaml:"status"`
 	HasTranscript bool   `yaml:"has_transcript"` // true if a JSONL transcript is available
+	HasRecording  bool   `yaml:"has_recording"`  // true if an asciicast recording is available
+	HasEvents     bool   `yaml:"has_events"`     // true if a normalized event log is available
 }
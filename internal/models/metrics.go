@@ -26,6 +26,18 @@ const (
 	MergeKindAutoPR MergeKind = "auto_pr"
 )
 
+// CostSource records where TaskMetrics.CostUSD came from.
+type CostSource string
+
+// Cost sources recorded in TaskMetrics.CostSource.
+const (
+	// CostSourceReported is a cost the backend printed itself.
+	CostSourceReported CostSource = "reported"
+	// CostSourceEstimated is a cost computed from token counts and the
+	// settings.yaml pricing table.
+	CostSourceEstimated CostSource = "estimated"
+)
+
 // TaskMetrics is the v6.0 Ember per-task metrics record persisted next to
 // the canonical task YAML as `<n>.metrics.yaml`. Token + cost fields are
 // pointers so a backend that doesn't expose them can leave the field nil
@@ -41,6 +53,14 @@ type TaskMetrics struct {
 	ExitReason MetricsExitReason `yaml:"exit_reason"`
 	CapturedAt time.Time         `yaml:"captured_at"`
 
+	// TokensCached counts cache-read input tokens, when the session's
+	// event log reported them. CostSource says whether CostUSD was
+	// reported by the backend or estimated from the pricing table; empty
+	// on files written before estimation existed, which only ever held
+	// reported costs.
+	TokensCached *int64     `yaml:"tokens_cached,omitempty"`
+	CostSource   CostSource `yaml:"cost_source,omitempty"`
+
 	// v8.0 Inferno — code-output stats, computed by the task-done merge path
 	// from git + the diff package before worktree cleanup. They measure what
 	// the agent SHIPPED, not just task throughput. Absent on metrics files
@@ -52,4 +72,54 @@ type TaskMetrics struct {
 	NetLines     int       `yaml:"net_lines"`
 	Merged       bool      `yaml:"merged"`
 	MergeKind    MergeKind `yaml:"merge_kind,omitempty"`
+
+	// Model is the model that produced most of the session's output, as
+	// named by its token_usage events; empty when none did. CommitSHAs
+	// are the task branch's commits at merge time, so insights can spot
+	// them being reverted on the default branch later.
+	Model      string   `yaml:"model,omitempty"`
+	CommitSHAs []string `yaml:"commit_shas,omitempty"`
+
+	// CreatedBy / StartedBy copy the task's attribution at capture time,
+	// so a per-person rollup doesn't depend on the task YAML surviving.
+	CreatedBy string `yaml:"created_by,omitempty"`
+	StartedBy string `yaml:"started_by,omitempty"`
+}
+
+// SessionMetrics is the metrics record of an agent session that ran
+// outside a task — chat, the wildfire refine / generate phases, and the
+// definition / task generation modes — persisted next to its session log
+// as `<logID>.metrics.yaml`. Insights roll these up as "overhead": agent
+// time and spend that didn't go into a task. Token + cost fields follow
+// TaskMetrics' nil-means-unknown convention.
+type SessionMetrics struct {
+	LogID      string    `yaml:"log_id"`
+	ProjectID  string    `yaml:"project_id"`
+	Agent      string    `yaml:"agent"`
+	Mode       string    `yaml:"mode"`
+	Phase      string    `yaml:"phase,omitempty"`
+	StartedAt  time.Time `yaml:"started_at"`
+	EndedAt    time.Time `yaml:"ended_at"`
+	DurationMs int64     `yaml:"duration_ms"`
+
+	TokensIn     *int64     `yaml:"tokens_in,omitempty"`
+	TokensOut    *int64     `yaml:"tokens_out,omitempty"`
+	TokensCached *int64     `yaml:"tokens_cached,omitempty"`
+	CostUSD      *float64   `yaml:"cost_usd,omitempty"`
+	CostSource   CostSource `yaml:"cost_source,omitempty"`
+	Model        string     `yaml:"model,omitempty"`
+	CapturedAt   time.Time  `yaml:"captured_at"`
+
+	// StartedBy is who started the session (see Task.StartedBy); empty
+	// when unknown.
+	StartedBy string `yaml:"started_by,omitempty"`
+}
+
+// Kind labels the session for overhead breakdowns: the mode, qualified
+// by the wildfire phase when there is one ("wildfire-refine").
+func (s *SessionMetrics) Kind() string {
+	if s.Phase != "" {
+		return s.Mode + "-" + s.Phase
+	}
+	return s.Mode
 }
@@ -56,6 +56,18 @@ const (
 	NotificationTaskFailed NotificationKind = iota
 	NotificationRunComplete
 	NotificationWeeklyDigest
+	// NotificationBudgetThreshold has no global event toggle: configuring
+	// a budget is the opt-in. Projects can still mute it via overrides.
+	NotificationBudgetThreshold
+	// The kinds below have no global toggle of their own; each follows
+	// the legacy toggle closest to it (see inheritedEvent).
+	NotificationTaskSucceeded
+	NotificationMergeFailed
+	NotificationPROpened
+	NotificationAgentNeedsAuth
+	NotificationRateLimited
+	NotificationWildfirePhase
+	NotificationTasksGenerated
 )
 
 // ShouldNotify combines all the gates a notification has to pass before it
@@ -100,7 +112,7 @@ func eventEnabled(kind NotificationKind, cfg NotificationsConfig, project Projec
 			return pref.Enabled
 		}
 	}
-	switch kind {
+	switch inheritedEvent(kind) {
 	case NotificationTaskFailed:
 		return cfg.Events.TaskFailed
 	case NotificationRunComplete:
@@ -111,17 +123,49 @@ func eventEnabled(kind NotificationKind, cfg NotificationsConfig, project Projec
 	return true
 }
 
+// inheritedEvent maps a kind to the one whose global toggle gates it.
+// Failure-shaped kinds (a failed merge, an agent stuck on auth or a
+// rate limit) follow task_failed; progress kinds follow run_complete.
+// MERGE_FAILED and PR_OPENED were sent as TASK_FAILED and RUN_COMPLETE
+// before they had kinds of their own, so this keeps them flowing for
+// anyone who had those on.
+func inheritedEvent(kind NotificationKind) NotificationKind {
+	switch kind {
+	case NotificationMergeFailed, NotificationAgentNeedsAuth, NotificationRateLimited:
+		return NotificationTaskFailed
+	case NotificationTaskSucceeded, NotificationPROpened, NotificationWildfirePhase, NotificationTasksGenerated:
+		return NotificationRunComplete
+	}
+	return kind
+}
+
 // eventKey is the YAML / map key for a NotificationKind. Stays internal so
 // callers don't reach into the map directly; the tests round-trip through
 // ShouldNotify rather than asserting on raw keys.
 func eventKey(kind NotificationKind) string {
 	switch kind {
 	case NotificationTaskFailed:
-		return "task_failed"
+		return EventTaskFailed
 	case NotificationRunComplete:
-		return "run_complete"
+		return EventRunComplete
 	case NotificationWeeklyDigest:
-		return "weekly_digest"
+		return EventWeeklyDigest
+	case NotificationBudgetThreshold:
+		return EventBudgetThreshold
+	case NotificationTaskSucceeded:
+		return EventTaskSucceeded
+	case NotificationMergeFailed:
+		return EventMergeFailed
+	case NotificationPROpened:
+		return EventPROpened
+	case NotificationAgentNeedsAuth:
+		return EventAgentNeedsAuth
+	case NotificationRateLimited:
+		return EventRateLimited
+	case NotificationWildfirePhase:
+		return EventWildfirePhase
+	case NotificationTasksGenerated:
+		return EventTasksGenerated
 	}
 	return ""
 }
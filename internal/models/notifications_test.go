@@ -129,6 +129,63 @@ func TestShouldNotifyDuringQuietHours(t *testing.T) {
 	}
 }
 
+// TestShouldNotifyExtendedEventsInherit pins the inheritance of the
+// lifecycle events added after task_failed / run_complete: failures
+// follow task_failed, successes follow run_complete, and a project row
+// overrides either independently.
+func TestShouldNotifyExtendedEventsInherit(t *testing.T) {
+	cfg := DefaultNotifications()
+	cfg.Events.RunComplete = false
+	now := atTime(t, "12:00")
+
+	for _, kind := range []NotificationKind{NotificationMergeFailed, NotificationAgentNeedsAuth, NotificationRateLimited} {
+		if !ShouldNotify(kind, cfg, ProjectNotifications{}, now) {
+			t.Errorf("%v should follow task_failed (on)", kind)
+		}
+	}
+	for _, kind := range []NotificationKind{NotificationTaskSucceeded, NotificationPROpened, NotificationWildfirePhase, NotificationTasksGenerated} {
+		if ShouldNotify(kind, cfg, ProjectNotifications{}, now) {
+			t.Errorf("%v should follow run_complete (off)", kind)
+		}
+	}
+
+	pn := ProjectNotifications{
+		OverrideEvents: true,
+		Events:         map[string]ProjectEventPref{EventPROpened: {Enabled: true}},
+	}
+	if !ShouldNotify(NotificationPROpened, cfg, pn, now) {
+		t.Errorf("pr_opened project override must win over inherited run_complete")
+	}
+}
+
+func TestEventBitmaskEnabled(t *testing.T) {
+	ev := EventBitmask{TaskFailed: true}
+	if !ev.Enabled(EventTaskFailed) || ev.Enabled(EventRunComplete) {
+		t.Fatalf("legacy keys must read their bools: %+v", ev)
+	}
+	if !ev.Enabled(EventMergeFailed) {
+		t.Errorf("merge_failed should inherit task_failed when unset")
+	}
+	if ev.Enabled(EventPROpened) || ev.Enabled(EventTaskSucceeded) {
+		t.Errorf("pr_opened / task_succeeded should default off here")
+	}
+
+	ev.Set(EventMergeFailed, false)
+	ev.Set(EventTasksGenerated, true)
+	ev.Set("bogus", true)
+	if ev.Enabled(EventMergeFailed) {
+		t.Errorf("explicit merge_failed=false must win over inheritance")
+	}
+	if _, ok := ev.Events["bogus"]; ok {
+		t.Errorf("Set must ignore unknown keys")
+	}
+	want := []string{EventTaskFailed, EventTasksGenerated}
+	got := ev.Keys()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+}
+
 // TestShouldNotifyProjectEventOverrideEnables exercises the v6 #0091 override
 // path: a project that flips a globally-disabled event to enabled.
 func TestShouldNotifyProjectEventOverrideEnables(t *testing.T) {
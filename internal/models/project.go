@@ -3,6 +3,7 @@ package models
 
 import (
 	"math/rand/v2"
+	"strings"
 	"time"
 )
 
@@ -89,6 +90,90 @@ type Project struct {
 	// recent `retrofit-definition` run. 0 = never retrofitted. Additive and
 	// omitempty so pre-v10 project.yaml files are untouched until first use.
 	LastRetrofitTaskNumber int `yaml:"last_retrofit_task_number,omitempty"`
+	// Retention, when set, replaces the global settings.yaml retention
+	// policy for this project. nil inherits.
+	Retention *RetentionConfig `yaml:"retention,omitempty"`
+	// Budget is this project's own monthly budget, checked alongside the
+	// global one. nil means the project has no budget of its own.
+	Budget *BudgetConfig `yaml:"budget,omitempty"`
+	// Approval set to "required" holds a finished task's merge until
+	// someone approves it from Slack, Discord or Telegram. The wait is
+	// bounded by ApprovalTimeout (default 24h), after which
+	// ApprovalOnTimeout decides: reject (default), approve or retry.
+	// ApprovalEndpoints narrows which chat endpoints get the request;
+	// empty means every Slack / Discord endpoint and Telegram chat that
+	// has not muted the project.
+	Approval          string   `yaml:"approval,omitempty"`
+	ApprovalTimeout   string   `yaml:"approval_timeout,omitempty"`
+	ApprovalOnTimeout string   `yaml:"approval_on_timeout,omitempty"`
+	ApprovalEndpoints []string `yaml:"approval_endpoints,omitempty"`
+}
+
+// ApprovalRequired is the Project.Approval value that gates merges.
+const ApprovalRequired = "required"
+
+// DefaultApprovalTimeout bounds an approval wait when the project sets
+// no (or an unparseable) approval_timeout.
+const DefaultApprovalTimeout = 24 * time.Hour
+
+// RequiresApproval reports whether the project gates merges on a chat
+// approval.
+func (p *Project) RequiresApproval() bool {
+	return p != nil && strings.EqualFold(strings.TrimSpace(p.Approval), ApprovalRequired)
+}
+
+// EffectiveApprovalTimeout parses ApprovalTimeout, falling back to
+// DefaultApprovalTimeout when it is unset, invalid or not positive.
+func (p *Project) EffectiveApprovalTimeout() time.Duration {
+	if p == nil || p.ApprovalTimeout == "" {
+		return DefaultApprovalTimeout
+	}
+	d, err := time.ParseDuration(p.ApprovalTimeout)
+	if err != nil || d <= 0 {
+		return DefaultApprovalTimeout
+	}
+	return d
+}
+
+// EffectiveApprovalOnTimeout returns what an unanswered approval turns
+// into: "approve", "retry" or — for anything else — "reject".
+func (p *Project) EffectiveApprovalOnTimeout() string {
+	if p == nil {
+		return "reject"
+	}
+	switch v := strings.ToLower(strings.TrimSpace(p.ApprovalOnTimeout)); v {
+	case "approve", "retry":
+		return v
+	default:
+		return "reject"
+	}
+}
+
+// EffectiveRetention returns the retention policy that applies to the
+// project: its own override when set, else the global one.
+func (p *Project) EffectiveRetention(global RetentionConfig) RetentionConfig {
+	if p == nil || p.Retention == nil {
+		return global
+	}
+	r := *p.Retention
+	r.normalize()
+	return r
+}
+
+// EffectiveBudget returns the project's own budget, normalized, or nil.
+// An override that leaves thresholds out gets the default ones.
+func (p *Project) EffectiveBudget() *BudgetConfig {
+	if p == nil || p.Budget == nil {
+		return nil
+	}
+	b := *p.Budget
+	if len(b.Thresholds) == 0 {
+		b.Thresholds = DefaultBudget().Thresholds
+	} else {
+		b.Thresholds = append([]int(nil), b.Thresholds...)
+	}
+	b.normalize()
+	return &b
 }
 
 // ProjectEntry represents an entry in the global projects.yaml index.
@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+// SessionEventType discriminates SessionEvent records.
+type SessionEventType string
+
+// Session event types.
+const (
+	SessionEventUserMessage      SessionEventType = "user_message"
+	SessionEventAssistantMessage SessionEventType = "assistant_message"
+	SessionEventToolCall         SessionEventType = "tool_call"
+	SessionEventFileEdit         SessionEventType = "file_edit"
+	SessionEventShellCommand     SessionEventType = "shell_command"
+	SessionEventTokenUsage       SessionEventType = "token_usage"
+)
+
+// File edit kinds carried in SessionEvent.EditKind.
+const (
+	FileEditWrite  = "write"  // file created or overwritten
+	FileEditModify = "modify" // in-place edit
+	FileEditDelete = "delete"
+)
+
+// SessionEvent is one entry of a session's normalized event log
+// (~/.watchfire/logs/<project_id>/<log_id>.events.jsonl). Every backend's
+// transcript maps into this one schema so UIs and insights don't need to
+// know agent-specific formats. Only the fields relevant to Type are set.
+//
+// A tool call that edits a file or runs a command produces the generic
+// tool_call event plus a file_edit / shell_command event with the same
+// CallID, so consumers can read either level.
+type SessionEvent struct {
+	Seq  int              `json:"seq"`
+	Type SessionEventType `json:"type"`
+	Time *time.Time       `json:"time,omitempty"`
+
+	// user_message / assistant_message
+	Text string `json:"text,omitempty"`
+
+	// tool_call (Tool, Args, Result, IsError) plus file_edit and
+	// shell_command, which share CallID with their tool_call.
+	Tool    string `json:"tool,omitempty"`
+	CallID  string `json:"call_id,omitempty"`
+	Args    string `json:"args,omitempty"`   // compact JSON
+	Result  string `json:"result,omitempty"` // truncated tool output
+	IsError bool   `json:"is_error,omitempty"`
+
+	// file_edit
+	Path     string `json:"path,omitempty"`
+	EditKind string `json:"edit_kind,omitempty"`
+
+	// shell_command
+	Command  string `json:"command,omitempty"`
+	ExitCode *int   `json:"exit_code,omitempty"` // nil when the transcript doesn't say
+
+	// token_usage — one event per model turn that reported usage
+	TokensIn        int64  `json:"tokens_in,omitempty"`
+	TokensOut       int64  `json:"tokens_out,omitempty"`
+	CacheReadTokens int64  `json:"cache_read_tokens,omitempty"`
+	Model           string `json:"model,omitempty"` // when the transcript names it
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -101,6 +103,221 @@ type AppearanceConfig struct {
 	Theme string `yaml:"theme"` // "system" | "light" | "dark"
 }
 
+// RecordingsConfig controls asciicast recording of agent sessions. Each
+// session's raw PTY stream is saved next to its log as <logID>.cast and
+// can be played back with `watchfire logs replay`.
+type RecordingsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// MaxAgeDays deletes recordings older than this many days. 0 keeps
+	// them regardless of age.
+	MaxAgeDays int `yaml:"max_age_days"`
+	// MaxPerProject keeps only the newest N recordings per project. 0
+	// means no cap.
+	MaxPerProject int `yaml:"max_per_project"`
+}
+
+// DefaultRecordings returns the default recording preferences.
+func DefaultRecordings() RecordingsConfig {
+	return RecordingsConfig{
+		Enabled:       true,
+		MaxAgeDays:    30,
+		MaxPerProject: 100,
+	}
+}
+
+// RetentionConfig bounds how much per-project history the daemon keeps
+// under ~/.watchfire and in the project repo. Log limits count a session
+// log together with its transcript, event log and recording. For every
+// limit, 0 means unlimited.
+type RetentionConfig struct {
+	Enabled bool `yaml:"enabled"` // Run the periodic janitor; `watchfire gc` works regardless
+	// MaxAgeDays removes session logs and cache files older than this
+	// many days.
+	MaxAgeDays int `yaml:"max_age_days"`
+	// MaxLogs keeps only the newest N session logs per project.
+	MaxLogs int `yaml:"max_logs"`
+	// MaxSizeMB caps the total size of a project's session logs; the
+	// oldest are removed first.
+	MaxSizeMB int `yaml:"max_size_mb"`
+	// BranchMaxAgeDays deletes watchfire/* branches whose task is done,
+	// trashed or gone and whose tip commit is older than this — merged
+	// or not, so it is off by default and only an explicit setting
+	// discards unmerged work.
+	BranchMaxAgeDays int `yaml:"branch_max_age_days"`
+}
+
+// DefaultRetention returns the default retention policy.
+func DefaultRetention() RetentionConfig {
+	return RetentionConfig{
+		Enabled:          true,
+		MaxAgeDays:       90,
+		MaxLogs:          500,
+		MaxSizeMB:        1024,
+		BranchMaxAgeDays: 0,
+	}
+}
+
+// normalize clamps negative limits to 0 (unlimited).
+func (r *RetentionConfig) normalize() {
+	for _, v := range []*int{&r.MaxAgeDays, &r.MaxLogs, &r.MaxSizeMB, &r.BranchMaxAgeDays} {
+		if *v < 0 {
+			*v = 0
+		}
+	}
+}
+
+// DefaultMetricsListen is the loopback bind used for the Prometheus
+// endpoint when `metrics_endpoint.listen` is empty. 9464 is the port the
+// OpenTelemetry Prometheus exporter uses, so existing scrape configs
+// tend to expect it.
+const DefaultMetricsListen = "127.0.0.1:9464"
+
+// MetricsEndpointConfig controls the daemon's Prometheus `/metrics`
+// endpoint. It is off by default and, unlike the Echo inbound server,
+// never sits behind a user's public tunnel: it binds its own address,
+// loopback unless the user says otherwise.
+type MetricsEndpointConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Listen  string `yaml:"listen"` // host:port; empty means DefaultMetricsListen
+}
+
+// DefaultMetricsEndpoint returns the default (disabled) endpoint config.
+func DefaultMetricsEndpoint() MetricsEndpointConfig {
+	return MetricsEndpointConfig{Listen: DefaultMetricsListen}
+}
+
+// Tracing exporters.
+const (
+	TracingExporterFile = "file" // JSONL under ~/.watchfire (or TracingConfig.File)
+	TracingExporterOTLP = "otlp" // OTLP/HTTP to TracingConfig.Endpoint
+)
+
+// DefaultTracingEndpoint is the OTLP/HTTP collector a fresh `otlp`
+// config points at — the port every OpenTelemetry collector listens on.
+const DefaultTracingEndpoint = "http://localhost:4318"
+
+// TracingConfig controls OpenTelemetry tracing of the task lifecycle.
+// Off by default; when on, spans go either to a local JSONL file or to
+// an OTLP/HTTP collector.
+type TracingConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	Exporter string `yaml:"exporter"` // "file" (default) | "otlp"
+	// Endpoint is the OTLP/HTTP base URL; spans are POSTed to
+	// <endpoint>/v1/traces.
+	Endpoint string `yaml:"endpoint"`
+	// Headers are sent with every OTLP request, e.g. a vendor API key.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// File overrides the JSONL path (default ~/.watchfire/traces.jsonl).
+	File string `yaml:"file,omitempty"`
+}
+
+// DefaultTracing returns the default (disabled) tracing config.
+func DefaultTracing() TracingConfig {
+	return TracingConfig{Exporter: TracingExporterFile, Endpoint: DefaultTracingEndpoint}
+}
+
+// TokenPrice is one row of the pricing table: USD per million tokens for
+// a backend, optionally narrowed to a model. Model matches by prefix, so
+// "claude-opus-4" covers every dated opus-4 build; an empty Model is the
+// backend's fallback rate.
+type TokenPrice struct {
+	Backend       string  `yaml:"backend"`
+	Model         string  `yaml:"model,omitempty"`
+	InputPerMTok  float64 `yaml:"input_per_mtok"`
+	OutputPerMTok float64 `yaml:"output_per_mtok"`
+	CachedPerMTok float64 `yaml:"cached_per_mtok"` // cache reads
+}
+
+// Cost prices a token count at this row's rates.
+func (p TokenPrice) Cost(in, out, cached int64) float64 {
+	return (float64(in)*p.InputPerMTok + float64(out)*p.OutputPerMTok + float64(cached)*p.CachedPerMTok) / 1e6
+}
+
+// PricingConfig is the token-pricing table used to estimate cost_usd for
+// backends that report tokens but not cost. Edit it to match the rates
+// you actually pay; an empty table disables estimation.
+type PricingConfig struct {
+	Prices []TokenPrice `yaml:"prices"`
+}
+
+// DefaultPricing returns list prices for each backend's default model at
+// the time of writing. They go stale; they exist so a fresh install gets
+// a ballpark instead of nothing. Cursor and Copilot have no rows: both
+// bill by subscription and report no tokens to price. Neither does
+// OpenCode, which fronts whichever provider it is configured for — add a
+// row at the rates you pay. Until then their sessions count $0 toward
+// budgets and show as missing cost in insights.
+func DefaultPricing() PricingConfig {
+	return PricingConfig{Prices: []TokenPrice{
+		{Backend: "claude-code", InputPerMTok: 3, OutputPerMTok: 15, CachedPerMTok: 0.3},
+		{Backend: "claude-code", Model: "claude-opus-4", InputPerMTok: 15, OutputPerMTok: 75, CachedPerMTok: 1.5},
+		{Backend: "claude-code", Model: "claude-opus-4-5", InputPerMTok: 5, OutputPerMTok: 25, CachedPerMTok: 0.5},
+		{Backend: "claude-code", Model: "claude-haiku-4", InputPerMTok: 1, OutputPerMTok: 5, CachedPerMTok: 0.1},
+		{Backend: "codex", InputPerMTok: 1.25, OutputPerMTok: 10, CachedPerMTok: 0.125},
+		{Backend: "gemini", InputPerMTok: 1.25, OutputPerMTok: 10, CachedPerMTok: 0.31},
+	}}
+}
+
+// Lookup returns the row pricing model on backend: the longest matching
+// model prefix, else the backend's fallback row. Returns nil when the
+// table has nothing for backend.
+func (c *PricingConfig) Lookup(backend, model string) *TokenPrice {
+	if c == nil {
+		return nil
+	}
+	var best *TokenPrice
+	for i := range c.Prices {
+		p := &c.Prices[i]
+		if p.Backend != backend {
+			continue
+		}
+		if p.Model != "" && !strings.HasPrefix(strings.ToLower(model), strings.ToLower(p.Model)) {
+			continue
+		}
+		if best == nil || len(p.Model) > len(best.Model) {
+			best = p
+		}
+	}
+	return best
+}
+
+// BudgetConfig caps monthly spend. Spend is the sum of cost_usd
+// (reported and estimated) over the metrics sidecars captured in the
+// current calendar month, local time; sessions without a cost (see
+// DefaultPricing) add nothing. MonthlyUSD 0 means no budget.
+type BudgetConfig struct {
+	MonthlyUSD float64 `yaml:"monthly_usd"`
+	// Thresholds are percentages of MonthlyUSD; crossing each raises one
+	// BUDGET_THRESHOLD notification per month. Empty means the defaults.
+	Thresholds []int `yaml:"thresholds,omitempty"`
+	// HardStop refuses new non-chat agent starts once spend reaches
+	// MonthlyUSD, unless the start is explicitly overridden.
+	HardStop bool `yaml:"hard_stop"`
+}
+
+// DefaultBudget returns the default (unset) budget.
+func DefaultBudget() BudgetConfig {
+	return BudgetConfig{Thresholds: []int{50, 80, 100}}
+}
+
+// normalize drops non-positive thresholds, sorts and dedupes the rest,
+// and clamps a negative limit to 0 (no budget).
+func (b *BudgetConfig) normalize() {
+	if b.MonthlyUSD < 0 {
+		b.MonthlyUSD = 0
+	}
+	seen := make(map[int]bool, len(b.Thresholds))
+	out := b.Thresholds[:0]
+	for _, t := range b.Thresholds {
+		if t > 0 && !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	sort.Ints(out)
+	b.Thresholds = out
+}
+
 // Settings represents global application settings.
 // This corresponds to ~/.watchfire/settings.yaml.
 type Settings struct {
@@ -109,6 +326,23 @@ type Settings struct {
 	Defaults   DefaultsConfig          `yaml:"defaults"`
 	Updates    UpdatesConfig           `yaml:"updates"`
 	Appearance AppearanceConfig        `yaml:"appearance"`
+	// Recordings is a pointer so "no block in settings.yaml" (older
+	// files) is distinguishable from "recording off, no retention limits",
+	// which is all zero values.
+	Recordings *RecordingsConfig `yaml:"recordings,omitempty"`
+	// Retention is a pointer for the same reason as Recordings. A
+	// project can replace it wholesale via project.yaml `retention:`.
+	Retention *RetentionConfig `yaml:"retention,omitempty"`
+	// MetricsEndpoint is the opt-in Prometheus scrape endpoint.
+	MetricsEndpoint *MetricsEndpointConfig `yaml:"metrics_endpoint,omitempty"`
+	// Tracing is the opt-in OpenTelemetry span export.
+	Tracing *TracingConfig `yaml:"tracing,omitempty"`
+	// Pricing is a pointer so a user's deliberately empty table stays
+	// empty instead of being re-seeded with the defaults.
+	Pricing *PricingConfig `yaml:"pricing,omitempty"`
+	// Budget is the fleet-wide monthly budget. A project can set its own
+	// via project.yaml `budget:`; both apply.
+	Budget *BudgetConfig `yaml:"budget,omitempty"`
 }
 
 // timeOfDayRe matches a HH:MM 24-hour time-of-day string.
@@ -127,6 +361,51 @@ func IsValidTimeOfDay(s string) bool {
 // and matching empty strings — so a deliberate user override that happens to
 // equal the default sticks rather than being clobbered.
 func (s *Settings) Normalize() {
+	if s.Recordings == nil {
+		def := DefaultRecordings()
+		s.Recordings = &def
+	}
+	if s.Recordings.MaxAgeDays < 0 {
+		s.Recordings.MaxAgeDays = 0
+	}
+	if s.Recordings.MaxPerProject < 0 {
+		s.Recordings.MaxPerProject = 0
+	}
+	if s.Retention == nil {
+		def := DefaultRetention()
+		s.Retention = &def
+	}
+	s.Retention.normalize()
+	if s.MetricsEndpoint == nil {
+		def := DefaultMetricsEndpoint()
+		s.MetricsEndpoint = &def
+	}
+	if s.MetricsEndpoint.Listen == "" {
+		s.MetricsEndpoint.Listen = DefaultMetricsListen
+	}
+	if s.Tracing == nil {
+		def := DefaultTracing()
+		s.Tracing = &def
+	}
+	if s.Tracing.Exporter != TracingExporterOTLP {
+		s.Tracing.Exporter = TracingExporterFile
+	}
+	if s.Tracing.Endpoint == "" {
+		s.Tracing.Endpoint = DefaultTracingEndpoint
+	}
+	if s.Pricing == nil {
+		def := DefaultPricing()
+		s.Pricing = &def
+	}
+	if s.Budget == nil {
+		def := DefaultBudget()
+		s.Budget = &def
+	}
+	if len(s.Budget.Thresholds) == 0 {
+		s.Budget.Thresholds = DefaultBudget().Thresholds
+	}
+	s.Budget.normalize()
+
 	def := DefaultNotifications()
 	n := &s.Defaults.Notifications
 
@@ -153,6 +432,12 @@ func (s *Settings) Normalize() {
 
 // NewSettings creates settings with default values.
 func NewSettings() *Settings {
+	recordings := DefaultRecordings()
+	retention := DefaultRetention()
+	metricsEndpoint := DefaultMetricsEndpoint()
+	tracing := DefaultTracing()
+	pricing := DefaultPricing()
+	budget := DefaultBudget()
 	return &Settings{
 		Version: 1,
 		Agents: map[string]*AgentConfig{
@@ -176,5 +461,11 @@ func NewSettings() *Settings {
 		Appearance: AppearanceConfig{
 			Theme: "system",
 		},
+		Recordings:      &recordings,
+		Retention:       &retention,
+		MetricsEndpoint: &metricsEndpoint,
+		Tracing:         &tracing,
+		Pricing:         &pricing,
+		Budget:          &budget,
 	}
 }
@@ -38,6 +38,27 @@ type Task struct {
 	UpdatedAt          time.Time  `yaml:"updated_at"`
 	DeletedAt          *time.Time `yaml:"deleted_at,omitempty"`        // Soft delete timestamp
 	RetrofitArchived   bool       `yaml:"retrofit_archived,omitempty"` // v10 Torch — soft-deleted by the definition-retrofit archive (still counted in insights)
+
+	// CreatedBy / StartedBy attribute the task to people: who added it,
+	// and who last started an agent on it. Values are identities as
+	// built by config.LocalUser / config.RemoteUser ("jane@example.com",
+	// "slack:U024BE7LH", "mcp:claude-desktop"); empty on tasks written
+	// before attribution existed and on tasks an agent wrote directly.
+	CreatedBy string `yaml:"created_by,omitempty"`
+	StartedBy string `yaml:"started_by,omitempty"`
+
+	// AwaitingInputSince is set while the agent waits on a question it
+	// asked a person (question.yaml); InputWaitMs accumulates the
+	// finished waits. Both stop the task's clock: ActiveDuration leaves
+	// them out, so a task isn't charged for time spent waiting on a human.
+	AwaitingInputSince *time.Time `yaml:"awaiting_input_since,omitempty"`
+	InputWaitMs        int64      `yaml:"input_wait_ms,omitempty"`
+
+	// AwaitingApprovalSince is set when a finished task's merge is held
+	// for `approval: required` and stays set until someone decides. It
+	// outlives a daemon restart, which drops the pending request, so the
+	// retention janitor knows the unmerged branch is not abandoned work.
+	AwaitingApprovalSince *time.Time `yaml:"awaiting_approval_since,omitempty"`
 }
 
 // NewTask creates a new task with default values. Position is left at the
@@ -96,6 +117,39 @@ func (t *Task) MarkDone(success bool, failureReason string) {
 	t.UpdatedAt = now
 }
 
+// Reopen moves a done task back to ready, clearing the terminal-only
+// fields so the next run starts from a clean slate.
+func (t *Task) Reopen() {
+	t.Status = TaskStatusReady
+	t.Success = nil
+	t.FailureReason = ""
+	t.CompletedAt = nil
+	t.AwaitingApprovalSince = nil
+	t.UpdatedAt = time.Now().UTC()
+}
+
+// HoldForApproval records that the task's merge waits on a person's
+// approval from now. A second call keeps the original start.
+func (t *Task) HoldForApproval(now time.Time) {
+	if t.AwaitingApprovalSince != nil {
+		return
+	}
+	now = now.UTC()
+	t.AwaitingApprovalSince = &now
+	t.UpdatedAt = now
+}
+
+// ReleaseApprovalHold clears the approval hold once the merge has been
+// decided. It reports whether the task was held.
+func (t *Task) ReleaseApprovalHold() bool {
+	if t.AwaitingApprovalSince == nil {
+		return false
+	}
+	t.AwaitingApprovalSince = nil
+	t.UpdatedAt = time.Now().UTC()
+	return true
+}
+
 // Start marks the task as started by an agent.
 func (t *Task) Start() {
 	now := time.Now().UTC()
@@ -106,6 +160,48 @@ func (t *Task) Start() {
 	t.UpdatedAt = now
 }
 
+// PauseClock records that the agent started waiting on a person at now.
+// A second call while already paused keeps the original start.
+func (t *Task) PauseClock(now time.Time) {
+	if t.AwaitingInputSince != nil {
+		return
+	}
+	now = now.UTC()
+	t.AwaitingInputSince = &now
+	t.UpdatedAt = now
+}
+
+// ResumeClock ends the current wait at now, adding it to InputWaitMs.
+// It reports whether the clock was paused.
+func (t *Task) ResumeClock(now time.Time) bool {
+	if t.AwaitingInputSince == nil {
+		return false
+	}
+	if waited := now.Sub(*t.AwaitingInputSince); waited > 0 {
+		t.InputWaitMs += waited.Milliseconds()
+	}
+	t.AwaitingInputSince = nil
+	t.UpdatedAt = now.UTC()
+	return true
+}
+
+// ActiveDuration is how long the task has run from StartedAt to end,
+// minus the time the agent spent waiting on a person. Zero when the
+// task never started.
+func (t *Task) ActiveDuration(end time.Time) time.Duration {
+	if t.StartedAt == nil {
+		return 0
+	}
+	if t.AwaitingInputSince != nil && t.AwaitingInputSince.Before(end) {
+		end = *t.AwaitingInputSince
+	}
+	d := end.Sub(*t.StartedAt) - time.Duration(t.InputWaitMs)*time.Millisecond
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
 // taskTimeFields are the YAML keys whose value type is time.Time or
 // *time.Time. Some agents emit `started_at: ""` (literal empty string) when
 // generating new task files; gopkg.in/yaml.v3 rejects that with a parse
@@ -117,6 +213,9 @@ var taskTimeFields = map[string]struct{}{
 	"completed_at": {},
 	"updated_at":   {},
 	"deleted_at":   {},
+
+	"awaiting_input_since":    {},
+	"awaiting_approval_since": {},
 }
 
 // UnmarshalYAML treats empty-string scalars on time-typed fields as null so a
@@ -3,6 +3,7 @@ package models
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -146,3 +147,32 @@ updated_at: 2026-05-19T18:00:00Z
 		t.Errorf("StartedAt mismatch: got %q want prefix %q", got, want)
 	}
 }
+
+func TestTaskClockExcludesInputWait(t *testing.T) {
+	start := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	task := &Task{StartedAt: &start}
+
+	task.PauseClock(start.Add(10 * time.Minute))
+	task.PauseClock(start.Add(12 * time.Minute)) // already paused: keeps the first start
+	if got := task.ActiveDuration(start.Add(40 * time.Minute)); got != 10*time.Minute {
+		t.Errorf("paused ActiveDuration = %v, want 10m", got)
+	}
+
+	if !task.ResumeClock(start.Add(30 * time.Minute)) {
+		t.Fatal("ResumeClock should report the clock was paused")
+	}
+	if task.ResumeClock(start.Add(31 * time.Minute)) {
+		t.Error("a second ResumeClock should be a no-op")
+	}
+	if got := task.ActiveDuration(start.Add(45 * time.Minute)); got != 25*time.Minute {
+		t.Errorf("ActiveDuration = %v, want 25m (45m minus a 20m wait)", got)
+	}
+
+	out, err := yaml.Marshal(task)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "input_wait_ms: 1200000") || strings.Contains(string(out), "awaiting_input_since") {
+		t.Errorf("yaml = %s", out)
+	}
+}
@@ -103,6 +103,7 @@ func startAgentCmd(conn *grpc.ClientConn, projectID, mode string, taskNumber int
 		defer cancel()
 
 		status, err := client.StartAgent(ctx, &pb.StartAgentRequest{
+			Meta:       &pb.RequestMeta{Origin: "tui", User: config.LocalUser()},
 			ProjectId:  projectID,
 			Mode:       mode,
 			TaskNumber: taskNumber,
@@ -270,6 +271,7 @@ func createTaskCmd(conn *grpc.ClientConn, projectID, title, prompt, criteria, st
 		defer cancel()
 
 		req := &pb.CreateTaskRequest{
+			Meta:      &pb.RequestMeta{Origin: "tui", User: config.LocalUser()},
 			ProjectId: projectID,
 			Title:     title,
 			Prompt:    prompt,
@@ -300,6 +302,7 @@ func createTasksBatchCmd(conn *grpc.ClientConn, projectID, text, status string)
 		defer cancel()
 
 		list, err := client.CreateTasksBatch(ctx, &pb.CreateTasksBatchRequest{
+			Meta:      &pb.RequestMeta{Origin: "tui", User: config.LocalUser()},
 			ProjectId: projectID,
 			Text:      text,
 			Status:    status,
@@ -853,6 +856,12 @@ func saveIntegrationCmd(conn *grpc.ClientConn, payload interface{}) tea.Cmd {
 			req.Payload = &pb.SaveIntegrationRequest_Github{Github: p}
 		case *pb.TelegramIntegration:
 			req.Payload = &pb.SaveIntegrationRequest_Telegram{Telegram: p}
+		case *pb.TeamsIntegration:
+			req.Payload = &pb.SaveIntegrationRequest_Teams{Teams: p}
+		case *pb.MatrixIntegration:
+			req.Payload = &pb.SaveIntegrationRequest_Matrix{Matrix: p}
+		case *pb.NtfyIntegration:
+			req.Payload = &pb.SaveIntegrationRequest_Ntfy{Ntfy: p}
 		default:
 			return ErrorMsg{Err: fmt.Errorf("save: unknown payload type")}
 		}
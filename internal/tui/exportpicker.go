@@ -1,9 +1,9 @@
 // Package tui — v6.0 Ember export picker overlay.
 //
 // The picker is opened with Ctrl+e from anywhere in the project view; it
-// presents Markdown / CSV options, calls InsightsService.ExportReport on
-// the daemon (scope = current project), writes the returned bytes to the
-// project root, and prints a status-bar confirmation. Future per-project
+// presents Markdown / CSV / JSON / HTML options, calls
+// InsightsService.ExportReport on the daemon (scope = current project),
+// writes the returned bytes to the project root, and prints a status-bar confirmation. Future per-project
 // + global Insights overlays (tasks 0057/0058) trigger the same picker
 // via the lower-case `e` key once those overlays land.
 package tui
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -23,11 +24,22 @@ import (
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
-// ExportPicker holds the picker's selection state. Two formats: Markdown
-// (default) and CSV. The picker is dormant unless m.activeOverlay ==
-// overlayExport.
+// exportFormats are the picker rows, top to bottom. Markdown is the
+// default.
+var exportFormats = []struct {
+	label  string
+	format pb.ExportFormat
+}{
+	{"Markdown (.md)", pb.ExportFormat_MARKDOWN},
+	{"CSV (.csv)", pb.ExportFormat_CSV},
+	{"JSON (.json)", pb.ExportFormat_JSON},
+	{"HTML (.html)", pb.ExportFormat_HTML},
+}
+
+// ExportPicker holds the picker's selection state. The picker is dormant
+// unless m.activeOverlay == overlayExport.
 type ExportPicker struct {
-	selected int // 0 = Markdown, 1 = CSV
+	selected int // index into exportFormats
 
 	// Scope drives which RPC variant we send when the user picks a format.
 	// scopeKind == "project" → ProjectId set; scopeKind == "single_task"
@@ -85,20 +97,17 @@ func (p *ExportPicker) MoveUp() {
 	if p.selected > 0 {
 		p.selected--
 	} else {
-		p.selected = 1
+		p.selected = len(exportFormats) - 1
 	}
 }
 
 func (p *ExportPicker) MoveDown() {
-	p.selected = (p.selected + 1) % 2
+	p.selected = (p.selected + 1) % len(exportFormats)
 }
 
 // Format returns the proto enum value for the currently-highlighted row.
 func (p *ExportPicker) Format() pb.ExportFormat {
-	if p.selected == 1 {
-		return pb.ExportFormat_CSV
-	}
-	return pb.ExportFormat_MARKDOWN
+	return exportFormats[p.selected].format
 }
 
 // View renders the picker overlay. Width is fixed (~24 cols) because the
@@ -114,9 +123,9 @@ func (p *ExportPicker) View() string {
 		}
 		return st.Render(marker+label) + lipgloss.NewStyle().Render("")
 	}
-	body := []string{
-		row("Markdown (.md)", 0),
-		row("CSV (.csv)", 1),
+	rows := make([]string, 0, len(exportFormats))
+	for i, f := range exportFormats {
+		rows = append(rows, row(f.label, i))
 	}
 
 	box := lipgloss.NewStyle().
@@ -129,7 +138,7 @@ func (p *ExportPicker) View() string {
 	hint := lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "240", Dark: "245"}).
 		Render("↑/↓ · Enter · Esc")
 
-	content := title + "\n" + body[0] + "\n" + body[1] + "\n" + hint
+	content := title + "\n" + strings.Join(rows, "\n") + "\n" + hint
 	return box.Render(content)
 }
 
@@ -100,6 +100,9 @@ func renderFleetInsightsOverlay(insights FleetInsights, width int) string {
 		body = append(body, fleetSparklineLine(insights.Data))
 		body = append(body, fleetTopProjectsLine(insights.Data))
 		body = append(body, fleetAgentsLine(insights.Data))
+		body = append(body, overheadLine(insights.Data.GetOverhead()))
+		body = append(body, usersLine(insights.Data.GetUsers()))
+		body = append(body, agentComparisonLines(insights.Data.GetAgentComparison())...)
 	}
 
 	body = append(body, "")
@@ -145,6 +148,9 @@ func fleetKpiLine(data *pb.GlobalInsights) string {
 	}
 	dur := formatDurationMs(data.GetTotalDurationMs())
 	cost := fmt.Sprintf("$%.2f", data.GetTotalCostUsd())
+	if data.GetTasksEstimatedCost() > 0 {
+		cost += fmt.Sprintf(" ($%.2f est.)", data.GetEstimatedCostUsd())
+	}
 	if data.GetTasksMissingCost() > 0 {
 		cost += fmt.Sprintf(" (%d part)", data.GetTasksMissingCost())
 	}
@@ -223,6 +229,107 @@ func fleetAgentsLine(data *pb.GlobalInsights) string {
 	return prefixedRow("Agents", strings.Join(parts, "  "))
 }
 
+// maxOverheadKinds caps the per-kind breakdown on the overhead line.
+const maxOverheadKinds = 3
+
+// overheadLine summarizes the sessions that ran outside a task: spend,
+// count, agent time, share of all spend, then the costliest kinds.
+func overheadLine(o *pb.InsightsOverhead) string {
+	if o.GetSessions() == 0 {
+		return prefixedRow("Overhead", lipgloss.NewStyle().Foreground(colorDim).Render("(no chat or planning sessions)"))
+	}
+	noun := "sessions"
+	if o.GetSessions() == 1 {
+		noun = "session"
+	}
+	line := fmt.Sprintf("$%.2f  %d %s  %s  %.0f%% of spend",
+		o.GetCostUsd(), o.GetSessions(), noun, formatDurationMs(o.GetDurationMs()), o.GetCostShare()*100)
+	kinds := o.GetByKind()
+	if len(kinds) > maxOverheadKinds {
+		kinds = kinds[:maxOverheadKinds]
+	}
+	parts := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		parts = append(parts, fmt.Sprintf("%s $%.2f", k.GetKind(), k.GetCostUsd()))
+	}
+	if len(parts) > 0 {
+		line += lipgloss.NewStyle().Foreground(colorDim).Render("  · " + strings.Join(parts, "  "))
+	}
+	return prefixedRow("Overhead", line)
+}
+
+// maxUserRows caps the per-person line to the biggest spenders.
+const maxUserRows = 4
+
+// usersLine renders spend and task count per person, highest spend
+// first.
+func usersLine(users []*pb.UserUsage) string {
+	if len(users) == 0 {
+		return prefixedRow("People", lipgloss.NewStyle().Foreground(colorDim).Render("(none yet)"))
+	}
+	more := 0
+	if len(users) > maxUserRows {
+		more = len(users) - maxUserRows
+		users = users[:maxUserRows]
+	}
+	parts := make([]string, 0, len(users))
+	for _, u := range users {
+		name := u.GetUser()
+		if name == "" {
+			name = "(unattributed)"
+		}
+		parts = append(parts, fmt.Sprintf("%s $%.2f/%d", name, u.GetTotalCostUsd(), u.GetTasks()))
+	}
+	line := strings.Join(parts, "  ")
+	if more > 0 {
+		line += lipgloss.NewStyle().Foreground(colorDim).Render(fmt.Sprintf("  +%d more", more))
+	}
+	return prefixedRow("People", line)
+}
+
+// maxComparisonRows caps the agent comparison table; the export carries
+// every row.
+const maxComparisonRows = 5
+
+// agentComparisonLines renders the per agent + model comparison as a
+// small table: tasks, success, median / p90 duration, cost per success,
+// then the merge-failure, follow-up and revert rates.
+func agentComparisonLines(rows []*pb.AgentComparison) []string {
+	if len(rows) == 0 {
+		return nil
+	}
+	more := 0
+	if len(rows) > maxComparisonRows {
+		more = len(rows) - maxComparisonRows
+		rows = rows[:maxComparisonRows]
+	}
+	dim := lipgloss.NewStyle().Foreground(colorDim)
+	lines := []string{"", prefixedRow("Compare", dim.Render(fmt.Sprintf(
+		"%-18s %3s %4s %6s %6s %6s %5s %5s %6s", "agent / model", "n", "ok", "p50", "p90", "$/ok", "merge", "f-up", "revert",
+	)))}
+	pct := func(r float64) string { return fmt.Sprintf("%.0f%%", r*100) }
+	for _, r := range rows {
+		label := r.GetAgent()
+		if r.GetModel() != "" {
+			label += " / " + r.GetModel()
+		}
+		costPerOk := "—"
+		if r.GetSucceeded() > 0 {
+			costPerOk = fmt.Sprintf("$%.2f", r.GetCostPerSuccessUsd())
+		}
+		lines = append(lines, prefixedRow("", fmt.Sprintf(
+			"%-18s %3d %4s %6s %6s %6s %5s %5s %6s",
+			truncateRunes(label, 18), r.GetTasks(), pct(r.GetSuccessRate()),
+			formatDurationMs(r.GetMedianDurationMs()), formatDurationMs(r.GetP90DurationMs()),
+			costPerOk, pct(r.GetMergeFailureRate()), pct(r.GetFollowUpRate()), pct(r.GetRevertRate()),
+		)))
+	}
+	if more > 0 {
+		lines = append(lines, prefixedRow("", dim.Render(fmt.Sprintf("+%d more in the export (Ctrl+e)", more))))
+	}
+	return lines
+}
+
 func prefixedRow(label, body string) string {
 	prefix := lipgloss.NewStyle().Foreground(colorDim).Render(fmt.Sprintf("%-9s", label) + " ┊ ")
 	return prefix + body
@@ -9,24 +9,34 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/watchfire-io/watchfire/internal/models"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
 // integrationsRowKind disambiguates rows in the IntegrationsForm list.
-// The list shows webhook + slack + discord endpoints as one flat list; a
-// trailing "GitHub" row is always present (single-instance config).
+// The list shows webhook + slack + discord + teams + matrix + ntfy
+// endpoints as one flat list; a trailing "GitHub" row is always present
+// (single-instance config).
 type integrationsRowKind int
 
 const (
 	integrationsRowWebhook integrationsRowKind = iota
 	integrationsRowSlack
 	integrationsRowDiscord
+	integrationsRowTeams
+	integrationsRowMatrix
+	integrationsRowNtfy
 	integrationsRowGitHub
 	integrationsRowTelegram // v10.0 Torch — single-instance bridge config
 	// integrationsRowTelegramChat rows sit under the Telegram summary row,
 	// one per paired chat. Deliberately after integrationsRowTelegram so the
 	// add-form kind cycle (which stops at Telegram) never offers them.
 	integrationsRowTelegramChat
+	// integrationsRowEmail rows list SMTP endpoints. Email is configured
+	// per recipient from the CLI (`watchfire integrations add email`),
+	// so it sits past the add-form cycle too; the TUI lists, tests and
+	// deletes it.
+	integrationsRowEmail
 )
 
 // integrationsRow captures one selectable row in the overlay list.
@@ -42,12 +52,15 @@ type integrationsRow struct {
 
 // integrationsAddField captures one input field in the stacked add-form
 // sequence. The form prompts for URL → label → events checkboxes →
-// project-mute multi-select; current step is `step`.
+// project-mute multi-select; current step is `step`. Matrix inserts a
+// room-ID step and Matrix / ntfy a masked token step after the URL.
 type integrationsAddField int
 
 const (
 	addFieldKind integrationsAddField = iota
 	addFieldURL
+	addFieldRoom
+	addFieldSecret
 	addFieldLabel
 	addFieldEvents
 	addFieldMutes
@@ -78,6 +91,7 @@ type IntegrationsForm struct {
 	addURL       string
 	addLabel     string
 	addEvents    pb.IntegrationEvents
+	eventCursor  int
 	addMutes     []string
 	statusLine   string
 	deleteCursor int
@@ -87,6 +101,10 @@ type IntegrationsForm struct {
 	// step, so only these two extra fields are needed.
 	addTelegramEnabled bool
 	addTelegramToken   string
+	// Matrix room ID and the Matrix access token / ntfy topic token
+	// staged by the room + secret steps.
+	addRoomID string
+	addSecret string
 	// Pairing display state: non-nil while a pairing code is live on
 	// screen. The model layer drives the 2s status polling; the form only
 	// renders code + deep link + countdown and the terminal states.
@@ -430,6 +448,7 @@ func (f *IntegrationsForm) Reset() {
 	f.addURL = ""
 	f.addLabel = ""
 	f.addEvents = pb.IntegrationEvents{TaskFailed: true, RunComplete: true}
+	f.eventCursor = 0
 	f.addMutes = nil
 	f.addTelegramEnabled = true
 	f.addTelegramToken = ""
@@ -478,9 +497,12 @@ func (f *IntegrationsForm) StartAdd() {
 	f.addURL = ""
 	f.addLabel = ""
 	f.addEvents = pb.IntegrationEvents{TaskFailed: true, RunComplete: true}
+	f.eventCursor = 0
 	f.addMutes = nil
 	f.addTelegramEnabled = true
 	f.addTelegramToken = ""
+	f.addRoomID = ""
+	f.addSecret = ""
 	f.input.EchoMode = textinput.EchoNormal
 }
 
@@ -493,12 +515,7 @@ func (f *IntegrationsForm) StartTelegramEdit() {
 	f.addKind = integrationsRowTelegram
 	if tg := f.cfg.GetTelegram(); tg != nil {
 		f.addTelegramEnabled = tg.GetEnabled()
-		ev := tg.GetEnabledEvents()
-		f.addEvents = pb.IntegrationEvents{
-			TaskFailed:   ev.GetTaskFailed(),
-			RunComplete:  ev.GetRunComplete(),
-			WeeklyDigest: ev.GetWeeklyDigest(),
-		}
+		f.addEvents = *cloneEvents(tg.GetEnabledEvents())
 	}
 	f.AdvanceAdd() // kind → token input
 }
@@ -541,7 +558,7 @@ func (f *IntegrationsForm) AdvanceAdd() bool {
 			}
 		} else {
 			f.input.EchoMode = textinput.EchoNormal
-			f.input.Placeholder = "https://hooks.slack.com/services/..."
+			f.input.Placeholder = urlPlaceholder(f.addKind)
 		}
 		f.input.SetValue("")
 		f.input.Focus()
@@ -562,11 +579,31 @@ func (f *IntegrationsForm) AdvanceAdd() bool {
 		if f.addURL == "" {
 			return false
 		}
-		f.input.Blur()
-		f.input.SetValue("")
-		f.input.Placeholder = "Friendly name (optional)"
-		f.input.Focus()
-		f.addStep = addFieldLabel
+		switch f.addKind {
+		case integrationsRowMatrix:
+			f.input.SetValue("")
+			f.input.Placeholder = "!roomid:example.org"
+			f.addStep = addFieldRoom
+			return false
+		case integrationsRowNtfy:
+			f.startSecretStep("Access token (empty for a public topic)")
+			return false
+		}
+		f.startLabelStep()
+	case addFieldRoom:
+		f.addRoomID = strings.TrimSpace(f.input.Value())
+		if f.addRoomID == "" {
+			return false
+		}
+		f.startSecretStep("Access token of the posting account")
+	case addFieldSecret:
+		// Matrix cannot post without a token; ntfy's is optional.
+		f.addSecret = strings.TrimSpace(f.input.Value())
+		if f.addSecret == "" && f.addKind == integrationsRowMatrix {
+			return false
+		}
+		f.input.EchoMode = textinput.EchoNormal
+		f.startLabelStep()
 	case addFieldLabel:
 		f.addLabel = strings.TrimSpace(f.input.Value())
 		f.input.Blur()
@@ -588,8 +625,39 @@ func (f *IntegrationsForm) AdvanceAdd() bool {
 	return false
 }
 
-// ToggleAddEvent flips one event bit in the add form. Index maps to
-// task_failed / run_complete / weekly_digest.
+// startSecretStep switches the shared input to a masked token prompt.
+func (f *IntegrationsForm) startSecretStep(placeholder string) {
+	f.input.SetValue("")
+	f.input.EchoMode = textinput.EchoPassword
+	f.input.Placeholder = placeholder
+	f.addStep = addFieldSecret
+}
+
+// startLabelStep switches the shared input to the label prompt.
+func (f *IntegrationsForm) startLabelStep() {
+	f.input.Blur()
+	f.input.SetValue("")
+	f.input.Placeholder = "Friendly name (optional)"
+	f.input.Focus()
+	f.addStep = addFieldLabel
+}
+
+// urlPlaceholder hints at the URL shape each kind expects.
+func urlPlaceholder(kind integrationsRowKind) string {
+	switch kind {
+	case integrationsRowTeams:
+		return "https://….webhook.office.com/... or a Workflows URL"
+	case integrationsRowMatrix:
+		return "https://matrix.example.org"
+	case integrationsRowNtfy:
+		return "https://ntfy.sh/your-topic"
+	}
+	return "https://hooks.slack.com/services/..."
+}
+
+// ToggleAddEvent flips one event toggle in the add form. Index maps to
+// models.NotificationEvents in order (task_failed, run_complete,
+// weekly_digest, budget_threshold, then the lifecycle events).
 func (f *IntegrationsForm) ToggleAddEvent(idx int) {
 	if f.addStep != addFieldEvents {
 		return
@@ -606,29 +674,47 @@ func (f *IntegrationsForm) ToggleAddEvent(idx int) {
 	}
 	if f.addKind == integrationsRowTelegram {
 		// Telegram folds the master Enabled toggle in at index 0; the
-		// three event bits shift down by one.
-		switch idx {
-		case 0:
+		// event bits shift down by one.
+		if idx == 0 {
 			f.addTelegramEnabled = !f.addTelegramEnabled
-		case 1:
-			f.addEvents.TaskFailed = !f.addEvents.TaskFailed
-		case 2:
-			f.addEvents.RunComplete = !f.addEvents.RunComplete
-		case 3:
-			f.addEvents.WeeklyDigest = !f.addEvents.WeeklyDigest
+			return
 		}
+		idx--
+	}
+	if idx >= 0 && idx < len(models.NotificationEvents) {
+		toggleEvent(&f.addEvents, models.NotificationEvents[idx])
+	}
+}
+
+// eventRowCount is the number of toggles on the events step for the
+// current kind.
+func (f *IntegrationsForm) eventRowCount() int {
+	switch f.addKind {
+	case integrationsRowGitHub:
+		return 2
+	case integrationsRowTelegram:
+		return len(models.NotificationEvents) + 1
+	}
+	return len(models.NotificationEvents)
+}
+
+// MoveEventCursor moves the events-step cursor, clamped to the rows.
+func (f *IntegrationsForm) MoveEventCursor(delta int) {
+	if f.addStep != addFieldEvents {
 		return
 	}
-	switch idx {
-	case 0:
-		f.addEvents.TaskFailed = !f.addEvents.TaskFailed
-	case 1:
-		f.addEvents.RunComplete = !f.addEvents.RunComplete
-	case 2:
-		f.addEvents.WeeklyDigest = !f.addEvents.WeeklyDigest
+	f.eventCursor += delta
+	if f.eventCursor < 0 {
+		f.eventCursor = 0
+	}
+	if n := f.eventRowCount(); f.eventCursor >= n {
+		f.eventCursor = n - 1
 	}
 }
 
+// ToggleEventAtCursor flips the toggle under the events-step cursor.
+func (f *IntegrationsForm) ToggleEventAtCursor() { f.ToggleAddEvent(f.eventCursor) }
+
 // CancelAdd aborts the add form and returns to list mode.
 func (f *IntegrationsForm) CancelAdd() {
 	f.mode = integrationsModeList
@@ -671,14 +757,16 @@ func (f *IntegrationsForm) IsEditing() bool { return f.mode != integrationsModeL
 // AddSnapshot returns the staged add-form values for the model layer
 // to roll into a SaveIntegrationRequest.
 func (f *IntegrationsForm) AddSnapshot() (kind integrationsRowKind, url, label string, events *pb.IntegrationEvents, mutes []string) {
-	ev := &pb.IntegrationEvents{
-		TaskFailed:   f.addEvents.TaskFailed,
-		RunComplete:  f.addEvents.RunComplete,
-		WeeklyDigest: f.addEvents.WeeklyDigest,
-	}
+	ev := cloneEvents(&f.addEvents)
 	return f.addKind, f.addURL, f.addLabel, ev, append([]string(nil), f.addMutes...)
 }
 
+// ChannelAddSnapshot returns the staged Matrix room ID and the Matrix /
+// ntfy token, which AddSnapshot's shared shape doesn't carry.
+func (f *IntegrationsForm) ChannelAddSnapshot() (roomID, secret string) {
+	return f.addRoomID, f.addSecret
+}
+
 // TelegramAddSnapshot rolls the staged Telegram add/edit values into a
 // SaveIntegration payload. BotToken follows the write-only convention:
 // empty means "keep the stored token". PairedChats is deliberately nil —
@@ -686,13 +774,9 @@ func (f *IntegrationsForm) AddSnapshot() (kind integrationsRowKind, url, label s
 // omitting them leaves the per-chat toggles untouched.
 func (f *IntegrationsForm) TelegramAddSnapshot() *pb.TelegramIntegration {
 	return &pb.TelegramIntegration{
-		Enabled:  f.addTelegramEnabled,
-		BotToken: f.addTelegramToken,
-		EnabledEvents: &pb.IntegrationEvents{
-			TaskFailed:   f.addEvents.TaskFailed,
-			RunComplete:  f.addEvents.RunComplete,
-			WeeklyDigest: f.addEvents.WeeklyDigest,
-		},
+		Enabled:       f.addTelegramEnabled,
+		BotToken:      f.addTelegramToken,
+		EnabledEvents: cloneEvents(&f.addEvents),
 	}
 }
 
@@ -756,15 +840,10 @@ func telegramChatTogglePayload(tg *pb.TelegramIntegration, chatID int64, flag st
 	if !found {
 		return nil
 	}
-	ev := tg.GetEnabledEvents()
 	return &pb.TelegramIntegration{
-		Enabled: tg.GetEnabled(),
-		EnabledEvents: &pb.IntegrationEvents{
-			TaskFailed:   ev.GetTaskFailed(),
-			RunComplete:  ev.GetRunComplete(),
-			WeeklyDigest: ev.GetWeeklyDigest(),
-		},
-		PairedChats: chats,
+		Enabled:       tg.GetEnabled(),
+		EnabledEvents: cloneEvents(tg.GetEnabledEvents()),
+		PairedChats:   chats,
 	}
 }
 
@@ -801,6 +880,45 @@ func (f *IntegrationsForm) rebuildRows() {
 			Muted:    len(dc.GetProjectMuteIds()),
 		})
 	}
+	for _, tm := range f.cfg.GetTeams() {
+		rows = append(rows, integrationsRow{
+			Kind:     integrationsRowTeams,
+			ID:       tm.GetId(),
+			Label:    f.deriveLabel(tm.GetLabel(), "Teams"),
+			URLLabel: tm.GetUrlLabel(),
+			Events:   tm.GetEnabledEvents(),
+			Muted:    len(tm.GetProjectMuteIds()),
+		})
+	}
+	for _, mx := range f.cfg.GetMatrix() {
+		rows = append(rows, integrationsRow{
+			Kind:     integrationsRowMatrix,
+			ID:       mx.GetId(),
+			Label:    f.deriveLabel(mx.GetLabel(), "Matrix"),
+			URLLabel: mx.GetRoomId(),
+			Events:   mx.GetEnabledEvents(),
+			Muted:    len(mx.GetProjectMuteIds()),
+		})
+	}
+	for _, nt := range f.cfg.GetNtfy() {
+		rows = append(rows, integrationsRow{
+			Kind:     integrationsRowNtfy,
+			ID:       nt.GetId(),
+			Label:    f.deriveLabel(nt.GetLabel(), "ntfy"),
+			URLLabel: nt.GetUrl(),
+			Events:   nt.GetEnabledEvents(),
+			Muted:    len(nt.GetProjectMuteIds()),
+		})
+	}
+	for _, em := range f.cfg.GetEmail() {
+		rows = append(rows, integrationsRow{
+			Kind:     integrationsRowEmail,
+			ID:       em.GetId(),
+			Label:    f.deriveLabel(em.GetLabel(), "Email"),
+			URLLabel: fmt.Sprintf("%s · %d recipient(s)", em.GetHost(), len(em.GetRecipients())),
+			Muted:    len(em.GetProjectMuteIds()),
+		})
+	}
 	// GitHub single-instance row is always present.
 	rows = append(rows, integrationsRow{
 		Kind:  integrationsRowGitHub,
@@ -900,6 +1018,14 @@ func kindLabel(k integrationsRowKind) string {
 		return "Slack"
 	case integrationsRowDiscord:
 		return "Discord"
+	case integrationsRowTeams:
+		return "Teams"
+	case integrationsRowMatrix:
+		return "Matrix"
+	case integrationsRowNtfy:
+		return "ntfy"
+	case integrationsRowEmail:
+		return "Email"
 	case integrationsRowGitHub:
 		return "GitHub"
 	case integrationsRowTelegram:
@@ -1320,10 +1446,32 @@ func (f *IntegrationsForm) renderAdd() string {
 			b.WriteString(lipgloss.NewStyle().Foreground(colorDim).Render(hint))
 			break
 		}
-		b.WriteString("URL:\n")
+		switch f.addKind {
+		case integrationsRowMatrix:
+			b.WriteString("Homeserver URL:\n")
+		case integrationsRowNtfy:
+			b.WriteString("Topic URL:\n")
+		default:
+			b.WriteString("URL:\n")
+		}
 		b.WriteString(f.input.View())
 		b.WriteString("\n\n")
 		b.WriteString(lipgloss.NewStyle().Foreground(colorDim).Render("Tab next · Esc cancel"))
+	case addFieldRoom:
+		b.WriteString(fmt.Sprintf("Homeserver: %s\n\n", f.addURL))
+		b.WriteString("Room ID:\n")
+		b.WriteString(f.input.View())
+		b.WriteString("\n\n")
+		b.WriteString(lipgloss.NewStyle().Foreground(colorDim).Render("Tab next · Esc cancel"))
+	case addFieldSecret:
+		b.WriteString("Access token:\n")
+		b.WriteString(f.input.View())
+		b.WriteString("\n\n")
+		hint := "stored in the OS keyring · Tab next · Esc cancel"
+		if f.addKind == integrationsRowNtfy {
+			hint = "optional · stored in the OS keyring · Tab next · Esc cancel"
+		}
+		b.WriteString(lipgloss.NewStyle().Foreground(colorDim).Render(hint))
 	case addFieldLabel:
 		b.WriteString(fmt.Sprintf("URL: %s\n\n", maskForDisplay(f.addURL)))
 		b.WriteString("Label:\n")
@@ -1332,20 +1480,20 @@ func (f *IntegrationsForm) renderAdd() string {
 		b.WriteString(lipgloss.NewStyle().Foreground(colorDim).Render("Tab next · Esc cancel"))
 	case addFieldEvents:
 		if f.addKind == integrationsRowGitHub {
-			b.WriteString(checkbox("Enabled", f.addEvents.TaskFailed) + "\n")
-			b.WriteString(checkbox("Open as draft", f.addEvents.RunComplete) + "\n")
+			b.WriteString(f.eventRow(0, checkbox("Enabled", f.addEvents.TaskFailed)) + "\n")
+			b.WriteString(f.eventRow(1, checkbox("Open as draft", f.addEvents.RunComplete)) + "\n")
 			b.WriteString("\n" + lipgloss.NewStyle().Foreground(colorDim).Render("Space toggles · Tab next · Esc cancel"))
 		} else if f.addKind == integrationsRowTelegram {
-			b.WriteString(checkbox("Enabled", f.addTelegramEnabled) + "\n")
-			b.WriteString(checkbox("TASK_FAILED", f.addEvents.TaskFailed) + "\n")
-			b.WriteString(checkbox("RUN_COMPLETE", f.addEvents.RunComplete) + "\n")
-			b.WriteString(checkbox("WEEKLY_DIGEST", f.addEvents.WeeklyDigest) + "\n")
-			b.WriteString("\n" + lipgloss.NewStyle().Foreground(colorDim).Render("1/2/3/4 toggle · Enter saves · Esc cancel"))
+			b.WriteString(f.eventRow(0, checkbox("Enabled", f.addTelegramEnabled)) + "\n")
+			for i, key := range models.NotificationEvents {
+				b.WriteString(f.eventRow(i+1, checkbox(strings.ToUpper(key), eventOn(&f.addEvents, key))) + "\n")
+			}
+			b.WriteString("\n" + lipgloss.NewStyle().Foreground(colorDim).Render("↑/↓ move · Space or 1-5 toggle · Enter saves · Esc cancel"))
 		} else {
-			b.WriteString(checkbox("TASK_FAILED", f.addEvents.TaskFailed) + "\n")
-			b.WriteString(checkbox("RUN_COMPLETE", f.addEvents.RunComplete) + "\n")
-			b.WriteString(checkbox("WEEKLY_DIGEST", f.addEvents.WeeklyDigest) + "\n")
-			b.WriteString("\n" + lipgloss.NewStyle().Foreground(colorDim).Render("1/2/3 toggle event · Tab next · Esc cancel"))
+			for i, key := range models.NotificationEvents {
+				b.WriteString(f.eventRow(i, checkbox(strings.ToUpper(key), eventOn(&f.addEvents, key))) + "\n")
+			}
+			b.WriteString("\n" + lipgloss.NewStyle().Foreground(colorDim).Render("↑/↓ move · Space or 1-4 toggle event · Tab next · Esc cancel"))
 		}
 	case addFieldMutes:
 		if len(f.projectIDs) == 0 {
@@ -1384,7 +1532,7 @@ func eventChips(e *pb.IntegrationEvents) string {
 	if e == nil {
 		return ""
 	}
-	parts := make([]string, 0, 3)
+	parts := make([]string, 0, 4)
 	if e.GetTaskFailed() {
 		parts = append(parts, "FAIL")
 	}
@@ -1394,12 +1542,98 @@ func eventChips(e *pb.IntegrationEvents) string {
 	if e.GetWeeklyDigest() {
 		parts = append(parts, "WEEK")
 	}
+	if e.GetBudgetThreshold() {
+		parts = append(parts, "BUDG")
+	}
+	for _, key := range models.ExtendedEvents {
+		if eventOn(e, key) {
+			parts = append(parts, extendedEventChips[key])
+		}
+	}
 	if len(parts) == 0 {
 		return lipgloss.NewStyle().Foreground(colorDim).Render("(no events)")
 	}
 	return lipgloss.NewStyle().Foreground(colorCyan).Render("[" + strings.Join(parts, "·") + "]")
 }
 
+// extendedEventChips are the list-row chip labels for the lifecycle
+// events, alongside FAIL / DONE / WEEK / BUDG.
+var extendedEventChips = map[string]string{
+	models.EventTaskSucceeded:  "OK",
+	models.EventMergeFailed:    "MERGE",
+	models.EventPROpened:       "PR",
+	models.EventAgentNeedsAuth: "AUTH",
+	models.EventRateLimited:    "RATE",
+	models.EventWildfirePhase:  "WILD",
+	models.EventTasksGenerated: "GEN",
+}
+
+// eventRow prefixes an events-step line with the cursor marker.
+func (f *IntegrationsForm) eventRow(idx int, line string) string {
+	if idx == f.eventCursor {
+		return "› " + line
+	}
+	return "  " + line
+}
+
+// eventsBitmask mirrors the daemon's proto → model conversion so the
+// TUI resolves inherited events (merge_failed following task_failed)
+// exactly like the relay does.
+func eventsBitmask(e *pb.IntegrationEvents) models.EventBitmask {
+	out := models.EventBitmask{
+		TaskFailed:      e.GetTaskFailed(),
+		RunComplete:     e.GetRunComplete(),
+		WeeklyDigest:    e.GetWeeklyDigest(),
+		BudgetThreshold: e.GetBudgetThreshold(),
+	}
+	for key, on := range e.GetEvents() {
+		out.Set(key, on)
+	}
+	return out
+}
+
+func eventOn(e *pb.IntegrationEvents, key string) bool { return eventsBitmask(e).Enabled(key) }
+
+// toggleEvent flips one event in place. Lifecycle events are written
+// explicitly into the map, so a toggle pins them rather than leaving
+// them to follow their parent event.
+func toggleEvent(e *pb.IntegrationEvents, key string) {
+	on := !eventOn(e, key)
+	switch key {
+	case models.EventTaskFailed:
+		e.TaskFailed = on
+	case models.EventRunComplete:
+		e.RunComplete = on
+	case models.EventWeeklyDigest:
+		e.WeeklyDigest = on
+	case models.EventBudgetThreshold:
+		e.BudgetThreshold = on
+	default:
+		if e.Events == nil {
+			e.Events = map[string]bool{}
+		}
+		e.Events[key] = on
+	}
+}
+
+// cloneEvents deep-copies an IntegrationEvents (the map included) so
+// form state never aliases the cached config.
+func cloneEvents(e *pb.IntegrationEvents) *pb.IntegrationEvents {
+	out := &pb.IntegrationEvents{
+		TaskFailed:      e.GetTaskFailed(),
+		RunComplete:     e.GetRunComplete(),
+		WeeklyDigest:    e.GetWeeklyDigest(),
+		BudgetThreshold: e.GetBudgetThreshold(),
+	}
+	if m := e.GetEvents(); len(m) > 0 {
+		out.Events = make(map[string]bool, len(m))
+		for k, v := range m {
+			out.Events[k] = v
+		}
+	}
+	return out
+}
+
 func checkbox(label string, on bool) string {
 	box := "[ ]"
 	style := settingsToggleOff
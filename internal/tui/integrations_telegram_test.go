@@ -67,11 +67,11 @@ func TestTelegramCycleAddKind(t *testing.T) {
 	if f.addKind != integrationsRowWebhook {
 		t.Fatalf("cycle +1 from Telegram should wrap to Webhook, got %v", f.addKind)
 	}
-	for i := 0; i < 4; i++ { // Webhook → Slack → Discord → GitHub → Telegram
+	for i := 0; i < 7; i++ { // Webhook → Slack → Discord → Teams → Matrix → ntfy → GitHub → Telegram
 		f.CycleAddKind(+1)
 	}
 	if f.addKind != integrationsRowTelegram {
-		t.Fatalf("four steps from Webhook should land on Telegram, got %v", f.addKind)
+		t.Fatalf("seven steps from Webhook should land on Telegram, got %v", f.addKind)
 	}
 }
 
@@ -4,6 +4,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/charmbracelet/bubbles/textinput"
+
+	"github.com/watchfire-io/watchfire/internal/models"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
@@ -98,6 +101,32 @@ func TestIntegrationsListThree(t *testing.T) {
 	mustContain(t, out, "WEEK")
 }
 
+// TestIntegrationsListEmail verifies SMTP endpoints list with their
+// host and recipient count, and stay out of the add-form kind cycle.
+func TestIntegrationsListEmail(t *testing.T) {
+	cfg := &pb.IntegrationsConfig{
+		Email: []*pb.EmailIntegration{{
+			Id: "mail-1", Label: "ops mail", Host: "smtp.example.com",
+			Recipients: []*pb.EmailRecipient{{Address: "a@example.com"}, {Address: "b@example.com"}},
+		}},
+		Github: &pb.GitHubIntegration{},
+	}
+	out := renderListSnapshot(cfg)
+	mustContain(t, out, "Email")
+	mustContain(t, out, "ops mail")
+	mustContain(t, out, "smtp.example.com · 2 recipient(s)")
+
+	f := NewIntegrationsForm()
+	f.Load(cfg)
+	f.StartAdd()
+	for i := 0; i < 20; i++ {
+		f.CycleAddKind(1)
+		if f.addKind == integrationsRowEmail {
+			t.Fatal("email must not be offered by the add-form kind cycle")
+		}
+	}
+}
+
 // TestIntegrationsAddFormFlow exercises the stacked form: kind cycle →
 // URL → label → events → mutes → confirm. Each AdvanceAdd step should
 // either move forward or, on the final step, return true so the model
@@ -207,3 +236,85 @@ func mustContain(t *testing.T, haystack, needle string) {
 		t.Errorf("expected output to contain %q, got:\n%s", needle, haystack)
 	}
 }
+
+// TestIntegrationsFormLifecycleEventToggles walks the events-step cursor
+// past the digit shortcuts and toggles a lifecycle event into the saved
+// snapshot's events map.
+func TestIntegrationsFormLifecycleEventToggles(t *testing.T) {
+	f := NewIntegrationsForm()
+	f.StartAdd()
+	f.addStep = addFieldEvents
+
+	if !eventOn(&f.addEvents, models.EventMergeFailed) {
+		t.Fatalf("merge_failed should inherit the default-on task_failed")
+	}
+	for i := 0; i < 20; i++ {
+		f.MoveEventCursor(+1)
+	}
+	if f.eventCursor != len(models.NotificationEvents)-1 {
+		t.Fatalf("cursor should clamp at the last row, got %d", f.eventCursor)
+	}
+	f.ToggleEventAtCursor() // tasks_generated: inherited off → on
+
+	_, _, _, ev, _ := f.AddSnapshot()
+	if !ev.GetEvents()[models.EventTasksGenerated] {
+		t.Errorf("tasks_generated should be pinned on in the snapshot: %+v", ev.GetEvents())
+	}
+	if got := eventChips(ev); !strings.Contains(got, "GEN") || !strings.Contains(got, "MERGE") {
+		t.Errorf("chips should list GEN and the inherited MERGE, got %q", got)
+	}
+}
+
+// TestIntegrationsMatrixAddFlow walks the Matrix add form: homeserver
+// URL → room ID → masked access token (required) → label → events →
+// mutes, and checks the staged room + token.
+func TestIntegrationsMatrixAddFlow(t *testing.T) {
+	f := NewIntegrationsForm()
+	f.Load(&pb.IntegrationsConfig{Github: &pb.GitHubIntegration{}})
+	f.StartAdd()
+	f.addKind = integrationsRowMatrix
+
+	f.AdvanceAdd() // kind → URL
+	f.input.SetValue("https://matrix.example.org")
+	f.AdvanceAdd()
+	if f.addStep != addFieldRoom {
+		t.Fatalf("step after the homeserver URL should be the room ID, got %v", f.addStep)
+	}
+	f.input.SetValue("!ops:example.org")
+	f.AdvanceAdd()
+	if f.addStep != addFieldSecret || f.input.EchoMode != textinput.EchoPassword {
+		t.Fatalf("room ID should lead to the masked token step, got %v", f.addStep)
+	}
+	f.AdvanceAdd() // empty token is refused
+	if f.addStep != addFieldSecret {
+		t.Fatalf("matrix must not advance without an access token")
+	}
+	f.input.SetValue("syt_secret")
+	f.AdvanceAdd()
+	if f.addStep != addFieldLabel || f.input.EchoMode != textinput.EchoNormal {
+		t.Fatalf("token should lead to an unmasked label step, got %v", f.addStep)
+	}
+
+	roomID, secret := f.ChannelAddSnapshot()
+	if roomID != "!ops:example.org" || secret != "syt_secret" {
+		t.Errorf("channel snapshot = %q, %q", roomID, secret)
+	}
+}
+
+// TestIntegrationsNtfyTokenOptional checks an empty ntfy token skips
+// straight to the label step.
+func TestIntegrationsNtfyTokenOptional(t *testing.T) {
+	f := NewIntegrationsForm()
+	f.StartAdd()
+	f.addKind = integrationsRowNtfy
+	f.AdvanceAdd()
+	f.input.SetValue("https://ntfy.sh/alerts")
+	f.AdvanceAdd()
+	if f.addStep != addFieldSecret {
+		t.Fatalf("ntfy URL should lead to the token step, got %v", f.addStep)
+	}
+	f.AdvanceAdd()
+	if f.addStep != addFieldLabel {
+		t.Fatalf("an empty ntfy token should be accepted, got %v", f.addStep)
+	}
+}
@@ -570,8 +570,9 @@ func (m *Model) currentDiscordGuildBinding() string {
 }
 
 func (m *Model) handleTerminalKey(msg tea.KeyMsg) tea.Cmd {
-	// Check for resume on issue
-	if m.currentIssue != nil && m.currentIssue.IssueType != "" {
+	// Check for resume on issue. A pending question is answered, not
+	// resumed — R is just a keystroke then.
+	if m.currentIssue != nil && m.currentIssue.IssueType != "" && m.currentIssue.IssueType != "awaiting_input" {
 		if key.Matches(msg, terminalKeys.Resume) {
 			return resumeAgentCmd(m.conn, m.projectID)
 		}
@@ -1017,6 +1018,8 @@ func (m *Model) handleIntegrationsKey(msg tea.KeyMsg) tea.Cmd {
 			// Chat rows edit the parent Telegram config too — the
 			// per-chat toggles have their own m/w shortcuts.
 			f.StartTelegramEdit()
+		case integrationsRowEmail:
+			f.SetStatus("Edit email recipients with `watchfire integrations add email`")
 		default:
 			f.StartAdd()
 			f.addKind = row.Kind
@@ -1038,6 +1041,14 @@ func (m *Model) handleIntegrationsKey(msg tea.KeyMsg) tea.Cmd {
 			return testIntegrationCmd(m.conn, pb.IntegrationKind_SLACK, row.ID)
 		case integrationsRowDiscord:
 			return testIntegrationCmd(m.conn, pb.IntegrationKind_DISCORD, row.ID)
+		case integrationsRowTeams:
+			return testIntegrationCmd(m.conn, pb.IntegrationKind_TEAMS, row.ID)
+		case integrationsRowMatrix:
+			return testIntegrationCmd(m.conn, pb.IntegrationKind_MATRIX, row.ID)
+		case integrationsRowNtfy:
+			return testIntegrationCmd(m.conn, pb.IntegrationKind_NTFY, row.ID)
+		case integrationsRowEmail:
+			return testIntegrationCmd(m.conn, pb.IntegrationKind_EMAIL, row.ID)
 		case integrationsRowGitHub:
 			return testIntegrationCmd(m.conn, pb.IntegrationKind_GITHUB, "")
 		case integrationsRowTelegram, integrationsRowTelegramChat:
@@ -1106,12 +1117,34 @@ func (m *Model) handleIntegrationsAddKey(msg tea.KeyMsg) tea.Cmd {
 			return nil
 		}
 	case "4":
-		// Telegram's events step carries a fourth toggle (Enabled at
-		// index 0 shifts the events down); harmless no-op elsewhere.
 		if f.addStep == addFieldEvents {
 			f.ToggleAddEvent(3)
 			return nil
 		}
+	case "5":
+		// Telegram's events step carries a fifth toggle (Enabled at
+		// index 0 shifts the events down); harmless no-op elsewhere.
+		if f.addStep == addFieldEvents {
+			f.ToggleAddEvent(4)
+			return nil
+		}
+	case "up", "k":
+		// The lifecycle events push the list past the digit shortcuts;
+		// the cursor reaches every row.
+		if f.addStep == addFieldEvents {
+			f.MoveEventCursor(-1)
+			return nil
+		}
+	case "down", "j":
+		if f.addStep == addFieldEvents {
+			f.MoveEventCursor(+1)
+			return nil
+		}
+	case " ":
+		if f.addStep == addFieldEvents {
+			f.ToggleEventAtCursor()
+			return nil
+		}
 	}
 
 	switch msg.Type {
@@ -1123,8 +1156,10 @@ func (m *Model) handleIntegrationsAddKey(msg tea.KeyMsg) tea.Cmd {
 		return nil
 	}
 
-	// While editing URL / label, forward the keystroke to the textinput.
-	if f.addStep == addFieldURL || f.addStep == addFieldLabel {
+	// While editing URL / room / token / label, forward the keystroke to
+	// the textinput.
+	switch f.addStep {
+	case addFieldURL, addFieldRoom, addFieldSecret, addFieldLabel:
 		ti := f.InputModel()
 		newTI, _ := ti.Update(msg)
 		*ti = newTI
@@ -1207,6 +1242,14 @@ func (m *Model) handleIntegrationsConfirmDeleteKey(msg tea.KeyMsg) tea.Cmd {
 			kind = pb.IntegrationKind_SLACK
 		case integrationsRowDiscord:
 			kind = pb.IntegrationKind_DISCORD
+		case integrationsRowTeams:
+			kind = pb.IntegrationKind_TEAMS
+		case integrationsRowMatrix:
+			kind = pb.IntegrationKind_MATRIX
+		case integrationsRowNtfy:
+			kind = pb.IntegrationKind_NTFY
+		case integrationsRowEmail:
+			kind = pb.IntegrationKind_EMAIL
 		case integrationsRowGitHub:
 			kind = pb.IntegrationKind_GITHUB
 		case integrationsRowTelegram:
@@ -1223,6 +1266,7 @@ func (m *Model) dispatchIntegrationsAdd() tea.Cmd {
 	f := m.integrationsForm
 	kind, url, label, events, mutes := f.AddSnapshot()
 	telegramPayload := f.TelegramAddSnapshot()
+	roomID, secret := f.ChannelAddSnapshot()
 	f.CancelAdd()
 	if m.conn == nil {
 		return nil
@@ -1251,6 +1295,30 @@ func (m *Model) dispatchIntegrationsAdd() tea.Cmd {
 			EnabledEvents:  events,
 			ProjectMuteIds: mutes,
 		})
+	case integrationsRowTeams:
+		return saveIntegrationCmd(m.conn, &pb.TeamsIntegration{
+			Label:          label,
+			Url:            url,
+			EnabledEvents:  events,
+			ProjectMuteIds: mutes,
+		})
+	case integrationsRowMatrix:
+		return saveIntegrationCmd(m.conn, &pb.MatrixIntegration{
+			Label:          label,
+			HomeserverUrl:  url,
+			RoomId:         roomID,
+			AccessToken:    secret,
+			EnabledEvents:  events,
+			ProjectMuteIds: mutes,
+		})
+	case integrationsRowNtfy:
+		return saveIntegrationCmd(m.conn, &pb.NtfyIntegration{
+			Label:          label,
+			Url:            url,
+			Token:          secret,
+			EnabledEvents:  events,
+			ProjectMuteIds: mutes,
+		})
 	case integrationsRowGitHub:
 		return saveIntegrationCmd(m.conn, &pb.GitHubIntegration{
 			Enabled:       events.GetTaskFailed(),
@@ -1324,7 +1392,7 @@ func (m *Model) openProjectInsightsOverlay(window string) tea.Cmd {
 }
 
 // handleExportPickerKey routes keys for the v6.0 Ember export overlay.
-// ↑/↓ swap between Markdown / CSV; Enter triggers the export and prints
+// ↑/↓ move between the export formats; Enter triggers the export and prints
 // a status-bar confirmation; Esc cancels. The picker stores the scope
 // (project / single-task / global) — the same handler runs all three.
 func (m *Model) handleExportPickerKey(msg tea.KeyMsg) tea.Cmd {
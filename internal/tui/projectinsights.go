@@ -3,8 +3,9 @@
 // Pressing `i` on the task list opens a read-only overlay rendering the
 // per-project rollup the daemon ships under
 // `InsightsService.GetProjectInsights`: KPI strip, sparkline of tasks-per-day,
-// agent breakdown, and duration percentiles. 1 / 3 / 9 / 0 cycle the window
-// (7d / 30d / 90d / All); Esc / q close.
+// agent breakdown, duration percentiles, and the diff hotspots from
+// `GetHotspots`. 1 / 3 / 9 / 0 cycle the window (7d / 30d / 90d / All);
+// Esc / q close.
 //
 // Sibling of insights.go (which renders the fleet overlay). Both use the
 // same unicode-block sparkline to stay dependency-free.
@@ -30,6 +31,10 @@ type ProjectInsights struct {
 	Window string // "7d" | "30d" | "90d" | "all"
 	Data   *pb.ProjectInsights
 	Err    error
+
+	// Hotspots is fetched alongside Data for the same window; nil when
+	// the call failed, which only hides the hotspot rows.
+	Hotspots *pb.Hotspots
 }
 
 // ProjectInsightsLoadedMsg is dispatched when the daemon RPC returns.
@@ -71,7 +76,21 @@ func loadProjectInsightsCmd(conn *grpc.ClientConn, projectID, window string) tea
 		if err != nil {
 			return ProjectInsightsLoadedMsg{Insights: ProjectInsights{Window: window, Err: err}}
 		}
-		return ProjectInsightsLoadedMsg{Insights: ProjectInsights{Window: window, Data: resp}}
+		// Hotspots may run git for tasks whose diff isn't cached yet, so
+		// they get their own budget.
+		hctx, hcancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer hcancel()
+		hotspots, herr := client.GetHotspots(hctx, &pb.GetHotspotsRequest{
+			Meta:        req.Meta,
+			ProjectId:   projectID,
+			WindowStart: req.WindowStart,
+			WindowEnd:   req.WindowEnd,
+			Limit:       maxHotspotRows,
+		})
+		if herr != nil {
+			hotspots = nil
+		}
+		return ProjectInsightsLoadedMsg{Insights: ProjectInsights{Window: window, Data: resp, Hotspots: hotspots}}
 	}
 }
 
@@ -101,6 +120,10 @@ func renderProjectInsightsOverlay(insights ProjectInsights, projectName string,
 		body = append(body, projectSparklineLine(insights.Data))
 		body = append(body, projectAgentsLine(insights.Data))
 		body = append(body, projectDurationLine(insights.Data))
+		body = append(body, overheadLine(insights.Data.GetOverhead()))
+		body = append(body, usersLine(insights.Data.GetUsers()))
+		body = append(body, agentComparisonLines(insights.Data.GetAgentComparison())...)
+		body = append(body, hotspotLines(insights.Hotspots)...)
 	}
 
 	body = append(body, "")
@@ -138,6 +161,9 @@ func projectInsightsKpiLine(data *pb.ProjectInsights) string {
 	}
 	dur := formatDurationMs(data.GetTotalDurationMs())
 	cost := fmt.Sprintf("$%.2f", data.GetTotalCostUsd())
+	if data.GetTasksEstimatedCost() > 0 {
+		cost += fmt.Sprintf(" ($%.2f est.)", data.GetEstimatedCostUsd())
+	}
 	if data.GetTasksMissingCost() > 0 {
 		cost += fmt.Sprintf(" (%d partial)", data.GetTasksMissingCost())
 	}
@@ -196,3 +222,45 @@ func projectDurationLine(data *pb.ProjectInsights) string {
 	body := fmt.Sprintf("avg %s  p50 %s  p95 %s", avg, p50, p95)
 	return prefixedRow("Duration", body)
 }
+
+// maxHotspotRows caps each hotspot row; the overlay has room for a few
+// paths per line at most.
+const maxHotspotRows = 3
+
+// hotspotLines renders the most-touched files and directories, and the
+// files behind failed tasks or merges. Empty when there's nothing to show.
+func hotspotLines(h *pb.Hotspots) []string {
+	if h == nil || len(h.GetFiles()) == 0 {
+		return nil
+	}
+	lines := []string{""}
+	files := make([]string, 0, maxHotspotRows)
+	for i, f := range h.GetFiles() {
+		if i >= maxHotspotRows {
+			break
+		}
+		files = append(files, fmt.Sprintf("%s ×%d", truncateRunes(f.GetPath(), 28), f.GetTasks()))
+	}
+	lines = append(lines, prefixedRow("Hot files", strings.Join(files, "  ")))
+	if dirs := h.GetDirectories(); len(dirs) > 0 {
+		parts := make([]string, 0, maxHotspotRows)
+		for i, d := range dirs {
+			if i >= maxHotspotRows {
+				break
+			}
+			parts = append(parts, fmt.Sprintf("%s/ ×%d", truncateRunes(d.GetPath(), 24), d.GetTasks()))
+		}
+		lines = append(lines, prefixedRow("Hot dirs", strings.Join(parts, "  ")))
+	}
+	if failing := h.GetFailureFiles(); len(failing) > 0 {
+		parts := make([]string, 0, maxHotspotRows)
+		for i, f := range failing {
+			if i >= maxHotspotRows {
+				break
+			}
+			parts = append(parts, fmt.Sprintf("%s ×%d", truncateRunes(f.GetPath(), 28), f.GetFailedTasks()+f.GetMergeFailures()))
+		}
+		lines = append(lines, prefixedRow("Failing", lipgloss.NewStyle().Foreground(colorYellow).Render(strings.Join(parts, "  "))))
+	}
+	return lines
+}
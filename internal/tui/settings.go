@@ -34,6 +34,7 @@ import (
 
 	"github.com/watchfire-io/watchfire/internal/config"
 	"github.com/watchfire-io/watchfire/internal/daemon/agent/backend"
+	"github.com/watchfire-io/watchfire/internal/models"
 	pb "github.com/watchfire-io/watchfire/proto"
 )
 
@@ -293,6 +294,13 @@ func (s *SettingsForm) rebuildRows() {
 		{"task_failed", "Notify on task failure"},
 		{"run_complete", "Notify on run complete"},
 		{"weekly_digest", "Send weekly digest"},
+		{models.EventTaskSucceeded, "Notify on task success"},
+		{models.EventMergeFailed, "Notify on merge failure"},
+		{models.EventPROpened, "Notify on PR opened"},
+		{models.EventAgentNeedsAuth, "Notify when the agent needs sign-in"},
+		{models.EventRateLimited, "Notify on rate limit"},
+		{models.EventWildfirePhase, "Notify on wildfire phase change"},
+		{models.EventTasksGenerated, "Notify on generated tasks"},
 	} {
 		enabled := false
 		if pref, ok := eventPrefs[ev.key]; ok && pref != nil {
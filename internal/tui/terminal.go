@@ -257,6 +257,15 @@ func (t *Terminal) renderIssueBanner() string {
 		} else {
 			text += ". Press R to resume."
 		}
+	case "awaiting_input":
+		// The banner is one line: flatten the question and keep the
+		// how-to-answer hint visible.
+		hint := " — answer from chat or `watchfire answer`"
+		question := strings.Join(strings.Fields(issue.Message), " ")
+		for i, o := range issue.Options {
+			question += fmt.Sprintf("  [%d] %s", i+1, o)
+		}
+		text = "❓ Agent asks: " + truncateRunes(question, t.width-len([]rune(hint))-18) + hint
 	default:
 		text = "⚠ " + issue.Message
 	}
@@ -79,28 +79,56 @@ func (FocusTarget) EnumDescriptor() ([]byte, []int) {
 // NotificationKind enumerates the high-level reasons the daemon emits a
 // notification. STUCK_AGENT is reserved for a future task; TASK_FAILED and
 // RUN_COMPLETE ship in v5.0 Pulse, WEEKLY_DIGEST in v6.0 Ember.
+// BUDGET_THRESHOLD fires when monthly spend crosses a configured
+// percentage of a global or per-project budget. The kinds from
+// TASK_SUCCEEDED on follow a task or run through its lifecycle; their
+// config keys are the lower-cased names ("merge_failed", …).
 type NotificationKind int32
 
 const (
-	NotificationKind_TASK_FAILED   NotificationKind = 0
-	NotificationKind_RUN_COMPLETE  NotificationKind = 1
-	NotificationKind_STUCK_AGENT   NotificationKind = 2
-	NotificationKind_WEEKLY_DIGEST NotificationKind = 3
+	NotificationKind_TASK_FAILED            NotificationKind = 0
+	NotificationKind_RUN_COMPLETE           NotificationKind = 1
+	NotificationKind_STUCK_AGENT            NotificationKind = 2
+	NotificationKind_WEEKLY_DIGEST          NotificationKind = 3
+	NotificationKind_BUDGET_THRESHOLD       NotificationKind = 4
+	NotificationKind_TASK_SUCCEEDED         NotificationKind = 5
+	NotificationKind_MERGE_FAILED           NotificationKind = 6  // Auto-merge into the default branch failed
+	NotificationKind_PR_OPENED              NotificationKind = 7  // Auto-PR opened; url set
+	NotificationKind_AGENT_NEEDS_AUTH       NotificationKind = 8  // Agent halted on a login / API-key error
+	NotificationKind_RATE_LIMITED           NotificationKind = 9  // Agent hit a provider rate limit
+	NotificationKind_WILDFIRE_PHASE_CHANGED NotificationKind = 10 // phase / previous_phase set
+	NotificationKind_TASKS_GENERATED        NotificationKind = 11 // count set
 )
 
 // Enum value maps for NotificationKind.
 var (
 	NotificationKind_name = map[int32]string{
-		0: "TASK_FAILED",
-		1: "RUN_COMPLETE",
-		2: "STUCK_AGENT",
-		3: "WEEKLY_DIGEST",
+		0:  "TASK_FAILED",
+		1:  "RUN_COMPLETE",
+		2:  "STUCK_AGENT",
+		3:  "WEEKLY_DIGEST",
+		4:  "BUDGET_THRESHOLD",
+		5:  "TASK_SUCCEEDED",
+		6:  "MERGE_FAILED",
+		7:  "PR_OPENED",
+		8:  "AGENT_NEEDS_AUTH",
+		9:  "RATE_LIMITED",
+		10: "WILDFIRE_PHASE_CHANGED",
+		11: "TASKS_GENERATED",
 	}
 	NotificationKind_value = map[string]int32{
-		"TASK_FAILED":   0,
-		"RUN_COMPLETE":  1,
-		"STUCK_AGENT":   2,
-		"WEEKLY_DIGEST": 3,
+		"TASK_FAILED":            0,
+		"RUN_COMPLETE":           1,
+		"STUCK_AGENT":            2,
+		"WEEKLY_DIGEST":          3,
+		"BUDGET_THRESHOLD":       4,
+		"TASK_SUCCEEDED":         5,
+		"MERGE_FAILED":           6,
+		"PR_OPENED":              7,
+		"AGENT_NEEDS_AUTH":       8,
+		"RATE_LIMITED":           9,
+		"WILDFIRE_PHASE_CHANGED": 10,
+		"TASKS_GENERATED":        11,
 	}
 )
 
@@ -134,12 +162,16 @@ func (NotificationKind) EnumDescriptor() ([]byte, []int) {
 // ExportFormat selects the wire format for an exported report. CSV is a
 // flat single-file representation (multi-section reports use a `# section:`
 // header line to delimit sub-tables); MARKDOWN renders human-readable
-// reports with H2 headings.
+// reports with H2 headings; JSON is the versioned machine-readable
+// schema (`watchfire.insights.report`, see schema_version in the body);
+// HTML is a self-contained page with inline CSS and SVG charts.
 type ExportFormat int32
 
 const (
 	ExportFormat_CSV      ExportFormat = 0
 	ExportFormat_MARKDOWN ExportFormat = 1
+	ExportFormat_JSON     ExportFormat = 2
+	ExportFormat_HTML     ExportFormat = 3
 )
 
 // Enum value maps for ExportFormat.
@@ -147,10 +179,14 @@ var (
 	ExportFormat_name = map[int32]string{
 		0: "CSV",
 		1: "MARKDOWN",
+		2: "JSON",
+		3: "HTML",
 	}
 	ExportFormat_value = map[string]int32{
 		"CSV":      0,
 		"MARKDOWN": 1,
+		"JSON":     2,
+		"HTML":     3,
 	}
 )
 
@@ -181,7 +217,7 @@ func (ExportFormat) EnumDescriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{2}
 }
 
-// IntegrationKind disambiguates the four outbound integration types in
+// IntegrationKind disambiguates the outbound integration types in
 // the IntegrationsService oneof payloads. The on-the-wire ordering
 // matches the order the GUI surfaces them in the picker.
 type IntegrationKind int32
@@ -192,6 +228,10 @@ const (
 	IntegrationKind_DISCORD  IntegrationKind = 2
 	IntegrationKind_GITHUB   IntegrationKind = 3
 	IntegrationKind_TELEGRAM IntegrationKind = 4 // v10.0 Torch — Telegram bot bridge
+	IntegrationKind_TEAMS    IntegrationKind = 5
+	IntegrationKind_MATRIX   IntegrationKind = 6
+	IntegrationKind_NTFY     IntegrationKind = 7
+	IntegrationKind_EMAIL    IntegrationKind = 8
 )
 
 // Enum value maps for IntegrationKind.
@@ -202,6 +242,10 @@ var (
 		2: "DISCORD",
 		3: "GITHUB",
 		4: "TELEGRAM",
+		5: "TEAMS",
+		6: "MATRIX",
+		7: "NTFY",
+		8: "EMAIL",
 	}
 	IntegrationKind_value = map[string]int32{
 		"WEBHOOK":  0,
@@ -209,6 +253,10 @@ var (
 		"DISCORD":  2,
 		"GITHUB":   3,
 		"TELEGRAM": 4,
+		"TEAMS":    5,
+		"MATRIX":   6,
+		"NTFY":     7,
+		"EMAIL":    8,
 	}
 )
 
@@ -457,7 +505,7 @@ func (x FileDiff_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FileDiff_Status.Descriptor instead.
 func (FileDiff_Status) EnumDescriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{82, 0}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{110, 0}
 }
 
 type DiffLine_Kind int32
@@ -506,15 +554,19 @@ func (x DiffLine_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiffLine_Kind.Descriptor instead.
 func (DiffLine_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{84, 0}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{112, 0}
 }
 
 // RequestMeta is included in every request for tracking and analytics
 type RequestMeta struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Origin        string                 `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`                     // "cli" | "tui" | "gui" | "api"
-	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Unique client instance ID
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                   // Client version
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Origin   string                 `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`                     // "cli" | "tui" | "gui" | "api"
+	ClientId string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Unique client instance ID
+	Version  string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                   // Client version
+	// Who is acting, for task attribution: the git user for local clients,
+	// "<transport>:<id>" for remote ones ("mcp:claude-desktop"). The daemon
+	// fills in its own local user when a local client leaves it empty.
+	User          string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -570,6 +622,13 @@ func (x *RequestMeta) GetVersion() string {
 	return ""
 }
 
+func (x *RequestMeta) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
 type Project struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId              string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -828,7 +887,7 @@ type ProjectNotifications struct {
 	state              protoimpl.MessageState       `protogen:"open.v1"`
 	Muted              bool                         `protobuf:"varint,1,opt,name=muted,proto3" json:"muted,omitempty"`                                                                            // If true, suppress all notifications for this project
 	OverrideEvents     bool                         `protobuf:"varint,2,opt,name=override_events,json=overrideEvents,proto3" json:"override_events,omitempty"`                                    // v6: when true, the per-event map overrides global Events
-	Events             map[string]*ProjectEventPref `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // v6: keys "task_failed" / "run_complete" / "weekly_digest"
+	Events             map[string]*ProjectEventPref `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // v6: keyed by event ("task_failed", "merge_failed", …)
 	QuietHoursOverride *QuietHoursConfig            `protobuf:"bytes,4,opt,name=quiet_hours_override,json=quietHoursOverride,proto3" json:"quiet_hours_override,omitempty"`                       // v6: when set, replaces global quiet hours for this project
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
@@ -1440,6 +1499,8 @@ type Task struct {
 	DeletedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                              // Soft delete
 	Agent              string                 `protobuf:"bytes,17,opt,name=agent,proto3" json:"agent,omitempty"`                                                             // Backend name override; empty = use project default
 	MergeFailureReason *string                `protobuf:"bytes,18,opt,name=merge_failure_reason,json=mergeFailureReason,proto3,oneof" json:"merge_failure_reason,omitempty"` // v5.0 — populated when the post-task auto-merge failed (distinct from agent-reported failure_reason)
+	CreatedBy          string                 `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                    // Who added the task; empty when unknown
+	StartedBy          string                 `protobuf:"bytes,20,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`                                    // Who last started an agent on it; empty when unknown
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -1600,6 +1661,20 @@ func (x *Task) GetMergeFailureReason() string {
 	return ""
 }
 
+func (x *Task) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Task) GetStartedBy() string {
+	if x != nil {
+		return x.StartedBy
+	}
+	return ""
+}
+
 type TaskId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -2758,16 +2833,17 @@ func (x *AgentStatus) GetStartedAt() *timestamppb.Timestamp {
 }
 
 type StartAgentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                // "chat" | "task" | "start-all" | "wildfire"
-	TaskNumber    int32                  `protobuf:"varint,4,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"` // Required for task/start-all modes
-	Rows          int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`                               // Initial PTY rows (0 = use default)
-	Cols          int32                  `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`                               // Initial PTY cols (0 = use default)
-	Sandbox       string                 `protobuf:"bytes,7,opt,name=sandbox,proto3" json:"sandbox,omitempty"`                          // Sandbox backend override: "auto" | "seatbelt" | "landlock" | "bwrap" | "none"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Mode           string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                            // "chat" | "task" | "start-all" | "wildfire"
+	TaskNumber     int32                  `protobuf:"varint,4,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`             // Required for task/start-all modes
+	Rows           int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`                                           // Initial PTY rows (0 = use default)
+	Cols           int32                  `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`                                           // Initial PTY cols (0 = use default)
+	Sandbox        string                 `protobuf:"bytes,7,opt,name=sandbox,proto3" json:"sandbox,omitempty"`                                      // Sandbox backend override: "auto" | "seatbelt" | "landlock" | "bwrap" | "none"
+	OverrideBudget bool                   `protobuf:"varint,8,opt,name=override_budget,json=overrideBudget,proto3" json:"override_budget,omitempty"` // Start even if a hard-stop monthly budget is spent (chat is never refused)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StartAgentRequest) Reset() {
@@ -2849,6 +2925,20 @@ func (x *StartAgentRequest) GetSandbox() string {
 	return ""
 }
 
+func (x *StartAgentRequest) GetOverrideBudget() bool {
+	if x != nil {
+		return x.OverrideBudget
+	}
+	return false
+}
+
+// ScreenBuffer is one coalesced frame of the agent's screen. Clients that
+// set SubscribeScreenRequest.deltas get a keyframe first (keyframe=true,
+// lines / ansi_content carry the whole screen) and row deltas afterwards;
+// every frame bumps seq by one. A keyframe can arrive mid-stream (resize,
+// or the client fell behind and the daemon resynced it) and always
+// replaces local state. Clients that don't opt in keep receiving full
+// frames and can ignore fields 8-10.
 type ScreenBuffer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -2858,6 +2948,9 @@ type ScreenBuffer struct {
 	Rows          int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
 	Cols          int32                  `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`
 	AnsiContent   string                 `protobuf:"bytes,7,opt,name=ansi_content,json=ansiContent,proto3" json:"ansi_content,omitempty"` // full screen as ANSI SGR text
+	Seq           uint64                 `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`                                   // Frame sequence number, monotonic per agent session
+	Keyframe      bool                   `protobuf:"varint,9,opt,name=keyframe,proto3" json:"keyframe,omitempty"`                         // lines / ansi_content hold the full screen
+	RowDeltas     []*ScreenRowDelta      `protobuf:"bytes,10,rep,name=row_deltas,json=rowDeltas,proto3" json:"row_deltas,omitempty"`      // Changed rows (delta frames only)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2941,17 +3034,100 @@ func (x *ScreenBuffer) GetAnsiContent() string {
 	return ""
 }
 
+func (x *ScreenBuffer) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ScreenBuffer) GetKeyframe() bool {
+	if x != nil {
+		return x.Keyframe
+	}
+	return false
+}
+
+func (x *ScreenBuffer) GetRowDeltas() []*ScreenRowDelta {
+	if x != nil {
+		return x.RowDeltas
+	}
+	return nil
+}
+
+// ScreenRowDelta replaces one row of the client's screen.
+type ScreenRowDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`  // 0-based screen row
+	Line          string                 `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"` // Plain text
+	Ansi          string                 `protobuf:"bytes,3,opt,name=ansi,proto3" json:"ansi,omitempty"` // Same row as ANSI SGR text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenRowDelta) Reset() {
+	*x = ScreenRowDelta{}
+	mi := &file_proto_watchfire_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenRowDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenRowDelta) ProtoMessage() {}
+
+func (x *ScreenRowDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenRowDelta.ProtoReflect.Descriptor instead.
+func (*ScreenRowDelta) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ScreenRowDelta) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ScreenRowDelta) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *ScreenRowDelta) GetAnsi() string {
+	if x != nil {
+		return x.Ansi
+	}
+	return ""
+}
+
 type SubscribeScreenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Deltas        bool                   `protobuf:"varint,3,opt,name=deltas,proto3" json:"deltas,omitempty"` // Opt in to keyframe + row-delta frames
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubscribeScreenRequest) Reset() {
 	*x = SubscribeScreenRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[30]
+	mi := &file_proto_watchfire_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2963,7 +3139,7 @@ func (x *SubscribeScreenRequest) String() string {
 func (*SubscribeScreenRequest) ProtoMessage() {}
 
 func (x *SubscribeScreenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[30]
+	mi := &file_proto_watchfire_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,7 +3152,7 @@ func (x *SubscribeScreenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeScreenRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeScreenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{30}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SubscribeScreenRequest) GetMeta() *RequestMeta {
@@ -2993,6 +3169,13 @@ func (x *SubscribeScreenRequest) GetProjectId() string {
 	return ""
 }
 
+func (x *SubscribeScreenRequest) GetDeltas() bool {
+	if x != nil {
+		return x.Deltas
+	}
+	return false
+}
+
 type ScrollbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -3005,7 +3188,7 @@ type ScrollbackRequest struct {
 
 func (x *ScrollbackRequest) Reset() {
 	*x = ScrollbackRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[31]
+	mi := &file_proto_watchfire_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3017,7 +3200,7 @@ func (x *ScrollbackRequest) String() string {
 func (*ScrollbackRequest) ProtoMessage() {}
 
 func (x *ScrollbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[31]
+	mi := &file_proto_watchfire_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3030,7 +3213,7 @@ func (x *ScrollbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScrollbackRequest.ProtoReflect.Descriptor instead.
 func (*ScrollbackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{31}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ScrollbackRequest) GetMeta() *RequestMeta {
@@ -3071,7 +3254,7 @@ type ScrollbackLines struct {
 
 func (x *ScrollbackLines) Reset() {
 	*x = ScrollbackLines{}
-	mi := &file_proto_watchfire_proto_msgTypes[32]
+	mi := &file_proto_watchfire_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3083,7 +3266,7 @@ func (x *ScrollbackLines) String() string {
 func (*ScrollbackLines) ProtoMessage() {}
 
 func (x *ScrollbackLines) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[32]
+	mi := &file_proto_watchfire_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +3279,7 @@ func (x *ScrollbackLines) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScrollbackLines.ProtoReflect.Descriptor instead.
 func (*ScrollbackLines) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{32}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ScrollbackLines) GetLines() []string {
@@ -3124,7 +3307,7 @@ type SendInputRequest struct {
 
 func (x *SendInputRequest) Reset() {
 	*x = SendInputRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[33]
+	mi := &file_proto_watchfire_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3136,7 +3319,7 @@ func (x *SendInputRequest) String() string {
 func (*SendInputRequest) ProtoMessage() {}
 
 func (x *SendInputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[33]
+	mi := &file_proto_watchfire_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3149,7 +3332,7 @@ func (x *SendInputRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendInputRequest.ProtoReflect.Descriptor instead.
 func (*SendInputRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{33}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SendInputRequest) GetMeta() *RequestMeta {
@@ -3185,7 +3368,7 @@ type ResizeRequest struct {
 
 func (x *ResizeRequest) Reset() {
 	*x = ResizeRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[34]
+	mi := &file_proto_watchfire_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3197,7 +3380,7 @@ func (x *ResizeRequest) String() string {
 func (*ResizeRequest) ProtoMessage() {}
 
 func (x *ResizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[34]
+	mi := &file_proto_watchfire_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3210,7 +3393,7 @@ func (x *ResizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResizeRequest.ProtoReflect.Descriptor instead.
 func (*ResizeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{34}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ResizeRequest) GetMeta() *RequestMeta {
@@ -3258,7 +3441,7 @@ type SubscribeRawOutputRequest struct {
 
 func (x *SubscribeRawOutputRequest) Reset() {
 	*x = SubscribeRawOutputRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[35]
+	mi := &file_proto_watchfire_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3270,7 +3453,7 @@ func (x *SubscribeRawOutputRequest) String() string {
 func (*SubscribeRawOutputRequest) ProtoMessage() {}
 
 func (x *SubscribeRawOutputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[35]
+	mi := &file_proto_watchfire_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3283,7 +3466,7 @@ func (x *SubscribeRawOutputRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRawOutputRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRawOutputRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{35}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SubscribeRawOutputRequest) GetMeta() *RequestMeta {
@@ -3317,7 +3500,7 @@ type RawOutputChunk struct {
 
 func (x *RawOutputChunk) Reset() {
 	*x = RawOutputChunk{}
-	mi := &file_proto_watchfire_proto_msgTypes[36]
+	mi := &file_proto_watchfire_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3329,7 +3512,7 @@ func (x *RawOutputChunk) String() string {
 func (*RawOutputChunk) ProtoMessage() {}
 
 func (x *RawOutputChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[36]
+	mi := &file_proto_watchfire_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3342,7 +3525,7 @@ func (x *RawOutputChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawOutputChunk.ProtoReflect.Descriptor instead.
 func (*RawOutputChunk) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{36}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RawOutputChunk) GetProjectId() string {
@@ -3361,18 +3544,19 @@ func (x *RawOutputChunk) GetData() []byte {
 
 type AgentIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueType     string                 `protobuf:"bytes,1,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"` // "auth_required" | "rate_limited" | ""
+	IssueType     string                 `protobuf:"bytes,1,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"` // "auth_required" | "rate_limited" | "awaiting_input" | ""
 	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                        // The question, for awaiting_input
 	ResetAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reset_at,json=resetAt,proto3,oneof" json:"reset_at,omitempty"`                   // When limit resets (rate limits)
 	CooldownUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cooldown_until,json=cooldownUntil,proto3,oneof" json:"cooldown_until,omitempty"` // When to auto-resume
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`                                        // Suggested answers (awaiting_input)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AgentIssue) Reset() {
 	*x = AgentIssue{}
-	mi := &file_proto_watchfire_proto_msgTypes[37]
+	mi := &file_proto_watchfire_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3384,7 +3568,7 @@ func (x *AgentIssue) String() string {
 func (*AgentIssue) ProtoMessage() {}
 
 func (x *AgentIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[37]
+	mi := &file_proto_watchfire_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3397,7 +3581,7 @@ func (x *AgentIssue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentIssue.ProtoReflect.Descriptor instead.
 func (*AgentIssue) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{37}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AgentIssue) GetIssueType() string {
@@ -3435,6 +3619,84 @@ func (x *AgentIssue) GetCooldownUntil() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AgentIssue) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// AnswerQuestionRequest answers the question a working agent is waiting
+// on (issue_type "awaiting_input"). option, when > 0, picks the 1-based
+// suggested answer and overrides answer.
+type AnswerQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Option        int32                  `protobuf:"varint,4,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerQuestionRequest) Reset() {
+	*x = AnswerQuestionRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerQuestionRequest) ProtoMessage() {}
+
+func (x *AnswerQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AnswerQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AnswerQuestionRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AnswerQuestionRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetOption() int32 {
+	if x != nil {
+		return x.Option
+	}
+	return 0
+}
+
 type SubscribeAgentIssuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -3445,7 +3707,7 @@ type SubscribeAgentIssuesRequest struct {
 
 func (x *SubscribeAgentIssuesRequest) Reset() {
 	*x = SubscribeAgentIssuesRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[38]
+	mi := &file_proto_watchfire_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3457,7 +3719,7 @@ func (x *SubscribeAgentIssuesRequest) String() string {
 func (*SubscribeAgentIssuesRequest) ProtoMessage() {}
 
 func (x *SubscribeAgentIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[38]
+	mi := &file_proto_watchfire_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3470,7 +3732,7 @@ func (x *SubscribeAgentIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeAgentIssuesRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeAgentIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{38}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SubscribeAgentIssuesRequest) GetMeta() *RequestMeta {
@@ -3501,7 +3763,7 @@ type Branch struct {
 
 func (x *Branch) Reset() {
 	*x = Branch{}
-	mi := &file_proto_watchfire_proto_msgTypes[39]
+	mi := &file_proto_watchfire_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3513,7 +3775,7 @@ func (x *Branch) String() string {
 func (*Branch) ProtoMessage() {}
 
 func (x *Branch) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[39]
+	mi := &file_proto_watchfire_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3526,7 +3788,7 @@ func (x *Branch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Branch.ProtoReflect.Descriptor instead.
 func (*Branch) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{39}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Branch) GetName() string {
@@ -3580,7 +3842,7 @@ type BranchList struct {
 
 func (x *BranchList) Reset() {
 	*x = BranchList{}
-	mi := &file_proto_watchfire_proto_msgTypes[40]
+	mi := &file_proto_watchfire_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3592,7 +3854,7 @@ func (x *BranchList) String() string {
 func (*BranchList) ProtoMessage() {}
 
 func (x *BranchList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[40]
+	mi := &file_proto_watchfire_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3605,7 +3867,7 @@ func (x *BranchList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchList.ProtoReflect.Descriptor instead.
 func (*BranchList) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{40}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *BranchList) GetBranches() []*Branch {
@@ -3627,7 +3889,7 @@ type BranchId struct {
 
 func (x *BranchId) Reset() {
 	*x = BranchId{}
-	mi := &file_proto_watchfire_proto_msgTypes[41]
+	mi := &file_proto_watchfire_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3639,7 +3901,7 @@ func (x *BranchId) String() string {
 func (*BranchId) ProtoMessage() {}
 
 func (x *BranchId) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[41]
+	mi := &file_proto_watchfire_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3652,7 +3914,7 @@ func (x *BranchId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchId.ProtoReflect.Descriptor instead.
 func (*BranchId) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{41}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *BranchId) GetMeta() *RequestMeta {
@@ -3695,7 +3957,7 @@ type MergeBranchRequest struct {
 
 func (x *MergeBranchRequest) Reset() {
 	*x = MergeBranchRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[42]
+	mi := &file_proto_watchfire_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3707,7 +3969,7 @@ func (x *MergeBranchRequest) String() string {
 func (*MergeBranchRequest) ProtoMessage() {}
 
 func (x *MergeBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[42]
+	mi := &file_proto_watchfire_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3720,7 +3982,7 @@ func (x *MergeBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MergeBranchRequest.ProtoReflect.Descriptor instead.
 func (*MergeBranchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{42}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *MergeBranchRequest) GetMeta() *RequestMeta {
@@ -3762,7 +4024,7 @@ type BulkBranchRequest struct {
 
 func (x *BulkBranchRequest) Reset() {
 	*x = BulkBranchRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[43]
+	mi := &file_proto_watchfire_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3774,7 +4036,7 @@ func (x *BulkBranchRequest) String() string {
 func (*BulkBranchRequest) ProtoMessage() {}
 
 func (x *BulkBranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[43]
+	mi := &file_proto_watchfire_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3787,7 +4049,7 @@ func (x *BulkBranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkBranchRequest.ProtoReflect.Descriptor instead.
 func (*BulkBranchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{43}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *BulkBranchRequest) GetMeta() *RequestMeta {
@@ -3820,7 +4082,7 @@ type AgentConfig struct {
 
 func (x *AgentConfig) Reset() {
 	*x = AgentConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[44]
+	mi := &file_proto_watchfire_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3832,7 +4094,7 @@ func (x *AgentConfig) String() string {
 func (*AgentConfig) ProtoMessage() {}
 
 func (x *AgentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[44]
+	mi := &file_proto_watchfire_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3845,7 +4107,7 @@ func (x *AgentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentConfig.ProtoReflect.Descriptor instead.
 func (*AgentConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{44}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AgentConfig) GetPath() string {
@@ -3870,7 +4132,7 @@ type DefaultsConfig struct {
 
 func (x *DefaultsConfig) Reset() {
 	*x = DefaultsConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[45]
+	mi := &file_proto_watchfire_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3882,7 +4144,7 @@ func (x *DefaultsConfig) String() string {
 func (*DefaultsConfig) ProtoMessage() {}
 
 func (x *DefaultsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[45]
+	mi := &file_proto_watchfire_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3895,7 +4157,7 @@ func (x *DefaultsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultsConfig.ProtoReflect.Descriptor instead.
 func (*DefaultsConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{45}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DefaultsConfig) GetAutoMerge() bool {
@@ -3958,7 +4220,7 @@ type NotificationsEvents struct {
 
 func (x *NotificationsEvents) Reset() {
 	*x = NotificationsEvents{}
-	mi := &file_proto_watchfire_proto_msgTypes[46]
+	mi := &file_proto_watchfire_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3970,7 +4232,7 @@ func (x *NotificationsEvents) String() string {
 func (*NotificationsEvents) ProtoMessage() {}
 
 func (x *NotificationsEvents) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[46]
+	mi := &file_proto_watchfire_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3983,7 +4245,7 @@ func (x *NotificationsEvents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationsEvents.ProtoReflect.Descriptor instead.
 func (*NotificationsEvents) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{46}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *NotificationsEvents) GetTaskFailed() bool {
@@ -4019,7 +4281,7 @@ type NotificationsSounds struct {
 
 func (x *NotificationsSounds) Reset() {
 	*x = NotificationsSounds{}
-	mi := &file_proto_watchfire_proto_msgTypes[47]
+	mi := &file_proto_watchfire_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4031,7 +4293,7 @@ func (x *NotificationsSounds) String() string {
 func (*NotificationsSounds) ProtoMessage() {}
 
 func (x *NotificationsSounds) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[47]
+	mi := &file_proto_watchfire_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4044,7 +4306,7 @@ func (x *NotificationsSounds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationsSounds.ProtoReflect.Descriptor instead.
 func (*NotificationsSounds) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{47}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *NotificationsSounds) GetEnabled() bool {
@@ -4086,7 +4348,7 @@ type QuietHoursConfig struct {
 
 func (x *QuietHoursConfig) Reset() {
 	*x = QuietHoursConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[48]
+	mi := &file_proto_watchfire_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4098,7 +4360,7 @@ func (x *QuietHoursConfig) String() string {
 func (*QuietHoursConfig) ProtoMessage() {}
 
 func (x *QuietHoursConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[48]
+	mi := &file_proto_watchfire_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4111,7 +4373,7 @@ func (x *QuietHoursConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuietHoursConfig.ProtoReflect.Descriptor instead.
 func (*QuietHoursConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{48}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *QuietHoursConfig) GetEnabled() bool {
@@ -4148,7 +4410,7 @@ type NotificationsConfig struct {
 
 func (x *NotificationsConfig) Reset() {
 	*x = NotificationsConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[49]
+	mi := &file_proto_watchfire_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4160,7 +4422,7 @@ func (x *NotificationsConfig) String() string {
 func (*NotificationsConfig) ProtoMessage() {}
 
 func (x *NotificationsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[49]
+	mi := &file_proto_watchfire_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4173,7 +4435,7 @@ func (x *NotificationsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationsConfig.ProtoReflect.Descriptor instead.
 func (*NotificationsConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{49}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *NotificationsConfig) GetEnabled() bool {
@@ -4222,7 +4484,7 @@ type UpdatesConfig struct {
 
 func (x *UpdatesConfig) Reset() {
 	*x = UpdatesConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[50]
+	mi := &file_proto_watchfire_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4234,7 +4496,7 @@ func (x *UpdatesConfig) String() string {
 func (*UpdatesConfig) ProtoMessage() {}
 
 func (x *UpdatesConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[50]
+	mi := &file_proto_watchfire_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4247,7 +4509,7 @@ func (x *UpdatesConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatesConfig.ProtoReflect.Descriptor instead.
 func (*UpdatesConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{50}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *UpdatesConfig) GetCheckOnStartup() bool {
@@ -4280,7 +4542,7 @@ type AppearanceConfig struct {
 
 func (x *AppearanceConfig) Reset() {
 	*x = AppearanceConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[51]
+	mi := &file_proto_watchfire_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4292,7 +4554,7 @@ func (x *AppearanceConfig) String() string {
 func (*AppearanceConfig) ProtoMessage() {}
 
 func (x *AppearanceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[51]
+	mi := &file_proto_watchfire_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4305,7 +4567,7 @@ func (x *AppearanceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppearanceConfig.ProtoReflect.Descriptor instead.
 func (*AppearanceConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{51}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AppearanceConfig) GetTheme() string {
@@ -4315,33 +4577,30 @@ func (x *AppearanceConfig) GetTheme() string {
 	return ""
 }
 
-type Settings struct {
-	state          protoimpl.MessageState  `protogen:"open.v1"`
-	Version        int32                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Agents         map[string]*AgentConfig `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Defaults       *DefaultsConfig         `protobuf:"bytes,3,opt,name=defaults,proto3" json:"defaults,omitempty"`
-	Updates        *UpdatesConfig          `protobuf:"bytes,4,opt,name=updates,proto3" json:"updates,omitempty"`
-	Appearance     *AppearanceConfig       `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
-	InstallationId string                  `protobuf:"bytes,6,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RecordingsConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                    // Record agent sessions as asciicast
+	MaxAgeDays    int32                  `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`          // 0 = keep regardless of age
+	MaxPerProject int32                  `protobuf:"varint,3,opt,name=max_per_project,json=maxPerProject,proto3" json:"max_per_project,omitempty"` // 0 = no cap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Settings) Reset() {
-	*x = Settings{}
-	mi := &file_proto_watchfire_proto_msgTypes[52]
+func (x *RecordingsConfig) Reset() {
+	*x = RecordingsConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Settings) String() string {
+func (x *RecordingsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Settings) ProtoMessage() {}
+func (*RecordingsConfig) ProtoMessage() {}
 
-func (x *Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[52]
+func (x *RecordingsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4352,79 +4611,58 @@ func (x *Settings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
-func (*Settings) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *Settings) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *Settings) GetAgents() map[string]*AgentConfig {
-	if x != nil {
-		return x.Agents
-	}
-	return nil
-}
-
-func (x *Settings) GetDefaults() *DefaultsConfig {
-	if x != nil {
-		return x.Defaults
-	}
-	return nil
+// Deprecated: Use RecordingsConfig.ProtoReflect.Descriptor instead.
+func (*RecordingsConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Settings) GetUpdates() *UpdatesConfig {
+func (x *RecordingsConfig) GetEnabled() bool {
 	if x != nil {
-		return x.Updates
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *Settings) GetAppearance() *AppearanceConfig {
+func (x *RecordingsConfig) GetMaxAgeDays() int32 {
 	if x != nil {
-		return x.Appearance
+		return x.MaxAgeDays
 	}
-	return nil
+	return 0
 }
 
-func (x *Settings) GetInstallationId() string {
+func (x *RecordingsConfig) GetMaxPerProject() int32 {
 	if x != nil {
-		return x.InstallationId
+		return x.MaxPerProject
 	}
-	return ""
+	return 0
 }
 
-type UpdateSettingsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Meta          *RequestMeta            `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Defaults      *DefaultsConfig         `protobuf:"bytes,2,opt,name=defaults,proto3,oneof" json:"defaults,omitempty"`
-	Updates       *UpdatesConfig          `protobuf:"bytes,3,opt,name=updates,proto3,oneof" json:"updates,omitempty"`
-	Appearance    *AppearanceConfig       `protobuf:"bytes,4,opt,name=appearance,proto3,oneof" json:"appearance,omitempty"`
-	Agents        map[string]*AgentConfig `protobuf:"bytes,5,rep,name=agents,proto3" json:"agents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Merge into existing
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RetentionConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                               // Run the periodic janitor (watchfire gc works regardless)
+	MaxAgeDays       int32                  `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`                     // Session logs and cache files; 0 = unlimited
+	MaxLogs          int32                  `protobuf:"varint,3,opt,name=max_logs,json=maxLogs,proto3" json:"max_logs,omitempty"`                                // Newest N session logs kept per project; 0 = unlimited
+	MaxSizeMb        int32                  `protobuf:"varint,4,opt,name=max_size_mb,json=maxSizeMb,proto3" json:"max_size_mb,omitempty"`                        // Total session-log size per project; 0 = unlimited
+	BranchMaxAgeDays int32                  `protobuf:"varint,5,opt,name=branch_max_age_days,json=branchMaxAgeDays,proto3" json:"branch_max_age_days,omitempty"` // watchfire/* branches of done/deleted tasks; 0 = never delete
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsRequest) Reset() {
-	*x = UpdateSettingsRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[53]
+func (x *RetentionConfig) Reset() {
+	*x = RetentionConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsRequest) String() string {
+func (x *RetentionConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsRequest) ProtoMessage() {}
+func (*RetentionConfig) ProtoMessage() {}
 
-func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[53]
+func (x *RetentionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4435,70 +4673,69 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use RetentionConfig.ProtoReflect.Descriptor instead.
+func (*RetentionConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateSettingsRequest) GetMeta() *RequestMeta {
+func (x *RetentionConfig) GetEnabled() bool {
 	if x != nil {
-		return x.Meta
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateSettingsRequest) GetDefaults() *DefaultsConfig {
+func (x *RetentionConfig) GetMaxAgeDays() int32 {
 	if x != nil {
-		return x.Defaults
+		return x.MaxAgeDays
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateSettingsRequest) GetUpdates() *UpdatesConfig {
+func (x *RetentionConfig) GetMaxLogs() int32 {
 	if x != nil {
-		return x.Updates
+		return x.MaxLogs
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateSettingsRequest) GetAppearance() *AppearanceConfig {
+func (x *RetentionConfig) GetMaxSizeMb() int32 {
 	if x != nil {
-		return x.Appearance
+		return x.MaxSizeMb
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateSettingsRequest) GetAgents() map[string]*AgentConfig {
+func (x *RetentionConfig) GetBranchMaxAgeDays() int32 {
 	if x != nil {
-		return x.Agents
+		return x.BranchMaxAgeDays
 	}
-	return nil
+	return 0
 }
 
-type AgentInfo struct {
+type MetricsEndpointConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Backend name (e.g. "claude-code")
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Human-readable label (e.g. "Claude Code")
-	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`                       // Binary resolves on this host (display-time hint only — never used to filter the registry)
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"` // Serve Prometheus /metrics (off by default)
+	Listen        string                 `protobuf:"bytes,2,opt,name=listen,proto3" json:"listen,omitempty"`    // host:port; default 127.0.0.1:9464
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AgentInfo) Reset() {
-	*x = AgentInfo{}
-	mi := &file_proto_watchfire_proto_msgTypes[54]
+func (x *MetricsEndpointConfig) Reset() {
+	*x = MetricsEndpointConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentInfo) String() string {
+func (x *MetricsEndpointConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentInfo) ProtoMessage() {}
+func (*MetricsEndpointConfig) ProtoMessage() {}
 
-func (x *AgentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[54]
+func (x *MetricsEndpointConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4509,54 +4746,51 @@ func (x *AgentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
-func (*AgentInfo) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use MetricsEndpointConfig.ProtoReflect.Descriptor instead.
+func (*MetricsEndpointConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AgentInfo) GetName() string {
+func (x *MetricsEndpointConfig) GetEnabled() bool {
 	if x != nil {
-		return x.Name
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *AgentInfo) GetDisplayName() string {
+func (x *MetricsEndpointConfig) GetListen() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Listen
 	}
 	return ""
 }
 
-func (x *AgentInfo) GetAvailable() bool {
-	if x != nil {
-		return x.Available
-	}
-	return false
-}
-
-type AgentList struct {
+type TracingConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Agents        []*AgentInfo           `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                                          // Export task-lifecycle spans (off by default)
+	Exporter      string                 `protobuf:"bytes,2,opt,name=exporter,proto3" json:"exporter,omitempty"`                                                                         // "file" | "otlp"
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                                                                         // OTLP/HTTP base URL; spans go to <endpoint>/v1/traces
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Sent with every OTLP request
+	File          string                 `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`                                                                                 // JSONL path; empty = ~/.watchfire/traces.jsonl
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AgentList) Reset() {
-	*x = AgentList{}
-	mi := &file_proto_watchfire_proto_msgTypes[55]
+func (x *TracingConfig) Reset() {
+	*x = TracingConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentList) String() string {
+func (x *TracingConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentList) ProtoMessage() {}
+func (*TracingConfig) ProtoMessage() {}
 
-func (x *AgentList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[55]
+func (x *TracingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4567,48 +4801,72 @@ func (x *AgentList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentList.ProtoReflect.Descriptor instead.
-func (*AgentList) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use TracingConfig.ProtoReflect.Descriptor instead.
+func (*TracingConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AgentList) GetAgents() []*AgentInfo {
+func (x *TracingConfig) GetEnabled() bool {
 	if x != nil {
-		return x.Agents
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TracingConfig) GetExporter() string {
+	if x != nil {
+		return x.Exporter
+	}
+	return ""
+}
+
+func (x *TracingConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *TracingConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
 	}
 	return nil
 }
 
-// McpClientStatus is one known MCP client's onboarding state on this machine
-// (v9.0 Firestorm). Produced both by GetMcpClientStatus (per-client state) and
-// by InstallMcpClient (post-install state + outcome message).
-type McpClientStatus struct {
+func (x *TracingConfig) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type TokenPrice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`                              // Stable key: "claude-code" | "codex" | "gemini" | "opencode" | "copilot"
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Human-readable client name (e.g. "Gemini CLI")
-	Detected      bool                   `protobuf:"varint,3,opt,name=detected,proto3" json:"detected,omitempty"`                         // Harness appears installed on this machine (CLI on PATH or config dir present)
-	Configured    bool                   `protobuf:"varint,4,opt,name=configured,proto3" json:"configured,omitempty"`                     // Client config already has a watchfire MCP entry
-	ConfigPath    string                 `protobuf:"bytes,5,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`    // Where the client's MCP config lives (may not exist yet)
-	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                            // Human-readable result; for a failed install, the manual instructions + snippet
+	Backend       string                 `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`                                      // Agent backend, e.g. "claude-code"
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`                                          // Model prefix; empty = the backend's fallback rate
+	InputPerMtok  float64                `protobuf:"fixed64,3,opt,name=input_per_mtok,json=inputPerMtok,proto3" json:"input_per_mtok,omitempty"`    // USD per million input tokens
+	OutputPerMtok float64                `protobuf:"fixed64,4,opt,name=output_per_mtok,json=outputPerMtok,proto3" json:"output_per_mtok,omitempty"` // USD per million output tokens
+	CachedPerMtok float64                `protobuf:"fixed64,5,opt,name=cached_per_mtok,json=cachedPerMtok,proto3" json:"cached_per_mtok,omitempty"` // USD per million cache-read tokens
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *McpClientStatus) Reset() {
-	*x = McpClientStatus{}
-	mi := &file_proto_watchfire_proto_msgTypes[56]
+func (x *TokenPrice) Reset() {
+	*x = TokenPrice{}
+	mi := &file_proto_watchfire_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *McpClientStatus) String() string {
+func (x *TokenPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*McpClientStatus) ProtoMessage() {}
+func (*TokenPrice) ProtoMessage() {}
 
-func (x *McpClientStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[56]
+func (x *TokenPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4619,76 +4877,68 @@ func (x *McpClientStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use McpClientStatus.ProtoReflect.Descriptor instead.
-func (*McpClientStatus) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use TokenPrice.ProtoReflect.Descriptor instead.
+func (*TokenPrice) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *McpClientStatus) GetClient() string {
+func (x *TokenPrice) GetBackend() string {
 	if x != nil {
-		return x.Client
+		return x.Backend
 	}
 	return ""
 }
 
-func (x *McpClientStatus) GetDisplayName() string {
+func (x *TokenPrice) GetModel() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Model
 	}
 	return ""
 }
 
-func (x *McpClientStatus) GetDetected() bool {
-	if x != nil {
-		return x.Detected
-	}
-	return false
-}
-
-func (x *McpClientStatus) GetConfigured() bool {
+func (x *TokenPrice) GetInputPerMtok() float64 {
 	if x != nil {
-		return x.Configured
+		return x.InputPerMtok
 	}
-	return false
+	return 0
 }
 
-func (x *McpClientStatus) GetConfigPath() string {
+func (x *TokenPrice) GetOutputPerMtok() float64 {
 	if x != nil {
-		return x.ConfigPath
+		return x.OutputPerMtok
 	}
-	return ""
+	return 0
 }
 
-func (x *McpClientStatus) GetMessage() string {
+func (x *TokenPrice) GetCachedPerMtok() float64 {
 	if x != nil {
-		return x.Message
+		return x.CachedPerMtok
 	}
-	return ""
+	return 0
 }
 
-type McpClientStatusList struct {
+type PricingConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clients       []*McpClientStatus     `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
-	CustomSnippet string                 `protobuf:"bytes,2,opt,name=custom_snippet,json=customSnippet,proto3" json:"custom_snippet,omitempty"` // Generic JSON block for the Custom option, so every surface renders it from one source of truth
+	Prices        []*TokenPrice          `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"` // Used to estimate cost when a backend reports none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *McpClientStatusList) Reset() {
-	*x = McpClientStatusList{}
-	mi := &file_proto_watchfire_proto_msgTypes[57]
+func (x *PricingConfig) Reset() {
+	*x = PricingConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *McpClientStatusList) String() string {
+func (x *PricingConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*McpClientStatusList) ProtoMessage() {}
+func (*PricingConfig) ProtoMessage() {}
 
-func (x *McpClientStatusList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[57]
+func (x *PricingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4699,48 +4949,42 @@ func (x *McpClientStatusList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use McpClientStatusList.ProtoReflect.Descriptor instead.
-func (*McpClientStatusList) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use PricingConfig.ProtoReflect.Descriptor instead.
+func (*PricingConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *McpClientStatusList) GetClients() []*McpClientStatus {
+func (x *PricingConfig) GetPrices() []*TokenPrice {
 	if x != nil {
-		return x.Clients
+		return x.Prices
 	}
 	return nil
 }
 
-func (x *McpClientStatusList) GetCustomSnippet() string {
-	if x != nil {
-		return x.CustomSnippet
-	}
-	return ""
-}
-
-type InstallMcpClientRequest struct {
+type BudgetConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Client        string                 `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"` // Stable key from McpClientStatus.client
+	MonthlyUsd    float64                `protobuf:"fixed64,1,opt,name=monthly_usd,json=monthlyUsd,proto3" json:"monthly_usd,omitempty"` // 0 = no budget
+	Thresholds    []int32                `protobuf:"varint,2,rep,packed,name=thresholds,proto3" json:"thresholds,omitempty"`             // Percentages that raise BUDGET_THRESHOLD
+	HardStop      bool                   `protobuf:"varint,3,opt,name=hard_stop,json=hardStop,proto3" json:"hard_stop,omitempty"`        // Refuse non-chat starts once spent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallMcpClientRequest) Reset() {
-	*x = InstallMcpClientRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[58]
+func (x *BudgetConfig) Reset() {
+	*x = BudgetConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallMcpClientRequest) String() string {
+func (x *BudgetConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallMcpClientRequest) ProtoMessage() {}
+func (*BudgetConfig) ProtoMessage() {}
 
-func (x *InstallMcpClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[58]
+func (x *BudgetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4751,49 +4995,65 @@ func (x *InstallMcpClientRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallMcpClientRequest.ProtoReflect.Descriptor instead.
-func (*InstallMcpClientRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use BudgetConfig.ProtoReflect.Descriptor instead.
+func (*BudgetConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *InstallMcpClientRequest) GetMeta() *RequestMeta {
+func (x *BudgetConfig) GetMonthlyUsd() float64 {
 	if x != nil {
-		return x.Meta
+		return x.MonthlyUsd
+	}
+	return 0
+}
+
+func (x *BudgetConfig) GetThresholds() []int32 {
+	if x != nil {
+		return x.Thresholds
 	}
 	return nil
 }
 
-func (x *InstallMcpClientRequest) GetClient() string {
+func (x *BudgetConfig) GetHardStop() bool {
 	if x != nil {
-		return x.Client
+		return x.HardStop
 	}
-	return ""
+	return false
 }
 
-type SetGitHubAutoPRScopeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Settings struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Version         int32                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Agents          map[string]*AgentConfig `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Defaults        *DefaultsConfig         `protobuf:"bytes,3,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Updates         *UpdatesConfig          `protobuf:"bytes,4,opt,name=updates,proto3" json:"updates,omitempty"`
+	Appearance      *AppearanceConfig       `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	InstallationId  string                  `protobuf:"bytes,6,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	Recordings      *RecordingsConfig       `protobuf:"bytes,7,opt,name=recordings,proto3" json:"recordings,omitempty"`
+	Retention       *RetentionConfig        `protobuf:"bytes,8,opt,name=retention,proto3" json:"retention,omitempty"`
+	MetricsEndpoint *MetricsEndpointConfig  `protobuf:"bytes,9,opt,name=metrics_endpoint,json=metricsEndpoint,proto3" json:"metrics_endpoint,omitempty"`
+	Tracing         *TracingConfig          `protobuf:"bytes,10,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	Pricing         *PricingConfig          `protobuf:"bytes,11,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	Budget          *BudgetConfig           `protobuf:"bytes,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SetGitHubAutoPRScopeRequest) Reset() {
-	*x = SetGitHubAutoPRScopeRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[59]
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_proto_watchfire_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetGitHubAutoPRScopeRequest) String() string {
+func (x *Settings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetGitHubAutoPRScopeRequest) ProtoMessage() {}
+func (*Settings) ProtoMessage() {}
 
-func (x *SetGitHubAutoPRScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[59]
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4804,122 +5064,127 @@ func (x *SetGitHubAutoPRScopeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetGitHubAutoPRScopeRequest.ProtoReflect.Descriptor instead.
-func (*SetGitHubAutoPRScopeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SetGitHubAutoPRScopeRequest) GetMeta() *RequestMeta {
+func (x *Settings) GetVersion() int32 {
 	if x != nil {
-		return x.Meta
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *SetGitHubAutoPRScopeRequest) GetProjectId() string {
+func (x *Settings) GetAgents() map[string]*AgentConfig {
 	if x != nil {
-		return x.ProjectId
+		return x.Agents
 	}
-	return ""
+	return nil
 }
 
-func (x *SetGitHubAutoPRScopeRequest) GetEnabled() bool {
+func (x *Settings) GetDefaults() *DefaultsConfig {
 	if x != nil {
-		return x.Enabled
+		return x.Defaults
 	}
-	return false
+	return nil
 }
 
-type SetProjectIntegrationBindingsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	SlackChannel   string                 `protobuf:"bytes,3,opt,name=slack_channel,json=slackChannel,proto3" json:"slack_channel,omitempty"`
-	DiscordGuildId string                 `protobuf:"bytes,4,opt,name=discord_guild_id,json=discordGuildId,proto3" json:"discord_guild_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Settings) GetUpdates() *UpdatesConfig {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
 }
 
-func (x *SetProjectIntegrationBindingsRequest) Reset() {
-	*x = SetProjectIntegrationBindingsRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Settings) GetAppearance() *AppearanceConfig {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
 }
 
-func (x *SetProjectIntegrationBindingsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Settings) GetInstallationId() string {
+	if x != nil {
+		return x.InstallationId
+	}
+	return ""
 }
 
-func (*SetProjectIntegrationBindingsRequest) ProtoMessage() {}
-
-func (x *SetProjectIntegrationBindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[60]
+func (x *Settings) GetRecordings() *RecordingsConfig {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Recordings
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SetProjectIntegrationBindingsRequest.ProtoReflect.Descriptor instead.
-func (*SetProjectIntegrationBindingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{60}
+func (x *Settings) GetRetention() *RetentionConfig {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
 }
 
-func (x *SetProjectIntegrationBindingsRequest) GetMeta() *RequestMeta {
+func (x *Settings) GetMetricsEndpoint() *MetricsEndpointConfig {
 	if x != nil {
-		return x.Meta
+		return x.MetricsEndpoint
 	}
 	return nil
 }
 
-func (x *SetProjectIntegrationBindingsRequest) GetProjectId() string {
+func (x *Settings) GetTracing() *TracingConfig {
 	if x != nil {
-		return x.ProjectId
+		return x.Tracing
 	}
-	return ""
+	return nil
 }
 
-func (x *SetProjectIntegrationBindingsRequest) GetSlackChannel() string {
+func (x *Settings) GetPricing() *PricingConfig {
 	if x != nil {
-		return x.SlackChannel
+		return x.Pricing
 	}
-	return ""
+	return nil
 }
 
-func (x *SetProjectIntegrationBindingsRequest) GetDiscordGuildId() string {
+func (x *Settings) GetBudget() *BudgetConfig {
 	if x != nil {
-		return x.DiscordGuildId
+		return x.Budget
 	}
-	return ""
+	return nil
 }
 
-type SubscribeFocusEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateSettingsRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Meta            *RequestMeta            `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Defaults        *DefaultsConfig         `protobuf:"bytes,2,opt,name=defaults,proto3,oneof" json:"defaults,omitempty"`
+	Updates         *UpdatesConfig          `protobuf:"bytes,3,opt,name=updates,proto3,oneof" json:"updates,omitempty"`
+	Appearance      *AppearanceConfig       `protobuf:"bytes,4,opt,name=appearance,proto3,oneof" json:"appearance,omitempty"`
+	Agents          map[string]*AgentConfig `protobuf:"bytes,5,rep,name=agents,proto3" json:"agents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Merge into existing
+	Recordings      *RecordingsConfig       `protobuf:"bytes,6,opt,name=recordings,proto3,oneof" json:"recordings,omitempty"`
+	Retention       *RetentionConfig        `protobuf:"bytes,7,opt,name=retention,proto3,oneof" json:"retention,omitempty"`
+	MetricsEndpoint *MetricsEndpointConfig  `protobuf:"bytes,8,opt,name=metrics_endpoint,json=metricsEndpoint,proto3,oneof" json:"metrics_endpoint,omitempty"`
+	Tracing         *TracingConfig          `protobuf:"bytes,9,opt,name=tracing,proto3,oneof" json:"tracing,omitempty"`
+	Pricing         *PricingConfig          `protobuf:"bytes,10,opt,name=pricing,proto3,oneof" json:"pricing,omitempty"` // Replaces the whole table
+	Budget          *BudgetConfig           `protobuf:"bytes,11,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SubscribeFocusEventsRequest) Reset() {
-	*x = SubscribeFocusEventsRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[61]
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeFocusEventsRequest) String() string {
+func (x *UpdateSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeFocusEventsRequest) ProtoMessage() {}
+func (*UpdateSettingsRequest) ProtoMessage() {}
 
-func (x *SubscribeFocusEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[61]
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4930,108 +5195,111 @@ func (x *SubscribeFocusEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeFocusEventsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeFocusEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *SubscribeFocusEventsRequest) GetMeta() *RequestMeta {
+func (x *UpdateSettingsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type FocusEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Target        FocusTarget            `protobuf:"varint,2,opt,name=target,proto3,enum=watchfire.FocusTarget" json:"target,omitempty"`
-	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"` // Only meaningful when target == FOCUS_TARGET_TASK
-	DigestDate    string                 `protobuf:"bytes,4,opt,name=digest_date,json=digestDate,proto3" json:"digest_date,omitempty"`  // YYYY-MM-DD identifying the digest file (FOCUS_TARGET_DIGEST)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateSettingsRequest) GetDefaults() *DefaultsConfig {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
 }
 
-func (x *FocusEvent) Reset() {
-	*x = FocusEvent{}
-	mi := &file_proto_watchfire_proto_msgTypes[62]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateSettingsRequest) GetUpdates() *UpdatesConfig {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
 }
 
-func (x *FocusEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateSettingsRequest) GetAppearance() *AppearanceConfig {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
 }
 
-func (*FocusEvent) ProtoMessage() {}
+func (x *UpdateSettingsRequest) GetAgents() map[string]*AgentConfig {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
 
-func (x *FocusEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[62]
+func (x *UpdateSettingsRequest) GetRecordings() *RecordingsConfig {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Recordings
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FocusEvent.ProtoReflect.Descriptor instead.
-func (*FocusEvent) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{62}
+func (x *UpdateSettingsRequest) GetRetention() *RetentionConfig {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
 }
 
-func (x *FocusEvent) GetProjectId() string {
+func (x *UpdateSettingsRequest) GetMetricsEndpoint() *MetricsEndpointConfig {
 	if x != nil {
-		return x.ProjectId
+		return x.MetricsEndpoint
 	}
-	return ""
+	return nil
 }
 
-func (x *FocusEvent) GetTarget() FocusTarget {
+func (x *UpdateSettingsRequest) GetTracing() *TracingConfig {
 	if x != nil {
-		return x.Target
+		return x.Tracing
 	}
-	return FocusTarget_FOCUS_TARGET_MAIN
+	return nil
 }
 
-func (x *FocusEvent) GetTaskNumber() int32 {
+func (x *UpdateSettingsRequest) GetPricing() *PricingConfig {
 	if x != nil {
-		return x.TaskNumber
+		return x.Pricing
 	}
-	return 0
+	return nil
 }
 
-func (x *FocusEvent) GetDigestDate() string {
+func (x *UpdateSettingsRequest) GetBudget() *BudgetConfig {
 	if x != nil {
-		return x.DigestDate
+		return x.Budget
 	}
-	return ""
+	return nil
 }
 
-type ListLogsRequest struct {
+type AgentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Backend name (e.g. "claude-code")
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Human-readable label (e.g. "Claude Code")
+	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`                       // Binary resolves on this host (display-time hint only — never used to filter the registry)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLogsRequest) Reset() {
-	*x = ListLogsRequest{}
+func (x *AgentInfo) Reset() {
+	*x = AgentInfo{}
 	mi := &file_proto_watchfire_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLogsRequest) String() string {
+func (x *AgentInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLogsRequest) ProtoMessage() {}
+func (*AgentInfo) ProtoMessage() {}
 
-func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
+func (x *AgentInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5043,54 +5311,53 @@ func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLogsRequest.ProtoReflect.Descriptor instead.
-func (*ListLogsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
+func (*AgentInfo) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListLogsRequest) GetMeta() *RequestMeta {
+func (x *AgentInfo) GetName() string {
 	if x != nil {
-		return x.Meta
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListLogsRequest) GetProjectId() string {
+func (x *AgentInfo) GetDisplayName() string {
 	if x != nil {
-		return x.ProjectId
+		return x.DisplayName
 	}
 	return ""
 }
 
-type LogEntry struct {
+func (x *AgentInfo) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type AgentList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LogId         string                 `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`
-	SessionNumber int32                  `protobuf:"varint,4,opt,name=session_number,json=sessionNumber,proto3" json:"session_number,omitempty"`
-	Agent         string                 `protobuf:"bytes,5,opt,name=agent,proto3" json:"agent,omitempty"`
-	Mode          string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
-	StartedAt     string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt       string                 `protobuf:"bytes,8,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Agents        []*AgentInfo           `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogEntry) Reset() {
-	*x = LogEntry{}
+func (x *AgentList) Reset() {
+	*x = AgentList{}
 	mi := &file_proto_watchfire_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogEntry) String() string {
+func (x *AgentList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogEntry) ProtoMessage() {}
+func (*AgentList) ProtoMessage() {}
 
-func (x *LogEntry) ProtoReflect() protoreflect.Message {
+func (x *AgentList) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5102,141 +5369,127 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
-func (*LogEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use AgentList.ProtoReflect.Descriptor instead.
+func (*AgentList) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *LogEntry) GetLogId() string {
+func (x *AgentList) GetAgents() []*AgentInfo {
 	if x != nil {
-		return x.LogId
+		return x.Agents
 	}
-	return ""
+	return nil
 }
 
-func (x *LogEntry) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// McpClientStatus is one known MCP client's onboarding state on this machine
+// (v9.0 Firestorm). Produced both by GetMcpClientStatus (per-client state) and
+// by InstallMcpClient (post-install state + outcome message).
+type McpClientStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`                              // Stable key: "claude-code" | "codex" | "gemini" | "opencode" | "copilot"
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Human-readable client name (e.g. "Gemini CLI")
+	Detected      bool                   `protobuf:"varint,3,opt,name=detected,proto3" json:"detected,omitempty"`                         // Harness appears installed on this machine (CLI on PATH or config dir present)
+	Configured    bool                   `protobuf:"varint,4,opt,name=configured,proto3" json:"configured,omitempty"`                     // Client config already has a watchfire MCP entry
+	ConfigPath    string                 `protobuf:"bytes,5,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`    // Where the client's MCP config lives (may not exist yet)
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                            // Human-readable result; for a failed install, the manual instructions + snippet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogEntry) GetTaskNumber() int32 {
-	if x != nil {
-		return x.TaskNumber
-	}
-	return 0
+func (x *McpClientStatus) Reset() {
+	*x = McpClientStatus{}
+	mi := &file_proto_watchfire_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogEntry) GetSessionNumber() int32 {
-	if x != nil {
-		return x.SessionNumber
-	}
-	return 0
+func (x *McpClientStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LogEntry) GetAgent() string {
+func (*McpClientStatus) ProtoMessage() {}
+
+func (x *McpClientStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[65]
 	if x != nil {
-		return x.Agent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LogEntry) GetMode() string {
-	if x != nil {
-		return x.Mode
-	}
-	return ""
+// Deprecated: Use McpClientStatus.ProtoReflect.Descriptor instead.
+func (*McpClientStatus) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *LogEntry) GetStartedAt() string {
+func (x *McpClientStatus) GetClient() string {
 	if x != nil {
-		return x.StartedAt
+		return x.Client
 	}
 	return ""
 }
 
-func (x *LogEntry) GetEndedAt() string {
+func (x *McpClientStatus) GetDisplayName() string {
 	if x != nil {
-		return x.EndedAt
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *LogEntry) GetStatus() string {
+func (x *McpClientStatus) GetDetected() bool {
 	if x != nil {
-		return x.Status
+		return x.Detected
 	}
-	return ""
-}
-
-type LogList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LogList) Reset() {
-	*x = LogList{}
-	mi := &file_proto_watchfire_proto_msgTypes[65]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LogList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*LogList) ProtoMessage() {}
-
-func (x *LogList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[65]
+func (x *McpClientStatus) GetConfigured() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Configured
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use LogList.ProtoReflect.Descriptor instead.
-func (*LogList) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{65}
+func (x *McpClientStatus) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
 }
 
-func (x *LogList) GetLogs() []*LogEntry {
+func (x *McpClientStatus) GetMessage() string {
 	if x != nil {
-		return x.Logs
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type GetLogRequest struct {
+type McpClientStatusList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Clients       []*McpClientStatus     `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	CustomSnippet string                 `protobuf:"bytes,2,opt,name=custom_snippet,json=customSnippet,proto3" json:"custom_snippet,omitempty"` // Generic JSON block for the Custom option, so every surface renders it from one source of truth
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLogRequest) Reset() {
-	*x = GetLogRequest{}
+func (x *McpClientStatusList) Reset() {
+	*x = McpClientStatusList{}
 	mi := &file_proto_watchfire_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLogRequest) String() string {
+func (x *McpClientStatusList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLogRequest) ProtoMessage() {}
+func (*McpClientStatusList) ProtoMessage() {}
 
-func (x *GetLogRequest) ProtoReflect() protoreflect.Message {
+func (x *McpClientStatusList) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5248,54 +5501,47 @@ func (x *GetLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLogRequest.ProtoReflect.Descriptor instead.
-func (*GetLogRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use McpClientStatusList.ProtoReflect.Descriptor instead.
+func (*McpClientStatusList) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetLogRequest) GetMeta() *RequestMeta {
+func (x *McpClientStatusList) GetClients() []*McpClientStatus {
 	if x != nil {
-		return x.Meta
+		return x.Clients
 	}
 	return nil
 }
 
-func (x *GetLogRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (x *GetLogRequest) GetLogId() string {
+func (x *McpClientStatusList) GetCustomSnippet() string {
 	if x != nil {
-		return x.LogId
+		return x.CustomSnippet
 	}
 	return ""
 }
 
-type LogContent struct {
+type InstallMcpClientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *LogEntry              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Client        string                 `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"` // Stable key from McpClientStatus.client
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogContent) Reset() {
-	*x = LogContent{}
+func (x *InstallMcpClientRequest) Reset() {
+	*x = InstallMcpClientRequest{}
 	mi := &file_proto_watchfire_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogContent) String() string {
+func (x *InstallMcpClientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogContent) ProtoMessage() {}
+func (*InstallMcpClientRequest) ProtoMessage() {}
 
-func (x *LogContent) ProtoReflect() protoreflect.Message {
+func (x *InstallMcpClientRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5307,48 +5553,48 @@ func (x *LogContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogContent.ProtoReflect.Descriptor instead.
-func (*LogContent) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstallMcpClientRequest.ProtoReflect.Descriptor instead.
+func (*InstallMcpClientRequest) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *LogContent) GetEntry() *LogEntry {
+func (x *InstallMcpClientRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Entry
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *LogContent) GetContent() string {
+func (x *InstallMcpClientRequest) GetClient() string {
 	if x != nil {
-		return x.Content
+		return x.Client
 	}
 	return ""
 }
 
-type DeleteLogRequest struct {
+type SetGitHubAutoPRScopeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLogRequest) Reset() {
-	*x = DeleteLogRequest{}
+func (x *SetGitHubAutoPRScopeRequest) Reset() {
+	*x = SetGitHubAutoPRScopeRequest{}
 	mi := &file_proto_watchfire_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLogRequest) String() string {
+func (x *SetGitHubAutoPRScopeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLogRequest) ProtoMessage() {}
+func (*SetGitHubAutoPRScopeRequest) ProtoMessage() {}
 
-func (x *DeleteLogRequest) ProtoReflect() protoreflect.Message {
+func (x *SetGitHubAutoPRScopeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5360,62 +5606,56 @@ func (x *DeleteLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLogRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLogRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetGitHubAutoPRScopeRequest.ProtoReflect.Descriptor instead.
+func (*SetGitHubAutoPRScopeRequest) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *DeleteLogRequest) GetMeta() *RequestMeta {
+func (x *SetGitHubAutoPRScopeRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteLogRequest) GetProjectId() string {
+func (x *SetGitHubAutoPRScopeRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *DeleteLogRequest) GetLogId() string {
+func (x *SetGitHubAutoPRScopeRequest) GetEnabled() bool {
 	if x != nil {
-		return x.LogId
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-// Notification is a single user-facing event the daemon emits when something
-// the user cares about happens (a task fails, an autonomous run finishes, …).
-// Mirrors the JSONL record written to ~/.watchfire/logs/<project_id>/notifications.log.
-type Notification struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Stable hash; duplicates collapse
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"` // 0 when not task-scoped
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	EmittedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=emitted_at,json=emittedAt,proto3" json:"emitted_at,omitempty"`
-	Kind          NotificationKind       `protobuf:"varint,7,opt,name=kind,proto3,enum=watchfire.NotificationKind" json:"kind,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetProjectIntegrationBindingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SlackChannel   string                 `protobuf:"bytes,3,opt,name=slack_channel,json=slackChannel,proto3" json:"slack_channel,omitempty"`
+	DiscordGuildId string                 `protobuf:"bytes,4,opt,name=discord_guild_id,json=discordGuildId,proto3" json:"discord_guild_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *SetProjectIntegrationBindingsRequest) Reset() {
+	*x = SetProjectIntegrationBindingsRequest{}
 	mi := &file_proto_watchfire_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Notification) String() string {
+func (x *SetProjectIntegrationBindingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*SetProjectIntegrationBindingsRequest) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
+func (x *SetProjectIntegrationBindingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5427,81 +5667,62 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProjectIntegrationBindingsRequest.ProtoReflect.Descriptor instead.
+func (*SetProjectIntegrationBindingsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Notification) GetId() string {
+func (x *SetProjectIntegrationBindingsRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *Notification) GetProjectId() string {
+func (x *SetProjectIntegrationBindingsRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *Notification) GetTaskNumber() int32 {
-	if x != nil {
-		return x.TaskNumber
-	}
-	return 0
-}
-
-func (x *Notification) GetTitle() string {
+func (x *SetProjectIntegrationBindingsRequest) GetSlackChannel() string {
 	if x != nil {
-		return x.Title
+		return x.SlackChannel
 	}
 	return ""
 }
 
-func (x *Notification) GetBody() string {
+func (x *SetProjectIntegrationBindingsRequest) GetDiscordGuildId() string {
 	if x != nil {
-		return x.Body
+		return x.DiscordGuildId
 	}
 	return ""
 }
 
-func (x *Notification) GetEmittedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EmittedAt
-	}
-	return nil
-}
-
-func (x *Notification) GetKind() NotificationKind {
-	if x != nil {
-		return x.Kind
-	}
-	return NotificationKind_TASK_FAILED
-}
-
-type SubscribeNotificationsRequest struct {
+type RunGCRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Empty = every project plus fleet-wide caches
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeNotificationsRequest) Reset() {
-	*x = SubscribeNotificationsRequest{}
+func (x *RunGCRequest) Reset() {
+	*x = RunGCRequest{}
 	mi := &file_proto_watchfire_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeNotificationsRequest) String() string {
+func (x *RunGCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeNotificationsRequest) ProtoMessage() {}
+func (*RunGCRequest) ProtoMessage() {}
 
-func (x *SubscribeNotificationsRequest) ProtoReflect() protoreflect.Message {
+func (x *RunGCRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5513,51 +5734,57 @@ func (x *SubscribeNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunGCRequest.ProtoReflect.Descriptor instead.
+func (*RunGCRequest) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *SubscribeNotificationsRequest) GetMeta() *RequestMeta {
+func (x *RunGCRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-// ExportReportRequest names a scope (single task / project / fleet-wide
-// global) and a format. window_start / window_end bound the time-range the
-// report covers (applies to per-day breakdowns; ignored for single_task).
-type ExportReportRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// Types that are valid to be assigned to Scope:
-	//
-	//	*ExportReportRequest_ProjectId
-	//	*ExportReportRequest_Global
-	//	*ExportReportRequest_SingleTask
-	Scope         isExportReportRequest_Scope `protobuf_oneof:"scope"`
-	Format        ExportFormat                `protobuf:"varint,5,opt,name=format,proto3,enum=watchfire.ExportFormat" json:"format,omitempty"`
-	WindowStart   *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd     *timestamppb.Timestamp      `protobuf:"bytes,7,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+func (x *RunGCRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunGCRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GCItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                            // "log" | "recording" | "insights_cache" | "diff_cache" | "dead_delivery" | "branch"
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Empty for fleet-wide caches
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                            // File or directory; branch name for "branch"
+	Bytes         int64                  `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`                         // 0 for branches
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportReportRequest) Reset() {
-	*x = ExportReportRequest{}
+func (x *GCItem) Reset() {
+	*x = GCItem{}
 	mi := &file_proto_watchfire_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportReportRequest) String() string {
+func (x *GCItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportReportRequest) ProtoMessage() {}
+func (*GCItem) ProtoMessage() {}
 
-func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
+func (x *GCItem) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5569,121 +5796,70 @@ func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportReportRequest.ProtoReflect.Descriptor instead.
-func (*ExportReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCItem.ProtoReflect.Descriptor instead.
+func (*GCItem) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ExportReportRequest) GetMeta() *RequestMeta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *ExportReportRequest) GetScope() isExportReportRequest_Scope {
-	if x != nil {
-		return x.Scope
-	}
-	return nil
-}
-
-func (x *ExportReportRequest) GetProjectId() string {
+func (x *GCItem) GetKind() string {
 	if x != nil {
-		if x, ok := x.Scope.(*ExportReportRequest_ProjectId); ok {
-			return x.ProjectId
-		}
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *ExportReportRequest) GetGlobal() bool {
-	if x != nil {
-		if x, ok := x.Scope.(*ExportReportRequest_Global); ok {
-			return x.Global
-		}
-	}
-	return false
-}
-
-func (x *ExportReportRequest) GetSingleTask() string {
+func (x *GCItem) GetProjectId() string {
 	if x != nil {
-		if x, ok := x.Scope.(*ExportReportRequest_SingleTask); ok {
-			return x.SingleTask
-		}
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *ExportReportRequest) GetFormat() ExportFormat {
+func (x *GCItem) GetPath() string {
 	if x != nil {
-		return x.Format
+		return x.Path
 	}
-	return ExportFormat_CSV
+	return ""
 }
 
-func (x *ExportReportRequest) GetWindowStart() *timestamppb.Timestamp {
+func (x *GCItem) GetBytes() int64 {
 	if x != nil {
-		return x.WindowStart
+		return x.Bytes
 	}
-	return nil
+	return 0
 }
 
-func (x *ExportReportRequest) GetWindowEnd() *timestamppb.Timestamp {
+func (x *GCItem) GetReason() string {
 	if x != nil {
-		return x.WindowEnd
+		return x.Reason
 	}
-	return nil
-}
-
-type isExportReportRequest_Scope interface {
-	isExportReportRequest_Scope()
-}
-
-type ExportReportRequest_ProjectId struct {
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof"` // Per-project report
-}
-
-type ExportReportRequest_Global struct {
-	Global bool `protobuf:"varint,3,opt,name=global,proto3,oneof"` // Fleet rollup
-}
-
-type ExportReportRequest_SingleTask struct {
-	SingleTask string `protobuf:"bytes,4,opt,name=single_task,json=singleTask,proto3,oneof"` // "<project_id>:<task_number>" — one task
+	return ""
 }
 
-func (*ExportReportRequest_ProjectId) isExportReportRequest_Scope() {}
-
-func (*ExportReportRequest_Global) isExportReportRequest_Scope() {}
-
-func (*ExportReportRequest_SingleTask) isExportReportRequest_Scope() {}
-
-// ExportReportResponse carries the rendered file. content is the raw bytes
-// (UTF-8 text for CSV / Markdown); the GUI saves it via Blob URL using the
-// supplied filename. mime is `text/csv` or `text/markdown`.
-type ExportReportResponse struct {
+type GCReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Mime          string                 `protobuf:"bytes,3,opt,name=mime,proto3" json:"mime,omitempty"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Items         []*GCItem              `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // Removed (or, on a dry run, removable) items
+	TotalBytes    int64                  `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"` // Removals that failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportReportResponse) Reset() {
-	*x = ExportReportResponse{}
+func (x *GCReport) Reset() {
+	*x = GCReport{}
 	mi := &file_proto_watchfire_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportReportResponse) String() string {
+func (x *GCReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportReportResponse) ProtoMessage() {}
+func (*GCReport) ProtoMessage() {}
 
-func (x *ExportReportResponse) ProtoReflect() protoreflect.Message {
+func (x *GCReport) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5695,58 +5871,60 @@ func (x *ExportReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportReportResponse.ProtoReflect.Descriptor instead.
-func (*ExportReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCReport.ProtoReflect.Descriptor instead.
+func (*GCReport) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ExportReportResponse) GetFilename() string {
+func (x *GCReport) GetDryRun() bool {
 	if x != nil {
-		return x.Filename
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *ExportReportResponse) GetContent() []byte {
+func (x *GCReport) GetItems() []*GCItem {
 	if x != nil {
-		return x.Content
+		return x.Items
 	}
 	return nil
 }
 
-func (x *ExportReportResponse) GetMime() string {
+func (x *GCReport) GetTotalBytes() int64 {
 	if x != nil {
-		return x.Mime
+		return x.TotalBytes
 	}
-	return ""
+	return 0
 }
 
-// GetGlobalInsightsRequest bounds a fleet-wide rollup query. Both bounds
-// are optional — an unset window_start collapses to "no lower bound" and
-// likewise for window_end. The dashboard typically passes a 30-day window.
-type GetGlobalInsightsRequest struct {
+func (x *GCReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SubscribeFocusEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGlobalInsightsRequest) Reset() {
-	*x = GetGlobalInsightsRequest{}
+func (x *SubscribeFocusEventsRequest) Reset() {
+	*x = SubscribeFocusEventsRequest{}
 	mi := &file_proto_watchfire_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGlobalInsightsRequest) String() string {
+func (x *SubscribeFocusEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGlobalInsightsRequest) ProtoMessage() {}
+func (*SubscribeFocusEventsRequest) ProtoMessage() {}
 
-func (x *GetGlobalInsightsRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeFocusEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5758,63 +5936,42 @@ func (x *GetGlobalInsightsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGlobalInsightsRequest.ProtoReflect.Descriptor instead.
-func (*GetGlobalInsightsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeFocusEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFocusEventsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetGlobalInsightsRequest) GetMeta() *RequestMeta {
+func (x *SubscribeFocusEventsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetGlobalInsightsRequest) GetWindowStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WindowStart
-	}
-	return nil
+type FocusEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Target        FocusTarget            `protobuf:"varint,2,opt,name=target,proto3,enum=watchfire.FocusTarget" json:"target,omitempty"`
+	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"` // Only meaningful when target == FOCUS_TARGET_TASK
+	DigestDate    string                 `protobuf:"bytes,4,opt,name=digest_date,json=digestDate,proto3" json:"digest_date,omitempty"`  // YYYY-MM-DD identifying the digest file (FOCUS_TARGET_DIGEST)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGlobalInsightsRequest) GetWindowEnd() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WindowEnd
-	}
-	return nil
-}
-
-// DayBucket — one calendar day's task counts. Used by both per-project and
-// fleet rollups to render stacked bars / sparklines.
-type DayBucket struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Date      string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // YYYY-MM-DD (local zone)
-	Count     int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // succeeded + failed
-	Succeeded int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
-	Failed    int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
-	// v8.0 Inferno — code churn for the day, for a churn-by-day chart drawn
-	// next to the tasks-by-day bars. Zero on days whose tasks lack code
-	// metrics (pre-v8.0 captures).
-	LinesAdded    int32 `protobuf:"varint,5,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
-	LinesRemoved  int32 `protobuf:"varint,6,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DayBucket) Reset() {
-	*x = DayBucket{}
+func (x *FocusEvent) Reset() {
+	*x = FocusEvent{}
 	mi := &file_proto_watchfire_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DayBucket) String() string {
+func (x *FocusEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DayBucket) ProtoMessage() {}
+func (*FocusEvent) ProtoMessage() {}
 
-func (x *DayBucket) ProtoReflect() protoreflect.Message {
+func (x *FocusEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_watchfire_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5826,89 +5983,124 @@ func (x *DayBucket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DayBucket.ProtoReflect.Descriptor instead.
-func (*DayBucket) Descriptor() ([]byte, []int) {
+// Deprecated: Use FocusEvent.ProtoReflect.Descriptor instead.
+func (*FocusEvent) Descriptor() ([]byte, []int) {
 	return file_proto_watchfire_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DayBucket) GetDate() string {
+func (x *FocusEvent) GetProjectId() string {
 	if x != nil {
-		return x.Date
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *DayBucket) GetCount() int32 {
+func (x *FocusEvent) GetTarget() FocusTarget {
 	if x != nil {
-		return x.Count
+		return x.Target
 	}
-	return 0
+	return FocusTarget_FOCUS_TARGET_MAIN
 }
 
-func (x *DayBucket) GetSucceeded() int32 {
+func (x *FocusEvent) GetTaskNumber() int32 {
 	if x != nil {
-		return x.Succeeded
+		return x.TaskNumber
 	}
 	return 0
 }
 
-func (x *DayBucket) GetFailed() int32 {
+func (x *FocusEvent) GetDigestDate() string {
 	if x != nil {
-		return x.Failed
+		return x.DigestDate
 	}
-	return 0
+	return ""
 }
 
-func (x *DayBucket) GetLinesAdded() int32 {
+type ListLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogsRequest) Reset() {
+	*x = ListLogsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogsRequest) ProtoMessage() {}
+
+func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[75]
 	if x != nil {
-		return x.LinesAdded
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DayBucket) GetLinesRemoved() int32 {
+// Deprecated: Use ListLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListLogsRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.LinesRemoved
+		return x.Meta
 	}
-	return 0
+	return nil
 }
 
-// AgentBreakdown — one row per backend agent that touched tasks in the
-// window. Field set is shared between the per-project and global rollups
-// so the same renderer code works for both surfaces.
-type AgentBreakdown struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Agent          string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
-	Count          int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	SuccessRate    float64                `protobuf:"fixed64,3,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
-	AvgDurationMs  int64                  `protobuf:"varint,4,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms,omitempty"`
-	TotalTokensIn  int64                  `protobuf:"varint,5,opt,name=total_tokens_in,json=totalTokensIn,proto3" json:"total_tokens_in,omitempty"`
-	TotalTokensOut int64                  `protobuf:"varint,6,opt,name=total_tokens_out,json=totalTokensOut,proto3" json:"total_tokens_out,omitempty"`
-	TotalCostUsd   float64                `protobuf:"fixed64,7,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
-	// v8.0 Inferno — output-per-agent, so agents are comparable on shipped
-	// code, not just task count. Zero for tasks lacking code metrics.
-	Commits       int32 `protobuf:"varint,8,opt,name=commits,proto3" json:"commits,omitempty"`
-	LinesAdded    int32 `protobuf:"varint,9,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
-	LinesRemoved  int32 `protobuf:"varint,10,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+func (x *ListLogsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogId         string                 `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`
+	SessionNumber int32                  `protobuf:"varint,4,opt,name=session_number,json=sessionNumber,proto3" json:"session_number,omitempty"`
+	Agent         string                 `protobuf:"bytes,5,opt,name=agent,proto3" json:"agent,omitempty"`
+	Mode          string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       string                 `protobuf:"bytes,8,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	HasTranscript bool                   `protobuf:"varint,10,opt,name=has_transcript,json=hasTranscript,proto3" json:"has_transcript,omitempty"`
+	HasRecording  bool                   `protobuf:"varint,11,opt,name=has_recording,json=hasRecording,proto3" json:"has_recording,omitempty"` // An asciicast recording is available via GetRecording
+	HasEvents     bool                   `protobuf:"varint,12,opt,name=has_events,json=hasEvents,proto3" json:"has_events,omitempty"`          // A normalized event log is available via GetSessionEvents
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AgentBreakdown) Reset() {
-	*x = AgentBreakdown{}
-	mi := &file_proto_watchfire_proto_msgTypes[75]
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_proto_watchfire_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentBreakdown) String() string {
+func (x *LogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentBreakdown) ProtoMessage() {}
+func (*LogEntry) ProtoMessage() {}
 
-func (x *AgentBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[75]
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5919,121 +6111,117 @@ func (x *AgentBreakdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentBreakdown.ProtoReflect.Descriptor instead.
-func (*AgentBreakdown) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AgentBreakdown) GetAgent() string {
+func (x *LogEntry) GetLogId() string {
 	if x != nil {
-		return x.Agent
+		return x.LogId
 	}
 	return ""
 }
 
-func (x *AgentBreakdown) GetCount() int32 {
+func (x *LogEntry) GetProjectId() string {
 	if x != nil {
-		return x.Count
+		return x.ProjectId
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentBreakdown) GetSuccessRate() float64 {
+func (x *LogEntry) GetTaskNumber() int32 {
 	if x != nil {
-		return x.SuccessRate
+		return x.TaskNumber
 	}
 	return 0
 }
 
-func (x *AgentBreakdown) GetAvgDurationMs() int64 {
+func (x *LogEntry) GetSessionNumber() int32 {
 	if x != nil {
-		return x.AvgDurationMs
+		return x.SessionNumber
 	}
 	return 0
 }
 
-func (x *AgentBreakdown) GetTotalTokensIn() int64 {
+func (x *LogEntry) GetAgent() string {
 	if x != nil {
-		return x.TotalTokensIn
+		return x.Agent
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentBreakdown) GetTotalTokensOut() int64 {
+func (x *LogEntry) GetMode() string {
 	if x != nil {
-		return x.TotalTokensOut
+		return x.Mode
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentBreakdown) GetTotalCostUsd() float64 {
+func (x *LogEntry) GetStartedAt() string {
 	if x != nil {
-		return x.TotalCostUsd
+		return x.StartedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentBreakdown) GetCommits() int32 {
+func (x *LogEntry) GetEndedAt() string {
 	if x != nil {
-		return x.Commits
+		return x.EndedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentBreakdown) GetLinesAdded() int32 {
+func (x *LogEntry) GetStatus() string {
 	if x != nil {
-		return x.LinesAdded
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *AgentBreakdown) GetLinesRemoved() int32 {
+func (x *LogEntry) GetHasTranscript() bool {
 	if x != nil {
-		return x.LinesRemoved
+		return x.HasTranscript
 	}
-	return 0
+	return false
 }
 
-// TopProject — one row of the fleet rollup's top-projects pill list,
-// sorted by completed-task count descending. The dashboard renders these
-// as clickable pills that route to each project's InsightsTab.
-type TopProject struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId    string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ProjectName  string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	ProjectColor string                 `protobuf:"bytes,3,opt,name=project_color,json=projectColor,proto3" json:"project_color,omitempty"`
-	Count        int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	SuccessRate  float64                `protobuf:"fixed64,5,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
-	// v8.0 Inferno — per-project shipped-code totals over the window, so the
-	// fleet rollup can rank projects by net lines / churn rather than only by
-	// completed-task count. Zero for projects whose tasks lack code metrics.
-	Commits      int32 `protobuf:"varint,6,opt,name=commits,proto3" json:"commits,omitempty"`
-	LinesAdded   int32 `protobuf:"varint,7,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
-	LinesRemoved int32 `protobuf:"varint,8,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
-	NetLines     int32 `protobuf:"varint,9,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
-	// Completed tasks merged in the window (silent merge or auto-PR). Lets the
-	// mission-control home window show a per-card "shipped" line including merge
-	// count alongside churn. Zero for projects whose tasks lack code metrics.
-	Merges        int32 `protobuf:"varint,10,opt,name=merges,proto3" json:"merges,omitempty"`
+func (x *LogEntry) GetHasRecording() bool {
+	if x != nil {
+		return x.HasRecording
+	}
+	return false
+}
+
+func (x *LogEntry) GetHasEvents() bool {
+	if x != nil {
+		return x.HasEvents
+	}
+	return false
+}
+
+type LogList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopProject) Reset() {
-	*x = TopProject{}
-	mi := &file_proto_watchfire_proto_msgTypes[76]
+func (x *LogList) Reset() {
+	*x = LogList{}
+	mi := &file_proto_watchfire_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopProject) String() string {
+func (x *LogList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopProject) ProtoMessage() {}
+func (*LogList) ProtoMessage() {}
 
-func (x *TopProject) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[76]
+func (x *LogList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6044,129 +6232,101 @@ func (x *TopProject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopProject.ProtoReflect.Descriptor instead.
-func (*TopProject) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *TopProject) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use LogList.ProtoReflect.Descriptor instead.
+func (*LogList) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *TopProject) GetProjectName() string {
+func (x *LogList) GetLogs() []*LogEntry {
 	if x != nil {
-		return x.ProjectName
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *TopProject) GetProjectColor() string {
-	if x != nil {
-		return x.ProjectColor
-	}
-	return ""
+type GetLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopProject) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+func (x *GetLogRequest) Reset() {
+	*x = GetLogRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopProject) GetSuccessRate() float64 {
-	if x != nil {
-		return x.SuccessRate
-	}
-	return 0
+func (x *GetLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TopProject) GetCommits() int32 {
+func (*GetLogRequest) ProtoMessage() {}
+
+func (x *GetLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[78]
 	if x != nil {
-		return x.Commits
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TopProject) GetLinesAdded() int32 {
-	if x != nil {
-		return x.LinesAdded
-	}
-	return 0
+// Deprecated: Use GetLogRequest.ProtoReflect.Descriptor instead.
+func (*GetLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *TopProject) GetLinesRemoved() int32 {
+func (x *GetLogRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.LinesRemoved
+		return x.Meta
 	}
-	return 0
+	return nil
 }
 
-func (x *TopProject) GetNetLines() int32 {
+func (x *GetLogRequest) GetProjectId() string {
 	if x != nil {
-		return x.NetLines
+		return x.ProjectId
 	}
-	return 0
+	return ""
 }
 
-func (x *TopProject) GetMerges() int32 {
+func (x *GetLogRequest) GetLogId() string {
 	if x != nil {
-		return x.Merges
+		return x.LogId
 	}
-	return 0
+	return ""
 }
 
-// GlobalInsights is the cross-project rollup the daemon returns from
-// `GetGlobalInsights`. Sums every per-project metric over the window;
-// `tasks_missing_cost` surfaces a partial-data caveat when not every
-// completed task carried a cost number.
-type GlobalInsights struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TasksTotal       int32                  `protobuf:"varint,1,opt,name=tasks_total,json=tasksTotal,proto3" json:"tasks_total,omitempty"`
-	TasksSucceeded   int32                  `protobuf:"varint,2,opt,name=tasks_succeeded,json=tasksSucceeded,proto3" json:"tasks_succeeded,omitempty"`
-	TasksFailed      int32                  `protobuf:"varint,3,opt,name=tasks_failed,json=tasksFailed,proto3" json:"tasks_failed,omitempty"`
-	TasksByDay       []*DayBucket           `protobuf:"bytes,4,rep,name=tasks_by_day,json=tasksByDay,proto3" json:"tasks_by_day,omitempty"`
-	TopProjects      []*TopProject          `protobuf:"bytes,5,rep,name=top_projects,json=topProjects,proto3" json:"top_projects,omitempty"`
-	AgentBreakdown   []*AgentBreakdown      `protobuf:"bytes,6,rep,name=agent_breakdown,json=agentBreakdown,proto3" json:"agent_breakdown,omitempty"`
-	TotalDurationMs  int64                  `protobuf:"varint,7,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
-	TotalCostUsd     float64                `protobuf:"fixed64,8,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
-	TasksMissingCost int32                  `protobuf:"varint,9,opt,name=tasks_missing_cost,json=tasksMissingCost,proto3" json:"tasks_missing_cost,omitempty"`
-	WindowStart      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
-	// v8.0 Inferno — fleet-wide shipped-code rollup. `metrics_missing_code`
-	// counts completed tasks with no code metrics so the GUI can honestly say
-	// "based on N of M tasks". Older cached payloads without these fields read
-	// them as zero.
-	TotalCommits       int32 `protobuf:"varint,12,opt,name=total_commits,json=totalCommits,proto3" json:"total_commits,omitempty"`
-	TotalFilesChanged  int32 `protobuf:"varint,13,opt,name=total_files_changed,json=totalFilesChanged,proto3" json:"total_files_changed,omitempty"`
-	TotalLinesAdded    int32 `protobuf:"varint,14,opt,name=total_lines_added,json=totalLinesAdded,proto3" json:"total_lines_added,omitempty"`
-	TotalLinesRemoved  int32 `protobuf:"varint,15,opt,name=total_lines_removed,json=totalLinesRemoved,proto3" json:"total_lines_removed,omitempty"`
-	NetLines           int32 `protobuf:"varint,16,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
-	TasksMerged        int32 `protobuf:"varint,17,opt,name=tasks_merged,json=tasksMerged,proto3" json:"tasks_merged,omitempty"`
-	TasksViaPr         int32 `protobuf:"varint,18,opt,name=tasks_via_pr,json=tasksViaPr,proto3" json:"tasks_via_pr,omitempty"`
-	MetricsMissingCode int32 `protobuf:"varint,19,opt,name=metrics_missing_code,json=metricsMissingCode,proto3" json:"metrics_missing_code,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type LogContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *LogEntry              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalInsights) Reset() {
-	*x = GlobalInsights{}
-	mi := &file_proto_watchfire_proto_msgTypes[77]
+func (x *LogContent) Reset() {
+	*x = LogContent{}
+	mi := &file_proto_watchfire_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalInsights) String() string {
+func (x *LogContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalInsights) ProtoMessage() {}
+func (*LogContent) ProtoMessage() {}
 
-func (x *GlobalInsights) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[77]
+func (x *LogContent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6177,171 +6337,220 @@ func (x *GlobalInsights) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalInsights.ProtoReflect.Descriptor instead.
-func (*GlobalInsights) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use LogContent.ProtoReflect.Descriptor instead.
+func (*LogContent) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GlobalInsights) GetTasksTotal() int32 {
+func (x *LogContent) GetEntry() *LogEntry {
 	if x != nil {
-		return x.TasksTotal
+		return x.Entry
 	}
-	return 0
+	return nil
 }
 
-func (x *GlobalInsights) GetTasksSucceeded() int32 {
+func (x *LogContent) GetContent() string {
 	if x != nil {
-		return x.TasksSucceeded
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *GlobalInsights) GetTasksFailed() int32 {
-	if x != nil {
-		return x.TasksFailed
-	}
-	return 0
+type DeleteLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalInsights) GetTasksByDay() []*DayBucket {
-	if x != nil {
-		return x.TasksByDay
-	}
-	return nil
+func (x *DeleteLogRequest) Reset() {
+	*x = DeleteLogRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalInsights) GetTopProjects() []*TopProject {
+func (x *DeleteLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogRequest) ProtoMessage() {}
+
+func (x *DeleteLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[80]
 	if x != nil {
-		return x.TopProjects
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GlobalInsights) GetAgentBreakdown() []*AgentBreakdown {
+// Deprecated: Use DeleteLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteLogRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.AgentBreakdown
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *GlobalInsights) GetTotalDurationMs() int64 {
+func (x *DeleteLogRequest) GetProjectId() string {
 	if x != nil {
-		return x.TotalDurationMs
+		return x.ProjectId
 	}
-	return 0
+	return ""
 }
 
-func (x *GlobalInsights) GetTotalCostUsd() float64 {
+func (x *DeleteLogRequest) GetLogId() string {
 	if x != nil {
-		return x.TotalCostUsd
+		return x.LogId
 	}
-	return 0
+	return ""
 }
 
-func (x *GlobalInsights) GetTasksMissingCost() int32 {
-	if x != nil {
-		return x.TasksMissingCost
-	}
-	return 0
+type GetRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalInsights) GetWindowStart() *timestamppb.Timestamp {
+func (x *GetRecordingRequest) Reset() {
+	*x = GetRecordingRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordingRequest) ProtoMessage() {}
+
+func (x *GetRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[81]
 	if x != nil {
-		return x.WindowStart
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GlobalInsights) GetWindowEnd() *timestamppb.Timestamp {
+// Deprecated: Use GetRecordingRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetRecordingRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.WindowEnd
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *GlobalInsights) GetTotalCommits() int32 {
+func (x *GetRecordingRequest) GetProjectId() string {
 	if x != nil {
-		return x.TotalCommits
+		return x.ProjectId
 	}
-	return 0
+	return ""
 }
 
-func (x *GlobalInsights) GetTotalFilesChanged() int32 {
+func (x *GetRecordingRequest) GetLogId() string {
 	if x != nil {
-		return x.TotalFilesChanged
+		return x.LogId
 	}
-	return 0
+	return ""
 }
 
-func (x *GlobalInsights) GetTotalLinesAdded() int32 {
-	if x != nil {
-		return x.TotalLinesAdded
-	}
-	return 0
+type RecordingChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Consecutive slices of the .cast file
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalInsights) GetTotalLinesRemoved() int32 {
-	if x != nil {
-		return x.TotalLinesRemoved
-	}
-	return 0
+func (x *RecordingChunk) Reset() {
+	*x = RecordingChunk{}
+	mi := &file_proto_watchfire_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalInsights) GetNetLines() int32 {
-	if x != nil {
-		return x.NetLines
-	}
-	return 0
+func (x *RecordingChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GlobalInsights) GetTasksMerged() int32 {
+func (*RecordingChunk) ProtoMessage() {}
+
+func (x *RecordingChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[82]
 	if x != nil {
-		return x.TasksMerged
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GlobalInsights) GetTasksViaPr() int32 {
-	if x != nil {
-		return x.TasksViaPr
-	}
-	return 0
+// Deprecated: Use RecordingChunk.ProtoReflect.Descriptor instead.
+func (*RecordingChunk) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GlobalInsights) GetMetricsMissingCode() int32 {
+func (x *RecordingChunk) GetData() []byte {
 	if x != nil {
-		return x.MetricsMissingCode
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// GetProjectInsightsRequest scopes a per-project insights query. Both
-// window bounds are optional — leaving them unset queries "all time".
-type GetProjectInsightsRequest struct {
+type SearchLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                              // Words are ANDed; "quoted phrases" must match verbatim
+	ProjectIds    []string               `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`  // Empty = all registered projects
+	Agent         string                 `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`                              // Backend name filter, e.g. "claude-code"
+	TaskNumber    int32                  `protobuf:"varint,5,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"` // 0 = any
+	Mode          string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`                                // "task" | "chat" | "wildfire" | ...
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                            // "completed" | "interrupted"
+	Since         string                 `protobuf:"bytes,8,opt,name=since,proto3" json:"since,omitempty"`                              // RFC3339 or YYYY-MM-DD, inclusive
+	Until         string                 `protobuf:"bytes,9,opt,name=until,proto3" json:"until,omitempty"`                              // RFC3339 (exclusive) or YYYY-MM-DD (through that day)
+	Limit         int32                  `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`                            // 0 = server default (20)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectInsightsRequest) Reset() {
-	*x = GetProjectInsightsRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[78]
+func (x *SearchLogsRequest) Reset() {
+	*x = SearchLogsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectInsightsRequest) String() string {
+func (x *SearchLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectInsightsRequest) ProtoMessage() {}
+func (*SearchLogsRequest) ProtoMessage() {}
 
-func (x *GetProjectInsightsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[78]
+func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6352,286 +6561,218 @@ func (x *GetProjectInsightsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectInsightsRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectInsightsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use SearchLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetProjectInsightsRequest) GetMeta() *RequestMeta {
+func (x *SearchLogsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetProjectInsightsRequest) GetProjectId() string {
+func (x *SearchLogsRequest) GetQuery() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *GetProjectInsightsRequest) GetWindowStart() *timestamppb.Timestamp {
+func (x *SearchLogsRequest) GetProjectIds() []string {
 	if x != nil {
-		return x.WindowStart
+		return x.ProjectIds
 	}
 	return nil
 }
 
-func (x *GetProjectInsightsRequest) GetWindowEnd() *timestamppb.Timestamp {
+func (x *SearchLogsRequest) GetAgent() string {
 	if x != nil {
-		return x.WindowEnd
+		return x.Agent
 	}
-	return nil
-}
-
-// ProjectInsights is the per-project rollup the daemon returns from
-// `GetProjectInsights`. Same shape primitives as GlobalInsights — the
-// dashboard's per-project Insights tab and the TUI per-project overlay
-// reuse the same renderers.
-type ProjectInsights struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId        string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TasksTotal       int32                  `protobuf:"varint,2,opt,name=tasks_total,json=tasksTotal,proto3" json:"tasks_total,omitempty"`
-	TasksSucceeded   int32                  `protobuf:"varint,3,opt,name=tasks_succeeded,json=tasksSucceeded,proto3" json:"tasks_succeeded,omitempty"`
-	TasksFailed      int32                  `protobuf:"varint,4,opt,name=tasks_failed,json=tasksFailed,proto3" json:"tasks_failed,omitempty"`
-	TasksByDay       []*DayBucket           `protobuf:"bytes,5,rep,name=tasks_by_day,json=tasksByDay,proto3" json:"tasks_by_day,omitempty"`
-	AgentBreakdown   []*AgentBreakdown      `protobuf:"bytes,6,rep,name=agent_breakdown,json=agentBreakdown,proto3" json:"agent_breakdown,omitempty"`
-	TotalDurationMs  int64                  `protobuf:"varint,7,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
-	AvgDurationMs    int64                  `protobuf:"varint,8,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms,omitempty"`
-	P50DurationMs    int64                  `protobuf:"varint,9,opt,name=p50_duration_ms,json=p50DurationMs,proto3" json:"p50_duration_ms,omitempty"`
-	P95DurationMs    int64                  `protobuf:"varint,10,opt,name=p95_duration_ms,json=p95DurationMs,proto3" json:"p95_duration_ms,omitempty"`
-	TotalCostUsd     float64                `protobuf:"fixed64,11,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
-	TasksMissingCost int32                  `protobuf:"varint,12,opt,name=tasks_missing_cost,json=tasksMissingCost,proto3" json:"tasks_missing_cost,omitempty"`
-	WindowStart      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
-	// v8.0 Inferno — per-project shipped-code rollup. Mirrors GlobalInsights;
-	// `metrics_missing_code` drives the "based on N of M tasks" coverage note
-	// in the Insights tab. Older cached payloads read these as zero.
-	TotalCommits       int32 `protobuf:"varint,15,opt,name=total_commits,json=totalCommits,proto3" json:"total_commits,omitempty"`
-	TotalFilesChanged  int32 `protobuf:"varint,16,opt,name=total_files_changed,json=totalFilesChanged,proto3" json:"total_files_changed,omitempty"`
-	TotalLinesAdded    int32 `protobuf:"varint,17,opt,name=total_lines_added,json=totalLinesAdded,proto3" json:"total_lines_added,omitempty"`
-	TotalLinesRemoved  int32 `protobuf:"varint,18,opt,name=total_lines_removed,json=totalLinesRemoved,proto3" json:"total_lines_removed,omitempty"`
-	NetLines           int32 `protobuf:"varint,19,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
-	TasksMerged        int32 `protobuf:"varint,20,opt,name=tasks_merged,json=tasksMerged,proto3" json:"tasks_merged,omitempty"`
-	TasksViaPr         int32 `protobuf:"varint,21,opt,name=tasks_via_pr,json=tasksViaPr,proto3" json:"tasks_via_pr,omitempty"`
-	MetricsMissingCode int32 `protobuf:"varint,22,opt,name=metrics_missing_code,json=metricsMissingCode,proto3" json:"metrics_missing_code,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *ProjectInsights) Reset() {
-	*x = ProjectInsights{}
-	mi := &file_proto_watchfire_proto_msgTypes[79]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ProjectInsights) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ProjectInsights) ProtoMessage() {}
-
-func (x *ProjectInsights) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[79]
+func (x *SearchLogsRequest) GetTaskNumber() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TaskNumber
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ProjectInsights.ProtoReflect.Descriptor instead.
-func (*ProjectInsights) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{79}
+func (x *SearchLogsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
 }
 
-func (x *ProjectInsights) GetProjectId() string {
+func (x *SearchLogsRequest) GetStatus() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ProjectInsights) GetTasksTotal() int32 {
+func (x *SearchLogsRequest) GetSince() string {
 	if x != nil {
-		return x.TasksTotal
+		return x.Since
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectInsights) GetTasksSucceeded() int32 {
+func (x *SearchLogsRequest) GetUntil() string {
 	if x != nil {
-		return x.TasksSucceeded
+		return x.Until
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectInsights) GetTasksFailed() int32 {
+func (x *SearchLogsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.TasksFailed
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *ProjectInsights) GetTasksByDay() []*DayBucket {
-	if x != nil {
-		return x.TasksByDay
-	}
-	return nil
+type LogSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *LogEntry              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	ProjectName   string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`     // BM25 relevance; only comparable within one response
+	Snippets      []string               `protobuf:"bytes,4,rep,name=snippets,proto3" json:"snippets,omitempty"` // Matching lines, trimmed around the match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectInsights) GetAgentBreakdown() []*AgentBreakdown {
-	if x != nil {
-		return x.AgentBreakdown
-	}
-	return nil
+func (x *LogSearchHit) Reset() {
+	*x = LogSearchHit{}
+	mi := &file_proto_watchfire_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectInsights) GetTotalDurationMs() int64 {
-	if x != nil {
-		return x.TotalDurationMs
-	}
-	return 0
+func (x *LogSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProjectInsights) GetAvgDurationMs() int64 {
+func (*LogSearchHit) ProtoMessage() {}
+
+func (x *LogSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[84]
 	if x != nil {
-		return x.AvgDurationMs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProjectInsights) GetP50DurationMs() int64 {
-	if x != nil {
-		return x.P50DurationMs
-	}
-	return 0
+// Deprecated: Use LogSearchHit.ProtoReflect.Descriptor instead.
+func (*LogSearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ProjectInsights) GetP95DurationMs() int64 {
+func (x *LogSearchHit) GetEntry() *LogEntry {
 	if x != nil {
-		return x.P95DurationMs
+		return x.Entry
 	}
-	return 0
+	return nil
 }
 
-func (x *ProjectInsights) GetTotalCostUsd() float64 {
+func (x *LogSearchHit) GetProjectName() string {
 	if x != nil {
-		return x.TotalCostUsd
+		return x.ProjectName
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectInsights) GetTasksMissingCost() int32 {
+func (x *LogSearchHit) GetScore() float64 {
 	if x != nil {
-		return x.TasksMissingCost
+		return x.Score
 	}
 	return 0
 }
 
-func (x *ProjectInsights) GetWindowStart() *timestamppb.Timestamp {
+func (x *LogSearchHit) GetSnippets() []string {
 	if x != nil {
-		return x.WindowStart
+		return x.Snippets
 	}
 	return nil
 }
 
-func (x *ProjectInsights) GetWindowEnd() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WindowEnd
-	}
-	return nil
+type SearchLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*LogSearchHit        `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectInsights) GetTotalCommits() int32 {
-	if x != nil {
-		return x.TotalCommits
-	}
-	return 0
+func (x *SearchLogsResponse) Reset() {
+	*x = SearchLogsResponse{}
+	mi := &file_proto_watchfire_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectInsights) GetTotalFilesChanged() int32 {
-	if x != nil {
-		return x.TotalFilesChanged
-	}
-	return 0
+func (x *SearchLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProjectInsights) GetTotalLinesAdded() int32 {
+func (*SearchLogsResponse) ProtoMessage() {}
+
+func (x *SearchLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[85]
 	if x != nil {
-		return x.TotalLinesAdded
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProjectInsights) GetTotalLinesRemoved() int32 {
-	if x != nil {
-		return x.TotalLinesRemoved
-	}
-	return 0
+// Deprecated: Use SearchLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ProjectInsights) GetNetLines() int32 {
+func (x *SearchLogsResponse) GetHits() []*LogSearchHit {
 	if x != nil {
-		return x.NetLines
+		return x.Hits
 	}
-	return 0
+	return nil
 }
 
-func (x *ProjectInsights) GetTasksMerged() int32 {
-	if x != nil {
-		return x.TasksMerged
-	}
-	return 0
-}
-
-func (x *ProjectInsights) GetTasksViaPr() int32 {
-	if x != nil {
-		return x.TasksViaPr
-	}
-	return 0
-}
-
-func (x *ProjectInsights) GetMetricsMissingCode() int32 {
-	if x != nil {
-		return x.MetricsMissingCode
-	}
-	return 0
-}
-
-// GetTaskDiffRequest names a task whose diff the daemon should compute
-// against either the still-existing `watchfire/<n>` branch or, if the
-// branch was already merged + deleted, the canonical merge commit on the
-// project's default branch.
-type GetTaskDiffRequest struct {
+type GetSessionEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`
+	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Types         []string               `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"` // Only return these event types; empty = all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskDiffRequest) Reset() {
-	*x = GetTaskDiffRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[80]
+func (x *GetSessionEventsRequest) Reset() {
+	*x = GetSessionEventsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskDiffRequest) String() string {
+func (x *GetSessionEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskDiffRequest) ProtoMessage() {}
+func (*GetSessionEventsRequest) ProtoMessage() {}
 
-func (x *GetTaskDiffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[80]
+func (x *GetSessionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6642,61 +6783,78 @@ func (x *GetTaskDiffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskDiffRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskDiffRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use GetSessionEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetTaskDiffRequest) GetMeta() *RequestMeta {
+func (x *GetSessionEventsRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *GetTaskDiffRequest) GetProjectId() string {
+func (x *GetSessionEventsRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *GetTaskDiffRequest) GetTaskNumber() int32 {
+func (x *GetSessionEventsRequest) GetLogId() string {
 	if x != nil {
-		return x.TaskNumber
+		return x.LogId
 	}
-	return 0
+	return ""
 }
 
-// FileDiffSet is the structured top-level shape returned by
-// `InsightsService.GetTaskDiff`. The GUI / TUI render natively from this
-// — no unified-diff parsing required. `truncated` flips when the daemon
-// caps a >MaxDiffLines result.
-type FileDiffSet struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Files          []*FileDiff            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	TotalAdditions int32                  `protobuf:"varint,2,opt,name=total_additions,json=totalAdditions,proto3" json:"total_additions,omitempty"`
-	TotalDeletions int32                  `protobuf:"varint,3,opt,name=total_deletions,json=totalDeletions,proto3" json:"total_deletions,omitempty"`
-	Truncated      bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetSessionEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-func (x *FileDiffSet) Reset() {
-	*x = FileDiffSet{}
-	mi := &file_proto_watchfire_proto_msgTypes[81]
+// SessionEvent is one entry of a session's normalized event log. Which
+// fields are set depends on type.
+type SessionEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Seq             int32                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`                                  // 1-based position in the session
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                 // "user_message" | "assistant_message" | "tool_call" | "file_edit" | "shell_command" | "token_usage"
+	Time            string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`                                 // RFC3339; empty when the transcript has no timestamps
+	Text            string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                 // Message text
+	Tool            string                 `protobuf:"bytes,5,opt,name=tool,proto3" json:"tool,omitempty"`                                 // Backend tool name (tool_call, file_edit, shell_command)
+	CallId          string                 `protobuf:"bytes,6,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`               // Links derived events to their tool_call
+	Args            string                 `protobuf:"bytes,7,opt,name=args,proto3" json:"args,omitempty"`                                 // Compact JSON arguments (tool_call)
+	Result          string                 `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`                             // Truncated tool output (tool_call)
+	IsError         bool                   `protobuf:"varint,9,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"`           // Tool reported failure (tool_call)
+	Path            string                 `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`                                // Edited file (file_edit)
+	EditKind        string                 `protobuf:"bytes,11,opt,name=edit_kind,json=editKind,proto3" json:"edit_kind,omitempty"`        // "write" | "modify" | "delete" (file_edit)
+	Command         string                 `protobuf:"bytes,12,opt,name=command,proto3" json:"command,omitempty"`                          // Command line (shell_command)
+	ExitCode        *int32                 `protobuf:"varint,13,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"` // Unset when the transcript doesn't say (shell_command)
+	TokensIn        int64                  `protobuf:"varint,14,opt,name=tokens_in,json=tokensIn,proto3" json:"tokens_in,omitempty"`       // token_usage
+	TokensOut       int64                  `protobuf:"varint,15,opt,name=tokens_out,json=tokensOut,proto3" json:"tokens_out,omitempty"`
+	CacheReadTokens int64                  `protobuf:"varint,16,opt,name=cache_read_tokens,json=cacheReadTokens,proto3" json:"cache_read_tokens,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	mi := &file_proto_watchfire_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileDiffSet) String() string {
+func (x *SessionEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileDiffSet) ProtoMessage() {}
+func (*SessionEvent) ProtoMessage() {}
 
-func (x *FileDiffSet) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[81]
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6707,141 +6865,145 @@ func (x *FileDiffSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileDiffSet.ProtoReflect.Descriptor instead.
-func (*FileDiffSet) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *FileDiffSet) GetFiles() []*FileDiff {
+func (x *SessionEvent) GetSeq() int32 {
 	if x != nil {
-		return x.Files
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *FileDiffSet) GetTotalAdditions() int32 {
+func (x *SessionEvent) GetType() string {
 	if x != nil {
-		return x.TotalAdditions
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *FileDiffSet) GetTotalDeletions() int32 {
+func (x *SessionEvent) GetTime() string {
 	if x != nil {
-		return x.TotalDeletions
+		return x.Time
 	}
-	return 0
+	return ""
 }
 
-func (x *FileDiffSet) GetTruncated() bool {
+func (x *SessionEvent) GetText() string {
 	if x != nil {
-		return x.Truncated
+		return x.Text
 	}
-	return false
+	return ""
 }
 
-// FileDiff is one file-level entry inside a FileDiffSet. Binary files
-// surface as `status=MODIFIED, hunks=[]` with a synthetic single hunk
-// whose header reads "Binary file changed" — consumers can render a
-// clear marker without trying to display bytes.
-type FileDiff struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Status        FileDiff_Status        `protobuf:"varint,2,opt,name=status,proto3,enum=watchfire.FileDiff_Status" json:"status,omitempty"`
-	OldPath       string                 `protobuf:"bytes,3,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
-	Hunks         []*Hunk                `protobuf:"bytes,4,rep,name=hunks,proto3" json:"hunks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SessionEvent) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
 }
 
-func (x *FileDiff) Reset() {
-	*x = FileDiff{}
-	mi := &file_proto_watchfire_proto_msgTypes[82]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SessionEvent) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
 }
 
-func (x *FileDiff) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SessionEvent) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
 }
 
-func (*FileDiff) ProtoMessage() {}
-
-func (x *FileDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[82]
+func (x *SessionEvent) GetResult() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Result
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FileDiff.ProtoReflect.Descriptor instead.
-func (*FileDiff) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{82}
+func (x *SessionEvent) GetIsError() bool {
+	if x != nil {
+		return x.IsError
+	}
+	return false
 }
 
-func (x *FileDiff) GetPath() string {
+func (x *SessionEvent) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-func (x *FileDiff) GetStatus() FileDiff_Status {
+func (x *SessionEvent) GetEditKind() string {
 	if x != nil {
-		return x.Status
+		return x.EditKind
 	}
-	return FileDiff_MODIFIED
+	return ""
 }
 
-func (x *FileDiff) GetOldPath() string {
+func (x *SessionEvent) GetCommand() string {
 	if x != nil {
-		return x.OldPath
+		return x.Command
 	}
 	return ""
 }
 
-func (x *FileDiff) GetHunks() []*Hunk {
+func (x *SessionEvent) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetTokensIn() int64 {
 	if x != nil {
-		return x.Hunks
+		return x.TokensIn
 	}
-	return nil
+	return 0
 }
 
-// Hunk corresponds to one `@@ -<oldStart>,<oldLines> +<newStart>,<newLines> @@`
-// region of a unified diff. `header` carries the trailing context label
-// git emits after the second `@@` (the function name, when available).
-type Hunk struct {
+func (x *SessionEvent) GetTokensOut() int64 {
+	if x != nil {
+		return x.TokensOut
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetCacheReadTokens() int64 {
+	if x != nil {
+		return x.CacheReadTokens
+	}
+	return 0
+}
+
+type SessionEventList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OldStart      int32                  `protobuf:"varint,1,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"`
-	OldLines      int32                  `protobuf:"varint,2,opt,name=old_lines,json=oldLines,proto3" json:"old_lines,omitempty"`
-	NewStart      int32                  `protobuf:"varint,3,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
-	NewLines      int32                  `protobuf:"varint,4,opt,name=new_lines,json=newLines,proto3" json:"new_lines,omitempty"`
-	Header        string                 `protobuf:"bytes,5,opt,name=header,proto3" json:"header,omitempty"`
-	Lines         []*DiffLine            `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`
+	Events        []*SessionEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Hunk) Reset() {
-	*x = Hunk{}
-	mi := &file_proto_watchfire_proto_msgTypes[83]
+func (x *SessionEventList) Reset() {
+	*x = SessionEventList{}
+	mi := &file_proto_watchfire_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Hunk) String() string {
+func (x *SessionEventList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Hunk) ProtoMessage() {}
+func (*SessionEventList) ProtoMessage() {}
 
-func (x *Hunk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[83]
+func (x *SessionEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6852,78 +7014,54 @@ func (x *Hunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Hunk.ProtoReflect.Descriptor instead.
-func (*Hunk) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *Hunk) GetOldStart() int32 {
-	if x != nil {
-		return x.OldStart
-	}
-	return 0
-}
-
-func (x *Hunk) GetOldLines() int32 {
-	if x != nil {
-		return x.OldLines
-	}
-	return 0
-}
-
-func (x *Hunk) GetNewStart() int32 {
-	if x != nil {
-		return x.NewStart
-	}
-	return 0
-}
-
-func (x *Hunk) GetNewLines() int32 {
-	if x != nil {
-		return x.NewLines
-	}
-	return 0
-}
-
-func (x *Hunk) GetHeader() string {
-	if x != nil {
-		return x.Header
-	}
-	return ""
+// Deprecated: Use SessionEventList.ProtoReflect.Descriptor instead.
+func (*SessionEventList) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *Hunk) GetLines() []*DiffLine {
+func (x *SessionEventList) GetEvents() []*SessionEvent {
 	if x != nil {
-		return x.Lines
+		return x.Events
 	}
 	return nil
 }
 
-// DiffLine is one line inside a Hunk. `text` excludes the leading +/-/space
-// prefix; the kind tag carries that semantic.
-type DiffLine struct {
+// Notification is a single user-facing event the daemon emits when something
+// the user cares about happens (a task fails, an autonomous run finishes, …).
+// Mirrors the JSONL record written to ~/.watchfire/logs/<project_id>/notifications.log.
+type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Kind          DiffLine_Kind          `protobuf:"varint,1,opt,name=kind,proto3,enum=watchfire.DiffLine_Kind" json:"kind,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Stable hash; duplicates collapse
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"` // 0 when not task-scoped
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	EmittedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=emitted_at,json=emittedAt,proto3" json:"emitted_at,omitempty"`
+	Kind          NotificationKind       `protobuf:"varint,7,opt,name=kind,proto3,enum=watchfire.NotificationKind" json:"kind,omitempty"`
+	Detail        string                 `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`                                     // Merge error / agent message, when the kind has one
+	Url           string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`                                           // PR_OPENED
+	Phase         string                 `protobuf:"bytes,10,opt,name=phase,proto3" json:"phase,omitempty"`                                      // WILDFIRE_PHASE_CHANGED
+	PreviousPhase string                 `protobuf:"bytes,11,opt,name=previous_phase,json=previousPhase,proto3" json:"previous_phase,omitempty"` // WILDFIRE_PHASE_CHANGED
+	Count         int32                  `protobuf:"varint,12,opt,name=count,proto3" json:"count,omitempty"`                                     // TASKS_GENERATED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiffLine) Reset() {
-	*x = DiffLine{}
-	mi := &file_proto_watchfire_proto_msgTypes[84]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_watchfire_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffLine) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffLine) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *DiffLine) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[84]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6934,53 +7072,117 @@ func (x *DiffLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
-func (*DiffLine) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *DiffLine) GetKind() DiffLine_Kind {
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Notification) GetTaskNumber() int32 {
+	if x != nil {
+		return x.TaskNumber
+	}
+	return 0
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetEmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EmittedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetKind() NotificationKind {
 	if x != nil {
 		return x.Kind
 	}
-	return DiffLine_CONTEXT
+	return NotificationKind_TASK_FAILED
 }
 
-func (x *DiffLine) GetText() string {
+func (x *Notification) GetDetail() string {
 	if x != nil {
-		return x.Text
+		return x.Detail
 	}
 	return ""
 }
 
-// IntegrationEvents is the per-integration event-bitmask. Mirrors the
-// daemon-side `models.EventBitmask` shape. Each integration carries its
-// own copy so the user can fan TASK_FAILED to Slack but RUN_COMPLETE to
-// Discord without duplicating endpoints.
-type IntegrationEvents struct {
+func (x *Notification) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Notification) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Notification) GetPreviousPhase() string {
+	if x != nil {
+		return x.PreviousPhase
+	}
+	return ""
+}
+
+func (x *Notification) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SubscribeNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskFailed    bool                   `protobuf:"varint,1,opt,name=task_failed,json=taskFailed,proto3" json:"task_failed,omitempty"`
-	RunComplete   bool                   `protobuf:"varint,2,opt,name=run_complete,json=runComplete,proto3" json:"run_complete,omitempty"`
-	WeeklyDigest  bool                   `protobuf:"varint,3,opt,name=weekly_digest,json=weeklyDigest,proto3" json:"weekly_digest,omitempty"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntegrationEvents) Reset() {
-	*x = IntegrationEvents{}
-	mi := &file_proto_watchfire_proto_msgTypes[85]
+func (x *SubscribeNotificationsRequest) Reset() {
+	*x = SubscribeNotificationsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntegrationEvents) String() string {
+func (x *SubscribeNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegrationEvents) ProtoMessage() {}
+func (*SubscribeNotificationsRequest) ProtoMessage() {}
 
-func (x *IntegrationEvents) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[85]
+func (x *SubscribeNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6991,65 +7193,52 @@ func (x *IntegrationEvents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegrationEvents.ProtoReflect.Descriptor instead.
-func (*IntegrationEvents) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *IntegrationEvents) GetTaskFailed() bool {
-	if x != nil {
-		return x.TaskFailed
-	}
-	return false
-}
-
-func (x *IntegrationEvents) GetRunComplete() bool {
-	if x != nil {
-		return x.RunComplete
-	}
-	return false
+// Deprecated: Use SubscribeNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *IntegrationEvents) GetWeeklyDigest() bool {
+func (x *SubscribeNotificationsRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.WeeklyDigest
+		return x.Meta
 	}
-	return false
+	return nil
 }
 
-// WebhookIntegration is a single generic outbound webhook target. The
-// `secret` field is write-only — Save accepts it (and pushes it to the
-// keyring); List + the response of Save scrub it (returning only
-// `secret_set: bool`).
-type WebhookIntegration struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"`     // Masked, display-only ("***hooks.example.com/...")
-	SecretSet      bool                   `protobuf:"varint,5,opt,name=secret_set,json=secretSet,proto3" json:"secret_set,omitempty"` // True if the keyring carries a secret
-	Secret         string                 `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`                         // Write-only — never returned by List
-	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,7,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
-	ProjectMuteIds []string               `protobuf:"bytes,8,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ExportReportRequest names a scope (single task / project / fleet-wide
+// global) and a format. window_start / window_end bound the time-range the
+// report covers (applies to per-day breakdowns; ignored for single_task).
+type ExportReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Types that are valid to be assigned to Scope:
+	//
+	//	*ExportReportRequest_ProjectId
+	//	*ExportReportRequest_Global
+	//	*ExportReportRequest_SingleTask
+	Scope         isExportReportRequest_Scope `protobuf_oneof:"scope"`
+	Format        ExportFormat                `protobuf:"varint,5,opt,name=format,proto3,enum=watchfire.ExportFormat" json:"format,omitempty"`
+	WindowStart   *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp      `protobuf:"bytes,7,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebhookIntegration) Reset() {
-	*x = WebhookIntegration{}
-	mi := &file_proto_watchfire_proto_msgTypes[86]
+func (x *ExportReportRequest) Reset() {
+	*x = ExportReportRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhookIntegration) String() string {
+func (x *ExportReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhookIntegration) ProtoMessage() {}
+func (*ExportReportRequest) ProtoMessage() {}
 
-func (x *WebhookIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[86]
+func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7060,98 +7249,4332 @@ func (x *WebhookIntegration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhookIntegration.ProtoReflect.Descriptor instead.
-func (*WebhookIntegration) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use ExportReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *WebhookIntegration) GetId() string {
+func (x *ExportReportRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *WebhookIntegration) GetLabel() string {
+func (x *ExportReportRequest) GetScope() isExportReportRequest_Scope {
 	if x != nil {
-		return x.Label
+		return x.Scope
 	}
-	return ""
+	return nil
 }
 
-func (x *WebhookIntegration) GetUrl() string {
+func (x *ExportReportRequest) GetProjectId() string {
 	if x != nil {
-		return x.Url
+		if x, ok := x.Scope.(*ExportReportRequest_ProjectId); ok {
+			return x.ProjectId
+		}
 	}
 	return ""
 }
 
-func (x *WebhookIntegration) GetUrlLabel() string {
+func (x *ExportReportRequest) GetGlobal() bool {
 	if x != nil {
-		return x.UrlLabel
+		if x, ok := x.Scope.(*ExportReportRequest_Global); ok {
+			return x.Global
+		}
 	}
-	return ""
+	return false
 }
 
-func (x *WebhookIntegration) GetSecretSet() bool {
+func (x *ExportReportRequest) GetSingleTask() string {
 	if x != nil {
-		return x.SecretSet
+		if x, ok := x.Scope.(*ExportReportRequest_SingleTask); ok {
+			return x.SingleTask
+		}
 	}
-	return false
+	return ""
 }
 
-func (x *WebhookIntegration) GetSecret() string {
+func (x *ExportReportRequest) GetFormat() ExportFormat {
 	if x != nil {
-		return x.Secret
+		return x.Format
 	}
-	return ""
+	return ExportFormat_CSV
 }
 
-func (x *WebhookIntegration) GetEnabledEvents() *IntegrationEvents {
+func (x *ExportReportRequest) GetWindowStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EnabledEvents
+		return x.WindowStart
 	}
 	return nil
 }
 
-func (x *WebhookIntegration) GetProjectMuteIds() []string {
+func (x *ExportReportRequest) GetWindowEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProjectMuteIds
+		return x.WindowEnd
 	}
 	return nil
 }
 
-// SlackIntegration targets a Slack incoming webhook. The URL itself is
-// the secret (Slack's incoming-webhook auth model), so it is write-only
-// — Save accepts it; List returns only the masked `url_label`.
-type SlackIntegration struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // Write-only — never returned by List
-	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"` // Masked, display-only
-	UrlSet         bool                   `protobuf:"varint,5,opt,name=url_set,json=urlSet,proto3" json:"url_set,omitempty"`      // True if the keyring carries the URL
-	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,6,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
-	ProjectMuteIds []string               `protobuf:"bytes,7,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type isExportReportRequest_Scope interface {
+	isExportReportRequest_Scope()
 }
 
-func (x *SlackIntegration) Reset() {
-	*x = SlackIntegration{}
-	mi := &file_proto_watchfire_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type ExportReportRequest_ProjectId struct {
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof"` // Per-project report
 }
 
-func (x *SlackIntegration) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type ExportReportRequest_Global struct {
+	Global bool `protobuf:"varint,3,opt,name=global,proto3,oneof"` // Fleet rollup
 }
 
-func (*SlackIntegration) ProtoMessage() {}
+type ExportReportRequest_SingleTask struct {
+	SingleTask string `protobuf:"bytes,4,opt,name=single_task,json=singleTask,proto3,oneof"` // "<project_id>:<task_number>" — one task
+}
+
+func (*ExportReportRequest_ProjectId) isExportReportRequest_Scope() {}
+
+func (*ExportReportRequest_Global) isExportReportRequest_Scope() {}
+
+func (*ExportReportRequest_SingleTask) isExportReportRequest_Scope() {}
+
+// ExportReportResponse carries the rendered file. content is the raw bytes
+// (UTF-8 text for every format); the GUI saves it via Blob URL using the
+// supplied filename. mime is `text/csv`, `text/markdown`,
+// `application/json` or `text/html`.
+type ExportReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Mime          string                 `protobuf:"bytes,3,opt,name=mime,proto3" json:"mime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReportResponse) Reset() {
+	*x = ExportReportResponse{}
+	mi := &file_proto_watchfire_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportResponse) ProtoMessage() {}
+
+func (x *ExportReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExportReportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportReportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportReportResponse) GetMime() string {
+	if x != nil {
+		return x.Mime
+	}
+	return ""
+}
+
+// GetGlobalInsightsRequest bounds a fleet-wide rollup query. Both bounds
+// are optional — an unset window_start collapses to "no lower bound" and
+// likewise for window_end. The dashboard typically passes a 30-day window.
+type GetGlobalInsightsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// Optional comparison window. Unset, a window bounded at both ends is
+	// compared with the period of the same length just before it.
+	CompareWindowStart *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=compare_window_start,json=compareWindowStart,proto3" json:"compare_window_start,omitempty"`
+	CompareWindowEnd   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=compare_window_end,json=compareWindowEnd,proto3" json:"compare_window_end,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetGlobalInsightsRequest) Reset() {
+	*x = GetGlobalInsightsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGlobalInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGlobalInsightsRequest) ProtoMessage() {}
+
+func (x *GetGlobalInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGlobalInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetGlobalInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetGlobalInsightsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetGlobalInsightsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *GetGlobalInsightsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *GetGlobalInsightsRequest) GetCompareWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompareWindowStart
+	}
+	return nil
+}
+
+func (x *GetGlobalInsightsRequest) GetCompareWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompareWindowEnd
+	}
+	return nil
+}
+
+// DayBucket — one calendar day's task counts. Used by both per-project and
+// fleet rollups to render stacked bars / sparklines.
+type DayBucket struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Date      string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // YYYY-MM-DD (local zone)
+	Count     int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // succeeded + failed
+	Succeeded int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// v8.0 Inferno — code churn for the day, for a churn-by-day chart drawn
+	// next to the tasks-by-day bars. Zero on days whose tasks lack code
+	// metrics (pre-v8.0 captures).
+	LinesAdded    int32 `protobuf:"varint,5,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32 `protobuf:"varint,6,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayBucket) Reset() {
+	*x = DayBucket{}
+	mi := &file_proto_watchfire_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayBucket) ProtoMessage() {}
+
+func (x *DayBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayBucket.ProtoReflect.Descriptor instead.
+func (*DayBucket) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DayBucket) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DayBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DayBucket) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *DayBucket) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *DayBucket) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *DayBucket) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+// AgentBreakdown — one row per backend agent that touched tasks in the
+// window. Field set is shared between the per-project and global rollups
+// so the same renderer code works for both surfaces.
+type AgentBreakdown struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Agent          string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Count          int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SuccessRate    float64                `protobuf:"fixed64,3,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	AvgDurationMs  int64                  `protobuf:"varint,4,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms,omitempty"`
+	TotalTokensIn  int64                  `protobuf:"varint,5,opt,name=total_tokens_in,json=totalTokensIn,proto3" json:"total_tokens_in,omitempty"`
+	TotalTokensOut int64                  `protobuf:"varint,6,opt,name=total_tokens_out,json=totalTokensOut,proto3" json:"total_tokens_out,omitempty"`
+	TotalCostUsd   float64                `protobuf:"fixed64,7,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
+	// v8.0 Inferno — output-per-agent, so agents are comparable on shipped
+	// code, not just task count. Zero for tasks lacking code metrics.
+	Commits       int32 `protobuf:"varint,8,opt,name=commits,proto3" json:"commits,omitempty"`
+	LinesAdded    int32 `protobuf:"varint,9,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32 `protobuf:"varint,10,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentBreakdown) Reset() {
+	*x = AgentBreakdown{}
+	mi := &file_proto_watchfire_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentBreakdown) ProtoMessage() {}
+
+func (x *AgentBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentBreakdown.ProtoReflect.Descriptor instead.
+func (*AgentBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AgentBreakdown) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *AgentBreakdown) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetAvgDurationMs() int64 {
+	if x != nil {
+		return x.AvgDurationMs
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetTotalTokensIn() int64 {
+	if x != nil {
+		return x.TotalTokensIn
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetTotalTokensOut() int64 {
+	if x != nil {
+		return x.TotalTokensOut
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetTotalCostUsd() float64 {
+	if x != nil {
+		return x.TotalCostUsd
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetCommits() int32 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *AgentBreakdown) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+// AgentComparison — one row per (backend, model) pair that completed tasks
+// in the window, for judging which agent works best on a repo. Rates are
+// 0..1 over `tasks`. `model` is empty when the session log named none.
+type AgentComparison struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Agent             string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Model             string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Tasks             int32                  `protobuf:"varint,3,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Succeeded         int32                  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	SuccessRate       float64                `protobuf:"fixed64,5,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	MedianDurationMs  int64                  `protobuf:"varint,6,opt,name=median_duration_ms,json=medianDurationMs,proto3" json:"median_duration_ms,omitempty"`
+	P90DurationMs     int64                  `protobuf:"varint,7,opt,name=p90_duration_ms,json=p90DurationMs,proto3" json:"p90_duration_ms,omitempty"`
+	TotalCostUsd      float64                `protobuf:"fixed64,8,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
+	CostPerSuccessUsd float64                `protobuf:"fixed64,9,opt,name=cost_per_success_usd,json=costPerSuccessUsd,proto3" json:"cost_per_success_usd,omitempty"` // total_cost_usd / succeeded; 0 with no successes
+	MergeFailures     int32                  `protobuf:"varint,10,opt,name=merge_failures,json=mergeFailures,proto3" json:"merge_failures,omitempty"`                 // Succeeded but the auto-merge failed
+	MergeFailureRate  float64                `protobuf:"fixed64,11,opt,name=merge_failure_rate,json=mergeFailureRate,proto3" json:"merge_failure_rate,omitempty"`
+	FollowUps         int32                  `protobuf:"varint,12,opt,name=follow_ups,json=followUps,proto3" json:"follow_ups,omitempty"` // Needed more than one agent session
+	FollowUpRate      float64                `protobuf:"fixed64,13,opt,name=follow_up_rate,json=followUpRate,proto3" json:"follow_up_rate,omitempty"`
+	Reverted          int32                  `protobuf:"varint,14,opt,name=reverted,proto3" json:"reverted,omitempty"` // Commits later reverted on the default branch
+	RevertRate        float64                `protobuf:"fixed64,15,opt,name=revert_rate,json=revertRate,proto3" json:"revert_rate,omitempty"`
+	LinesAdded        int32                  `protobuf:"varint,16,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved      int32                  `protobuf:"varint,17,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentComparison) Reset() {
+	*x = AgentComparison{}
+	mi := &file_proto_watchfire_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentComparison) ProtoMessage() {}
+
+func (x *AgentComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentComparison.ProtoReflect.Descriptor instead.
+func (*AgentComparison) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AgentComparison) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *AgentComparison) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AgentComparison) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetMedianDurationMs() int64 {
+	if x != nil {
+		return x.MedianDurationMs
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetP90DurationMs() int64 {
+	if x != nil {
+		return x.P90DurationMs
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetTotalCostUsd() float64 {
+	if x != nil {
+		return x.TotalCostUsd
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetCostPerSuccessUsd() float64 {
+	if x != nil {
+		return x.CostPerSuccessUsd
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetMergeFailures() int32 {
+	if x != nil {
+		return x.MergeFailures
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetMergeFailureRate() float64 {
+	if x != nil {
+		return x.MergeFailureRate
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetFollowUps() int32 {
+	if x != nil {
+		return x.FollowUps
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetFollowUpRate() float64 {
+	if x != nil {
+		return x.FollowUpRate
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetReverted() int32 {
+	if x != nil {
+		return x.Reverted
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetRevertRate() float64 {
+	if x != nil {
+		return x.RevertRate
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *AgentComparison) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+// UserUsage is one person's share of a window: the completed tasks they
+// started (or, when nobody is recorded as starting them, created), the
+// tasks they created, and the non-task sessions they started. `user` is
+// empty for unattributed work.
+type UserUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Tasks          int32                  `protobuf:"varint,2,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Succeeded      int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	SuccessRate    float64                `protobuf:"fixed64,4,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	DurationMs     int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	TaskCostUsd    float64                `protobuf:"fixed64,6,opt,name=task_cost_usd,json=taskCostUsd,proto3" json:"task_cost_usd,omitempty"`
+	NetLines       int32                  `protobuf:"varint,7,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
+	TasksCreated   int32                  `protobuf:"varint,8,opt,name=tasks_created,json=tasksCreated,proto3" json:"tasks_created,omitempty"`
+	Sessions       int32                  `protobuf:"varint,9,opt,name=sessions,proto3" json:"sessions,omitempty"` // Non-task sessions started
+	SessionCostUsd float64                `protobuf:"fixed64,10,opt,name=session_cost_usd,json=sessionCostUsd,proto3" json:"session_cost_usd,omitempty"`
+	TotalCostUsd   float64                `protobuf:"fixed64,11,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"` // task_cost_usd + session_cost_usd
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
+	mi := &file_proto_watchfire_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUsage) ProtoMessage() {}
+
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UserUsage) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *UserUsage) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *UserUsage) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *UserUsage) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *UserUsage) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *UserUsage) GetTaskCostUsd() float64 {
+	if x != nil {
+		return x.TaskCostUsd
+	}
+	return 0
+}
+
+func (x *UserUsage) GetNetLines() int32 {
+	if x != nil {
+		return x.NetLines
+	}
+	return 0
+}
+
+func (x *UserUsage) GetTasksCreated() int32 {
+	if x != nil {
+		return x.TasksCreated
+	}
+	return 0
+}
+
+func (x *UserUsage) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *UserUsage) GetSessionCostUsd() float64 {
+	if x != nil {
+		return x.SessionCostUsd
+	}
+	return 0
+}
+
+func (x *UserUsage) GetTotalCostUsd() float64 {
+	if x != nil {
+		return x.TotalCostUsd
+	}
+	return 0
+}
+
+// InsightsOverhead — agent sessions that ran outside a task (chat,
+// wildfire refine / generate, definition and task generation), which the
+// task totals don't include. `cost_share` is the overhead's part of task
+// plus overhead spend, 0..1.
+type InsightsOverhead struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Sessions            int32                  `protobuf:"varint,1,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	DurationMs          int64                  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	TokensIn            int64                  `protobuf:"varint,3,opt,name=tokens_in,json=tokensIn,proto3" json:"tokens_in,omitempty"`
+	TokensOut           int64                  `protobuf:"varint,4,opt,name=tokens_out,json=tokensOut,proto3" json:"tokens_out,omitempty"`
+	CostUsd             float64                `protobuf:"fixed64,5,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	SessionsMissingCost int32                  `protobuf:"varint,6,opt,name=sessions_missing_cost,json=sessionsMissingCost,proto3" json:"sessions_missing_cost,omitempty"`
+	CostShare           float64                `protobuf:"fixed64,7,opt,name=cost_share,json=costShare,proto3" json:"cost_share,omitempty"`
+	ByKind              []*OverheadKind        `protobuf:"bytes,8,rep,name=by_kind,json=byKind,proto3" json:"by_kind,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *InsightsOverhead) Reset() {
+	*x = InsightsOverhead{}
+	mi := &file_proto_watchfire_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsOverhead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsOverhead) ProtoMessage() {}
+
+func (x *InsightsOverhead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsOverhead.ProtoReflect.Descriptor instead.
+func (*InsightsOverhead) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *InsightsOverhead) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetTokensIn() int64 {
+	if x != nil {
+		return x.TokensIn
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetTokensOut() int64 {
+	if x != nil {
+		return x.TokensOut
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetSessionsMissingCost() int32 {
+	if x != nil {
+		return x.SessionsMissingCost
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetCostShare() float64 {
+	if x != nil {
+		return x.CostShare
+	}
+	return 0
+}
+
+func (x *InsightsOverhead) GetByKind() []*OverheadKind {
+	if x != nil {
+		return x.ByKind
+	}
+	return nil
+}
+
+// OverheadKind — one session kind's part of InsightsOverhead. `kind` is
+// the agent mode, qualified by the wildfire phase ("wildfire-refine").
+type OverheadKind struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Sessions      int32                  `protobuf:"varint,2,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	TokensIn      int64                  `protobuf:"varint,4,opt,name=tokens_in,json=tokensIn,proto3" json:"tokens_in,omitempty"`
+	TokensOut     int64                  `protobuf:"varint,5,opt,name=tokens_out,json=tokensOut,proto3" json:"tokens_out,omitempty"`
+	CostUsd       float64                `protobuf:"fixed64,6,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverheadKind) Reset() {
+	*x = OverheadKind{}
+	mi := &file_proto_watchfire_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverheadKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverheadKind) ProtoMessage() {}
+
+func (x *OverheadKind) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverheadKind.ProtoReflect.Descriptor instead.
+func (*OverheadKind) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *OverheadKind) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OverheadKind) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *OverheadKind) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *OverheadKind) GetTokensIn() int64 {
+	if x != nil {
+		return x.TokensIn
+	}
+	return 0
+}
+
+func (x *OverheadKind) GetTokensOut() int64 {
+	if x != nil {
+		return x.TokensOut
+	}
+	return 0
+}
+
+func (x *OverheadKind) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+// MetricDelta — one figure in the window against the comparison window.
+// `change` is current − previous (points, 0..1, for rates); `change_pct`
+// is change / |previous|, 0 when previous is 0.
+type MetricDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       float64                `protobuf:"fixed64,1,opt,name=current,proto3" json:"current,omitempty"`
+	Previous      float64                `protobuf:"fixed64,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	Change        float64                `protobuf:"fixed64,3,opt,name=change,proto3" json:"change,omitempty"`
+	ChangePct     float64                `protobuf:"fixed64,4,opt,name=change_pct,json=changePct,proto3" json:"change_pct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricDelta) Reset() {
+	*x = MetricDelta{}
+	mi := &file_proto_watchfire_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricDelta) ProtoMessage() {}
+
+func (x *MetricDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricDelta.ProtoReflect.Descriptor instead.
+func (*MetricDelta) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *MetricDelta) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *MetricDelta) GetPrevious() float64 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *MetricDelta) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *MetricDelta) GetChangePct() float64 {
+	if x != nil {
+		return x.ChangePct
+	}
+	return 0
+}
+
+// InsightsComparison — a rollup against an earlier window.
+// `regressions` are human-readable reasons ("success rate down 15 pts
+// (80% → 65%)") for a success-rate drop of 10 points or a 50% rise in cost
+// per task, when both windows completed at least 3 tasks.
+type InsightsComparison struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"` // Comparison window
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Tasks         *MetricDelta           `protobuf:"bytes,3,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	SuccessRate   *MetricDelta           `protobuf:"bytes,4,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	CostUsd       *MetricDelta           `protobuf:"bytes,5,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	NetLines      *MetricDelta           `protobuf:"bytes,6,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
+	Regressions   []string               `protobuf:"bytes,7,rep,name=regressions,proto3" json:"regressions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsComparison) Reset() {
+	*x = InsightsComparison{}
+	mi := &file_proto_watchfire_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsComparison) ProtoMessage() {}
+
+func (x *InsightsComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsComparison.ProtoReflect.Descriptor instead.
+func (*InsightsComparison) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *InsightsComparison) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *InsightsComparison) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *InsightsComparison) GetTasks() *MetricDelta {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *InsightsComparison) GetSuccessRate() *MetricDelta {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return nil
+}
+
+func (x *InsightsComparison) GetCostUsd() *MetricDelta {
+	if x != nil {
+		return x.CostUsd
+	}
+	return nil
+}
+
+func (x *InsightsComparison) GetNetLines() *MetricDelta {
+	if x != nil {
+		return x.NetLines
+	}
+	return nil
+}
+
+func (x *InsightsComparison) GetRegressions() []string {
+	if x != nil {
+		return x.Regressions
+	}
+	return nil
+}
+
+// TrendWeek — one Monday-to-Sunday week (local time) of the rolling trend.
+// Empty weeks are kept, zeroed.
+type TrendWeek struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart     string                 `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // YYYY-MM-DD, the Monday
+	Tasks         int32                  `protobuf:"varint,2,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	SuccessRate   float64                `protobuf:"fixed64,4,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	CostUsd       float64                `protobuf:"fixed64,5,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	NetLines      int32                  `protobuf:"varint,6,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendWeek) Reset() {
+	*x = TrendWeek{}
+	mi := &file_proto_watchfire_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendWeek) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendWeek) ProtoMessage() {}
+
+func (x *TrendWeek) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendWeek.ProtoReflect.Descriptor instead.
+func (*TrendWeek) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TrendWeek) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *TrendWeek) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *TrendWeek) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *TrendWeek) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *TrendWeek) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+func (x *TrendWeek) GetNetLines() int32 {
+	if x != nil {
+		return x.NetLines
+	}
+	return 0
+}
+
+// TopProject — one row of the fleet rollup's top-projects pill list,
+// sorted by completed-task count descending. The dashboard renders these
+// as clickable pills that route to each project's InsightsTab.
+type TopProject struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId    string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName  string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectColor string                 `protobuf:"bytes,3,opt,name=project_color,json=projectColor,proto3" json:"project_color,omitempty"`
+	Count        int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	SuccessRate  float64                `protobuf:"fixed64,5,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// v8.0 Inferno — per-project shipped-code totals over the window, so the
+	// fleet rollup can rank projects by net lines / churn rather than only by
+	// completed-task count. Zero for projects whose tasks lack code metrics.
+	Commits      int32 `protobuf:"varint,6,opt,name=commits,proto3" json:"commits,omitempty"`
+	LinesAdded   int32 `protobuf:"varint,7,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved int32 `protobuf:"varint,8,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	NetLines     int32 `protobuf:"varint,9,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
+	// Completed tasks merged in the window (silent merge or auto-PR). Lets the
+	// mission-control home window show a per-card "shipped" line including merge
+	// count alongside churn. Zero for projects whose tasks lack code metrics.
+	Merges        int32 `protobuf:"varint,10,opt,name=merges,proto3" json:"merges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopProject) Reset() {
+	*x = TopProject{}
+	mi := &file_proto_watchfire_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopProject) ProtoMessage() {}
+
+func (x *TopProject) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopProject.ProtoReflect.Descriptor instead.
+func (*TopProject) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *TopProject) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TopProject) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *TopProject) GetProjectColor() string {
+	if x != nil {
+		return x.ProjectColor
+	}
+	return ""
+}
+
+func (x *TopProject) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TopProject) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *TopProject) GetCommits() int32 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *TopProject) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *TopProject) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *TopProject) GetNetLines() int32 {
+	if x != nil {
+		return x.NetLines
+	}
+	return 0
+}
+
+func (x *TopProject) GetMerges() int32 {
+	if x != nil {
+		return x.Merges
+	}
+	return 0
+}
+
+// GlobalInsights is the cross-project rollup the daemon returns from
+// `GetGlobalInsights`. Sums every per-project metric over the window;
+// `tasks_missing_cost` surfaces a partial-data caveat when not every
+// completed task carried a cost number.
+type GlobalInsights struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TasksTotal       int32                  `protobuf:"varint,1,opt,name=tasks_total,json=tasksTotal,proto3" json:"tasks_total,omitempty"`
+	TasksSucceeded   int32                  `protobuf:"varint,2,opt,name=tasks_succeeded,json=tasksSucceeded,proto3" json:"tasks_succeeded,omitempty"`
+	TasksFailed      int32                  `protobuf:"varint,3,opt,name=tasks_failed,json=tasksFailed,proto3" json:"tasks_failed,omitempty"`
+	TasksByDay       []*DayBucket           `protobuf:"bytes,4,rep,name=tasks_by_day,json=tasksByDay,proto3" json:"tasks_by_day,omitempty"`
+	TopProjects      []*TopProject          `protobuf:"bytes,5,rep,name=top_projects,json=topProjects,proto3" json:"top_projects,omitempty"`
+	AgentBreakdown   []*AgentBreakdown      `protobuf:"bytes,6,rep,name=agent_breakdown,json=agentBreakdown,proto3" json:"agent_breakdown,omitempty"`
+	TotalDurationMs  int64                  `protobuf:"varint,7,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	TotalCostUsd     float64                `protobuf:"fixed64,8,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
+	TasksMissingCost int32                  `protobuf:"varint,9,opt,name=tasks_missing_cost,json=tasksMissingCost,proto3" json:"tasks_missing_cost,omitempty"`
+	WindowStart      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// v8.0 Inferno — fleet-wide shipped-code rollup. `metrics_missing_code`
+	// counts completed tasks with no code metrics so the GUI can honestly say
+	// "based on N of M tasks". Older cached payloads without these fields read
+	// them as zero.
+	TotalCommits       int32 `protobuf:"varint,12,opt,name=total_commits,json=totalCommits,proto3" json:"total_commits,omitempty"`
+	TotalFilesChanged  int32 `protobuf:"varint,13,opt,name=total_files_changed,json=totalFilesChanged,proto3" json:"total_files_changed,omitempty"`
+	TotalLinesAdded    int32 `protobuf:"varint,14,opt,name=total_lines_added,json=totalLinesAdded,proto3" json:"total_lines_added,omitempty"`
+	TotalLinesRemoved  int32 `protobuf:"varint,15,opt,name=total_lines_removed,json=totalLinesRemoved,proto3" json:"total_lines_removed,omitempty"`
+	NetLines           int32 `protobuf:"varint,16,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
+	TasksMerged        int32 `protobuf:"varint,17,opt,name=tasks_merged,json=tasksMerged,proto3" json:"tasks_merged,omitempty"`
+	TasksViaPr         int32 `protobuf:"varint,18,opt,name=tasks_via_pr,json=tasksViaPr,proto3" json:"tasks_via_pr,omitempty"`
+	MetricsMissingCode int32 `protobuf:"varint,19,opt,name=metrics_missing_code,json=metricsMissingCode,proto3" json:"metrics_missing_code,omitempty"`
+	// The part of total_cost_usd estimated from the settings.yaml pricing
+	// table for backends that don't report cost, and the tasks it covers.
+	EstimatedCostUsd   float64 `protobuf:"fixed64,20,opt,name=estimated_cost_usd,json=estimatedCostUsd,proto3" json:"estimated_cost_usd,omitempty"`
+	TasksEstimatedCost int32   `protobuf:"varint,21,opt,name=tasks_estimated_cost,json=tasksEstimatedCost,proto3" json:"tasks_estimated_cost,omitempty"`
+	// Standing of every configured monthly budget for the current month
+	// (not the query window): the global one first, then per-project ones.
+	Budgets []*BudgetStatus `protobuf:"bytes,22,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	// Per backend + model comparison across every project, most tasks first.
+	AgentComparison []*AgentComparison `protobuf:"bytes,23,rep,name=agent_comparison,json=agentComparison,proto3" json:"agent_comparison,omitempty"`
+	Overhead        *InsightsOverhead  `protobuf:"bytes,24,opt,name=overhead,proto3" json:"overhead,omitempty"`
+	// The window against the comparison window; unset when there is none
+	// (an all-time query without compare_window_*).
+	Comparison *InsightsComparison `protobuf:"bytes,25,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// The 12 weeks ending with the window's last week, oldest first.
+	Trend []*TrendWeek `protobuf:"bytes,26,rep,name=trend,proto3" json:"trend,omitempty"`
+	// Usage and spend per person, highest total cost first.
+	Users         []*UserUsage `protobuf:"bytes,27,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalInsights) Reset() {
+	*x = GlobalInsights{}
+	mi := &file_proto_watchfire_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalInsights) ProtoMessage() {}
+
+func (x *GlobalInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalInsights.ProtoReflect.Descriptor instead.
+func (*GlobalInsights) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GlobalInsights) GetTasksTotal() int32 {
+	if x != nil {
+		return x.TasksTotal
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksSucceeded() int32 {
+	if x != nil {
+		return x.TasksSucceeded
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksFailed() int32 {
+	if x != nil {
+		return x.TasksFailed
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksByDay() []*DayBucket {
+	if x != nil {
+		return x.TasksByDay
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetTopProjects() []*TopProject {
+	if x != nil {
+		return x.TopProjects
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetAgentBreakdown() []*AgentBreakdown {
+	if x != nil {
+		return x.AgentBreakdown
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetTotalDurationMs() int64 {
+	if x != nil {
+		return x.TotalDurationMs
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTotalCostUsd() float64 {
+	if x != nil {
+		return x.TotalCostUsd
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksMissingCost() int32 {
+	if x != nil {
+		return x.TasksMissingCost
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetTotalCommits() int32 {
+	if x != nil {
+		return x.TotalCommits
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTotalFilesChanged() int32 {
+	if x != nil {
+		return x.TotalFilesChanged
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTotalLinesAdded() int32 {
+	if x != nil {
+		return x.TotalLinesAdded
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTotalLinesRemoved() int32 {
+	if x != nil {
+		return x.TotalLinesRemoved
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetNetLines() int32 {
+	if x != nil {
+		return x.NetLines
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksMerged() int32 {
+	if x != nil {
+		return x.TasksMerged
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksViaPr() int32 {
+	if x != nil {
+		return x.TasksViaPr
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetMetricsMissingCode() int32 {
+	if x != nil {
+		return x.MetricsMissingCode
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetEstimatedCostUsd() float64 {
+	if x != nil {
+		return x.EstimatedCostUsd
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetTasksEstimatedCost() int32 {
+	if x != nil {
+		return x.TasksEstimatedCost
+	}
+	return 0
+}
+
+func (x *GlobalInsights) GetBudgets() []*BudgetStatus {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetAgentComparison() []*AgentComparison {
+	if x != nil {
+		return x.AgentComparison
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetOverhead() *InsightsOverhead {
+	if x != nil {
+		return x.Overhead
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetComparison() *InsightsComparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetTrend() []*TrendWeek {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *GlobalInsights) GetUsers() []*UserUsage {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// BudgetStatus is one monthly budget's standing.
+type BudgetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`                          // "global" | "project"
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Empty for the global budget
+	ProjectName   string                 `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Month         string                 `protobuf:"bytes,4,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM, local time
+	LimitUsd      float64                `protobuf:"fixed64,5,opt,name=limit_usd,json=limitUsd,proto3" json:"limit_usd,omitempty"`
+	SpentUsd      float64                `protobuf:"fixed64,6,opt,name=spent_usd,json=spentUsd,proto3" json:"spent_usd,omitempty"`
+	Threshold     int32                  `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"` // Highest threshold reached; 0 = none
+	HardStop      bool                   `protobuf:"varint,8,opt,name=hard_stop,json=hardStop,proto3" json:"hard_stop,omitempty"`
+	Exceeded      bool                   `protobuf:"varint,9,opt,name=exceeded,proto3" json:"exceeded,omitempty"`  // spent_usd >= limit_usd
+	Blocking      bool                   `protobuf:"varint,10,opt,name=blocking,proto3" json:"blocking,omitempty"` // hard_stop && exceeded: non-chat starts are refused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_proto_watchfire_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *BudgetStatus) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetLimitUsd() float64 {
+	if x != nil {
+		return x.LimitUsd
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetSpentUsd() float64 {
+	if x != nil {
+		return x.SpentUsd
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetHardStop() bool {
+	if x != nil {
+		return x.HardStop
+	}
+	return false
+}
+
+func (x *BudgetStatus) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+func (x *BudgetStatus) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+// GetProjectInsightsRequest scopes a per-project insights query. Both
+// window bounds are optional — leaving them unset queries "all time".
+type GetProjectInsightsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Meta        *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId   string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// Optional comparison window; see GetGlobalInsightsRequest.
+	CompareWindowStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=compare_window_start,json=compareWindowStart,proto3" json:"compare_window_start,omitempty"`
+	CompareWindowEnd   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=compare_window_end,json=compareWindowEnd,proto3" json:"compare_window_end,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetProjectInsightsRequest) Reset() {
+	*x = GetProjectInsightsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectInsightsRequest) ProtoMessage() {}
+
+func (x *GetProjectInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetProjectInsightsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetProjectInsightsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetProjectInsightsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *GetProjectInsightsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *GetProjectInsightsRequest) GetCompareWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompareWindowStart
+	}
+	return nil
+}
+
+func (x *GetProjectInsightsRequest) GetCompareWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompareWindowEnd
+	}
+	return nil
+}
+
+// ProjectInsights is the per-project rollup the daemon returns from
+// `GetProjectInsights`. Same shape primitives as GlobalInsights — the
+// dashboard's per-project Insights tab and the TUI per-project overlay
+// reuse the same renderers.
+type ProjectInsights struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId        string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TasksTotal       int32                  `protobuf:"varint,2,opt,name=tasks_total,json=tasksTotal,proto3" json:"tasks_total,omitempty"`
+	TasksSucceeded   int32                  `protobuf:"varint,3,opt,name=tasks_succeeded,json=tasksSucceeded,proto3" json:"tasks_succeeded,omitempty"`
+	TasksFailed      int32                  `protobuf:"varint,4,opt,name=tasks_failed,json=tasksFailed,proto3" json:"tasks_failed,omitempty"`
+	TasksByDay       []*DayBucket           `protobuf:"bytes,5,rep,name=tasks_by_day,json=tasksByDay,proto3" json:"tasks_by_day,omitempty"`
+	AgentBreakdown   []*AgentBreakdown      `protobuf:"bytes,6,rep,name=agent_breakdown,json=agentBreakdown,proto3" json:"agent_breakdown,omitempty"`
+	TotalDurationMs  int64                  `protobuf:"varint,7,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	AvgDurationMs    int64                  `protobuf:"varint,8,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms,omitempty"`
+	P50DurationMs    int64                  `protobuf:"varint,9,opt,name=p50_duration_ms,json=p50DurationMs,proto3" json:"p50_duration_ms,omitempty"`
+	P95DurationMs    int64                  `protobuf:"varint,10,opt,name=p95_duration_ms,json=p95DurationMs,proto3" json:"p95_duration_ms,omitempty"`
+	TotalCostUsd     float64                `protobuf:"fixed64,11,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
+	TasksMissingCost int32                  `protobuf:"varint,12,opt,name=tasks_missing_cost,json=tasksMissingCost,proto3" json:"tasks_missing_cost,omitempty"`
+	WindowStart      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// v8.0 Inferno — per-project shipped-code rollup. Mirrors GlobalInsights;
+	// `metrics_missing_code` drives the "based on N of M tasks" coverage note
+	// in the Insights tab. Older cached payloads read these as zero.
+	TotalCommits       int32 `protobuf:"varint,15,opt,name=total_commits,json=totalCommits,proto3" json:"total_commits,omitempty"`
+	TotalFilesChanged  int32 `protobuf:"varint,16,opt,name=total_files_changed,json=totalFilesChanged,proto3" json:"total_files_changed,omitempty"`
+	TotalLinesAdded    int32 `protobuf:"varint,17,opt,name=total_lines_added,json=totalLinesAdded,proto3" json:"total_lines_added,omitempty"`
+	TotalLinesRemoved  int32 `protobuf:"varint,18,opt,name=total_lines_removed,json=totalLinesRemoved,proto3" json:"total_lines_removed,omitempty"`
+	NetLines           int32 `protobuf:"varint,19,opt,name=net_lines,json=netLines,proto3" json:"net_lines,omitempty"`
+	TasksMerged        int32 `protobuf:"varint,20,opt,name=tasks_merged,json=tasksMerged,proto3" json:"tasks_merged,omitempty"`
+	TasksViaPr         int32 `protobuf:"varint,21,opt,name=tasks_via_pr,json=tasksViaPr,proto3" json:"tasks_via_pr,omitempty"`
+	MetricsMissingCode int32 `protobuf:"varint,22,opt,name=metrics_missing_code,json=metricsMissingCode,proto3" json:"metrics_missing_code,omitempty"`
+	// Mirrors GlobalInsights: the estimated share of total_cost_usd.
+	EstimatedCostUsd   float64 `protobuf:"fixed64,23,opt,name=estimated_cost_usd,json=estimatedCostUsd,proto3" json:"estimated_cost_usd,omitempty"`
+	TasksEstimatedCost int32   `protobuf:"varint,24,opt,name=tasks_estimated_cost,json=tasksEstimatedCost,proto3" json:"tasks_estimated_cost,omitempty"`
+	// Per backend + model comparison, most tasks first.
+	AgentComparison []*AgentComparison `protobuf:"bytes,25,rep,name=agent_comparison,json=agentComparison,proto3" json:"agent_comparison,omitempty"`
+	Overhead        *InsightsOverhead  `protobuf:"bytes,26,opt,name=overhead,proto3" json:"overhead,omitempty"`
+	// Mirrors GlobalInsights.
+	Comparison    *InsightsComparison `protobuf:"bytes,27,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	Trend         []*TrendWeek        `protobuf:"bytes,28,rep,name=trend,proto3" json:"trend,omitempty"`
+	Users         []*UserUsage        `protobuf:"bytes,29,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectInsights) Reset() {
+	*x = ProjectInsights{}
+	mi := &file_proto_watchfire_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectInsights) ProtoMessage() {}
+
+func (x *ProjectInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectInsights.ProtoReflect.Descriptor instead.
+func (*ProjectInsights) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ProjectInsights) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectInsights) GetTasksTotal() int32 {
+	if x != nil {
+		return x.TasksTotal
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksSucceeded() int32 {
+	if x != nil {
+		return x.TasksSucceeded
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksFailed() int32 {
+	if x != nil {
+		return x.TasksFailed
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksByDay() []*DayBucket {
+	if x != nil {
+		return x.TasksByDay
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetAgentBreakdown() []*AgentBreakdown {
+	if x != nil {
+		return x.AgentBreakdown
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetTotalDurationMs() int64 {
+	if x != nil {
+		return x.TotalDurationMs
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetAvgDurationMs() int64 {
+	if x != nil {
+		return x.AvgDurationMs
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetP50DurationMs() int64 {
+	if x != nil {
+		return x.P50DurationMs
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetP95DurationMs() int64 {
+	if x != nil {
+		return x.P95DurationMs
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTotalCostUsd() float64 {
+	if x != nil {
+		return x.TotalCostUsd
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksMissingCost() int32 {
+	if x != nil {
+		return x.TasksMissingCost
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetTotalCommits() int32 {
+	if x != nil {
+		return x.TotalCommits
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTotalFilesChanged() int32 {
+	if x != nil {
+		return x.TotalFilesChanged
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTotalLinesAdded() int32 {
+	if x != nil {
+		return x.TotalLinesAdded
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTotalLinesRemoved() int32 {
+	if x != nil {
+		return x.TotalLinesRemoved
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetNetLines() int32 {
+	if x != nil {
+		return x.NetLines
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksMerged() int32 {
+	if x != nil {
+		return x.TasksMerged
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksViaPr() int32 {
+	if x != nil {
+		return x.TasksViaPr
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetMetricsMissingCode() int32 {
+	if x != nil {
+		return x.MetricsMissingCode
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetEstimatedCostUsd() float64 {
+	if x != nil {
+		return x.EstimatedCostUsd
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetTasksEstimatedCost() int32 {
+	if x != nil {
+		return x.TasksEstimatedCost
+	}
+	return 0
+}
+
+func (x *ProjectInsights) GetAgentComparison() []*AgentComparison {
+	if x != nil {
+		return x.AgentComparison
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetOverhead() *InsightsOverhead {
+	if x != nil {
+		return x.Overhead
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetComparison() *InsightsComparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetTrend() []*TrendWeek {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *ProjectInsights) GetUsers() []*UserUsage {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// GetTaskDiffRequest names a task whose diff the daemon should compute
+// against either the still-existing `watchfire/<n>` branch or, if the
+// branch was already merged + deleted, the canonical merge commit on the
+// project's default branch.
+type GetTaskDiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TaskNumber    int32                  `protobuf:"varint,3,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskDiffRequest) Reset() {
+	*x = GetTaskDiffRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskDiffRequest) ProtoMessage() {}
+
+func (x *GetTaskDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskDiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetTaskDiffRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetTaskDiffRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetTaskDiffRequest) GetTaskNumber() int32 {
+	if x != nil {
+		return x.TaskNumber
+	}
+	return 0
+}
+
+// FileDiffSet is the structured top-level shape returned by
+// `InsightsService.GetTaskDiff`. The GUI / TUI render natively from this
+// — no unified-diff parsing required. `truncated` flips when the daemon
+// caps a >MaxDiffLines result.
+type FileDiffSet struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Files          []*FileDiff            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	TotalAdditions int32                  `protobuf:"varint,2,opt,name=total_additions,json=totalAdditions,proto3" json:"total_additions,omitempty"`
+	TotalDeletions int32                  `protobuf:"varint,3,opt,name=total_deletions,json=totalDeletions,proto3" json:"total_deletions,omitempty"`
+	Truncated      bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FileDiffSet) Reset() {
+	*x = FileDiffSet{}
+	mi := &file_proto_watchfire_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileDiffSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDiffSet) ProtoMessage() {}
+
+func (x *FileDiffSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDiffSet.ProtoReflect.Descriptor instead.
+func (*FileDiffSet) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *FileDiffSet) GetFiles() []*FileDiff {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *FileDiffSet) GetTotalAdditions() int32 {
+	if x != nil {
+		return x.TotalAdditions
+	}
+	return 0
+}
+
+func (x *FileDiffSet) GetTotalDeletions() int32 {
+	if x != nil {
+		return x.TotalDeletions
+	}
+	return 0
+}
+
+func (x *FileDiffSet) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// FileDiff is one file-level entry inside a FileDiffSet. Binary files
+// surface as `status=MODIFIED, hunks=[]` with a synthetic single hunk
+// whose header reads "Binary file changed" — consumers can render a
+// clear marker without trying to display bytes.
+type FileDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Status        FileDiff_Status        `protobuf:"varint,2,opt,name=status,proto3,enum=watchfire.FileDiff_Status" json:"status,omitempty"`
+	OldPath       string                 `protobuf:"bytes,3,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	Hunks         []*Hunk                `protobuf:"bytes,4,rep,name=hunks,proto3" json:"hunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileDiff) Reset() {
+	*x = FileDiff{}
+	mi := &file_proto_watchfire_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDiff) ProtoMessage() {}
+
+func (x *FileDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDiff.ProtoReflect.Descriptor instead.
+func (*FileDiff) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *FileDiff) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileDiff) GetStatus() FileDiff_Status {
+	if x != nil {
+		return x.Status
+	}
+	return FileDiff_MODIFIED
+}
+
+func (x *FileDiff) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *FileDiff) GetHunks() []*Hunk {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+// Hunk corresponds to one `@@ -<oldStart>,<oldLines> +<newStart>,<newLines> @@`
+// region of a unified diff. `header` carries the trailing context label
+// git emits after the second `@@` (the function name, when available).
+type Hunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldStart      int32                  `protobuf:"varint,1,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"`
+	OldLines      int32                  `protobuf:"varint,2,opt,name=old_lines,json=oldLines,proto3" json:"old_lines,omitempty"`
+	NewStart      int32                  `protobuf:"varint,3,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
+	NewLines      int32                  `protobuf:"varint,4,opt,name=new_lines,json=newLines,proto3" json:"new_lines,omitempty"`
+	Header        string                 `protobuf:"bytes,5,opt,name=header,proto3" json:"header,omitempty"`
+	Lines         []*DiffLine            `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hunk) Reset() {
+	*x = Hunk{}
+	mi := &file_proto_watchfire_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hunk) ProtoMessage() {}
+
+func (x *Hunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hunk.ProtoReflect.Descriptor instead.
+func (*Hunk) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Hunk) GetOldStart() int32 {
+	if x != nil {
+		return x.OldStart
+	}
+	return 0
+}
+
+func (x *Hunk) GetOldLines() int32 {
+	if x != nil {
+		return x.OldLines
+	}
+	return 0
+}
+
+func (x *Hunk) GetNewStart() int32 {
+	if x != nil {
+		return x.NewStart
+	}
+	return 0
+}
+
+func (x *Hunk) GetNewLines() int32 {
+	if x != nil {
+		return x.NewLines
+	}
+	return 0
+}
+
+func (x *Hunk) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *Hunk) GetLines() []*DiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// DiffLine is one line inside a Hunk. `text` excludes the leading +/-/space
+// prefix; the kind tag carries that semantic.
+type DiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          DiffLine_Kind          `protobuf:"varint,1,opt,name=kind,proto3,enum=watchfire.DiffLine_Kind" json:"kind,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_proto_watchfire_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DiffLine) GetKind() DiffLine_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiffLine_CONTEXT
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// GetHotspotsRequest scopes a hotspot report to one project and an
+// optional window (unset bounds mean "all time"). `limit` caps each list;
+// 0 means 10, and it is clamped to 100.
+type GetHotspotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotspotsRequest) Reset() {
+	*x = GetHotspotsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotspotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotspotsRequest) ProtoMessage() {}
+
+func (x *GetHotspotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotspotsRequest.ProtoReflect.Descriptor instead.
+func (*GetHotspotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetHotspotsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetHotspotsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetHotspotsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *GetHotspotsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *GetHotspotsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Hotspots aggregates the diffs of a project's tasks completed in the
+// window. `files` are the most-touched files (most tasks, then most
+// churn); `failure_files` those touched by failed tasks or failed
+// (conflicted) merges; `directories` roll files up at every depth.
+// `weeks` are the 12 week starts each file's `weekly_churn` lines up
+// with. `tasks_without_diff` counts tasks whose diff couldn't be resolved.
+type Hotspots struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId        string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	WindowStart      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	TasksScanned     int32                  `protobuf:"varint,4,opt,name=tasks_scanned,json=tasksScanned,proto3" json:"tasks_scanned,omitempty"`
+	TasksWithoutDiff int32                  `protobuf:"varint,5,opt,name=tasks_without_diff,json=tasksWithoutDiff,proto3" json:"tasks_without_diff,omitempty"`
+	Files            []*HotspotFile         `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	FailureFiles     []*HotspotFile         `protobuf:"bytes,7,rep,name=failure_files,json=failureFiles,proto3" json:"failure_files,omitempty"`
+	Directories      []*HotspotDirectory    `protobuf:"bytes,8,rep,name=directories,proto3" json:"directories,omitempty"`
+	Weeks            []string               `protobuf:"bytes,9,rep,name=weeks,proto3" json:"weeks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Hotspots) Reset() {
+	*x = Hotspots{}
+	mi := &file_proto_watchfire_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hotspots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hotspots) ProtoMessage() {}
+
+func (x *Hotspots) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hotspots.ProtoReflect.Descriptor instead.
+func (*Hotspots) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Hotspots) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Hotspots) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *Hotspots) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *Hotspots) GetTasksScanned() int32 {
+	if x != nil {
+		return x.TasksScanned
+	}
+	return 0
+}
+
+func (x *Hotspots) GetTasksWithoutDiff() int32 {
+	if x != nil {
+		return x.TasksWithoutDiff
+	}
+	return 0
+}
+
+func (x *Hotspots) GetFiles() []*HotspotFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Hotspots) GetFailureFiles() []*HotspotFile {
+	if x != nil {
+		return x.FailureFiles
+	}
+	return nil
+}
+
+func (x *Hotspots) GetDirectories() []*HotspotDirectory {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+func (x *Hotspots) GetWeeks() []string {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+// HotspotFile — one path's tally. Renamed files count under the new path.
+type HotspotFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Tasks         int32                  `protobuf:"varint,2,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	FailedTasks   int32                  `protobuf:"varint,3,opt,name=failed_tasks,json=failedTasks,proto3" json:"failed_tasks,omitempty"`
+	MergeFailures int32                  `protobuf:"varint,4,opt,name=merge_failures,json=mergeFailures,proto3" json:"merge_failures,omitempty"`
+	LinesAdded    int32                  `protobuf:"varint,5,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32                  `protobuf:"varint,6,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	LastTouched   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_touched,json=lastTouched,proto3" json:"last_touched,omitempty"`
+	WeeklyChurn   []int32                `protobuf:"varint,8,rep,packed,name=weekly_churn,json=weeklyChurn,proto3" json:"weekly_churn,omitempty"` // Lines added + removed per `weeks` entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotspotFile) Reset() {
+	*x = HotspotFile{}
+	mi := &file_proto_watchfire_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotspotFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotspotFile) ProtoMessage() {}
+
+func (x *HotspotFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotspotFile.ProtoReflect.Descriptor instead.
+func (*HotspotFile) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *HotspotFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HotspotFile) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *HotspotFile) GetFailedTasks() int32 {
+	if x != nil {
+		return x.FailedTasks
+	}
+	return 0
+}
+
+func (x *HotspotFile) GetMergeFailures() int32 {
+	if x != nil {
+		return x.MergeFailures
+	}
+	return 0
+}
+
+func (x *HotspotFile) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *HotspotFile) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *HotspotFile) GetLastTouched() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTouched
+	}
+	return nil
+}
+
+func (x *HotspotFile) GetWeeklyChurn() []int32 {
+	if x != nil {
+		return x.WeeklyChurn
+	}
+	return nil
+}
+
+// HotspotDirectory — every file under a directory; `tasks` counts
+// distinct tasks.
+type HotspotDirectory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Tasks         int32                  `protobuf:"varint,2,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Files         int32                  `protobuf:"varint,3,opt,name=files,proto3" json:"files,omitempty"`
+	FailedTasks   int32                  `protobuf:"varint,4,opt,name=failed_tasks,json=failedTasks,proto3" json:"failed_tasks,omitempty"`
+	MergeFailures int32                  `protobuf:"varint,5,opt,name=merge_failures,json=mergeFailures,proto3" json:"merge_failures,omitempty"`
+	LinesAdded    int32                  `protobuf:"varint,6,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32                  `protobuf:"varint,7,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotspotDirectory) Reset() {
+	*x = HotspotDirectory{}
+	mi := &file_proto_watchfire_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotspotDirectory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotspotDirectory) ProtoMessage() {}
+
+func (x *HotspotDirectory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotspotDirectory.ProtoReflect.Descriptor instead.
+func (*HotspotDirectory) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *HotspotDirectory) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HotspotDirectory) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *HotspotDirectory) GetFiles() int32 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *HotspotDirectory) GetFailedTasks() int32 {
+	if x != nil {
+		return x.FailedTasks
+	}
+	return 0
+}
+
+func (x *HotspotDirectory) GetMergeFailures() int32 {
+	if x != nil {
+		return x.MergeFailures
+	}
+	return 0
+}
+
+func (x *HotspotDirectory) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *HotspotDirectory) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+// IntegrationEvents is the per-integration event-bitmask. Mirrors the
+// daemon-side `models.EventBitmask` shape. Each integration carries its
+// own copy so the user can fan TASK_FAILED to Slack but RUN_COMPLETE to
+// Discord without duplicating endpoints.
+type IntegrationEvents struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskFailed      bool                   `protobuf:"varint,1,opt,name=task_failed,json=taskFailed,proto3" json:"task_failed,omitempty"`
+	RunComplete     bool                   `protobuf:"varint,2,opt,name=run_complete,json=runComplete,proto3" json:"run_complete,omitempty"`
+	WeeklyDigest    bool                   `protobuf:"varint,3,opt,name=weekly_digest,json=weeklyDigest,proto3" json:"weekly_digest,omitempty"`
+	BudgetThreshold bool                   `protobuf:"varint,4,opt,name=budget_threshold,json=budgetThreshold,proto3" json:"budget_threshold,omitempty"`
+	Events          map[string]bool        `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Every later event by key ("task_succeeded", "pr_opened", …)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IntegrationEvents) Reset() {
+	*x = IntegrationEvents{}
+	mi := &file_proto_watchfire_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationEvents) ProtoMessage() {}
+
+func (x *IntegrationEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationEvents.ProtoReflect.Descriptor instead.
+func (*IntegrationEvents) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *IntegrationEvents) GetTaskFailed() bool {
+	if x != nil {
+		return x.TaskFailed
+	}
+	return false
+}
+
+func (x *IntegrationEvents) GetRunComplete() bool {
+	if x != nil {
+		return x.RunComplete
+	}
+	return false
+}
+
+func (x *IntegrationEvents) GetWeeklyDigest() bool {
+	if x != nil {
+		return x.WeeklyDigest
+	}
+	return false
+}
+
+func (x *IntegrationEvents) GetBudgetThreshold() bool {
+	if x != nil {
+		return x.BudgetThreshold
+	}
+	return false
+}
+
+func (x *IntegrationEvents) GetEvents() map[string]bool {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// WebhookIntegration is a single generic outbound webhook target. The
+// `secret` field is write-only — Save accepts it (and pushes it to the
+// keyring); List + the response of Save scrub it (returning only
+// `secret_set: bool`).
+type WebhookIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"`     // Masked, display-only ("***hooks.example.com/...")
+	SecretSet      bool                   `protobuf:"varint,5,opt,name=secret_set,json=secretSet,proto3" json:"secret_set,omitempty"` // True if the keyring carries a secret
+	Secret         string                 `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`                         // Write-only — never returned by List
+	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,7,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,8,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookIntegration) Reset() {
+	*x = WebhookIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookIntegration) ProtoMessage() {}
+
+func (x *WebhookIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookIntegration.ProtoReflect.Descriptor instead.
+func (*WebhookIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *WebhookIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WebhookIntegration) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookIntegration) GetUrlLabel() string {
+	if x != nil {
+		return x.UrlLabel
+	}
+	return ""
+}
+
+func (x *WebhookIntegration) GetSecretSet() bool {
+	if x != nil {
+		return x.SecretSet
+	}
+	return false
+}
+
+func (x *WebhookIntegration) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *WebhookIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// SlackIntegration targets a Slack incoming webhook. The URL itself is
+// the secret (Slack's incoming-webhook auth model), so it is write-only
+// — Save accepts it; List returns only the masked `url_label`.
+type SlackIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // Write-only — never returned by List
+	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"` // Masked, display-only
+	UrlSet         bool                   `protobuf:"varint,5,opt,name=url_set,json=urlSet,proto3" json:"url_set,omitempty"`      // True if the keyring carries the URL
+	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,6,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,7,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SlackIntegration) Reset() {
+	*x = SlackIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlackIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackIntegration) ProtoMessage() {}
+
+func (x *SlackIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackIntegration.ProtoReflect.Descriptor instead.
+func (*SlackIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SlackIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SlackIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SlackIntegration) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SlackIntegration) GetUrlLabel() string {
+	if x != nil {
+		return x.UrlLabel
+	}
+	return ""
+}
+
+func (x *SlackIntegration) GetUrlSet() bool {
+	if x != nil {
+		return x.UrlSet
+	}
+	return false
+}
+
+func (x *SlackIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *SlackIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// DiscordIntegration mirrors SlackIntegration exactly — Discord's
+// webhook auth model is the same (URL-as-secret).
+type DiscordIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // Write-only — never returned by List
+	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"` // Masked, display-only
+	UrlSet         bool                   `protobuf:"varint,5,opt,name=url_set,json=urlSet,proto3" json:"url_set,omitempty"`      // True if the keyring carries the URL
+	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,6,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,7,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DiscordIntegration) Reset() {
+	*x = DiscordIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscordIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscordIntegration) ProtoMessage() {}
+
+func (x *DiscordIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscordIntegration.ProtoReflect.Descriptor instead.
+func (*DiscordIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DiscordIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiscordIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DiscordIntegration) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DiscordIntegration) GetUrlLabel() string {
+	if x != nil {
+		return x.UrlLabel
+	}
+	return ""
+}
+
+func (x *DiscordIntegration) GetUrlSet() bool {
+	if x != nil {
+		return x.UrlSet
+	}
+	return false
+}
+
+func (x *DiscordIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *DiscordIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// TeamsIntegration is a Microsoft Teams incoming webhook (Workflows or
+// legacy connector URL). Posts Adaptive Cards.
+type TeamsIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // Write-only — never returned by List
+	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"` // Masked, display-only
+	UrlSet         bool                   `protobuf:"varint,5,opt,name=url_set,json=urlSet,proto3" json:"url_set,omitempty"`      // True if the keyring carries the URL
+	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,6,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,7,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TeamsIntegration) Reset() {
+	*x = TeamsIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamsIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamsIntegration) ProtoMessage() {}
+
+func (x *TeamsIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamsIntegration.ProtoReflect.Descriptor instead.
+func (*TeamsIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *TeamsIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeamsIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TeamsIntegration) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TeamsIntegration) GetUrlLabel() string {
+	if x != nil {
+		return x.UrlLabel
+	}
+	return ""
+}
+
+func (x *TeamsIntegration) GetUrlSet() bool {
+	if x != nil {
+		return x.UrlSet
+	}
+	return false
+}
+
+func (x *TeamsIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *TeamsIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// MatrixIntegration posts m.notice events into one Matrix room as the
+// account owning access_token.
+type MatrixIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	HomeserverUrl  string                 `protobuf:"bytes,3,opt,name=homeserver_url,json=homeserverUrl,proto3" json:"homeserver_url,omitempty"` // e.g. https://matrix.example.org
+	RoomId         string                 `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`                      // e.g. !abcdef:example.org
+	AccessToken    string                 `protobuf:"bytes,5,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`       // Write-only — never returned by List
+	TokenSet       bool                   `protobuf:"varint,6,opt,name=token_set,json=tokenSet,proto3" json:"token_set,omitempty"`               // True if the keyring carries the token
+	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,7,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,8,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MatrixIntegration) Reset() {
+	*x = MatrixIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixIntegration) ProtoMessage() {}
+
+func (x *MatrixIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixIntegration.ProtoReflect.Descriptor instead.
+func (*MatrixIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MatrixIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MatrixIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MatrixIntegration) GetHomeserverUrl() string {
+	if x != nil {
+		return x.HomeserverUrl
+	}
+	return ""
+}
+
+func (x *MatrixIntegration) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *MatrixIntegration) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *MatrixIntegration) GetTokenSet() bool {
+	if x != nil {
+		return x.TokenSet
+	}
+	return false
+}
+
+func (x *MatrixIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *MatrixIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// NtfyIntegration publishes to one ntfy topic. The topic URL is not a
+// secret; protected topics add an access token.
+type NtfyIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                            // Full topic URL, e.g. https://ntfy.sh/alerts
+	Token          string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`                        // Write-only, optional — never returned by List
+	TokenSet       bool                   `protobuf:"varint,5,opt,name=token_set,json=tokenSet,proto3" json:"token_set,omitempty"` // True if the keyring carries a token
+	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,6,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,7,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NtfyIntegration) Reset() {
+	*x = NtfyIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NtfyIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NtfyIntegration) ProtoMessage() {}
+
+func (x *NtfyIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NtfyIntegration.ProtoReflect.Descriptor instead.
+func (*NtfyIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *NtfyIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NtfyIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NtfyIntegration) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NtfyIntegration) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NtfyIntegration) GetTokenSet() bool {
+	if x != nil {
+		return x.TokenSet
+	}
+	return false
+}
+
+func (x *NtfyIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *NtfyIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// EmailRecipient is one address on an EmailIntegration with its own
+// event selection.
+type EmailRecipient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	EnabledEvents *IntegrationEvents     `protobuf:"bytes,2,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailRecipient) Reset() {
+	*x = EmailRecipient{}
+	mi := &file_proto_watchfire_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailRecipient) ProtoMessage() {}
+
+func (x *EmailRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailRecipient.ProtoReflect.Descriptor instead.
+func (*EmailRecipient) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *EmailRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EmailRecipient) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+// EmailIntegration delivers notifications over SMTP. Events are selected
+// per recipient, so the integration itself carries no event bitmask. The
+// password follows the write-only secret convention.
+type EmailIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Host           string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port           int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`                                  // 0 = default for the security mode (587 / 465)
+	Security       string                 `protobuf:"bytes,5,opt,name=security,proto3" json:"security,omitempty"`                           // "starttls" (default), "tls" or "none"
+	Username       string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`                           // Empty = no AUTH
+	Password       string                 `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`                           // Write-only — never returned by List
+	PasswordSet    bool                   `protobuf:"varint,8,opt,name=password_set,json=passwordSet,proto3" json:"password_set,omitempty"` // True if the keyring carries a password
+	From           string                 `protobuf:"bytes,9,opt,name=from,proto3" json:"from,omitempty"`
+	Recipients     []*EmailRecipient      `protobuf:"bytes,10,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	ProjectMuteIds []string               `protobuf:"bytes,11,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EmailIntegration) Reset() {
+	*x = EmailIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailIntegration) ProtoMessage() {}
+
+func (x *EmailIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailIntegration.ProtoReflect.Descriptor instead.
+func (*EmailIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *EmailIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *EmailIntegration) GetSecurity() string {
+	if x != nil {
+		return x.Security
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetPasswordSet() bool {
+	if x != nil {
+		return x.PasswordSet
+	}
+	return false
+}
+
+func (x *EmailIntegration) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EmailIntegration) GetRecipients() []*EmailRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *EmailIntegration) GetProjectMuteIds() []string {
+	if x != nil {
+		return x.ProjectMuteIds
+	}
+	return nil
+}
+
+// GitHubIntegration is the single-instance GitHub auto-PR config. No
+// URL field — relies on `gh` CLI auth.
+type GitHubIntegration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DraftDefault  bool                   `protobuf:"varint,2,opt,name=draft_default,json=draftDefault,proto3" json:"draft_default,omitempty"`
+	ProjectScopes []string               `protobuf:"bytes,3,rep,name=project_scopes,json=projectScopes,proto3" json:"project_scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubIntegration) Reset() {
+	*x = GitHubIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubIntegration) ProtoMessage() {}
+
+func (x *GitHubIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubIntegration.ProtoReflect.Descriptor instead.
+func (*GitHubIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GitHubIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GitHubIntegration) GetDraftDefault() bool {
+	if x != nil {
+		return x.DraftDefault
+	}
+	return false
+}
+
+func (x *GitHubIntegration) GetProjectScopes() []string {
+	if x != nil {
+		return x.ProjectScopes
+	}
+	return nil
+}
+
+// TelegramPairedChatInfo is one paired Telegram chat as surfaced to the
+// settings UIs. `username` is display-only — authorization is by chat_id
+// membership in the paired list, never by username.
+type TelegramPairedChatInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ChatId           int64                  `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // Display only, never trusted for auth
+	PairedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=paired_at,json=pairedAt,proto3" json:"paired_at,omitempty"`
+	DefaultProjectId string                 `protobuf:"bytes,4,opt,name=default_project_id,json=defaultProjectId,proto3" json:"default_project_id,omitempty"` // Project selected via /use, persisted per chat
+	Muted            bool                   `protobuf:"varint,5,opt,name=muted,proto3" json:"muted,omitempty"`                                                // Outbound events paused for this chat
+	Watch            bool                   `protobuf:"varint,6,opt,name=watch,proto3" json:"watch,omitempty"`                                                // Live conversation relay enabled
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TelegramPairedChatInfo) Reset() {
+	*x = TelegramPairedChatInfo{}
+	mi := &file_proto_watchfire_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelegramPairedChatInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramPairedChatInfo) ProtoMessage() {}
+
+func (x *TelegramPairedChatInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramPairedChatInfo.ProtoReflect.Descriptor instead.
+func (*TelegramPairedChatInfo) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *TelegramPairedChatInfo) GetChatId() int64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *TelegramPairedChatInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TelegramPairedChatInfo) GetPairedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PairedAt
+	}
+	return nil
+}
+
+func (x *TelegramPairedChatInfo) GetDefaultProjectId() string {
+	if x != nil {
+		return x.DefaultProjectId
+	}
+	return ""
+}
+
+func (x *TelegramPairedChatInfo) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *TelegramPairedChatInfo) GetWatch() bool {
+	if x != nil {
+		return x.Watch
+	}
+	return false
+}
+
+// TelegramIntegration is the single-instance Telegram bridge config
+// (v10.0 Torch). The bot token follows the write-only secret convention:
+// Save accepts `bot_token` (pushes it to the keyring); List + the Save
+// response return only `token_set`. An empty `bot_token` on update means
+// "keep the stored token".
+type TelegramIntegration struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Enabled       bool                      `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BotToken      string                    `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`  // Write-only — never returned by List
+	TokenSet      bool                      `protobuf:"varint,3,opt,name=token_set,json=tokenSet,proto3" json:"token_set,omitempty"` // True if the keyring carries a token
+	EnabledEvents *IntegrationEvents        `protobuf:"bytes,4,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
+	PairedChats   []*TelegramPairedChatInfo `protobuf:"bytes,5,rep,name=paired_chats,json=pairedChats,proto3" json:"paired_chats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TelegramIntegration) Reset() {
+	*x = TelegramIntegration{}
+	mi := &file_proto_watchfire_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelegramIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramIntegration) ProtoMessage() {}
+
+func (x *TelegramIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramIntegration.ProtoReflect.Descriptor instead.
+func (*TelegramIntegration) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *TelegramIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TelegramIntegration) GetBotToken() string {
+	if x != nil {
+		return x.BotToken
+	}
+	return ""
+}
+
+func (x *TelegramIntegration) GetTokenSet() bool {
+	if x != nil {
+		return x.TokenSet
+	}
+	return false
+}
+
+func (x *TelegramIntegration) GetEnabledEvents() *IntegrationEvents {
+	if x != nil {
+		return x.EnabledEvents
+	}
+	return nil
+}
+
+func (x *TelegramIntegration) GetPairedChats() []*TelegramPairedChatInfo {
+	if x != nil {
+		return x.PairedChats
+	}
+	return nil
+}
+
+// IntegrationsConfig is the root document the IntegrationsService
+// returns from List + as the response to Save / Delete.
+type IntegrationsConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*WebhookIntegration  `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Slack         []*SlackIntegration    `protobuf:"bytes,2,rep,name=slack,proto3" json:"slack,omitempty"`
+	Discord       []*DiscordIntegration  `protobuf:"bytes,3,rep,name=discord,proto3" json:"discord,omitempty"`
+	Github        *GitHubIntegration     `protobuf:"bytes,4,opt,name=github,proto3" json:"github,omitempty"`
+	Telegram      *TelegramIntegration   `protobuf:"bytes,5,opt,name=telegram,proto3" json:"telegram,omitempty"` // v10.0 Torch — unset when unconfigured
+	Teams         []*TeamsIntegration    `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	Matrix        []*MatrixIntegration   `protobuf:"bytes,7,rep,name=matrix,proto3" json:"matrix,omitempty"`
+	Ntfy          []*NtfyIntegration     `protobuf:"bytes,8,rep,name=ntfy,proto3" json:"ntfy,omitempty"`
+	Email         []*EmailIntegration    `protobuf:"bytes,9,rep,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsConfig) Reset() {
+	*x = IntegrationsConfig{}
+	mi := &file_proto_watchfire_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsConfig) ProtoMessage() {}
+
+func (x *IntegrationsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsConfig.ProtoReflect.Descriptor instead.
+func (*IntegrationsConfig) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *IntegrationsConfig) GetWebhooks() []*WebhookIntegration {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetSlack() []*SlackIntegration {
+	if x != nil {
+		return x.Slack
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetDiscord() []*DiscordIntegration {
+	if x != nil {
+		return x.Discord
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetGithub() *GitHubIntegration {
+	if x != nil {
+		return x.Github
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetTelegram() *TelegramIntegration {
+	if x != nil {
+		return x.Telegram
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetTeams() []*TeamsIntegration {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetMatrix() []*MatrixIntegration {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetNtfy() []*NtfyIntegration {
+	if x != nil {
+		return x.Ntfy
+	}
+	return nil
+}
+
+func (x *IntegrationsConfig) GetEmail() []*EmailIntegration {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+type ListIntegrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationsRequest) Reset() {
+	*x = ListIntegrationsRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationsRequest) ProtoMessage() {}
+
+func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListIntegrationsRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// SaveIntegrationRequest is the unified create + update wire shape. The
+// `payload` oneof carries the integration-specific body; the server
+// derives create-vs-update by checking whether the ID already exists in
+// the YAML.
+type SaveIntegrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SaveIntegrationRequest_Webhook
+	//	*SaveIntegrationRequest_Slack
+	//	*SaveIntegrationRequest_Discord
+	//	*SaveIntegrationRequest_Github
+	//	*SaveIntegrationRequest_Telegram
+	//	*SaveIntegrationRequest_Teams
+	//	*SaveIntegrationRequest_Matrix
+	//	*SaveIntegrationRequest_Ntfy
+	//	*SaveIntegrationRequest_Email
+	Payload       isSaveIntegrationRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveIntegrationRequest) Reset() {
+	*x = SaveIntegrationRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveIntegrationRequest) ProtoMessage() {}
+
+func (x *SaveIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*SaveIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SaveIntegrationRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetPayload() isSaveIntegrationRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetWebhook() *WebhookIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Webhook); ok {
+			return x.Webhook
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetSlack() *SlackIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Slack); ok {
+			return x.Slack
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetDiscord() *DiscordIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Discord); ok {
+			return x.Discord
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetGithub() *GitHubIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Github); ok {
+			return x.Github
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetTelegram() *TelegramIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Telegram); ok {
+			return x.Telegram
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetTeams() *TeamsIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Teams); ok {
+			return x.Teams
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetMatrix() *MatrixIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Matrix); ok {
+			return x.Matrix
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetNtfy() *NtfyIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Ntfy); ok {
+			return x.Ntfy
+		}
+	}
+	return nil
+}
+
+func (x *SaveIntegrationRequest) GetEmail() *EmailIntegration {
+	if x != nil {
+		if x, ok := x.Payload.(*SaveIntegrationRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return nil
+}
+
+type isSaveIntegrationRequest_Payload interface {
+	isSaveIntegrationRequest_Payload()
+}
+
+type SaveIntegrationRequest_Webhook struct {
+	Webhook *WebhookIntegration `protobuf:"bytes,2,opt,name=webhook,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Slack struct {
+	Slack *SlackIntegration `protobuf:"bytes,3,opt,name=slack,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Discord struct {
+	Discord *DiscordIntegration `protobuf:"bytes,4,opt,name=discord,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Github struct {
+	Github *GitHubIntegration `protobuf:"bytes,5,opt,name=github,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Telegram struct {
+	Telegram *TelegramIntegration `protobuf:"bytes,6,opt,name=telegram,proto3,oneof"` // v10.0 Torch
+}
+
+type SaveIntegrationRequest_Teams struct {
+	Teams *TeamsIntegration `protobuf:"bytes,7,opt,name=teams,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Matrix struct {
+	Matrix *MatrixIntegration `protobuf:"bytes,8,opt,name=matrix,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Ntfy struct {
+	Ntfy *NtfyIntegration `protobuf:"bytes,9,opt,name=ntfy,proto3,oneof"`
+}
+
+type SaveIntegrationRequest_Email struct {
+	Email *EmailIntegration `protobuf:"bytes,10,opt,name=email,proto3,oneof"`
+}
+
+func (*SaveIntegrationRequest_Webhook) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Slack) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Discord) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Github) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Telegram) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Teams) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Matrix) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Ntfy) isSaveIntegrationRequest_Payload() {}
+
+func (*SaveIntegrationRequest_Email) isSaveIntegrationRequest_Payload() {}
+
+// DeleteIntegrationRequest names the integration to delete by kind + id.
+// GitHub is single-instance; passing GITHUB resets it to the zero value.
+type DeleteIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Kind          IntegrationKind        `protobuf:"varint,2,opt,name=kind,proto3,enum=watchfire.IntegrationKind" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"` // Ignored when kind = GITHUB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIntegrationRequest) Reset() {
+	*x = DeleteIntegrationRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIntegrationRequest) ProtoMessage() {}
+
+func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *DeleteIntegrationRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteIntegrationRequest) GetKind() IntegrationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IntegrationKind_WEBHOOK
+}
+
+func (x *DeleteIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TestIntegrationRequest fires a synthetic notification through the
+// dispatcher to the named integration. Used by the GUI / TUI's "Test"
+// button to verify plumbing end-to-end.
+type TestIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Kind          IntegrationKind        `protobuf:"varint,2,opt,name=kind,proto3,enum=watchfire.IntegrationKind" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestIntegrationRequest) Reset() {
+	*x = TestIntegrationRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestIntegrationRequest) ProtoMessage() {}
 
-func (x *SlackIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[87]
+func (x *TestIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7162,90 +11585,131 @@ func (x *SlackIntegration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlackIntegration.ProtoReflect.Descriptor instead.
-func (*SlackIntegration) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use TestIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*TestIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *SlackIntegration) GetId() string {
+func (x *TestIntegrationRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *SlackIntegration) GetLabel() string {
+func (x *TestIntegrationRequest) GetKind() IntegrationKind {
 	if x != nil {
-		return x.Label
+		return x.Kind
 	}
-	return ""
+	return IntegrationKind_WEBHOOK
 }
 
-func (x *SlackIntegration) GetUrl() string {
+func (x *TestIntegrationRequest) GetId() string {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SlackIntegration) GetUrlLabel() string {
+type TestIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                          // Human-readable success / failure summary
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // HTTP status of the test send (0 if not applicable)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestIntegrationResponse) Reset() {
+	*x = TestIntegrationResponse{}
+	mi := &file_proto_watchfire_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestIntegrationResponse) ProtoMessage() {}
+
+func (x *TestIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[134]
 	if x != nil {
-		return x.UrlLabel
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SlackIntegration) GetUrlSet() bool {
+// Deprecated: Use TestIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*TestIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *TestIntegrationResponse) GetOk() bool {
 	if x != nil {
-		return x.UrlSet
+		return x.Ok
 	}
 	return false
 }
 
-func (x *SlackIntegration) GetEnabledEvents() *IntegrationEvents {
+func (x *TestIntegrationResponse) GetMessage() string {
 	if x != nil {
-		return x.EnabledEvents
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *SlackIntegration) GetProjectMuteIds() []string {
+func (x *TestIntegrationResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.ProjectMuteIds
+		return x.StatusCode
 	}
-	return nil
+	return 0
 }
 
-// DiscordIntegration mirrors SlackIntegration exactly — Discord's
-// webhook auth model is the same (URL-as-secret).
-type DiscordIntegration struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                           // Write-only — never returned by List
-	UrlLabel       string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"` // Masked, display-only
-	UrlSet         bool                   `protobuf:"varint,5,opt,name=url_set,json=urlSet,proto3" json:"url_set,omitempty"`      // True if the keyring carries the URL
-	EnabledEvents  *IntegrationEvents     `protobuf:"bytes,6,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
-	ProjectMuteIds []string               `protobuf:"bytes,7,rep,name=project_mute_ids,json=projectMuteIds,proto3" json:"project_mute_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// RelayDelivery is one record from the relay dispatcher's durable
+// outbox: a notification bound to one adapter that has failed at least
+// one delivery round. `dead` records exhausted their retries and wait in
+// the dead-letter store for a ReplayDelivery; the rest are still being
+// retried on the outbox backoff schedule.
+type RelayDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdapterId     string                 `protobuf:"bytes,2,opt,name=adapter_id,json=adapterId,proto3" json:"adapter_id,omitempty"`
+	AdapterKind   string                 `protobuf:"bytes,3,opt,name=adapter_kind,json=adapterKind,proto3" json:"adapter_kind,omitempty"` // webhook | slack | discord | telegram
+	Event         string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`                                // canonical kind, e.g. TASK_FAILED
+	ProjectId     string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName   string                 `protobuf:"bytes,6,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	TaskNumber    int32                  `protobuf:"varint,7,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`
+	Attempts      int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"` // failed delivery rounds so far
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // unset once dead
+	DeadAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=dead_at,json=deadAt,proto3" json:"dead_at,omitempty"`                        // set when dead
+	Dead          bool                   `protobuf:"varint,13,opt,name=dead,proto3" json:"dead,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiscordIntegration) Reset() {
-	*x = DiscordIntegration{}
-	mi := &file_proto_watchfire_proto_msgTypes[88]
+func (x *RelayDelivery) Reset() {
+	*x = RelayDelivery{}
+	mi := &file_proto_watchfire_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiscordIntegration) String() string {
+func (x *RelayDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscordIntegration) ProtoMessage() {}
+func (*RelayDelivery) ProtoMessage() {}
 
-func (x *DiscordIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[88]
+func (x *RelayDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7256,152 +11720,125 @@ func (x *DiscordIntegration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscordIntegration.ProtoReflect.Descriptor instead.
-func (*DiscordIntegration) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use RelayDelivery.ProtoReflect.Descriptor instead.
+func (*RelayDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *DiscordIntegration) GetId() string {
+func (x *RelayDelivery) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DiscordIntegration) GetLabel() string {
+func (x *RelayDelivery) GetAdapterId() string {
 	if x != nil {
-		return x.Label
+		return x.AdapterId
 	}
 	return ""
 }
 
-func (x *DiscordIntegration) GetUrl() string {
+func (x *RelayDelivery) GetAdapterKind() string {
 	if x != nil {
-		return x.Url
+		return x.AdapterKind
 	}
 	return ""
 }
 
-func (x *DiscordIntegration) GetUrlLabel() string {
+func (x *RelayDelivery) GetEvent() string {
 	if x != nil {
-		return x.UrlLabel
+		return x.Event
 	}
 	return ""
 }
 
-func (x *DiscordIntegration) GetUrlSet() bool {
+func (x *RelayDelivery) GetProjectId() string {
 	if x != nil {
-		return x.UrlSet
+		return x.ProjectId
 	}
-	return false
+	return ""
 }
 
-func (x *DiscordIntegration) GetEnabledEvents() *IntegrationEvents {
+func (x *RelayDelivery) GetProjectName() string {
 	if x != nil {
-		return x.EnabledEvents
+		return x.ProjectName
 	}
-	return nil
+	return ""
 }
 
-func (x *DiscordIntegration) GetProjectMuteIds() []string {
+func (x *RelayDelivery) GetTaskNumber() int32 {
 	if x != nil {
-		return x.ProjectMuteIds
+		return x.TaskNumber
 	}
-	return nil
-}
-
-// GitHubIntegration is the single-instance GitHub auto-PR config. No
-// URL field — relies on `gh` CLI auth.
-type GitHubIntegration struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	DraftDefault  bool                   `protobuf:"varint,2,opt,name=draft_default,json=draftDefault,proto3" json:"draft_default,omitempty"`
-	ProjectScopes []string               `protobuf:"bytes,3,rep,name=project_scopes,json=projectScopes,proto3" json:"project_scopes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GitHubIntegration) Reset() {
-	*x = GitHubIntegration{}
-	mi := &file_proto_watchfire_proto_msgTypes[89]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *GitHubIntegration) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RelayDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
-func (*GitHubIntegration) ProtoMessage() {}
-
-func (x *GitHubIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[89]
+func (x *RelayDelivery) GetLastError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastError
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GitHubIntegration.ProtoReflect.Descriptor instead.
-func (*GitHubIntegration) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{89}
+func (x *RelayDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GitHubIntegration) GetEnabled() bool {
+func (x *RelayDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Enabled
+		return x.NextAttemptAt
 	}
-	return false
+	return nil
 }
 
-func (x *GitHubIntegration) GetDraftDefault() bool {
+func (x *RelayDelivery) GetDeadAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DraftDefault
+		return x.DeadAt
 	}
-	return false
+	return nil
 }
 
-func (x *GitHubIntegration) GetProjectScopes() []string {
+func (x *RelayDelivery) GetDead() bool {
 	if x != nil {
-		return x.ProjectScopes
+		return x.Dead
 	}
-	return nil
+	return false
 }
 
-// TelegramPairedChatInfo is one paired Telegram chat as surfaced to the
-// settings UIs. `username` is display-only — authorization is by chat_id
-// membership in the paired list, never by username.
-type TelegramPairedChatInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ChatId           int64                  `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // Display only, never trusted for auth
-	PairedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=paired_at,json=pairedAt,proto3" json:"paired_at,omitempty"`
-	DefaultProjectId string                 `protobuf:"bytes,4,opt,name=default_project_id,json=defaultProjectId,proto3" json:"default_project_id,omitempty"` // Project selected via /use, persisted per chat
-	Muted            bool                   `protobuf:"varint,5,opt,name=muted,proto3" json:"muted,omitempty"`                                                // Outbound events paused for this chat
-	Watch            bool                   `protobuf:"varint,6,opt,name=watch,proto3" json:"watch,omitempty"`                                                // Live conversation relay enabled
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListFailedDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	IncludePending bool                   `protobuf:"varint,2,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"` // also list deliveries still being retried
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *TelegramPairedChatInfo) Reset() {
-	*x = TelegramPairedChatInfo{}
-	mi := &file_proto_watchfire_proto_msgTypes[90]
+func (x *ListFailedDeliveriesRequest) Reset() {
+	*x = ListFailedDeliveriesRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TelegramPairedChatInfo) String() string {
+func (x *ListFailedDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TelegramPairedChatInfo) ProtoMessage() {}
+func (*ListFailedDeliveriesRequest) ProtoMessage() {}
 
-func (x *TelegramPairedChatInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[90]
+func (x *ListFailedDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7412,84 +11849,47 @@ func (x *TelegramPairedChatInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TelegramPairedChatInfo.ProtoReflect.Descriptor instead.
-func (*TelegramPairedChatInfo) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{90}
-}
-
-func (x *TelegramPairedChatInfo) GetChatId() int64 {
-	if x != nil {
-		return x.ChatId
-	}
-	return 0
-}
-
-func (x *TelegramPairedChatInfo) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *TelegramPairedChatInfo) GetPairedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.PairedAt
-	}
-	return nil
-}
-
-func (x *TelegramPairedChatInfo) GetDefaultProjectId() string {
-	if x != nil {
-		return x.DefaultProjectId
-	}
-	return ""
-}
-
-func (x *TelegramPairedChatInfo) GetMuted() bool {
-	if x != nil {
-		return x.Muted
-	}
-	return false
+// Deprecated: Use ListFailedDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *TelegramPairedChatInfo) GetWatch() bool {
+func (x *ListFailedDeliveriesRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Watch
-	}
-	return false
-}
-
-// TelegramIntegration is the single-instance Telegram bridge config
-// (v10.0 Torch). The bot token follows the write-only secret convention:
-// Save accepts `bot_token` (pushes it to the keyring); List + the Save
-// response return only `token_set`. An empty `bot_token` on update means
-// "keep the stored token".
-type TelegramIntegration struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Enabled       bool                      `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	BotToken      string                    `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`  // Write-only — never returned by List
-	TokenSet      bool                      `protobuf:"varint,3,opt,name=token_set,json=tokenSet,proto3" json:"token_set,omitempty"` // True if the keyring carries a token
-	EnabledEvents *IntegrationEvents        `protobuf:"bytes,4,opt,name=enabled_events,json=enabledEvents,proto3" json:"enabled_events,omitempty"`
-	PairedChats   []*TelegramPairedChatInfo `protobuf:"bytes,5,rep,name=paired_chats,json=pairedChats,proto3" json:"paired_chats,omitempty"`
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListFailedDeliveriesRequest) GetIncludePending() bool {
+	if x != nil {
+		return x.IncludePending
+	}
+	return false
+}
+
+type ListFailedDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*RelayDelivery       `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TelegramIntegration) Reset() {
-	*x = TelegramIntegration{}
-	mi := &file_proto_watchfire_proto_msgTypes[91]
+func (x *ListFailedDeliveriesResponse) Reset() {
+	*x = ListFailedDeliveriesResponse{}
+	mi := &file_proto_watchfire_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TelegramIntegration) String() string {
+func (x *ListFailedDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TelegramIntegration) ProtoMessage() {}
+func (*ListFailedDeliveriesResponse) ProtoMessage() {}
 
-func (x *TelegramIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[91]
+func (x *ListFailedDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7500,74 +11900,101 @@ func (x *TelegramIntegration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TelegramIntegration.ProtoReflect.Descriptor instead.
-func (*TelegramIntegration) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use ListFailedDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListFailedDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *TelegramIntegration) GetEnabled() bool {
+func (x *ListFailedDeliveriesResponse) GetDeliveries() []*RelayDelivery {
 	if x != nil {
-		return x.Enabled
+		return x.Deliveries
 	}
-	return false
+	return nil
 }
 
-func (x *TelegramIntegration) GetBotToken() string {
-	if x != nil {
-		return x.BotToken
-	}
-	return ""
+type ReplayDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TelegramIntegration) GetTokenSet() bool {
+func (x *ReplayDeliveryRequest) Reset() {
+	*x = ReplayDeliveryRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeliveryRequest) ProtoMessage() {}
+
+func (x *ReplayDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[138]
 	if x != nil {
-		return x.TokenSet
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *TelegramIntegration) GetEnabledEvents() *IntegrationEvents {
+// Deprecated: Use ReplayDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ReplayDeliveryRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.EnabledEvents
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *TelegramIntegration) GetPairedChats() []*TelegramPairedChatInfo {
+func (x *ReplayDeliveryRequest) GetId() string {
 	if x != nil {
-		return x.PairedChats
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// IntegrationsConfig is the root document the IntegrationsService
-// returns from List + as the response to Save / Delete.
-type IntegrationsConfig struct {
+// PreviewTemplateRequest renders a relay message template against the
+// sample payload for one event. With `source` empty the template the
+// endpoint currently uses is rendered; with `save` set a `source` that
+// validates is written as the override and the dispatcher reloads.
+type PreviewTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Webhooks      []*WebhookIntegration  `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
-	Slack         []*SlackIntegration    `protobuf:"bytes,2,rep,name=slack,proto3" json:"slack,omitempty"`
-	Discord       []*DiscordIntegration  `protobuf:"bytes,3,rep,name=discord,proto3" json:"discord,omitempty"`
-	Github        *GitHubIntegration     `protobuf:"bytes,4,opt,name=github,proto3" json:"github,omitempty"`
-	Telegram      *TelegramIntegration   `protobuf:"bytes,5,opt,name=telegram,proto3" json:"telegram,omitempty"` // v10.0 Torch — unset when unconfigured
+	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`                         // slack | discord | teams | matrix | ntfy
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                             // event key, e.g. task_failed
+	EndpointId    string                 `protobuf:"bytes,4,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"` // empty = every endpoint of the channel
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                           // template to preview; empty = the one in effect
+	Save          bool                   `protobuf:"varint,6,opt,name=save,proto3" json:"save,omitempty"`                              // write `source` as the override once it validates
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntegrationsConfig) Reset() {
-	*x = IntegrationsConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[92]
+func (x *PreviewTemplateRequest) Reset() {
+	*x = PreviewTemplateRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntegrationsConfig) String() string {
+func (x *PreviewTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegrationsConfig) ProtoMessage() {}
+func (*PreviewTemplateRequest) ProtoMessage() {}
 
-func (x *IntegrationsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[92]
+func (x *PreviewTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7578,68 +12005,81 @@ func (x *IntegrationsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegrationsConfig.ProtoReflect.Descriptor instead.
-func (*IntegrationsConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use PreviewTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *IntegrationsConfig) GetWebhooks() []*WebhookIntegration {
+func (x *PreviewTemplateRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.Webhooks
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *IntegrationsConfig) GetSlack() []*SlackIntegration {
+func (x *PreviewTemplateRequest) GetChannel() string {
 	if x != nil {
-		return x.Slack
+		return x.Channel
 	}
-	return nil
+	return ""
 }
 
-func (x *IntegrationsConfig) GetDiscord() []*DiscordIntegration {
+func (x *PreviewTemplateRequest) GetEvent() string {
 	if x != nil {
-		return x.Discord
+		return x.Event
 	}
-	return nil
+	return ""
 }
 
-func (x *IntegrationsConfig) GetGithub() *GitHubIntegration {
+func (x *PreviewTemplateRequest) GetEndpointId() string {
 	if x != nil {
-		return x.Github
+		return x.EndpointId
 	}
-	return nil
+	return ""
 }
 
-func (x *IntegrationsConfig) GetTelegram() *TelegramIntegration {
+func (x *PreviewTemplateRequest) GetSource() string {
 	if x != nil {
-		return x.Telegram
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-type ListIntegrationsRequest struct {
+func (x *PreviewTemplateRequest) GetSave() bool {
+	if x != nil {
+		return x.Save
+	}
+	return false
+}
+
+type PreviewTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`            // false when the template failed validation
+	Rendered      string                 `protobuf:"bytes,2,opt,name=rendered,proto3" json:"rendered,omitempty"` // rendered sample payload (JSON)
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`       // parse / render / JSON error when !ok
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`     // the template that was rendered
+	Origin        string                 `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`     // endpoint | global | builtin | request
+	Path          string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`         // override file read, or written when saved
+	Saved         bool                   `protobuf:"varint,7,opt,name=saved,proto3" json:"saved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIntegrationsRequest) Reset() {
-	*x = ListIntegrationsRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[93]
+func (x *PreviewTemplateResponse) Reset() {
+	*x = PreviewTemplateResponse{}
+	mi := &file_proto_watchfire_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIntegrationsRequest) String() string {
+func (x *PreviewTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegrationsRequest) ProtoMessage() {}
+func (*PreviewTemplateResponse) ProtoMessage() {}
 
-func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[93]
+func (x *PreviewTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7650,52 +12090,93 @@ func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
-func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use PreviewTemplateResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *ListIntegrationsRequest) GetMeta() *RequestMeta {
+func (x *PreviewTemplateResponse) GetOk() bool {
 	if x != nil {
-		return x.Meta
+		return x.Ok
 	}
-	return nil
+	return false
 }
 
-// SaveIntegrationRequest is the unified create + update wire shape. The
-// `payload` oneof carries the integration-specific body; the server
-// derives create-vs-update by checking whether the ID already exists in
-// the YAML.
-type SaveIntegrationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Meta  *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*SaveIntegrationRequest_Webhook
-	//	*SaveIntegrationRequest_Slack
-	//	*SaveIntegrationRequest_Discord
-	//	*SaveIntegrationRequest_Github
-	//	*SaveIntegrationRequest_Telegram
-	Payload       isSaveIntegrationRequest_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PreviewTemplateResponse) GetRendered() string {
+	if x != nil {
+		return x.Rendered
+	}
+	return ""
 }
 
-func (x *SaveIntegrationRequest) Reset() {
-	*x = SaveIntegrationRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[94]
+func (x *PreviewTemplateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
+}
+
+// TestRouteRequest describes a notification to dry-run through the
+// routing rules in integrations.yaml. The project (id or name) and task
+// number are resolved like a live notification; the remaining fields
+// override what was resolved.
+type TestRouteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Meta            *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Event           string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`     // event key, e.g. task_failed
+	Project         string                 `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"` // project id or name
+	TaskNumber      int32                  `protobuf:"varint,4,opt,name=task_number,json=taskNumber,proto3" json:"task_number,omitempty"`
+	Agent           string                 `protobuf:"bytes,5,opt,name=agent,proto3" json:"agent,omitempty"` // agent backend, e.g. codex
+	TaskTitle       string                 `protobuf:"bytes,6,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	FailureReason   string                 `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TestRouteRequest) Reset() {
+	*x = TestRouteRequest{}
+	mi := &file_proto_watchfire_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveIntegrationRequest) String() string {
+func (x *TestRouteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveIntegrationRequest) ProtoMessage() {}
+func (*TestRouteRequest) ProtoMessage() {}
 
-func (x *SaveIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[94]
+func (x *TestRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7706,130 +12187,93 @@ func (x *SaveIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*SaveIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use TestRouteRequest.ProtoReflect.Descriptor instead.
+func (*TestRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *SaveIntegrationRequest) GetMeta() *RequestMeta {
+func (x *TestRouteRequest) GetMeta() *RequestMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *SaveIntegrationRequest) GetPayload() isSaveIntegrationRequest_Payload {
+func (x *TestRouteRequest) GetEvent() string {
 	if x != nil {
-		return x.Payload
+		return x.Event
 	}
-	return nil
+	return ""
 }
 
-func (x *SaveIntegrationRequest) GetWebhook() *WebhookIntegration {
+func (x *TestRouteRequest) GetProject() string {
 	if x != nil {
-		if x, ok := x.Payload.(*SaveIntegrationRequest_Webhook); ok {
-			return x.Webhook
-		}
+		return x.Project
 	}
-	return nil
+	return ""
 }
 
-func (x *SaveIntegrationRequest) GetSlack() *SlackIntegration {
+func (x *TestRouteRequest) GetTaskNumber() int32 {
 	if x != nil {
-		if x, ok := x.Payload.(*SaveIntegrationRequest_Slack); ok {
-			return x.Slack
-		}
+		return x.TaskNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *SaveIntegrationRequest) GetDiscord() *DiscordIntegration {
+func (x *TestRouteRequest) GetAgent() string {
 	if x != nil {
-		if x, ok := x.Payload.(*SaveIntegrationRequest_Discord); ok {
-			return x.Discord
-		}
+		return x.Agent
 	}
-	return nil
+	return ""
 }
 
-func (x *SaveIntegrationRequest) GetGithub() *GitHubIntegration {
+func (x *TestRouteRequest) GetTaskTitle() string {
 	if x != nil {
-		if x, ok := x.Payload.(*SaveIntegrationRequest_Github); ok {
-			return x.Github
-		}
+		return x.TaskTitle
 	}
-	return nil
+	return ""
 }
 
-func (x *SaveIntegrationRequest) GetTelegram() *TelegramIntegration {
+func (x *TestRouteRequest) GetFailureReason() string {
 	if x != nil {
-		if x, ok := x.Payload.(*SaveIntegrationRequest_Telegram); ok {
-			return x.Telegram
-		}
+		return x.FailureReason
 	}
-	return nil
-}
-
-type isSaveIntegrationRequest_Payload interface {
-	isSaveIntegrationRequest_Payload()
-}
-
-type SaveIntegrationRequest_Webhook struct {
-	Webhook *WebhookIntegration `protobuf:"bytes,2,opt,name=webhook,proto3,oneof"`
-}
-
-type SaveIntegrationRequest_Slack struct {
-	Slack *SlackIntegration `protobuf:"bytes,3,opt,name=slack,proto3,oneof"`
-}
-
-type SaveIntegrationRequest_Discord struct {
-	Discord *DiscordIntegration `protobuf:"bytes,4,opt,name=discord,proto3,oneof"`
-}
-
-type SaveIntegrationRequest_Github struct {
-	Github *GitHubIntegration `protobuf:"bytes,5,opt,name=github,proto3,oneof"`
-}
-
-type SaveIntegrationRequest_Telegram struct {
-	Telegram *TelegramIntegration `protobuf:"bytes,6,opt,name=telegram,proto3,oneof"` // v10.0 Torch
-}
-
-func (*SaveIntegrationRequest_Webhook) isSaveIntegrationRequest_Payload() {}
-
-func (*SaveIntegrationRequest_Slack) isSaveIntegrationRequest_Payload() {}
-
-func (*SaveIntegrationRequest_Discord) isSaveIntegrationRequest_Payload() {}
-
-func (*SaveIntegrationRequest_Github) isSaveIntegrationRequest_Payload() {}
+	return ""
+}
 
-func (*SaveIntegrationRequest_Telegram) isSaveIntegrationRequest_Payload() {}
+func (x *TestRouteRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
 
-// DeleteIntegrationRequest names the integration to delete by kind + id.
-// GitHub is single-instance; passing GITHUB resets it to the zero value.
-type DeleteIntegrationRequest struct {
+// RouteTarget is one delivery a routed notification would make.
+type RouteTarget struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Kind          IntegrationKind        `protobuf:"varint,2,opt,name=kind,proto3,enum=watchfire.IntegrationKind" json:"kind,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"` // Ignored when kind = GITHUB
+	AdapterId     string                 `protobuf:"bytes,1,opt,name=adapter_id,json=adapterId,proto3" json:"adapter_id,omitempty"`
+	AdapterKind   string                 `protobuf:"bytes,2,opt,name=adapter_kind,json=adapterKind,proto3" json:"adapter_kind,omitempty"`
+	ChatIds       []int64                `protobuf:"varint,3,rep,packed,name=chat_ids,json=chatIds,proto3" json:"chat_ids,omitempty"` // telegram only; empty = every paired chat
+	Rule          string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`                              // selecting rule; empty = default routing
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIntegrationRequest) Reset() {
-	*x = DeleteIntegrationRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[95]
+func (x *RouteTarget) Reset() {
+	*x = RouteTarget{}
+	mi := &file_proto_watchfire_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIntegrationRequest) String() string {
+func (x *RouteTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIntegrationRequest) ProtoMessage() {}
+func (*RouteTarget) ProtoMessage() {}
 
-func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[95]
+func (x *RouteTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7840,59 +12284,73 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use RouteTarget.ProtoReflect.Descriptor instead.
+func (*RouteTarget) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *DeleteIntegrationRequest) GetMeta() *RequestMeta {
+func (x *RouteTarget) GetAdapterId() string {
 	if x != nil {
-		return x.Meta
+		return x.AdapterId
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteIntegrationRequest) GetKind() IntegrationKind {
+func (x *RouteTarget) GetAdapterKind() string {
 	if x != nil {
-		return x.Kind
+		return x.AdapterKind
 	}
-	return IntegrationKind_WEBHOOK
+	return ""
 }
 
-func (x *DeleteIntegrationRequest) GetId() string {
+func (x *RouteTarget) GetChatIds() []int64 {
 	if x != nil {
-		return x.Id
+		return x.ChatIds
+	}
+	return nil
+}
+
+func (x *RouteTarget) GetRule() string {
+	if x != nil {
+		return x.Rule
 	}
 	return ""
 }
 
-// TestIntegrationRequest fires a synthetic notification through the
-// dispatcher to the named integration. Used by the GUI / TUI's "Test"
-// button to verify plumbing end-to-end.
-type TestIntegrationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMeta           `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Kind          IntegrationKind        `protobuf:"varint,2,opt,name=kind,proto3,enum=watchfire.IntegrationKind" json:"kind,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type TestRouteResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rules          []string               `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`                                          // matched rules, in evaluation order
+	DefaultRouting bool                   `protobuf:"varint,2,opt,name=default_routing,json=defaultRouting,proto3" json:"default_routing,omitempty"` // no rule matched, or one kept the default
+	Targets        []*RouteTarget         `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	Muted          []string               `protobuf:"bytes,4,rep,name=muted,proto3" json:"muted,omitempty"`     // adapters skipped because the project is muted
+	Unknown        []string               `protobuf:"bytes,5,rep,name=unknown,proto3" json:"unknown,omitempty"` // rule targets that are not configured
+	// The notification as resolved, so the user can see what the rules
+	// were matched against.
+	ProjectId       string `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName     string `protobuf:"bytes,7,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Agent           string `protobuf:"bytes,8,opt,name=agent,proto3" json:"agent,omitempty"`
+	TaskTitle       string `protobuf:"bytes,9,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	FailureReason   string `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	DurationSeconds int64  `protobuf:"varint,11,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *TestIntegrationRequest) Reset() {
-	*x = TestIntegrationRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[96]
+func (x *TestRouteResponse) Reset() {
+	*x = TestRouteResponse{}
+	mi := &file_proto_watchfire_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestIntegrationRequest) String() string {
+func (x *TestRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestIntegrationRequest) ProtoMessage() {}
+func (*TestRouteResponse) ProtoMessage() {}
 
-func (x *TestIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[96]
+func (x *TestRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_watchfire_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7903,88 +12361,84 @@ func (x *TestIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*TestIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use TestRouteResponse.ProtoReflect.Descriptor instead.
+func (*TestRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *TestIntegrationRequest) GetMeta() *RequestMeta {
+func (x *TestRouteResponse) GetRules() []string {
 	if x != nil {
-		return x.Meta
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *TestIntegrationRequest) GetKind() IntegrationKind {
+func (x *TestRouteResponse) GetDefaultRouting() bool {
 	if x != nil {
-		return x.Kind
+		return x.DefaultRouting
 	}
-	return IntegrationKind_WEBHOOK
+	return false
 }
 
-func (x *TestIntegrationRequest) GetId() string {
+func (x *TestRouteResponse) GetTargets() []*RouteTarget {
 	if x != nil {
-		return x.Id
+		return x.Targets
 	}
-	return ""
+	return nil
 }
 
-type TestIntegrationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                          // Human-readable success / failure summary
-	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // HTTP status of the test send (0 if not applicable)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TestRouteResponse) GetMuted() []string {
+	if x != nil {
+		return x.Muted
+	}
+	return nil
 }
 
-func (x *TestIntegrationResponse) Reset() {
-	*x = TestIntegrationResponse{}
-	mi := &file_proto_watchfire_proto_msgTypes[97]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TestRouteResponse) GetUnknown() []string {
+	if x != nil {
+		return x.Unknown
+	}
+	return nil
 }
 
-func (x *TestIntegrationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TestRouteResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
 }
 
-func (*TestIntegrationResponse) ProtoMessage() {}
-
-func (x *TestIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[97]
+func (x *TestRouteResponse) GetProjectName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProjectName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TestIntegrationResponse.ProtoReflect.Descriptor instead.
-func (*TestIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{97}
+func (x *TestRouteResponse) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
 }
 
-func (x *TestIntegrationResponse) GetOk() bool {
+func (x *TestRouteResponse) GetTaskTitle() string {
 	if x != nil {
-		return x.Ok
+		return x.TaskTitle
 	}
-	return false
+	return ""
 }
 
-func (x *TestIntegrationResponse) GetMessage() string {
+func (x *TestRouteResponse) GetFailureReason() string {
 	if x != nil {
-		return x.Message
+		return x.FailureReason
 	}
 	return ""
 }
 
-func (x *TestIntegrationResponse) GetStatusCode() int32 {
+func (x *TestRouteResponse) GetDurationSeconds() int64 {
 	if x != nil {
-		return x.StatusCode
+		return x.DurationSeconds
 	}
 	return 0
 }
@@ -7998,7 +12452,7 @@ type BeginTelegramPairingRequest struct {
 
 func (x *BeginTelegramPairingRequest) Reset() {
 	*x = BeginTelegramPairingRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[98]
+	mi := &file_proto_watchfire_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8010,7 +12464,7 @@ func (x *BeginTelegramPairingRequest) String() string {
 func (*BeginTelegramPairingRequest) ProtoMessage() {}
 
 func (x *BeginTelegramPairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[98]
+	mi := &file_proto_watchfire_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8023,7 +12477,7 @@ func (x *BeginTelegramPairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeginTelegramPairingRequest.ProtoReflect.Descriptor instead.
 func (*BeginTelegramPairingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{98}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *BeginTelegramPairingRequest) GetMeta() *RequestMeta {
@@ -8045,7 +12499,7 @@ type BeginTelegramPairingResponse struct {
 
 func (x *BeginTelegramPairingResponse) Reset() {
 	*x = BeginTelegramPairingResponse{}
-	mi := &file_proto_watchfire_proto_msgTypes[99]
+	mi := &file_proto_watchfire_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8057,7 +12511,7 @@ func (x *BeginTelegramPairingResponse) String() string {
 func (*BeginTelegramPairingResponse) ProtoMessage() {}
 
 func (x *BeginTelegramPairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[99]
+	mi := &file_proto_watchfire_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8070,7 +12524,7 @@ func (x *BeginTelegramPairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeginTelegramPairingResponse.ProtoReflect.Descriptor instead.
 func (*BeginTelegramPairingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{99}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *BeginTelegramPairingResponse) GetCode() string {
@@ -8110,7 +12564,7 @@ type GetTelegramPairingStatusRequest struct {
 
 func (x *GetTelegramPairingStatusRequest) Reset() {
 	*x = GetTelegramPairingStatusRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[100]
+	mi := &file_proto_watchfire_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8122,7 +12576,7 @@ func (x *GetTelegramPairingStatusRequest) String() string {
 func (*GetTelegramPairingStatusRequest) ProtoMessage() {}
 
 func (x *GetTelegramPairingStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[100]
+	mi := &file_proto_watchfire_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8135,7 +12589,7 @@ func (x *GetTelegramPairingStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTelegramPairingStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetTelegramPairingStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{100}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *GetTelegramPairingStatusRequest) GetMeta() *RequestMeta {
@@ -8158,7 +12612,7 @@ type TelegramPairingStatus struct {
 
 func (x *TelegramPairingStatus) Reset() {
 	*x = TelegramPairingStatus{}
-	mi := &file_proto_watchfire_proto_msgTypes[101]
+	mi := &file_proto_watchfire_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8170,7 +12624,7 @@ func (x *TelegramPairingStatus) String() string {
 func (*TelegramPairingStatus) ProtoMessage() {}
 
 func (x *TelegramPairingStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[101]
+	mi := &file_proto_watchfire_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8183,7 +12637,7 @@ func (x *TelegramPairingStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelegramPairingStatus.ProtoReflect.Descriptor instead.
 func (*TelegramPairingStatus) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{101}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *TelegramPairingStatus) GetState() TelegramPairingState {
@@ -8231,7 +12685,7 @@ type RevokeTelegramChatRequest struct {
 
 func (x *RevokeTelegramChatRequest) Reset() {
 	*x = RevokeTelegramChatRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[102]
+	mi := &file_proto_watchfire_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8243,7 +12697,7 @@ func (x *RevokeTelegramChatRequest) String() string {
 func (*RevokeTelegramChatRequest) ProtoMessage() {}
 
 func (x *RevokeTelegramChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[102]
+	mi := &file_proto_watchfire_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8256,7 +12710,7 @@ func (x *RevokeTelegramChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeTelegramChatRequest.ProtoReflect.Descriptor instead.
 func (*RevokeTelegramChatRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{102}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *RevokeTelegramChatRequest) GetMeta() *RequestMeta {
@@ -8286,7 +12740,7 @@ type BeginOAuthRequest struct {
 
 func (x *BeginOAuthRequest) Reset() {
 	*x = BeginOAuthRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[103]
+	mi := &file_proto_watchfire_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8298,7 +12752,7 @@ func (x *BeginOAuthRequest) String() string {
 func (*BeginOAuthRequest) ProtoMessage() {}
 
 func (x *BeginOAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[103]
+	mi := &file_proto_watchfire_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8311,7 +12765,7 @@ func (x *BeginOAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeginOAuthRequest.ProtoReflect.Descriptor instead.
 func (*BeginOAuthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{103}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *BeginOAuthRequest) GetMeta() *RequestMeta {
@@ -8351,7 +12805,7 @@ type BeginOAuthResponse struct {
 
 func (x *BeginOAuthResponse) Reset() {
 	*x = BeginOAuthResponse{}
-	mi := &file_proto_watchfire_proto_msgTypes[104]
+	mi := &file_proto_watchfire_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8363,7 +12817,7 @@ func (x *BeginOAuthResponse) String() string {
 func (*BeginOAuthResponse) ProtoMessage() {}
 
 func (x *BeginOAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[104]
+	mi := &file_proto_watchfire_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8376,7 +12830,7 @@ func (x *BeginOAuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeginOAuthResponse.ProtoReflect.Descriptor instead.
 func (*BeginOAuthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{104}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *BeginOAuthResponse) GetAuthorizeUrl() string {
@@ -8410,7 +12864,7 @@ type GetOAuthStatusRequest struct {
 
 func (x *GetOAuthStatusRequest) Reset() {
 	*x = GetOAuthStatusRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[105]
+	mi := &file_proto_watchfire_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8422,7 +12876,7 @@ func (x *GetOAuthStatusRequest) String() string {
 func (*GetOAuthStatusRequest) ProtoMessage() {}
 
 func (x *GetOAuthStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[105]
+	mi := &file_proto_watchfire_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8435,7 +12889,7 @@ func (x *GetOAuthStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetOAuthStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{105}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *GetOAuthStatusRequest) GetMeta() *RequestMeta {
@@ -8465,7 +12919,7 @@ type OAuthStatus struct {
 
 func (x *OAuthStatus) Reset() {
 	*x = OAuthStatus{}
-	mi := &file_proto_watchfire_proto_msgTypes[106]
+	mi := &file_proto_watchfire_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8477,7 +12931,7 @@ func (x *OAuthStatus) String() string {
 func (*OAuthStatus) ProtoMessage() {}
 
 func (x *OAuthStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[106]
+	mi := &file_proto_watchfire_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8490,7 +12944,7 @@ func (x *OAuthStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthStatus.ProtoReflect.Descriptor instead.
 func (*OAuthStatus) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{106}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *OAuthStatus) GetProvider() OAuthProvider {
@@ -8538,7 +12992,7 @@ type CancelOAuthRequest struct {
 
 func (x *CancelOAuthRequest) Reset() {
 	*x = CancelOAuthRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[107]
+	mi := &file_proto_watchfire_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8550,7 +13004,7 @@ func (x *CancelOAuthRequest) String() string {
 func (*CancelOAuthRequest) ProtoMessage() {}
 
 func (x *CancelOAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[107]
+	mi := &file_proto_watchfire_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8563,7 +13017,7 @@ func (x *CancelOAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOAuthRequest.ProtoReflect.Descriptor instead.
 func (*CancelOAuthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{107}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *CancelOAuthRequest) GetMeta() *RequestMeta {
@@ -8592,7 +13046,7 @@ type PostOAuthHelloRequest struct {
 
 func (x *PostOAuthHelloRequest) Reset() {
 	*x = PostOAuthHelloRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[108]
+	mi := &file_proto_watchfire_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8604,7 +13058,7 @@ func (x *PostOAuthHelloRequest) String() string {
 func (*PostOAuthHelloRequest) ProtoMessage() {}
 
 func (x *PostOAuthHelloRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[108]
+	mi := &file_proto_watchfire_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8617,7 +13071,7 @@ func (x *PostOAuthHelloRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostOAuthHelloRequest.ProtoReflect.Descriptor instead.
 func (*PostOAuthHelloRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{108}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *PostOAuthHelloRequest) GetMeta() *RequestMeta {
@@ -8658,7 +13112,7 @@ type PostOAuthHelloResponse struct {
 
 func (x *PostOAuthHelloResponse) Reset() {
 	*x = PostOAuthHelloResponse{}
-	mi := &file_proto_watchfire_proto_msgTypes[109]
+	mi := &file_proto_watchfire_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8670,7 +13124,7 @@ func (x *PostOAuthHelloResponse) String() string {
 func (*PostOAuthHelloResponse) ProtoMessage() {}
 
 func (x *PostOAuthHelloResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[109]
+	mi := &file_proto_watchfire_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8683,7 +13137,7 @@ func (x *PostOAuthHelloResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostOAuthHelloResponse.ProtoReflect.Descriptor instead.
 func (*PostOAuthHelloResponse) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{109}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *PostOAuthHelloResponse) GetOk() bool {
@@ -8764,7 +13218,7 @@ type InboundConfig struct {
 
 func (x *InboundConfig) Reset() {
 	*x = InboundConfig{}
-	mi := &file_proto_watchfire_proto_msgTypes[110]
+	mi := &file_proto_watchfire_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8776,7 +13230,7 @@ func (x *InboundConfig) String() string {
 func (*InboundConfig) ProtoMessage() {}
 
 func (x *InboundConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[110]
+	mi := &file_proto_watchfire_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8789,7 +13243,7 @@ func (x *InboundConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InboundConfig.ProtoReflect.Descriptor instead.
 func (*InboundConfig) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{110}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *InboundConfig) GetListenAddr() string {
@@ -9066,7 +13520,7 @@ type InboundStatus struct {
 
 func (x *InboundStatus) Reset() {
 	*x = InboundStatus{}
-	mi := &file_proto_watchfire_proto_msgTypes[111]
+	mi := &file_proto_watchfire_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9078,7 +13532,7 @@ func (x *InboundStatus) String() string {
 func (*InboundStatus) ProtoMessage() {}
 
 func (x *InboundStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[111]
+	mi := &file_proto_watchfire_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9091,7 +13545,7 @@ func (x *InboundStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InboundStatus.ProtoReflect.Descriptor instead.
 func (*InboundStatus) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{111}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *InboundStatus) GetListening() bool {
@@ -9187,7 +13641,7 @@ type GetInboundStatusRequest struct {
 
 func (x *GetInboundStatusRequest) Reset() {
 	*x = GetInboundStatusRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[112]
+	mi := &file_proto_watchfire_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9199,7 +13653,7 @@ func (x *GetInboundStatusRequest) String() string {
 func (*GetInboundStatusRequest) ProtoMessage() {}
 
 func (x *GetInboundStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[112]
+	mi := &file_proto_watchfire_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9212,7 +13666,7 @@ func (x *GetInboundStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInboundStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetInboundStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{112}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *GetInboundStatusRequest) GetMeta() *RequestMeta {
@@ -9232,7 +13686,7 @@ type SaveInboundConfigRequest struct {
 
 func (x *SaveInboundConfigRequest) Reset() {
 	*x = SaveInboundConfigRequest{}
-	mi := &file_proto_watchfire_proto_msgTypes[113]
+	mi := &file_proto_watchfire_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9244,7 +13698,7 @@ func (x *SaveInboundConfigRequest) String() string {
 func (*SaveInboundConfigRequest) ProtoMessage() {}
 
 func (x *SaveInboundConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[113]
+	mi := &file_proto_watchfire_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9257,7 +13711,7 @@ func (x *SaveInboundConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveInboundConfigRequest.ProtoReflect.Descriptor instead.
 func (*SaveInboundConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{113}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *SaveInboundConfigRequest) GetMeta() *RequestMeta {
@@ -9292,7 +13746,7 @@ type DiscordGuildRegistration struct {
 
 func (x *DiscordGuildRegistration) Reset() {
 	*x = DiscordGuildRegistration{}
-	mi := &file_proto_watchfire_proto_msgTypes[114]
+	mi := &file_proto_watchfire_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9304,7 +13758,7 @@ func (x *DiscordGuildRegistration) String() string {
 func (*DiscordGuildRegistration) ProtoMessage() {}
 
 func (x *DiscordGuildRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_watchfire_proto_msgTypes[114]
+	mi := &file_proto_watchfire_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9317,7 +13771,7 @@ func (x *DiscordGuildRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscordGuildRegistration.ProtoReflect.Descriptor instead.
 func (*DiscordGuildRegistration) Descriptor() ([]byte, []int) {
-	return file_proto_watchfire_proto_rawDescGZIP(), []int{114}
+	return file_proto_watchfire_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *DiscordGuildRegistration) GetGuildId() string {
@@ -9359,11 +13813,12 @@ var File_proto_watchfire_proto protoreflect.FileDescriptor
 
 const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/watchfire.proto\x12\twatchfire\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\\\n" +
+	"\x15proto/watchfire.proto\x12\twatchfire\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"p\n" +
 	"\vRequestMeta\x12\x16\n" +
 	"\x06origin\x18\x01 \x01(\tR\x06origin\x12\x1b\n" +
 	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\"\x8f\x06\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x12\n" +
+	"\x04user\x18\x04 \x01(\tR\x04user\"\x8f\x06\n" +
 	"\aProject\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
@@ -9467,7 +13922,7 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\bis_dirty\x18\x03 \x01(\bR\aisDirty\x12+\n" +
 	"\x11uncommitted_count\x18\x04 \x01(\x05R\x10uncommittedCount\x12\x14\n" +
 	"\x05ahead\x18\x05 \x01(\x05R\x05ahead\x12\x16\n" +
-	"\x06behind\x18\x06 \x01(\x05R\x06behind\"\xd2\x06\n" +
+	"\x06behind\x18\x06 \x01(\x05R\x06behind\"\x90\a\n" +
 	"\x04Task\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1f\n" +
 	"\vtask_number\x18\x02 \x01(\x05R\n" +
@@ -9493,7 +13948,11 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
 	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tdeletedAt\x88\x01\x01\x12\x14\n" +
 	"\x05agent\x18\x11 \x01(\tR\x05agent\x125\n" +
-	"\x14merge_failure_reason\x18\x12 \x01(\tH\x05R\x12mergeFailureReason\x88\x01\x01B\n" +
+	"\x14merge_failure_reason\x18\x12 \x01(\tH\x05R\x12mergeFailureReason\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"started_by\x18\x14 \x01(\tR\tstartedByB\n" +
 	"\n" +
 	"\b_successB\x11\n" +
 	"\x0f_failure_reasonB\r\n" +
@@ -9625,7 +14084,7 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartedAt\x88\x01\x01B\b\n" +
 	"\x06_issueB\r\n" +
-	"\v_started_at\"\xd5\x01\n" +
+	"\v_started_at\"\xfe\x01\n" +
 	"\x11StartAgentRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -9635,7 +14094,8 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"taskNumber\x12\x12\n" +
 	"\x04rows\x18\x05 \x01(\x05R\x04rows\x12\x12\n" +
 	"\x04cols\x18\x06 \x01(\x05R\x04cols\x12\x18\n" +
-	"\asandbox\x18\a \x01(\tR\asandbox\"\xcc\x01\n" +
+	"\asandbox\x18\a \x01(\tR\asandbox\x12'\n" +
+	"\x0foverride_budget\x18\b \x01(\bR\x0eoverrideBudget\"\xb4\x02\n" +
 	"\fScreenBuffer\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x14\n" +
@@ -9646,11 +14106,21 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"cursor_col\x18\x04 \x01(\x05R\tcursorCol\x12\x12\n" +
 	"\x04rows\x18\x05 \x01(\x05R\x04rows\x12\x12\n" +
 	"\x04cols\x18\x06 \x01(\x05R\x04cols\x12!\n" +
-	"\fansi_content\x18\a \x01(\tR\vansiContent\"c\n" +
+	"\fansi_content\x18\a \x01(\tR\vansiContent\x12\x10\n" +
+	"\x03seq\x18\b \x01(\x04R\x03seq\x12\x1a\n" +
+	"\bkeyframe\x18\t \x01(\bR\bkeyframe\x128\n" +
+	"\n" +
+	"row_deltas\x18\n" +
+	" \x03(\v2\x19.watchfire.ScreenRowDeltaR\trowDeltas\"J\n" +
+	"\x0eScreenRowDelta\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\x12\x12\n" +
+	"\x04ansi\x18\x03 \x01(\tR\x04ansi\"{\n" +
 	"\x16SubscribeScreenRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x02 \x01(\tR\tprojectId\"\x8c\x01\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x16\n" +
+	"\x06deltas\x18\x03 \x01(\bR\x06deltas\"\x8c\x01\n" +
 	"\x11ScrollbackRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -9680,7 +14150,7 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x0eRawOutputChunk\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data\"\xa6\x02\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xc0\x02\n" +
 	"\n" +
 	"AgentIssue\x12\x1d\n" +
 	"\n" +
@@ -9689,9 +14159,16 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"detectedAt\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12:\n" +
 	"\breset_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aresetAt\x88\x01\x01\x12F\n" +
-	"\x0ecooldown_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rcooldownUntil\x88\x01\x01B\v\n" +
+	"\x0ecooldown_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rcooldownUntil\x88\x01\x01\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptionsB\v\n" +
 	"\t_reset_atB\x11\n" +
-	"\x0f_cooldown_until\"h\n" +
+	"\x0f_cooldown_until\"\x92\x01\n" +
+	"\x15AnswerQuestionRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12\x16\n" +
+	"\x06option\x18\x04 \x01(\x05R\x06option\"h\n" +
 	"\x1bSubscribeAgentIssuesRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -9765,7 +14242,47 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x0fcheck_frequency\x18\x02 \x01(\tR\x0echeckFrequency\x12#\n" +
 	"\rauto_download\x18\x03 \x01(\bR\fautoDownload\"(\n" +
 	"\x10AppearanceConfig\x12\x14\n" +
-	"\x05theme\x18\x01 \x01(\tR\x05theme\"\x81\x03\n" +
+	"\x05theme\x18\x01 \x01(\tR\x05theme\"v\n" +
+	"\x10RecordingsConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12 \n" +
+	"\fmax_age_days\x18\x02 \x01(\x05R\n" +
+	"maxAgeDays\x12&\n" +
+	"\x0fmax_per_project\x18\x03 \x01(\x05R\rmaxPerProject\"\xb7\x01\n" +
+	"\x0fRetentionConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12 \n" +
+	"\fmax_age_days\x18\x02 \x01(\x05R\n" +
+	"maxAgeDays\x12\x19\n" +
+	"\bmax_logs\x18\x03 \x01(\x05R\amaxLogs\x12\x1e\n" +
+	"\vmax_size_mb\x18\x04 \x01(\x05R\tmaxSizeMb\x12-\n" +
+	"\x13branch_max_age_days\x18\x05 \x01(\x05R\x10branchMaxAgeDays\"I\n" +
+	"\x15MetricsEndpointConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06listen\x18\x02 \x01(\tR\x06listen\"\xf2\x01\n" +
+	"\rTracingConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bexporter\x18\x02 \x01(\tR\bexporter\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12?\n" +
+	"\aheaders\x18\x04 \x03(\v2%.watchfire.TracingConfig.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04file\x18\x05 \x01(\tR\x04file\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x01\n" +
+	"\n" +
+	"TokenPrice\x12\x18\n" +
+	"\abackend\x18\x01 \x01(\tR\abackend\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12$\n" +
+	"\x0einput_per_mtok\x18\x03 \x01(\x01R\finputPerMtok\x12&\n" +
+	"\x0foutput_per_mtok\x18\x04 \x01(\x01R\routputPerMtok\x12&\n" +
+	"\x0fcached_per_mtok\x18\x05 \x01(\x01R\rcachedPerMtok\">\n" +
+	"\rPricingConfig\x12-\n" +
+	"\x06prices\x18\x01 \x03(\v2\x15.watchfire.TokenPriceR\x06prices\"l\n" +
+	"\fBudgetConfig\x12\x1f\n" +
+	"\vmonthly_usd\x18\x01 \x01(\x01R\n" +
+	"monthlyUsd\x12\x1e\n" +
+	"\n" +
+	"thresholds\x18\x02 \x03(\x05R\n" +
+	"thresholds\x12\x1b\n" +
+	"\thard_stop\x18\x03 \x01(\bR\bhardStop\"\xde\x05\n" +
 	"\bSettings\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x05R\aversion\x127\n" +
 	"\x06agents\x18\x02 \x03(\v2\x1f.watchfire.Settings.AgentsEntryR\x06agents\x125\n" +
@@ -9774,10 +14291,19 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
 	"appearance\x18\x05 \x01(\v2\x1b.watchfire.AppearanceConfigR\n" +
 	"appearance\x12'\n" +
-	"\x0finstallation_id\x18\x06 \x01(\tR\x0einstallationId\x1aQ\n" +
+	"\x0finstallation_id\x18\x06 \x01(\tR\x0einstallationId\x12;\n" +
+	"\n" +
+	"recordings\x18\a \x01(\v2\x1b.watchfire.RecordingsConfigR\n" +
+	"recordings\x128\n" +
+	"\tretention\x18\b \x01(\v2\x1a.watchfire.RetentionConfigR\tretention\x12K\n" +
+	"\x10metrics_endpoint\x18\t \x01(\v2 .watchfire.MetricsEndpointConfigR\x0fmetricsEndpoint\x122\n" +
+	"\atracing\x18\n" +
+	" \x01(\v2\x18.watchfire.TracingConfigR\atracing\x122\n" +
+	"\apricing\x18\v \x01(\v2\x18.watchfire.PricingConfigR\apricing\x12/\n" +
+	"\x06budget\x18\f \x01(\v2\x17.watchfire.BudgetConfigR\x06budget\x1aQ\n" +
 	"\vAgentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.watchfire.AgentConfigR\x05value:\x028\x01\"\xbb\x03\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.watchfire.AgentConfigR\x05value:\x028\x01\"\x8b\a\n" +
 	"\x15UpdateSettingsRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12:\n" +
 	"\bdefaults\x18\x02 \x01(\v2\x19.watchfire.DefaultsConfigH\x00R\bdefaults\x88\x01\x01\x127\n" +
@@ -9785,14 +14311,32 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
 	"appearance\x18\x04 \x01(\v2\x1b.watchfire.AppearanceConfigH\x02R\n" +
 	"appearance\x88\x01\x01\x12D\n" +
-	"\x06agents\x18\x05 \x03(\v2,.watchfire.UpdateSettingsRequest.AgentsEntryR\x06agents\x1aQ\n" +
+	"\x06agents\x18\x05 \x03(\v2,.watchfire.UpdateSettingsRequest.AgentsEntryR\x06agents\x12@\n" +
+	"\n" +
+	"recordings\x18\x06 \x01(\v2\x1b.watchfire.RecordingsConfigH\x03R\n" +
+	"recordings\x88\x01\x01\x12=\n" +
+	"\tretention\x18\a \x01(\v2\x1a.watchfire.RetentionConfigH\x04R\tretention\x88\x01\x01\x12P\n" +
+	"\x10metrics_endpoint\x18\b \x01(\v2 .watchfire.MetricsEndpointConfigH\x05R\x0fmetricsEndpoint\x88\x01\x01\x127\n" +
+	"\atracing\x18\t \x01(\v2\x18.watchfire.TracingConfigH\x06R\atracing\x88\x01\x01\x127\n" +
+	"\apricing\x18\n" +
+	" \x01(\v2\x18.watchfire.PricingConfigH\aR\apricing\x88\x01\x01\x124\n" +
+	"\x06budget\x18\v \x01(\v2\x17.watchfire.BudgetConfigH\bR\x06budget\x88\x01\x01\x1aQ\n" +
 	"\vAgentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
 	"\x05value\x18\x02 \x01(\v2\x16.watchfire.AgentConfigR\x05value:\x028\x01B\v\n" +
 	"\t_defaultsB\n" +
 	"\n" +
 	"\b_updatesB\r\n" +
-	"\v_appearance\"`\n" +
+	"\v_appearanceB\r\n" +
+	"\v_recordingsB\f\n" +
+	"\n" +
+	"_retentionB\x13\n" +
+	"\x11_metrics_endpointB\n" +
+	"\n" +
+	"\b_tracingB\n" +
+	"\n" +
+	"\b_pricingB\t\n" +
+	"\a_budget\"`\n" +
 	"\tAgentInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1c\n" +
@@ -9825,7 +14369,25 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12#\n" +
 	"\rslack_channel\x18\x03 \x01(\tR\fslackChannel\x12(\n" +
-	"\x10discord_guild_id\x18\x04 \x01(\tR\x0ediscordGuildId\"I\n" +
+	"\x10discord_guild_id\x18\x04 \x01(\tR\x0ediscordGuildId\"r\n" +
+	"\fRunGCRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\"}\n" +
+	"\x06GCItem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x14\n" +
+	"\x05bytes\x18\x04 \x01(\x03R\x05bytes\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x85\x01\n" +
+	"\bGCReport\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12'\n" +
+	"\x05items\x18\x02 \x03(\v2\x11.watchfire.GCItemR\x05items\x12\x1f\n" +
+	"\vtotal_bytes\x18\x03 \x01(\x03R\n" +
+	"totalBytes\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"I\n" +
 	"\x1bSubscribeFocusEventsRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\"\x9d\x01\n" +
 	"\n" +
@@ -9840,7 +14402,7 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x0fListLogsRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x02 \x01(\tR\tprojectId\"\x84\x02\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\"\xef\x02\n" +
 	"\bLogEntry\x12\x15\n" +
 	"\x06log_id\x18\x01 \x01(\tR\x05logId\x12\x1d\n" +
 	"\n" +
@@ -9853,7 +14415,12 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\a \x01(\tR\tstartedAt\x12\x19\n" +
 	"\bended_at\x18\b \x01(\tR\aendedAt\x12\x16\n" +
-	"\x06status\x18\t \x01(\tR\x06status\"2\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12%\n" +
+	"\x0ehas_transcript\x18\n" +
+	" \x01(\bR\rhasTranscript\x12#\n" +
+	"\rhas_recording\x18\v \x01(\bR\fhasRecording\x12\x1d\n" +
+	"\n" +
+	"has_events\x18\f \x01(\bR\thasEvents\"2\n" +
 	"\aLogList\x12'\n" +
 	"\x04logs\x18\x01 \x03(\v2\x13.watchfire.LogEntryR\x04logs\"q\n" +
 	"\rGetLogRequest\x12*\n" +
@@ -9869,7 +14436,64 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x15\n" +
-	"\x06log_id\x18\x03 \x01(\tR\x05logId\"\xf4\x01\n" +
+	"\x06log_id\x18\x03 \x01(\tR\x05logId\"w\n" +
+	"\x13GetRecordingRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06log_id\x18\x03 \x01(\tR\x05logId\"$\n" +
+	"\x0eRecordingChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x9b\x02\n" +
+	"\x11SearchLogsRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1f\n" +
+	"\vproject_ids\x18\x03 \x03(\tR\n" +
+	"projectIds\x12\x14\n" +
+	"\x05agent\x18\x04 \x01(\tR\x05agent\x12\x1f\n" +
+	"\vtask_number\x18\x05 \x01(\x05R\n" +
+	"taskNumber\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x14\n" +
+	"\x05since\x18\b \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\t \x01(\tR\x05until\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\"\x8e\x01\n" +
+	"\fLogSearchHit\x12)\n" +
+	"\x05entry\x18\x01 \x01(\v2\x13.watchfire.LogEntryR\x05entry\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x1a\n" +
+	"\bsnippets\x18\x04 \x03(\tR\bsnippets\"A\n" +
+	"\x12SearchLogsResponse\x12+\n" +
+	"\x04hits\x18\x01 \x03(\v2\x17.watchfire.LogSearchHitR\x04hits\"\x91\x01\n" +
+	"\x17GetSessionEventsRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06log_id\x18\x03 \x01(\tR\x05logId\x12\x14\n" +
+	"\x05types\x18\x04 \x03(\tR\x05types\"\xb3\x03\n" +
+	"\fSessionEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x05R\x03seq\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x12\n" +
+	"\x04tool\x18\x05 \x01(\tR\x04tool\x12\x17\n" +
+	"\acall_id\x18\x06 \x01(\tR\x06callId\x12\x12\n" +
+	"\x04args\x18\a \x01(\tR\x04args\x12\x16\n" +
+	"\x06result\x18\b \x01(\tR\x06result\x12\x19\n" +
+	"\bis_error\x18\t \x01(\bR\aisError\x12\x12\n" +
+	"\x04path\x18\n" +
+	" \x01(\tR\x04path\x12\x1b\n" +
+	"\tedit_kind\x18\v \x01(\tR\beditKind\x12\x18\n" +
+	"\acommand\x18\f \x01(\tR\acommand\x12 \n" +
+	"\texit_code\x18\r \x01(\x05H\x00R\bexitCode\x88\x01\x01\x12\x1b\n" +
+	"\ttokens_in\x18\x0e \x01(\x03R\btokensIn\x12\x1d\n" +
+	"\n" +
+	"tokens_out\x18\x0f \x01(\x03R\ttokensOut\x12*\n" +
+	"\x11cache_read_tokens\x18\x10 \x01(\x03R\x0fcacheReadTokensB\f\n" +
+	"\n" +
+	"_exit_code\"C\n" +
+	"\x10SessionEventList\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.watchfire.SessionEventR\x06events\"\xf1\x02\n" +
 	"\fNotification\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -9880,7 +14504,13 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x04body\x18\x05 \x01(\tR\x04body\x129\n" +
 	"\n" +
 	"emitted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\temittedAt\x12/\n" +
-	"\x04kind\x18\a \x01(\x0e2\x1b.watchfire.NotificationKindR\x04kind\"K\n" +
+	"\x04kind\x18\a \x01(\x0e2\x1b.watchfire.NotificationKindR\x04kind\x12\x16\n" +
+	"\x06detail\x18\b \x01(\tR\x06detail\x12\x10\n" +
+	"\x03url\x18\t \x01(\tR\x03url\x12\x14\n" +
+	"\x05phase\x18\n" +
+	" \x01(\tR\x05phase\x12%\n" +
+	"\x0eprevious_phase\x18\v \x01(\tR\rpreviousPhase\x12\x14\n" +
+	"\x05count\x18\f \x01(\x05R\x05count\"K\n" +
 	"\x1dSubscribeNotificationsRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\"\xd3\x02\n" +
 	"\x13ExportReportRequest\x12*\n" +
@@ -9898,12 +14528,14 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x14ExportReportResponse\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\fR\acontent\x12\x12\n" +
-	"\x04mime\x18\x03 \x01(\tR\x04mime\"\xc0\x01\n" +
+	"\x04mime\x18\x03 \x01(\tR\x04mime\"\xd8\x02\n" +
 	"\x18GetGlobalInsightsRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12=\n" +
 	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
 	"\n" +
-	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"\xb1\x01\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12L\n" +
+	"\x14compare_window_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12compareWindowStart\x12H\n" +
+	"\x12compare_window_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10compareWindowEnd\"\xb1\x01\n" +
 	"\tDayBucket\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1c\n" +
@@ -9924,7 +14556,87 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\vlines_added\x18\t \x01(\x05R\n" +
 	"linesAdded\x12#\n" +
 	"\rlines_removed\x18\n" +
-	" \x01(\x05R\flinesRemoved\"\xc1\x02\n" +
+	" \x01(\x05R\flinesRemoved\"\xde\x04\n" +
+	"\x0fAgentComparison\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x14\n" +
+	"\x05tasks\x18\x03 \x01(\x05R\x05tasks\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12!\n" +
+	"\fsuccess_rate\x18\x05 \x01(\x01R\vsuccessRate\x12,\n" +
+	"\x12median_duration_ms\x18\x06 \x01(\x03R\x10medianDurationMs\x12&\n" +
+	"\x0fp90_duration_ms\x18\a \x01(\x03R\rp90DurationMs\x12$\n" +
+	"\x0etotal_cost_usd\x18\b \x01(\x01R\ftotalCostUsd\x12/\n" +
+	"\x14cost_per_success_usd\x18\t \x01(\x01R\x11costPerSuccessUsd\x12%\n" +
+	"\x0emerge_failures\x18\n" +
+	" \x01(\x05R\rmergeFailures\x12,\n" +
+	"\x12merge_failure_rate\x18\v \x01(\x01R\x10mergeFailureRate\x12\x1d\n" +
+	"\n" +
+	"follow_ups\x18\f \x01(\x05R\tfollowUps\x12$\n" +
+	"\x0efollow_up_rate\x18\r \x01(\x01R\ffollowUpRate\x12\x1a\n" +
+	"\breverted\x18\x0e \x01(\x05R\breverted\x12\x1f\n" +
+	"\vrevert_rate\x18\x0f \x01(\x01R\n" +
+	"revertRate\x12\x1f\n" +
+	"\vlines_added\x18\x10 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\x11 \x01(\x05R\flinesRemoved\"\xe9\x02\n" +
+	"\tUserUsage\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
+	"\x05tasks\x18\x02 \x01(\x05R\x05tasks\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\x05R\tsucceeded\x12!\n" +
+	"\fsuccess_rate\x18\x04 \x01(\x01R\vsuccessRate\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12\"\n" +
+	"\rtask_cost_usd\x18\x06 \x01(\x01R\vtaskCostUsd\x12\x1b\n" +
+	"\tnet_lines\x18\a \x01(\x05R\bnetLines\x12#\n" +
+	"\rtasks_created\x18\b \x01(\x05R\ftasksCreated\x12\x1a\n" +
+	"\bsessions\x18\t \x01(\x05R\bsessions\x12(\n" +
+	"\x10session_cost_usd\x18\n" +
+	" \x01(\x01R\x0esessionCostUsd\x12$\n" +
+	"\x0etotal_cost_usd\x18\v \x01(\x01R\ftotalCostUsd\"\xab\x02\n" +
+	"\x10InsightsOverhead\x12\x1a\n" +
+	"\bsessions\x18\x01 \x01(\x05R\bsessions\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x03R\n" +
+	"durationMs\x12\x1b\n" +
+	"\ttokens_in\x18\x03 \x01(\x03R\btokensIn\x12\x1d\n" +
+	"\n" +
+	"tokens_out\x18\x04 \x01(\x03R\ttokensOut\x12\x19\n" +
+	"\bcost_usd\x18\x05 \x01(\x01R\acostUsd\x122\n" +
+	"\x15sessions_missing_cost\x18\x06 \x01(\x05R\x13sessionsMissingCost\x12\x1d\n" +
+	"\n" +
+	"cost_share\x18\a \x01(\x01R\tcostShare\x120\n" +
+	"\aby_kind\x18\b \x03(\v2\x17.watchfire.OverheadKindR\x06byKind\"\xb6\x01\n" +
+	"\fOverheadKind\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bsessions\x18\x02 \x01(\x05R\bsessions\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x03R\n" +
+	"durationMs\x12\x1b\n" +
+	"\ttokens_in\x18\x04 \x01(\x03R\btokensIn\x12\x1d\n" +
+	"\n" +
+	"tokens_out\x18\x05 \x01(\x03R\ttokensOut\x12\x19\n" +
+	"\bcost_usd\x18\x06 \x01(\x01R\acostUsd\"z\n" +
+	"\vMetricDelta\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\x01R\acurrent\x12\x1a\n" +
+	"\bprevious\x18\x02 \x01(\x01R\bprevious\x12\x16\n" +
+	"\x06change\x18\x03 \x01(\x01R\x06change\x12\x1d\n" +
+	"\n" +
+	"change_pct\x18\x04 \x01(\x01R\tchangePct\"\x81\x03\n" +
+	"\x12InsightsComparison\x12=\n" +
+	"\fwindow_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12,\n" +
+	"\x05tasks\x18\x03 \x01(\v2\x16.watchfire.MetricDeltaR\x05tasks\x129\n" +
+	"\fsuccess_rate\x18\x04 \x01(\v2\x16.watchfire.MetricDeltaR\vsuccessRate\x121\n" +
+	"\bcost_usd\x18\x05 \x01(\v2\x16.watchfire.MetricDeltaR\acostUsd\x123\n" +
+	"\tnet_lines\x18\x06 \x01(\v2\x16.watchfire.MetricDeltaR\bnetLines\x12 \n" +
+	"\vregressions\x18\a \x03(\tR\vregressions\"\xb9\x01\n" +
+	"\tTrendWeek\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\tR\tweekStart\x12\x14\n" +
+	"\x05tasks\x18\x02 \x01(\x05R\x05tasks\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\x05R\tsucceeded\x12!\n" +
+	"\fsuccess_rate\x18\x04 \x01(\x01R\vsuccessRate\x12\x19\n" +
+	"\bcost_usd\x18\x05 \x01(\x01R\acostUsd\x12\x1b\n" +
+	"\tnet_lines\x18\x06 \x01(\x05R\bnetLines\"\xc1\x02\n" +
 	"\n" +
 	"TopProject\x12\x1d\n" +
 	"\n" +
@@ -9939,7 +14651,8 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\rlines_removed\x18\b \x01(\x05R\flinesRemoved\x12\x1b\n" +
 	"\tnet_lines\x18\t \x01(\x05R\bnetLines\x12\x16\n" +
 	"\x06merges\x18\n" +
-	" \x01(\x05R\x06merges\"\xf2\x06\n" +
+	" \x01(\x05R\x06merges\"\x9c\n" +
+	"\n" +
 	"\x0eGlobalInsights\x12\x1f\n" +
 	"\vtasks_total\x18\x01 \x01(\x05R\n" +
 	"tasksTotal\x12'\n" +
@@ -9964,14 +14677,40 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\ftasks_merged\x18\x11 \x01(\x05R\vtasksMerged\x12 \n" +
 	"\ftasks_via_pr\x18\x12 \x01(\x05R\n" +
 	"tasksViaPr\x120\n" +
-	"\x14metrics_missing_code\x18\x13 \x01(\x05R\x12metricsMissingCode\"\xe0\x01\n" +
+	"\x14metrics_missing_code\x18\x13 \x01(\x05R\x12metricsMissingCode\x12,\n" +
+	"\x12estimated_cost_usd\x18\x14 \x01(\x01R\x10estimatedCostUsd\x120\n" +
+	"\x14tasks_estimated_cost\x18\x15 \x01(\x05R\x12tasksEstimatedCost\x121\n" +
+	"\abudgets\x18\x16 \x03(\v2\x17.watchfire.BudgetStatusR\abudgets\x12E\n" +
+	"\x10agent_comparison\x18\x17 \x03(\v2\x1a.watchfire.AgentComparisonR\x0fagentComparison\x127\n" +
+	"\boverhead\x18\x18 \x01(\v2\x1b.watchfire.InsightsOverheadR\boverhead\x12=\n" +
+	"\n" +
+	"comparison\x18\x19 \x01(\v2\x1d.watchfire.InsightsComparisonR\n" +
+	"comparison\x12*\n" +
+	"\x05trend\x18\x1a \x03(\v2\x14.watchfire.TrendWeekR\x05trend\x12*\n" +
+	"\x05users\x18\x1b \x03(\v2\x14.watchfire.UserUsageR\x05users\"\xa9\x02\n" +
+	"\fBudgetStatus\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x03 \x01(\tR\vprojectName\x12\x14\n" +
+	"\x05month\x18\x04 \x01(\tR\x05month\x12\x1b\n" +
+	"\tlimit_usd\x18\x05 \x01(\x01R\blimitUsd\x12\x1b\n" +
+	"\tspent_usd\x18\x06 \x01(\x01R\bspentUsd\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x05R\tthreshold\x12\x1b\n" +
+	"\thard_stop\x18\b \x01(\bR\bhardStop\x12\x1a\n" +
+	"\bexceeded\x18\t \x01(\bR\bexceeded\x12\x1a\n" +
+	"\bblocking\x18\n" +
+	" \x01(\bR\bblocking\"\xf8\x02\n" +
 	"\x19GetProjectInsightsRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12=\n" +
 	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
 	"\n" +
-	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"\xd0\a\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12L\n" +
+	"\x14compare_window_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x12compareWindowStart\x12H\n" +
+	"\x12compare_window_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10compareWindowEnd\"\xc7\n" +
+	"\n" +
 	"\x0fProjectInsights\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
@@ -10000,7 +14739,16 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\ftasks_merged\x18\x14 \x01(\x05R\vtasksMerged\x12 \n" +
 	"\ftasks_via_pr\x18\x15 \x01(\x05R\n" +
 	"tasksViaPr\x120\n" +
-	"\x14metrics_missing_code\x18\x16 \x01(\x05R\x12metricsMissingCode\"\x80\x01\n" +
+	"\x14metrics_missing_code\x18\x16 \x01(\x05R\x12metricsMissingCode\x12,\n" +
+	"\x12estimated_cost_usd\x18\x17 \x01(\x01R\x10estimatedCostUsd\x120\n" +
+	"\x14tasks_estimated_cost\x18\x18 \x01(\x05R\x12tasksEstimatedCost\x12E\n" +
+	"\x10agent_comparison\x18\x19 \x03(\v2\x1a.watchfire.AgentComparisonR\x0fagentComparison\x127\n" +
+	"\boverhead\x18\x1a \x01(\v2\x1b.watchfire.InsightsOverheadR\boverhead\x12=\n" +
+	"\n" +
+	"comparison\x18\x1b \x01(\v2\x1d.watchfire.InsightsComparisonR\n" +
+	"comparison\x12*\n" +
+	"\x05trend\x18\x1c \x03(\v2\x14.watchfire.TrendWeekR\x05trend\x12*\n" +
+	"\x05users\x18\x1d \x03(\v2\x14.watchfire.UserUsageR\x05users\"\x80\x01\n" +
 	"\x12GetTaskDiffRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -10035,12 +14783,56 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x04Kind\x12\v\n" +
 	"\aCONTEXT\x10\x00\x12\a\n" +
 	"\x03ADD\x10\x01\x12\a\n" +
-	"\x03DEL\x10\x02\"|\n" +
+	"\x03DEL\x10\x02\"\xef\x01\n" +
+	"\x12GetHotspotsRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xb6\x03\n" +
+	"\bHotspots\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12#\n" +
+	"\rtasks_scanned\x18\x04 \x01(\x05R\ftasksScanned\x12,\n" +
+	"\x12tasks_without_diff\x18\x05 \x01(\x05R\x10tasksWithoutDiff\x12,\n" +
+	"\x05files\x18\x06 \x03(\v2\x16.watchfire.HotspotFileR\x05files\x12;\n" +
+	"\rfailure_files\x18\a \x03(\v2\x16.watchfire.HotspotFileR\ffailureFiles\x12=\n" +
+	"\vdirectories\x18\b \x03(\v2\x1b.watchfire.HotspotDirectoryR\vdirectories\x12\x14\n" +
+	"\x05weeks\x18\t \x03(\tR\x05weeks\"\xa9\x02\n" +
+	"\vHotspotFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05tasks\x18\x02 \x01(\x05R\x05tasks\x12!\n" +
+	"\ffailed_tasks\x18\x03 \x01(\x05R\vfailedTasks\x12%\n" +
+	"\x0emerge_failures\x18\x04 \x01(\x05R\rmergeFailures\x12\x1f\n" +
+	"\vlines_added\x18\x05 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\x06 \x01(\x05R\flinesRemoved\x12=\n" +
+	"\flast_touched\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastTouched\x12!\n" +
+	"\fweekly_churn\x18\b \x03(\x05R\vweeklyChurn\"\xe2\x01\n" +
+	"\x10HotspotDirectory\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05tasks\x18\x02 \x01(\x05R\x05tasks\x12\x14\n" +
+	"\x05files\x18\x03 \x01(\x05R\x05files\x12!\n" +
+	"\ffailed_tasks\x18\x04 \x01(\x05R\vfailedTasks\x12%\n" +
+	"\x0emerge_failures\x18\x05 \x01(\x05R\rmergeFailures\x12\x1f\n" +
+	"\vlines_added\x18\x06 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\a \x01(\x05R\flinesRemoved\"\xa4\x02\n" +
 	"\x11IntegrationEvents\x12\x1f\n" +
 	"\vtask_failed\x18\x01 \x01(\bR\n" +
 	"taskFailed\x12!\n" +
 	"\frun_complete\x18\x02 \x01(\bR\vrunComplete\x12#\n" +
-	"\rweekly_digest\x18\x03 \x01(\bR\fweeklyDigest\"\x8f\x02\n" +
+	"\rweekly_digest\x18\x03 \x01(\bR\fweeklyDigest\x12)\n" +
+	"\x10budget_threshold\x18\x04 \x01(\bR\x0fbudgetThreshold\x12@\n" +
+	"\x06events\x18\x05 \x03(\v2(.watchfire.IntegrationEvents.EventsEntryR\x06events\x1a9\n" +
+	"\vEventsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x8f\x02\n" +
 	"\x12WebhookIntegration\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x10\n" +
@@ -10066,7 +14858,50 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\turl_label\x18\x04 \x01(\tR\burlLabel\x12\x17\n" +
 	"\aurl_set\x18\x05 \x01(\bR\x06urlSet\x12C\n" +
 	"\x0eenabled_events\x18\x06 \x01(\v2\x1c.watchfire.IntegrationEventsR\renabledEvents\x12(\n" +
-	"\x10project_mute_ids\x18\a \x03(\tR\x0eprojectMuteIds\"y\n" +
+	"\x10project_mute_ids\x18\a \x03(\tR\x0eprojectMuteIds\"\xef\x01\n" +
+	"\x10TeamsIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
+	"\turl_label\x18\x04 \x01(\tR\burlLabel\x12\x17\n" +
+	"\aurl_set\x18\x05 \x01(\bR\x06urlSet\x12C\n" +
+	"\x0eenabled_events\x18\x06 \x01(\v2\x1c.watchfire.IntegrationEventsR\renabledEvents\x12(\n" +
+	"\x10project_mute_ids\x18\a \x03(\tR\x0eprojectMuteIds\"\xa8\x02\n" +
+	"\x11MatrixIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12%\n" +
+	"\x0ehomeserver_url\x18\x03 \x01(\tR\rhomeserverUrl\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12!\n" +
+	"\faccess_token\x18\x05 \x01(\tR\vaccessToken\x12\x1b\n" +
+	"\ttoken_set\x18\x06 \x01(\bR\btokenSet\x12C\n" +
+	"\x0eenabled_events\x18\a \x01(\v2\x1c.watchfire.IntegrationEventsR\renabledEvents\x12(\n" +
+	"\x10project_mute_ids\x18\b \x03(\tR\x0eprojectMuteIds\"\xeb\x01\n" +
+	"\x0fNtfyIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1b\n" +
+	"\ttoken_set\x18\x05 \x01(\bR\btokenSet\x12C\n" +
+	"\x0eenabled_events\x18\x06 \x01(\v2\x1c.watchfire.IntegrationEventsR\renabledEvents\x12(\n" +
+	"\x10project_mute_ids\x18\a \x03(\tR\x0eprojectMuteIds\"o\n" +
+	"\x0eEmailRecipient\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12C\n" +
+	"\x0eenabled_events\x18\x02 \x01(\v2\x1c.watchfire.IntegrationEventsR\renabledEvents\"\xd0\x02\n" +
+	"\x10EmailIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bsecurity\x18\x05 \x01(\tR\bsecurity\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\a \x01(\tR\bpassword\x12!\n" +
+	"\fpassword_set\x18\b \x01(\bR\vpasswordSet\x12\x12\n" +
+	"\x04from\x18\t \x01(\tR\x04from\x129\n" +
+	"\n" +
+	"recipients\x18\n" +
+	" \x03(\v2\x19.watchfire.EmailRecipientR\n" +
+	"recipients\x12(\n" +
+	"\x10project_mute_ids\x18\v \x03(\tR\x0eprojectMuteIds\"y\n" +
 	"\x11GitHubIntegration\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
 	"\rdraft_default\x18\x02 \x01(\bR\fdraftDefault\x12%\n" +
@@ -10083,22 +14918,31 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\tbot_token\x18\x02 \x01(\tR\bbotToken\x12\x1b\n" +
 	"\ttoken_set\x18\x03 \x01(\bR\btokenSet\x12C\n" +
 	"\x0eenabled_events\x18\x04 \x01(\v2\x1c.watchfire.IntegrationEventsR\renabledEvents\x12D\n" +
-	"\fpaired_chats\x18\x05 \x03(\v2!.watchfire.TelegramPairedChatInfoR\vpairedChats\"\xad\x02\n" +
+	"\fpaired_chats\x18\x05 \x03(\v2!.watchfire.TelegramPairedChatInfoR\vpairedChats\"\xf9\x03\n" +
 	"\x12IntegrationsConfig\x129\n" +
 	"\bwebhooks\x18\x01 \x03(\v2\x1d.watchfire.WebhookIntegrationR\bwebhooks\x121\n" +
 	"\x05slack\x18\x02 \x03(\v2\x1b.watchfire.SlackIntegrationR\x05slack\x127\n" +
 	"\adiscord\x18\x03 \x03(\v2\x1d.watchfire.DiscordIntegrationR\adiscord\x124\n" +
 	"\x06github\x18\x04 \x01(\v2\x1c.watchfire.GitHubIntegrationR\x06github\x12:\n" +
-	"\btelegram\x18\x05 \x01(\v2\x1e.watchfire.TelegramIntegrationR\btelegram\"E\n" +
+	"\btelegram\x18\x05 \x01(\v2\x1e.watchfire.TelegramIntegrationR\btelegram\x121\n" +
+	"\x05teams\x18\x06 \x03(\v2\x1b.watchfire.TeamsIntegrationR\x05teams\x124\n" +
+	"\x06matrix\x18\a \x03(\v2\x1c.watchfire.MatrixIntegrationR\x06matrix\x12.\n" +
+	"\x04ntfy\x18\b \x03(\v2\x1a.watchfire.NtfyIntegrationR\x04ntfy\x121\n" +
+	"\x05email\x18\t \x03(\v2\x1b.watchfire.EmailIntegrationR\x05email\"E\n" +
 	"\x17ListIntegrationsRequest\x12*\n" +
-	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\"\xf0\x02\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\"\xc4\x04\n" +
 	"\x16SaveIntegrationRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x129\n" +
 	"\awebhook\x18\x02 \x01(\v2\x1d.watchfire.WebhookIntegrationH\x00R\awebhook\x123\n" +
 	"\x05slack\x18\x03 \x01(\v2\x1b.watchfire.SlackIntegrationH\x00R\x05slack\x129\n" +
 	"\adiscord\x18\x04 \x01(\v2\x1d.watchfire.DiscordIntegrationH\x00R\adiscord\x126\n" +
 	"\x06github\x18\x05 \x01(\v2\x1c.watchfire.GitHubIntegrationH\x00R\x06github\x12<\n" +
-	"\btelegram\x18\x06 \x01(\v2\x1e.watchfire.TelegramIntegrationH\x00R\btelegramB\t\n" +
+	"\btelegram\x18\x06 \x01(\v2\x1e.watchfire.TelegramIntegrationH\x00R\btelegram\x123\n" +
+	"\x05teams\x18\a \x01(\v2\x1b.watchfire.TeamsIntegrationH\x00R\x05teams\x126\n" +
+	"\x06matrix\x18\b \x01(\v2\x1c.watchfire.MatrixIntegrationH\x00R\x06matrix\x120\n" +
+	"\x04ntfy\x18\t \x01(\v2\x1a.watchfire.NtfyIntegrationH\x00R\x04ntfy\x123\n" +
+	"\x05email\x18\n" +
+	" \x01(\v2\x1b.watchfire.EmailIntegrationH\x00R\x05emailB\t\n" +
 	"\apayload\"\x86\x01\n" +
 	"\x18DeleteIntegrationRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12.\n" +
@@ -10112,7 +14956,85 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vstatus_code\x18\x03 \x01(\x05R\n" +
-	"statusCode\"I\n" +
+	"statusCode\"\xdd\x03\n" +
+	"\rRelayDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"adapter_id\x18\x02 \x01(\tR\tadapterId\x12!\n" +
+	"\fadapter_kind\x18\x03 \x01(\tR\vadapterKind\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x06 \x01(\tR\vprojectName\x12\x1f\n" +
+	"\vtask_number\x18\a \x01(\x05R\n" +
+	"taskNumber\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x123\n" +
+	"\adead_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06deadAt\x12\x12\n" +
+	"\x04dead\x18\r \x01(\bR\x04dead\"r\n" +
+	"\x1bListFailedDeliveriesRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12'\n" +
+	"\x0finclude_pending\x18\x02 \x01(\bR\x0eincludePending\"X\n" +
+	"\x1cListFailedDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.watchfire.RelayDeliveryR\n" +
+	"deliveries\"S\n" +
+	"\x15ReplayDeliveryRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc1\x01\n" +
+	"\x16PreviewTemplateRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x1f\n" +
+	"\vendpoint_id\x18\x04 \x01(\tR\n" +
+	"endpointId\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x12\n" +
+	"\x04save\x18\x06 \x01(\bR\x04save\"\xb5\x01\n" +
+	"\x17PreviewTemplateResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x1a\n" +
+	"\brendered\x18\x02 \x01(\tR\brendered\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x16\n" +
+	"\x06origin\x18\x05 \x01(\tR\x06origin\x12\x12\n" +
+	"\x04path\x18\x06 \x01(\tR\x04path\x12\x14\n" +
+	"\x05saved\x18\a \x01(\bR\x05saved\"\x96\x02\n" +
+	"\x10TestRouteRequest\x12*\n" +
+	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12\x1f\n" +
+	"\vtask_number\x18\x04 \x01(\x05R\n" +
+	"taskNumber\x12\x14\n" +
+	"\x05agent\x18\x05 \x01(\tR\x05agent\x12\x1d\n" +
+	"\n" +
+	"task_title\x18\x06 \x01(\tR\ttaskTitle\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\x03R\x0fdurationSeconds\"~\n" +
+	"\vRouteTarget\x12\x1d\n" +
+	"\n" +
+	"adapter_id\x18\x01 \x01(\tR\tadapterId\x12!\n" +
+	"\fadapter_kind\x18\x02 \x01(\tR\vadapterKind\x12\x19\n" +
+	"\bchat_ids\x18\x03 \x03(\x03R\achatIds\x12\x12\n" +
+	"\x04rule\x18\x04 \x01(\tR\x04rule\"\xfd\x02\n" +
+	"\x11TestRouteResponse\x12\x14\n" +
+	"\x05rules\x18\x01 \x03(\tR\x05rules\x12'\n" +
+	"\x0fdefault_routing\x18\x02 \x01(\bR\x0edefaultRouting\x120\n" +
+	"\atargets\x18\x03 \x03(\v2\x16.watchfire.RouteTargetR\atargets\x12\x14\n" +
+	"\x05muted\x18\x04 \x03(\tR\x05muted\x12\x18\n" +
+	"\aunknown\x18\x05 \x03(\tR\aunknown\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x06 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\a \x01(\tR\vprojectName\x12\x14\n" +
+	"\x05agent\x18\b \x01(\tR\x05agent\x12\x1d\n" +
+	"\n" +
+	"task_title\x18\t \x01(\tR\ttaskTitle\x12%\n" +
+	"\x0efailure_reason\x18\n" +
+	" \x01(\tR\rfailureReason\x12)\n" +
+	"\x10duration_seconds\x18\v \x01(\x03R\x0fdurationSeconds\"I\n" +
 	"\x1bBeginTelegramPairingRequest\x12*\n" +
 	"\x04meta\x18\x01 \x01(\v2\x16.watchfire.RequestMetaR\x04meta\"\xad\x01\n" +
 	"\x1cBeginTelegramPairingResponse\x12\x12\n" +
@@ -10235,22 +15157,38 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x11FOCUS_TARGET_MAIN\x10\x00\x12\x16\n" +
 	"\x12FOCUS_TARGET_TASKS\x10\x01\x12\x15\n" +
 	"\x11FOCUS_TARGET_TASK\x10\x02\x12\x17\n" +
-	"\x13FOCUS_TARGET_DIGEST\x10\x03*Y\n" +
+	"\x13FOCUS_TARGET_DIGEST\x10\x03*\xfd\x01\n" +
 	"\x10NotificationKind\x12\x0f\n" +
 	"\vTASK_FAILED\x10\x00\x12\x10\n" +
 	"\fRUN_COMPLETE\x10\x01\x12\x0f\n" +
 	"\vSTUCK_AGENT\x10\x02\x12\x11\n" +
-	"\rWEEKLY_DIGEST\x10\x03*%\n" +
+	"\rWEEKLY_DIGEST\x10\x03\x12\x14\n" +
+	"\x10BUDGET_THRESHOLD\x10\x04\x12\x12\n" +
+	"\x0eTASK_SUCCEEDED\x10\x05\x12\x10\n" +
+	"\fMERGE_FAILED\x10\x06\x12\r\n" +
+	"\tPR_OPENED\x10\a\x12\x14\n" +
+	"\x10AGENT_NEEDS_AUTH\x10\b\x12\x10\n" +
+	"\fRATE_LIMITED\x10\t\x12\x1a\n" +
+	"\x16WILDFIRE_PHASE_CHANGED\x10\n" +
+	"\x12\x13\n" +
+	"\x0fTASKS_GENERATED\x10\v*9\n" +
 	"\fExportFormat\x12\a\n" +
 	"\x03CSV\x10\x00\x12\f\n" +
-	"\bMARKDOWN\x10\x01*P\n" +
+	"\bMARKDOWN\x10\x01\x12\b\n" +
+	"\x04JSON\x10\x02\x12\b\n" +
+	"\x04HTML\x10\x03*|\n" +
 	"\x0fIntegrationKind\x12\v\n" +
 	"\aWEBHOOK\x10\x00\x12\t\n" +
 	"\x05SLACK\x10\x01\x12\v\n" +
 	"\aDISCORD\x10\x02\x12\n" +
 	"\n" +
 	"\x06GITHUB\x10\x03\x12\f\n" +
-	"\bTELEGRAM\x10\x04*\x8a\x01\n" +
+	"\bTELEGRAM\x10\x04\x12\t\n" +
+	"\x05TEAMS\x10\x05\x12\n" +
+	"\n" +
+	"\x06MATRIX\x10\x06\x12\b\n" +
+	"\x04NTFY\x10\a\x12\t\n" +
+	"\x05EMAIL\x10\b*\x8a\x01\n" +
 	"\x14TelegramPairingState\x12\x19\n" +
 	"\x15TELEGRAM_PAIRING_NONE\x10\x00\x12\x1c\n" +
 	"\x18TELEGRAM_PAIRING_PENDING\x10\x01\x12\x1b\n" +
@@ -10301,17 +15239,22 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\vBulkRestore\x12\x1d.watchfire.BulkRestoreRequest\x1a\x13.watchfire.TaskList\x12C\n" +
 	"\fReorderTasks\x12\x1e.watchfire.ReorderTasksRequest\x1a\x13.watchfire.TaskList\x12K\n" +
 	"\x10CreateTasksBatch\x12\".watchfire.CreateTasksBatchRequest\x1a\x13.watchfire.TaskList\x12N\n" +
-	"\x14ArchiveRetrofitTasks\x12!.watchfire.ArchiveRetrofitRequest\x1a\x13.watchfire.TaskList2\x9a\x02\n" +
+	"\x14ArchiveRetrofitTasks\x12!.watchfire.ArchiveRetrofitRequest\x1a\x13.watchfire.TaskList2\xd1\x02\n" +
 	"\rDaemonService\x12<\n" +
 	"\tGetStatus\x12\x16.google.protobuf.Empty\x1a\x17.watchfire.DaemonStatus\x12:\n" +
 	"\bShutdown\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x126\n" +
 	"\x04Ping\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12W\n" +
-	"\x14SubscribeFocusEvents\x12&.watchfire.SubscribeFocusEventsRequest\x1a\x15.watchfire.FocusEvent0\x012\xc5\x01\n" +
+	"\x14SubscribeFocusEvents\x12&.watchfire.SubscribeFocusEventsRequest\x1a\x15.watchfire.FocusEvent0\x01\x125\n" +
+	"\x05RunGC\x12\x17.watchfire.RunGCRequest\x1a\x13.watchfire.GCReport2\xb2\x03\n" +
 	"\n" +
 	"LogService\x12:\n" +
 	"\bListLogs\x12\x1a.watchfire.ListLogsRequest\x1a\x12.watchfire.LogList\x129\n" +
 	"\x06GetLog\x12\x18.watchfire.GetLogRequest\x1a\x15.watchfire.LogContent\x12@\n" +
-	"\tDeleteLog\x12\x1b.watchfire.DeleteLogRequest\x1a\x16.google.protobuf.Empty2\xd6\x05\n" +
+	"\tDeleteLog\x12\x1b.watchfire.DeleteLogRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\fGetRecording\x12\x1e.watchfire.GetRecordingRequest\x1a\x19.watchfire.RecordingChunk0\x01\x12I\n" +
+	"\n" +
+	"SearchLogs\x12\x1c.watchfire.SearchLogsRequest\x1a\x1d.watchfire.SearchLogsResponse\x12S\n" +
+	"\x10GetSessionEvents\x12\".watchfire.GetSessionEventsRequest\x1a\x1b.watchfire.SessionEventList2\xa2\x06\n" +
 	"\fAgentService\x12B\n" +
 	"\n" +
 	"StartAgent\x12\x1c.watchfire.StartAgentRequest\x1a\x16.watchfire.AgentStatus\x129\n" +
@@ -10323,7 +15266,8 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x06Resize\x12\x18.watchfire.ResizeRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
 	"\x12SubscribeRawOutput\x12$.watchfire.SubscribeRawOutputRequest\x1a\x19.watchfire.RawOutputChunk0\x01\x12W\n" +
 	"\x14SubscribeAgentIssues\x12&.watchfire.SubscribeAgentIssuesRequest\x1a\x15.watchfire.AgentIssue0\x01\x12;\n" +
-	"\vResumeAgent\x12\x14.watchfire.ProjectId\x1a\x16.watchfire.AgentStatus2\xc3\x03\n" +
+	"\vResumeAgent\x12\x14.watchfire.ProjectId\x1a\x16.watchfire.AgentStatus\x12J\n" +
+	"\x0eAnswerQuestion\x12 .watchfire.AnswerQuestionRequest\x1a\x16.google.protobuf.Empty2\xc3\x03\n" +
 	"\rBranchService\x12;\n" +
 	"\fListBranches\x12\x14.watchfire.ProjectId\x1a\x15.watchfire.BranchList\x123\n" +
 	"\tGetBranch\x12\x13.watchfire.BranchId\x1a\x11.watchfire.Branch\x12?\n" +
@@ -10341,12 +15285,13 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x12GetMcpClientStatus\x12\x16.google.protobuf.Empty\x1a\x1e.watchfire.McpClientStatusList\x12R\n" +
 	"\x10InstallMcpClient\x12\".watchfire.InstallMcpClientRequest\x1a\x1a.watchfire.McpClientStatus2g\n" +
 	"\x13NotificationService\x12P\n" +
-	"\tSubscribe\x12(.watchfire.SubscribeNotificationsRequest\x1a\x17.watchfire.Notification0\x012\xd5\x02\n" +
+	"\tSubscribe\x12(.watchfire.SubscribeNotificationsRequest\x1a\x17.watchfire.Notification0\x012\x98\x03\n" +
 	"\x0fInsightsService\x12O\n" +
 	"\fExportReport\x12\x1e.watchfire.ExportReportRequest\x1a\x1f.watchfire.ExportReportResponse\x12S\n" +
 	"\x11GetGlobalInsights\x12#.watchfire.GetGlobalInsightsRequest\x1a\x19.watchfire.GlobalInsights\x12V\n" +
 	"\x12GetProjectInsights\x12$.watchfire.GetProjectInsightsRequest\x1a\x1a.watchfire.ProjectInsights\x12D\n" +
-	"\vGetTaskDiff\x12\x1d.watchfire.GetTaskDiffRequest\x1a\x16.watchfire.FileDiffSet2\xfc\b\n" +
+	"\vGetTaskDiff\x12\x1d.watchfire.GetTaskDiffRequest\x1a\x16.watchfire.FileDiffSet\x12A\n" +
+	"\vGetHotspots\x12\x1d.watchfire.GetHotspotsRequest\x1a\x13.watchfire.Hotspots2\xd5\v\n" +
 	"\x13IntegrationsService\x12U\n" +
 	"\x10ListIntegrations\x12\".watchfire.ListIntegrationsRequest\x1a\x1d.watchfire.IntegrationsConfig\x12S\n" +
 	"\x0fSaveIntegration\x12!.watchfire.SaveIntegrationRequest\x1a\x1d.watchfire.IntegrationsConfig\x12W\n" +
@@ -10361,7 +15306,11 @@ const file_proto_watchfire_proto_rawDesc = "" +
 	"\x0ePostOAuthHello\x12 .watchfire.PostOAuthHelloRequest\x1a!.watchfire.PostOAuthHelloResponse\x12g\n" +
 	"\x14BeginTelegramPairing\x12&.watchfire.BeginTelegramPairingRequest\x1a'.watchfire.BeginTelegramPairingResponse\x12h\n" +
 	"\x18GetTelegramPairingStatus\x12*.watchfire.GetTelegramPairingStatusRequest\x1a .watchfire.TelegramPairingStatus\x12Y\n" +
-	"\x12RevokeTelegramChat\x12$.watchfire.RevokeTelegramChatRequest\x1a\x1d.watchfire.IntegrationsConfigB)Z'github.com/watchfire-io/watchfire/protob\x06proto3"
+	"\x12RevokeTelegramChat\x12$.watchfire.RevokeTelegramChatRequest\x1a\x1d.watchfire.IntegrationsConfig\x12g\n" +
+	"\x14ListFailedDeliveries\x12&.watchfire.ListFailedDeliveriesRequest\x1a'.watchfire.ListFailedDeliveriesResponse\x12L\n" +
+	"\x0eReplayDelivery\x12 .watchfire.ReplayDeliveryRequest\x1a\x18.watchfire.RelayDelivery\x12X\n" +
+	"\x0fPreviewTemplate\x12!.watchfire.PreviewTemplateRequest\x1a\".watchfire.PreviewTemplateResponse\x12F\n" +
+	"\tTestRoute\x12\x1b.watchfire.TestRouteRequest\x1a\x1c.watchfire.TestRouteResponseB)Z'github.com/watchfire-io/watchfire/protob\x06proto3"
 
 var (
 	file_proto_watchfire_proto_rawDescOnce sync.Once
@@ -10376,7 +15325,7 @@ func file_proto_watchfire_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_watchfire_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_proto_watchfire_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_proto_watchfire_proto_msgTypes = make([]protoimpl.MessageInfo, 166)
 var file_proto_watchfire_proto_goTypes = []any{
 	(FocusTarget)(0),                             // 0: watchfire.FocusTarget
 	(NotificationKind)(0),                        // 1: watchfire.NotificationKind
@@ -10417,115 +15366,163 @@ var file_proto_watchfire_proto_goTypes = []any{
 	(*AgentStatus)(nil),                          // 36: watchfire.AgentStatus
 	(*StartAgentRequest)(nil),                    // 37: watchfire.StartAgentRequest
 	(*ScreenBuffer)(nil),                         // 38: watchfire.ScreenBuffer
-	(*SubscribeScreenRequest)(nil),               // 39: watchfire.SubscribeScreenRequest
-	(*ScrollbackRequest)(nil),                    // 40: watchfire.ScrollbackRequest
-	(*ScrollbackLines)(nil),                      // 41: watchfire.ScrollbackLines
-	(*SendInputRequest)(nil),                     // 42: watchfire.SendInputRequest
-	(*ResizeRequest)(nil),                        // 43: watchfire.ResizeRequest
-	(*SubscribeRawOutputRequest)(nil),            // 44: watchfire.SubscribeRawOutputRequest
-	(*RawOutputChunk)(nil),                       // 45: watchfire.RawOutputChunk
-	(*AgentIssue)(nil),                           // 46: watchfire.AgentIssue
-	(*SubscribeAgentIssuesRequest)(nil),          // 47: watchfire.SubscribeAgentIssuesRequest
-	(*Branch)(nil),                               // 48: watchfire.Branch
-	(*BranchList)(nil),                           // 49: watchfire.BranchList
-	(*BranchId)(nil),                             // 50: watchfire.BranchId
-	(*MergeBranchRequest)(nil),                   // 51: watchfire.MergeBranchRequest
-	(*BulkBranchRequest)(nil),                    // 52: watchfire.BulkBranchRequest
-	(*AgentConfig)(nil),                          // 53: watchfire.AgentConfig
-	(*DefaultsConfig)(nil),                       // 54: watchfire.DefaultsConfig
-	(*NotificationsEvents)(nil),                  // 55: watchfire.NotificationsEvents
-	(*NotificationsSounds)(nil),                  // 56: watchfire.NotificationsSounds
-	(*QuietHoursConfig)(nil),                     // 57: watchfire.QuietHoursConfig
-	(*NotificationsConfig)(nil),                  // 58: watchfire.NotificationsConfig
-	(*UpdatesConfig)(nil),                        // 59: watchfire.UpdatesConfig
-	(*AppearanceConfig)(nil),                     // 60: watchfire.AppearanceConfig
-	(*Settings)(nil),                             // 61: watchfire.Settings
-	(*UpdateSettingsRequest)(nil),                // 62: watchfire.UpdateSettingsRequest
-	(*AgentInfo)(nil),                            // 63: watchfire.AgentInfo
-	(*AgentList)(nil),                            // 64: watchfire.AgentList
-	(*McpClientStatus)(nil),                      // 65: watchfire.McpClientStatus
-	(*McpClientStatusList)(nil),                  // 66: watchfire.McpClientStatusList
-	(*InstallMcpClientRequest)(nil),              // 67: watchfire.InstallMcpClientRequest
-	(*SetGitHubAutoPRScopeRequest)(nil),          // 68: watchfire.SetGitHubAutoPRScopeRequest
-	(*SetProjectIntegrationBindingsRequest)(nil), // 69: watchfire.SetProjectIntegrationBindingsRequest
-	(*SubscribeFocusEventsRequest)(nil),          // 70: watchfire.SubscribeFocusEventsRequest
-	(*FocusEvent)(nil),                           // 71: watchfire.FocusEvent
-	(*ListLogsRequest)(nil),                      // 72: watchfire.ListLogsRequest
-	(*LogEntry)(nil),                             // 73: watchfire.LogEntry
-	(*LogList)(nil),                              // 74: watchfire.LogList
-	(*GetLogRequest)(nil),                        // 75: watchfire.GetLogRequest
-	(*LogContent)(nil),                           // 76: watchfire.LogContent
-	(*DeleteLogRequest)(nil),                     // 77: watchfire.DeleteLogRequest
-	(*Notification)(nil),                         // 78: watchfire.Notification
-	(*SubscribeNotificationsRequest)(nil),        // 79: watchfire.SubscribeNotificationsRequest
-	(*ExportReportRequest)(nil),                  // 80: watchfire.ExportReportRequest
-	(*ExportReportResponse)(nil),                 // 81: watchfire.ExportReportResponse
-	(*GetGlobalInsightsRequest)(nil),             // 82: watchfire.GetGlobalInsightsRequest
-	(*DayBucket)(nil),                            // 83: watchfire.DayBucket
-	(*AgentBreakdown)(nil),                       // 84: watchfire.AgentBreakdown
-	(*TopProject)(nil),                           // 85: watchfire.TopProject
-	(*GlobalInsights)(nil),                       // 86: watchfire.GlobalInsights
-	(*GetProjectInsightsRequest)(nil),            // 87: watchfire.GetProjectInsightsRequest
-	(*ProjectInsights)(nil),                      // 88: watchfire.ProjectInsights
-	(*GetTaskDiffRequest)(nil),                   // 89: watchfire.GetTaskDiffRequest
-	(*FileDiffSet)(nil),                          // 90: watchfire.FileDiffSet
-	(*FileDiff)(nil),                             // 91: watchfire.FileDiff
-	(*Hunk)(nil),                                 // 92: watchfire.Hunk
-	(*DiffLine)(nil),                             // 93: watchfire.DiffLine
-	(*IntegrationEvents)(nil),                    // 94: watchfire.IntegrationEvents
-	(*WebhookIntegration)(nil),                   // 95: watchfire.WebhookIntegration
-	(*SlackIntegration)(nil),                     // 96: watchfire.SlackIntegration
-	(*DiscordIntegration)(nil),                   // 97: watchfire.DiscordIntegration
-	(*GitHubIntegration)(nil),                    // 98: watchfire.GitHubIntegration
-	(*TelegramPairedChatInfo)(nil),               // 99: watchfire.TelegramPairedChatInfo
-	(*TelegramIntegration)(nil),                  // 100: watchfire.TelegramIntegration
-	(*IntegrationsConfig)(nil),                   // 101: watchfire.IntegrationsConfig
-	(*ListIntegrationsRequest)(nil),              // 102: watchfire.ListIntegrationsRequest
-	(*SaveIntegrationRequest)(nil),               // 103: watchfire.SaveIntegrationRequest
-	(*DeleteIntegrationRequest)(nil),             // 104: watchfire.DeleteIntegrationRequest
-	(*TestIntegrationRequest)(nil),               // 105: watchfire.TestIntegrationRequest
-	(*TestIntegrationResponse)(nil),              // 106: watchfire.TestIntegrationResponse
-	(*BeginTelegramPairingRequest)(nil),          // 107: watchfire.BeginTelegramPairingRequest
-	(*BeginTelegramPairingResponse)(nil),         // 108: watchfire.BeginTelegramPairingResponse
-	(*GetTelegramPairingStatusRequest)(nil),      // 109: watchfire.GetTelegramPairingStatusRequest
-	(*TelegramPairingStatus)(nil),                // 110: watchfire.TelegramPairingStatus
-	(*RevokeTelegramChatRequest)(nil),            // 111: watchfire.RevokeTelegramChatRequest
-	(*BeginOAuthRequest)(nil),                    // 112: watchfire.BeginOAuthRequest
-	(*BeginOAuthResponse)(nil),                   // 113: watchfire.BeginOAuthResponse
-	(*GetOAuthStatusRequest)(nil),                // 114: watchfire.GetOAuthStatusRequest
-	(*OAuthStatus)(nil),                          // 115: watchfire.OAuthStatus
-	(*CancelOAuthRequest)(nil),                   // 116: watchfire.CancelOAuthRequest
-	(*PostOAuthHelloRequest)(nil),                // 117: watchfire.PostOAuthHelloRequest
-	(*PostOAuthHelloResponse)(nil),               // 118: watchfire.PostOAuthHelloResponse
-	(*InboundConfig)(nil),                        // 119: watchfire.InboundConfig
-	(*InboundStatus)(nil),                        // 120: watchfire.InboundStatus
-	(*GetInboundStatusRequest)(nil),              // 121: watchfire.GetInboundStatusRequest
-	(*SaveInboundConfigRequest)(nil),             // 122: watchfire.SaveInboundConfigRequest
-	(*DiscordGuildRegistration)(nil),             // 123: watchfire.DiscordGuildRegistration
-	nil,                                          // 124: watchfire.ProjectNotifications.EventsEntry
-	nil,                                          // 125: watchfire.Settings.AgentsEntry
-	nil,                                          // 126: watchfire.UpdateSettingsRequest.AgentsEntry
-	(*timestamppb.Timestamp)(nil),                // 127: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                        // 128: google.protobuf.Empty
+	(*ScreenRowDelta)(nil),                       // 39: watchfire.ScreenRowDelta
+	(*SubscribeScreenRequest)(nil),               // 40: watchfire.SubscribeScreenRequest
+	(*ScrollbackRequest)(nil),                    // 41: watchfire.ScrollbackRequest
+	(*ScrollbackLines)(nil),                      // 42: watchfire.ScrollbackLines
+	(*SendInputRequest)(nil),                     // 43: watchfire.SendInputRequest
+	(*ResizeRequest)(nil),                        // 44: watchfire.ResizeRequest
+	(*SubscribeRawOutputRequest)(nil),            // 45: watchfire.SubscribeRawOutputRequest
+	(*RawOutputChunk)(nil),                       // 46: watchfire.RawOutputChunk
+	(*AgentIssue)(nil),                           // 47: watchfire.AgentIssue
+	(*AnswerQuestionRequest)(nil),                // 48: watchfire.AnswerQuestionRequest
+	(*SubscribeAgentIssuesRequest)(nil),          // 49: watchfire.SubscribeAgentIssuesRequest
+	(*Branch)(nil),                               // 50: watchfire.Branch
+	(*BranchList)(nil),                           // 51: watchfire.BranchList
+	(*BranchId)(nil),                             // 52: watchfire.BranchId
+	(*MergeBranchRequest)(nil),                   // 53: watchfire.MergeBranchRequest
+	(*BulkBranchRequest)(nil),                    // 54: watchfire.BulkBranchRequest
+	(*AgentConfig)(nil),                          // 55: watchfire.AgentConfig
+	(*DefaultsConfig)(nil),                       // 56: watchfire.DefaultsConfig
+	(*NotificationsEvents)(nil),                  // 57: watchfire.NotificationsEvents
+	(*NotificationsSounds)(nil),                  // 58: watchfire.NotificationsSounds
+	(*QuietHoursConfig)(nil),                     // 59: watchfire.QuietHoursConfig
+	(*NotificationsConfig)(nil),                  // 60: watchfire.NotificationsConfig
+	(*UpdatesConfig)(nil),                        // 61: watchfire.UpdatesConfig
+	(*AppearanceConfig)(nil),                     // 62: watchfire.AppearanceConfig
+	(*RecordingsConfig)(nil),                     // 63: watchfire.RecordingsConfig
+	(*RetentionConfig)(nil),                      // 64: watchfire.RetentionConfig
+	(*MetricsEndpointConfig)(nil),                // 65: watchfire.MetricsEndpointConfig
+	(*TracingConfig)(nil),                        // 66: watchfire.TracingConfig
+	(*TokenPrice)(nil),                           // 67: watchfire.TokenPrice
+	(*PricingConfig)(nil),                        // 68: watchfire.PricingConfig
+	(*BudgetConfig)(nil),                         // 69: watchfire.BudgetConfig
+	(*Settings)(nil),                             // 70: watchfire.Settings
+	(*UpdateSettingsRequest)(nil),                // 71: watchfire.UpdateSettingsRequest
+	(*AgentInfo)(nil),                            // 72: watchfire.AgentInfo
+	(*AgentList)(nil),                            // 73: watchfire.AgentList
+	(*McpClientStatus)(nil),                      // 74: watchfire.McpClientStatus
+	(*McpClientStatusList)(nil),                  // 75: watchfire.McpClientStatusList
+	(*InstallMcpClientRequest)(nil),              // 76: watchfire.InstallMcpClientRequest
+	(*SetGitHubAutoPRScopeRequest)(nil),          // 77: watchfire.SetGitHubAutoPRScopeRequest
+	(*SetProjectIntegrationBindingsRequest)(nil), // 78: watchfire.SetProjectIntegrationBindingsRequest
+	(*RunGCRequest)(nil),                         // 79: watchfire.RunGCRequest
+	(*GCItem)(nil),                               // 80: watchfire.GCItem
+	(*GCReport)(nil),                             // 81: watchfire.GCReport
+	(*SubscribeFocusEventsRequest)(nil),          // 82: watchfire.SubscribeFocusEventsRequest
+	(*FocusEvent)(nil),                           // 83: watchfire.FocusEvent
+	(*ListLogsRequest)(nil),                      // 84: watchfire.ListLogsRequest
+	(*LogEntry)(nil),                             // 85: watchfire.LogEntry
+	(*LogList)(nil),                              // 86: watchfire.LogList
+	(*GetLogRequest)(nil),                        // 87: watchfire.GetLogRequest
+	(*LogContent)(nil),                           // 88: watchfire.LogContent
+	(*DeleteLogRequest)(nil),                     // 89: watchfire.DeleteLogRequest
+	(*GetRecordingRequest)(nil),                  // 90: watchfire.GetRecordingRequest
+	(*RecordingChunk)(nil),                       // 91: watchfire.RecordingChunk
+	(*SearchLogsRequest)(nil),                    // 92: watchfire.SearchLogsRequest
+	(*LogSearchHit)(nil),                         // 93: watchfire.LogSearchHit
+	(*SearchLogsResponse)(nil),                   // 94: watchfire.SearchLogsResponse
+	(*GetSessionEventsRequest)(nil),              // 95: watchfire.GetSessionEventsRequest
+	(*SessionEvent)(nil),                         // 96: watchfire.SessionEvent
+	(*SessionEventList)(nil),                     // 97: watchfire.SessionEventList
+	(*Notification)(nil),                         // 98: watchfire.Notification
+	(*SubscribeNotificationsRequest)(nil),        // 99: watchfire.SubscribeNotificationsRequest
+	(*ExportReportRequest)(nil),                  // 100: watchfire.ExportReportRequest
+	(*ExportReportResponse)(nil),                 // 101: watchfire.ExportReportResponse
+	(*GetGlobalInsightsRequest)(nil),             // 102: watchfire.GetGlobalInsightsRequest
+	(*DayBucket)(nil),                            // 103: watchfire.DayBucket
+	(*AgentBreakdown)(nil),                       // 104: watchfire.AgentBreakdown
+	(*AgentComparison)(nil),                      // 105: watchfire.AgentComparison
+	(*UserUsage)(nil),                            // 106: watchfire.UserUsage
+	(*InsightsOverhead)(nil),                     // 107: watchfire.InsightsOverhead
+	(*OverheadKind)(nil),                         // 108: watchfire.OverheadKind
+	(*MetricDelta)(nil),                          // 109: watchfire.MetricDelta
+	(*InsightsComparison)(nil),                   // 110: watchfire.InsightsComparison
+	(*TrendWeek)(nil),                            // 111: watchfire.TrendWeek
+	(*TopProject)(nil),                           // 112: watchfire.TopProject
+	(*GlobalInsights)(nil),                       // 113: watchfire.GlobalInsights
+	(*BudgetStatus)(nil),                         // 114: watchfire.BudgetStatus
+	(*GetProjectInsightsRequest)(nil),            // 115: watchfire.GetProjectInsightsRequest
+	(*ProjectInsights)(nil),                      // 116: watchfire.ProjectInsights
+	(*GetTaskDiffRequest)(nil),                   // 117: watchfire.GetTaskDiffRequest
+	(*FileDiffSet)(nil),                          // 118: watchfire.FileDiffSet
+	(*FileDiff)(nil),                             // 119: watchfire.FileDiff
+	(*Hunk)(nil),                                 // 120: watchfire.Hunk
+	(*DiffLine)(nil),                             // 121: watchfire.DiffLine
+	(*GetHotspotsRequest)(nil),                   // 122: watchfire.GetHotspotsRequest
+	(*Hotspots)(nil),                             // 123: watchfire.Hotspots
+	(*HotspotFile)(nil),                          // 124: watchfire.HotspotFile
+	(*HotspotDirectory)(nil),                     // 125: watchfire.HotspotDirectory
+	(*IntegrationEvents)(nil),                    // 126: watchfire.IntegrationEvents
+	(*WebhookIntegration)(nil),                   // 127: watchfire.WebhookIntegration
+	(*SlackIntegration)(nil),                     // 128: watchfire.SlackIntegration
+	(*DiscordIntegration)(nil),                   // 129: watchfire.DiscordIntegration
+	(*TeamsIntegration)(nil),                     // 130: watchfire.TeamsIntegration
+	(*MatrixIntegration)(nil),                    // 131: watchfire.MatrixIntegration
+	(*NtfyIntegration)(nil),                      // 132: watchfire.NtfyIntegration
+	(*EmailRecipient)(nil),                       // 133: watchfire.EmailRecipient
+	(*EmailIntegration)(nil),                     // 134: watchfire.EmailIntegration
+	(*GitHubIntegration)(nil),                    // 135: watchfire.GitHubIntegration
+	(*TelegramPairedChatInfo)(nil),               // 136: watchfire.TelegramPairedChatInfo
+	(*TelegramIntegration)(nil),                  // 137: watchfire.TelegramIntegration
+	(*IntegrationsConfig)(nil),                   // 138: watchfire.IntegrationsConfig
+	(*ListIntegrationsRequest)(nil),              // 139: watchfire.ListIntegrationsRequest
+	(*SaveIntegrationRequest)(nil),               // 140: watchfire.SaveIntegrationRequest
+	(*DeleteIntegrationRequest)(nil),             // 141: watchfire.DeleteIntegrationRequest
+	(*TestIntegrationRequest)(nil),               // 142: watchfire.TestIntegrationRequest
+	(*TestIntegrationResponse)(nil),              // 143: watchfire.TestIntegrationResponse
+	(*RelayDelivery)(nil),                        // 144: watchfire.RelayDelivery
+	(*ListFailedDeliveriesRequest)(nil),          // 145: watchfire.ListFailedDeliveriesRequest
+	(*ListFailedDeliveriesResponse)(nil),         // 146: watchfire.ListFailedDeliveriesResponse
+	(*ReplayDeliveryRequest)(nil),                // 147: watchfire.ReplayDeliveryRequest
+	(*PreviewTemplateRequest)(nil),               // 148: watchfire.PreviewTemplateRequest
+	(*PreviewTemplateResponse)(nil),              // 149: watchfire.PreviewTemplateResponse
+	(*TestRouteRequest)(nil),                     // 150: watchfire.TestRouteRequest
+	(*RouteTarget)(nil),                          // 151: watchfire.RouteTarget
+	(*TestRouteResponse)(nil),                    // 152: watchfire.TestRouteResponse
+	(*BeginTelegramPairingRequest)(nil),          // 153: watchfire.BeginTelegramPairingRequest
+	(*BeginTelegramPairingResponse)(nil),         // 154: watchfire.BeginTelegramPairingResponse
+	(*GetTelegramPairingStatusRequest)(nil),      // 155: watchfire.GetTelegramPairingStatusRequest
+	(*TelegramPairingStatus)(nil),                // 156: watchfire.TelegramPairingStatus
+	(*RevokeTelegramChatRequest)(nil),            // 157: watchfire.RevokeTelegramChatRequest
+	(*BeginOAuthRequest)(nil),                    // 158: watchfire.BeginOAuthRequest
+	(*BeginOAuthResponse)(nil),                   // 159: watchfire.BeginOAuthResponse
+	(*GetOAuthStatusRequest)(nil),                // 160: watchfire.GetOAuthStatusRequest
+	(*OAuthStatus)(nil),                          // 161: watchfire.OAuthStatus
+	(*CancelOAuthRequest)(nil),                   // 162: watchfire.CancelOAuthRequest
+	(*PostOAuthHelloRequest)(nil),                // 163: watchfire.PostOAuthHelloRequest
+	(*PostOAuthHelloResponse)(nil),               // 164: watchfire.PostOAuthHelloResponse
+	(*InboundConfig)(nil),                        // 165: watchfire.InboundConfig
+	(*InboundStatus)(nil),                        // 166: watchfire.InboundStatus
+	(*GetInboundStatusRequest)(nil),              // 167: watchfire.GetInboundStatusRequest
+	(*SaveInboundConfigRequest)(nil),             // 168: watchfire.SaveInboundConfigRequest
+	(*DiscordGuildRegistration)(nil),             // 169: watchfire.DiscordGuildRegistration
+	nil,                                          // 170: watchfire.ProjectNotifications.EventsEntry
+	nil,                                          // 171: watchfire.TracingConfig.HeadersEntry
+	nil,                                          // 172: watchfire.Settings.AgentsEntry
+	nil,                                          // 173: watchfire.UpdateSettingsRequest.AgentsEntry
+	nil,                                          // 174: watchfire.IntegrationEvents.EventsEntry
+	(*timestamppb.Timestamp)(nil),                // 175: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                        // 176: google.protobuf.Empty
 }
 var file_proto_watchfire_proto_depIdxs = []int32{
-	127, // 0: watchfire.Project.created_at:type_name -> google.protobuf.Timestamp
-	127, // 1: watchfire.Project.updated_at:type_name -> google.protobuf.Timestamp
+	175, // 0: watchfire.Project.created_at:type_name -> google.protobuf.Timestamp
+	175, // 1: watchfire.Project.updated_at:type_name -> google.protobuf.Timestamp
 	12,  // 2: watchfire.Project.notifications:type_name -> watchfire.ProjectNotifications
 	11,  // 3: watchfire.Project.integrations:type_name -> watchfire.ProjectIntegrations
-	124, // 4: watchfire.ProjectNotifications.events:type_name -> watchfire.ProjectNotifications.EventsEntry
-	57,  // 5: watchfire.ProjectNotifications.quiet_hours_override:type_name -> watchfire.QuietHoursConfig
+	170, // 4: watchfire.ProjectNotifications.events:type_name -> watchfire.ProjectNotifications.EventsEntry
+	59,  // 5: watchfire.ProjectNotifications.quiet_hours_override:type_name -> watchfire.QuietHoursConfig
 	9,   // 6: watchfire.ProjectId.meta:type_name -> watchfire.RequestMeta
 	10,  // 7: watchfire.ProjectList.projects:type_name -> watchfire.Project
 	9,   // 8: watchfire.CreateProjectRequest.meta:type_name -> watchfire.RequestMeta
 	9,   // 9: watchfire.UpdateProjectRequest.meta:type_name -> watchfire.RequestMeta
 	12,  // 10: watchfire.UpdateProjectRequest.notifications:type_name -> watchfire.ProjectNotifications
 	9,   // 11: watchfire.ReorderProjectsRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 12: watchfire.Task.created_at:type_name -> google.protobuf.Timestamp
-	127, // 13: watchfire.Task.started_at:type_name -> google.protobuf.Timestamp
-	127, // 14: watchfire.Task.completed_at:type_name -> google.protobuf.Timestamp
-	127, // 15: watchfire.Task.updated_at:type_name -> google.protobuf.Timestamp
-	127, // 16: watchfire.Task.deleted_at:type_name -> google.protobuf.Timestamp
+	175, // 12: watchfire.Task.created_at:type_name -> google.protobuf.Timestamp
+	175, // 13: watchfire.Task.started_at:type_name -> google.protobuf.Timestamp
+	175, // 14: watchfire.Task.completed_at:type_name -> google.protobuf.Timestamp
+	175, // 15: watchfire.Task.updated_at:type_name -> google.protobuf.Timestamp
+	175, // 16: watchfire.Task.deleted_at:type_name -> google.protobuf.Timestamp
 	9,   // 17: watchfire.TaskId.meta:type_name -> watchfire.RequestMeta
 	20,  // 18: watchfire.TaskList.tasks:type_name -> watchfire.Task
 	23,  // 19: watchfire.MalformedTaskList.tasks:type_name -> watchfire.MalformedTask
@@ -10539,276 +15536,374 @@ var file_proto_watchfire_proto_depIdxs = []int32{
 	9,   // 27: watchfire.CreateTasksBatchRequest.meta:type_name -> watchfire.RequestMeta
 	9,   // 28: watchfire.ArchiveRetrofitRequest.meta:type_name -> watchfire.RequestMeta
 	9,   // 29: watchfire.ReorderTasksRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 30: watchfire.DaemonStatus.started_at:type_name -> google.protobuf.Timestamp
-	46,  // 31: watchfire.AgentStatus.issue:type_name -> watchfire.AgentIssue
-	127, // 32: watchfire.AgentStatus.started_at:type_name -> google.protobuf.Timestamp
+	175, // 30: watchfire.DaemonStatus.started_at:type_name -> google.protobuf.Timestamp
+	47,  // 31: watchfire.AgentStatus.issue:type_name -> watchfire.AgentIssue
+	175, // 32: watchfire.AgentStatus.started_at:type_name -> google.protobuf.Timestamp
 	9,   // 33: watchfire.StartAgentRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 34: watchfire.SubscribeScreenRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 35: watchfire.ScrollbackRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 36: watchfire.SendInputRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 37: watchfire.ResizeRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 38: watchfire.SubscribeRawOutputRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 39: watchfire.AgentIssue.detected_at:type_name -> google.protobuf.Timestamp
-	127, // 40: watchfire.AgentIssue.reset_at:type_name -> google.protobuf.Timestamp
-	127, // 41: watchfire.AgentIssue.cooldown_until:type_name -> google.protobuf.Timestamp
-	9,   // 42: watchfire.SubscribeAgentIssuesRequest.meta:type_name -> watchfire.RequestMeta
-	48,  // 43: watchfire.BranchList.branches:type_name -> watchfire.Branch
-	9,   // 44: watchfire.BranchId.meta:type_name -> watchfire.RequestMeta
-	9,   // 45: watchfire.MergeBranchRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 46: watchfire.BulkBranchRequest.meta:type_name -> watchfire.RequestMeta
-	58,  // 47: watchfire.DefaultsConfig.notifications:type_name -> watchfire.NotificationsConfig
-	55,  // 48: watchfire.NotificationsConfig.events:type_name -> watchfire.NotificationsEvents
-	56,  // 49: watchfire.NotificationsConfig.sounds:type_name -> watchfire.NotificationsSounds
-	57,  // 50: watchfire.NotificationsConfig.quiet_hours:type_name -> watchfire.QuietHoursConfig
-	125, // 51: watchfire.Settings.agents:type_name -> watchfire.Settings.AgentsEntry
-	54,  // 52: watchfire.Settings.defaults:type_name -> watchfire.DefaultsConfig
-	59,  // 53: watchfire.Settings.updates:type_name -> watchfire.UpdatesConfig
-	60,  // 54: watchfire.Settings.appearance:type_name -> watchfire.AppearanceConfig
-	9,   // 55: watchfire.UpdateSettingsRequest.meta:type_name -> watchfire.RequestMeta
-	54,  // 56: watchfire.UpdateSettingsRequest.defaults:type_name -> watchfire.DefaultsConfig
-	59,  // 57: watchfire.UpdateSettingsRequest.updates:type_name -> watchfire.UpdatesConfig
-	60,  // 58: watchfire.UpdateSettingsRequest.appearance:type_name -> watchfire.AppearanceConfig
-	126, // 59: watchfire.UpdateSettingsRequest.agents:type_name -> watchfire.UpdateSettingsRequest.AgentsEntry
-	63,  // 60: watchfire.AgentList.agents:type_name -> watchfire.AgentInfo
-	65,  // 61: watchfire.McpClientStatusList.clients:type_name -> watchfire.McpClientStatus
-	9,   // 62: watchfire.InstallMcpClientRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 63: watchfire.SetGitHubAutoPRScopeRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 64: watchfire.SetProjectIntegrationBindingsRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 65: watchfire.SubscribeFocusEventsRequest.meta:type_name -> watchfire.RequestMeta
-	0,   // 66: watchfire.FocusEvent.target:type_name -> watchfire.FocusTarget
-	9,   // 67: watchfire.ListLogsRequest.meta:type_name -> watchfire.RequestMeta
-	73,  // 68: watchfire.LogList.logs:type_name -> watchfire.LogEntry
-	9,   // 69: watchfire.GetLogRequest.meta:type_name -> watchfire.RequestMeta
-	73,  // 70: watchfire.LogContent.entry:type_name -> watchfire.LogEntry
-	9,   // 71: watchfire.DeleteLogRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 72: watchfire.Notification.emitted_at:type_name -> google.protobuf.Timestamp
-	1,   // 73: watchfire.Notification.kind:type_name -> watchfire.NotificationKind
-	9,   // 74: watchfire.SubscribeNotificationsRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 75: watchfire.ExportReportRequest.meta:type_name -> watchfire.RequestMeta
-	2,   // 76: watchfire.ExportReportRequest.format:type_name -> watchfire.ExportFormat
-	127, // 77: watchfire.ExportReportRequest.window_start:type_name -> google.protobuf.Timestamp
-	127, // 78: watchfire.ExportReportRequest.window_end:type_name -> google.protobuf.Timestamp
-	9,   // 79: watchfire.GetGlobalInsightsRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 80: watchfire.GetGlobalInsightsRequest.window_start:type_name -> google.protobuf.Timestamp
-	127, // 81: watchfire.GetGlobalInsightsRequest.window_end:type_name -> google.protobuf.Timestamp
-	83,  // 82: watchfire.GlobalInsights.tasks_by_day:type_name -> watchfire.DayBucket
-	85,  // 83: watchfire.GlobalInsights.top_projects:type_name -> watchfire.TopProject
-	84,  // 84: watchfire.GlobalInsights.agent_breakdown:type_name -> watchfire.AgentBreakdown
-	127, // 85: watchfire.GlobalInsights.window_start:type_name -> google.protobuf.Timestamp
-	127, // 86: watchfire.GlobalInsights.window_end:type_name -> google.protobuf.Timestamp
-	9,   // 87: watchfire.GetProjectInsightsRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 88: watchfire.GetProjectInsightsRequest.window_start:type_name -> google.protobuf.Timestamp
-	127, // 89: watchfire.GetProjectInsightsRequest.window_end:type_name -> google.protobuf.Timestamp
-	83,  // 90: watchfire.ProjectInsights.tasks_by_day:type_name -> watchfire.DayBucket
-	84,  // 91: watchfire.ProjectInsights.agent_breakdown:type_name -> watchfire.AgentBreakdown
-	127, // 92: watchfire.ProjectInsights.window_start:type_name -> google.protobuf.Timestamp
-	127, // 93: watchfire.ProjectInsights.window_end:type_name -> google.protobuf.Timestamp
-	9,   // 94: watchfire.GetTaskDiffRequest.meta:type_name -> watchfire.RequestMeta
-	91,  // 95: watchfire.FileDiffSet.files:type_name -> watchfire.FileDiff
-	7,   // 96: watchfire.FileDiff.status:type_name -> watchfire.FileDiff.Status
-	92,  // 97: watchfire.FileDiff.hunks:type_name -> watchfire.Hunk
-	93,  // 98: watchfire.Hunk.lines:type_name -> watchfire.DiffLine
-	8,   // 99: watchfire.DiffLine.kind:type_name -> watchfire.DiffLine.Kind
-	94,  // 100: watchfire.WebhookIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
-	94,  // 101: watchfire.SlackIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
-	94,  // 102: watchfire.DiscordIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
-	127, // 103: watchfire.TelegramPairedChatInfo.paired_at:type_name -> google.protobuf.Timestamp
-	94,  // 104: watchfire.TelegramIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
-	99,  // 105: watchfire.TelegramIntegration.paired_chats:type_name -> watchfire.TelegramPairedChatInfo
-	95,  // 106: watchfire.IntegrationsConfig.webhooks:type_name -> watchfire.WebhookIntegration
-	96,  // 107: watchfire.IntegrationsConfig.slack:type_name -> watchfire.SlackIntegration
-	97,  // 108: watchfire.IntegrationsConfig.discord:type_name -> watchfire.DiscordIntegration
-	98,  // 109: watchfire.IntegrationsConfig.github:type_name -> watchfire.GitHubIntegration
-	100, // 110: watchfire.IntegrationsConfig.telegram:type_name -> watchfire.TelegramIntegration
-	9,   // 111: watchfire.ListIntegrationsRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 112: watchfire.SaveIntegrationRequest.meta:type_name -> watchfire.RequestMeta
-	95,  // 113: watchfire.SaveIntegrationRequest.webhook:type_name -> watchfire.WebhookIntegration
-	96,  // 114: watchfire.SaveIntegrationRequest.slack:type_name -> watchfire.SlackIntegration
-	97,  // 115: watchfire.SaveIntegrationRequest.discord:type_name -> watchfire.DiscordIntegration
-	98,  // 116: watchfire.SaveIntegrationRequest.github:type_name -> watchfire.GitHubIntegration
-	100, // 117: watchfire.SaveIntegrationRequest.telegram:type_name -> watchfire.TelegramIntegration
-	9,   // 118: watchfire.DeleteIntegrationRequest.meta:type_name -> watchfire.RequestMeta
-	3,   // 119: watchfire.DeleteIntegrationRequest.kind:type_name -> watchfire.IntegrationKind
-	9,   // 120: watchfire.TestIntegrationRequest.meta:type_name -> watchfire.RequestMeta
-	3,   // 121: watchfire.TestIntegrationRequest.kind:type_name -> watchfire.IntegrationKind
-	9,   // 122: watchfire.BeginTelegramPairingRequest.meta:type_name -> watchfire.RequestMeta
-	127, // 123: watchfire.BeginTelegramPairingResponse.expires_at:type_name -> google.protobuf.Timestamp
-	9,   // 124: watchfire.GetTelegramPairingStatusRequest.meta:type_name -> watchfire.RequestMeta
-	4,   // 125: watchfire.TelegramPairingStatus.state:type_name -> watchfire.TelegramPairingState
-	127, // 126: watchfire.TelegramPairingStatus.expires_at:type_name -> google.protobuf.Timestamp
-	99,  // 127: watchfire.TelegramPairingStatus.chat:type_name -> watchfire.TelegramPairedChatInfo
-	9,   // 128: watchfire.RevokeTelegramChatRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 129: watchfire.BeginOAuthRequest.meta:type_name -> watchfire.RequestMeta
-	5,   // 130: watchfire.BeginOAuthRequest.provider:type_name -> watchfire.OAuthProvider
-	9,   // 131: watchfire.GetOAuthStatusRequest.meta:type_name -> watchfire.RequestMeta
-	5,   // 132: watchfire.GetOAuthStatusRequest.provider:type_name -> watchfire.OAuthProvider
-	5,   // 133: watchfire.OAuthStatus.provider:type_name -> watchfire.OAuthProvider
-	6,   // 134: watchfire.OAuthStatus.state:type_name -> watchfire.OAuthState
-	9,   // 135: watchfire.CancelOAuthRequest.meta:type_name -> watchfire.RequestMeta
-	5,   // 136: watchfire.CancelOAuthRequest.provider:type_name -> watchfire.OAuthProvider
-	9,   // 137: watchfire.PostOAuthHelloRequest.meta:type_name -> watchfire.RequestMeta
-	5,   // 138: watchfire.PostOAuthHelloRequest.provider:type_name -> watchfire.OAuthProvider
-	119, // 139: watchfire.InboundStatus.config:type_name -> watchfire.InboundConfig
-	123, // 140: watchfire.InboundStatus.discord_guilds:type_name -> watchfire.DiscordGuildRegistration
-	9,   // 141: watchfire.GetInboundStatusRequest.meta:type_name -> watchfire.RequestMeta
-	9,   // 142: watchfire.SaveInboundConfigRequest.meta:type_name -> watchfire.RequestMeta
-	119, // 143: watchfire.SaveInboundConfigRequest.config:type_name -> watchfire.InboundConfig
-	13,  // 144: watchfire.ProjectNotifications.EventsEntry.value:type_name -> watchfire.ProjectEventPref
-	53,  // 145: watchfire.Settings.AgentsEntry.value:type_name -> watchfire.AgentConfig
-	53,  // 146: watchfire.UpdateSettingsRequest.AgentsEntry.value:type_name -> watchfire.AgentConfig
-	128, // 147: watchfire.ProjectService.ListProjects:input_type -> google.protobuf.Empty
-	14,  // 148: watchfire.ProjectService.GetProject:input_type -> watchfire.ProjectId
-	16,  // 149: watchfire.ProjectService.CreateProject:input_type -> watchfire.CreateProjectRequest
-	17,  // 150: watchfire.ProjectService.UpdateProject:input_type -> watchfire.UpdateProjectRequest
-	14,  // 151: watchfire.ProjectService.DeleteProject:input_type -> watchfire.ProjectId
-	14,  // 152: watchfire.ProjectService.GetGitInfo:input_type -> watchfire.ProjectId
-	18,  // 153: watchfire.ProjectService.ReorderProjects:input_type -> watchfire.ReorderProjectsRequest
-	14,  // 154: watchfire.ProjectService.RegenerateProjectId:input_type -> watchfire.ProjectId
-	14,  // 155: watchfire.ProjectService.ResetTaskNumbering:input_type -> watchfire.ProjectId
-	14,  // 156: watchfire.ProjectService.UnregisterProject:input_type -> watchfire.ProjectId
-	68,  // 157: watchfire.ProjectService.SetGitHubAutoPRScope:input_type -> watchfire.SetGitHubAutoPRScopeRequest
-	69,  // 158: watchfire.ProjectService.SetProjectIntegrationBindings:input_type -> watchfire.SetProjectIntegrationBindingsRequest
-	26,  // 159: watchfire.TaskService.ListTasks:input_type -> watchfire.ListTasksRequest
-	25,  // 160: watchfire.TaskService.ListMalformedTasks:input_type -> watchfire.ListMalformedTasksRequest
-	21,  // 161: watchfire.TaskService.GetTask:input_type -> watchfire.TaskId
-	27,  // 162: watchfire.TaskService.CreateTask:input_type -> watchfire.CreateTaskRequest
-	28,  // 163: watchfire.TaskService.UpdateTask:input_type -> watchfire.UpdateTaskRequest
-	21,  // 164: watchfire.TaskService.DeleteTask:input_type -> watchfire.TaskId
-	21,  // 165: watchfire.TaskService.RestoreTask:input_type -> watchfire.TaskId
-	21,  // 166: watchfire.TaskService.PermanentDeleteTask:input_type -> watchfire.TaskId
-	14,  // 167: watchfire.TaskService.EmptyTrash:input_type -> watchfire.ProjectId
-	29,  // 168: watchfire.TaskService.BulkUpdateStatus:input_type -> watchfire.BulkUpdateStatusRequest
-	30,  // 169: watchfire.TaskService.BulkDelete:input_type -> watchfire.BulkDeleteRequest
-	31,  // 170: watchfire.TaskService.BulkRestore:input_type -> watchfire.BulkRestoreRequest
-	34,  // 171: watchfire.TaskService.ReorderTasks:input_type -> watchfire.ReorderTasksRequest
-	32,  // 172: watchfire.TaskService.CreateTasksBatch:input_type -> watchfire.CreateTasksBatchRequest
-	33,  // 173: watchfire.TaskService.ArchiveRetrofitTasks:input_type -> watchfire.ArchiveRetrofitRequest
-	128, // 174: watchfire.DaemonService.GetStatus:input_type -> google.protobuf.Empty
-	128, // 175: watchfire.DaemonService.Shutdown:input_type -> google.protobuf.Empty
-	128, // 176: watchfire.DaemonService.Ping:input_type -> google.protobuf.Empty
-	70,  // 177: watchfire.DaemonService.SubscribeFocusEvents:input_type -> watchfire.SubscribeFocusEventsRequest
-	72,  // 178: watchfire.LogService.ListLogs:input_type -> watchfire.ListLogsRequest
-	75,  // 179: watchfire.LogService.GetLog:input_type -> watchfire.GetLogRequest
-	77,  // 180: watchfire.LogService.DeleteLog:input_type -> watchfire.DeleteLogRequest
-	37,  // 181: watchfire.AgentService.StartAgent:input_type -> watchfire.StartAgentRequest
-	14,  // 182: watchfire.AgentService.StopAgent:input_type -> watchfire.ProjectId
-	14,  // 183: watchfire.AgentService.GetAgentStatus:input_type -> watchfire.ProjectId
-	39,  // 184: watchfire.AgentService.SubscribeScreen:input_type -> watchfire.SubscribeScreenRequest
-	40,  // 185: watchfire.AgentService.GetScrollback:input_type -> watchfire.ScrollbackRequest
-	42,  // 186: watchfire.AgentService.SendInput:input_type -> watchfire.SendInputRequest
-	43,  // 187: watchfire.AgentService.Resize:input_type -> watchfire.ResizeRequest
-	44,  // 188: watchfire.AgentService.SubscribeRawOutput:input_type -> watchfire.SubscribeRawOutputRequest
-	47,  // 189: watchfire.AgentService.SubscribeAgentIssues:input_type -> watchfire.SubscribeAgentIssuesRequest
-	14,  // 190: watchfire.AgentService.ResumeAgent:input_type -> watchfire.ProjectId
-	14,  // 191: watchfire.BranchService.ListBranches:input_type -> watchfire.ProjectId
-	50,  // 192: watchfire.BranchService.GetBranch:input_type -> watchfire.BranchId
-	51,  // 193: watchfire.BranchService.MergeBranch:input_type -> watchfire.MergeBranchRequest
-	50,  // 194: watchfire.BranchService.DeleteBranch:input_type -> watchfire.BranchId
-	14,  // 195: watchfire.BranchService.PruneBranches:input_type -> watchfire.ProjectId
-	52,  // 196: watchfire.BranchService.BulkMerge:input_type -> watchfire.BulkBranchRequest
-	52,  // 197: watchfire.BranchService.BulkDelete:input_type -> watchfire.BulkBranchRequest
-	128, // 198: watchfire.SettingsService.GetSettings:input_type -> google.protobuf.Empty
-	62,  // 199: watchfire.SettingsService.UpdateSettings:input_type -> watchfire.UpdateSettingsRequest
-	128, // 200: watchfire.SettingsService.ListAgents:input_type -> google.protobuf.Empty
-	128, // 201: watchfire.SettingsService.GetMcpClientStatus:input_type -> google.protobuf.Empty
-	67,  // 202: watchfire.SettingsService.InstallMcpClient:input_type -> watchfire.InstallMcpClientRequest
-	79,  // 203: watchfire.NotificationService.Subscribe:input_type -> watchfire.SubscribeNotificationsRequest
-	80,  // 204: watchfire.InsightsService.ExportReport:input_type -> watchfire.ExportReportRequest
-	82,  // 205: watchfire.InsightsService.GetGlobalInsights:input_type -> watchfire.GetGlobalInsightsRequest
-	87,  // 206: watchfire.InsightsService.GetProjectInsights:input_type -> watchfire.GetProjectInsightsRequest
-	89,  // 207: watchfire.InsightsService.GetTaskDiff:input_type -> watchfire.GetTaskDiffRequest
-	102, // 208: watchfire.IntegrationsService.ListIntegrations:input_type -> watchfire.ListIntegrationsRequest
-	103, // 209: watchfire.IntegrationsService.SaveIntegration:input_type -> watchfire.SaveIntegrationRequest
-	104, // 210: watchfire.IntegrationsService.DeleteIntegration:input_type -> watchfire.DeleteIntegrationRequest
-	105, // 211: watchfire.IntegrationsService.TestIntegration:input_type -> watchfire.TestIntegrationRequest
-	121, // 212: watchfire.IntegrationsService.GetInboundStatus:input_type -> watchfire.GetInboundStatusRequest
-	122, // 213: watchfire.IntegrationsService.SaveInboundConfig:input_type -> watchfire.SaveInboundConfigRequest
-	112, // 214: watchfire.IntegrationsService.BeginOAuth:input_type -> watchfire.BeginOAuthRequest
-	114, // 215: watchfire.IntegrationsService.GetOAuthStatus:input_type -> watchfire.GetOAuthStatusRequest
-	116, // 216: watchfire.IntegrationsService.CancelOAuth:input_type -> watchfire.CancelOAuthRequest
-	117, // 217: watchfire.IntegrationsService.PostOAuthHello:input_type -> watchfire.PostOAuthHelloRequest
-	107, // 218: watchfire.IntegrationsService.BeginTelegramPairing:input_type -> watchfire.BeginTelegramPairingRequest
-	109, // 219: watchfire.IntegrationsService.GetTelegramPairingStatus:input_type -> watchfire.GetTelegramPairingStatusRequest
-	111, // 220: watchfire.IntegrationsService.RevokeTelegramChat:input_type -> watchfire.RevokeTelegramChatRequest
-	15,  // 221: watchfire.ProjectService.ListProjects:output_type -> watchfire.ProjectList
-	10,  // 222: watchfire.ProjectService.GetProject:output_type -> watchfire.Project
-	10,  // 223: watchfire.ProjectService.CreateProject:output_type -> watchfire.Project
-	10,  // 224: watchfire.ProjectService.UpdateProject:output_type -> watchfire.Project
-	128, // 225: watchfire.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	19,  // 226: watchfire.ProjectService.GetGitInfo:output_type -> watchfire.GitInfo
-	15,  // 227: watchfire.ProjectService.ReorderProjects:output_type -> watchfire.ProjectList
-	10,  // 228: watchfire.ProjectService.RegenerateProjectId:output_type -> watchfire.Project
-	10,  // 229: watchfire.ProjectService.ResetTaskNumbering:output_type -> watchfire.Project
-	128, // 230: watchfire.ProjectService.UnregisterProject:output_type -> google.protobuf.Empty
-	128, // 231: watchfire.ProjectService.SetGitHubAutoPRScope:output_type -> google.protobuf.Empty
-	10,  // 232: watchfire.ProjectService.SetProjectIntegrationBindings:output_type -> watchfire.Project
-	22,  // 233: watchfire.TaskService.ListTasks:output_type -> watchfire.TaskList
-	24,  // 234: watchfire.TaskService.ListMalformedTasks:output_type -> watchfire.MalformedTaskList
-	20,  // 235: watchfire.TaskService.GetTask:output_type -> watchfire.Task
-	20,  // 236: watchfire.TaskService.CreateTask:output_type -> watchfire.Task
-	20,  // 237: watchfire.TaskService.UpdateTask:output_type -> watchfire.Task
-	20,  // 238: watchfire.TaskService.DeleteTask:output_type -> watchfire.Task
-	20,  // 239: watchfire.TaskService.RestoreTask:output_type -> watchfire.Task
-	128, // 240: watchfire.TaskService.PermanentDeleteTask:output_type -> google.protobuf.Empty
-	128, // 241: watchfire.TaskService.EmptyTrash:output_type -> google.protobuf.Empty
-	22,  // 242: watchfire.TaskService.BulkUpdateStatus:output_type -> watchfire.TaskList
-	22,  // 243: watchfire.TaskService.BulkDelete:output_type -> watchfire.TaskList
-	22,  // 244: watchfire.TaskService.BulkRestore:output_type -> watchfire.TaskList
-	22,  // 245: watchfire.TaskService.ReorderTasks:output_type -> watchfire.TaskList
-	22,  // 246: watchfire.TaskService.CreateTasksBatch:output_type -> watchfire.TaskList
-	22,  // 247: watchfire.TaskService.ArchiveRetrofitTasks:output_type -> watchfire.TaskList
-	35,  // 248: watchfire.DaemonService.GetStatus:output_type -> watchfire.DaemonStatus
-	128, // 249: watchfire.DaemonService.Shutdown:output_type -> google.protobuf.Empty
-	128, // 250: watchfire.DaemonService.Ping:output_type -> google.protobuf.Empty
-	71,  // 251: watchfire.DaemonService.SubscribeFocusEvents:output_type -> watchfire.FocusEvent
-	74,  // 252: watchfire.LogService.ListLogs:output_type -> watchfire.LogList
-	76,  // 253: watchfire.LogService.GetLog:output_type -> watchfire.LogContent
-	128, // 254: watchfire.LogService.DeleteLog:output_type -> google.protobuf.Empty
-	36,  // 255: watchfire.AgentService.StartAgent:output_type -> watchfire.AgentStatus
-	128, // 256: watchfire.AgentService.StopAgent:output_type -> google.protobuf.Empty
-	36,  // 257: watchfire.AgentService.GetAgentStatus:output_type -> watchfire.AgentStatus
-	38,  // 258: watchfire.AgentService.SubscribeScreen:output_type -> watchfire.ScreenBuffer
-	41,  // 259: watchfire.AgentService.GetScrollback:output_type -> watchfire.ScrollbackLines
-	128, // 260: watchfire.AgentService.SendInput:output_type -> google.protobuf.Empty
-	128, // 261: watchfire.AgentService.Resize:output_type -> google.protobuf.Empty
-	45,  // 262: watchfire.AgentService.SubscribeRawOutput:output_type -> watchfire.RawOutputChunk
-	46,  // 263: watchfire.AgentService.SubscribeAgentIssues:output_type -> watchfire.AgentIssue
-	36,  // 264: watchfire.AgentService.ResumeAgent:output_type -> watchfire.AgentStatus
-	49,  // 265: watchfire.BranchService.ListBranches:output_type -> watchfire.BranchList
-	48,  // 266: watchfire.BranchService.GetBranch:output_type -> watchfire.Branch
-	48,  // 267: watchfire.BranchService.MergeBranch:output_type -> watchfire.Branch
-	128, // 268: watchfire.BranchService.DeleteBranch:output_type -> google.protobuf.Empty
-	49,  // 269: watchfire.BranchService.PruneBranches:output_type -> watchfire.BranchList
-	49,  // 270: watchfire.BranchService.BulkMerge:output_type -> watchfire.BranchList
-	128, // 271: watchfire.BranchService.BulkDelete:output_type -> google.protobuf.Empty
-	61,  // 272: watchfire.SettingsService.GetSettings:output_type -> watchfire.Settings
-	61,  // 273: watchfire.SettingsService.UpdateSettings:output_type -> watchfire.Settings
-	64,  // 274: watchfire.SettingsService.ListAgents:output_type -> watchfire.AgentList
-	66,  // 275: watchfire.SettingsService.GetMcpClientStatus:output_type -> watchfire.McpClientStatusList
-	65,  // 276: watchfire.SettingsService.InstallMcpClient:output_type -> watchfire.McpClientStatus
-	78,  // 277: watchfire.NotificationService.Subscribe:output_type -> watchfire.Notification
-	81,  // 278: watchfire.InsightsService.ExportReport:output_type -> watchfire.ExportReportResponse
-	86,  // 279: watchfire.InsightsService.GetGlobalInsights:output_type -> watchfire.GlobalInsights
-	88,  // 280: watchfire.InsightsService.GetProjectInsights:output_type -> watchfire.ProjectInsights
-	90,  // 281: watchfire.InsightsService.GetTaskDiff:output_type -> watchfire.FileDiffSet
-	101, // 282: watchfire.IntegrationsService.ListIntegrations:output_type -> watchfire.IntegrationsConfig
-	101, // 283: watchfire.IntegrationsService.SaveIntegration:output_type -> watchfire.IntegrationsConfig
-	101, // 284: watchfire.IntegrationsService.DeleteIntegration:output_type -> watchfire.IntegrationsConfig
-	106, // 285: watchfire.IntegrationsService.TestIntegration:output_type -> watchfire.TestIntegrationResponse
-	120, // 286: watchfire.IntegrationsService.GetInboundStatus:output_type -> watchfire.InboundStatus
-	120, // 287: watchfire.IntegrationsService.SaveInboundConfig:output_type -> watchfire.InboundStatus
-	113, // 288: watchfire.IntegrationsService.BeginOAuth:output_type -> watchfire.BeginOAuthResponse
-	115, // 289: watchfire.IntegrationsService.GetOAuthStatus:output_type -> watchfire.OAuthStatus
-	115, // 290: watchfire.IntegrationsService.CancelOAuth:output_type -> watchfire.OAuthStatus
-	118, // 291: watchfire.IntegrationsService.PostOAuthHello:output_type -> watchfire.PostOAuthHelloResponse
-	108, // 292: watchfire.IntegrationsService.BeginTelegramPairing:output_type -> watchfire.BeginTelegramPairingResponse
-	110, // 293: watchfire.IntegrationsService.GetTelegramPairingStatus:output_type -> watchfire.TelegramPairingStatus
-	101, // 294: watchfire.IntegrationsService.RevokeTelegramChat:output_type -> watchfire.IntegrationsConfig
-	221, // [221:295] is the sub-list for method output_type
-	147, // [147:221] is the sub-list for method input_type
-	147, // [147:147] is the sub-list for extension type_name
-	147, // [147:147] is the sub-list for extension extendee
-	0,   // [0:147] is the sub-list for field type_name
+	39,  // 34: watchfire.ScreenBuffer.row_deltas:type_name -> watchfire.ScreenRowDelta
+	9,   // 35: watchfire.SubscribeScreenRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 36: watchfire.ScrollbackRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 37: watchfire.SendInputRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 38: watchfire.ResizeRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 39: watchfire.SubscribeRawOutputRequest.meta:type_name -> watchfire.RequestMeta
+	175, // 40: watchfire.AgentIssue.detected_at:type_name -> google.protobuf.Timestamp
+	175, // 41: watchfire.AgentIssue.reset_at:type_name -> google.protobuf.Timestamp
+	175, // 42: watchfire.AgentIssue.cooldown_until:type_name -> google.protobuf.Timestamp
+	9,   // 43: watchfire.AnswerQuestionRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 44: watchfire.SubscribeAgentIssuesRequest.meta:type_name -> watchfire.RequestMeta
+	50,  // 45: watchfire.BranchList.branches:type_name -> watchfire.Branch
+	9,   // 46: watchfire.BranchId.meta:type_name -> watchfire.RequestMeta
+	9,   // 47: watchfire.MergeBranchRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 48: watchfire.BulkBranchRequest.meta:type_name -> watchfire.RequestMeta
+	60,  // 49: watchfire.DefaultsConfig.notifications:type_name -> watchfire.NotificationsConfig
+	57,  // 50: watchfire.NotificationsConfig.events:type_name -> watchfire.NotificationsEvents
+	58,  // 51: watchfire.NotificationsConfig.sounds:type_name -> watchfire.NotificationsSounds
+	59,  // 52: watchfire.NotificationsConfig.quiet_hours:type_name -> watchfire.QuietHoursConfig
+	171, // 53: watchfire.TracingConfig.headers:type_name -> watchfire.TracingConfig.HeadersEntry
+	67,  // 54: watchfire.PricingConfig.prices:type_name -> watchfire.TokenPrice
+	172, // 55: watchfire.Settings.agents:type_name -> watchfire.Settings.AgentsEntry
+	56,  // 56: watchfire.Settings.defaults:type_name -> watchfire.DefaultsConfig
+	61,  // 57: watchfire.Settings.updates:type_name -> watchfire.UpdatesConfig
+	62,  // 58: watchfire.Settings.appearance:type_name -> watchfire.AppearanceConfig
+	63,  // 59: watchfire.Settings.recordings:type_name -> watchfire.RecordingsConfig
+	64,  // 60: watchfire.Settings.retention:type_name -> watchfire.RetentionConfig
+	65,  // 61: watchfire.Settings.metrics_endpoint:type_name -> watchfire.MetricsEndpointConfig
+	66,  // 62: watchfire.Settings.tracing:type_name -> watchfire.TracingConfig
+	68,  // 63: watchfire.Settings.pricing:type_name -> watchfire.PricingConfig
+	69,  // 64: watchfire.Settings.budget:type_name -> watchfire.BudgetConfig
+	9,   // 65: watchfire.UpdateSettingsRequest.meta:type_name -> watchfire.RequestMeta
+	56,  // 66: watchfire.UpdateSettingsRequest.defaults:type_name -> watchfire.DefaultsConfig
+	61,  // 67: watchfire.UpdateSettingsRequest.updates:type_name -> watchfire.UpdatesConfig
+	62,  // 68: watchfire.UpdateSettingsRequest.appearance:type_name -> watchfire.AppearanceConfig
+	173, // 69: watchfire.UpdateSettingsRequest.agents:type_name -> watchfire.UpdateSettingsRequest.AgentsEntry
+	63,  // 70: watchfire.UpdateSettingsRequest.recordings:type_name -> watchfire.RecordingsConfig
+	64,  // 71: watchfire.UpdateSettingsRequest.retention:type_name -> watchfire.RetentionConfig
+	65,  // 72: watchfire.UpdateSettingsRequest.metrics_endpoint:type_name -> watchfire.MetricsEndpointConfig
+	66,  // 73: watchfire.UpdateSettingsRequest.tracing:type_name -> watchfire.TracingConfig
+	68,  // 74: watchfire.UpdateSettingsRequest.pricing:type_name -> watchfire.PricingConfig
+	69,  // 75: watchfire.UpdateSettingsRequest.budget:type_name -> watchfire.BudgetConfig
+	72,  // 76: watchfire.AgentList.agents:type_name -> watchfire.AgentInfo
+	74,  // 77: watchfire.McpClientStatusList.clients:type_name -> watchfire.McpClientStatus
+	9,   // 78: watchfire.InstallMcpClientRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 79: watchfire.SetGitHubAutoPRScopeRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 80: watchfire.SetProjectIntegrationBindingsRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 81: watchfire.RunGCRequest.meta:type_name -> watchfire.RequestMeta
+	80,  // 82: watchfire.GCReport.items:type_name -> watchfire.GCItem
+	9,   // 83: watchfire.SubscribeFocusEventsRequest.meta:type_name -> watchfire.RequestMeta
+	0,   // 84: watchfire.FocusEvent.target:type_name -> watchfire.FocusTarget
+	9,   // 85: watchfire.ListLogsRequest.meta:type_name -> watchfire.RequestMeta
+	85,  // 86: watchfire.LogList.logs:type_name -> watchfire.LogEntry
+	9,   // 87: watchfire.GetLogRequest.meta:type_name -> watchfire.RequestMeta
+	85,  // 88: watchfire.LogContent.entry:type_name -> watchfire.LogEntry
+	9,   // 89: watchfire.DeleteLogRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 90: watchfire.GetRecordingRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 91: watchfire.SearchLogsRequest.meta:type_name -> watchfire.RequestMeta
+	85,  // 92: watchfire.LogSearchHit.entry:type_name -> watchfire.LogEntry
+	93,  // 93: watchfire.SearchLogsResponse.hits:type_name -> watchfire.LogSearchHit
+	9,   // 94: watchfire.GetSessionEventsRequest.meta:type_name -> watchfire.RequestMeta
+	96,  // 95: watchfire.SessionEventList.events:type_name -> watchfire.SessionEvent
+	175, // 96: watchfire.Notification.emitted_at:type_name -> google.protobuf.Timestamp
+	1,   // 97: watchfire.Notification.kind:type_name -> watchfire.NotificationKind
+	9,   // 98: watchfire.SubscribeNotificationsRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 99: watchfire.ExportReportRequest.meta:type_name -> watchfire.RequestMeta
+	2,   // 100: watchfire.ExportReportRequest.format:type_name -> watchfire.ExportFormat
+	175, // 101: watchfire.ExportReportRequest.window_start:type_name -> google.protobuf.Timestamp
+	175, // 102: watchfire.ExportReportRequest.window_end:type_name -> google.protobuf.Timestamp
+	9,   // 103: watchfire.GetGlobalInsightsRequest.meta:type_name -> watchfire.RequestMeta
+	175, // 104: watchfire.GetGlobalInsightsRequest.window_start:type_name -> google.protobuf.Timestamp
+	175, // 105: watchfire.GetGlobalInsightsRequest.window_end:type_name -> google.protobuf.Timestamp
+	175, // 106: watchfire.GetGlobalInsightsRequest.compare_window_start:type_name -> google.protobuf.Timestamp
+	175, // 107: watchfire.GetGlobalInsightsRequest.compare_window_end:type_name -> google.protobuf.Timestamp
+	108, // 108: watchfire.InsightsOverhead.by_kind:type_name -> watchfire.OverheadKind
+	175, // 109: watchfire.InsightsComparison.window_start:type_name -> google.protobuf.Timestamp
+	175, // 110: watchfire.InsightsComparison.window_end:type_name -> google.protobuf.Timestamp
+	109, // 111: watchfire.InsightsComparison.tasks:type_name -> watchfire.MetricDelta
+	109, // 112: watchfire.InsightsComparison.success_rate:type_name -> watchfire.MetricDelta
+	109, // 113: watchfire.InsightsComparison.cost_usd:type_name -> watchfire.MetricDelta
+	109, // 114: watchfire.InsightsComparison.net_lines:type_name -> watchfire.MetricDelta
+	103, // 115: watchfire.GlobalInsights.tasks_by_day:type_name -> watchfire.DayBucket
+	112, // 116: watchfire.GlobalInsights.top_projects:type_name -> watchfire.TopProject
+	104, // 117: watchfire.GlobalInsights.agent_breakdown:type_name -> watchfire.AgentBreakdown
+	175, // 118: watchfire.GlobalInsights.window_start:type_name -> google.protobuf.Timestamp
+	175, // 119: watchfire.GlobalInsights.window_end:type_name -> google.protobuf.Timestamp
+	114, // 120: watchfire.GlobalInsights.budgets:type_name -> watchfire.BudgetStatus
+	105, // 121: watchfire.GlobalInsights.agent_comparison:type_name -> watchfire.AgentComparison
+	107, // 122: watchfire.GlobalInsights.overhead:type_name -> watchfire.InsightsOverhead
+	110, // 123: watchfire.GlobalInsights.comparison:type_name -> watchfire.InsightsComparison
+	111, // 124: watchfire.GlobalInsights.trend:type_name -> watchfire.TrendWeek
+	106, // 125: watchfire.GlobalInsights.users:type_name -> watchfire.UserUsage
+	9,   // 126: watchfire.GetProjectInsightsRequest.meta:type_name -> watchfire.RequestMeta
+	175, // 127: watchfire.GetProjectInsightsRequest.window_start:type_name -> google.protobuf.Timestamp
+	175, // 128: watchfire.GetProjectInsightsRequest.window_end:type_name -> google.protobuf.Timestamp
+	175, // 129: watchfire.GetProjectInsightsRequest.compare_window_start:type_name -> google.protobuf.Timestamp
+	175, // 130: watchfire.GetProjectInsightsRequest.compare_window_end:type_name -> google.protobuf.Timestamp
+	103, // 131: watchfire.ProjectInsights.tasks_by_day:type_name -> watchfire.DayBucket
+	104, // 132: watchfire.ProjectInsights.agent_breakdown:type_name -> watchfire.AgentBreakdown
+	175, // 133: watchfire.ProjectInsights.window_start:type_name -> google.protobuf.Timestamp
+	175, // 134: watchfire.ProjectInsights.window_end:type_name -> google.protobuf.Timestamp
+	105, // 135: watchfire.ProjectInsights.agent_comparison:type_name -> watchfire.AgentComparison
+	107, // 136: watchfire.ProjectInsights.overhead:type_name -> watchfire.InsightsOverhead
+	110, // 137: watchfire.ProjectInsights.comparison:type_name -> watchfire.InsightsComparison
+	111, // 138: watchfire.ProjectInsights.trend:type_name -> watchfire.TrendWeek
+	106, // 139: watchfire.ProjectInsights.users:type_name -> watchfire.UserUsage
+	9,   // 140: watchfire.GetTaskDiffRequest.meta:type_name -> watchfire.RequestMeta
+	119, // 141: watchfire.FileDiffSet.files:type_name -> watchfire.FileDiff
+	7,   // 142: watchfire.FileDiff.status:type_name -> watchfire.FileDiff.Status
+	120, // 143: watchfire.FileDiff.hunks:type_name -> watchfire.Hunk
+	121, // 144: watchfire.Hunk.lines:type_name -> watchfire.DiffLine
+	8,   // 145: watchfire.DiffLine.kind:type_name -> watchfire.DiffLine.Kind
+	9,   // 146: watchfire.GetHotspotsRequest.meta:type_name -> watchfire.RequestMeta
+	175, // 147: watchfire.GetHotspotsRequest.window_start:type_name -> google.protobuf.Timestamp
+	175, // 148: watchfire.GetHotspotsRequest.window_end:type_name -> google.protobuf.Timestamp
+	175, // 149: watchfire.Hotspots.window_start:type_name -> google.protobuf.Timestamp
+	175, // 150: watchfire.Hotspots.window_end:type_name -> google.protobuf.Timestamp
+	124, // 151: watchfire.Hotspots.files:type_name -> watchfire.HotspotFile
+	124, // 152: watchfire.Hotspots.failure_files:type_name -> watchfire.HotspotFile
+	125, // 153: watchfire.Hotspots.directories:type_name -> watchfire.HotspotDirectory
+	175, // 154: watchfire.HotspotFile.last_touched:type_name -> google.protobuf.Timestamp
+	174, // 155: watchfire.IntegrationEvents.events:type_name -> watchfire.IntegrationEvents.EventsEntry
+	126, // 156: watchfire.WebhookIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	126, // 157: watchfire.SlackIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	126, // 158: watchfire.DiscordIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	126, // 159: watchfire.TeamsIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	126, // 160: watchfire.MatrixIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	126, // 161: watchfire.NtfyIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	126, // 162: watchfire.EmailRecipient.enabled_events:type_name -> watchfire.IntegrationEvents
+	133, // 163: watchfire.EmailIntegration.recipients:type_name -> watchfire.EmailRecipient
+	175, // 164: watchfire.TelegramPairedChatInfo.paired_at:type_name -> google.protobuf.Timestamp
+	126, // 165: watchfire.TelegramIntegration.enabled_events:type_name -> watchfire.IntegrationEvents
+	136, // 166: watchfire.TelegramIntegration.paired_chats:type_name -> watchfire.TelegramPairedChatInfo
+	127, // 167: watchfire.IntegrationsConfig.webhooks:type_name -> watchfire.WebhookIntegration
+	128, // 168: watchfire.IntegrationsConfig.slack:type_name -> watchfire.SlackIntegration
+	129, // 169: watchfire.IntegrationsConfig.discord:type_name -> watchfire.DiscordIntegration
+	135, // 170: watchfire.IntegrationsConfig.github:type_name -> watchfire.GitHubIntegration
+	137, // 171: watchfire.IntegrationsConfig.telegram:type_name -> watchfire.TelegramIntegration
+	130, // 172: watchfire.IntegrationsConfig.teams:type_name -> watchfire.TeamsIntegration
+	131, // 173: watchfire.IntegrationsConfig.matrix:type_name -> watchfire.MatrixIntegration
+	132, // 174: watchfire.IntegrationsConfig.ntfy:type_name -> watchfire.NtfyIntegration
+	134, // 175: watchfire.IntegrationsConfig.email:type_name -> watchfire.EmailIntegration
+	9,   // 176: watchfire.ListIntegrationsRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 177: watchfire.SaveIntegrationRequest.meta:type_name -> watchfire.RequestMeta
+	127, // 178: watchfire.SaveIntegrationRequest.webhook:type_name -> watchfire.WebhookIntegration
+	128, // 179: watchfire.SaveIntegrationRequest.slack:type_name -> watchfire.SlackIntegration
+	129, // 180: watchfire.SaveIntegrationRequest.discord:type_name -> watchfire.DiscordIntegration
+	135, // 181: watchfire.SaveIntegrationRequest.github:type_name -> watchfire.GitHubIntegration
+	137, // 182: watchfire.SaveIntegrationRequest.telegram:type_name -> watchfire.TelegramIntegration
+	130, // 183: watchfire.SaveIntegrationRequest.teams:type_name -> watchfire.TeamsIntegration
+	131, // 184: watchfire.SaveIntegrationRequest.matrix:type_name -> watchfire.MatrixIntegration
+	132, // 185: watchfire.SaveIntegrationRequest.ntfy:type_name -> watchfire.NtfyIntegration
+	134, // 186: watchfire.SaveIntegrationRequest.email:type_name -> watchfire.EmailIntegration
+	9,   // 187: watchfire.DeleteIntegrationRequest.meta:type_name -> watchfire.RequestMeta
+	3,   // 188: watchfire.DeleteIntegrationRequest.kind:type_name -> watchfire.IntegrationKind
+	9,   // 189: watchfire.TestIntegrationRequest.meta:type_name -> watchfire.RequestMeta
+	3,   // 190: watchfire.TestIntegrationRequest.kind:type_name -> watchfire.IntegrationKind
+	175, // 191: watchfire.RelayDelivery.created_at:type_name -> google.protobuf.Timestamp
+	175, // 192: watchfire.RelayDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	175, // 193: watchfire.RelayDelivery.dead_at:type_name -> google.protobuf.Timestamp
+	9,   // 194: watchfire.ListFailedDeliveriesRequest.meta:type_name -> watchfire.RequestMeta
+	144, // 195: watchfire.ListFailedDeliveriesResponse.deliveries:type_name -> watchfire.RelayDelivery
+	9,   // 196: watchfire.ReplayDeliveryRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 197: watchfire.PreviewTemplateRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 198: watchfire.TestRouteRequest.meta:type_name -> watchfire.RequestMeta
+	151, // 199: watchfire.TestRouteResponse.targets:type_name -> watchfire.RouteTarget
+	9,   // 200: watchfire.BeginTelegramPairingRequest.meta:type_name -> watchfire.RequestMeta
+	175, // 201: watchfire.BeginTelegramPairingResponse.expires_at:type_name -> google.protobuf.Timestamp
+	9,   // 202: watchfire.GetTelegramPairingStatusRequest.meta:type_name -> watchfire.RequestMeta
+	4,   // 203: watchfire.TelegramPairingStatus.state:type_name -> watchfire.TelegramPairingState
+	175, // 204: watchfire.TelegramPairingStatus.expires_at:type_name -> google.protobuf.Timestamp
+	136, // 205: watchfire.TelegramPairingStatus.chat:type_name -> watchfire.TelegramPairedChatInfo
+	9,   // 206: watchfire.RevokeTelegramChatRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 207: watchfire.BeginOAuthRequest.meta:type_name -> watchfire.RequestMeta
+	5,   // 208: watchfire.BeginOAuthRequest.provider:type_name -> watchfire.OAuthProvider
+	9,   // 209: watchfire.GetOAuthStatusRequest.meta:type_name -> watchfire.RequestMeta
+	5,   // 210: watchfire.GetOAuthStatusRequest.provider:type_name -> watchfire.OAuthProvider
+	5,   // 211: watchfire.OAuthStatus.provider:type_name -> watchfire.OAuthProvider
+	6,   // 212: watchfire.OAuthStatus.state:type_name -> watchfire.OAuthState
+	9,   // 213: watchfire.CancelOAuthRequest.meta:type_name -> watchfire.RequestMeta
+	5,   // 214: watchfire.CancelOAuthRequest.provider:type_name -> watchfire.OAuthProvider
+	9,   // 215: watchfire.PostOAuthHelloRequest.meta:type_name -> watchfire.RequestMeta
+	5,   // 216: watchfire.PostOAuthHelloRequest.provider:type_name -> watchfire.OAuthProvider
+	165, // 217: watchfire.InboundStatus.config:type_name -> watchfire.InboundConfig
+	169, // 218: watchfire.InboundStatus.discord_guilds:type_name -> watchfire.DiscordGuildRegistration
+	9,   // 219: watchfire.GetInboundStatusRequest.meta:type_name -> watchfire.RequestMeta
+	9,   // 220: watchfire.SaveInboundConfigRequest.meta:type_name -> watchfire.RequestMeta
+	165, // 221: watchfire.SaveInboundConfigRequest.config:type_name -> watchfire.InboundConfig
+	13,  // 222: watchfire.ProjectNotifications.EventsEntry.value:type_name -> watchfire.ProjectEventPref
+	55,  // 223: watchfire.Settings.AgentsEntry.value:type_name -> watchfire.AgentConfig
+	55,  // 224: watchfire.UpdateSettingsRequest.AgentsEntry.value:type_name -> watchfire.AgentConfig
+	176, // 225: watchfire.ProjectService.ListProjects:input_type -> google.protobuf.Empty
+	14,  // 226: watchfire.ProjectService.GetProject:input_type -> watchfire.ProjectId
+	16,  // 227: watchfire.ProjectService.CreateProject:input_type -> watchfire.CreateProjectRequest
+	17,  // 228: watchfire.ProjectService.UpdateProject:input_type -> watchfire.UpdateProjectRequest
+	14,  // 229: watchfire.ProjectService.DeleteProject:input_type -> watchfire.ProjectId
+	14,  // 230: watchfire.ProjectService.GetGitInfo:input_type -> watchfire.ProjectId
+	18,  // 231: watchfire.ProjectService.ReorderProjects:input_type -> watchfire.ReorderProjectsRequest
+	14,  // 232: watchfire.ProjectService.RegenerateProjectId:input_type -> watchfire.ProjectId
+	14,  // 233: watchfire.ProjectService.ResetTaskNumbering:input_type -> watchfire.ProjectId
+	14,  // 234: watchfire.ProjectService.UnregisterProject:input_type -> watchfire.ProjectId
+	77,  // 235: watchfire.ProjectService.SetGitHubAutoPRScope:input_type -> watchfire.SetGitHubAutoPRScopeRequest
+	78,  // 236: watchfire.ProjectService.SetProjectIntegrationBindings:input_type -> watchfire.SetProjectIntegrationBindingsRequest
+	26,  // 237: watchfire.TaskService.ListTasks:input_type -> watchfire.ListTasksRequest
+	25,  // 238: watchfire.TaskService.ListMalformedTasks:input_type -> watchfire.ListMalformedTasksRequest
+	21,  // 239: watchfire.TaskService.GetTask:input_type -> watchfire.TaskId
+	27,  // 240: watchfire.TaskService.CreateTask:input_type -> watchfire.CreateTaskRequest
+	28,  // 241: watchfire.TaskService.UpdateTask:input_type -> watchfire.UpdateTaskRequest
+	21,  // 242: watchfire.TaskService.DeleteTask:input_type -> watchfire.TaskId
+	21,  // 243: watchfire.TaskService.RestoreTask:input_type -> watchfire.TaskId
+	21,  // 244: watchfire.TaskService.PermanentDeleteTask:input_type -> watchfire.TaskId
+	14,  // 245: watchfire.TaskService.EmptyTrash:input_type -> watchfire.ProjectId
+	29,  // 246: watchfire.TaskService.BulkUpdateStatus:input_type -> watchfire.BulkUpdateStatusRequest
+	30,  // 247: watchfire.TaskService.BulkDelete:input_type -> watchfire.BulkDeleteRequest
+	31,  // 248: watchfire.TaskService.BulkRestore:input_type -> watchfire.BulkRestoreRequest
+	34,  // 249: watchfire.TaskService.ReorderTasks:input_type -> watchfire.ReorderTasksRequest
+	32,  // 250: watchfire.TaskService.CreateTasksBatch:input_type -> watchfire.CreateTasksBatchRequest
+	33,  // 251: watchfire.TaskService.ArchiveRetrofitTasks:input_type -> watchfire.ArchiveRetrofitRequest
+	176, // 252: watchfire.DaemonService.GetStatus:input_type -> google.protobuf.Empty
+	176, // 253: watchfire.DaemonService.Shutdown:input_type -> google.protobuf.Empty
+	176, // 254: watchfire.DaemonService.Ping:input_type -> google.protobuf.Empty
+	82,  // 255: watchfire.DaemonService.SubscribeFocusEvents:input_type -> watchfire.SubscribeFocusEventsRequest
+	79,  // 256: watchfire.DaemonService.RunGC:input_type -> watchfire.RunGCRequest
+	84,  // 257: watchfire.LogService.ListLogs:input_type -> watchfire.ListLogsRequest
+	87,  // 258: watchfire.LogService.GetLog:input_type -> watchfire.GetLogRequest
+	89,  // 259: watchfire.LogService.DeleteLog:input_type -> watchfire.DeleteLogRequest
+	90,  // 260: watchfire.LogService.GetRecording:input_type -> watchfire.GetRecordingRequest
+	92,  // 261: watchfire.LogService.SearchLogs:input_type -> watchfire.SearchLogsRequest
+	95,  // 262: watchfire.LogService.GetSessionEvents:input_type -> watchfire.GetSessionEventsRequest
+	37,  // 263: watchfire.AgentService.StartAgent:input_type -> watchfire.StartAgentRequest
+	14,  // 264: watchfire.AgentService.StopAgent:input_type -> watchfire.ProjectId
+	14,  // 265: watchfire.AgentService.GetAgentStatus:input_type -> watchfire.ProjectId
+	40,  // 266: watchfire.AgentService.SubscribeScreen:input_type -> watchfire.SubscribeScreenRequest
+	41,  // 267: watchfire.AgentService.GetScrollback:input_type -> watchfire.ScrollbackRequest
+	43,  // 268: watchfire.AgentService.SendInput:input_type -> watchfire.SendInputRequest
+	44,  // 269: watchfire.AgentService.Resize:input_type -> watchfire.ResizeRequest
+	45,  // 270: watchfire.AgentService.SubscribeRawOutput:input_type -> watchfire.SubscribeRawOutputRequest
+	49,  // 271: watchfire.AgentService.SubscribeAgentIssues:input_type -> watchfire.SubscribeAgentIssuesRequest
+	14,  // 272: watchfire.AgentService.ResumeAgent:input_type -> watchfire.ProjectId
+	48,  // 273: watchfire.AgentService.AnswerQuestion:input_type -> watchfire.AnswerQuestionRequest
+	14,  // 274: watchfire.BranchService.ListBranches:input_type -> watchfire.ProjectId
+	52,  // 275: watchfire.BranchService.GetBranch:input_type -> watchfire.BranchId
+	53,  // 276: watchfire.BranchService.MergeBranch:input_type -> watchfire.MergeBranchRequest
+	52,  // 277: watchfire.BranchService.DeleteBranch:input_type -> watchfire.BranchId
+	14,  // 278: watchfire.BranchService.PruneBranches:input_type -> watchfire.ProjectId
+	54,  // 279: watchfire.BranchService.BulkMerge:input_type -> watchfire.BulkBranchRequest
+	54,  // 280: watchfire.BranchService.BulkDelete:input_type -> watchfire.BulkBranchRequest
+	176, // 281: watchfire.SettingsService.GetSettings:input_type -> google.protobuf.Empty
+	71,  // 282: watchfire.SettingsService.UpdateSettings:input_type -> watchfire.UpdateSettingsRequest
+	176, // 283: watchfire.SettingsService.ListAgents:input_type -> google.protobuf.Empty
+	176, // 284: watchfire.SettingsService.GetMcpClientStatus:input_type -> google.protobuf.Empty
+	76,  // 285: watchfire.SettingsService.InstallMcpClient:input_type -> watchfire.InstallMcpClientRequest
+	99,  // 286: watchfire.NotificationService.Subscribe:input_type -> watchfire.SubscribeNotificationsRequest
+	100, // 287: watchfire.InsightsService.ExportReport:input_type -> watchfire.ExportReportRequest
+	102, // 288: watchfire.InsightsService.GetGlobalInsights:input_type -> watchfire.GetGlobalInsightsRequest
+	115, // 289: watchfire.InsightsService.GetProjectInsights:input_type -> watchfire.GetProjectInsightsRequest
+	117, // 290: watchfire.InsightsService.GetTaskDiff:input_type -> watchfire.GetTaskDiffRequest
+	122, // 291: watchfire.InsightsService.GetHotspots:input_type -> watchfire.GetHotspotsRequest
+	139, // 292: watchfire.IntegrationsService.ListIntegrations:input_type -> watchfire.ListIntegrationsRequest
+	140, // 293: watchfire.IntegrationsService.SaveIntegration:input_type -> watchfire.SaveIntegrationRequest
+	141, // 294: watchfire.IntegrationsService.DeleteIntegration:input_type -> watchfire.DeleteIntegrationRequest
+	142, // 295: watchfire.IntegrationsService.TestIntegration:input_type -> watchfire.TestIntegrationRequest
+	167, // 296: watchfire.IntegrationsService.GetInboundStatus:input_type -> watchfire.GetInboundStatusRequest
+	168, // 297: watchfire.IntegrationsService.SaveInboundConfig:input_type -> watchfire.SaveInboundConfigRequest
+	158, // 298: watchfire.IntegrationsService.BeginOAuth:input_type -> watchfire.BeginOAuthRequest
+	160, // 299: watchfire.IntegrationsService.GetOAuthStatus:input_type -> watchfire.GetOAuthStatusRequest
+	162, // 300: watchfire.IntegrationsService.CancelOAuth:input_type -> watchfire.CancelOAuthRequest
+	163, // 301: watchfire.IntegrationsService.PostOAuthHello:input_type -> watchfire.PostOAuthHelloRequest
+	153, // 302: watchfire.IntegrationsService.BeginTelegramPairing:input_type -> watchfire.BeginTelegramPairingRequest
+	155, // 303: watchfire.IntegrationsService.GetTelegramPairingStatus:input_type -> watchfire.GetTelegramPairingStatusRequest
+	157, // 304: watchfire.IntegrationsService.RevokeTelegramChat:input_type -> watchfire.RevokeTelegramChatRequest
+	145, // 305: watchfire.IntegrationsService.ListFailedDeliveries:input_type -> watchfire.ListFailedDeliveriesRequest
+	147, // 306: watchfire.IntegrationsService.ReplayDelivery:input_type -> watchfire.ReplayDeliveryRequest
+	148, // 307: watchfire.IntegrationsService.PreviewTemplate:input_type -> watchfire.PreviewTemplateRequest
+	150, // 308: watchfire.IntegrationsService.TestRoute:input_type -> watchfire.TestRouteRequest
+	15,  // 309: watchfire.ProjectService.ListProjects:output_type -> watchfire.ProjectList
+	10,  // 310: watchfire.ProjectService.GetProject:output_type -> watchfire.Project
+	10,  // 311: watchfire.ProjectService.CreateProject:output_type -> watchfire.Project
+	10,  // 312: watchfire.ProjectService.UpdateProject:output_type -> watchfire.Project
+	176, // 313: watchfire.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	19,  // 314: watchfire.ProjectService.GetGitInfo:output_type -> watchfire.GitInfo
+	15,  // 315: watchfire.ProjectService.ReorderProjects:output_type -> watchfire.ProjectList
+	10,  // 316: watchfire.ProjectService.RegenerateProjectId:output_type -> watchfire.Project
+	10,  // 317: watchfire.ProjectService.ResetTaskNumbering:output_type -> watchfire.Project
+	176, // 318: watchfire.ProjectService.UnregisterProject:output_type -> google.protobuf.Empty
+	176, // 319: watchfire.ProjectService.SetGitHubAutoPRScope:output_type -> google.protobuf.Empty
+	10,  // 320: watchfire.ProjectService.SetProjectIntegrationBindings:output_type -> watchfire.Project
+	22,  // 321: watchfire.TaskService.ListTasks:output_type -> watchfire.TaskList
+	24,  // 322: watchfire.TaskService.ListMalformedTasks:output_type -> watchfire.MalformedTaskList
+	20,  // 323: watchfire.TaskService.GetTask:output_type -> watchfire.Task
+	20,  // 324: watchfire.TaskService.CreateTask:output_type -> watchfire.Task
+	20,  // 325: watchfire.TaskService.UpdateTask:output_type -> watchfire.Task
+	20,  // 326: watchfire.TaskService.DeleteTask:output_type -> watchfire.Task
+	20,  // 327: watchfire.TaskService.RestoreTask:output_type -> watchfire.Task
+	176, // 328: watchfire.TaskService.PermanentDeleteTask:output_type -> google.protobuf.Empty
+	176, // 329: watchfire.TaskService.EmptyTrash:output_type -> google.protobuf.Empty
+	22,  // 330: watchfire.TaskService.BulkUpdateStatus:output_type -> watchfire.TaskList
+	22,  // 331: watchfire.TaskService.BulkDelete:output_type -> watchfire.TaskList
+	22,  // 332: watchfire.TaskService.BulkRestore:output_type -> watchfire.TaskList
+	22,  // 333: watchfire.TaskService.ReorderTasks:output_type -> watchfire.TaskList
+	22,  // 334: watchfire.TaskService.CreateTasksBatch:output_type -> watchfire.TaskList
+	22,  // 335: watchfire.TaskService.ArchiveRetrofitTasks:output_type -> watchfire.TaskList
+	35,  // 336: watchfire.DaemonService.GetStatus:output_type -> watchfire.DaemonStatus
+	176, // 337: watchfire.DaemonService.Shutdown:output_type -> google.protobuf.Empty
+	176, // 338: watchfire.DaemonService.Ping:output_type -> google.protobuf.Empty
+	83,  // 339: watchfire.DaemonService.SubscribeFocusEvents:output_type -> watchfire.FocusEvent
+	81,  // 340: watchfire.DaemonService.RunGC:output_type -> watchfire.GCReport
+	86,  // 341: watchfire.LogService.ListLogs:output_type -> watchfire.LogList
+	88,  // 342: watchfire.LogService.GetLog:output_type -> watchfire.LogContent
+	176, // 343: watchfire.LogService.DeleteLog:output_type -> google.protobuf.Empty
+	91,  // 344: watchfire.LogService.GetRecording:output_type -> watchfire.RecordingChunk
+	94,  // 345: watchfire.LogService.SearchLogs:output_type -> watchfire.SearchLogsResponse
+	97,  // 346: watchfire.LogService.GetSessionEvents:output_type -> watchfire.SessionEventList
+	36,  // 347: watchfire.AgentService.StartAgent:output_type -> watchfire.AgentStatus
+	176, // 348: watchfire.AgentService.StopAgent:output_type -> google.protobuf.Empty
+	36,  // 349: watchfire.AgentService.GetAgentStatus:output_type -> watchfire.AgentStatus
+	38,  // 350: watchfire.AgentService.SubscribeScreen:output_type -> watchfire.ScreenBuffer
+	42,  // 351: watchfire.AgentService.GetScrollback:output_type -> watchfire.ScrollbackLines
+	176, // 352: watchfire.AgentService.SendInput:output_type -> google.protobuf.Empty
+	176, // 353: watchfire.AgentService.Resize:output_type -> google.protobuf.Empty
+	46,  // 354: watchfire.AgentService.SubscribeRawOutput:output_type -> watchfire.RawOutputChunk
+	47,  // 355: watchfire.AgentService.SubscribeAgentIssues:output_type -> watchfire.AgentIssue
+	36,  // 356: watchfire.AgentService.ResumeAgent:output_type -> watchfire.AgentStatus
+	176, // 357: watchfire.AgentService.AnswerQuestion:output_type -> google.protobuf.Empty
+	51,  // 358: watchfire.BranchService.ListBranches:output_type -> watchfire.BranchList
+	50,  // 359: watchfire.BranchService.GetBranch:output_type -> watchfire.Branch
+	50,  // 360: watchfire.BranchService.MergeBranch:output_type -> watchfire.Branch
+	176, // 361: watchfire.BranchService.DeleteBranch:output_type -> google.protobuf.Empty
+	51,  // 362: watchfire.BranchService.PruneBranches:output_type -> watchfire.BranchList
+	51,  // 363: watchfire.BranchService.BulkMerge:output_type -> watchfire.BranchList
+	176, // 364: watchfire.BranchService.BulkDelete:output_type -> google.protobuf.Empty
+	70,  // 365: watchfire.SettingsService.GetSettings:output_type -> watchfire.Settings
+	70,  // 366: watchfire.SettingsService.UpdateSettings:output_type -> watchfire.Settings
+	73,  // 367: watchfire.SettingsService.ListAgents:output_type -> watchfire.AgentList
+	75,  // 368: watchfire.SettingsService.GetMcpClientStatus:output_type -> watchfire.McpClientStatusList
+	74,  // 369: watchfire.SettingsService.InstallMcpClient:output_type -> watchfire.McpClientStatus
+	98,  // 370: watchfire.NotificationService.Subscribe:output_type -> watchfire.Notification
+	101, // 371: watchfire.InsightsService.ExportReport:output_type -> watchfire.ExportReportResponse
+	113, // 372: watchfire.InsightsService.GetGlobalInsights:output_type -> watchfire.GlobalInsights
+	116, // 373: watchfire.InsightsService.GetProjectInsights:output_type -> watchfire.ProjectInsights
+	118, // 374: watchfire.InsightsService.GetTaskDiff:output_type -> watchfire.FileDiffSet
+	123, // 375: watchfire.InsightsService.GetHotspots:output_type -> watchfire.Hotspots
+	138, // 376: watchfire.IntegrationsService.ListIntegrations:output_type -> watchfire.IntegrationsConfig
+	138, // 377: watchfire.IntegrationsService.SaveIntegration:output_type -> watchfire.IntegrationsConfig
+	138, // 378: watchfire.IntegrationsService.DeleteIntegration:output_type -> watchfire.IntegrationsConfig
+	143, // 379: watchfire.IntegrationsService.TestIntegration:output_type -> watchfire.TestIntegrationResponse
+	166, // 380: watchfire.IntegrationsService.GetInboundStatus:output_type -> watchfire.InboundStatus
+	166, // 381: watchfire.IntegrationsService.SaveInboundConfig:output_type -> watchfire.InboundStatus
+	159, // 382: watchfire.IntegrationsService.BeginOAuth:output_type -> watchfire.BeginOAuthResponse
+	161, // 383: watchfire.IntegrationsService.GetOAuthStatus:output_type -> watchfire.OAuthStatus
+	161, // 384: watchfire.IntegrationsService.CancelOAuth:output_type -> watchfire.OAuthStatus
+	164, // 385: watchfire.IntegrationsService.PostOAuthHello:output_type -> watchfire.PostOAuthHelloResponse
+	154, // 386: watchfire.IntegrationsService.BeginTelegramPairing:output_type -> watchfire.BeginTelegramPairingResponse
+	156, // 387: watchfire.IntegrationsService.GetTelegramPairingStatus:output_type -> watchfire.TelegramPairingStatus
+	138, // 388: watchfire.IntegrationsService.RevokeTelegramChat:output_type -> watchfire.IntegrationsConfig
+	146, // 389: watchfire.IntegrationsService.ListFailedDeliveries:output_type -> watchfire.ListFailedDeliveriesResponse
+	144, // 390: watchfire.IntegrationsService.ReplayDelivery:output_type -> watchfire.RelayDelivery
+	149, // 391: watchfire.IntegrationsService.PreviewTemplate:output_type -> watchfire.PreviewTemplateResponse
+	152, // 392: watchfire.IntegrationsService.TestRoute:output_type -> watchfire.TestRouteResponse
+	309, // [309:393] is the sub-list for method output_type
+	225, // [225:309] is the sub-list for method input_type
+	225, // [225:225] is the sub-list for extension type_name
+	225, // [225:225] is the sub-list for extension extendee
+	0,   // [0:225] is the sub-list for field type_name
 }
 
 func init() { file_proto_watchfire_proto_init() }
@@ -10822,19 +15917,24 @@ func file_proto_watchfire_proto_init() {
 	file_proto_watchfire_proto_msgTypes[18].OneofWrappers = []any{}
 	file_proto_watchfire_proto_msgTypes[19].OneofWrappers = []any{}
 	file_proto_watchfire_proto_msgTypes[27].OneofWrappers = []any{}
-	file_proto_watchfire_proto_msgTypes[37].OneofWrappers = []any{}
-	file_proto_watchfire_proto_msgTypes[53].OneofWrappers = []any{}
-	file_proto_watchfire_proto_msgTypes[71].OneofWrappers = []any{
+	file_proto_watchfire_proto_msgTypes[38].OneofWrappers = []any{}
+	file_proto_watchfire_proto_msgTypes[62].OneofWrappers = []any{}
+	file_proto_watchfire_proto_msgTypes[87].OneofWrappers = []any{}
+	file_proto_watchfire_proto_msgTypes[91].OneofWrappers = []any{
 		(*ExportReportRequest_ProjectId)(nil),
 		(*ExportReportRequest_Global)(nil),
 		(*ExportReportRequest_SingleTask)(nil),
 	}
-	file_proto_watchfire_proto_msgTypes[94].OneofWrappers = []any{
+	file_proto_watchfire_proto_msgTypes[131].OneofWrappers = []any{
 		(*SaveIntegrationRequest_Webhook)(nil),
 		(*SaveIntegrationRequest_Slack)(nil),
 		(*SaveIntegrationRequest_Discord)(nil),
 		(*SaveIntegrationRequest_Github)(nil),
 		(*SaveIntegrationRequest_Telegram)(nil),
+		(*SaveIntegrationRequest_Teams)(nil),
+		(*SaveIntegrationRequest_Matrix)(nil),
+		(*SaveIntegrationRequest_Ntfy)(nil),
+		(*SaveIntegrationRequest_Email)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10842,7 +15942,7 @@ func file_proto_watchfire_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_watchfire_proto_rawDesc), len(file_proto_watchfire_proto_rawDesc)),
 			NumEnums:      9,
-			NumMessages:   118,
+			NumMessages:   166,
 			NumExtensions: 0,
 			NumServices:   10,
 		},
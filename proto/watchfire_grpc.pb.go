@@ -1230,6 +1230,7 @@ const (
 	DaemonService_Shutdown_FullMethodName             = "/watchfire.DaemonService/Shutdown"
 	DaemonService_Ping_FullMethodName                 = "/watchfire.DaemonService/Ping"
 	DaemonService_SubscribeFocusEvents_FullMethodName = "/watchfire.DaemonService/SubscribeFocusEvents"
+	DaemonService_RunGC_FullMethodName                = "/watchfire.DaemonService/RunGC"
 )
 
 // DaemonServiceClient is the client API for DaemonService service.
@@ -1242,6 +1243,10 @@ type DaemonServiceClient interface {
 	Shutdown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SubscribeFocusEvents(ctx context.Context, in *SubscribeFocusEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FocusEvent], error)
+	// RunGC applies the retention policies once — session logs, recordings,
+	// insights and diff caches, stale watchfire/* branches. dry_run reports
+	// what would be removed without touching anything.
+	RunGC(ctx context.Context, in *RunGCRequest, opts ...grpc.CallOption) (*GCReport, error)
 }
 
 type daemonServiceClient struct {
@@ -1301,6 +1306,16 @@ func (c *daemonServiceClient) SubscribeFocusEvents(ctx context.Context, in *Subs
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DaemonService_SubscribeFocusEventsClient = grpc.ServerStreamingClient[FocusEvent]
 
+func (c *daemonServiceClient) RunGC(ctx context.Context, in *RunGCRequest, opts ...grpc.CallOption) (*GCReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GCReport)
+	err := c.cc.Invoke(ctx, DaemonService_RunGC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility.
@@ -1311,6 +1326,10 @@ type DaemonServiceServer interface {
 	Shutdown(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Ping(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	SubscribeFocusEvents(*SubscribeFocusEventsRequest, grpc.ServerStreamingServer[FocusEvent]) error
+	// RunGC applies the retention policies once — session logs, recordings,
+	// insights and diff caches, stale watchfire/* branches. dry_run reports
+	// what would be removed without touching anything.
+	RunGC(context.Context, *RunGCRequest) (*GCReport, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -1333,6 +1352,9 @@ func (UnimplementedDaemonServiceServer) Ping(context.Context, *emptypb.Empty) (*
 func (UnimplementedDaemonServiceServer) SubscribeFocusEvents(*SubscribeFocusEventsRequest, grpc.ServerStreamingServer[FocusEvent]) error {
 	return status.Error(codes.Unimplemented, "method SubscribeFocusEvents not implemented")
 }
+func (UnimplementedDaemonServiceServer) RunGC(context.Context, *RunGCRequest) (*GCReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunGC not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 func (UnimplementedDaemonServiceServer) testEmbeddedByValue()                       {}
 
@@ -1419,6 +1441,24 @@ func _DaemonService_SubscribeFocusEvents_Handler(srv interface{}, stream grpc.Se
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DaemonService_SubscribeFocusEventsServer = grpc.ServerStreamingServer[FocusEvent]
 
+func _DaemonService_RunGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).RunGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_RunGC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).RunGC(ctx, req.(*RunGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1438,6 +1478,10 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _DaemonService_Ping_Handler,
 		},
+		{
+			MethodName: "RunGC",
+			Handler:    _DaemonService_RunGC_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1450,9 +1494,12 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LogService_ListLogs_FullMethodName  = "/watchfire.LogService/ListLogs"
-	LogService_GetLog_FullMethodName    = "/watchfire.LogService/GetLog"
-	LogService_DeleteLog_FullMethodName = "/watchfire.LogService/DeleteLog"
+	LogService_ListLogs_FullMethodName         = "/watchfire.LogService/ListLogs"
+	LogService_GetLog_FullMethodName           = "/watchfire.LogService/GetLog"
+	LogService_DeleteLog_FullMethodName        = "/watchfire.LogService/DeleteLog"
+	LogService_GetRecording_FullMethodName     = "/watchfire.LogService/GetRecording"
+	LogService_SearchLogs_FullMethodName       = "/watchfire.LogService/SearchLogs"
+	LogService_GetSessionEvents_FullMethodName = "/watchfire.LogService/GetSessionEvents"
 )
 
 // LogServiceClient is the client API for LogService service.
@@ -1464,6 +1511,15 @@ type LogServiceClient interface {
 	ListLogs(ctx context.Context, in *ListLogsRequest, opts ...grpc.CallOption) (*LogList, error)
 	GetLog(ctx context.Context, in *GetLogRequest, opts ...grpc.CallOption) (*LogContent, error)
 	DeleteLog(ctx context.Context, in *DeleteLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetRecording streams a session's asciicast v2 recording in chunks so
+	// long sessions don't run into the gRPC message size limit.
+	GetRecording(ctx context.Context, in *GetRecordingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RecordingChunk], error)
+	// SearchLogs runs a full-text query over rendered transcripts (or
+	// scrollback) of session logs, across one or all projects.
+	SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error)
+	// GetSessionEvents returns a session's normalized event log: messages,
+	// tool calls, shell commands, file edits and token usage.
+	GetSessionEvents(ctx context.Context, in *GetSessionEventsRequest, opts ...grpc.CallOption) (*SessionEventList, error)
 }
 
 type logServiceClient struct {
@@ -1504,6 +1560,45 @@ func (c *logServiceClient) DeleteLog(ctx context.Context, in *DeleteLogRequest,
 	return out, nil
 }
 
+func (c *logServiceClient) GetRecording(ctx context.Context, in *GetRecordingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RecordingChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[0], LogService_GetRecording_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetRecordingRequest, RecordingChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LogService_GetRecordingClient = grpc.ServerStreamingClient[RecordingChunk]
+
+func (c *logServiceClient) SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLogsResponse)
+	err := c.cc.Invoke(ctx, LogService_SearchLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) GetSessionEvents(ctx context.Context, in *GetSessionEventsRequest, opts ...grpc.CallOption) (*SessionEventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionEventList)
+	err := c.cc.Invoke(ctx, LogService_GetSessionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility.
@@ -1513,6 +1608,15 @@ type LogServiceServer interface {
 	ListLogs(context.Context, *ListLogsRequest) (*LogList, error)
 	GetLog(context.Context, *GetLogRequest) (*LogContent, error)
 	DeleteLog(context.Context, *DeleteLogRequest) (*emptypb.Empty, error)
+	// GetRecording streams a session's asciicast v2 recording in chunks so
+	// long sessions don't run into the gRPC message size limit.
+	GetRecording(*GetRecordingRequest, grpc.ServerStreamingServer[RecordingChunk]) error
+	// SearchLogs runs a full-text query over rendered transcripts (or
+	// scrollback) of session logs, across one or all projects.
+	SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error)
+	// GetSessionEvents returns a session's normalized event log: messages,
+	// tool calls, shell commands, file edits and token usage.
+	GetSessionEvents(context.Context, *GetSessionEventsRequest) (*SessionEventList, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -1532,6 +1636,15 @@ func (UnimplementedLogServiceServer) GetLog(context.Context, *GetLogRequest) (*L
 func (UnimplementedLogServiceServer) DeleteLog(context.Context, *DeleteLogRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteLog not implemented")
 }
+func (UnimplementedLogServiceServer) GetRecording(*GetRecordingRequest, grpc.ServerStreamingServer[RecordingChunk]) error {
+	return status.Error(codes.Unimplemented, "method GetRecording not implemented")
+}
+func (UnimplementedLogServiceServer) SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchLogs not implemented")
+}
+func (UnimplementedLogServiceServer) GetSessionEvents(context.Context, *GetSessionEventsRequest) (*SessionEventList, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionEvents not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 func (UnimplementedLogServiceServer) testEmbeddedByValue()                    {}
 
@@ -1607,6 +1720,53 @@ func _LogService_DeleteLog_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_GetRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRecordingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogServiceServer).GetRecording(m, &grpc.GenericServerStream[GetRecordingRequest, RecordingChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LogService_GetRecordingServer = grpc.ServerStreamingServer[RecordingChunk]
+
+func _LogService_SearchLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).SearchLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_SearchLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).SearchLogs(ctx, req.(*SearchLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_GetSessionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).GetSessionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_GetSessionEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).GetSessionEvents(ctx, req.(*GetSessionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1626,8 +1786,22 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLog",
 			Handler:    _LogService_DeleteLog_Handler,
 		},
+		{
+			MethodName: "SearchLogs",
+			Handler:    _LogService_SearchLogs_Handler,
+		},
+		{
+			MethodName: "GetSessionEvents",
+			Handler:    _LogService_GetSessionEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetRecording",
+			Handler:       _LogService_GetRecording_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/watchfire.proto",
 }
 
@@ -1642,6 +1816,7 @@ const (
 	AgentService_SubscribeRawOutput_FullMethodName   = "/watchfire.AgentService/SubscribeRawOutput"
 	AgentService_SubscribeAgentIssues_FullMethodName = "/watchfire.AgentService/SubscribeAgentIssues"
 	AgentService_ResumeAgent_FullMethodName          = "/watchfire.AgentService/ResumeAgent"
+	AgentService_AnswerQuestion_FullMethodName       = "/watchfire.AgentService/AnswerQuestion"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -1660,6 +1835,7 @@ type AgentServiceClient interface {
 	SubscribeRawOutput(ctx context.Context, in *SubscribeRawOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RawOutputChunk], error)
 	SubscribeAgentIssues(ctx context.Context, in *SubscribeAgentIssuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AgentIssue], error)
 	ResumeAgent(ctx context.Context, in *ProjectId, opts ...grpc.CallOption) (*AgentStatus, error)
+	AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type agentServiceClient struct {
@@ -1797,6 +1973,16 @@ func (c *agentServiceClient) ResumeAgent(ctx context.Context, in *ProjectId, opt
 	return out, nil
 }
 
+func (c *agentServiceClient) AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AgentService_AnswerQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServiceServer is the server API for AgentService service.
 // All implementations must embed UnimplementedAgentServiceServer
 // for forward compatibility.
@@ -1813,6 +1999,7 @@ type AgentServiceServer interface {
 	SubscribeRawOutput(*SubscribeRawOutputRequest, grpc.ServerStreamingServer[RawOutputChunk]) error
 	SubscribeAgentIssues(*SubscribeAgentIssuesRequest, grpc.ServerStreamingServer[AgentIssue]) error
 	ResumeAgent(context.Context, *ProjectId) (*AgentStatus, error)
+	AnswerQuestion(context.Context, *AnswerQuestionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAgentServiceServer()
 }
 
@@ -1853,6 +2040,9 @@ func (UnimplementedAgentServiceServer) SubscribeAgentIssues(*SubscribeAgentIssue
 func (UnimplementedAgentServiceServer) ResumeAgent(context.Context, *ProjectId) (*AgentStatus, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResumeAgent not implemented")
 }
+func (UnimplementedAgentServiceServer) AnswerQuestion(context.Context, *AnswerQuestionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnswerQuestion not implemented")
+}
 func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
 func (UnimplementedAgentServiceServer) testEmbeddedByValue()                      {}
 
@@ -2033,6 +2223,24 @@ func _AgentService_ResumeAgent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_AnswerQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).AnswerQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_AnswerQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).AnswerQuestion(ctx, req.(*AnswerQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2068,6 +2276,10 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResumeAgent",
 			Handler:    _AgentService_ResumeAgent_Handler,
 		},
+		{
+			MethodName: "AnswerQuestion",
+			Handler:    _AgentService_AnswerQuestion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2811,6 +3023,7 @@ const (
 	InsightsService_GetGlobalInsights_FullMethodName  = "/watchfire.InsightsService/GetGlobalInsights"
 	InsightsService_GetProjectInsights_FullMethodName = "/watchfire.InsightsService/GetProjectInsights"
 	InsightsService_GetTaskDiff_FullMethodName        = "/watchfire.InsightsService/GetTaskDiff"
+	InsightsService_GetHotspots_FullMethodName        = "/watchfire.InsightsService/GetHotspots"
 )
 
 // InsightsServiceClient is the client API for InsightsService service.
@@ -2827,6 +3040,7 @@ type InsightsServiceClient interface {
 	GetGlobalInsights(ctx context.Context, in *GetGlobalInsightsRequest, opts ...grpc.CallOption) (*GlobalInsights, error)
 	GetProjectInsights(ctx context.Context, in *GetProjectInsightsRequest, opts ...grpc.CallOption) (*ProjectInsights, error)
 	GetTaskDiff(ctx context.Context, in *GetTaskDiffRequest, opts ...grpc.CallOption) (*FileDiffSet, error)
+	GetHotspots(ctx context.Context, in *GetHotspotsRequest, opts ...grpc.CallOption) (*Hotspots, error)
 }
 
 type insightsServiceClient struct {
@@ -2877,6 +3091,16 @@ func (c *insightsServiceClient) GetTaskDiff(ctx context.Context, in *GetTaskDiff
 	return out, nil
 }
 
+func (c *insightsServiceClient) GetHotspots(ctx context.Context, in *GetHotspotsRequest, opts ...grpc.CallOption) (*Hotspots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hotspots)
+	err := c.cc.Invoke(ctx, InsightsService_GetHotspots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InsightsServiceServer is the server API for InsightsService service.
 // All implementations must embed UnimplementedInsightsServiceServer
 // for forward compatibility.
@@ -2891,6 +3115,7 @@ type InsightsServiceServer interface {
 	GetGlobalInsights(context.Context, *GetGlobalInsightsRequest) (*GlobalInsights, error)
 	GetProjectInsights(context.Context, *GetProjectInsightsRequest) (*ProjectInsights, error)
 	GetTaskDiff(context.Context, *GetTaskDiffRequest) (*FileDiffSet, error)
+	GetHotspots(context.Context, *GetHotspotsRequest) (*Hotspots, error)
 	mustEmbedUnimplementedInsightsServiceServer()
 }
 
@@ -2913,6 +3138,9 @@ func (UnimplementedInsightsServiceServer) GetProjectInsights(context.Context, *G
 func (UnimplementedInsightsServiceServer) GetTaskDiff(context.Context, *GetTaskDiffRequest) (*FileDiffSet, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTaskDiff not implemented")
 }
+func (UnimplementedInsightsServiceServer) GetHotspots(context.Context, *GetHotspotsRequest) (*Hotspots, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotspots not implemented")
+}
 func (UnimplementedInsightsServiceServer) mustEmbedUnimplementedInsightsServiceServer() {}
 func (UnimplementedInsightsServiceServer) testEmbeddedByValue()                         {}
 
@@ -3006,6 +3234,24 @@ func _InsightsService_GetTaskDiff_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InsightsService_GetHotspots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotspotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsightsServiceServer).GetHotspots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InsightsService_GetHotspots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsightsServiceServer).GetHotspots(ctx, req.(*GetHotspotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InsightsService_ServiceDesc is the grpc.ServiceDesc for InsightsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3029,6 +3275,10 @@ var InsightsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskDiff",
 			Handler:    _InsightsService_GetTaskDiff_Handler,
 		},
+		{
+			MethodName: "GetHotspots",
+			Handler:    _InsightsService_GetHotspots_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/watchfire.proto",
@@ -3048,6 +3298,10 @@ const (
 	IntegrationsService_BeginTelegramPairing_FullMethodName     = "/watchfire.IntegrationsService/BeginTelegramPairing"
 	IntegrationsService_GetTelegramPairingStatus_FullMethodName = "/watchfire.IntegrationsService/GetTelegramPairingStatus"
 	IntegrationsService_RevokeTelegramChat_FullMethodName       = "/watchfire.IntegrationsService/RevokeTelegramChat"
+	IntegrationsService_ListFailedDeliveries_FullMethodName     = "/watchfire.IntegrationsService/ListFailedDeliveries"
+	IntegrationsService_ReplayDelivery_FullMethodName           = "/watchfire.IntegrationsService/ReplayDelivery"
+	IntegrationsService_PreviewTemplate_FullMethodName          = "/watchfire.IntegrationsService/PreviewTemplate"
+	IntegrationsService_TestRoute_FullMethodName                = "/watchfire.IntegrationsService/TestRoute"
 )
 
 // IntegrationsServiceClient is the client API for IntegrationsService service.
@@ -3095,6 +3349,20 @@ type IntegrationsServiceClient interface {
 	BeginTelegramPairing(ctx context.Context, in *BeginTelegramPairingRequest, opts ...grpc.CallOption) (*BeginTelegramPairingResponse, error)
 	GetTelegramPairingStatus(ctx context.Context, in *GetTelegramPairingStatusRequest, opts ...grpc.CallOption) (*TelegramPairingStatus, error)
 	RevokeTelegramChat(ctx context.Context, in *RevokeTelegramChatRequest, opts ...grpc.CallOption) (*IntegrationsConfig, error)
+	// Durable relay outbox. ListFailedDeliveries returns the dead-lettered
+	// deliveries (and, on request, the ones still retrying); ReplayDelivery
+	// re-queues one for an immediate attempt with a fresh retry budget.
+	ListFailedDeliveries(ctx context.Context, in *ListFailedDeliveriesRequest, opts ...grpc.CallOption) (*ListFailedDeliveriesResponse, error)
+	ReplayDelivery(ctx context.Context, in *ReplayDeliveryRequest, opts ...grpc.CallOption) (*RelayDelivery, error)
+	// Relay message templates. Overrides live in
+	// ~/.watchfire/templates/relay/ (per endpoint in a subdirectory named
+	// after its id); PreviewTemplate validates and previews one against a
+	// sample payload and can save it.
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error)
+	// Notification routing. TestRoute dry-runs one notification through
+	// the `routes:` rules and reports where it would be delivered; nothing
+	// is sent.
+	TestRoute(ctx context.Context, in *TestRouteRequest, opts ...grpc.CallOption) (*TestRouteResponse, error)
 }
 
 type integrationsServiceClient struct {
@@ -3235,6 +3503,46 @@ func (c *integrationsServiceClient) RevokeTelegramChat(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *integrationsServiceClient) ListFailedDeliveries(ctx context.Context, in *ListFailedDeliveriesRequest, opts ...grpc.CallOption) (*ListFailedDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFailedDeliveriesResponse)
+	err := c.cc.Invoke(ctx, IntegrationsService_ListFailedDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationsServiceClient) ReplayDelivery(ctx context.Context, in *ReplayDeliveryRequest, opts ...grpc.CallOption) (*RelayDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelayDelivery)
+	err := c.cc.Invoke(ctx, IntegrationsService_ReplayDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationsServiceClient) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewTemplateResponse)
+	err := c.cc.Invoke(ctx, IntegrationsService_PreviewTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationsServiceClient) TestRoute(ctx context.Context, in *TestRouteRequest, opts ...grpc.CallOption) (*TestRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestRouteResponse)
+	err := c.cc.Invoke(ctx, IntegrationsService_TestRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IntegrationsServiceServer is the server API for IntegrationsService service.
 // All implementations must embed UnimplementedIntegrationsServiceServer
 // for forward compatibility.
@@ -3280,6 +3588,20 @@ type IntegrationsServiceServer interface {
 	BeginTelegramPairing(context.Context, *BeginTelegramPairingRequest) (*BeginTelegramPairingResponse, error)
 	GetTelegramPairingStatus(context.Context, *GetTelegramPairingStatusRequest) (*TelegramPairingStatus, error)
 	RevokeTelegramChat(context.Context, *RevokeTelegramChatRequest) (*IntegrationsConfig, error)
+	// Durable relay outbox. ListFailedDeliveries returns the dead-lettered
+	// deliveries (and, on request, the ones still retrying); ReplayDelivery
+	// re-queues one for an immediate attempt with a fresh retry budget.
+	ListFailedDeliveries(context.Context, *ListFailedDeliveriesRequest) (*ListFailedDeliveriesResponse, error)
+	ReplayDelivery(context.Context, *ReplayDeliveryRequest) (*RelayDelivery, error)
+	// Relay message templates. Overrides live in
+	// ~/.watchfire/templates/relay/ (per endpoint in a subdirectory named
+	// after its id); PreviewTemplate validates and previews one against a
+	// sample payload and can save it.
+	PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error)
+	// Notification routing. TestRoute dry-runs one notification through
+	// the `routes:` rules and reports where it would be delivered; nothing
+	// is sent.
+	TestRoute(context.Context, *TestRouteRequest) (*TestRouteResponse, error)
 	mustEmbedUnimplementedIntegrationsServiceServer()
 }
 
@@ -3329,6 +3651,18 @@ func (UnimplementedIntegrationsServiceServer) GetTelegramPairingStatus(context.C
 func (UnimplementedIntegrationsServiceServer) RevokeTelegramChat(context.Context, *RevokeTelegramChatRequest) (*IntegrationsConfig, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeTelegramChat not implemented")
 }
+func (UnimplementedIntegrationsServiceServer) ListFailedDeliveries(context.Context, *ListFailedDeliveriesRequest) (*ListFailedDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFailedDeliveries not implemented")
+}
+func (UnimplementedIntegrationsServiceServer) ReplayDelivery(context.Context, *ReplayDeliveryRequest) (*RelayDelivery, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDelivery not implemented")
+}
+func (UnimplementedIntegrationsServiceServer) PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewTemplate not implemented")
+}
+func (UnimplementedIntegrationsServiceServer) TestRoute(context.Context, *TestRouteRequest) (*TestRouteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestRoute not implemented")
+}
 func (UnimplementedIntegrationsServiceServer) mustEmbedUnimplementedIntegrationsServiceServer() {}
 func (UnimplementedIntegrationsServiceServer) testEmbeddedByValue()                             {}
 
@@ -3584,6 +3918,78 @@ func _IntegrationsService_RevokeTelegramChat_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntegrationsService_ListFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).ListFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_ListFailedDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).ListFailedDeliveries(ctx, req.(*ListFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationsService_ReplayDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).ReplayDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_ReplayDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).ReplayDelivery(ctx, req.(*ReplayDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationsService_PreviewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).PreviewTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_PreviewTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).PreviewTemplate(ctx, req.(*PreviewTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationsService_TestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).TestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_TestRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).TestRoute(ctx, req.(*TestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IntegrationsService_ServiceDesc is the grpc.ServiceDesc for IntegrationsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3643,6 +4049,22 @@ var IntegrationsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeTelegramChat",
 			Handler:    _IntegrationsService_RevokeTelegramChat_Handler,
 		},
+		{
+			MethodName: "ListFailedDeliveries",
+			Handler:    _IntegrationsService_ListFailedDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayDelivery",
+			Handler:    _IntegrationsService_ReplayDelivery_Handler,
+		},
+		{
+			MethodName: "PreviewTemplate",
+			Handler:    _IntegrationsService_PreviewTemplate_Handler,
+		},
+		{
+			MethodName: "TestRoute",
+			Handler:    _IntegrationsService_TestRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/watchfire.proto",